@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
@@ -10,6 +12,8 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
@@ -22,8 +26,11 @@ import (
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
 	grpcserver "github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/grpc"
 	httphandler "github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/http"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/service"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/telemetry"
 )
 
 func main() {
@@ -32,65 +39,121 @@ func main() {
 	// Load configuration
 	cfg := config.Load()
 
-	// Validate required configuration
-	if cfg.InstanceConnection == "" {
-		log.Fatal("Cloud SQL instance connection not configured. Set GCP_PROJECT_ID, GCP_REGION, and CLOUDSQL_INSTANCE_NAME environment variables.")
+	// Validate required configuration (DATABASE_URL bypasses Cloud SQL)
+	if cfg.DatabaseURL == "" {
+		if cfg.InstanceConnection == "" {
+			log.Fatal("Cloud SQL instance connection not configured. Set GCP_PROJECT_ID, GCP_REGION, and CLOUDSQL_INSTANCE_NAME environment variables, or DATABASE_URL.")
+		}
+		if cfg.DatabaseUser == "" {
+			log.Fatal("Database user not configured. Set DB_USER environment variable (IAM email without domain for Cloud SQL IAM auth).")
+		}
 	}
-	if cfg.DatabaseUser == "" {
-		log.Fatal("Database user not configured. Set DB_USER environment variable (IAM email without domain for Cloud SQL IAM auth).")
+
+	// "server migrate ..." manages the schema and exits
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(ctx, cfg, os.Args[2:]); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+
+	// Configure token signing before touching the database so a bad setup fails fast
+	jwtService, err := newJWTService(cfg)
+	if err != nil {
+		log.Fatalf("Failed to configure JWT signing: %v", err)
+	}
+
+	// Prometheus metrics (served on /metrics) and OpenTelemetry traces linking RPCs to their queries
+	metrics := telemetry.NewMetrics()
+	shutdownTracing, err := telemetry.SetupTracing(ctx, cfg.ServiceName, cfg.OTLPEndpoint)
+	if err != nil {
+		log.Fatalf("Failed to configure tracing: %v", err)
+	}
+	if cfg.OTLPEndpoint != "" {
+		log.Printf("Exporting traces to %s", cfg.OTLPEndpoint)
 	}
 
 	// Create database connection pool
-	pool, cleanup, err := db.NewPool(ctx, cfg.InstanceConnection, cfg.DatabaseUser, cfg.DatabaseName, cfg.DatabasePassword, cfg.DatabasePort)
+	pool, cleanup, err := openDatabase(ctx, cfg, metrics.NewQueryTracer("primary"))
 	if err != nil {
-		log.Fatalf("Failed to connect to Cloud SQL: %v", err)
+		log.Fatalf("Failed to connect to database: %v", err)
 	}
 	defer cleanup()
+	if err := metrics.Register(telemetry.NewPoolCollector("primary", pool)); err != nil {
+		log.Fatalf("Failed to register pool metrics: %v", err)
+	}
 
-	log.Printf("Connected to database: %s", cfg.DatabaseName)
+	log.Printf("Connected to database: %s (max %d connections)", pool.Config().ConnConfig.Database, pool.Config().MaxConns)
 
-	// Create RLS-aware pool wrapper
-	rlsPool := db.NewRLSPool(pool)
+	// Create RLS-aware pool wrapper, routing Get/List queries to the read replica if configured
+	replica, replicaCleanup, err := openReplica(ctx, cfg, metrics.NewQueryTracer("replica"))
+	if err != nil {
+		log.Fatalf("Failed to connect to read replica: %v", err)
+	}
+	var rlsPool *db.RLSPool
+	if replica != nil {
+		defer replicaCleanup()
+		if err := metrics.Register(telemetry.NewPoolCollector("replica", replica)); err != nil {
+			log.Fatalf("Failed to register replica pool metrics: %v", err)
+		}
+		log.Printf("Connected to read replica (read-your-writes window %s)", cfg.ReadYourWritesWindow)
+		rlsPool = db.NewRLSPoolWithReplica(pool, replica, cfg.ReadYourWritesWindow)
+	} else {
+		rlsPool = db.NewRLSPool(pool)
+	}
+
+	// Retry reads and transactions that fail with transient errors (serialization failures,
+	// deadlocks, Cloud SQL maintenance restarts)
+	retryMetrics := &db.RetryMetrics{}
+	database := db.NewRetryingDB(rlsPool, db.DefaultRetryPolicy, retryMetrics)
+	if err := metrics.Register(telemetry.NewRetryCollector(retryMetrics)); err != nil {
+		log.Fatalf("Failed to register retry metrics: %v", err)
+	}
 
 	// Create repositories with RLS pool (auto-sets app.organization_id per request)
-	orgRepo := repository.NewOrganizationRepositoryWithDB(rlsPool)
-	appUserRepo := repository.NewAppUserRepositoryWithDB(rlsPool)
-	userOrgRepo := repository.NewUserOrganizationRepositoryWithDB(rlsPool)
-	fileRepo := repository.NewFileRepositoryWithDB(rlsPool)
-	flickrPhotoRepo := repository.NewFlickrPhotoRepositoryWithDB(rlsPool)
-	camFileRepo := repository.NewCamFileRepositoryWithDB(rlsPool)
-	camFileExeRepo := repository.NewCamFileExeRepositoryWithDB(rlsPool)
-	camFileExeStageRepo := repository.NewCamFileExeStageRepositoryWithDB(rlsPool)
-	ichibanCarRepo := repository.NewIchibanCarRepositoryWithDB(rlsPool)
-	dtakoCarsIchibanCarsRepo := repository.NewDtakoCarsIchibanCarsRepositoryWithDB(rlsPool)
-	uriageRepo := repository.NewUriageRepositoryWithDB(rlsPool)
-	uriageJishaRepo := repository.NewUriageJishaRepositoryWithDB(rlsPool)
-	carInspectionRepo := repository.NewCarInspectionRepositoryWithDB(rlsPool)
-	carInspectionFilesRepo := repository.NewCarInspectionFilesRepositoryWithDB(rlsPool)
-	carInspectionFilesARepo := repository.NewCarInspectionFilesARepositoryWithDB(rlsPool)
-	carInspectionFilesBRepo := repository.NewCarInspectionFilesBRepositoryWithDB(rlsPool)
-	carInspectionDeregistrationRepo := repository.NewCarInspectionDeregistrationRepositoryWithDB(rlsPool)
-	carInspectionDeregistrationFilesRepo := repository.NewCarInspectionDeregistrationFilesRepositoryWithDB(rlsPool)
-	carInsSheetIchibanCarsRepo := repository.NewCarInsSheetIchibanCarsRepositoryWithDB(rlsPool)
-	carInsSheetIchibanCarsARepo := repository.NewCarInsSheetIchibanCarsARepositoryWithDB(rlsPool)
-	kudgfryRepo := repository.NewKudgfryRepositoryWithDB(rlsPool)
-	kudguriRepo := repository.NewKudguriRepositoryWithDB(rlsPool)
-	kudgcstRepo := repository.NewKudgcstRepositoryWithDB(rlsPool)
-	kudgfulRepo := repository.NewKudgfulRepositoryWithDB(rlsPool)
-	kudgsirRepo := repository.NewKudgsirRepositoryWithDB(rlsPool)
-	kudgivtRepo := repository.NewKudgivtRepositoryWithDB(rlsPool)
-	dtakologsRepo := repository.NewDtakologsRepositoryWithDB(rlsPool)
-	oauthAccountRepo := repository.NewOAuthAccountRepositoryWithDB(rlsPool)
-	invitationRepo := repository.NewInvitationRepositoryWithDB(rlsPool)
-	etcMeisaiRepo := repository.NewETCMeisaiRepositoryWithDB(rlsPool)
+	orgRepo := repository.NewOrganizationRepositoryWithDB(database)
+	appUserRepo := repository.NewAppUserRepositoryWithDB(database)
+	userOrgRepo := repository.NewUserOrganizationRepositoryWithDB(database)
+	fileRepo := repository.NewFileRepositoryWithDB(database)
+	flickrPhotoRepo := repository.NewFlickrPhotoRepositoryWithDB(database)
+	camFileRepo := repository.NewCamFileRepositoryWithDB(database)
+	camFileExeRepo := repository.NewCamFileExeRepositoryWithDB(database)
+	camFileExeStageRepo := repository.NewCamFileExeStageRepositoryWithDB(database)
+	ichibanCarRepo := repository.NewIchibanCarRepositoryWithDB(database)
+	dtakoCarsIchibanCarsRepo := repository.NewDtakoCarsIchibanCarsRepositoryWithDB(database)
+	uriageRepo := repository.NewUriageRepositoryWithDB(database)
+	uriageJishaRepo := repository.NewUriageJishaRepositoryWithDB(database)
+	carInspectionRepo := repository.NewCarInspectionRepositoryWithDB(database)
+	carInspectionFilesRepo := repository.NewCarInspectionFilesRepositoryWithDB(database)
+	carInspectionFilesARepo := repository.NewCarInspectionFilesARepositoryWithDB(database)
+	carInspectionFilesBRepo := repository.NewCarInspectionFilesBRepositoryWithDB(database)
+	carInspectionDeregistrationRepo := repository.NewCarInspectionDeregistrationRepositoryWithDB(database)
+	carInspectionDeregistrationFilesRepo := repository.NewCarInspectionDeregistrationFilesRepositoryWithDB(database)
+	carInsSheetIchibanCarsRepo := repository.NewCarInsSheetIchibanCarsRepositoryWithDB(database)
+	carInsSheetIchibanCarsARepo := repository.NewCarInsSheetIchibanCarsARepositoryWithDB(database)
+	kudgfryRepo := repository.NewKudgfryRepositoryWithDB(database)
+	kudguriRepo := repository.NewKudguriRepositoryWithDB(database)
+	kudgcstRepo := repository.NewKudgcstRepositoryWithDB(database)
+	kudgfulRepo := repository.NewKudgfulRepositoryWithDB(database)
+	kudgsirRepo := repository.NewKudgsirRepositoryWithDB(database)
+	kudgivtRepo := repository.NewKudgivtRepositoryWithDB(database)
+	dtakologsRepo := repository.NewDtakologsRepositoryWithDB(database)
+	oauthAccountRepo := repository.NewOAuthAccountRepositoryWithDB(database)
+	invitationRepo := repository.NewInvitationRepositoryWithDB(database)
+	etcMeisaiRepo := repository.NewETCMeisaiRepositoryWithDB(database)
+	refreshTokenRepo := repository.NewRefreshTokenRepositoryWithDB(database)
+	apiKeyRepo := repository.NewAPIKeyRepositoryWithDB(database)
+	auditLogRepo := repository.NewAuditLogRepositoryWithDB(database)
+
+	// Create services for operations that span repositories (one transaction each)
+	unitOfWork := service.NewUnitOfWork(database)
+	invitationService := service.NewInvitationService(unitOfWork)
+	carInspectionService := service.NewCarInspectionService(unitOfWork)
 
 	// Create auth services
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		jwtSecret = "default-secret-change-in-production"
-		log.Println("WARNING: JWT_SECRET not set, using default secret")
-	}
-	jwtService := auth.NewJWTService(jwtSecret, 15*time.Minute, 7*24*time.Hour)
+	sessionService := auth.NewSessionService(jwtService, refreshTokenRepo, appUserRepo, userOrgRepo, unitOfWork)
+	apiKeyVerifier := auth.NewAPIKeyVerifier(apiKeyRepo)
+	identityService := auth.NewIdentityService(appUserRepo, oauthAccountRepo, unitOfWork, cfg.OAuthAutoLinkVerifiedEmail)
 
 	googleClient := auth.NewGoogleOAuthClient(
 		os.Getenv("GOOGLE_CLIENT_ID"),
@@ -102,51 +165,86 @@ func main() {
 		os.Getenv("LINE_CHANNEL_SECRET"),
 		os.Getenv("LINE_REDIRECT_URI"),
 	)
+	stateSigner := auth.NewStateSigner(oauthStateKey(cfg), 10*time.Minute)
+	pageTokens := pagination.NewTokens(pageTokenKey(cfg))
+
+	// Membership cache used by the RLS interceptor to verify x-organization-id
+	// and by the authorization interceptor to look up the caller's role;
+	// membership writes invalidate it
+	membershipCache := grpcserver.NewMembershipCache(userOrgRepo, grpcserver.DefaultMembershipCacheTTL)
 
 	// Create gRPC servers
-	orgServer := grpcserver.NewOrganizationServer(orgRepo)
-	appUserServer := grpcserver.NewAppUserServer(appUserRepo)
-	userOrgServer := grpcserver.NewUserOrganizationServer(userOrgRepo)
-	fileServer := grpcserver.NewFileServer(fileRepo)
-	flickrPhotoServer := grpcserver.NewFlickrPhotoServer(flickrPhotoRepo)
-	camFileServer := grpcserver.NewCamFileServer(camFileRepo)
-	camFileExeServer := grpcserver.NewCamFileExeServer(camFileExeRepo)
-	camFileExeStageServer := grpcserver.NewCamFileExeStageServer(camFileExeStageRepo)
-	ichibanCarServer := grpcserver.NewIchibanCarServer(ichibanCarRepo)
-	dtakoCarsIchibanCarsServer := grpcserver.NewDtakoCarsIchibanCarsServer(dtakoCarsIchibanCarsRepo)
-	uriageServer := grpcserver.NewUriageServer(uriageRepo)
-	uriageJishaServer := grpcserver.NewUriageJishaServer(uriageJishaRepo)
-	carInspectionServer := grpcserver.NewCarInspectionServer(carInspectionRepo)
-	carInspectionFilesServer := grpcserver.NewCarInspectionFilesServer(carInspectionFilesRepo)
-	carInspectionFilesAServer := grpcserver.NewCarInspectionFilesAServer(carInspectionFilesARepo)
-	carInspectionFilesBServer := grpcserver.NewCarInspectionFilesBServer(carInspectionFilesBRepo)
-	carInspectionDeregistrationServer := grpcserver.NewCarInspectionDeregistrationServer(carInspectionDeregistrationRepo)
-	carInspectionDeregistrationFilesServer := grpcserver.NewCarInspectionDeregistrationFilesServer(carInspectionDeregistrationFilesRepo)
-	carInsSheetIchibanCarsServer := grpcserver.NewCarInsSheetIchibanCarsServer(carInsSheetIchibanCarsRepo)
-	carInsSheetIchibanCarsAServer := grpcserver.NewCarInsSheetIchibanCarsAServer(carInsSheetIchibanCarsARepo)
-	kudgfryServer := grpcserver.NewKudgfryServer(kudgfryRepo)
-	kudguriServer := grpcserver.NewKudguriServer(kudguriRepo)
-	kudgcstServer := grpcserver.NewKudgcstServer(kudgcstRepo)
-	kudgfulServer := grpcserver.NewKudgfulServer(kudgfulRepo)
-	kudgsirServer := grpcserver.NewKudgsirServer(kudgsirRepo)
-	kudgivtServer := grpcserver.NewKudgivtServer(kudgivtRepo)
-	dtakologsServer := grpcserver.NewDtakologsServer(dtakologsRepo)
-	authServer := grpcserver.NewAuthServer(appUserRepo, oauthAccountRepo, jwtService, googleClient, lineClient)
-	invitationServer := grpcserver.NewInvitationServer(invitationRepo, orgRepo, userOrgRepo)
-	etcMeisaiServer := grpcserver.NewETCMeisaiServer(etcMeisaiRepo)
+	orgServer := grpcserver.NewOrganizationServer(orgRepo, pageTokens)
+	appUserServer := grpcserver.NewAppUserServer(appUserRepo, pageTokens)
+	userOrgServer := grpcserver.NewUserOrganizationServer(userOrgRepo, membershipCache, pageTokens)
+	fileServer := grpcserver.NewFileServer(fileRepo, pageTokens)
+	flickrPhotoServer := grpcserver.NewFlickrPhotoServer(flickrPhotoRepo, pageTokens)
+	camFileServer := grpcserver.NewCamFileServer(camFileRepo, pageTokens)
+	camFileExeServer := grpcserver.NewCamFileExeServer(camFileExeRepo, pageTokens)
+	camFileExeStageServer := grpcserver.NewCamFileExeStageServer(camFileExeStageRepo, pageTokens)
+	ichibanCarServer := grpcserver.NewIchibanCarServer(ichibanCarRepo, pageTokens)
+	dtakoCarsIchibanCarsServer := grpcserver.NewDtakoCarsIchibanCarsServer(dtakoCarsIchibanCarsRepo, pageTokens)
+	uriageServer := grpcserver.NewUriageServer(uriageRepo, pageTokens)
+	uriageJishaServer := grpcserver.NewUriageJishaServer(uriageJishaRepo, pageTokens)
+	carInspectionServer := grpcserver.NewCarInspectionServer(carInspectionRepo, carInspectionService, pageTokens)
+	carInspectionFilesServer := grpcserver.NewCarInspectionFilesServer(carInspectionFilesRepo, pageTokens)
+	carInspectionFilesAServer := grpcserver.NewCarInspectionFilesAServer(carInspectionFilesARepo, pageTokens)
+	carInspectionFilesBServer := grpcserver.NewCarInspectionFilesBServer(carInspectionFilesBRepo, pageTokens)
+	carInspectionDeregistrationServer := grpcserver.NewCarInspectionDeregistrationServer(carInspectionDeregistrationRepo, pageTokens)
+	carInspectionDeregistrationFilesServer := grpcserver.NewCarInspectionDeregistrationFilesServer(carInspectionDeregistrationFilesRepo, pageTokens)
+	carInsSheetIchibanCarsServer := grpcserver.NewCarInsSheetIchibanCarsServer(carInsSheetIchibanCarsRepo, pageTokens)
+	carInsSheetIchibanCarsAServer := grpcserver.NewCarInsSheetIchibanCarsAServer(carInsSheetIchibanCarsARepo, pageTokens)
+	kudgfryServer := grpcserver.NewKudgfryServer(kudgfryRepo, pageTokens)
+	kudguriServer := grpcserver.NewKudguriServer(kudguriRepo, pageTokens)
+	kudgcstServer := grpcserver.NewKudgcstServer(kudgcstRepo, pageTokens)
+	kudgfulServer := grpcserver.NewKudgfulServer(kudgfulRepo, pageTokens)
+	kudgsirServer := grpcserver.NewKudgsirServer(kudgsirRepo, pageTokens)
+	kudgivtServer := grpcserver.NewKudgivtServer(kudgivtRepo, pageTokens)
+	dtakologsServer := grpcserver.NewDtakologsServer(dtakologsRepo, pageTokens)
+	authServer := grpcserver.NewAuthServer(appUserRepo, oauthAccountRepo, jwtService, sessionService, identityService, googleClient, lineClient)
+	invitationServer := grpcserver.NewInvitationServer(invitationRepo, orgRepo, userOrgRepo, invitationService, pageTokens)
+	etcMeisaiServer := grpcserver.NewETCMeisaiServer(etcMeisaiRepo, pageTokens)
+	apiKeyServer := grpcserver.NewApiKeyServer(apiKeyRepo)
+	auditLogServer := grpcserver.NewAuditLogServer(auditLogRepo, pageTokens)
 
 	// Create HTTP auth handler
-	authHandler := httphandler.NewAuthHandler(googleClient, lineClient, jwtService, appUserRepo, oauthAccountRepo, cfg.FrontendURL)
+	authHandler := httphandler.NewAuthHandler(googleClient, lineClient, jwtService, sessionService, stateSigner, identityService, cfg.FrontendURL, cfg.OAuthRedirectPaths)
 
-	// Create gRPC server with health check, JWT auth, and RLS interceptor
-	// JWT interceptor runs first (validates token and sets user context),
-	// then RLS interceptor (sets organization context)
+	authorizer := grpcserver.NewAuthorizer(membershipCache, grpcserver.DefaultMethodPolicies(userOrgRepo))
+
+	// Per-method deadlines; the RLS pool applies them as statement_timeout
+	rpcTimeouts := grpcserver.NewTimeouts(cfg.RPCDefaultTimeout, grpcserver.DefaultMethodTimeouts())
+
+	// Create gRPC server with health check, JWT auth, RLS and authorization interceptors
+	// Telemetry interceptor runs first (traces the call and records its latency and final status code),
+	// then error interceptor (converts repository errors to status codes and hides
+	// internal error messages from clients),
+	// then timeout interceptor (sets the per-method deadline, which also bounds the queries),
+	// then JWT interceptor (validates token or x-api-key and sets user context),
+	// then RLS interceptor (verifies membership and sets organization context),
+	// then read routing interceptor (marks Get/List calls read-only for the replica),
+	// then audit interceptor (records mutating calls, including denied ones),
+	// then authorization interceptor (checks the caller's role against the method policy)
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
-			grpcserver.JWTUnaryInterceptor(jwtService),
-			grpcserver.RLSUnaryInterceptor(),
+			grpcserver.TelemetryUnaryInterceptor(metrics),
+			grpcserver.ErrorUnaryInterceptor(),
+			grpcserver.TimeoutUnaryInterceptor(rpcTimeouts),
+			grpcserver.JWTUnaryInterceptor(jwtService, apiKeyVerifier),
+			grpcserver.RLSUnaryInterceptor(membershipCache),
+			grpcserver.ReadRoutingUnaryInterceptor(),
+			grpcserver.AuditUnaryInterceptor(auditLogRepo),
+			grpcserver.AuthorizationUnaryInterceptor(authorizer),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcserver.TelemetryStreamInterceptor(metrics),
+			grpcserver.ErrorStreamInterceptor(),
+			grpcserver.TimeoutStreamInterceptor(rpcTimeouts),
+			grpcserver.JWTStreamInterceptor(jwtService, apiKeyVerifier),
+			grpcserver.RLSStreamInterceptor(membershipCache),
+			grpcserver.ReadRoutingStreamInterceptor(),
+			grpcserver.AuthorizationStreamInterceptor(authorizer),
 		),
-		grpc.StreamInterceptor(grpcserver.RLSStreamInterceptor()),
 	)
 
 	// Register all services
@@ -180,6 +278,8 @@ func main() {
 	pb.RegisterAuthServiceServer(grpcServer, authServer)
 	pb.RegisterInvitationServiceServer(grpcServer, invitationServer)
 	pb.RegisterETCMeisaiServiceServer(grpcServer, etcMeisaiServer)
+	pb.RegisterApiKeyServiceServer(grpcServer, apiKeyServer)
+	pb.RegisterAuditLogServiceServer(grpcServer, auditLogServer)
 
 	// Register health check service for Cloud Run
 	healthServer := health.NewServer()
@@ -197,6 +297,7 @@ func main() {
 	// Create HTTP mux for auth endpoints
 	httpMux := http.NewServeMux()
 	authHandler.RegisterRoutes(httpMux)
+	httpMux.Handle("/metrics", metrics.Handler())
 
 	// Create a handler that routes based on content-type and HTTP/2
 	// gRPC uses HTTP/2 with content-type starting with "application/grpc"
@@ -226,8 +327,12 @@ func main() {
 		<-sigCh
 
 		log.Println("Shutting down servers...")
+		log.Printf("Database retries: %+v", retryMetrics.Snapshot())
 		grpcServer.GracefulStop()
 		httpServer.Shutdown(context.Background())
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Printf("Failed to flush traces: %v", err)
+		}
 	}()
 
 	log.Printf("Starting server on port %s (HTTP + gRPC with h2c)", port)
@@ -237,3 +342,129 @@ func main() {
 
 	log.Println("Server stopped")
 }
+
+// openDatabase connects to DATABASE_URL if set, and to Cloud SQL otherwise.
+// tracer may be nil.
+func openDatabase(ctx context.Context, cfg *config.Config, tracer pgx.QueryTracer) (*pgxpool.Pool, func() error, error) {
+	opts := poolOptions(cfg)
+	opts.Tracer = tracer
+
+	if cfg.DatabaseURL != "" {
+		pool, err := db.NewURLPool(ctx, cfg.DatabaseURL, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		cleanup := func() error {
+			pool.Close()
+			return nil
+		}
+		return pool, cleanup, nil
+	}
+
+	return db.NewPool(ctx, cfg.InstanceConnection, cfg.DatabaseUser, cfg.DatabaseName, cfg.DatabasePassword, cfg.DatabasePort, opts)
+}
+
+// poolOptions returns the connection pool tuning of cfg
+func poolOptions(cfg *config.Config) db.PoolOptions {
+	return db.PoolOptions{
+		MaxConns:         int32(cfg.DBMaxConns),
+		MinConns:         int32(cfg.DBMinConns),
+		MaxConnLifetime:  cfg.DBMaxConnLifetime,
+		MaxConnIdleTime:  cfg.DBMaxConnIdleTime,
+		StatementTimeout: cfg.DBStatementTimeout,
+	}
+}
+
+// openReplica connects to the read replica, or returns a nil pool if none is configured
+func openReplica(ctx context.Context, cfg *config.Config, tracer pgx.QueryTracer) (*pgxpool.Pool, func() error, error) {
+	opts := poolOptions(cfg)
+	opts.Tracer = tracer
+
+	if cfg.ReplicaDatabaseURL != "" {
+		pool, err := db.NewURLPool(ctx, cfg.ReplicaDatabaseURL, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		cleanup := func() error {
+			pool.Close()
+			return nil
+		}
+		return pool, cleanup, nil
+	}
+
+	if cfg.ReplicaInstanceConnection != "" {
+		return db.NewPool(ctx, cfg.ReplicaInstanceConnection, cfg.DatabaseUser, cfg.DatabaseName, cfg.DatabasePassword, cfg.ReplicaDatabasePort, opts)
+	}
+
+	return nil, nil, nil
+}
+
+// defaultJWTSecret is the well-known development secret; tokens signed with it can be forged by anyone
+const defaultJWTSecret = "default-secret-change-in-production"
+
+// newJWTService creates the JWT service from configuration.
+// Asymmetric keys (JWT_KEYS_DIR) are preferred; an HS256 JWT_SECRET is still accepted,
+// but the default secret is refused unless JWT_ALLOW_INSECURE_DEFAULT_SECRET is set.
+func newJWTService(cfg *config.Config) (*auth.JWTService, error) {
+	const (
+		accessExpiry  = 15 * time.Minute
+		refreshExpiry = 7 * 24 * time.Hour
+	)
+
+	if cfg.JWTKeysDir != "" {
+		if cfg.JWTActiveKeyID == "" {
+			return nil, fmt.Errorf("JWT_ACTIVE_KEY_ID is required when JWT_KEYS_DIR is set")
+		}
+		keys, err := auth.LoadKeySet(cfg.JWTKeysDir, cfg.JWTActiveKeyID)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("Signing JWTs with %s key %q (%d keys published in JWKS)",
+			keys.Active().Method.Alg(), keys.Active().ID, len(keys.JWKS().Keys))
+		return auth.NewJWTServiceWithKeys(keys, accessExpiry, refreshExpiry), nil
+	}
+
+	secret := cfg.JWTSecret
+	if secret == "" || secret == defaultJWTSecret {
+		if !cfg.AllowInsecureJWTSecret {
+			return nil, fmt.Errorf("set JWT_KEYS_DIR and JWT_ACTIVE_KEY_ID (or JWT_SECRET); " +
+				"the default secret is only allowed with JWT_ALLOW_INSECURE_DEFAULT_SECRET=true for local development")
+		}
+		log.Println("WARNING: using the default JWT secret (JWT_ALLOW_INSECURE_DEFAULT_SECRET=true); never do this in production")
+		secret = defaultJWTSecret
+	}
+
+	log.Println("WARNING: signing JWTs with an HS256 shared secret; set JWT_KEYS_DIR to publish verification keys via JWKS")
+	return auth.NewJWTService(secret, accessExpiry, refreshExpiry), nil
+}
+
+// oauthStateKey returns the key that signs OAuth state values. Without OAUTH_STATE_SECRET
+// a random per-process key is used, so a login started on one instance fails on another.
+func oauthStateKey(cfg *config.Config) []byte {
+	if cfg.OAuthStateSecret != "" {
+		return []byte(cfg.OAuthStateSecret)
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		log.Fatalf("Failed to generate OAuth state key: %v", err)
+	}
+	log.Println("WARNING: OAUTH_STATE_SECRET not set, using a random per-instance key")
+	return key
+}
+
+// pageTokenKey returns the key that signs page tokens. Without PAGE_TOKEN_SECRET a random
+// per-process key is used, so a client paging through a list across instances gets
+// InvalidArgument when its next page lands on another instance.
+func pageTokenKey(cfg *config.Config) []byte {
+	if cfg.PageTokenSecret != "" {
+		return []byte(cfg.PageTokenSecret)
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		log.Fatalf("Failed to generate page token key: %v", err)
+	}
+	log.Println("WARNING: PAGE_TOKEN_SECRET not set, using a random per-instance key")
+	return key
+}
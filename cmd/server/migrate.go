@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/internal/config"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/migrate"
+)
+
+var errMigrateUsage = errors.New("usage: server migrate [up | down [N] | status]")
+
+// runMigrate implements the "migrate" subcommand:
+//
+//	server migrate [up]      apply all pending migrations
+//	server migrate down [N]  revert the latest N migrations (default 1)
+//	server migrate status    list migrations and when they were applied
+func runMigrate(ctx context.Context, cfg *config.Config, args []string) error {
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	steps := 1
+	switch command {
+	case "up", "status":
+		if len(args) > 1 {
+			return errMigrateUsage
+		}
+	case "down":
+		if len(args) > 2 {
+			return errMigrateUsage
+		}
+		if len(args) == 2 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid number of migrations to revert: %q", args[1])
+			}
+			steps = n
+		}
+	default:
+		return errMigrateUsage
+	}
+
+	pool, cleanup, err := openDatabase(ctx, cfg, nil)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer cleanup()
+
+	migrator, err := migrate.NewMigrator(pool)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			log.Printf("Applied migration %04d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			log.Printf("Database is up to date")
+		}
+	case "down":
+		reverted, err := migrator.Down(ctx, steps)
+		for _, m := range reverted {
+			log.Printf("Reverted migration %04d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(reverted) == 0 {
+			log.Printf("No applied migrations to revert")
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			applied := "pending"
+			if s.AppliedAt != nil {
+				applied = "applied " + s.AppliedAt.Local().Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-24s %s\n", s.Version, s.Name, applied)
+		}
+	}
+
+	return nil
+}
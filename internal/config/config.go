@@ -3,6 +3,8 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // Default configuration (override via environment variables)
@@ -21,11 +23,49 @@ type Config struct {
 	DatabasePort       int    // For local proxy port
 	InstanceConnection string // format: project:region:instance
 
+	// Plain PostgreSQL connection (e.g. docker Postgres or CI).
+	// When set, the Cloud SQL settings above are ignored.
+	DatabaseURL string
+
+	// Connection pool tuning; zero keeps the pgx default
+	DBMaxConns         int
+	DBMinConns         int
+	DBMaxConnLifetime  time.Duration
+	DBMaxConnIdleTime  time.Duration
+	DBStatementTimeout time.Duration
+
+	// Read replica for Get/List RPCs; without one all queries go to the primary.
+	// ReplicaDatabaseURL takes precedence over the Cloud SQL replica instance.
+	ReplicaDatabaseURL        string
+	ReplicaInstanceName       string
+	ReplicaInstanceConnection string        // format: project:region:instance
+	ReplicaDatabasePort       int           // local proxy port of the replica
+	ReadYourWritesWindow      time.Duration // reads stay on the primary this long after the caller wrote
+
 	// Server
-	Port string
+	Port              string
+	RPCDefaultTimeout time.Duration // deadline of RPCs without an entry in the method timeout table
+	PageTokenSecret   string        // HMAC key for List page tokens; shared by all instances
+
+	// Observability
+	OTLPEndpoint string // OTLP/gRPC collector for traces (e.g. http://localhost:4317); traces are not exported when empty
+	ServiceName  string // service.name of exported traces
 
 	// Frontend
 	FrontendURL string // OAuth callback redirect URL
+
+	// OAuth login flow
+	OAuthStateSecret   string   // HMAC key for OAuth state values; shared by all instances
+	OAuthRedirectPaths []string // path prefixes allowed as post-login redirects
+	// OAuthAutoLinkVerifiedEmail signs a new provider identity in as the existing user with
+	// the same provider-verified email instead of creating a second user
+	OAuthAutoLinkVerifiedEmail bool
+
+	// JWT signing
+	JWTKeysDir             string // directory of <kid>.pem keys for RS256/ES256 signing
+	JWTActiveKeyID         string // kid of the key used to sign new tokens
+	JWTSecret              string // HS256 shared secret, used when JWTKeysDir is not set
+	AllowInsecureJWTSecret bool   // development only: allow the built-in default secret
 }
 
 func Load() *Config {
@@ -39,12 +79,42 @@ func Load() *Config {
 		DatabasePort:     getEnvInt("DB_PORT", 5432),
 		Port:             getEnv("PORT", "8080"),
 		FrontendURL:      getEnv("FRONTEND_URL", ""),
+
+		DatabaseURL:        getEnv("DATABASE_URL", ""),
+		DBMaxConns:         getEnvInt("DB_MAX_CONNS", 0),
+		DBMinConns:         getEnvInt("DB_MIN_CONNS", 0),
+		DBMaxConnLifetime:  getEnvDuration("DB_MAX_CONN_LIFETIME", 0),
+		DBMaxConnIdleTime:  getEnvDuration("DB_MAX_CONN_IDLE_TIME", 0),
+		DBStatementTimeout: getEnvDuration("DB_STATEMENT_TIMEOUT", 0),
+
+		ReplicaDatabaseURL:   getEnv("DATABASE_REPLICA_URL", ""),
+		ReplicaInstanceName:  getEnv("CLOUDSQL_REPLICA_INSTANCE_NAME", ""),
+		ReplicaDatabasePort:  getEnvInt("DB_REPLICA_PORT", 5433),
+		ReadYourWritesWindow: getEnvDuration("DB_READ_YOUR_WRITES_WINDOW", 5*time.Second),
+
+		RPCDefaultTimeout: getEnvDuration("RPC_DEFAULT_TIMEOUT", 10*time.Second),
+		PageTokenSecret:   getEnv("PAGE_TOKEN_SECRET", ""),
+
+		OTLPEndpoint: getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", ""),
+		ServiceName:  getEnv("K_SERVICE", "postgres-prod"),
+
+		OAuthStateSecret:           getEnv("OAUTH_STATE_SECRET", ""),
+		OAuthRedirectPaths:         getEnvList("OAUTH_REDIRECT_PATHS", []string{"/"}),
+		OAuthAutoLinkVerifiedEmail: getEnvBool("OAUTH_AUTO_LINK_VERIFIED_EMAIL", false),
+
+		JWTKeysDir:             getEnv("JWT_KEYS_DIR", ""),
+		JWTActiveKeyID:         getEnv("JWT_ACTIVE_KEY_ID", ""),
+		JWTSecret:              getEnv("JWT_SECRET", ""),
+		AllowInsecureJWTSecret: getEnvBool("JWT_ALLOW_INSECURE_DEFAULT_SECRET", false),
 	}
 
 	// Build instance connection string
 	if cfg.ProjectID != "" && cfg.Region != "" && cfg.InstanceName != "" {
 		cfg.InstanceConnection = cfg.ProjectID + ":" + cfg.Region + ":" + cfg.InstanceName
 	}
+	if cfg.ProjectID != "" && cfg.Region != "" && cfg.ReplicaInstanceName != "" {
+		cfg.ReplicaInstanceConnection = cfg.ProjectID + ":" + cfg.Region + ":" + cfg.ReplicaInstanceName
+	}
 
 	return cfg
 }
@@ -64,3 +134,35 @@ func getEnvInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+func getEnvList(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return defaultValue
+}
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return defaultValue
+}
@@ -31,15 +31,22 @@ type GoogleOAuthClient struct {
 	clientID     string
 	clientSecret string
 	redirectURI  string
+	endpoints    OAuthEndpoints
 	httpClient   *http.Client
 }
 
 // NewGoogleOAuthClient creates a new Google OAuth client
 func NewGoogleOAuthClient(clientID, clientSecret, redirectURI string) *GoogleOAuthClient {
+	return NewGoogleOAuthClientWithEndpoints(clientID, clientSecret, redirectURI, GoogleEndpoints)
+}
+
+// NewGoogleOAuthClientWithEndpoints creates a client with custom endpoints (for testing)
+func NewGoogleOAuthClientWithEndpoints(clientID, clientSecret, redirectURI string, endpoints OAuthEndpoints) *GoogleOAuthClient {
 	return &GoogleOAuthClient{
 		clientID:     clientID,
 		clientSecret: clientSecret,
 		redirectURI:  redirectURI,
+		endpoints:    endpoints,
 		httpClient:   &http.Client{},
 	}
 }
@@ -53,16 +60,20 @@ type GoogleTokenResponse struct {
 	IDToken      string `json:"id_token"`
 }
 
-// ExchangeCode exchanges an authorization code for tokens
-func (c *GoogleOAuthClient) ExchangeCode(ctx context.Context, code string) (*GoogleTokenResponse, error) {
+// ExchangeCode exchanges an authorization code for tokens.
+// codeVerifier is the PKCE verifier the code was requested with; empty if PKCE was not used.
+func (c *GoogleOAuthClient) ExchangeCode(ctx context.Context, code, codeVerifier string) (*GoogleTokenResponse, error) {
 	data := url.Values{}
 	data.Set("code", code)
 	data.Set("client_id", c.clientID)
 	data.Set("client_secret", c.clientSecret)
 	data.Set("redirect_uri", c.redirectURI)
 	data.Set("grant_type", "authorization_code")
+	if codeVerifier != "" {
+		data.Set("code_verifier", codeVerifier)
+	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", "https://oauth2.googleapis.com/token", strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoints.TokenURL, strings.NewReader(data.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -89,7 +100,7 @@ func (c *GoogleOAuthClient) ExchangeCode(ctx context.Context, code string) (*Goo
 
 // GetUserInfo retrieves user info using the access token
 func (c *GoogleOAuthClient) GetUserInfo(ctx context.Context, accessToken string) (*GoogleUserInfo, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", "https://www.googleapis.com/oauth2/v2/userinfo", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", c.endpoints.UserInfoURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -122,7 +133,7 @@ func (c *GoogleOAuthClient) RefreshAccessToken(ctx context.Context, refreshToken
 	data.Set("client_secret", c.clientSecret)
 	data.Set("grant_type", "refresh_token")
 
-	req, err := http.NewRequestWithContext(ctx, "POST", "https://oauth2.googleapis.com/token", strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoints.TokenURL, strings.NewReader(data.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -147,8 +158,9 @@ func (c *GoogleOAuthClient) RefreshAccessToken(ctx context.Context, refreshToken
 	return &tokenResp, nil
 }
 
-// GetAuthURL returns the Google OAuth authorization URL
-func (c *GoogleOAuthClient) GetAuthURL(state string) string {
+// GetAuthURL returns the Google OAuth authorization URL.
+// codeChallenge is the PKCE S256 challenge; it is omitted when empty.
+func (c *GoogleOAuthClient) GetAuthURL(state, codeChallenge string) string {
 	params := url.Values{}
 	params.Set("client_id", c.clientID)
 	params.Set("redirect_uri", c.redirectURI)
@@ -157,6 +169,41 @@ func (c *GoogleOAuthClient) GetAuthURL(state string) string {
 	params.Set("access_type", "offline")
 	params.Set("prompt", "consent")
 	params.Set("state", state)
+	if codeChallenge != "" {
+		params.Set("code_challenge", codeChallenge)
+		params.Set("code_challenge_method", PKCEMethodS256)
+	}
+
+	return c.endpoints.AuthURL + "?" + params.Encode()
+}
+
+// FetchIdentity exchanges an authorization code and returns the Google identity it belongs to
+func (c *GoogleOAuthClient) FetchIdentity(ctx context.Context, code, codeVerifier string) (*ProviderIdentity, error) {
+	tokenResp, err := c.ExchangeCode(ctx, code, codeVerifier)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCodeExchangeFailed, err)
+	}
+
+	userInfo, err := c.GetUserInfo(ctx, tokenResp.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUserInfoFailed, err)
+	}
+
+	identity := &ProviderIdentity{
+		Provider:       "google",
+		ProviderUserID: userInfo.ID,
+		EmailVerified:  userInfo.VerifiedEmail,
+		DisplayName:    userInfo.Name,
+		AccessToken:    tokenResp.AccessToken,
+		RefreshToken:   tokenResp.RefreshToken,
+		ExpiresIn:      tokenResp.ExpiresIn,
+	}
+	if userInfo.Email != "" {
+		identity.Email = &userInfo.Email
+	}
+	if userInfo.Picture != "" {
+		identity.AvatarURL = &userInfo.Picture
+	}
 
-	return "https://accounts.google.com/o/oauth2/v2/auth?" + params.Encode()
+	return identity, nil
 }
@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/service"
+)
+
+var (
+	ErrIdentityLinkedToOtherUser = errors.New("this provider account is already linked to another user")
+	ErrProviderAlreadyLinked     = errors.New("a different account of this provider is already linked")
+	ErrIdentityNotLinked         = errors.New("no account of this provider is linked")
+	ErrLastIdentity              = errors.New("cannot unlink the last sign-in method")
+
+	// FetchIdentity wraps provider errors with the step that failed
+	ErrCodeExchangeFailed = errors.New("failed to exchange code")
+	ErrUserInfoFailed     = errors.New("failed to get user info")
+)
+
+// ProviderIdentity is an authenticated identity returned by an OAuth provider
+type ProviderIdentity struct {
+	Provider       string // 'google', 'line'
+	ProviderUserID string
+	Email          *string
+	EmailVerified  bool // whether the provider vouches for Email
+	DisplayName    string
+	AvatarURL      *string
+	AccessToken    string
+	RefreshToken   string
+	ExpiresIn      int
+}
+
+// IdentityService maps provider identities to app users and manages account linking.
+// A user may have one linked account per provider.
+type IdentityService struct {
+	appUserRepo *repository.AppUserRepository
+	oauthRepo   *repository.OAuthAccountRepository
+	unitOfWork  *service.UnitOfWork
+	// autoLinkVerifiedEmail attaches a new provider identity to the existing user with
+	// the same email instead of creating a second user, if the provider verified the email
+	autoLinkVerifiedEmail bool
+}
+
+// NewIdentityService creates a new IdentityService
+func NewIdentityService(appUserRepo *repository.AppUserRepository, oauthRepo *repository.OAuthAccountRepository, unitOfWork *service.UnitOfWork, autoLinkVerifiedEmail bool) *IdentityService {
+	return &IdentityService{
+		appUserRepo:           appUserRepo,
+		oauthRepo:             oauthRepo,
+		unitOfWork:            unitOfWork,
+		autoLinkVerifiedEmail: autoLinkVerifiedEmail,
+	}
+}
+
+// Login returns the user an identity signs in as, creating the user on first login
+func (s *IdentityService) Login(ctx context.Context, identity *ProviderIdentity) (*repository.AppUser, error) {
+	// Try to find existing OAuth account
+	account, err := s.oauthRepo.GetByProviderAndProviderUserID(ctx, identity.Provider, identity.ProviderUserID)
+	if err == nil {
+		user, err := s.appUserRepo.GetByID(ctx, account.AppUserID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.updateTokens(ctx, account.ID, identity); err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+	if !errors.Is(err, repository.ErrOAuthAccountNotFound) {
+		return nil, err
+	}
+
+	// Same person signing in with another provider: attach instead of duplicating the user
+	if s.autoLinkVerifiedEmail && identity.EmailVerified && identity.Email != nil && *identity.Email != "" {
+		user, err := s.appUserRepo.GetByEmail(ctx, *identity.Email)
+		if err == nil {
+			if _, err := s.Link(ctx, user.ID, identity); err != nil {
+				return nil, err
+			}
+			return user, nil
+		}
+		if !errors.Is(err, repository.ErrAppUserNotFound) {
+			return nil, err
+		}
+	}
+
+	// No existing OAuth account, create new user and OAuth account in one transaction,
+	// so a failed account insert does not leave a user nobody can sign in as
+	var user *repository.AppUser
+	err = s.unitOfWork.Do(ctx, func(repos *repository.Repositories) error {
+		user, err = repos.AppUser().Create(ctx, identity.Email, identity.DisplayName, identity.AvatarURL, false)
+		if err != nil {
+			return err
+		}
+		_, err = s.createAccount(ctx, repos.OAuthAccount(), user.ID, identity)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// Link attaches a provider identity to an existing user.
+// Linking an identity the user already has only refreshes its tokens.
+func (s *IdentityService) Link(ctx context.Context, userID string, identity *ProviderIdentity) (*repository.OAuthAccount, error) {
+	account, err := s.oauthRepo.GetByProviderAndProviderUserID(ctx, identity.Provider, identity.ProviderUserID)
+	if err == nil {
+		if account.AppUserID != userID {
+			return nil, ErrIdentityLinkedToOtherUser
+		}
+		if err := s.updateTokens(ctx, account.ID, identity); err != nil {
+			return nil, err
+		}
+		return account, nil
+	}
+	if !errors.Is(err, repository.ErrOAuthAccountNotFound) {
+		return nil, err
+	}
+
+	accounts, err := s.oauthRepo.ListByAppUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range accounts {
+		if a.Provider == identity.Provider {
+			return nil, ErrProviderAlreadyLinked
+		}
+	}
+
+	return s.createAccount(ctx, s.oauthRepo, userID, identity)
+}
+
+// Unlink detaches a provider from a user, refusing to remove the user's last identity
+func (s *IdentityService) Unlink(ctx context.Context, userID, provider string) error {
+	err := s.oauthRepo.DeleteByProviderUnlessLast(ctx, userID, provider)
+	switch {
+	case errors.Is(err, repository.ErrLastOAuthAccount):
+		return ErrLastIdentity
+	case errors.Is(err, repository.ErrOAuthAccountNotFound):
+		return ErrIdentityNotLinked
+	}
+	return err
+}
+
+// createAccount stores a provider identity for a user
+func (s *IdentityService) createAccount(ctx context.Context, oauthRepo *repository.OAuthAccountRepository, userID string, identity *ProviderIdentity) (*repository.OAuthAccount, error) {
+	tokenExpiresAt := time.Now().Add(time.Duration(identity.ExpiresIn) * time.Second)
+	account, err := oauthRepo.Create(ctx, userID, identity.Provider, identity.ProviderUserID, identity.Email,
+		&identity.AccessToken, &identity.RefreshToken, &tokenExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create oauth account: %w", err)
+	}
+	return account, nil
+}
+
+// updateTokens stores the provider tokens of a repeated sign-in
+func (s *IdentityService) updateTokens(ctx context.Context, accountID string, identity *ProviderIdentity) error {
+	tokenExpiresAt := time.Now().Add(time.Duration(identity.ExpiresIn) * time.Second)
+	_, err := s.oauthRepo.UpdateTokens(ctx, accountID, &identity.AccessToken, &identity.RefreshToken, &tokenExpiresAt)
+	return err
+}
@@ -18,12 +18,28 @@ type Claims struct {
 	Email        string `json:"email,omitempty"`
 	DisplayName  string `json:"display_name"`
 	IsSuperadmin bool   `json:"is_superadmin"`
+	// SessionID is the refresh token family the access token was issued from
+	SessionID string `json:"sid,omitempty"`
+	// OrganizationID and Role bind the token to one organization; empty when the
+	// user has no organization selected
+	OrganizationID string `json:"org_id,omitempty"`
+	Role           string `json:"role,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// JWTService handles JWT token operations
+// TokenScope is the session and organization an access token is bound to
+type TokenScope struct {
+	SessionID      string
+	OrganizationID string
+	Role           string
+}
+
+// JWTService handles JWT token operations.
+// Tokens are signed with the active key of a KeySet (RS256/ES256), or with an
+// HS256 shared secret when the service was created by NewJWTService.
 type JWTService struct {
 	secretKey     []byte
+	keys          *KeySet
 	accessExpiry  time.Duration
 	refreshExpiry time.Duration
 	issuer        string
@@ -39,14 +55,75 @@ func NewJWTService(secretKey string, accessExpiry, refreshExpiry time.Duration)
 	}
 }
 
-// GenerateAccessToken creates a new access token
+// NewJWTServiceWithKeys creates a JWT service signing with asymmetric keys.
+// Verifiers can fetch the public keys from JWKS() instead of sharing a secret.
+func NewJWTServiceWithKeys(keys *KeySet, accessExpiry, refreshExpiry time.Duration) *JWTService {
+	return &JWTService{
+		keys:          keys,
+		accessExpiry:  accessExpiry,
+		refreshExpiry: refreshExpiry,
+		issuer:        "postgres-prod",
+	}
+}
+
+// sign signs claims with the active key, setting the kid header
+func (s *JWTService) sign(claims jwt.Claims) (string, error) {
+	if s.keys == nil {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secretKey)
+	}
+
+	active := s.keys.Active()
+	token := jwt.NewWithClaims(active.Method, claims)
+	token.Header["kid"] = active.ID
+	return token.SignedString(active.PrivateKey)
+}
+
+// verificationKey resolves the key for a token being parsed.
+// The algorithm must match the key's own so a token cannot choose how it is verified.
+func (s *JWTService) verificationKey(token *jwt.Token) (interface{}, error) {
+	if s.keys == nil {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrInvalidToken
+		}
+		return s.secretKey, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.keys.Lookup(kid)
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, ErrInvalidToken
+	}
+	return key.PublicKey, nil
+}
+
+// JWKS returns the public verification keys. It is empty for HS256 services,
+// since a shared secret must never be published.
+func (s *JWTService) JWKS() JWKS {
+	if s.keys == nil {
+		return JWKS{Keys: []JWK{}}
+	}
+	return s.keys.JWKS()
+}
+
+// GenerateAccessToken creates a new access token that is not bound to an organization
 func (s *JWTService) GenerateAccessToken(userID, email, displayName string, isSuperadmin bool) (string, error) {
+	return s.GenerateScopedAccessToken(userID, email, displayName, isSuperadmin, TokenScope{})
+}
+
+// GenerateScopedAccessToken creates a new access token carrying the session and organization claims
+func (s *JWTService) GenerateScopedAccessToken(userID, email, displayName string, isSuperadmin bool, scope TokenScope) (string, error) {
 	now := time.Now()
 	claims := Claims{
-		UserID:       userID,
-		Email:        email,
-		DisplayName:  displayName,
-		IsSuperadmin: isSuperadmin,
+		UserID:         userID,
+		Email:          email,
+		DisplayName:    displayName,
+		IsSuperadmin:   isSuperadmin,
+		SessionID:      scope.SessionID,
+		OrganizationID: scope.OrganizationID,
+		Role:           scope.Role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(s.accessExpiry)),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -56,33 +133,33 @@ func (s *JWTService) GenerateAccessToken(userID, email, displayName string, isSu
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.secretKey)
+	return s.sign(claims)
 }
 
-// GenerateRefreshToken creates a new refresh token
-func (s *JWTService) GenerateRefreshToken(userID string) (string, error) {
+// GenerateRefreshToken creates a new refresh token carrying tokenID as its jti.
+// The token ID is what the session store persists (hashed) to support rotation and revocation.
+func (s *JWTService) GenerateRefreshToken(userID, tokenID string) (string, time.Time, error) {
 	now := time.Now()
+	expiresAt := now.Add(s.refreshExpiry)
 	claims := jwt.RegisteredClaims{
-		ExpiresAt: jwt.NewNumericDate(now.Add(s.refreshExpiry)),
+		ID:        tokenID,
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
 		IssuedAt:  jwt.NewNumericDate(now),
 		NotBefore: jwt.NewNumericDate(now),
 		Issuer:    s.issuer,
 		Subject:   userID,
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.secretKey)
+	signed, err := s.sign(claims)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
 }
 
 // ValidateAccessToken validates an access token and returns the claims
 func (s *JWTService) ValidateAccessToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, ErrInvalidToken
-		}
-		return s.secretKey, nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, s.verificationKey)
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -99,28 +176,46 @@ func (s *JWTService) ValidateAccessToken(tokenString string) (*Claims, error) {
 	return claims, nil
 }
 
-// ValidateRefreshToken validates a refresh token and returns the user ID
-func (s *JWTService) ValidateRefreshToken(tokenString string) (string, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &jwt.RegisteredClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, ErrInvalidToken
-		}
-		return s.secretKey, nil
-	})
+// RefreshClaims identifies a validated refresh token
+type RefreshClaims struct {
+	UserID    string
+	TokenID   string
+	ExpiresAt time.Time
+}
+
+// ParseRefreshToken validates a refresh token's signature and expiry and returns its claims
+func (s *JWTService) ParseRefreshToken(tokenString string) (*RefreshClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &jwt.RegisteredClaims{}, s.verificationKey)
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
-			return "", ErrExpiredToken
+			return nil, ErrExpiredToken
 		}
-		return "", ErrInvalidToken
+		return nil, ErrInvalidToken
 	}
 
 	claims, ok := token.Claims.(*jwt.RegisteredClaims)
-	if !ok || !token.Valid {
-		return "", ErrInvalidToken
+	if !ok || !token.Valid || claims.ID == "" {
+		return nil, ErrInvalidToken
 	}
 
-	return claims.Subject, nil
+	refreshClaims := &RefreshClaims{
+		UserID:  claims.Subject,
+		TokenID: claims.ID,
+	}
+	if claims.ExpiresAt != nil {
+		refreshClaims.ExpiresAt = claims.ExpiresAt.Time
+	}
+	return refreshClaims, nil
+}
+
+// ValidateRefreshToken validates a refresh token and returns the user ID
+func (s *JWTService) ValidateRefreshToken(tokenString string) (string, error) {
+	claims, err := s.ParseRefreshToken(tokenString)
+	if err != nil {
+		return "", err
+	}
+	return claims.UserID, nil
 }
 
 // TokenPair represents an access and refresh token pair
@@ -128,23 +223,12 @@ type TokenPair struct {
 	AccessToken  string
 	RefreshToken string
 	ExpiresIn    int64 // seconds until access token expires
+	// OrganizationID and Role are the organization claims of the access token
+	OrganizationID string
+	Role           string
 }
 
-// GenerateTokenPair creates both access and refresh tokens
-func (s *JWTService) GenerateTokenPair(userID, email, displayName string, isSuperadmin bool) (*TokenPair, error) {
-	accessToken, err := s.GenerateAccessToken(userID, email, displayName, isSuperadmin)
-	if err != nil {
-		return nil, err
-	}
-
-	refreshToken, err := s.GenerateRefreshToken(userID)
-	if err != nil {
-		return nil, err
-	}
-
-	return &TokenPair{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresIn:    int64(s.accessExpiry.Seconds()),
-	}, nil
+// AccessExpiry returns the lifetime of access tokens
+func (s *JWTService) AccessExpiry() time.Duration {
+	return s.accessExpiry
 }
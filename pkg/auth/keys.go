@@ -0,0 +1,239 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSAKeyBits is the smallest RSA modulus accepted for signing keys
+const minRSAKeyBits = 2048
+
+var (
+	ErrUnknownKeyID        = errors.New("unknown signing key id")
+	ErrUnsupportedKey      = errors.New("unsupported signing key (use RSA >= 2048 bits or ECDSA P-256)")
+	ErrActiveKeyNotFound   = errors.New("active signing key not found")
+	ErrActiveKeyNotPrivate = errors.New("active signing key has no private key")
+)
+
+// SigningKey is an asymmetric key identified by its kid.
+// Retired keys only need the public half: they verify tokens issued before rotation.
+type SigningKey struct {
+	ID         string
+	Method     jwt.SigningMethod // RS256 or ES256
+	PrivateKey crypto.Signer     // nil for verification-only keys
+	PublicKey  crypto.PublicKey
+}
+
+// NewSigningKey creates a signing key from a private key, choosing RS256 or ES256 by key type
+func NewSigningKey(id string, privateKey crypto.Signer) (*SigningKey, error) {
+	key, err := newVerificationKey(id, privateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+	key.PrivateKey = privateKey
+	return key, nil
+}
+
+// newVerificationKey creates a verification-only key from a public key
+func newVerificationKey(id string, publicKey crypto.PublicKey) (*SigningKey, error) {
+	if id == "" {
+		return nil, errors.New("signing key id is required")
+	}
+
+	switch pub := publicKey.(type) {
+	case *rsa.PublicKey:
+		if pub.N.BitLen() < minRSAKeyBits {
+			return nil, ErrUnsupportedKey
+		}
+		return &SigningKey{ID: id, Method: jwt.SigningMethodRS256, PublicKey: pub}, nil
+	case *ecdsa.PublicKey:
+		if pub.Curve != elliptic.P256() {
+			return nil, ErrUnsupportedKey
+		}
+		return &SigningKey{ID: id, Method: jwt.SigningMethodES256, PublicKey: pub}, nil
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+// ParseSigningKeyPEM parses a PEM encoded private key (PKCS#1, PKCS#8 or SEC 1)
+// or a public key (PKIX). Public keys produce verification-only keys.
+func ParseSigningKeyPEM(id string, data []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("key %s: no PEM block found", id)
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		priv, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", id, err)
+		}
+		return NewSigningKey(id, priv)
+	case "EC PRIVATE KEY":
+		priv, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", id, err)
+		}
+		return NewSigningKey(id, priv)
+	case "PRIVATE KEY":
+		priv, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", id, err)
+		}
+		signer, ok := priv.(crypto.Signer)
+		if !ok {
+			return nil, ErrUnsupportedKey
+		}
+		return NewSigningKey(id, signer)
+	case "PUBLIC KEY":
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", id, err)
+		}
+		return newVerificationKey(id, pub)
+	default:
+		return nil, fmt.Errorf("key %s: unsupported PEM block %q", id, block.Type)
+	}
+}
+
+// KeySet holds the active signing key and the retired keys still accepted for verification
+type KeySet struct {
+	active *SigningKey
+	keys   map[string]*SigningKey
+}
+
+// NewKeySet creates a key set signing with activeID.
+// All other keys are retired: they verify existing tokens but never sign new ones.
+func NewKeySet(activeID string, keys ...*SigningKey) (*KeySet, error) {
+	ks := &KeySet{keys: make(map[string]*SigningKey, len(keys))}
+	for _, key := range keys {
+		if _, exists := ks.keys[key.ID]; exists {
+			return nil, fmt.Errorf("duplicate signing key id %q", key.ID)
+		}
+		ks.keys[key.ID] = key
+	}
+
+	active, ok := ks.keys[activeID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrActiveKeyNotFound, activeID)
+	}
+	if active.PrivateKey == nil {
+		return nil, fmt.Errorf("%w: %q", ErrActiveKeyNotPrivate, activeID)
+	}
+	ks.active = active
+
+	return ks, nil
+}
+
+// LoadKeySet loads every <kid>.pem file in dir. Rotating a key means adding the new
+// key file, switching activeID to it, and deleting the old file once its tokens expired.
+func LoadKeySet(dir, activeID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no *.pem signing keys found in %s", dir)
+	}
+
+	keys := make([]*SigningKey, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := ParseSigningKeyPEM(strings.TrimSuffix(filepath.Base(path), ".pem"), data)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return NewKeySet(activeID, keys...)
+}
+
+// Active returns the key used to sign new tokens
+func (ks *KeySet) Active() *SigningKey {
+	return ks.active
+}
+
+// Lookup returns the key with the given kid
+func (ks *KeySet) Lookup(id string) (*SigningKey, bool) {
+	key, ok := ks.keys[id]
+	return key, ok
+}
+
+// JWK is a public key in JSON Web Key format (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKS is the document served at /.well-known/jwks.json
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public halves of all keys, active key first
+func (ks *KeySet) JWKS() JWKS {
+	ids := make([]string, 0, len(ks.keys))
+	for id := range ks.keys {
+		if id != ks.active.ID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	ids = append([]string{ks.active.ID}, ids...)
+
+	set := JWKS{Keys: make([]JWK, 0, len(ids))}
+	for _, id := range ids {
+		set.Keys = append(set.Keys, ks.keys[id].jwk())
+	}
+	return set
+}
+
+// jwk converts the public key to JWK format
+func (k *SigningKey) jwk() JWK {
+	jwk := JWK{Kid: k.ID, Use: "sig", Alg: k.Method.Alg()}
+
+	switch pub := k.PublicKey.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		// Coordinates are fixed-width (32 bytes for P-256) per RFC 7518
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		jwk.Kty = "EC"
+		jwk.Crv = pub.Curve.Params().Name
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, size)))
+		jwk.Y = base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, size)))
+	}
+
+	return jwk
+}
@@ -0,0 +1,189 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func mustRSAKey(t *testing.T, id string) *SigningKey {
+	t.Helper()
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	key, err := NewSigningKey(id, priv)
+	if err != nil {
+		t.Fatalf("NewSigningKey: %v", err)
+	}
+	return key
+}
+
+func mustECKey(t *testing.T, id string) *SigningKey {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	key, err := NewSigningKey(id, priv)
+	if err != nil {
+		t.Fatalf("NewSigningKey: %v", err)
+	}
+	return key
+}
+
+func TestJWTService_KeyRotation(t *testing.T) {
+	oldKey := mustRSAKey(t, "2024-01")
+	newKey := mustECKey(t, "2024-07")
+
+	before, err := NewKeySet(oldKey.ID, oldKey)
+	if err != nil {
+		t.Fatalf("NewKeySet: %v", err)
+	}
+	oldService := NewJWTServiceWithKeys(before, time.Minute, time.Hour)
+
+	token, err := oldService.GenerateAccessToken("user-1", "a@example.com", "A", false)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken: %v", err)
+	}
+
+	// Rotate: the new key signs, the old one is retired but still verifies
+	after, err := NewKeySet(newKey.ID, oldKey, newKey)
+	if err != nil {
+		t.Fatalf("NewKeySet: %v", err)
+	}
+	newService := NewJWTServiceWithKeys(after, time.Minute, time.Hour)
+
+	claims, err := newService.ValidateAccessToken(token)
+	if err != nil {
+		t.Fatalf("token signed by retired key rejected: %v", err)
+	}
+	if claims.UserID != "user-1" {
+		t.Errorf("UserID = %q, want user-1", claims.UserID)
+	}
+
+	rotated, err := newService.GenerateAccessToken("user-1", "a@example.com", "A", false)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken: %v", err)
+	}
+	if _, err := oldService.ValidateAccessToken(rotated); err == nil {
+		t.Error("token signed by a key unknown to the verifier was accepted")
+	}
+
+	refresh, _, err := newService.GenerateRefreshToken("user-1", "jti-1")
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken: %v", err)
+	}
+	if rc, err := newService.ParseRefreshToken(refresh); err != nil || rc.TokenID != "jti-1" {
+		t.Errorf("ParseRefreshToken = %+v, %v", rc, err)
+	}
+
+	// Once the old key is removed its tokens stop validating
+	pruned, err := NewKeySet(newKey.ID, newKey)
+	if err != nil {
+		t.Fatalf("NewKeySet: %v", err)
+	}
+	if _, err := NewJWTServiceWithKeys(pruned, time.Minute, time.Hour).ValidateAccessToken(token); err == nil {
+		t.Error("token signed by removed key was accepted")
+	}
+}
+
+func TestJWTService_RejectsSymmetricTokens(t *testing.T) {
+	key := mustECKey(t, "k1")
+	keys, err := NewKeySet(key.ID, key)
+	if err != nil {
+		t.Fatalf("NewKeySet: %v", err)
+	}
+
+	hmacToken, err := NewJWTService("secret", time.Minute, time.Hour).GenerateAccessToken("user-1", "", "A", true)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken: %v", err)
+	}
+	if _, err := NewJWTServiceWithKeys(keys, time.Minute, time.Hour).ValidateAccessToken(hmacToken); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("HS256 token: err = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestKeySet_JWKS(t *testing.T) {
+	rsaKey := mustRSAKey(t, "rsa")
+	ecKey := mustECKey(t, "ec")
+
+	keys, err := NewKeySet(ecKey.ID, rsaKey, ecKey)
+	if err != nil {
+		t.Fatalf("NewKeySet: %v", err)
+	}
+
+	set := keys.JWKS()
+	if len(set.Keys) != 2 {
+		t.Fatalf("len(Keys) = %d, want 2", len(set.Keys))
+	}
+	if k := set.Keys[0]; k.Kid != "ec" || k.Kty != "EC" || k.Alg != "ES256" || k.Crv != "P-256" || len(k.X) != 43 || len(k.Y) != 43 {
+		t.Errorf("active key = %+v", k)
+	}
+	if k := set.Keys[1]; k.Kid != "rsa" || k.Kty != "RSA" || k.Alg != "RS256" || k.E != "AQAB" || k.N == "" {
+		t.Errorf("retired key = %+v", k)
+	}
+
+	if got := NewJWTService("secret", time.Minute, time.Hour).JWKS(); len(got.Keys) != 0 {
+		t.Errorf("HS256 service published %d keys", len(got.Keys))
+	}
+}
+
+func TestLoadKeySet(t *testing.T) {
+	dir := t.TempDir()
+
+	active, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(active)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey: %v", err)
+	}
+	writePEM(t, filepath.Join(dir, "new.pem"), "PRIVATE KEY", der)
+
+	retired, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	der, err = x509.MarshalPKIXPublicKey(&retired.PublicKey)
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey: %v", err)
+	}
+	writePEM(t, filepath.Join(dir, "old.pem"), "PUBLIC KEY", der)
+
+	keys, err := LoadKeySet(dir, "new")
+	if err != nil {
+		t.Fatalf("LoadKeySet: %v", err)
+	}
+	if keys.Active().ID != "new" {
+		t.Errorf("Active = %q, want new", keys.Active().ID)
+	}
+	if old, ok := keys.Lookup("old"); !ok || old.PrivateKey != nil {
+		t.Errorf("Lookup(old) = %+v, %v; want verification-only key", old, ok)
+	}
+
+	// A public key can never be the active key
+	if _, err := LoadKeySet(dir, "old"); !errors.Is(err, ErrActiveKeyNotPrivate) {
+		t.Errorf("LoadKeySet(active=old): err = %v, want ErrActiveKeyNotPrivate", err)
+	}
+	if _, err := LoadKeySet(dir, "missing"); !errors.Is(err, ErrActiveKeyNotFound) {
+		t.Errorf("LoadKeySet(active=missing): err = %v, want ErrActiveKeyNotFound", err)
+	}
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+}
@@ -40,15 +40,22 @@ type LineOAuthClient struct {
 	channelID     string
 	channelSecret string
 	redirectURI   string
+	endpoints     OAuthEndpoints
 	httpClient    *http.Client
 }
 
 // NewLineOAuthClient creates a new LINE OAuth client
 func NewLineOAuthClient(channelID, channelSecret, redirectURI string) *LineOAuthClient {
+	return NewLineOAuthClientWithEndpoints(channelID, channelSecret, redirectURI, LineEndpoints)
+}
+
+// NewLineOAuthClientWithEndpoints creates a client with custom endpoints (for testing)
+func NewLineOAuthClientWithEndpoints(channelID, channelSecret, redirectURI string, endpoints OAuthEndpoints) *LineOAuthClient {
 	return &LineOAuthClient{
 		channelID:     channelID,
 		channelSecret: channelSecret,
 		redirectURI:   redirectURI,
+		endpoints:     endpoints,
 		httpClient:    &http.Client{},
 	}
 }
@@ -63,16 +70,20 @@ type LineTokenResponse struct {
 	Scope        string `json:"scope"`
 }
 
-// ExchangeCode exchanges an authorization code for tokens
-func (c *LineOAuthClient) ExchangeCode(ctx context.Context, code string) (*LineTokenResponse, error) {
+// ExchangeCode exchanges an authorization code for tokens.
+// codeVerifier is the PKCE verifier the code was requested with; empty if PKCE was not used.
+func (c *LineOAuthClient) ExchangeCode(ctx context.Context, code, codeVerifier string) (*LineTokenResponse, error) {
 	data := url.Values{}
 	data.Set("code", code)
 	data.Set("client_id", c.channelID)
 	data.Set("client_secret", c.channelSecret)
 	data.Set("redirect_uri", c.redirectURI)
 	data.Set("grant_type", "authorization_code")
+	if codeVerifier != "" {
+		data.Set("code_verifier", codeVerifier)
+	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.line.me/oauth2/v2.1/token", strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoints.TokenURL, strings.NewReader(data.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -99,7 +110,7 @@ func (c *LineOAuthClient) ExchangeCode(ctx context.Context, code string) (*LineT
 
 // GetUserInfo retrieves user info using the access token
 func (c *LineOAuthClient) GetUserInfo(ctx context.Context, accessToken string) (*LineUserInfo, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", "https://api.line.me/v2/profile", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", c.endpoints.UserInfoURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -130,7 +141,7 @@ func (c *LineOAuthClient) VerifyIDToken(ctx context.Context, idToken string) (*L
 	data.Set("id_token", idToken)
 	data.Set("client_id", c.channelID)
 
-	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.line.me/oauth2/v2.1/verify", strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoints.VerifyURL, strings.NewReader(data.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -163,7 +174,7 @@ func (c *LineOAuthClient) RefreshAccessToken(ctx context.Context, refreshToken s
 	data.Set("client_secret", c.channelSecret)
 	data.Set("grant_type", "refresh_token")
 
-	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.line.me/oauth2/v2.1/token", strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoints.TokenURL, strings.NewReader(data.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -188,14 +199,55 @@ func (c *LineOAuthClient) RefreshAccessToken(ctx context.Context, refreshToken s
 	return &tokenResp, nil
 }
 
-// GetAuthURL returns the LINE OAuth authorization URL
-func (c *LineOAuthClient) GetAuthURL(state string) string {
+// GetAuthURL returns the LINE OAuth authorization URL.
+// codeChallenge is the PKCE S256 challenge; it is omitted when empty.
+func (c *LineOAuthClient) GetAuthURL(state, codeChallenge string) string {
 	params := url.Values{}
 	params.Set("response_type", "code")
 	params.Set("client_id", c.channelID)
 	params.Set("redirect_uri", c.redirectURI)
 	params.Set("scope", "profile openid email")
 	params.Set("state", state)
+	if codeChallenge != "" {
+		params.Set("code_challenge", codeChallenge)
+		params.Set("code_challenge_method", PKCEMethodS256)
+	}
+
+	return c.endpoints.AuthURL + "?" + params.Encode()
+}
+
+// FetchIdentity exchanges an authorization code and returns the LINE identity it belongs to.
+// The email comes from the ID token; LINE only releases addresses its users have verified.
+func (c *LineOAuthClient) FetchIdentity(ctx context.Context, code, codeVerifier string) (*ProviderIdentity, error) {
+	tokenResp, err := c.ExchangeCode(ctx, code, codeVerifier)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCodeExchangeFailed, err)
+	}
+
+	userInfo, err := c.GetUserInfo(ctx, tokenResp.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUserInfoFailed, err)
+	}
+
+	identity := &ProviderIdentity{
+		Provider:       "line",
+		ProviderUserID: userInfo.UserID,
+		DisplayName:    userInfo.DisplayName,
+		AccessToken:    tokenResp.AccessToken,
+		RefreshToken:   tokenResp.RefreshToken,
+		ExpiresIn:      tokenResp.ExpiresIn,
+	}
+
+	// Try to get email from ID token if available
+	if tokenResp.IDToken != "" {
+		if payload, err := c.VerifyIDToken(ctx, tokenResp.IDToken); err == nil && payload.Email != "" && payload.Sub == userInfo.UserID {
+			identity.Email = &payload.Email
+			identity.EmailVerified = true
+		}
+	}
+	if userInfo.PictureURL != "" {
+		identity.AvatarURL = &userInfo.PictureURL
+	}
 
-	return "https://access.line.me/oauth2/v2.1/authorize?" + params.Encode()
+	return identity, nil
 }
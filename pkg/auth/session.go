@@ -0,0 +1,295 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/service"
+)
+
+var (
+	ErrSessionRevoked        = errors.New("session has been revoked")
+	ErrRefreshTokenReused    = errors.New("refresh token reuse detected")
+	ErrNotOrganizationMember = errors.New("not a member of this organization")
+)
+
+// ClientInfo describes the device a session was started or refreshed from
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+// SessionService issues token pairs backed by persisted refresh tokens.
+// Every refresh rotates the refresh token; presenting an already rotated
+// token revokes the whole family, since it means the token was stolen.
+// Access tokens are bound to the organization selected in the session.
+type SessionService struct {
+	jwtService  *JWTService
+	tokenRepo   *repository.RefreshTokenRepository
+	appUserRepo *repository.AppUserRepository
+	userOrgRepo *repository.UserOrganizationRepository
+	unitOfWork  *service.UnitOfWork
+}
+
+// NewSessionService creates a new SessionService
+func NewSessionService(jwtService *JWTService, tokenRepo *repository.RefreshTokenRepository, appUserRepo *repository.AppUserRepository, userOrgRepo *repository.UserOrganizationRepository, unitOfWork *service.UnitOfWork) *SessionService {
+	return &SessionService{
+		jwtService:  jwtService,
+		tokenRepo:   tokenRepo,
+		appUserRepo: appUserRepo,
+		userOrgRepo: userOrgRepo,
+		unitOfWork:  unitOfWork,
+	}
+}
+
+// HashTokenID returns the value stored in refresh_tokens.token_hash for a jti
+func HashTokenID(tokenID string) string {
+	sum := sha256.Sum256([]byte(tokenID))
+	return hex.EncodeToString(sum[:])
+}
+
+// StartSession issues a token pair for a freshly authenticated user, starting a new token family
+// in the user's default organization
+func (s *SessionService) StartSession(ctx context.Context, user *repository.AppUser, client ClientInfo) (*TokenPair, error) {
+	return s.issue(ctx, s.tokenRepo, user, uuid.New().String(), uuid.New().String(), "", client)
+}
+
+// Refresh rotates a refresh token and issues a new token pair.
+// Returns ErrRefreshTokenReused (after revoking the family) if the token was already rotated.
+func (s *SessionService) Refresh(ctx context.Context, refreshToken string, client ClientInfo) (*TokenPair, *repository.AppUser, error) {
+	claims, err := s.jwtService.ParseRefreshToken(refreshToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stored, err := s.tokenRepo.GetByTokenHash(ctx, HashTokenID(claims.TokenID))
+	if err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+			return nil, nil, ErrInvalidToken
+		}
+		return nil, nil, err
+	}
+
+	if stored.RevokedAt != nil {
+		if stored.ReplacedBy != nil {
+			// A rotated token came back: someone else holds a copy
+			if _, err := s.tokenRepo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+				return nil, nil, err
+			}
+			return nil, nil, ErrRefreshTokenReused
+		}
+		return nil, nil, ErrSessionRevoked
+	}
+	if time.Now().After(stored.ExpiresAt) {
+		return nil, nil, ErrExpiredToken
+	}
+
+	user, err := s.appUserRepo.GetByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var organizationID string
+	if stored.OrganizationID != nil {
+		organizationID = *stored.OrganizationID
+	}
+
+	// Rotate and store the successor in one transaction, so a failed insert
+	// does not leave the session with a revoked token and no replacement
+	newID := uuid.New().String()
+	var pair *TokenPair
+	err = s.unitOfWork.Do(ctx, func(repos *repository.Repositories) error {
+		tokenRepo := repos.RefreshToken()
+		if err := tokenRepo.MarkReplaced(ctx, stored.ID, newID); err != nil {
+			return err
+		}
+		pair, err = s.issue(ctx, tokenRepo, user, newID, stored.FamilyID, organizationID, client)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenAlreadyRotated) {
+			// Lost a race with another refresh of the same token
+			if _, err := s.tokenRepo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+				return nil, nil, err
+			}
+			return nil, nil, ErrRefreshTokenReused
+		}
+		return nil, nil, err
+	}
+
+	return pair, user, nil
+}
+
+// Logout revokes the session (token family) the refresh token belongs to.
+// Unknown or already revoked tokens are ignored so logout is idempotent.
+func (s *SessionService) Logout(ctx context.Context, refreshToken string) error {
+	claims, err := s.jwtService.ParseRefreshToken(refreshToken)
+	if err != nil {
+		if errors.Is(err, ErrExpiredToken) {
+			return nil
+		}
+		return err
+	}
+
+	stored, err := s.tokenRepo.GetByTokenHash(ctx, HashTokenID(claims.TokenID))
+	if err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	_, err = s.tokenRepo.RevokeFamily(ctx, stored.FamilyID)
+	return err
+}
+
+// RevokeAll revokes every session of a user and returns the number of tokens revoked
+func (s *SessionService) RevokeAll(ctx context.Context, userID string) (int64, error) {
+	return s.tokenRepo.RevokeAllForUser(ctx, userID)
+}
+
+// ListSessions returns the active sessions of a user, one refresh token per session
+func (s *SessionService) ListSessions(ctx context.Context, userID string) ([]*repository.RefreshToken, error) {
+	return s.tokenRepo.ListActiveByUser(ctx, userID)
+}
+
+// SessionID returns the session (token family) ID a refresh token belongs to
+func (s *SessionService) SessionID(ctx context.Context, refreshToken string) (string, error) {
+	claims, err := s.jwtService.ParseRefreshToken(refreshToken)
+	if err != nil {
+		return "", err
+	}
+
+	stored, err := s.tokenRepo.GetByTokenHash(ctx, HashTokenID(claims.TokenID))
+	if err != nil {
+		return "", err
+	}
+
+	return stored.FamilyID, nil
+}
+
+// SwitchOrganization issues an access token bound to another organization and records the
+// selection on the session so refreshed tokens keep it. sessionID may be empty for tokens
+// issued without a session, in which case only the access token changes.
+func (s *SessionService) SwitchOrganization(ctx context.Context, userID, sessionID, organizationID string) (string, *TokenScope, error) {
+	user, err := s.appUserRepo.GetByID(ctx, userID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	scope := TokenScope{SessionID: sessionID, OrganizationID: organizationID}
+	membership, err := s.userOrgRepo.GetByUserAndOrganization(ctx, userID, organizationID)
+	switch {
+	case err == nil:
+		scope.Role = membership.Role
+	case errors.Is(err, repository.ErrUserOrganizationNotFound) && user.IsSuperadmin:
+		// Superadmins may enter any organization without a role
+	case errors.Is(err, repository.ErrUserOrganizationNotFound):
+		return "", nil, ErrNotOrganizationMember
+	default:
+		return "", nil, err
+	}
+
+	if sessionID != "" {
+		if err := s.tokenRepo.SetFamilyOrganization(ctx, sessionID, userID, organizationID); err != nil {
+			if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+				return "", nil, ErrSessionRevoked
+			}
+			return "", nil, err
+		}
+	}
+
+	accessToken, err := s.generateAccessToken(user, scope)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return accessToken, &scope, nil
+}
+
+// resolveScope returns the organization claims for a session: the requested organization
+// while the user is still a member of it, otherwise the user's default organization.
+// Users without any membership get an empty scope.
+func (s *SessionService) resolveScope(ctx context.Context, user *repository.AppUser, organizationID string) (TokenScope, error) {
+	if organizationID != "" {
+		membership, err := s.userOrgRepo.GetByUserAndOrganization(ctx, user.ID, organizationID)
+		if err == nil {
+			return TokenScope{OrganizationID: membership.OrganizationID, Role: membership.Role}, nil
+		}
+		if !errors.Is(err, repository.ErrUserOrganizationNotFound) {
+			return TokenScope{}, err
+		}
+		if user.IsSuperadmin {
+			return TokenScope{OrganizationID: organizationID}, nil
+		}
+	}
+
+	membership, err := s.userOrgRepo.GetDefaultByUserID(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserOrganizationNotFound) {
+			return TokenScope{}, nil
+		}
+		return TokenScope{}, err
+	}
+
+	return TokenScope{OrganizationID: membership.OrganizationID, Role: membership.Role}, nil
+}
+
+// generateAccessToken signs an access token for the user with the given scope
+func (s *SessionService) generateAccessToken(user *repository.AppUser, scope TokenScope) (string, error) {
+	var email string
+	if user.Email != nil {
+		email = *user.Email
+	}
+
+	return s.jwtService.GenerateScopedAccessToken(user.ID, email, user.DisplayName, user.IsSuperadmin, scope)
+}
+
+// issue generates a token pair scoped to organizationID (or the default organization)
+// and persists the refresh token through tokenRepo
+func (s *SessionService) issue(ctx context.Context, tokenRepo *repository.RefreshTokenRepository, user *repository.AppUser, tokenID, familyID, organizationID string, client ClientInfo) (*TokenPair, error) {
+	scope, err := s.resolveScope(ctx, user, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve organization: %w", err)
+	}
+	scope.SessionID = familyID
+
+	accessToken, err := s.generateAccessToken(user, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, expiresAt, err := s.jwtService.GenerateRefreshToken(user.ID, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tokenRepo.Create(ctx, tokenID, user.ID, familyID, HashTokenID(tokenID),
+		optionalString(client.UserAgent), optionalString(client.IPAddress), optionalString(scope.OrganizationID), time.Now(), expiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store refresh token: %w", err)
+	}
+
+	return &TokenPair{
+		AccessToken:    accessToken,
+		RefreshToken:   refreshToken,
+		ExpiresIn:      int64(s.jwtService.AccessExpiry().Seconds()),
+		OrganizationID: scope.OrganizationID,
+		Role:           scope.Role,
+	}, nil
+}
+
+// optionalString returns nil for empty strings
+func optionalString(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidState = errors.New("invalid oauth state")
+	ErrStateExpired = errors.New("oauth state has expired")
+)
+
+// OAuthState is carried through the provider in the state parameter
+type OAuthState struct {
+	Nonce     string `json:"n"`
+	Provider  string `json:"p"`
+	Redirect  string `json:"r,omitempty"` // post-login path, validated before signing
+	LinkUser  string `json:"u,omitempty"` // set when the flow links the identity to this user
+	ExpiresAt int64  `json:"e"`
+}
+
+// oauthCookie is stored in the browser that started the flow.
+// It holds the PKCE verifier, which must never travel through the provider.
+type oauthCookie struct {
+	Nonce     string `json:"n"`
+	Verifier  string `json:"v"`
+	ExpiresAt int64  `json:"e"`
+}
+
+// OAuthFlow is a started authorization request
+type OAuthFlow struct {
+	State         string // value for the state parameter
+	Cookie        string // value for the state cookie
+	CodeChallenge string // PKCE S256 challenge for the authorization URL
+}
+
+// StateSigner issues and verifies signed, expiring OAuth state values.
+// The state parameter and the cookie share a nonce, so a callback only succeeds
+// in the browser that started the flow (CSRF / login fixation protection).
+type StateSigner struct {
+	key []byte
+	ttl time.Duration
+	now func() time.Time
+}
+
+// NewStateSigner creates a new StateSigner
+func NewStateSigner(key []byte, ttl time.Duration) *StateSigner {
+	return &StateSigner{
+		key: key,
+		ttl: ttl,
+		now: time.Now,
+	}
+}
+
+// TTL returns how long a started flow stays valid
+func (s *StateSigner) TTL() time.Duration {
+	return s.ttl
+}
+
+// Begin starts an authorization flow for provider, carrying redirect through the state
+func (s *StateSigner) Begin(provider, redirect string) (*OAuthFlow, error) {
+	return s.begin(OAuthState{Provider: provider, Redirect: redirect})
+}
+
+// BeginLink starts a flow that links the provider identity to an authenticated user
+func (s *StateSigner) BeginLink(provider, redirect, userID string) (*OAuthFlow, error) {
+	return s.begin(OAuthState{Provider: provider, Redirect: redirect, LinkUser: userID})
+}
+
+func (s *StateSigner) begin(st OAuthState) (*OAuthFlow, error) {
+	nonce, err := randomToken(16)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := GeneratePKCEVerifier()
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := s.now().Add(s.ttl).Unix()
+	st.Nonce = nonce
+	st.ExpiresAt = expiresAt
+
+	state, err := s.seal(statePurpose, st)
+	if err != nil {
+		return nil, err
+	}
+	cookie, err := s.seal(cookiePurpose, oauthCookie{Nonce: nonce, Verifier: verifier, ExpiresAt: expiresAt})
+	if err != nil {
+		return nil, err
+	}
+
+	return &OAuthFlow{
+		State:         state,
+		Cookie:        cookie,
+		CodeChallenge: PKCEChallenge(verifier),
+	}, nil
+}
+
+// Verify checks a callback's state parameter against the cookie of the browser and
+// returns the state and the PKCE verifier to exchange the code with
+func (s *StateSigner) Verify(provider, state, cookie string) (*OAuthState, string, error) {
+	var st OAuthState
+	if err := s.open(statePurpose, state, &st); err != nil {
+		return nil, "", err
+	}
+	var c oauthCookie
+	if err := s.open(cookiePurpose, cookie, &c); err != nil {
+		return nil, "", err
+	}
+
+	if st.Provider != provider || subtle.ConstantTimeCompare([]byte(st.Nonce), []byte(c.Nonce)) != 1 {
+		return nil, "", ErrInvalidState
+	}
+	now := s.now().Unix()
+	if now > st.ExpiresAt || now > c.ExpiresAt {
+		return nil, "", ErrStateExpired
+	}
+
+	return &st, c.Verifier, nil
+}
+
+// Purposes separate the signatures of the state parameter and the cookie,
+// so one can never be presented as the other
+const (
+	statePurpose  = "oauth-state"
+	cookiePurpose = "oauth-cookie"
+)
+
+// seal encodes v as base64url(JSON) "." base64url(HMAC-SHA256)
+func (s *StateSigner) seal(purpose string, v interface{}) (string, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.sign(purpose, encoded)), nil
+}
+
+// open verifies the signature of a sealed value and decodes it into v
+func (s *StateSigner) open(purpose, sealed string, v interface{}) error {
+	encoded, sig, ok := strings.Cut(sealed, ".")
+	if !ok {
+		return ErrInvalidState
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, s.sign(purpose, encoded)) {
+		return ErrInvalidState
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return ErrInvalidState
+	}
+	if err := json.Unmarshal(payload, v); err != nil {
+		return ErrInvalidState
+	}
+	return nil
+}
+
+func (s *StateSigner) sign(purpose, encoded string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(purpose + "." + encoded))
+	return mac.Sum(nil)
+}
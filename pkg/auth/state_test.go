@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestStateSigner_Verify(t *testing.T) {
+	signer := NewStateSigner([]byte("test-key"), 10*time.Minute)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	signer.now = func() time.Time { return now }
+
+	flow, err := signer.Begin("google", "/dashboard")
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	other, err := signer.Begin("google", "")
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+
+	state, verifier, err := signer.Verify("google", flow.State, flow.Cookie)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if state.Redirect != "/dashboard" {
+		t.Errorf("Redirect = %q, want /dashboard", state.Redirect)
+	}
+	if PKCEChallenge(verifier) != flow.CodeChallenge {
+		t.Error("verifier does not match the code challenge")
+	}
+
+	tests := []struct {
+		name     string
+		provider string
+		state    string
+		cookie   string
+		want     error
+	}{
+		{"other browser's cookie", "google", flow.State, other.Cookie, ErrInvalidState},
+		{"other provider", "line", flow.State, flow.Cookie, ErrInvalidState},
+		{"tampered state", "google", flow.State + "x", flow.Cookie, ErrInvalidState},
+		{"cookie as state", "google", flow.Cookie, flow.Cookie, ErrInvalidState},
+		{"missing cookie", "google", flow.State, "", ErrInvalidState},
+		{"caller supplied state", "google", "default", flow.Cookie, ErrInvalidState},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := signer.Verify(tt.provider, tt.state, tt.cookie); !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	// A different key cannot have produced the state
+	if _, _, err := NewStateSigner([]byte("other-key"), time.Minute).Verify("google", flow.State, flow.Cookie); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("foreign key: err = %v, want ErrInvalidState", err)
+	}
+
+	now = now.Add(11 * time.Minute)
+	if _, _, err := signer.Verify("google", flow.State, flow.Cookie); !errors.Is(err, ErrStateExpired) {
+		t.Errorf("expired: err = %v, want ErrStateExpired", err)
+	}
+}
+
+func TestOAuthClients_PKCE(t *testing.T) {
+	verifier, err := GeneratePKCEVerifier()
+	if err != nil {
+		t.Fatalf("GeneratePKCEVerifier: %v", err)
+	}
+	challenge := PKCEChallenge(verifier)
+
+	// Stand-in for the provider token endpoints: only issues tokens for the right verifier
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("code") != "the-code" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		if PKCEChallenge(r.PostForm.Get("code_verifier")) != challenge {
+			http.Error(w, `{"error":"invalid_grant","error_description":"code_verifier mismatch"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"at","refresh_token":"rt","expires_in":3600,"token_type":"Bearer"}`))
+	}))
+	defer provider.Close()
+
+	endpoints := OAuthEndpoints{AuthURL: provider.URL + "/authorize", TokenURL: provider.URL + "/token"}
+	google := NewGoogleOAuthClientWithEndpoints("id", "secret", "https://app/callback", endpoints)
+	line := NewLineOAuthClientWithEndpoints("id", "secret", "https://app/callback", endpoints)
+
+	for name, authURL := range map[string]string{
+		"google": google.GetAuthURL("state", challenge),
+		"line":   line.GetAuthURL("state", challenge),
+	} {
+		u, err := url.Parse(authURL)
+		if err != nil {
+			t.Fatalf("%s: parse auth URL: %v", name, err)
+		}
+		if q := u.Query(); q.Get("code_challenge") != challenge || q.Get("code_challenge_method") != "S256" {
+			t.Errorf("%s: auth URL query = %v, want S256 challenge", name, q)
+		}
+	}
+
+	ctx := context.Background()
+	if resp, err := google.ExchangeCode(ctx, "the-code", verifier); err != nil || resp.AccessToken != "at" {
+		t.Errorf("google ExchangeCode = %+v, %v", resp, err)
+	}
+	if resp, err := line.ExchangeCode(ctx, "the-code", verifier); err != nil || resp.AccessToken != "at" {
+		t.Errorf("line ExchangeCode = %+v, %v", resp, err)
+	}
+
+	wrong, _ := GeneratePKCEVerifier()
+	if _, err := google.ExchangeCode(ctx, "the-code", wrong); !errors.Is(err, ErrGoogleAuthFailed) {
+		t.Errorf("google with wrong verifier: err = %v, want ErrGoogleAuthFailed", err)
+	}
+	if _, err := line.ExchangeCode(ctx, "the-code", wrong); !errors.Is(err, ErrLineAuthFailed) {
+		t.Errorf("line with wrong verifier: err = %v, want ErrLineAuthFailed", err)
+	}
+}
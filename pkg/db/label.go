@@ -0,0 +1,19 @@
+package db
+
+import "context"
+
+// queryLabelKey is the context key of the query label
+type queryLabelKey struct{}
+
+// WithQueryLabel returns a context whose statements are labeled label in query metrics
+// and spans. Repositories label their statements with the method issuing them,
+// e.g. "KudgivtRepository.List".
+func WithQueryLabel(ctx context.Context, label string) context.Context {
+	return context.WithValue(ctx, queryLabelKey{}, label)
+}
+
+// QueryLabel returns the label set by WithQueryLabel, or "" if there is none
+func QueryLabel(ctx context.Context) string {
+	label, _ := ctx.Value(queryLabelKey{}).(string)
+	return label
+}
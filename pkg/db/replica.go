@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type readOnlyContextKey struct{}
+
+type callerContextKey struct{}
+
+// WithReadOnly marks ctx as only reading, so an RLSPool with a replica may serve its queries there
+func WithReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyContextKey{}, true)
+}
+
+// IsReadOnly reports whether ctx was marked with WithReadOnly
+func IsReadOnly(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(readOnlyContextKey{}).(bool)
+	return readOnly
+}
+
+// WithCallerID returns a context naming the caller (user or API key) of the request.
+// It keys read-your-writes: after a caller writes, its reads go to the primary for a while.
+func WithCallerID(ctx context.Context, callerID string) context.Context {
+	return context.WithValue(ctx, callerContextKey{}, callerID)
+}
+
+// GetCallerID retrieves the caller ID from context
+func GetCallerID(ctx context.Context) (string, bool) {
+	callerID, ok := ctx.Value(callerContextKey{}).(string)
+	return callerID, ok
+}
+
+// writeTracker remembers when each caller last wrote
+type writeTracker struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	lastWrite map[string]time.Time
+	lastPrune time.Time
+}
+
+func newWriteTracker(window time.Duration) *writeTracker {
+	return &writeTracker{
+		window:    window,
+		now:       time.Now,
+		lastWrite: make(map[string]time.Time),
+	}
+}
+
+// markWrite records a write by callerID
+func (w *writeTracker) markWrite(callerID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := w.now()
+	w.lastWrite[callerID] = now
+
+	// Forget callers whose window has passed, at most once per window
+	if now.Sub(w.lastPrune) >= w.window {
+		for id, at := range w.lastWrite {
+			if now.Sub(at) >= w.window {
+				delete(w.lastWrite, id)
+			}
+		}
+		w.lastPrune = now
+	}
+}
+
+// wroteRecently reports whether callerID wrote within the window
+func (w *writeTracker) wroteRecently(callerID string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	at, ok := w.lastWrite[callerID]
+	return ok && w.now().Sub(at) < w.window
+}
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// newTestPool creates a pool without connecting; pgxpool dials lazily
+func newTestPool(t *testing.T, dsn string) *pgxpool.Pool {
+	pool, err := pgxpool.New(context.Background(), dsn)
+	if err != nil {
+		t.Fatalf("pgxpool.New: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func TestWriteTracker(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	w := newWriteTracker(5 * time.Second)
+	w.now = func() time.Time { return now }
+
+	if w.wroteRecently("user:a") {
+		t.Error("wroteRecently before any write = true")
+	}
+
+	w.markWrite("user:a")
+	now = now.Add(4 * time.Second)
+	if !w.wroteRecently("user:a") {
+		t.Error("wroteRecently within the window = false")
+	}
+	if w.wroteRecently("user:b") {
+		t.Error("wroteRecently of another caller = true")
+	}
+
+	now = now.Add(time.Second)
+	if w.wroteRecently("user:a") {
+		t.Error("wroteRecently after the window = true")
+	}
+
+	// A later write prunes callers whose window has passed
+	w.markWrite("user:b")
+	if _, ok := w.lastWrite["user:a"]; ok || len(w.lastWrite) != 1 {
+		t.Errorf("lastWrite = %v, want only user:b", w.lastWrite)
+	}
+}
+
+func TestRLSPool_QueryPool(t *testing.T) {
+	primary := newTestPool(t, "postgres://app@primary.invalid:5432/app")
+	replica := newTestPool(t, "postgres://app@replica.invalid:5432/app")
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := NewRLSPoolWithReplica(primary, replica, 5*time.Second)
+	r.writes.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	alice := WithCallerID(ctx, "user:alice")
+	bob := WithCallerID(ctx, "user:bob")
+
+	if got := r.queryPool(WithReadOnly(alice)); got != replica {
+		t.Error("read-only query went to the primary, want the replica")
+	}
+	if got := r.queryPool(alice); got != primary {
+		t.Error("query outside a read-only context went to the replica")
+	}
+
+	// alice wrote (the query above), so her reads stay on the primary for the window
+	if got := r.queryPool(WithReadOnly(alice)); got != primary {
+		t.Error("read right after a write went to the replica, want the primary")
+	}
+	if got := r.queryPool(WithReadOnly(bob)); got != replica {
+		t.Error("another caller's read went to the primary, want the replica")
+	}
+
+	now = now.Add(5 * time.Second)
+	if got := r.queryPool(WithReadOnly(alice)); got != replica {
+		t.Error("read after the window went to the primary, want the replica")
+	}
+
+	// Exec counts as a write even when it fails to reach the database
+	ctxTimeout, cancel := context.WithTimeout(WithReadOnly(bob), time.Millisecond)
+	defer cancel()
+	r.Exec(ctxTimeout, "SELECT 1")
+	if got := r.queryPool(WithReadOnly(bob)); got != primary {
+		t.Error("read right after Exec went to the replica, want the primary")
+	}
+
+	// Without a replica everything goes to the primary
+	if got := NewRLSPool(primary).queryPool(WithReadOnly(ctx)); got != primary {
+		t.Error("RLSPool without replica did not use the primary")
+	}
+}
@@ -0,0 +1,247 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// retryableSQLStates are the SQLSTATE codes of errors that succeed when the statement is repeated
+var retryableSQLStates = map[string]bool{
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+	"57P01": true, // admin_shutdown (e.g. Cloud SQL maintenance)
+	"57P02": true, // crash_shutdown
+	"57P03": true, // cannot_connect_now
+}
+
+// retryReasonConnection labels retries of connection failures without a SQLSTATE
+const retryReasonConnection = "connection"
+
+// retryReason returns why err is worth retrying (its SQLSTATE, or "connection"), or "" if it is not
+func retryReason(err error) string {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return ""
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// Class 08 is connection_exception
+		if retryableSQLStates[pgErr.Code] || strings.HasPrefix(pgErr.Code, "08") {
+			return pgErr.Code
+		}
+		return ""
+	}
+
+	if pgconn.SafeToRetry(err) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF) {
+		return retryReasonConnection
+	}
+	return ""
+}
+
+// IsRetryable reports whether err is a transient failure (serialization failure, deadlock,
+// server shutdown or a lost connection) after which the operation may be repeated
+func IsRetryable(err error) bool {
+	return retryReason(err) != ""
+}
+
+// RetryPolicy configures retries of transient errors
+type RetryPolicy struct {
+	MaxAttempts int           // total attempts including the first; 1 disables retries
+	BaseDelay   time.Duration // backoff before the first retry, doubled for each further retry
+	MaxDelay    time.Duration // upper bound of the backoff
+}
+
+// DefaultRetryPolicy retries twice within roughly a quarter second
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   50 * time.Millisecond,
+	MaxDelay:    time.Second,
+}
+
+// backoff returns the jittered delay before retry number retry (1-based): a random
+// duration up to BaseDelay*2^(retry-1), capped at MaxDelay
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < retry && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(int64(delay))) + 1
+}
+
+// RetryMetrics counts retries. It is safe for concurrent use.
+type RetryMetrics struct {
+	mu        sync.Mutex
+	retries   map[string]int64
+	exhausted int64
+}
+
+// RetryStats is a snapshot of RetryMetrics
+type RetryStats struct {
+	Retries   map[string]int64 // retries by reason (SQLSTATE or "connection")
+	Exhausted int64            // operations that still failed with a transient error after the last attempt
+}
+
+func (m *RetryMetrics) recordRetry(reason string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.retries == nil {
+		m.retries = make(map[string]int64)
+	}
+	m.retries[reason]++
+}
+
+func (m *RetryMetrics) recordExhausted() {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.exhausted++
+}
+
+// Snapshot returns the current counts
+func (m *RetryMetrics) Snapshot() RetryStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stats := RetryStats{Retries: make(map[string]int64, len(m.retries)), Exhausted: m.exhausted}
+	for reason, n := range m.retries {
+		stats.Retries[reason] = n
+	}
+	return stats
+}
+
+// RetryTarget is the database RetryingDB wraps. *RLSPool implements it.
+type RetryTarget interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	RunInTx(ctx context.Context, fn func(tx Tx) error) error
+}
+
+// RetryingDB retries operations that failed with a transient error (see IsRetryable).
+// Only operations that are safe to repeat are retried:
+//   - QueryRow and Query of read-only contexts (see WithReadOnly). Query is retried until
+//     it returns rows; errors while reading the rows are returned as is.
+//   - Exec only when the statement never reached the server
+//   - RunInTx as a whole: fn runs again in a new transaction, so it must not have
+//     side effects outside the database
+type RetryingDB struct {
+	db      RetryTarget
+	policy  RetryPolicy
+	metrics *RetryMetrics
+}
+
+// NewRetryingDB wraps db with policy. metrics may be nil.
+func NewRetryingDB(db RetryTarget, policy RetryPolicy, metrics *RetryMetrics) *RetryingDB {
+	return &RetryingDB{db: db, policy: policy, metrics: metrics}
+}
+
+// do runs fn until it succeeds, fails with an error retryable rejects, or the attempts run out
+func (r *RetryingDB) do(ctx context.Context, retryable func(error) string, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		reason := retryable(err)
+		if reason == "" {
+			return err
+		}
+		if attempt >= r.policy.MaxAttempts {
+			r.metrics.recordExhausted()
+			return err
+		}
+
+		timer := time.NewTimer(r.policy.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		r.metrics.recordRetry(reason)
+	}
+}
+
+// notSent returns the retry reason of errors raised before the statement reached the server
+func notSent(err error) string {
+	if err != nil && pgconn.SafeToRetry(err) {
+		return retryReasonConnection
+	}
+	return ""
+}
+
+// QueryRow executes a query that returns one row. Read-only queries are retried on Scan.
+func (r *RetryingDB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	if !IsReadOnly(ctx) {
+		return r.db.QueryRow(ctx, sql, args...)
+	}
+	return &retryRow{db: r, ctx: ctx, sql: sql, args: args}
+}
+
+// Query executes a query that returns rows. Read-only queries are retried.
+func (r *RetryingDB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	retryable := notSent
+	if IsReadOnly(ctx) {
+		retryable = retryReason
+	}
+
+	var rows pgx.Rows
+	err := r.do(ctx, retryable, func() error {
+		var err error
+		rows, err = r.db.Query(ctx, sql, args...)
+		return err
+	})
+	return rows, err
+}
+
+// Exec executes a command. It is only retried if it never reached the server.
+func (r *RetryingDB) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	var tag pgconn.CommandTag
+	err := r.do(ctx, notSent, func() error {
+		var err error
+		tag, err = r.db.Exec(ctx, sql, args...)
+		return err
+	})
+	return tag, err
+}
+
+// RunInTx runs fn in a transaction, repeating the whole transaction after a transient failure
+func (r *RetryingDB) RunInTx(ctx context.Context, fn func(tx Tx) error) error {
+	return r.do(ctx, retryReason, func() error {
+		return r.db.RunInTx(ctx, fn)
+	})
+}
+
+// retryRow runs a read-only QueryRow when scanned, retrying transient errors
+type retryRow struct {
+	db   *RetryingDB
+	ctx  context.Context
+	sql  string
+	args []any
+}
+
+func (r *retryRow) Scan(dest ...any) error {
+	return r.db.do(r.ctx, retryReason, func() error {
+		return r.db.db.QueryRow(r.ctx, r.sql, r.args...).Scan(dest...)
+	})
+}
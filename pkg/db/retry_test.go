@@ -0,0 +1,259 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakeRetryTarget returns scripted errors, one per call, then succeeds
+type fakeRetryTarget struct {
+	errs  []error
+	calls int
+}
+
+func (f *fakeRetryTarget) next() error {
+	f.calls++
+	if len(f.errs) == 0 {
+		return nil
+	}
+	err := f.errs[0]
+	f.errs = f.errs[1:]
+	return err
+}
+
+func (f *fakeRetryTarget) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return &errorRow{err: f.next()}
+}
+
+func (f *fakeRetryTarget) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return nil, f.next()
+}
+
+func (f *fakeRetryTarget) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, f.next()
+}
+
+func (f *fakeRetryTarget) RunInTx(ctx context.Context, fn func(tx Tx) error) error {
+	if err := fn(nil); err != nil {
+		return err
+	}
+	return f.next()
+}
+
+// notSentError is an error pgx raises before a statement reaches the server
+type notSentError struct{}
+
+func (notSentError) Error() string     { return "failed to connect" }
+func (notSentError) SafeToRetry() bool { return true }
+
+func pgError(code string) error {
+	return &pgconn.PgError{Code: code, Message: "scripted"}
+}
+
+var testRetryPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Microsecond, MaxDelay: time.Millisecond}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"serialization failure", pgError("40001"), true},
+		{"deadlock", pgError("40P01"), true},
+		{"admin shutdown", pgError("57P01"), true},
+		{"connection failure", pgError("08006"), true},
+		{"wrapped", fmt.Errorf("failed to list: %w", pgError("40001")), true},
+		{"connection reset", fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		{"unexpected EOF", io.ErrUnexpectedEOF, true},
+		{"not sent", notSentError{}, true},
+		{"unique violation", pgError("23505"), false},
+		{"no rows", pgx.ErrNoRows, false},
+		{"canceled", context.Canceled, false},
+		{"deadline", fmt.Errorf("query: %w", context.DeadlineExceeded), false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryingDB_QueryRow(t *testing.T) {
+	readOnly := WithReadOnly(context.Background())
+
+	t.Run("read-only query is retried", func(t *testing.T) {
+		fake := &fakeRetryTarget{errs: []error{pgError("40001"), pgError("57P01")}}
+		metrics := &RetryMetrics{}
+		r := NewRetryingDB(fake, testRetryPolicy, metrics)
+
+		if err := r.QueryRow(readOnly, "SELECT 1").Scan(); err != nil {
+			t.Fatalf("Scan: %v", err)
+		}
+		if fake.calls != 3 {
+			t.Errorf("calls = %d, want 3", fake.calls)
+		}
+		stats := metrics.Snapshot()
+		if stats.Retries["40001"] != 1 || stats.Retries["57P01"] != 1 || stats.Exhausted != 0 {
+			t.Errorf("stats = %+v, want one retry each of 40001 and 57P01", stats)
+		}
+	})
+
+	t.Run("attempts run out", func(t *testing.T) {
+		fake := &fakeRetryTarget{errs: []error{pgError("40P01"), pgError("40P01"), pgError("40P01"), pgError("40P01")}}
+		metrics := &RetryMetrics{}
+		r := NewRetryingDB(fake, testRetryPolicy, metrics)
+
+		err := r.QueryRow(readOnly, "SELECT 1").Scan()
+		var pgErr *pgconn.PgError
+		if !errors.As(err, &pgErr) || pgErr.Code != "40P01" {
+			t.Fatalf("Scan error = %v, want 40P01", err)
+		}
+		if fake.calls != 3 {
+			t.Errorf("calls = %d, want MaxAttempts", fake.calls)
+		}
+		if stats := metrics.Snapshot(); stats.Retries["40P01"] != 2 || stats.Exhausted != 1 {
+			t.Errorf("stats = %+v, want 2 retries and 1 exhausted", stats)
+		}
+	})
+
+	t.Run("permanent error is not retried", func(t *testing.T) {
+		fake := &fakeRetryTarget{errs: []error{pgx.ErrNoRows}}
+		r := NewRetryingDB(fake, testRetryPolicy, nil)
+
+		if err := r.QueryRow(readOnly, "SELECT 1").Scan(); !errors.Is(err, pgx.ErrNoRows) {
+			t.Errorf("Scan error = %v, want ErrNoRows", err)
+		}
+		if fake.calls != 1 {
+			t.Errorf("calls = %d, want 1", fake.calls)
+		}
+	})
+
+	t.Run("query outside a read-only context is not retried", func(t *testing.T) {
+		fake := &fakeRetryTarget{errs: []error{pgError("40001")}}
+		r := NewRetryingDB(fake, testRetryPolicy, nil)
+
+		if err := r.QueryRow(context.Background(), "INSERT INTO t VALUES (1) RETURNING id").Scan(); !IsRetryable(err) {
+			t.Errorf("Scan error = %v, want the 40001 error", err)
+		}
+		if fake.calls != 1 {
+			t.Errorf("calls = %d, want 1", fake.calls)
+		}
+	})
+
+	t.Run("canceled context stops retrying", func(t *testing.T) {
+		fake := &fakeRetryTarget{errs: []error{pgError("40001"), pgError("40001")}}
+		r := NewRetryingDB(fake, RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour, MaxDelay: time.Hour}, nil)
+
+		ctx, cancel := context.WithCancel(readOnly)
+		cancel()
+		if err := r.QueryRow(ctx, "SELECT 1").Scan(); !IsRetryable(err) {
+			t.Errorf("Scan error = %v, want the 40001 error", err)
+		}
+		if fake.calls != 1 {
+			t.Errorf("calls = %d, want 1", fake.calls)
+		}
+	})
+}
+
+func TestRetryingDB_Query(t *testing.T) {
+	fake := &fakeRetryTarget{errs: []error{fmt.Errorf("read: %w", syscall.ECONNRESET)}}
+	metrics := &RetryMetrics{}
+	r := NewRetryingDB(fake, testRetryPolicy, metrics)
+
+	if _, err := r.Query(WithReadOnly(context.Background()), "SELECT 1"); err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if stats := metrics.Snapshot(); stats.Retries[retryReasonConnection] != 1 {
+		t.Errorf("stats = %+v, want one connection retry", stats)
+	}
+}
+
+func TestRetryingDB_Exec(t *testing.T) {
+	t.Run("statement that reached the server is not retried", func(t *testing.T) {
+		fake := &fakeRetryTarget{errs: []error{pgError("40001")}}
+		r := NewRetryingDB(fake, testRetryPolicy, nil)
+
+		if _, err := r.Exec(context.Background(), "UPDATE t SET n = n + 1"); err == nil {
+			t.Error("Exec error = nil, want the 40001 error")
+		}
+		if fake.calls != 1 {
+			t.Errorf("calls = %d, want 1", fake.calls)
+		}
+	})
+
+	t.Run("statement that was never sent is retried", func(t *testing.T) {
+		fake := &fakeRetryTarget{errs: []error{notSentError{}}}
+		r := NewRetryingDB(fake, testRetryPolicy, nil)
+
+		if _, err := r.Exec(context.Background(), "UPDATE t SET n = n + 1"); err != nil {
+			t.Errorf("Exec: %v", err)
+		}
+		if fake.calls != 2 {
+			t.Errorf("calls = %d, want 2", fake.calls)
+		}
+	})
+}
+
+func TestRetryingDB_RunInTx(t *testing.T) {
+	t.Run("whole transaction is repeated", func(t *testing.T) {
+		// The commit fails with a serialization failure once
+		fake := &fakeRetryTarget{errs: []error{pgError("40001")}}
+		r := NewRetryingDB(fake, testRetryPolicy, nil)
+
+		runs := 0
+		err := r.RunInTx(context.Background(), func(tx Tx) error {
+			runs++
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("RunInTx: %v", err)
+		}
+		if runs != 2 {
+			t.Errorf("fn ran %d times, want 2", runs)
+		}
+	})
+
+	t.Run("error from fn is retried only if transient", func(t *testing.T) {
+		fake := &fakeRetryTarget{}
+		r := NewRetryingDB(fake, testRetryPolicy, nil)
+
+		errInvalid := errors.New("invalid")
+		runs := 0
+		err := r.RunInTx(context.Background(), func(tx Tx) error {
+			runs++
+			if runs == 1 {
+				return fmt.Errorf("insert: %w", pgError("40P01"))
+			}
+			return errInvalid
+		})
+		if !errors.Is(err, errInvalid) {
+			t.Errorf("RunInTx error = %v, want %v", err, errInvalid)
+		}
+		if runs != 2 {
+			t.Errorf("fn ran %d times, want 2", runs)
+		}
+	})
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, BaseDelay: 10 * time.Millisecond, MaxDelay: 25 * time.Millisecond}
+	limits := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 25 * time.Millisecond, 25 * time.Millisecond}
+	for i, limit := range limits {
+		for n := 0; n < 50; n++ {
+			if d := p.backoff(i + 1); d <= 0 || d > limit {
+				t.Fatalf("backoff(%d) = %v, want in (0, %v]", i+1, d, limit)
+			}
+		}
+	}
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -23,7 +24,12 @@ func GetOrganizationID(ctx context.Context) (string, bool) {
 	return orgID, ok
 }
 
-// SetRLSContext sets the app.current_organization_id session variable for RLS
+// rlsResetTimeout bounds resetting the RLS context before a connection returns to the pool
+const rlsResetTimeout = 5 * time.Second
+
+// SetRLSContext sets the app.current_organization_id session variable for RLS.
+// The setting outlives the query, so the connection must be released with
+// ReleaseRLSConn rather than conn.Release().
 func SetRLSContext(ctx context.Context, conn *pgxpool.Conn, orgID string) error {
 	// SET doesn't support parameterized queries, so we use set_config() function instead
 	_, err := conn.Exec(ctx, "SELECT set_config('app.current_organization_id', $1, false)", orgID)
@@ -33,13 +39,35 @@ func SetRLSContext(ctx context.Context, conn *pgxpool.Conn, orgID string) error
 	return nil
 }
 
+// ResetRLSContext clears the app.current_organization_id session variable
+func ResetRLSContext(ctx context.Context, conn *pgxpool.Conn) error {
+	if _, err := conn.Exec(ctx, "RESET app.current_organization_id"); err != nil {
+		return fmt.Errorf("failed to reset RLS context: %w", err)
+	}
+	return nil
+}
+
+// ReleaseRLSConn resets the RLS context and returns the connection to the pool,
+// so the next borrower does not inherit the organization. If the reset fails
+// the connection is closed instead of being reused.
+func ReleaseRLSConn(conn *pgxpool.Conn) {
+	ctx, cancel := context.WithTimeout(context.Background(), rlsResetTimeout)
+	defer cancel()
+
+	if err := ResetRLSContext(ctx, conn); err != nil {
+		conn.Hijack().Close(ctx)
+		return
+	}
+	conn.Release()
+}
+
 // WithRLSContext acquires a connection and sets the RLS context.
-// The returned connection must be released by calling conn.Release().
+// The returned connection must be released by calling db.ReleaseRLSConn(conn).
 // Usage:
 //
 //	conn, err := db.WithRLSContext(ctx, pool)
 //	if err != nil { return err }
-//	defer conn.Release()
+//	defer db.ReleaseRLSConn(conn)
 //	// Use conn for queries...
 func WithRLSContext(ctx context.Context, pool *pgxpool.Pool) (*pgxpool.Conn, error) {
 	orgID, ok := GetOrganizationID(ctx)
@@ -53,7 +81,7 @@ func WithRLSContext(ctx context.Context, pool *pgxpool.Pool) (*pgxpool.Conn, err
 	}
 
 	if err := SetRLSContext(ctx, conn, orgID); err != nil {
-		conn.Release()
+		ReleaseRLSConn(conn)
 		return nil, err
 	}
 
@@ -64,6 +92,10 @@ func WithRLSContext(ctx context.Context, pool *pgxpool.Pool) (*pgxpool.Conn, err
 // It implements the DB interface used by repositories.
 type RLSPool struct {
 	pool *pgxpool.Pool
+
+	// replica serves QueryRow/Query of read-only contexts (nil without a replica)
+	replica *pgxpool.Pool
+	writes  *writeTracker
 }
 
 // NewRLSPool creates a new RLS-aware pool wrapper
@@ -71,111 +103,198 @@ func NewRLSPool(pool *pgxpool.Pool) *RLSPool {
 	return &RLSPool{pool: pool}
 }
 
+// NewRLSPoolWithReplica creates an RLS-aware pool wrapper that sends the queries of
+// contexts marked with WithReadOnly to replica. A caller (see WithCallerID) that wrote
+// through the pool reads from the primary for readYourWritesWindow afterwards, so it
+// sees its own writes despite replication lag.
+func NewRLSPoolWithReplica(pool, replica *pgxpool.Pool, readYourWritesWindow time.Duration) *RLSPool {
+	return &RLSPool{
+		pool:    pool,
+		replica: replica,
+		writes:  newWriteTracker(readYourWritesWindow),
+	}
+}
+
 // Pool returns the underlying pgxpool.Pool
 func (r *RLSPool) Pool() *pgxpool.Pool {
 	return r.pool
 }
 
-// QueryRow executes a query with RLS context and returns a single row.
-// If organization_id is in context, it sets the RLS context before querying.
-func (r *RLSPool) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
-	orgID, hasOrg := GetOrganizationID(ctx)
-	if !hasOrg {
-		// No RLS context, use pool directly
-		return r.pool.QueryRow(ctx, sql, args...)
+// queryPool returns the pool to run a query of ctx on: the replica for read-only
+// contexts whose caller has not written recently, the primary otherwise.
+// Queries on the primary outside a read-only context count as writes of the caller.
+func (r *RLSPool) queryPool(ctx context.Context) *pgxpool.Pool {
+	if r.replica == nil {
+		return r.pool
 	}
 
-	conn, err := r.pool.Acquire(ctx)
-	if err != nil {
-		return &errorRow{err: fmt.Errorf("failed to acquire connection: %w", err)}
+	callerID, hasCaller := GetCallerID(ctx)
+	if IsReadOnly(ctx) {
+		if !hasCaller || !r.writes.wroteRecently(callerID) {
+			return r.replica
+		}
+		return r.pool
 	}
 
-	if err := SetRLSContext(ctx, conn, orgID); err != nil {
-		conn.Release()
+	r.markWrite(ctx)
+	return r.pool
+}
+
+// markWrite records a write by the caller of ctx for read-your-writes
+func (r *RLSPool) markWrite(ctx context.Context) {
+	if r.writes == nil {
+		return
+	}
+	if callerID, ok := GetCallerID(ctx); ok {
+		r.writes.markWrite(callerID)
+	}
+}
+
+// setLocalRLSSQL applies the organization until the end of the current transaction
+const setLocalRLSSQL = "SELECT set_config('app.current_organization_id', $1, true)"
+
+// localSettings returns the statements applying the RLS context and the statement timeout
+// of ctx to the current transaction, with their arguments
+func localSettings(ctx context.Context) (sqls []string, args [][]any) {
+	if orgID, ok := GetOrganizationID(ctx); ok {
+		sqls = append(sqls, setLocalRLSSQL)
+		args = append(args, []any{orgID})
+	}
+	if timeout, ok := statementTimeout(ctx); ok {
+		sqls = append(sqls, setLocalStatementTimeoutSQL)
+		args = append(args, []any{formatStatementTimeout(timeout)})
+	}
+	return sqls, args
+}
+
+// settingsBatch queues the local settings in front of sql. A batch is sent in one round
+// trip and runs in one implicit transaction, so the transaction-local settings apply to
+// sql only and are gone when the connection returns to the pool.
+func settingsBatch(settings []string, settingArgs [][]any, sql string, args []any) *pgx.Batch {
+	b := &pgx.Batch{}
+	for i, setting := range settings {
+		b.Queue(setting, settingArgs[i]...)
+	}
+	b.Queue(sql, args...)
+	return b
+}
+
+// sendWithSettings sends sql with the local settings of ctx to pool and consumes the
+// set_config results. ok is false if ctx has no settings; the caller then uses pool directly.
+func sendWithSettings(ctx context.Context, pool *pgxpool.Pool, sql string, args []any) (br pgx.BatchResults, ok bool, err error) {
+	settings, settingArgs := localSettings(ctx)
+	if len(settings) == 0 {
+		return nil, false, nil
+	}
+
+	br = pool.SendBatch(ctx, settingsBatch(settings, settingArgs, sql, args))
+	for range settings {
+		if _, err := br.Exec(); err != nil {
+			br.Close()
+			return nil, true, fmt.Errorf("failed to apply RLS context and statement timeout: %w", err)
+		}
+	}
+	return br, true, nil
+}
+
+// QueryRow executes a query with RLS context and returns a single row.
+// If organization_id or a deadline is in context, the RLS context and statement
+// timeout are set in the same round trip.
+func (r *RLSPool) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	pool := r.queryPool(ctx)
+
+	br, ok, err := sendWithSettings(ctx, pool, sql, args)
+	if !ok {
+		// No RLS context or deadline, use pool directly
+		return pool.QueryRow(ctx, sql, args...)
+	}
+	if err != nil {
 		return &errorRow{err: err}
 	}
 
 	// Return a row that will release the connection after Scan
 	return &rlsRow{
-		conn: conn,
-		row:  conn.QueryRow(ctx, sql, args...),
+		batch: br,
+		row:   br.QueryRow(),
 	}
 }
 
 // Query executes a query with RLS context and returns rows.
-// If organization_id is in context, it sets the RLS context before querying.
+// If organization_id or a deadline is in context, the RLS context and statement
+// timeout are set in the same round trip.
 func (r *RLSPool) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
-	orgID, hasOrg := GetOrganizationID(ctx)
-	if !hasOrg {
-		// No RLS context, use pool directly
-		return r.pool.Query(ctx, sql, args...)
-	}
+	pool := r.queryPool(ctx)
 
-	conn, err := r.pool.Acquire(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	br, ok, err := sendWithSettings(ctx, pool, sql, args)
+	if !ok {
+		// No RLS context or deadline, use pool directly
+		return pool.Query(ctx, sql, args...)
 	}
-
-	if err := SetRLSContext(ctx, conn, orgID); err != nil {
-		conn.Release()
+	if err != nil {
 		return nil, err
 	}
 
-	rows, err := conn.Query(ctx, sql, args...)
+	rows, err := br.Query()
 	if err != nil {
-		conn.Release()
+		br.Close()
 		return nil, err
 	}
 
 	// Return rows that will release connection when closed
 	return &rlsRows{
-		conn: conn,
-		Rows: rows,
+		batch: br,
+		Rows:  rows,
 	}, nil
 }
 
-// Exec executes a command with RLS context.
-// If organization_id is in context, it sets the RLS context before executing.
+// Exec executes a command with RLS context. Commands always run on the primary.
+// If organization_id or a deadline is in context, the RLS context and statement
+// timeout are set in the same round trip.
 func (r *RLSPool) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
-	orgID, hasOrg := GetOrganizationID(ctx)
-	if !hasOrg {
-		// No RLS context, use pool directly
+	r.markWrite(ctx)
+
+	br, ok, err := sendWithSettings(ctx, r.pool, sql, args)
+	if !ok {
+		// No RLS context or deadline, use pool directly
 		return r.pool.Exec(ctx, sql, args...)
 	}
-
-	conn, err := r.pool.Acquire(ctx)
 	if err != nil {
-		return pgconn.CommandTag{}, fmt.Errorf("failed to acquire connection: %w", err)
+		return pgconn.CommandTag{}, err
 	}
-	defer conn.Release()
+	defer br.Close()
 
-	if err := SetRLSContext(ctx, conn, orgID); err != nil {
-		return pgconn.CommandTag{}, err
+	tag, err := br.Exec()
+	if err != nil {
+		return tag, err
 	}
 
-	return conn.Exec(ctx, sql, args...)
+	// Close reports errors raised when the implicit transaction commits (e.g. deferred constraints)
+	return tag, br.Close()
 }
 
 // rlsRow wraps pgx.Row to release connection after Scan
 type rlsRow struct {
-	conn *pgxpool.Conn
-	row  pgx.Row
+	batch pgx.BatchResults
+	row   pgx.Row
 }
 
 func (r *rlsRow) Scan(dest ...any) error {
-	defer r.conn.Release()
-	return r.row.Scan(dest...)
+	err := r.row.Scan(dest...)
+	if closeErr := r.batch.Close(); err == nil {
+		err = closeErr
+	}
+	return err
 }
 
 // rlsRows wraps pgx.Rows to release connection when closed
 type rlsRows struct {
-	conn *pgxpool.Conn
+	batch pgx.BatchResults
 	pgx.Rows
 }
 
 func (r *rlsRows) Close() {
 	r.Rows.Close()
-	r.conn.Release()
+	r.batch.Close()
 }
 
 // errorRow implements pgx.Row for error cases
@@ -228,7 +347,12 @@ func (t *rlsTx) Rollback(ctx context.Context) error {
 
 // Begin starts a new transaction. RLS context is NOT applied to transactions
 // since organization management operations typically need to bypass RLS.
+// Use BeginWithRLS for transactions on organization data.
+// If ctx has a deadline, its statements get the matching statement timeout.
+// Transactions always run on the primary.
 func (r *RLSPool) Begin(ctx context.Context) (Tx, error) {
+	r.markWrite(ctx)
+
 	conn, err := r.pool.Acquire(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to acquire connection: %w", err)
@@ -240,5 +364,72 @@ func (r *RLSPool) Begin(ctx context.Context) (Tx, error) {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
+	if timeout, ok := statementTimeout(ctx); ok {
+		if _, err := tx.Exec(ctx, setLocalStatementTimeoutSQL, formatStatementTimeout(timeout)); err != nil {
+			tx.Rollback(ctx)
+			conn.Release()
+			return nil, fmt.Errorf("failed to set statement timeout: %w", err)
+		}
+	}
+
 	return &rlsTx{conn: conn, tx: tx}, nil
 }
+
+// BeginWithRLS starts a new transaction with the RLS context of ctx applied.
+// The organization is set with a transaction-local set_config, so it ends with
+// the transaction and never leaks onto the pooled connection.
+func (r *RLSPool) BeginWithRLS(ctx context.Context) (Tx, error) {
+	orgID, ok := GetOrganizationID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("organization_id not found in context")
+	}
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, setLocalRLSSQL, orgID); err != nil {
+		tx.Rollback(ctx)
+		return nil, fmt.Errorf("failed to set RLS context: %w", err)
+	}
+
+	return tx, nil
+}
+
+// RunInTx runs fn in a transaction and commits it if fn returns nil, or rolls it back otherwise.
+// The RLS context is applied if ctx carries an organization ID.
+// Usage:
+//
+//	err := rlsPool.RunInTx(ctx, func(tx db.Tx) error {
+//		if _, err := tx.Exec(ctx, ...); err != nil { return err }
+//		return nil
+//	})
+func (r *RLSPool) RunInTx(ctx context.Context, fn func(tx Tx) error) (err error) {
+	var tx Tx
+	if _, hasOrg := GetOrganizationID(ctx); hasOrg {
+		tx, err = r.BeginWithRLS(ctx)
+	} else {
+		tx, err = r.Begin(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback(ctx)
+			panic(p)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+//go:build integration
+
+package db
+
+import (
+	"context"
+	"testing"
+)
+
+// Benchmarks comparing the RLS setup paths against a local Postgres:
+//
+//	go test -tags integration -run '^$' -bench RLS ./pkg/db
+//
+// SeparateRoundTrips is the previous RLSPool path: set_config, the statement and
+// the reset each take a round trip. Batch is the current RLSPool path.
+
+const benchQuery = "SELECT COUNT(*) FROM cam_files WHERE organization_id = $1"
+
+// queryRowSeparateRoundTrips runs a query the way RLSPool did before batching
+func queryRowSeparateRoundTrips(ctx context.Context, b *testing.B, rlsPool *RLSPool, orgID string) {
+	conn, err := WithRLSContext(ctx, rlsPool.Pool())
+	if err != nil {
+		b.Fatalf("WithRLSContext failed: %v", err)
+	}
+	defer ReleaseRLSConn(conn)
+
+	var count int
+	if err := conn.QueryRow(ctx, benchQuery, orgID).Scan(&count); err != nil {
+		b.Fatalf("Query failed: %v", err)
+	}
+}
+
+func BenchmarkRLSQueryRow_NoRLS(b *testing.B) {
+	pool := setupRLSTestPool(b)
+	defer pool.Close()
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var count int
+		if err := pool.QueryRow(ctx, benchQuery, testOrgA).Scan(&count); err != nil {
+			b.Fatalf("Query failed: %v", err)
+		}
+	}
+}
+
+func BenchmarkRLSQueryRow_SeparateRoundTrips(b *testing.B) {
+	pool := setupRLSTestPool(b)
+	defer pool.Close()
+	rlsPool := NewRLSPool(pool)
+	ctx := WithOrganizationID(context.Background(), testOrgA)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		queryRowSeparateRoundTrips(ctx, b, rlsPool, testOrgA)
+	}
+}
+
+func BenchmarkRLSQueryRow_Batch(b *testing.B) {
+	pool := setupRLSTestPool(b)
+	defer pool.Close()
+	rlsPool := NewRLSPool(pool)
+	ctx := WithOrganizationID(context.Background(), testOrgA)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var count int
+		if err := rlsPool.QueryRow(ctx, benchQuery, testOrgA).Scan(&count); err != nil {
+			b.Fatalf("Query failed: %v", err)
+		}
+	}
+}
+
+func BenchmarkRLSQuery_Batch(b *testing.B) {
+	pool := setupRLSTestPool(b)
+	defer pool.Close()
+	rlsPool := NewRLSPool(pool)
+	ctx := WithOrganizationID(context.Background(), testOrgA)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rows, err := rlsPool.Query(ctx, "SELECT name FROM cam_files WHERE organization_id = $1 LIMIT 10", testOrgA)
+		if err != nil {
+			b.Fatalf("Query failed: %v", err)
+		}
+		for rows.Next() {
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			b.Fatalf("Rows failed: %v", err)
+		}
+	}
+}
+
+func BenchmarkRLSExec_SeparateRoundTrips(b *testing.B) {
+	pool := setupRLSTestPool(b)
+	defer pool.Close()
+	rlsPool := NewRLSPool(pool)
+	ctx := WithOrganizationID(context.Background(), testOrgA)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		conn, err := WithRLSContext(ctx, rlsPool.Pool())
+		if err != nil {
+			b.Fatalf("WithRLSContext failed: %v", err)
+		}
+		if _, err := conn.Exec(ctx, "UPDATE cam_files SET hour = hour WHERE name = $1", "bench-missing"); err != nil {
+			b.Fatalf("Exec failed: %v", err)
+		}
+		ReleaseRLSConn(conn)
+	}
+}
+
+func BenchmarkRLSExec_Batch(b *testing.B) {
+	pool := setupRLSTestPool(b)
+	defer pool.Close()
+	rlsPool := NewRLSPool(pool)
+	ctx := WithOrganizationID(context.Background(), testOrgA)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := rlsPool.Exec(ctx, "UPDATE cam_files SET hour = hour WHERE name = $1", "bench-missing"); err != nil {
+			b.Fatalf("Exec failed: %v", err)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// statementTimeoutMargin is left between the statement timeout and the context deadline,
+// so Postgres cancels a slow statement itself and the connection stays usable. When the
+// context expires first, pgx has to interrupt the connection instead.
+const statementTimeoutMargin = 100 * time.Millisecond
+
+// minStatementTimeout is the statement timeout of contexts with less time left than the
+// margin; statement_timeout 0 would disable the timeout
+const minStatementTimeout = time.Millisecond
+
+// statementTimeout returns the statement_timeout matching the deadline of ctx, or false
+// if ctx has no deadline
+func statementTimeout(ctx context.Context) (time.Duration, bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0, false
+	}
+	timeout := time.Until(deadline) - statementTimeoutMargin
+	if timeout < minStatementTimeout {
+		timeout = minStatementTimeout
+	}
+	return timeout, true
+}
+
+// formatStatementTimeout formats a timeout as a statement_timeout value in milliseconds
+func formatStatementTimeout(timeout time.Duration) string {
+	return strconv.FormatInt(timeout.Milliseconds(), 10)
+}
+
+// setLocalStatementTimeoutSQL applies a statement timeout until the end of the current transaction
+const setLocalStatementTimeoutSQL = "SELECT set_config('statement_timeout', $1, true)"
+
+// IsQueryCanceled reports whether Postgres canceled the statement (SQLSTATE 57014
+// query_canceled), e.g. because it ran into the statement timeout of its context
+func IsQueryCanceled(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "57014"
+}
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestStatementTimeout(t *testing.T) {
+	if _, ok := statementTimeout(context.Background()); ok {
+		t.Error("context without deadline got a statement timeout")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	timeout, ok := statementTimeout(ctx)
+	if !ok || timeout > 30*time.Second-statementTimeoutMargin || timeout < 29*time.Second {
+		t.Errorf("statementTimeout() = %v, %v, want just under 30s", timeout, ok)
+	}
+
+	// statement_timeout 0 would disable the timeout
+	expired, cancelExpired := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancelExpired()
+	time.Sleep(2 * time.Millisecond)
+	if timeout, _ := statementTimeout(expired); formatStatementTimeout(timeout) == "0" {
+		t.Errorf("statementTimeout() of expired context = %v, formats as 0", timeout)
+	}
+}
+
+func TestLocalSettings(t *testing.T) {
+	ctx, cancel := context.WithTimeout(WithOrganizationID(context.Background(), "org"), 10*time.Second)
+	defer cancel()
+
+	sqls, args := localSettings(ctx)
+	if len(sqls) != 2 || sqls[0] != setLocalRLSSQL || sqls[1] != setLocalStatementTimeoutSQL {
+		t.Fatalf("localSettings() = %v", sqls)
+	}
+	if args[0][0] != "org" {
+		t.Errorf("organization argument = %v", args[0][0])
+	}
+
+	if sqls, _ := localSettings(context.Background()); len(sqls) != 0 {
+		t.Errorf("localSettings() without organization or deadline = %v", sqls)
+	}
+}
+
+func TestIsQueryCanceled(t *testing.T) {
+	if !IsQueryCanceled(fmt.Errorf("list: %w", &pgconn.PgError{Code: "57014"})) {
+		t.Error("57014 not reported as canceled")
+	}
+	if IsQueryCanceled(&pgconn.PgError{Code: "40001"}) || IsQueryCanceled(errors.New("boom")) {
+		t.Error("other error reported as canceled")
+	}
+}
@@ -0,0 +1,213 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/auth"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+// ApiKeyServer implements the gRPC ApiKeyService
+type ApiKeyServer struct {
+	pb.UnimplementedApiKeyServiceServer
+	repo *repository.APIKeyRepository
+}
+
+// NewApiKeyServer creates a new gRPC server
+func NewApiKeyServer(repo *repository.APIKeyRepository) *ApiKeyServer {
+	return &ApiKeyServer{repo: repo}
+}
+
+// CreateApiKey creates an API key and returns the key once
+func (s *ApiKeyServer) CreateApiKey(ctx context.Context, req *pb.CreateApiKeyRequest) (*pb.CreateApiKeyResponse, error) {
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if _, isKey := GetAPIKeyIDFromContext(ctx); isKey {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot create api keys")
+	}
+
+	scopes, err := normalizeAPIKeyScopes(req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keys act without a user behind them, so they are limited to data access roles
+	role := req.Role
+	if role == "" {
+		role = RoleMember
+	}
+	if role != RoleMember && role != RoleViewer {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role for api key: %s", role)
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		if !req.ExpiresAt.IsValid() || !t.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+		}
+		expiresAt = &t
+	}
+
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate api key: %w", err)
+	}
+
+	apiKey, err := s.repo.Create(ctx, req.OrganizationId, req.Name, prefix, hash, scopes, role, userID, expiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	return &pb.CreateApiKeyResponse{
+		ApiKey: toProtoApiKey(apiKey),
+		Key:    key,
+	}, nil
+}
+
+// GetApiKey retrieves an API key by ID
+func (s *ApiKeyServer) GetApiKey(ctx context.Context, req *pb.GetApiKeyRequest) (*pb.GetApiKeyResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	apiKey, err := s.getInOrganization(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetApiKeyResponse{
+		ApiKey: toProtoApiKey(apiKey),
+	}, nil
+}
+
+// ListApiKeys lists the API keys of an organization
+func (s *ApiKeyServer) ListApiKeys(ctx context.Context, req *pb.ListApiKeysRequest) (*pb.ListApiKeysResponse, error) {
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+
+	apiKeys, err := s.repo.ListByOrganization(ctx, req.OrganizationId, req.IncludeRevoked)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+
+	protoKeys := make([]*pb.ApiKey, len(apiKeys))
+	for i, k := range apiKeys {
+		protoKeys[i] = toProtoApiKey(k)
+	}
+
+	return &pb.ListApiKeysResponse{
+		ApiKeys: protoKeys,
+	}, nil
+}
+
+// RevokeApiKey revokes an API key; it stops authenticating immediately
+func (s *ApiKeyServer) RevokeApiKey(ctx context.Context, req *pb.RevokeApiKeyRequest) (*pb.RevokeApiKeyResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if _, err := s.getInOrganization(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Revoke(ctx, req.Id); err != nil {
+		return nil, fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	return &pb.RevokeApiKeyResponse{
+		Success: true,
+	}, nil
+}
+
+// getInOrganization retrieves an API key of the request's organization.
+// api_keys is read across organizations to authenticate keys, so the
+// organization is checked here instead of by RLS.
+func (s *ApiKeyServer) getInOrganization(ctx context.Context, id string) (*repository.APIKey, error) {
+	apiKey, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	if orgID, ok := db.GetOrganizationID(ctx); !ok || apiKey.OrganizationID != orgID {
+		return nil, status.Error(codes.NotFound, "api key not found")
+	}
+
+	return apiKey, nil
+}
+
+// normalizeAPIKeyScopes validates scopes and qualifies bare service names
+// ("DtakologsService" -> "organization.DtakologsService")
+func normalizeAPIKeyScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one scope is required")
+	}
+
+	seen := make(map[string]bool, len(scopes))
+	normalized := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !strings.Contains(scope, ".") {
+			scope = "organization." + scope
+		}
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(scope))
+		if _, isService := desc.(protoreflect.ServiceDescriptor); err != nil || !isService {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown service in scopes: %s", scope)
+		}
+		if !IsAPIKeyScopeAllowed(scope) {
+			return nil, status.Errorf(codes.InvalidArgument, "api keys cannot be granted %s", scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			normalized = append(normalized, scope)
+		}
+	}
+
+	return normalized, nil
+}
+
+// toProtoApiKey converts repository model to proto message
+func toProtoApiKey(k *repository.APIKey) *pb.ApiKey {
+	pbKey := &pb.ApiKey{
+		Id:             k.ID,
+		OrganizationId: k.OrganizationID,
+		Name:           k.Name,
+		Prefix:         k.Prefix,
+		Scopes:         k.Scopes,
+		Role:           k.Role,
+		CreatedBy:      k.CreatedBy,
+		CreatedAt:      timestamppb.New(k.CreatedAt),
+	}
+
+	if k.ExpiresAt != nil {
+		pbKey.ExpiresAt = timestamppb.New(*k.ExpiresAt)
+	}
+	if k.LastUsedAt != nil {
+		pbKey.LastUsedAt = timestamppb.New(*k.LastUsedAt)
+	}
+	if k.RevokedAt != nil {
+		pbKey.RevokedAt = timestamppb.New(*k.RevokedAt)
+	}
+
+	return pbKey
+}
@@ -2,12 +2,13 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -15,12 +16,13 @@ import (
 // AppUserServer implements the gRPC AppUserService
 type AppUserServer struct {
 	pb.UnimplementedAppUserServiceServer
-	repo *repository.AppUserRepository
+	repo       *repository.AppUserRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewAppUserServer creates a new gRPC server
-func NewAppUserServer(repo *repository.AppUserRepository) *AppUserServer {
-	return &AppUserServer{repo: repo}
+func NewAppUserServer(repo *repository.AppUserRepository, pageTokens *pagination.Tokens) *AppUserServer {
+	return &AppUserServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateAppUser creates a new app user
@@ -31,7 +33,7 @@ func (s *AppUserServer) CreateAppUser(ctx context.Context, req *pb.CreateAppUser
 
 	user, err := s.repo.Create(ctx, req.Email, req.DisplayName, req.AvatarUrl, req.IsSuperadmin)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create app user: %v", err)
+		return nil, fmt.Errorf("failed to create app user: %w", err)
 	}
 
 	return &pb.CreateAppUserResponse{
@@ -47,10 +49,7 @@ func (s *AppUserServer) GetAppUser(ctx context.Context, req *pb.GetAppUserReques
 
 	user, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, repository.ErrAppUserNotFound) {
-			return nil, status.Error(codes.NotFound, "app user not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get app user: %v", err)
+		return nil, fmt.Errorf("failed to get app user: %w", err)
 	}
 
 	return &pb.GetAppUserResponse{
@@ -66,10 +65,7 @@ func (s *AppUserServer) GetAppUserByEmail(ctx context.Context, req *pb.GetAppUse
 
 	user, err := s.repo.GetByEmail(ctx, req.Email)
 	if err != nil {
-		if errors.Is(err, repository.ErrAppUserNotFound) {
-			return nil, status.Error(codes.NotFound, "app user not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get app user: %v", err)
+		return nil, fmt.Errorf("failed to get app user: %w", err)
 	}
 
 	return &pb.GetAppUserByEmailResponse{
@@ -86,12 +82,9 @@ func (s *AppUserServer) UpdateAppUser(ctx context.Context, req *pb.UpdateAppUser
 		return nil, status.Error(codes.InvalidArgument, "display_name is required")
 	}
 
-	user, err := s.repo.Update(ctx, req.Id, req.DisplayName, req.AvatarUrl, req.IsSuperadmin)
+	user, err := s.repo.Update(ctx, req.Id, req.DisplayName, req.AvatarUrl, req.IsSuperadmin, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrAppUserNotFound) {
-			return nil, status.Error(codes.NotFound, "app user not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update app user: %v", err)
+		return nil, fmt.Errorf("failed to update app user: %w", err)
 	}
 
 	return &pb.UpdateAppUserResponse{
@@ -105,12 +98,9 @@ func (s *AppUserServer) DeleteAppUser(ctx context.Context, req *pb.DeleteAppUser
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Id)
+	err := s.repo.Delete(ctx, req.Id, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrAppUserNotFound) {
-			return nil, status.Error(codes.NotFound, "app user not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete app user: %v", err)
+		return nil, fmt.Errorf("failed to delete app user: %w", err)
 	}
 
 	return &pb.DeleteAppUserResponse{
@@ -120,27 +110,15 @@ func (s *AppUserServer) DeleteAppUser(ctx context.Context, req *pb.DeleteAppUser
 
 // ListAppUsers retrieves app users with pagination
 func (s *AppUserServer) ListAppUsers(ctx context.Context, req *pb.ListAppUsersRequest) (*pb.ListAppUsersResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	users, err := s.repo.List(ctx, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListAppUsers"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list app users: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(users) > limit {
-		users = users[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	users, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list app users: %w", err)
 	}
 
 	protoUsers := make([]*pb.AppUser, len(users))
@@ -150,7 +128,7 @@ func (s *AppUserServer) ListAppUsers(ctx context.Context, req *pb.ListAppUsersRe
 
 	return &pb.ListAppUsersResponse{
 		AppUsers:      protoUsers,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -164,6 +142,7 @@ func toProtoAppUser(user *repository.AppUser) *pb.AppUser {
 		IsSuperadmin: user.IsSuperadmin,
 		CreatedAt:    timestamppb.New(user.CreatedAt),
 		UpdatedAt:    timestamppb.New(user.UpdatedAt),
+		Etag:         user.Etag,
 	}
 	if user.DeletedAt != nil {
 		proto.DeletedAt = timestamppb.New(*user.DeletedAt)
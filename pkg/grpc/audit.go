@@ -0,0 +1,399 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+// AuditLogWriter is the subset of AuditLogRepository used by the audit interceptor
+type AuditLogWriter interface {
+	Create(ctx context.Context, e *repository.AuditEvent) error
+}
+
+// auditedMethodPrefixes are the method name prefixes of mutating RPCs
+var auditedMethodPrefixes = []string{"Create", "Update", "Delete", "BulkCreate", "Bulk"}
+
+// auditWriteTimeout bounds the audit insert after the handler returned
+const auditWriteTimeout = 5 * time.Second
+
+// auditedEntity returns the entity a mutating method acts on
+// ("/organization.CarInspectionService/UpdateCarInspection" -> "CarInspection"),
+// or "" if the method is not audited
+func auditedEntity(fullMethod string) string {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range auditedMethodPrefixes {
+		if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+			return name[len(prefix):]
+		}
+	}
+	return ""
+}
+
+// AuditUnaryInterceptor writes an audit_log entry for every Create/Update/Delete/Bulk RPC.
+// It runs after the RLS interceptor so the organization is known, and before the
+// authorization interceptor so denied attempts are recorded too.
+// A failed audit write is logged and does not fail the request.
+func AuditUnaryInterceptor(audit AuditLogWriter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		entity := auditedEntity(info.FullMethod)
+		if entity == "" {
+			return handler(ctx, req)
+		}
+
+		// An Update without a mask is recorded as its difference to the stored row,
+		// which has to be read before the handler writes it
+		var stored protoreflect.Message
+		if reqMsg, ok := req.(proto.Message); ok && isUpdateMethod(info.FullMethod) && len(updateMask(reqMsg.ProtoReflect())) == 0 {
+			stored = storedEntity(ctx, info.Server, entity, reqMsg)
+		}
+
+		resp, err := handler(ctx, req)
+
+		event := newAuditEvent(ctx, info.FullMethod, entity, req, resp, stored, err)
+		writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auditWriteTimeout)
+		defer cancel()
+		if werr := audit.Create(writeCtx, event); werr != nil {
+			log.Printf("Failed to write audit event for %s: %v", info.FullMethod, werr)
+		}
+
+		return resp, err
+	}
+}
+
+// isUpdateMethod reports whether a method updates an existing entity
+func isUpdateMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod[strings.LastIndex(fullMethod, "/")+1:], "Update")
+}
+
+// newAuditEvent describes a completed mutating call. stored is the entity an Update
+// request targets as it was before the call, if it could be read.
+func newAuditEvent(ctx context.Context, fullMethod, entity string, req, resp interface{}, stored protoreflect.Message, err error) *repository.AuditEvent {
+	// Handler errors are converted by the error interceptor further out; record the
+	// code the client will see
+	st, _ := errorStatus(err)
+	event := &repository.AuditEvent{
+		Method:     fullMethod,
+		EntityType: entity,
+		StatusCode: st.Code().String(),
+	}
+
+	if orgID, ok := db.GetOrganizationID(ctx); ok && orgID != "" {
+		event.OrganizationID = &orgID
+	}
+	if keyID, ok := GetAPIKeyIDFromContext(ctx); ok {
+		event.APIKeyID = &keyID
+	}
+	if userID, ok := GetUserIDFromContext(ctx); ok {
+		event.UserID = &userID
+	}
+
+	reqMsg, _ := req.(proto.Message)
+	respMsg, _ := resp.(proto.Message)
+	if reqMsg != nil {
+		if entityID := auditEntityID(fullMethod, entity, reqMsg, respMsg); entityID != "" {
+			event.EntityID = &entityID
+		}
+		fields := requestFields(reqMsg.ProtoReflect())
+		if isUpdateMethod(fullMethod) {
+			fields = updatedFields(reqMsg.ProtoReflect(), stored, auditKeyFields(fullMethod, entity))
+		}
+		if b, err := json.Marshal(fields); err == nil {
+			event.Request = b
+		}
+	}
+
+	return event
+}
+
+// auditKeyOverrides lists primary key fields that cannot be derived from the Delete request
+var auditKeyOverrides = map[string][]string{
+	"File": {"uuid"}, // DeleteFileRequest also carries the deletion timestamp
+}
+
+// auditKeyFields returns the primary key fields of an entity. The Delete<Entity>Request of the
+// service names exactly the key (plus the organization and the etag), so it is used as the source of truth.
+// Entities without a Delete RPC fall back to "id".
+func auditKeyFields(fullMethod, entity string) []string {
+	if fields, ok := auditKeyOverrides[entity]; ok {
+		return fields
+	}
+
+	pkg := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(pkg, "."); i >= 0 {
+		pkg = pkg[:i]
+	}
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(pkg + ".Delete" + entity + "Request"))
+	if md, ok := desc.(protoreflect.MessageDescriptor); err == nil && ok {
+		var fields []string
+		for i := 0; i < md.Fields().Len(); i++ {
+			if name := string(md.Fields().Get(i).Name()); name != "organization_id" && name != "etag" {
+				fields = append(fields, name)
+			}
+		}
+		if len(fields) > 0 {
+			return fields
+		}
+	}
+
+	return []string{"id"}
+}
+
+// auditEntityID extracts the primary key of the affected row. It is read from the request,
+// or from the entity in the response when the server generated it (e.g. on Create).
+// Composite keys are encoded as "k1=v1&k2=v2".
+func auditEntityID(fullMethod, entity string, req, resp proto.Message) string {
+	fields := auditKeyFields(fullMethod, entity)
+
+	candidates := []protoreflect.Message{req.ProtoReflect()}
+	if resp != nil {
+		candidates = append(candidates, nestedMessages(resp.ProtoReflect())...)
+	}
+	candidates = append(candidates, nestedMessages(req.ProtoReflect())...)
+
+	for _, m := range candidates {
+		if values, ok := keyValues(m, fields); ok {
+			if len(fields) == 1 {
+				return values.Get(fields[0])
+			}
+			return values.Encode()
+		}
+	}
+	return ""
+}
+
+// nestedMessages returns the populated singular message fields of m (the entity of a response)
+func nestedMessages(m protoreflect.Message) []protoreflect.Message {
+	var nested []protoreflect.Message
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+			nested = append(nested, v.Message())
+		}
+		return true
+	})
+	return nested
+}
+
+// keyValues reads fields from m; ok is false unless every field is present and non-empty
+func keyValues(m protoreflect.Message, fields []string) (url.Values, bool) {
+	values := url.Values{}
+	for _, name := range fields {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil || fd.IsList() || fd.IsMap() || fd.Kind() == protoreflect.MessageKind || !m.Has(fd) {
+			return nil, false
+		}
+		v := fmt.Sprint(m.Get(fd).Interface())
+		if v == "" {
+			return nil, false
+		}
+		values.Set(name, v)
+	}
+	return values, true
+}
+
+// redactedValue replaces credentials in the audit copy of a request
+const redactedValue = "[REDACTED]"
+
+// credentialFields lists, per message, the fields that hold credentials and must not be logged
+var credentialFields = map[protoreflect.FullName]map[protoreflect.Name]bool{
+	messageName(&pb.AuthWithGoogleRequest{}):       {"code": true, "code_verifier": true},
+	messageName(&pb.AuthWithLineRequest{}):         {"code": true, "code_verifier": true},
+	messageName(&pb.LinkAccountRequest{}):          {"code": true, "code_verifier": true},
+	messageName(&pb.RefreshTokenRequest{}):         {"refresh_token": true},
+	messageName(&pb.LogoutRequest{}):               {"refresh_token": true},
+	messageName(&pb.ListSessionsRequest{}):         {"refresh_token": true},
+	messageName(&pb.ValidateTokenRequest{}):        {"access_token": true},
+	messageName(&pb.AuthResponse{}):                {"access_token": true, "refresh_token": true},
+	messageName(&pb.SwitchOrganizationResponse{}):  {"access_token": true},
+	messageName(&pb.Invitation{}):                  {"token": true},
+	messageName(&pb.GetInvitationByTokenRequest{}): {"token": true},
+	messageName(&pb.AcceptInvitationRequest{}):     {"token": true},
+	messageName(&pb.CreateApiKeyResponse{}):        {"key": true},
+}
+
+func messageName(m proto.Message) protoreflect.FullName {
+	return m.ProtoReflect().Descriptor().FullName()
+}
+
+// isCredentialField reports whether fd holds a credential that must not be logged
+func isCredentialField(fd protoreflect.FieldDescriptor) bool {
+	return credentialFields[fd.ContainingMessage().FullName()][fd.Name()]
+}
+
+// requestFields converts the populated fields of a request to JSON-friendly values.
+// Credentials are redacted and binary content is reduced to its size.
+func requestFields(m protoreflect.Message) map[string]interface{} {
+	out := make(map[string]interface{})
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		out[string(fd.Name())] = auditValue(fd, v)
+		return true
+	})
+	return out
+}
+
+// updatedFields converts the fields an Update request writes to JSON-friendly values:
+// the paths of its update_mask, or without a mask the fields it sets that differ from
+// the stored entity (every field it sets if stored is nil). The key fields, the mask
+// and the etag are left out; credentials are redacted as in requestFields.
+func updatedFields(req, stored protoreflect.Message, key []string) map[string]interface{} {
+	skip := map[string]bool{"organization_id": true, "update_mask": true, "etag": true}
+	for _, name := range key {
+		skip[name] = true
+	}
+
+	out := make(map[string]interface{})
+	if paths := updateMask(req); len(paths) > 0 {
+		fields := req.Descriptor().Fields()
+		for _, path := range paths {
+			if fd := fields.ByName(protoreflect.Name(path)); fd != nil && !skip[path] {
+				out[path] = messageValue(req, fd)
+			}
+		}
+		return out
+	}
+
+	for name, v := range requestFields(req) {
+		if skip[name] {
+			continue
+		}
+		if stored != nil {
+			if fd := stored.Descriptor().Fields().ByName(protoreflect.Name(name)); fd != nil && reflect.DeepEqual(messageValue(stored, fd), v) {
+				continue
+			}
+		}
+		out[name] = v
+	}
+	return out
+}
+
+// updateMask returns the paths of the update_mask of an Update request, or nil if it
+// has none or it selects every field ("*")
+func updateMask(req protoreflect.Message) []string {
+	fd := req.Descriptor().Fields().ByName("update_mask")
+	if fd == nil || fd.Kind() != protoreflect.MessageKind || !req.Has(fd) {
+		return nil
+	}
+	mask, ok := req.Get(fd).Message().Interface().(*fieldmaskpb.FieldMask)
+	if !ok || len(mask.GetPaths()) == 1 && mask.GetPaths()[0] == "*" {
+		return nil
+	}
+	return mask.GetPaths()
+}
+
+// storedEntity reads the entity an Update request targets with the Get<Entity> RPC of
+// the same service, or returns nil if it cannot. The Get request is filled from the
+// fields of the Update request with the same names (the key). The read runs under the
+// caller's organization but ahead of authorization; it only ends up in the audit log.
+func storedEntity(ctx context.Context, server interface{}, entity string, req proto.Message) protoreflect.Message {
+	if server == nil {
+		return nil
+	}
+	get := reflect.ValueOf(server).MethodByName("Get" + entity)
+	if !get.IsValid() || get.Type().NumIn() != 2 || get.Type().NumOut() != 2 || get.Type().In(1).Kind() != reflect.Pointer {
+		return nil
+	}
+	getReq, ok := reflect.New(get.Type().In(1).Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	src, dst := req.ProtoReflect(), getReq.ProtoReflect()
+	fields := dst.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		srcFD := src.Descriptor().Fields().ByName(fd.Name())
+		if srcFD == nil || srcFD.Kind() != fd.Kind() || fd.Kind() == protoreflect.MessageKind || srcFD.Cardinality() != fd.Cardinality() {
+			return nil
+		}
+		if src.Has(srcFD) {
+			dst.Set(fd, src.Get(srcFD))
+		}
+	}
+
+	out := get.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(getReq)})
+	if err, _ := out[1].Interface().(error); err != nil {
+		return nil
+	}
+	resp, ok := out[0].Interface().(proto.Message)
+	if !ok || reflect.ValueOf(resp).IsNil() {
+		return nil
+	}
+	if nested := nestedMessages(resp.ProtoReflect()); len(nested) == 1 {
+		return nested[0]
+	}
+	return nil
+}
+
+// messageValue converts field fd of m to a JSON-friendly value, nil if it tracks
+// presence and is unset
+func messageValue(m protoreflect.Message, fd protoreflect.FieldDescriptor) interface{} {
+	if fd.HasPresence() && !m.Has(fd) {
+		return nil
+	}
+	return auditValue(fd, m.Get(fd))
+}
+
+// auditValue converts a field value to a JSON-friendly value, redacting credentials
+func auditValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch {
+	case isCredentialField(fd):
+		return redactedValue
+	case fd.IsList():
+		list := v.List()
+		items := make([]interface{}, list.Len())
+		for i := range items {
+			items[i] = fieldValue(fd, list.Get(i))
+		}
+		return items
+	case fd.IsMap():
+		entries := make(map[string]interface{})
+		v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+			entries[k.String()] = fieldValue(fd.MapValue(), mv)
+			return true
+		})
+		return entries
+	default:
+		return fieldValue(fd, v)
+	}
+}
+
+// fieldValue converts a singular field value to a JSON-friendly value
+func fieldValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if ts, ok := v.Message().Interface().(*timestamppb.Timestamp); ok {
+			return ts.AsTime().Format(time.RFC3339Nano)
+		}
+		return requestFields(v.Message())
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("[%d bytes]", len(v.Bytes()))
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return int32(v.Enum())
+	default:
+		return v.Interface()
+	}
+}
@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+// AuditLogServer implements the gRPC AuditLogService
+type AuditLogServer struct {
+	pb.UnimplementedAuditLogServiceServer
+	repo       *repository.AuditLogRepository
+	pageTokens *pagination.Tokens
+}
+
+// NewAuditLogServer creates a new gRPC server
+func NewAuditLogServer(repo *repository.AuditLogRepository, pageTokens *pagination.Tokens) *AuditLogServer {
+	return &AuditLogServer{repo: repo, pageTokens: pageTokens}
+}
+
+// ListAuditEvents lists audit events of an organization
+func (s *AuditLogServer) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+
+	params := repository.AuditEventListParams{
+		OrganizationID: req.OrganizationId,
+		UserID:         req.UserId,
+		Method:         req.Method,
+		EntityType:     req.EntityType,
+		EntityID:       req.EntityId,
+	}
+	if req.StartTime != nil {
+		t := req.StartTime.AsTime()
+		params.StartTime = &t
+	}
+	if req.EndTime != nil {
+		t := req.EndTime.AsTime()
+		params.EndTime = &t
+	}
+	if params.StartTime != nil && params.EndTime != nil && !params.EndTime.After(*params.StartTime) {
+		return nil, status.Error(codes.InvalidArgument, "end_time must be after start_time")
+	}
+
+	scope := []string{"ListAuditEvents", req.OrganizationId, req.UserId, req.Method, req.EntityType, req.EntityId,
+		scopeTime(params.StartTime), scopeTime(params.EndTime)}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
+	if err != nil {
+		return nil, err
+	}
+	params.Page = pagination.Page{Size: int(req.PageSize), After: after}
+
+	events, next, err := s.repo.List(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	protoEvents := make([]*pb.AuditEvent, len(events))
+	for i, e := range events {
+		protoEvents[i] = toProtoAuditEvent(e)
+	}
+
+	return &pb.ListAuditEventsResponse{
+		Events:        protoEvents,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
+	}, nil
+}
+
+// scopeTime formats an optional time filter for a page token scope
+func scopeTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// toProtoAuditEvent converts repository model to proto message
+func toProtoAuditEvent(e *repository.AuditEvent) *pb.AuditEvent {
+	return &pb.AuditEvent{
+		Id:             e.ID,
+		OrganizationId: e.OrganizationID,
+		UserId:         e.UserID,
+		ApiKeyId:       e.APIKeyID,
+		Method:         e.Method,
+		EntityType:     e.EntityType,
+		EntityId:       e.EntityID,
+		StatusCode:     e.StatusCode,
+		RequestJson:    string(e.Request),
+		CreatedAt:      timestamppb.New(e.CreatedAt),
+	}
+}
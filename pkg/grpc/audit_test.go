@@ -0,0 +1,218 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+// fakeAuditLog implements AuditLogWriter for testing
+type fakeAuditLog struct {
+	events []*repository.AuditEvent
+	err    error
+}
+
+func (f *fakeAuditLog) Create(ctx context.Context, e *repository.AuditEvent) error {
+	f.events = append(f.events, e)
+	return f.err
+}
+
+func TestAuditedEntity(t *testing.T) {
+	tests := map[string]string{
+		pb.CarInspectionService_UpdateCarInspection_FullMethodName:          "CarInspection",
+		pb.ETCMeisaiService_BulkCreateETCMeisai_FullMethodName:              "ETCMeisai",
+		pb.CarInspectionFilesService_CreateCarInspectionFile_FullMethodName: "CarInspectionFile",
+		pb.ETCMeisaiService_ListETCMeisai_FullMethodName:                    "",
+		pb.AuthService_LinkAccount_FullMethodName:                           "",
+	}
+	for method, want := range tests {
+		if got := auditedEntity(method); got != want {
+			t.Errorf("auditedEntity(%q) = %q, want %q", method, got, want)
+		}
+	}
+}
+
+func TestAuditUnaryInterceptor(t *testing.T) {
+	const orgID = "11111111-1111-1111-1111-111111111111"
+
+	ctx := db.WithOrganizationID(context.WithValue(context.Background(), UserIDKey, "user-a"), orgID)
+	call := func(audit *fakeAuditLog, method string, req, resp interface{}, handlerErr error) error {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) { return resp, handlerErr }
+		_, err := AuditUnaryInterceptor(audit)(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	t.Run("composite key from request", func(t *testing.T) {
+		audit := &fakeAuditLog{}
+		req := &pb.DeleteCarInspectionRequest{OrganizationId: orgID, ElectCertMgNo: "M-1", ElectCertPublishdateE: "R"}
+		if err := call(audit, pb.CarInspectionService_DeleteCarInspection_FullMethodName, req, &pb.DeleteCarInspectionResponse{}, nil); err != nil {
+			t.Fatalf("err = %v", err)
+		}
+		if len(audit.events) != 1 {
+			t.Fatalf("got %d events, want 1", len(audit.events))
+		}
+		e := audit.events[0]
+		if e.UserID == nil || *e.UserID != "user-a" || e.OrganizationID == nil || *e.OrganizationID != orgID {
+			t.Errorf("user/org = %v/%v", e.UserID, e.OrganizationID)
+		}
+		if e.EntityType != "CarInspection" || e.StatusCode != "OK" {
+			t.Errorf("entity/status = %q/%q", e.EntityType, e.StatusCode)
+		}
+		// The key fields come from DeleteCarInspectionRequest; unset ones make the key incomplete
+		if e.EntityID != nil {
+			t.Errorf("EntityID = %q, want nil for a partially specified key", *e.EntityID)
+		}
+	})
+
+	t.Run("generated id from response", func(t *testing.T) {
+		audit := &fakeAuditLog{}
+		req := &pb.CreateInvitationRequest{OrganizationId: orgID, Email: "new@example.com"}
+		resp := &pb.CreateInvitationResponse{Invitation: &pb.Invitation{Id: "inv-1", Token: "secret-token"}}
+		if err := call(audit, pb.InvitationService_CreateInvitation_FullMethodName, req, resp, nil); err != nil {
+			t.Fatalf("err = %v", err)
+		}
+		if e := audit.events[0]; e.EntityID == nil || *e.EntityID != "inv-1" {
+			t.Errorf("EntityID = %v, want inv-1", e.EntityID)
+		}
+	})
+
+	t.Run("failed call is recorded with its code", func(t *testing.T) {
+		audit := &fakeAuditLog{}
+		denied := status.Error(codes.PermissionDenied, "denied")
+		req := &pb.DeleteKudgfryRequest{Uuid: "k-1"}
+		if err := call(audit, pb.KudgfryService_DeleteKudgfry_FullMethodName, req, nil, denied); status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("err = %v, want the handler error", err)
+		}
+		e := audit.events[0]
+		if e.StatusCode != "PermissionDenied" || e.EntityID == nil || *e.EntityID != "k-1" {
+			t.Errorf("status/entity = %q/%v", e.StatusCode, e.EntityID)
+		}
+	})
+
+	t.Run("read calls are not recorded", func(t *testing.T) {
+		audit := &fakeAuditLog{}
+		call(audit, pb.KudgfryService_GetKudgfry_FullMethodName, &pb.GetKudgfryRequest{}, nil, nil)
+		if len(audit.events) != 0 {
+			t.Errorf("got %d events, want 0", len(audit.events))
+		}
+	})
+
+	t.Run("audit failure does not fail the call", func(t *testing.T) {
+		audit := &fakeAuditLog{err: errors.New("db down")}
+		if err := call(audit, pb.KudgfryService_DeleteKudgfry_FullMethodName, &pb.DeleteKudgfryRequest{Uuid: "k-1"}, &pb.DeleteKudgfryResponse{}, nil); err != nil {
+			t.Errorf("err = %v, want nil", err)
+		}
+	})
+}
+
+func TestRequestFields(t *testing.T) {
+	verifier := "v"
+	req := &pb.LinkAccountRequest{Provider: "google", Code: "auth-code", CodeVerifier: &verifier}
+	b, err := json.Marshal(requestFields(req.ProtoReflect()))
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	want := `{"code":"[REDACTED]","code_verifier":"[REDACTED]","provider":"google"}`
+	if string(b) != want {
+		t.Errorf("redacted = %s, want %s", b, want)
+	}
+
+	// Fields named like credentials are kept outside the messages that carry credentials
+	photo := &pb.CreateFlickrPhotoRequest{Id: "p-1", Secret: "url-secret"}
+	if fields := requestFields(photo.ProtoReflect()); fields["secret"] != "url-secret" {
+		t.Errorf("fields = %v, want secret kept", fields)
+	}
+
+	// Unset fields are left out, so an update only shows the fields it sets
+	update := &pb.UpdateCamFileRequest{Name: "a.mp4", Type: "front"}
+	fields := requestFields(update.ProtoReflect())
+	if len(fields) != 2 || fields["type"] != "front" {
+		t.Errorf("fields = %v, want only name and type", fields)
+	}
+}
+
+// fakeKudgfryServer serves GetKudgfry from stored, the service whose Update the audit diffs
+type fakeKudgfryServer struct {
+	stored *pb.Kudgfry
+	gets   int
+}
+
+func (s *fakeKudgfryServer) GetKudgfry(ctx context.Context, req *pb.GetKudgfryRequest) (*pb.GetKudgfryResponse, error) {
+	s.gets++
+	if req.Uuid != s.stored.Uuid {
+		return nil, status.Error(codes.NotFound, "kudgfry not found")
+	}
+	return &pb.GetKudgfryResponse{Kudgfry: s.stored}, nil
+}
+
+func TestAuditUnaryInterceptor_UpdateDiff(t *testing.T) {
+	oldNo, newNo, date := "1", "2", "2026-01-01"
+	server := &fakeKudgfryServer{stored: &pb.Kudgfry{Uuid: "k-1", Hash: "h", TargetDriverType: "a", UnkouNo: &oldNo, UnkouDate: &date}}
+
+	call := func(req *pb.UpdateKudgfryRequest) map[string]interface{} {
+		audit := &fakeAuditLog{}
+		info := &grpc.UnaryServerInfo{FullMethod: pb.KudgfryService_UpdateKudgfry_FullMethodName, Server: server}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &pb.UpdateKudgfryResponse{}, nil
+		}
+		if _, err := AuditUnaryInterceptor(audit)(context.Background(), req, info, handler); err != nil {
+			t.Fatalf("err = %v", err)
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(audit.events[0].Request, &fields); err != nil {
+			t.Fatalf("Unmarshal: %v", err)
+		}
+		return fields
+	}
+
+	// Only the fields that differ from the stored row; the key and etag are in EntityID and left out
+	fields := call(&pb.UpdateKudgfryRequest{Uuid: "k-1", Hash: "h", TargetDriverType: "a", UnkouNo: &newNo, UnkouDate: &date, Etag: "7"})
+	if len(fields) != 1 || fields["unkou_no"] != "2" {
+		t.Errorf("fields = %v, want only unkou_no", fields)
+	}
+
+	// Without the stored row every field the request sets is recorded
+	fields = call(&pb.UpdateKudgfryRequest{Uuid: "k-2", Hash: "h", UnkouNo: &newNo})
+	if len(fields) != 2 || fields["hash"] != "h" || fields["unkou_no"] != "2" {
+		t.Errorf("fields = %v, want hash and unkou_no", fields)
+	}
+	if server.gets != 2 {
+		t.Errorf("stored row read %d times, want once per update", server.gets)
+	}
+}
+
+func TestUpdatedFields_Mask(t *testing.T) {
+	vehicle := "V-1"
+	req := &pb.UpdateKudgivtRequest{
+		Uuid:       "k-1",
+		Hash:       "h",
+		VehicleCd:  &vehicle,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"vehicle_cd", "unkou_no"}},
+	}
+	if paths := updateMask(req.ProtoReflect()); len(paths) != 2 {
+		t.Fatalf("updateMask = %v, want 2 paths", paths)
+	}
+
+	// The masked fields as written (unkou_no is cleared), nothing else the request carries
+	fields := updatedFields(req.ProtoReflect(), nil, []string{"uuid"})
+	want := map[string]interface{}{"vehicle_cd": "V-1", "unkou_no": nil}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("fields = %v, want %v", fields, want)
+	}
+
+	all := &pb.UpdateKudgivtRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"*"}}}
+	if paths := updateMask(all.ProtoReflect()); paths != nil {
+		t.Errorf("updateMask(*) = %v, want nil", paths)
+	}
+}
@@ -3,9 +3,13 @@ package grpc
 import (
 	"context"
 	"errors"
-	"time"
+	"fmt"
+	"net"
+	"strings"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -17,11 +21,13 @@ import (
 // AuthServer implements the gRPC AuthService
 type AuthServer struct {
 	pb.UnimplementedAuthServiceServer
-	appUserRepo    *repository.AppUserRepository
-	oauthRepo      *repository.OAuthAccountRepository
-	jwtService     *auth.JWTService
-	googleClient   *auth.GoogleOAuthClient
-	lineClient     *auth.LineOAuthClient
+	appUserRepo  *repository.AppUserRepository
+	oauthRepo    *repository.OAuthAccountRepository
+	jwtService   *auth.JWTService
+	sessions     *auth.SessionService
+	identities   *auth.IdentityService
+	googleClient *auth.GoogleOAuthClient
+	lineClient   *auth.LineOAuthClient
 }
 
 // NewAuthServer creates a new AuthServer
@@ -29,6 +35,8 @@ func NewAuthServer(
 	appUserRepo *repository.AppUserRepository,
 	oauthRepo *repository.OAuthAccountRepository,
 	jwtService *auth.JWTService,
+	sessions *auth.SessionService,
+	identities *auth.IdentityService,
 	googleClient *auth.GoogleOAuthClient,
 	lineClient *auth.LineOAuthClient,
 ) *AuthServer {
@@ -36,6 +44,8 @@ func NewAuthServer(
 		appUserRepo:  appUserRepo,
 		oauthRepo:    oauthRepo,
 		jwtService:   jwtService,
+		sessions:     sessions,
+		identities:   identities,
 		googleClient: googleClient,
 		lineClient:   lineClient,
 	}
@@ -47,94 +57,255 @@ func (s *AuthServer) AuthWithGoogle(ctx context.Context, req *pb.AuthWithGoogleR
 		return nil, status.Error(codes.InvalidArgument, "code is required")
 	}
 
-	// Exchange authorization code for tokens
-	tokenResp, err := s.googleClient.ExchangeCode(ctx, req.Code)
-	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "failed to exchange code: %v", err)
+	return s.authWithProvider(ctx, "google", req.Code, req.GetCodeVerifier())
+}
+
+// AuthWithLine authenticates a user with LINE OAuth
+func (s *AuthServer) AuthWithLine(ctx context.Context, req *pb.AuthWithLineRequest) (*pb.AuthResponse, error) {
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
 	}
 
-	// Get user info from Google
-	userInfo, err := s.googleClient.GetUserInfo(ctx, tokenResp.AccessToken)
+	return s.authWithProvider(ctx, "line", req.Code, req.GetCodeVerifier())
+}
+
+// authWithProvider signs in the user an authorization code belongs to
+func (s *AuthServer) authWithProvider(ctx context.Context, provider, code, codeVerifier string) (*pb.AuthResponse, error) {
+	identity, err := s.fetchIdentity(ctx, provider, code, codeVerifier)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user info: %v", err)
+		return nil, err
 	}
 
 	// Find or create user
-	user, err := s.findOrCreateUser(ctx, "google", userInfo.ID, &userInfo.Email, userInfo.Name, &userInfo.Picture, tokenResp.AccessToken, tokenResp.RefreshToken, tokenResp.ExpiresIn)
+	user, err := s.identities.Login(ctx, identity)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to find or create user: %v", err)
+		return nil, fmt.Errorf("failed to find or create user: %w", err)
 	}
 
 	// Generate JWT tokens
-	return s.generateAuthResponse(user)
+	return s.generateAuthResponse(ctx, user)
 }
 
-// AuthWithLine authenticates a user with LINE OAuth
-func (s *AuthServer) AuthWithLine(ctx context.Context, req *pb.AuthWithLineRequest) (*pb.AuthResponse, error) {
-	if req.Code == "" {
-		return nil, status.Error(codes.InvalidArgument, "code is required")
+// fetchIdentity exchanges an authorization code for the provider identity it belongs to
+func (s *AuthServer) fetchIdentity(ctx context.Context, provider, code, codeVerifier string) (*auth.ProviderIdentity, error) {
+	var identity *auth.ProviderIdentity
+	var err error
+	switch provider {
+	case "google":
+		identity, err = s.googleClient.FetchIdentity(ctx, code, codeVerifier)
+	case "line":
+		identity, err = s.lineClient.FetchIdentity(ctx, code, codeVerifier)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported provider: %s", provider)
 	}
-
-	// Exchange authorization code for tokens
-	tokenResp, err := s.lineClient.ExchangeCode(ctx, req.Code)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "failed to exchange code: %v", err)
+		if errors.Is(err, auth.ErrCodeExchangeFailed) {
+			return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
+	return identity, nil
+}
 
-	// Get user info from LINE
-	userInfo, err := s.lineClient.GetUserInfo(ctx, tokenResp.AccessToken)
+// RefreshToken rotates a refresh token and issues a new token pair.
+// Reusing a rotated refresh token revokes every token in its session.
+func (s *AuthServer) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.AuthResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	tokenPair, user, err := s.sessions.Refresh(ctx, req.RefreshToken, clientInfoFromContext(ctx))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user info: %v", err)
+		switch {
+		case errors.Is(err, auth.ErrExpiredToken):
+			return nil, status.Error(codes.Unauthenticated, "refresh token has expired")
+		case errors.Is(err, auth.ErrRefreshTokenReused):
+			return nil, status.Error(codes.Unauthenticated, "refresh token reuse detected; session revoked")
+		case errors.Is(err, auth.ErrSessionRevoked):
+			return nil, status.Error(codes.Unauthenticated, "session has been revoked")
+		case errors.Is(err, auth.ErrInvalidToken):
+			return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+		case errors.Is(err, repository.ErrAppUserNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+
+	return toProtoAuthResponse(tokenPair, user), nil
+}
+
+// Logout revokes the session the refresh token belongs to
+func (s *AuthServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
 	}
 
-	// Try to get email from ID token if available
-	var email *string
-	if tokenResp.IDToken != "" {
-		if payload, err := s.lineClient.VerifyIDToken(ctx, tokenResp.IDToken); err == nil && payload.Email != "" {
-			email = &payload.Email
+	if err := s.sessions.Logout(ctx, req.RefreshToken); err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) {
+			return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
 		}
+		return nil, fmt.Errorf("failed to logout: %w", err)
 	}
 
-	// Find or create user
-	var pictureURL *string
-	if userInfo.PictureURL != "" {
-		pictureURL = &userInfo.PictureURL
+	return &pb.LogoutResponse{Success: true}, nil
+}
+
+// RevokeAllSessions revokes every session of the authenticated user
+func (s *AuthServer) RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSessionsRequest) (*pb.RevokeAllSessionsResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
 	}
-	user, err := s.findOrCreateUser(ctx, "line", userInfo.UserID, email, userInfo.DisplayName, pictureURL, tokenResp.AccessToken, tokenResp.RefreshToken, tokenResp.ExpiresIn)
+
+	count, err := s.sessions.RevokeAll(ctx, userID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to find or create user: %v", err)
+		return nil, fmt.Errorf("failed to revoke sessions: %w", err)
 	}
 
-	// Generate JWT tokens
-	return s.generateAuthResponse(user)
+	return &pb.RevokeAllSessionsResponse{RevokedCount: int32(count)}, nil
 }
 
-// RefreshToken refreshes an access token
-func (s *AuthServer) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.AuthResponse, error) {
-	if req.RefreshToken == "" {
-		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
+// ListSessions lists the active sessions of the authenticated user
+func (s *AuthServer) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
 	}
 
-	// Validate refresh token
-	userID, err := s.jwtService.ValidateRefreshToken(req.RefreshToken)
+	tokens, err := s.sessions.ListSessions(ctx, userID)
 	if err != nil {
-		if errors.Is(err, auth.ErrExpiredToken) {
-			return nil, status.Error(codes.Unauthenticated, "refresh token has expired")
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	// Identify the caller's own session if they sent their refresh token
+	var currentID string
+	if req.RefreshToken != "" {
+		currentID, _ = s.sessions.SessionID(ctx, req.RefreshToken)
+	}
+
+	sessions := make([]*pb.Session, len(tokens))
+	for i, t := range tokens {
+		sessions[i] = &pb.Session{
+			Id:              t.FamilyID,
+			UserAgent:       t.UserAgent,
+			IpAddress:       t.IPAddress,
+			LastRefreshedAt: timestamppb.New(t.IssuedAt),
+			ExpiresAt:       timestamppb.New(t.ExpiresAt),
+			Current:         t.FamilyID == currentID,
 		}
-		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
 	}
 
-	// Get user
-	user, err := s.appUserRepo.GetByID(ctx, userID)
+	return &pb.ListSessionsResponse{Sessions: sessions}, nil
+}
+
+// SwitchOrganization issues an access token bound to another organization of the authenticated user
+func (s *AuthServer) SwitchOrganization(ctx context.Context, req *pb.SwitchOrganizationRequest) (*pb.SwitchOrganizationResponse, error) {
+	if req.OrganizationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	sessionID, _ := GetSessionIDFromContext(ctx)
+
+	accessToken, scope, err := s.sessions.SwitchOrganization(ctx, userID, sessionID, req.OrganizationId)
 	if err != nil {
-		if errors.Is(err, repository.ErrAppUserNotFound) {
+		switch {
+		case errors.Is(err, auth.ErrNotOrganizationMember):
+			return nil, status.Error(codes.PermissionDenied, "not a member of this organization")
+		case errors.Is(err, auth.ErrSessionRevoked):
+			return nil, status.Error(codes.Unauthenticated, "session has been revoked")
+		case errors.Is(err, repository.ErrAppUserNotFound):
 			return nil, status.Error(codes.NotFound, "user not found")
 		}
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		return nil, fmt.Errorf("failed to switch organization: %w", err)
 	}
 
-	// Generate new JWT tokens
-	return s.generateAuthResponse(user)
+	return &pb.SwitchOrganizationResponse{
+		AccessToken:    accessToken,
+		ExpiresIn:      int64(s.jwtService.AccessExpiry().Seconds()),
+		OrganizationId: scope.OrganizationID,
+		Role:           scope.Role,
+	}, nil
+}
+
+// LinkAccount attaches the provider identity of an authorization code to the authenticated user
+func (s *AuthServer) LinkAccount(ctx context.Context, req *pb.LinkAccountRequest) (*pb.LinkAccountResponse, error) {
+	if req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	identity, err := s.fetchIdentity(ctx, req.Provider, req.Code, req.GetCodeVerifier())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.identities.Link(ctx, userID, identity)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrIdentityLinkedToOtherUser):
+			return nil, status.Error(codes.AlreadyExists, "this account is already linked to another user")
+		case errors.Is(err, auth.ErrProviderAlreadyLinked):
+			return nil, status.Errorf(codes.FailedPrecondition, "another %s account is already linked; unlink it first", req.Provider)
+		}
+		return nil, fmt.Errorf("failed to link account: %w", err)
+	}
+
+	return &pb.LinkAccountResponse{Account: toProtoLinkedAccount(account)}, nil
+}
+
+// UnlinkAccount removes a provider identity from the authenticated user
+func (s *AuthServer) UnlinkAccount(ctx context.Context, req *pb.UnlinkAccountRequest) (*pb.UnlinkAccountResponse, error) {
+	if req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if err := s.identities.Unlink(ctx, userID, req.Provider); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrLastIdentity):
+			return nil, status.Error(codes.FailedPrecondition, "cannot unlink the last sign-in method")
+		case errors.Is(err, auth.ErrIdentityNotLinked):
+			return nil, status.Errorf(codes.NotFound, "no %s account is linked", req.Provider)
+		}
+		return nil, fmt.Errorf("failed to unlink account: %w", err)
+	}
+
+	return &pb.UnlinkAccountResponse{Success: true}, nil
+}
+
+// ListLinkedAccounts lists the provider identities of the authenticated user
+func (s *AuthServer) ListLinkedAccounts(ctx context.Context, req *pb.ListLinkedAccountsRequest) (*pb.ListLinkedAccountsResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	accounts, err := s.oauthRepo.ListByAppUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list linked accounts: %w", err)
+	}
+
+	linked := make([]*pb.LinkedAccount, len(accounts))
+	for i, a := range accounts {
+		linked[i] = toProtoLinkedAccount(a)
+	}
+
+	return &pb.ListLinkedAccountsResponse{Accounts: linked}, nil
 }
 
 // GetAuthURL returns the OAuth authorization URL
@@ -149,9 +320,9 @@ func (s *AuthServer) GetAuthURL(ctx context.Context, req *pb.GetAuthURLRequest)
 	var url string
 	switch req.Provider {
 	case "google":
-		url = s.googleClient.GetAuthURL(req.State)
+		url = s.googleClient.GetAuthURL(req.State, req.GetCodeChallenge())
 	case "line":
-		url = s.lineClient.GetAuthURL(req.State)
+		url = s.lineClient.GetAuthURL(req.State, req.GetCodeChallenge())
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported provider: %s", req.Provider)
 	}
@@ -182,67 +353,68 @@ func (s *AuthServer) ValidateToken(ctx context.Context, req *pb.ValidateTokenReq
 	}, nil
 }
 
-// findOrCreateUser finds an existing user by OAuth account or creates a new one
-func (s *AuthServer) findOrCreateUser(ctx context.Context, provider, providerUserID string, email *string, displayName string, avatarURL *string, accessToken, refreshToken string, expiresIn int) (*repository.AppUser, error) {
-	// Try to find existing OAuth account
-	oauthAccount, err := s.oauthRepo.GetByProviderAndProviderUserID(ctx, provider, providerUserID)
-	if err == nil {
-		// Found existing OAuth account, get the user
-		user, err := s.appUserRepo.GetByID(ctx, oauthAccount.AppUserID)
-		if err != nil {
-			return nil, err
-		}
-
-		// Update tokens
-		tokenExpiresAt := time.Now().Add(time.Duration(expiresIn) * time.Second)
-		_, err = s.oauthRepo.UpdateTokens(ctx, oauthAccount.ID, &accessToken, &refreshToken, &tokenExpiresAt)
-		if err != nil {
-			return nil, err
-		}
-
-		return user, nil
-	}
-
-	if !errors.Is(err, repository.ErrOAuthAccountNotFound) {
-		return nil, err
-	}
-
-	// No existing OAuth account, create new user and OAuth account
-	user, err := s.appUserRepo.Create(ctx, email, displayName, avatarURL, false)
+// generateAuthResponse starts a new session and generates an AuthResponse with JWT tokens
+func (s *AuthServer) generateAuthResponse(ctx context.Context, user *repository.AppUser) (*pb.AuthResponse, error) {
+	tokenPair, err := s.sessions.StartSession(ctx, user, clientInfoFromContext(ctx))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to generate tokens: %w", err)
 	}
 
-	// Create OAuth account
-	tokenExpiresAt := time.Now().Add(time.Duration(expiresIn) * time.Second)
-	_, err = s.oauthRepo.Create(ctx, user.ID, provider, providerUserID, email, &accessToken, &refreshToken, &tokenExpiresAt)
-	if err != nil {
-		// Rollback user creation
-		_ = s.appUserRepo.Delete(ctx, user.ID)
-		return nil, err
-	}
-
-	return user, nil
+	return toProtoAuthResponse(tokenPair, user), nil
 }
 
-// generateAuthResponse generates an AuthResponse with JWT tokens
-func (s *AuthServer) generateAuthResponse(user *repository.AppUser) (*pb.AuthResponse, error) {
-	var email string
-	if user.Email != nil {
-		email = *user.Email
+// clientInfoFromContext describes the calling device for session listings.
+// Envoy forwards the browser user agent as x-user-agent for gRPC-Web requests.
+func clientInfoFromContext(ctx context.Context) auth.ClientInfo {
+	var client auth.ClientInfo
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, key := range []string{"x-user-agent", "user-agent"} {
+			if v := md.Get(key); len(v) > 0 && v[0] != "" {
+				client.UserAgent = v[0]
+				break
+			}
+		}
+		if v := md.Get("x-forwarded-for"); len(v) > 0 && v[0] != "" {
+			client.IPAddress = strings.TrimSpace(strings.Split(v[0], ",")[0])
+		}
 	}
 
-	tokenPair, err := s.jwtService.GenerateTokenPair(user.ID, email, user.DisplayName, user.IsSuperadmin)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to generate tokens: %v", err)
+	if client.IPAddress == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			client.IPAddress = p.Addr.String()
+			if host, _, err := net.SplitHostPort(client.IPAddress); err == nil {
+				client.IPAddress = host
+			}
+		}
 	}
 
-	return &pb.AuthResponse{
+	return client
+}
+
+// toProtoAuthResponse converts a token pair to an AuthResponse
+func toProtoAuthResponse(tokenPair *auth.TokenPair, user *repository.AppUser) *pb.AuthResponse {
+	resp := &pb.AuthResponse{
 		AccessToken:  tokenPair.AccessToken,
 		RefreshToken: tokenPair.RefreshToken,
 		ExpiresIn:    tokenPair.ExpiresIn,
 		User:         toAuthProtoAppUser(user),
-	}, nil
+	}
+	if tokenPair.OrganizationID != "" {
+		resp.OrganizationId = &tokenPair.OrganizationID
+		resp.Role = &tokenPair.Role
+	}
+	return resp
+}
+
+// toProtoLinkedAccount converts an OAuth account to a LinkedAccount without its provider tokens
+func toProtoLinkedAccount(account *repository.OAuthAccount) *pb.LinkedAccount {
+	return &pb.LinkedAccount{
+		Provider:       account.Provider,
+		ProviderUserId: account.ProviderUserID,
+		Email:          account.Email,
+		CreatedAt:      timestamppb.New(account.CreatedAt),
+	}
 }
 
 // toAuthProtoAppUser converts repository model to proto message
@@ -255,6 +427,7 @@ func toAuthProtoAppUser(user *repository.AppUser) *pb.AppUser {
 		IsSuperadmin: user.IsSuperadmin,
 		CreatedAt:    timestamppb.New(user.CreatedAt),
 		UpdatedAt:    timestamppb.New(user.UpdatedAt),
+		Etag:         user.Etag,
 	}
 	if user.DeletedAt != nil {
 		proto.DeletedAt = timestamppb.New(*user.DeletedAt)
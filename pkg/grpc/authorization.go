@@ -0,0 +1,317 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+// Organization roles stored in user_organizations.role, from most to least privileged
+const (
+	RoleOwner  = "owner"
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+	RoleViewer = "viewer"
+
+	// RoleNone marks a method that requires no organization role
+	// (e.g. accepting an invitation, which is authorized by its token)
+	RoleNone = ""
+)
+
+// roleRank orders roles by privilege; unknown roles rank 0 and are never sufficient
+var roleRank = map[string]int{
+	RoleViewer: 1,
+	RoleMember: 2,
+	RoleAdmin:  3,
+	RoleOwner:  4,
+}
+
+// IsValidRole reports whether role is a known organization role
+func IsValidRole(role string) bool {
+	_, ok := roleRank[role]
+	return ok
+}
+
+// RoleAtLeast reports whether role grants at least the privileges of minRole
+func RoleAtLeast(role, minRole string) bool {
+	if minRole == RoleNone {
+		return true
+	}
+	return roleRank[role] >= roleRank[minRole]
+}
+
+// OrganizationResolver returns the organization a request targets, or "" if it cannot be determined
+type OrganizationResolver func(ctx context.Context, req interface{}) (string, error)
+
+// MethodPolicy declares the minimum organization role required to call a method
+type MethodPolicy struct {
+	// Method is a full method name ("/organization.OrganizationService/DeleteOrganization")
+	// or a service prefix ("/organization.InvitationService/")
+	Method string
+	// MinRole is the least privileged role allowed to call the method
+	MinRole string
+	// OrganizationField names the request field holding the target organization ID
+	// when it is not "organization_id" (e.g. "id" for OrganizationService)
+	OrganizationField string
+	// Resolve looks up the target organization when it is not a request field
+	Resolve OrganizationResolver
+}
+
+// UserOrganizationLookup is the subset of UserOrganizationRepository used to resolve membership rows
+type UserOrganizationLookup interface {
+	GetByID(ctx context.Context, id string) (*repository.UserOrganization, error)
+}
+
+// DefaultMethodPolicies returns the authorization policy table for all services.
+// Methods without an entry default to RoleViewer for Get*/List* and RoleMember otherwise.
+func DefaultMethodPolicies(userOrgs UserOrganizationLookup) []MethodPolicy {
+	// Membership rows are addressed by their own ID, so resolve the organization through the row
+	resolveUserOrg := func(ctx context.Context, req interface{}) (string, error) {
+		r, ok := req.(interface{ GetId() string })
+		if !ok || r.GetId() == "" {
+			return "", nil
+		}
+		uo, err := userOrgs.GetByID(ctx, r.GetId())
+		if err != nil {
+			if errors.Is(err, repository.ErrUserOrganizationNotFound) {
+				return "", nil
+			}
+			return "", err
+		}
+		return uo.OrganizationID, nil
+	}
+
+	return []MethodPolicy{
+		// Any member may switch to their organization; SwitchOrganization checks membership itself
+		{Method: pb.AuthService_SwitchOrganization_FullMethodName, MinRole: RoleNone},
+		// Linked accounts belong to the user, not to an organization
+		{Method: pb.AuthService_LinkAccount_FullMethodName, MinRole: RoleNone},
+		{Method: pb.AuthService_UnlinkAccount_FullMethodName, MinRole: RoleNone},
+		{Method: pb.AuthService_ListLinkedAccounts_FullMethodName, MinRole: RoleNone},
+
+		// Organization management
+		{Method: pb.OrganizationService_UpdateOrganization_FullMethodName, MinRole: RoleOwner, OrganizationField: "id"},
+		{Method: pb.OrganizationService_DeleteOrganization_FullMethodName, MinRole: RoleOwner, OrganizationField: "id"},
+		{Method: pb.OrganizationService_GetOrganization_FullMethodName, MinRole: RoleViewer, OrganizationField: "id"},
+
+		// Membership management
+		{Method: pb.UserOrganizationService_CreateUserOrganization_FullMethodName, MinRole: RoleAdmin},
+		{Method: pb.UserOrganizationService_UpdateUserOrganization_FullMethodName, MinRole: RoleAdmin, Resolve: resolveUserOrg},
+		{Method: pb.UserOrganizationService_DeleteUserOrganization_FullMethodName, MinRole: RoleAdmin, Resolve: resolveUserOrg},
+		{Method: pb.UserOrganizationService_ListUserOrganizationsByOrg_FullMethodName, MinRole: RoleViewer},
+
+		// Invitations (accepting is authorized by the invitation token instead)
+		{Method: "/organization.InvitationService/", MinRole: RoleAdmin},
+		{Method: pb.InvitationService_GetInvitationByToken_FullMethodName, MinRole: RoleNone},
+		{Method: pb.InvitationService_AcceptInvitation_FullMethodName, MinRole: RoleNone},
+
+		// API keys are credentials for the whole organization
+		{Method: "/organization.ApiKeyService/", MinRole: RoleAdmin},
+
+		// Audit trail
+		{Method: pb.AuditLogService_ListAuditEvents_FullMethodName, MinRole: RoleAdmin},
+
+		// Destructive operations on inspection records
+		{Method: pb.CarInspectionService_DeleteCarInspection_FullMethodName, MinRole: RoleAdmin},
+		{Method: pb.CarInspectionDeregistrationService_DeleteCarInspectionDeregistration_FullMethodName, MinRole: RoleAdmin},
+	}
+}
+
+// isReadOnlyMethod reports whether the method name starts with Get or List
+func isReadOnlyMethod(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	return strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "List")
+}
+
+// Authorizer enforces MethodPolicy entries against the caller's role in the target organization
+type Authorizer struct {
+	membership *MembershipCache
+	exact      map[string]MethodPolicy
+	prefixes   []MethodPolicy
+}
+
+// NewAuthorizer creates a new Authorizer from a policy table
+func NewAuthorizer(membership *MembershipCache, policies []MethodPolicy) *Authorizer {
+	a := &Authorizer{
+		membership: membership,
+		exact:      make(map[string]MethodPolicy),
+	}
+	for _, p := range policies {
+		if strings.HasSuffix(p.Method, "/") {
+			a.prefixes = append(a.prefixes, p)
+		} else {
+			a.exact[p.Method] = p
+		}
+	}
+	return a
+}
+
+// policyFor returns the policy for a method and whether it was declared explicitly.
+// Exact method entries win over the longest matching service prefix.
+func (a *Authorizer) policyFor(fullMethod string) (MethodPolicy, bool) {
+	var policy MethodPolicy
+	found := false
+	if p, ok := a.exact[fullMethod]; ok {
+		policy, found = p, true
+	} else {
+		for _, p := range a.prefixes {
+			if strings.HasPrefix(fullMethod, p.Method) && (!found || len(p.Method) > len(policy.Method)) {
+				policy, found = p, true
+			}
+		}
+	}
+
+	if !found {
+		policy = MethodPolicy{Method: fullMethod, MinRole: RoleMember}
+		if isReadOnlyMethod(fullMethod) {
+			policy.MinRole = RoleViewer
+		}
+	}
+
+	// Viewers are read-only regardless of what the table says
+	if policy.MinRole == RoleViewer && !isReadOnlyMethod(fullMethod) {
+		policy.MinRole = RoleMember
+	}
+
+	return policy, found
+}
+
+// RequiredRole returns the minimum role needed to call a method
+func (a *Authorizer) RequiredRole(fullMethod string) string {
+	policy, _ := a.policyFor(fullMethod)
+	return policy.MinRole
+}
+
+// targetOrganization determines which organization a request acts on.
+// An organization ID in the request takes precedence over x-organization-id.
+func targetOrganization(ctx context.Context, policy MethodPolicy, req interface{}) (string, error) {
+	if policy.Resolve != nil {
+		orgID, err := policy.Resolve(ctx, req)
+		if err != nil || orgID != "" {
+			return orgID, err
+		}
+	}
+
+	if msg, ok := req.(proto.Message); ok {
+		field := policy.OrganizationField
+		if field == "" {
+			field = "organization_id"
+		}
+		m := msg.ProtoReflect()
+		if fd := m.Descriptor().Fields().ByName(protoreflect.Name(field)); fd != nil && fd.Kind() == protoreflect.StringKind {
+			if orgID := m.Get(fd).String(); orgID != "" {
+				return orgID, nil
+			}
+		}
+	}
+
+	if orgID, ok := db.GetOrganizationID(ctx); ok && orgID != "" {
+		return orgID, nil
+	}
+
+	return "", nil
+}
+
+// authorize checks the caller's role against the method policy
+func (a *Authorizer) authorize(ctx context.Context, fullMethod string, req interface{}) error {
+	if shouldSkipAuth(fullMethod) {
+		return nil
+	}
+
+	policy, explicit := a.policyFor(fullMethod)
+	if policy.MinRole == RoleNone || GetIsSuperadminFromContext(ctx) {
+		return nil
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	orgID, err := targetOrganization(ctx, policy, req)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to resolve organization: %v", err)
+	}
+	if orgID == "" {
+		if explicit {
+			return status.Error(codes.InvalidArgument, "organization could not be determined for this request")
+		}
+		// Not an organization-scoped call (e.g. CreateOrganization, AppUserService)
+		return nil
+	}
+
+	role, err := memberRole(ctx, a.membership, userID, orgID)
+	if err != nil {
+		return err
+	}
+
+	if !RoleAtLeast(role, policy.MinRole) {
+		return status.Errorf(codes.PermissionDenied, "role %q is not allowed to call this method (requires %s)", role, policy.MinRole)
+	}
+
+	return nil
+}
+
+// memberRole resolves userID's role in orgID, preferring the role claim of a
+// token scoped to that organization over a membership lookup. API keys only hold
+// their own role in their own organization, never the role of the user who created them.
+func memberRole(ctx context.Context, membership *MembershipCache, userID, orgID string) (string, error) {
+	if tokenOrgID, ok := GetTokenOrganizationIDFromContext(ctx); ok && tokenOrgID == orgID {
+		return GetTokenRoleFromContext(ctx), nil
+	}
+	if _, isKey := GetAPIKeyIDFromContext(ctx); isKey {
+		return "", status.Error(codes.PermissionDenied, "api key is not valid for this organization")
+	}
+
+	userOrg, err := membership.Get(ctx, userID, orgID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserOrganizationNotFound) {
+			return "", status.Error(codes.PermissionDenied, "not a member of this organization")
+		}
+		return "", status.Errorf(codes.Internal, "failed to get organization role: %v", err)
+	}
+
+	return userOrg.Role, nil
+}
+
+// AuthorizationUnaryInterceptor enforces role-based method policies.
+// It must run after the JWT and RLS interceptors.
+func AuthorizationUnaryInterceptor(authorizer *Authorizer) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := authorizer.authorize(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthorizationStreamInterceptor enforces role-based method policies for streaming RPCs.
+// The target organization is taken from the stream context since no request is available yet.
+func AuthorizationStreamInterceptor(authorizer *Authorizer) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := authorizer.authorize(ss.Context(), info.FullMethod, nil); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,257 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+// fakeUserOrgLookup implements UserOrganizationLookup for testing
+type fakeUserOrgLookup map[string]*repository.UserOrganization
+
+func (f fakeUserOrgLookup) GetByID(ctx context.Context, id string) (*repository.UserOrganization, error) {
+	uo, ok := f[id]
+	if !ok {
+		return nil, repository.ErrUserOrganizationNotFound
+	}
+	return uo, nil
+}
+
+// userContext builds a context as seen by the authorization interceptor
+func userContext(userID string, isSuperadmin bool, orgID string) context.Context {
+	ctx := context.WithValue(context.Background(), UserIDKey, userID)
+	ctx = context.WithValue(ctx, IsSuperadminKey, isSuperadmin)
+	if orgID != "" {
+		ctx = db.WithOrganizationID(ctx, orgID)
+	}
+	return ctx
+}
+
+func TestRoleAtLeast(t *testing.T) {
+	tests := []struct {
+		role    string
+		minRole string
+		want    bool
+	}{
+		{RoleOwner, RoleAdmin, true},
+		{RoleAdmin, RoleAdmin, true},
+		{RoleMember, RoleAdmin, false},
+		{RoleViewer, RoleMember, false},
+		{RoleViewer, RoleViewer, true},
+		{"unknown", RoleViewer, false},
+		{"unknown", RoleNone, true},
+	}
+
+	for _, tt := range tests {
+		if got := RoleAtLeast(tt.role, tt.minRole); got != tt.want {
+			t.Errorf("RoleAtLeast(%q, %q) = %v, want %v", tt.role, tt.minRole, got, tt.want)
+		}
+	}
+}
+
+func TestAuthorizer_RequiredRole(t *testing.T) {
+	a := NewAuthorizer(nil, append(DefaultMethodPolicies(fakeUserOrgLookup{}),
+		// Misconfigured entry: viewers must never be allowed to mutate
+		MethodPolicy{Method: pb.FileService_UpdateFile_FullMethodName, MinRole: RoleViewer},
+	))
+
+	tests := []struct {
+		method string
+		want   string
+	}{
+		{pb.OrganizationService_DeleteOrganization_FullMethodName, RoleOwner},
+		{pb.InvitationService_CreateInvitation_FullMethodName, RoleAdmin},
+		{pb.InvitationService_ListInvitations_FullMethodName, RoleAdmin},
+		{pb.InvitationService_AcceptInvitation_FullMethodName, RoleNone},
+		{pb.CarInspectionService_DeleteCarInspection_FullMethodName, RoleAdmin},
+		{pb.CarInspectionService_UpdateCarInspection_FullMethodName, RoleMember},
+		{pb.CarInspectionService_GetCarInspection_FullMethodName, RoleViewer},
+		{pb.KudguriService_ListKudguris_FullMethodName, RoleViewer},
+		{pb.ETCMeisaiService_BulkCreateETCMeisai_FullMethodName, RoleMember},
+		{pb.FileService_UpdateFile_FullMethodName, RoleMember},
+	}
+
+	for _, tt := range tests {
+		if got := a.RequiredRole(tt.method); got != tt.want {
+			t.Errorf("RequiredRole(%s) = %q, want %q", tt.method, got, tt.want)
+		}
+	}
+}
+
+func TestAuthorizationUnaryInterceptor(t *testing.T) {
+	const (
+		orgA = "11111111-1111-1111-1111-111111111111"
+		orgB = "22222222-2222-2222-2222-222222222222"
+	)
+
+	repo := newFakeMembershipRepo()
+	repo.add("owner", orgA, RoleOwner)
+	repo.add("admin", orgA, RoleAdmin)
+	repo.add("member", orgA, RoleMember)
+	repo.add("viewer", orgA, RoleViewer)
+	repo.add("admin", orgB, RoleAdmin)
+
+	userOrgs := fakeUserOrgLookup{
+		"uo-viewer": {ID: "uo-viewer", UserID: "viewer", OrganizationID: orgA, Role: RoleViewer},
+	}
+
+	interceptor := AuthorizationUnaryInterceptor(
+		NewAuthorizer(NewMembershipCache(repo, time.Minute), DefaultMethodPolicies(userOrgs)),
+	)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		req      interface{}
+		wantCode codes.Code
+	}{
+		{
+			name:     "owner deletes organization",
+			ctx:      userContext("owner", false, ""),
+			method:   pb.OrganizationService_DeleteOrganization_FullMethodName,
+			req:      &pb.DeleteOrganizationRequest{Id: orgA},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "member cannot delete organization",
+			ctx:      userContext("member", false, ""),
+			method:   pb.OrganizationService_DeleteOrganization_FullMethodName,
+			req:      &pb.DeleteOrganizationRequest{Id: orgA},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "non-member cannot delete organization",
+			ctx:      userContext("owner", false, ""),
+			method:   pb.OrganizationService_DeleteOrganization_FullMethodName,
+			req:      &pb.DeleteOrganizationRequest{Id: orgB},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "admin creates invitation",
+			ctx:      userContext("admin", false, orgA),
+			method:   pb.InvitationService_CreateInvitation_FullMethodName,
+			req:      &pb.CreateInvitationRequest{OrganizationId: orgA, Email: "x@example.com"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "member cannot create invitation",
+			ctx:      userContext("member", false, orgA),
+			method:   pb.InvitationService_CreateInvitation_FullMethodName,
+			req:      &pb.CreateInvitationRequest{OrganizationId: orgA, Email: "x@example.com"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "non-member accepts invitation",
+			ctx:      userContext("stranger", false, orgA),
+			method:   pb.InvitationService_AcceptInvitation_FullMethodName,
+			req:      &pb.AcceptInvitationRequest{Token: "token"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "member cannot delete car inspection",
+			ctx:      userContext("member", false, orgA),
+			method:   pb.CarInspectionService_DeleteCarInspection_FullMethodName,
+			req:      &pb.DeleteCarInspectionRequest{},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "member updates car inspection",
+			ctx:      userContext("member", false, orgA),
+			method:   pb.CarInspectionService_UpdateCarInspection_FullMethodName,
+			req:      &pb.UpdateCarInspectionRequest{},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "viewer lists",
+			ctx:      userContext("viewer", false, orgA),
+			method:   pb.KudguriService_ListKudguris_FullMethodName,
+			req:      &pb.ListKudgurisRequest{},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "viewer cannot create",
+			ctx:      userContext("viewer", false, orgA),
+			method:   pb.CamFileService_CreateCamFile_FullMethodName,
+			req:      &pb.CreateCamFileRequest{},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "viewer cannot promote themselves",
+			ctx:      userContext("viewer", false, ""),
+			method:   pb.UserOrganizationService_UpdateUserOrganization_FullMethodName,
+			req:      &pb.UpdateUserOrganizationRequest{Id: "uo-viewer", Role: RoleOwner},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "role claim of the token organization",
+			ctx:      tokenContext("claims-only", orgA, RoleAdmin, ""),
+			method:   pb.InvitationService_CreateInvitation_FullMethodName,
+			req:      &pb.CreateInvitationRequest{OrganizationId: orgA, Email: "x@example.com"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "role claim does not apply to other organizations",
+			ctx:      tokenContext("claims-only", orgA, RoleAdmin, ""),
+			method:   pb.InvitationService_CreateInvitation_FullMethodName,
+			req:      &pb.CreateInvitationRequest{OrganizationId: orgB, Email: "x@example.com"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "api key does not act in its creator's other organizations",
+			ctx:      context.WithValue(tokenContext("admin", orgA, RoleMember, ""), APIKeyIDKey, "key-1"),
+			method:   pb.InvitationService_CreateInvitation_FullMethodName,
+			req:      &pb.CreateInvitationRequest{OrganizationId: orgB, Email: "x@example.com"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "viewer switches organization",
+			ctx:      userContext("viewer", false, ""),
+			method:   pb.AuthService_SwitchOrganization_FullMethodName,
+			req:      &pb.SwitchOrganizationRequest{OrganizationId: orgA},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "superadmin bypasses roles",
+			ctx:      userContext("root", true, ""),
+			method:   pb.OrganizationService_DeleteOrganization_FullMethodName,
+			req:      &pb.DeleteOrganizationRequest{Id: orgB},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "not organization scoped",
+			ctx:      userContext("member", false, ""),
+			method:   pb.OrganizationService_CreateOrganization_FullMethodName,
+			req:      &pb.CreateOrganizationRequest{Name: "New"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "explicit policy without organization",
+			ctx:      userContext("owner", false, ""),
+			method:   pb.OrganizationService_DeleteOrganization_FullMethodName,
+			req:      &pb.DeleteOrganizationRequest{},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "ok", nil
+			}
+
+			_, err := interceptor(tt.ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("code = %v, want %v (err = %v)", status.Code(err), tt.wantCode, err)
+			}
+		})
+	}
+}
@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // CamFileExeServer implements the gRPC CamFileExeService
 type CamFileExeServer struct {
 	pb.UnimplementedCamFileExeServiceServer
-	repo *repository.CamFileExeRepository
+	repo       *repository.CamFileExeRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewCamFileExeServer creates a new gRPC server
-func NewCamFileExeServer(repo *repository.CamFileExeRepository) *CamFileExeServer {
-	return &CamFileExeServer{repo: repo}
+func NewCamFileExeServer(repo *repository.CamFileExeRepository, pageTokens *pagination.Tokens) *CamFileExeServer {
+	return &CamFileExeServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateCamFileExe creates a new cam file exe
@@ -36,7 +38,7 @@ func (s *CamFileExeServer) CreateCamFileExe(ctx context.Context, req *pb.CreateC
 
 	camFileExe, err := s.repo.Create(ctx, req.Name, req.Cam, req.OrganizationId, req.Stage)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create cam file exe: %v", err)
+		return nil, fmt.Errorf("failed to create cam file exe: %w", err)
 	}
 
 	return &pb.CreateCamFileExeResponse{
@@ -58,10 +60,7 @@ func (s *CamFileExeServer) GetCamFileExe(ctx context.Context, req *pb.GetCamFile
 
 	camFileExe, err := s.repo.GetByKey(ctx, req.Name, req.Cam, req.OrganizationId)
 	if err != nil {
-		if errors.Is(err, repository.ErrCamFileExeNotFound) {
-			return nil, status.Error(codes.NotFound, "cam file exe not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get cam file exe: %v", err)
+		return nil, fmt.Errorf("failed to get cam file exe: %w", err)
 	}
 
 	return &pb.GetCamFileExeResponse{
@@ -81,12 +80,9 @@ func (s *CamFileExeServer) UpdateCamFileExe(ctx context.Context, req *pb.UpdateC
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	camFileExe, err := s.repo.Update(ctx, req.Name, req.Cam, req.OrganizationId, req.Stage)
+	camFileExe, err := s.repo.Update(ctx, req.Name, req.Cam, req.OrganizationId, req.Stage, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrCamFileExeNotFound) {
-			return nil, status.Error(codes.NotFound, "cam file exe not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update cam file exe: %v", err)
+		return nil, fmt.Errorf("failed to update cam file exe: %w", err)
 	}
 
 	return &pb.UpdateCamFileExeResponse{
@@ -106,12 +102,9 @@ func (s *CamFileExeServer) DeleteCamFileExe(ctx context.Context, req *pb.DeleteC
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Name, req.Cam, req.OrganizationId)
+	err := s.repo.Delete(ctx, req.Name, req.Cam, req.OrganizationId, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrCamFileExeNotFound) {
-			return nil, status.Error(codes.NotFound, "cam file exe not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete cam file exe: %v", err)
+		return nil, fmt.Errorf("failed to delete cam file exe: %w", err)
 	}
 
 	return &pb.DeleteCamFileExeResponse{
@@ -130,27 +123,15 @@ func (s *CamFileExeServer) ListCamFileExesByOrganization(ctx context.Context, re
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	camFileExes, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListCamFileExesByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list cam file exes: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(camFileExes) > limit {
-		camFileExes = camFileExes[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	camFileExes, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cam file exes: %w", err)
 	}
 
 	protoCamFileExes := make([]*pb.CamFileExe, len(camFileExes))
@@ -160,7 +141,7 @@ func (s *CamFileExeServer) ListCamFileExesByOrganization(ctx context.Context, re
 
 	return &pb.ListCamFileExesByOrganizationResponse{
 		CamFileExes:   protoCamFileExes,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -171,5 +152,6 @@ func toProtoCamFileExe(camFileExe *repository.CamFileExe) *pb.CamFileExe {
 		Cam:            camFileExe.Cam,
 		OrganizationId: camFileExe.OrganizationID,
 		Stage:          camFileExe.Stage,
+		Etag:           camFileExe.Etag,
 	}
 }
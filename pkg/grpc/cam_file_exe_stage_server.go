@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // CamFileExeStageServer implements the gRPC CamFileExeStageService
 type CamFileExeStageServer struct {
 	pb.UnimplementedCamFileExeStageServiceServer
-	repo *repository.CamFileExeStageRepository
+	repo       *repository.CamFileExeStageRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewCamFileExeStageServer creates a new gRPC server
-func NewCamFileExeStageServer(repo *repository.CamFileExeStageRepository) *CamFileExeStageServer {
-	return &CamFileExeStageServer{repo: repo}
+func NewCamFileExeStageServer(repo *repository.CamFileExeStageRepository, pageTokens *pagination.Tokens) *CamFileExeStageServer {
+	return &CamFileExeStageServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateCamFileExeStage creates a new cam file exe stage
@@ -33,7 +35,7 @@ func (s *CamFileExeStageServer) CreateCamFileExeStage(ctx context.Context, req *
 
 	camStage, err := s.repo.Create(ctx, req.Stage, req.OrganizationId, req.Name)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create cam file exe stage: %v", err)
+		return nil, fmt.Errorf("failed to create cam file exe stage: %w", err)
 	}
 
 	return &pb.CreateCamFileExeStageResponse{
@@ -49,10 +51,7 @@ func (s *CamFileExeStageServer) GetCamFileExeStage(ctx context.Context, req *pb.
 
 	camStage, err := s.repo.GetByStageAndOrg(ctx, req.Stage, req.OrganizationId)
 	if err != nil {
-		if errors.Is(err, repository.ErrCamFileExeStageNotFound) {
-			return nil, status.Error(codes.NotFound, "cam file exe stage not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get cam file exe stage: %v", err)
+		return nil, fmt.Errorf("failed to get cam file exe stage: %w", err)
 	}
 
 	return &pb.GetCamFileExeStageResponse{
@@ -69,12 +68,9 @@ func (s *CamFileExeStageServer) UpdateCamFileExeStage(ctx context.Context, req *
 		return nil, status.Error(codes.InvalidArgument, "name is required")
 	}
 
-	camStage, err := s.repo.Update(ctx, req.Stage, req.OrganizationId, req.Name)
+	camStage, err := s.repo.Update(ctx, req.Stage, req.OrganizationId, req.Name, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrCamFileExeStageNotFound) {
-			return nil, status.Error(codes.NotFound, "cam file exe stage not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update cam file exe stage: %v", err)
+		return nil, fmt.Errorf("failed to update cam file exe stage: %w", err)
 	}
 
 	return &pb.UpdateCamFileExeStageResponse{
@@ -88,12 +84,9 @@ func (s *CamFileExeStageServer) DeleteCamFileExeStage(ctx context.Context, req *
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Stage, req.OrganizationId)
+	err := s.repo.Delete(ctx, req.Stage, req.OrganizationId, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrCamFileExeStageNotFound) {
-			return nil, status.Error(codes.NotFound, "cam file exe stage not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete cam file exe stage: %v", err)
+		return nil, fmt.Errorf("failed to delete cam file exe stage: %w", err)
 	}
 
 	return &pb.DeleteCamFileExeStageResponse{
@@ -106,17 +99,21 @@ func (s *CamFileExeStageServer) ListCamFileExeStages(ctx context.Context, req *p
 	return nil, status.Error(codes.Unimplemented, "use ListCamFileExeStagesByOrganization instead")
 }
 
-// ListCamFileExeStagesByOrganization retrieves cam file exe stages for a specific organization
+// ListCamFileExeStagesByOrganization retrieves cam file exe stages for a specific organization with pagination
 func (s *CamFileExeStageServer) ListCamFileExeStagesByOrganization(ctx context.Context, req *pb.ListCamFileExeStagesByOrganizationRequest) (*pb.ListCamFileExeStagesByOrganizationResponse, error) {
 	if req.OrganizationId == "" {
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	// Note: This repository method doesn't support pagination
-	// It returns all stages for the organization
-	camStages, err := s.repo.ListByOrganization(ctx, req.OrganizationId)
+	scope := []string{"ListCamFileExeStagesByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list cam file exe stages: %v", err)
+		return nil, err
+	}
+
+	camStages, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cam file exe stages: %w", err)
 	}
 
 	protoCamStages := make([]*pb.CamFileExeStage, len(camStages))
@@ -126,7 +123,7 @@ func (s *CamFileExeStageServer) ListCamFileExeStagesByOrganization(ctx context.C
 
 	return &pb.ListCamFileExeStagesByOrganizationResponse{
 		CamFileExeStages: protoCamStages,
-		NextPageToken:    "", // No pagination for this endpoint
+		NextPageToken:    s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -136,5 +133,6 @@ func toProtoCamFileExeStage(camStage *repository.CamFileExeStage) *pb.CamFileExe
 		Stage:          camStage.Stage,
 		OrganizationId: camStage.OrganizationID,
 		Name:           camStage.Name,
+		Etag:           camStage.Etag,
 	}
 }
@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // CamFileServer implements the gRPC CamFileService
 type CamFileServer struct {
 	pb.UnimplementedCamFileServiceServer
-	repo *repository.CamFileRepository
+	repo       *repository.CamFileRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewCamFileServer creates a new gRPC server
-func NewCamFileServer(repo *repository.CamFileRepository) *CamFileServer {
-	return &CamFileServer{repo: repo}
+func NewCamFileServer(repo *repository.CamFileRepository, pageTokens *pagination.Tokens) *CamFileServer {
+	return &CamFileServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateCamFile creates a new cam file
@@ -50,7 +52,7 @@ func (s *CamFileServer) CreateCamFile(ctx context.Context, req *pb.CreateCamFile
 
 	camFile, err := s.repo.Create(ctx, req.Name, req.OrganizationId, req.Date, req.Hour, req.Type, req.Cam, flickrID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create cam file: %v", err)
+		return nil, fmt.Errorf("failed to create cam file: %w", err)
 	}
 
 	return &pb.CreateCamFileResponse{
@@ -69,10 +71,7 @@ func (s *CamFileServer) GetCamFile(ctx context.Context, req *pb.GetCamFileReques
 
 	camFile, err := s.repo.GetByNameAndOrg(ctx, req.Name, req.OrganizationId)
 	if err != nil {
-		if errors.Is(err, repository.ErrCamFileNotFound) {
-			return nil, status.Error(codes.NotFound, "cam file not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get cam file: %v", err)
+		return nil, fmt.Errorf("failed to get cam file: %w", err)
 	}
 
 	return &pb.GetCamFileResponse{
@@ -106,12 +105,9 @@ func (s *CamFileServer) UpdateCamFile(ctx context.Context, req *pb.UpdateCamFile
 		flickrID = req.FlickrId
 	}
 
-	camFile, err := s.repo.Update(ctx, req.Name, req.OrganizationId, req.Date, req.Hour, req.Type, req.Cam, flickrID)
+	camFile, err := s.repo.Update(ctx, req.Name, req.OrganizationId, req.Date, req.Hour, req.Type, req.Cam, flickrID, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrCamFileNotFound) {
-			return nil, status.Error(codes.NotFound, "cam file not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update cam file: %v", err)
+		return nil, fmt.Errorf("failed to update cam file: %w", err)
 	}
 
 	return &pb.UpdateCamFileResponse{
@@ -128,12 +124,9 @@ func (s *CamFileServer) DeleteCamFile(ctx context.Context, req *pb.DeleteCamFile
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Name, req.OrganizationId)
+	err := s.repo.Delete(ctx, req.Name, req.OrganizationId, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrCamFileNotFound) {
-			return nil, status.Error(codes.NotFound, "cam file not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete cam file: %v", err)
+		return nil, fmt.Errorf("failed to delete cam file: %w", err)
 	}
 
 	return &pb.DeleteCamFileResponse{
@@ -152,27 +145,19 @@ func (s *CamFileServer) ListCamFilesByOrganization(ctx context.Context, req *pb.
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	camFiles, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListCamFilesByOrganization", req.OrganizationId, req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list cam files: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(camFiles) > limit {
-		camFiles = camFiles[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	camFiles, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cam files: %w", err)
 	}
 
 	protoCamFiles := make([]*pb.CamFile, len(camFiles))
@@ -182,7 +167,7 @@ func (s *CamFileServer) ListCamFilesByOrganization(ctx context.Context, req *pb.
 
 	return &pb.ListCamFilesByOrganizationResponse{
 		CamFiles:      protoCamFiles,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -195,6 +180,7 @@ func toProtoCamFile(camFile *repository.CamFile) *pb.CamFile {
 		Hour:           camFile.Hour,
 		Type:           camFile.Type,
 		Cam:            camFile.Cam,
+		Etag:           camFile.Etag,
 	}
 	if camFile.FlickrID != nil {
 		proto.FlickrId = camFile.FlickrID
@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // CarInsSheetIchibanCarsAServer implements the gRPC CarInsSheetIchibanCarsAService
 type CarInsSheetIchibanCarsAServer struct {
 	pb.UnimplementedCarInsSheetIchibanCarsAServiceServer
-	repo *repository.CarInsSheetIchibanCarsARepository
+	repo       *repository.CarInsSheetIchibanCarsARepository
+	pageTokens *pagination.Tokens
 }
 
 // NewCarInsSheetIchibanCarsAServer creates a new gRPC server
-func NewCarInsSheetIchibanCarsAServer(repo *repository.CarInsSheetIchibanCarsARepository) *CarInsSheetIchibanCarsAServer {
-	return &CarInsSheetIchibanCarsAServer{repo: repo}
+func NewCarInsSheetIchibanCarsAServer(repo *repository.CarInsSheetIchibanCarsARepository, pageTokens *pagination.Tokens) *CarInsSheetIchibanCarsAServer {
+	return &CarInsSheetIchibanCarsAServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateCarInsSheetIchibanCarsA creates a new car_ins_sheet_ichiban_cars_a entry
@@ -59,7 +61,7 @@ func (s *CarInsSheetIchibanCarsAServer) CreateCarInsSheetIchibanCarsA(ctx contex
 		idCars,
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create car_ins_sheet_ichiban_cars_a: %v", err)
+		return nil, fmt.Errorf("failed to create car_ins_sheet_ichiban_cars_a: %w", err)
 	}
 
 	return &pb.CreateCarInsSheetIchibanCarsAResponse{
@@ -97,10 +99,7 @@ func (s *CarInsSheetIchibanCarsAServer) GetCarInsSheetIchibanCarsA(ctx context.C
 		req.GrantdateD,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInsSheetIchibanCarsANotFound) {
-			return nil, status.Error(codes.NotFound, "car_ins_sheet_ichiban_cars_a not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get car_ins_sheet_ichiban_cars_a: %v", err)
+		return nil, fmt.Errorf("failed to get car_ins_sheet_ichiban_cars_a: %w", err)
 	}
 
 	return &pb.GetCarInsSheetIchibanCarsAResponse{
@@ -143,12 +142,10 @@ func (s *CarInsSheetIchibanCarsAServer) UpdateCarInsSheetIchibanCarsA(ctx contex
 		req.GrantdateM,
 		req.GrantdateD,
 		idCars,
+		req.Etag,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInsSheetIchibanCarsANotFound) {
-			return nil, status.Error(codes.NotFound, "car_ins_sheet_ichiban_cars_a not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update car_ins_sheet_ichiban_cars_a: %v", err)
+		return nil, fmt.Errorf("failed to update car_ins_sheet_ichiban_cars_a: %w", err)
 	}
 
 	return &pb.UpdateCarInsSheetIchibanCarsAResponse{
@@ -184,12 +181,10 @@ func (s *CarInsSheetIchibanCarsAServer) DeleteCarInsSheetIchibanCarsA(ctx contex
 		req.GrantdateY,
 		req.GrantdateM,
 		req.GrantdateD,
+		req.Etag,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInsSheetIchibanCarsANotFound) {
-			return nil, status.Error(codes.NotFound, "car_ins_sheet_ichiban_cars_a not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete car_ins_sheet_ichiban_cars_a: %v", err)
+		return nil, fmt.Errorf("failed to delete car_ins_sheet_ichiban_cars_a: %w", err)
 	}
 
 	return &pb.DeleteCarInsSheetIchibanCarsAResponse{
@@ -199,27 +194,15 @@ func (s *CarInsSheetIchibanCarsAServer) DeleteCarInsSheetIchibanCarsA(ctx contex
 
 // ListCarInsSheetIchibanCarsAs retrieves car_ins_sheet_ichiban_cars_a entries with pagination
 func (s *CarInsSheetIchibanCarsAServer) ListCarInsSheetIchibanCarsAs(ctx context.Context, req *pb.ListCarInsSheetIchibanCarsAsRequest) (*pb.ListCarInsSheetIchibanCarsAsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	records, err := s.repo.List(ctx, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListCarInsSheetIchibanCarsAs"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car_ins_sheet_ichiban_cars_a: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	records, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car_ins_sheet_ichiban_cars_a: %w", err)
 	}
 
 	protoRecords := make([]*pb.CarInsSheetIchibanCarsA, len(records))
@@ -229,7 +212,7 @@ func (s *CarInsSheetIchibanCarsAServer) ListCarInsSheetIchibanCarsAs(ctx context
 
 	return &pb.ListCarInsSheetIchibanCarsAsResponse{
 		CarInsSheetIchibanCarsAs: protoRecords,
-		NextPageToken:            nextPageToken,
+		NextPageToken:            s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -239,27 +222,15 @@ func (s *CarInsSheetIchibanCarsAServer) ListCarInsSheetIchibanCarsAsByOrganizati
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	records, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListCarInsSheetIchibanCarsAsByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car_ins_sheet_ichiban_cars_a by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	records, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car_ins_sheet_ichiban_cars_a by organization: %w", err)
 	}
 
 	protoRecords := make([]*pb.CarInsSheetIchibanCarsA, len(records))
@@ -269,7 +240,7 @@ func (s *CarInsSheetIchibanCarsAServer) ListCarInsSheetIchibanCarsAsByOrganizati
 
 	return &pb.ListCarInsSheetIchibanCarsAsByOrganizationResponse{
 		CarInsSheetIchibanCarsAs: protoRecords,
-		NextPageToken:            nextPageToken,
+		NextPageToken:            s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -283,5 +254,6 @@ func toProtoCarInsSheetIchibanCarsA(record *repository.CarInsSheetIchibanCarsA)
 		GrantdateY:     record.GrantdateY,
 		GrantdateM:     record.GrantdateM,
 		GrantdateD:     record.GrantdateD,
+		Etag:           record.Etag,
 	}
 }
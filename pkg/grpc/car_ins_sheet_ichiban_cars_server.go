@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // CarInsSheetIchibanCarsServer implements the gRPC CarInsSheetIchibanCarsService
 type CarInsSheetIchibanCarsServer struct {
 	pb.UnimplementedCarInsSheetIchibanCarsServiceServer
-	repo *repository.CarInsSheetIchibanCarsRepository
+	repo       *repository.CarInsSheetIchibanCarsRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewCarInsSheetIchibanCarsServer creates a new gRPC server
-func NewCarInsSheetIchibanCarsServer(repo *repository.CarInsSheetIchibanCarsRepository) *CarInsSheetIchibanCarsServer {
-	return &CarInsSheetIchibanCarsServer{repo: repo}
+func NewCarInsSheetIchibanCarsServer(repo *repository.CarInsSheetIchibanCarsRepository, pageTokens *pagination.Tokens) *CarInsSheetIchibanCarsServer {
+	return &CarInsSheetIchibanCarsServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateCarInsSheetIchibanCars creates a new car_ins_sheet_ichiban_cars record
@@ -59,7 +61,7 @@ func (s *CarInsSheetIchibanCarsServer) CreateCarInsSheetIchibanCars(ctx context.
 		idCars,
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create car_ins_sheet_ichiban_cars: %v", err)
+		return nil, fmt.Errorf("failed to create car_ins_sheet_ichiban_cars: %w", err)
 	}
 
 	return &pb.CreateCarInsSheetIchibanCarsResponse{
@@ -97,10 +99,7 @@ func (s *CarInsSheetIchibanCarsServer) GetCarInsSheetIchibanCars(ctx context.Con
 		req.ElectCertPublishdateD,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInsSheetIchibanCarsNotFound) {
-			return nil, status.Error(codes.NotFound, "car_ins_sheet_ichiban_cars not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get car_ins_sheet_ichiban_cars: %v", err)
+		return nil, fmt.Errorf("failed to get car_ins_sheet_ichiban_cars: %w", err)
 	}
 
 	return &pb.GetCarInsSheetIchibanCarsResponse{
@@ -143,12 +142,10 @@ func (s *CarInsSheetIchibanCarsServer) UpdateCarInsSheetIchibanCars(ctx context.
 		req.ElectCertPublishdateM,
 		req.ElectCertPublishdateD,
 		idCars,
+		req.Etag,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInsSheetIchibanCarsNotFound) {
-			return nil, status.Error(codes.NotFound, "car_ins_sheet_ichiban_cars not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update car_ins_sheet_ichiban_cars: %v", err)
+		return nil, fmt.Errorf("failed to update car_ins_sheet_ichiban_cars: %w", err)
 	}
 
 	return &pb.UpdateCarInsSheetIchibanCarsResponse{
@@ -184,12 +181,10 @@ func (s *CarInsSheetIchibanCarsServer) DeleteCarInsSheetIchibanCars(ctx context.
 		req.ElectCertPublishdateY,
 		req.ElectCertPublishdateM,
 		req.ElectCertPublishdateD,
+		req.Etag,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInsSheetIchibanCarsNotFound) {
-			return nil, status.Error(codes.NotFound, "car_ins_sheet_ichiban_cars not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete car_ins_sheet_ichiban_cars: %v", err)
+		return nil, fmt.Errorf("failed to delete car_ins_sheet_ichiban_cars: %w", err)
 	}
 
 	return &pb.DeleteCarInsSheetIchibanCarsResponse{
@@ -199,27 +194,15 @@ func (s *CarInsSheetIchibanCarsServer) DeleteCarInsSheetIchibanCars(ctx context.
 
 // ListCarInsSheetIchibanCarss retrieves car_ins_sheet_ichiban_cars records with pagination
 func (s *CarInsSheetIchibanCarsServer) ListCarInsSheetIchibanCarss(ctx context.Context, req *pb.ListCarInsSheetIchibanCarssRequest) (*pb.ListCarInsSheetIchibanCarssResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	records, err := s.repo.List(ctx, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListCarInsSheetIchibanCarss"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car_ins_sheet_ichiban_cars: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	records, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car_ins_sheet_ichiban_cars: %w", err)
 	}
 
 	protoRecords := make([]*pb.CarInsSheetIchibanCars, len(records))
@@ -229,7 +212,7 @@ func (s *CarInsSheetIchibanCarsServer) ListCarInsSheetIchibanCarss(ctx context.C
 
 	return &pb.ListCarInsSheetIchibanCarssResponse{
 		CarInsSheetIchibanCarss: protoRecords,
-		NextPageToken:           nextPageToken,
+		NextPageToken:           s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -239,27 +222,15 @@ func (s *CarInsSheetIchibanCarsServer) ListCarInsSheetIchibanCarssByOrganization
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	records, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListCarInsSheetIchibanCarssByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car_ins_sheet_ichiban_cars by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	records, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car_ins_sheet_ichiban_cars by organization: %w", err)
 	}
 
 	protoRecords := make([]*pb.CarInsSheetIchibanCars, len(records))
@@ -269,7 +240,7 @@ func (s *CarInsSheetIchibanCarsServer) ListCarInsSheetIchibanCarssByOrganization
 
 	return &pb.ListCarInsSheetIchibanCarssByOrganizationResponse{
 		CarInsSheetIchibanCarss: protoRecords,
-		NextPageToken:           nextPageToken,
+		NextPageToken:           s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -283,5 +254,6 @@ func toProtoCarInsSheetIchibanCars(record *repository.CarInsSheetIchibanCars) *p
 		ElectCertPublishdateY:  record.ElectCertPublishdateY,
 		ElectCertPublishdateM:  record.ElectCertPublishdateM,
 		ElectCertPublishdateD:  record.ElectCertPublishdateD,
+		Etag:                   record.Etag,
 	}
 }
@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // CarInspectionDeregistrationFilesServer implements the gRPC CarInspectionDeregistrationFilesService
 type CarInspectionDeregistrationFilesServer struct {
 	pb.UnimplementedCarInspectionDeregistrationFilesServiceServer
-	repo *repository.CarInspectionDeregistrationFilesRepository
+	repo       *repository.CarInspectionDeregistrationFilesRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewCarInspectionDeregistrationFilesServer creates a new gRPC server
-func NewCarInspectionDeregistrationFilesServer(repo *repository.CarInspectionDeregistrationFilesRepository) *CarInspectionDeregistrationFilesServer {
-	return &CarInspectionDeregistrationFilesServer{repo: repo}
+func NewCarInspectionDeregistrationFilesServer(repo *repository.CarInspectionDeregistrationFilesRepository, pageTokens *pagination.Tokens) *CarInspectionDeregistrationFilesServer {
+	return &CarInspectionDeregistrationFilesServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateCarInspectionDeregistrationFiles creates a new car inspection deregistration file
@@ -44,7 +46,7 @@ func (s *CarInspectionDeregistrationFilesServer) CreateCarInspectionDeregistrati
 		req.FileUuid,
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create car inspection deregistration file: %v", err)
+		return nil, fmt.Errorf("failed to create car inspection deregistration file: %w", err)
 	}
 
 	return &pb.CreateCarInspectionDeregistrationFilesResponse{
@@ -74,10 +76,7 @@ func (s *CarInspectionDeregistrationFilesServer) GetCarInspectionDeregistrationF
 		req.FileUuid,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionDeregistrationFilesNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection deregistration file not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get car inspection deregistration file: %v", err)
+		return nil, fmt.Errorf("failed to get car inspection deregistration file: %w", err)
 	}
 
 	return &pb.GetCarInspectionDeregistrationFilesResponse{
@@ -109,10 +108,7 @@ func (s *CarInspectionDeregistrationFilesServer) UpdateCarInspectionDeregistrati
 		req.FileUuid,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionDeregistrationFilesNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection deregistration file not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update car inspection deregistration file: %v", err)
+		return nil, fmt.Errorf("failed to update car inspection deregistration file: %w", err)
 	}
 
 	return &pb.UpdateCarInspectionDeregistrationFilesResponse{
@@ -142,10 +138,7 @@ func (s *CarInspectionDeregistrationFilesServer) DeleteCarInspectionDeregistrati
 		req.FileUuid,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionDeregistrationFilesNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection deregistration file not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete car inspection deregistration file: %v", err)
+		return nil, fmt.Errorf("failed to delete car inspection deregistration file: %w", err)
 	}
 
 	return &pb.DeleteCarInspectionDeregistrationFilesResponse{
@@ -155,27 +148,15 @@ func (s *CarInspectionDeregistrationFilesServer) DeleteCarInspectionDeregistrati
 
 // ListCarInspectionDeregistrationFiless retrieves car inspection deregistration files with pagination
 func (s *CarInspectionDeregistrationFilesServer) ListCarInspectionDeregistrationFiless(ctx context.Context, req *pb.ListCarInspectionDeregistrationFilessRequest) (*pb.ListCarInspectionDeregistrationFilessResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	records, err := s.repo.List(ctx, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListCarInspectionDeregistrationFiless"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car inspection deregistration files: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	records, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car inspection deregistration files: %w", err)
 	}
 
 	protoRecords := make([]*pb.CarInspectionDeregistrationFiles, len(records))
@@ -185,7 +166,7 @@ func (s *CarInspectionDeregistrationFilesServer) ListCarInspectionDeregistration
 
 	return &pb.ListCarInspectionDeregistrationFilessResponse{
 		CarInspectionDeregistrationFiless: protoRecords,
-		NextPageToken:                     nextPageToken,
+		NextPageToken:                     s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -195,27 +176,15 @@ func (s *CarInspectionDeregistrationFilesServer) ListCarInspectionDeregistration
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	records, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListCarInspectionDeregistrationFilessByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car inspection deregistration files by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	records, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car inspection deregistration files by organization: %w", err)
 	}
 
 	protoRecords := make([]*pb.CarInspectionDeregistrationFiles, len(records))
@@ -225,7 +194,7 @@ func (s *CarInspectionDeregistrationFilesServer) ListCarInspectionDeregistration
 
 	return &pb.ListCarInspectionDeregistrationFilessByOrganizationResponse{
 		CarInspectionDeregistrationFiless: protoRecords,
-		NextPageToken:                     nextPageToken,
+		NextPageToken:                     s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // CarInspectionDeregistrationServer implements the gRPC CarInspectionDeregistrationService
 type CarInspectionDeregistrationServer struct {
 	pb.UnimplementedCarInspectionDeregistrationServiceServer
-	repo *repository.CarInspectionDeregistrationRepository
+	repo       *repository.CarInspectionDeregistrationRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewCarInspectionDeregistrationServer creates a new gRPC server
-func NewCarInspectionDeregistrationServer(repo *repository.CarInspectionDeregistrationRepository) *CarInspectionDeregistrationServer {
-	return &CarInspectionDeregistrationServer{repo: repo}
+func NewCarInspectionDeregistrationServer(repo *repository.CarInspectionDeregistrationRepository, pageTokens *pagination.Tokens) *CarInspectionDeregistrationServer {
+	return &CarInspectionDeregistrationServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateCarInspectionDeregistration creates a new car inspection deregistration record
@@ -46,7 +48,7 @@ func (s *CarInspectionDeregistrationServer) CreateCarInspectionDeregistration(ct
 		req.TwodimensionCodeInfoValidPeriodExpirDate,
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create car inspection deregistration: %v", err)
+		return nil, fmt.Errorf("failed to create car inspection deregistration: %w", err)
 	}
 
 	return &pb.CreateCarInspectionDeregistrationResponse{
@@ -72,10 +74,7 @@ func (s *CarInspectionDeregistrationServer) GetCarInspectionDeregistration(ctx c
 		req.TwodimensionCodeInfoValidPeriodExpirDate,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionDeregistrationNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection deregistration not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get car inspection deregistration: %v", err)
+		return nil, fmt.Errorf("failed to get car inspection deregistration: %w", err)
 	}
 
 	return &pb.GetCarInspectionDeregistrationResponse{
@@ -105,12 +104,10 @@ func (s *CarInspectionDeregistrationServer) UpdateCarInspectionDeregistration(ct
 		req.ValidPeriodExpirDateM,
 		req.ValidPeriodExpirDateD,
 		req.TwodimensionCodeInfoValidPeriodExpirDate,
+		req.Etag,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionDeregistrationNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection deregistration not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update car inspection deregistration: %v", err)
+		return nil, fmt.Errorf("failed to update car inspection deregistration: %w", err)
 	}
 
 	return &pb.UpdateCarInspectionDeregistrationResponse{
@@ -134,12 +131,10 @@ func (s *CarInspectionDeregistrationServer) DeleteCarInspectionDeregistration(ct
 		req.OrganizationId,
 		req.CarId,
 		req.TwodimensionCodeInfoValidPeriodExpirDate,
+		req.Etag,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionDeregistrationNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection deregistration not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete car inspection deregistration: %v", err)
+		return nil, fmt.Errorf("failed to delete car inspection deregistration: %w", err)
 	}
 
 	return &pb.DeleteCarInspectionDeregistrationResponse{
@@ -149,27 +144,15 @@ func (s *CarInspectionDeregistrationServer) DeleteCarInspectionDeregistration(ct
 
 // ListCarInspectionDeregistrations retrieves car inspection deregistration records with pagination
 func (s *CarInspectionDeregistrationServer) ListCarInspectionDeregistrations(ctx context.Context, req *pb.ListCarInspectionDeregistrationsRequest) (*pb.ListCarInspectionDeregistrationsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	records, err := s.repo.List(ctx, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListCarInspectionDeregistrations"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car inspection deregistrations: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	records, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car inspection deregistrations: %w", err)
 	}
 
 	protoRecords := make([]*pb.CarInspectionDeregistration, len(records))
@@ -179,7 +162,7 @@ func (s *CarInspectionDeregistrationServer) ListCarInspectionDeregistrations(ctx
 
 	return &pb.ListCarInspectionDeregistrationsResponse{
 		CarInspectionDeregistrations: protoRecords,
-		NextPageToken:                nextPageToken,
+		NextPageToken:                s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -189,27 +172,15 @@ func (s *CarInspectionDeregistrationServer) ListCarInspectionDeregistrationsByOr
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	records, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListCarInspectionDeregistrationsByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car inspection deregistrations by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	records, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car inspection deregistrations by organization: %w", err)
 	}
 
 	protoRecords := make([]*pb.CarInspectionDeregistration, len(records))
@@ -219,7 +190,7 @@ func (s *CarInspectionDeregistrationServer) ListCarInspectionDeregistrationsByOr
 
 	return &pb.ListCarInspectionDeregistrationsByOrganizationResponse{
 		CarInspectionDeregistrations: protoRecords,
-		NextPageToken:                nextPageToken,
+		NextPageToken:                s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -235,5 +206,6 @@ func toProtoCarInspectionDeregistration(record *repository.CarInspectionDeregist
 		ValidPeriodExpirDateM:                    record.ValidPeriodExpirDateM,
 		ValidPeriodExpirDateD:                    record.ValidPeriodExpirDateD,
 		TwodimensionCodeInfoValidPeriodExpirDate: record.TwodimensionCodeInfoValidPeriodExpirDate,
+		Etag:                                     record.Etag,
 	}
 }
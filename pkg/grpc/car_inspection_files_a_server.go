@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // CarInspectionFilesAServer implements the gRPC CarInspectionFilesAService
 type CarInspectionFilesAServer struct {
 	pb.UnimplementedCarInspectionFilesAServiceServer
-	repo *repository.CarInspectionFilesARepository
+	repo       *repository.CarInspectionFilesARepository
+	pageTokens *pagination.Tokens
 }
 
 // NewCarInspectionFilesAServer creates a new gRPC server
-func NewCarInspectionFilesAServer(repo *repository.CarInspectionFilesARepository) *CarInspectionFilesAServer {
-	return &CarInspectionFilesAServer{repo: repo}
+func NewCarInspectionFilesAServer(repo *repository.CarInspectionFilesARepository, pageTokens *pagination.Tokens) *CarInspectionFilesAServer {
+	return &CarInspectionFilesAServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateCarInspectionFilesA creates a new car inspection files A record
@@ -51,7 +53,7 @@ func (s *CarInspectionFilesAServer) CreateCarInspectionFilesA(ctx context.Contex
 
 	result, err := s.repo.Create(ctx, record)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create car inspection files A: %v", err)
+		return nil, fmt.Errorf("failed to create car inspection files A: %w", err)
 	}
 
 	return &pb.CreateCarInspectionFilesAResponse{
@@ -67,10 +69,7 @@ func (s *CarInspectionFilesAServer) GetCarInspectionFilesA(ctx context.Context,
 
 	record, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionFilesANotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection files A not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get car inspection files A: %v", err)
+		return nil, fmt.Errorf("failed to get car inspection files A: %w", err)
 	}
 
 	return &pb.GetCarInspectionFilesAResponse{
@@ -93,22 +92,16 @@ func (s *CarInspectionFilesAServer) UpdateCarInspectionFilesA(ctx context.Contex
 	// Get the existing record first
 	existing, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionFilesANotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection files A not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get car inspection files A: %v", err)
+		return nil, fmt.Errorf("failed to get car inspection files A: %w", err)
 	}
 
 	// Update mutable fields
 	existing.Type = req.Type
 	existing.Modified = req.Modified
 
-	result, err := s.repo.Update(ctx, existing)
+	result, err := s.repo.Update(ctx, existing, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionFilesANotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection files A not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update car inspection files A: %v", err)
+		return nil, fmt.Errorf("failed to update car inspection files A: %w", err)
 	}
 
 	return &pb.UpdateCarInspectionFilesAResponse{
@@ -125,12 +118,9 @@ func (s *CarInspectionFilesAServer) DeleteCarInspectionFilesA(ctx context.Contex
 	// Generate timestamp for soft delete (in production, use actual timestamp)
 	deletedTime := req.Uuid
 
-	err := s.repo.Delete(ctx, req.Uuid, deletedTime)
+	err := s.repo.Delete(ctx, req.Uuid, deletedTime, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionFilesANotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection files A not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete car inspection files A: %v", err)
+		return nil, fmt.Errorf("failed to delete car inspection files A: %w", err)
 	}
 
 	return &pb.DeleteCarInspectionFilesAResponse{
@@ -140,25 +130,15 @@ func (s *CarInspectionFilesAServer) DeleteCarInspectionFilesA(ctx context.Contex
 
 // ListCarInspectionFilesAs retrieves all car inspection files A records with pagination
 func (s *CarInspectionFilesAServer) ListCarInspectionFilesAs(ctx context.Context, req *pb.ListCarInspectionFilesAsRequest) (*pb.ListCarInspectionFilesAsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	records, err := s.repo.List(ctx, limit+1, offset)
+	scope := []string{"ListCarInspectionFilesAs"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car inspection files A: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next"
+	records, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car inspection files A: %w", err)
 	}
 
 	protoRecords := make([]*pb.CarInspectionFilesA, len(records))
@@ -168,7 +148,7 @@ func (s *CarInspectionFilesAServer) ListCarInspectionFilesAs(ctx context.Context
 
 	return &pb.ListCarInspectionFilesAsResponse{
 		CarInspectionFilesAs: protoRecords,
-		NextPageToken:        nextPageToken,
+		NextPageToken:        s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -178,25 +158,15 @@ func (s *CarInspectionFilesAServer) ListCarInspectionFilesAsByOrganization(ctx c
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	records, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListCarInspectionFilesAsByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car inspection files A by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next"
+	records, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car inspection files A by organization: %w", err)
 	}
 
 	protoRecords := make([]*pb.CarInspectionFilesA, len(records))
@@ -206,7 +176,7 @@ func (s *CarInspectionFilesAServer) ListCarInspectionFilesAsByOrganization(ctx c
 
 	return &pb.ListCarInspectionFilesAsByOrganizationResponse{
 		CarInspectionFilesAs: protoRecords,
-		NextPageToken:        nextPageToken,
+		NextPageToken:        s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -223,6 +193,7 @@ func toProtoCarInspectionFilesA(record *repository.CarInspectionFilesA) *pb.CarI
 		GrantdateD:     record.GrantdateD,
 		Created:        record.Created,
 		Modified:       record.Modified,
+		Etag:           record.Etag,
 	}
 	if record.Deleted != nil {
 		proto.Deleted = record.Deleted
@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // CarInspectionFilesBServer implements the gRPC CarInspectionFilesBService
 type CarInspectionFilesBServer struct {
 	pb.UnimplementedCarInspectionFilesBServiceServer
-	repo *repository.CarInspectionFilesBRepository
+	repo       *repository.CarInspectionFilesBRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewCarInspectionFilesBServer creates a new gRPC server
-func NewCarInspectionFilesBServer(repo *repository.CarInspectionFilesBRepository) *CarInspectionFilesBServer {
-	return &CarInspectionFilesBServer{repo: repo}
+func NewCarInspectionFilesBServer(repo *repository.CarInspectionFilesBRepository, pageTokens *pagination.Tokens) *CarInspectionFilesBServer {
+	return &CarInspectionFilesBServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateCarInspectionFilesB creates a new car inspection files B record
@@ -42,7 +44,7 @@ func (s *CarInspectionFilesBServer) CreateCarInspectionFilesB(ctx context.Contex
 		req.GrantdateD,
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create car inspection files B: %v", err)
+		return nil, fmt.Errorf("failed to create car inspection files B: %w", err)
 	}
 
 	return &pb.CreateCarInspectionFilesBResponse{
@@ -58,10 +60,7 @@ func (s *CarInspectionFilesBServer) GetCarInspectionFilesB(ctx context.Context,
 
 	record, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionFilesBNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection files B not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get car inspection files B: %v", err)
+		return nil, fmt.Errorf("failed to get car inspection files B: %w", err)
 	}
 
 	return &pb.GetCarInspectionFilesBResponse{
@@ -84,10 +83,7 @@ func (s *CarInspectionFilesBServer) UpdateCarInspectionFilesB(ctx context.Contex
 	// Get the existing record first to preserve immutable fields
 	existing, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionFilesBNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection files B not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get car inspection files B: %v", err)
+		return nil, fmt.Errorf("failed to get car inspection files B: %w", err)
 	}
 
 	// Update with mutable fields (type and modified)
@@ -101,12 +97,10 @@ func (s *CarInspectionFilesBServer) UpdateCarInspectionFilesB(ctx context.Contex
 		existing.GrantdateY,
 		existing.GrantdateM,
 		existing.GrantdateD,
+		req.Etag,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionFilesBNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection files B not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update car inspection files B: %v", err)
+		return nil, fmt.Errorf("failed to update car inspection files B: %w", err)
 	}
 
 	return &pb.UpdateCarInspectionFilesBResponse{
@@ -120,12 +114,9 @@ func (s *CarInspectionFilesBServer) DeleteCarInspectionFilesB(ctx context.Contex
 		return nil, status.Error(codes.InvalidArgument, "uuid is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Uuid)
+	err := s.repo.Delete(ctx, req.Uuid, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionFilesBNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection files B not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete car inspection files B: %v", err)
+		return nil, fmt.Errorf("failed to delete car inspection files B: %w", err)
 	}
 
 	return &pb.DeleteCarInspectionFilesBResponse{
@@ -135,25 +126,15 @@ func (s *CarInspectionFilesBServer) DeleteCarInspectionFilesB(ctx context.Contex
 
 // ListCarInspectionFilesBs retrieves all car inspection files B records with pagination
 func (s *CarInspectionFilesBServer) ListCarInspectionFilesBs(ctx context.Context, req *pb.ListCarInspectionFilesBsRequest) (*pb.ListCarInspectionFilesBsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	records, err := s.repo.List(ctx, limit+1, offset)
+	scope := []string{"ListCarInspectionFilesBs"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car inspection files B: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next"
+	records, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car inspection files B: %w", err)
 	}
 
 	protoRecords := make([]*pb.CarInspectionFilesB, len(records))
@@ -163,7 +144,7 @@ func (s *CarInspectionFilesBServer) ListCarInspectionFilesBs(ctx context.Context
 
 	return &pb.ListCarInspectionFilesBsResponse{
 		CarInspectionFilesBs: protoRecords,
-		NextPageToken:        nextPageToken,
+		NextPageToken:        s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -173,25 +154,15 @@ func (s *CarInspectionFilesBServer) ListCarInspectionFilesBsByOrganization(ctx c
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	records, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListCarInspectionFilesBsByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car inspection files B by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next"
+	records, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car inspection files B by organization: %w", err)
 	}
 
 	protoRecords := make([]*pb.CarInspectionFilesB, len(records))
@@ -201,7 +172,7 @@ func (s *CarInspectionFilesBServer) ListCarInspectionFilesBsByOrganization(ctx c
 
 	return &pb.ListCarInspectionFilesBsByOrganizationResponse{
 		CarInspectionFilesBs: protoRecords,
-		NextPageToken:        nextPageToken,
+		NextPageToken:        s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -218,6 +189,7 @@ func toProtoCarInspectionFilesB(record *repository.CarInspectionFilesB) *pb.CarI
 		GrantdateD:     record.GrantdateD,
 		Created:        record.Created,
 		Modified:       record.Modified,
+		Etag:           record.Etag,
 	}
 	if record.Deleted != nil {
 		proto.Deleted = record.Deleted
@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // CarInspectionFilesServer implements the gRPC CarInspectionFilesService
 type CarInspectionFilesServer struct {
 	pb.UnimplementedCarInspectionFilesServiceServer
-	repo *repository.CarInspectionFilesRepository
+	repo       *repository.CarInspectionFilesRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewCarInspectionFilesServer creates a new gRPC server
-func NewCarInspectionFilesServer(repo *repository.CarInspectionFilesRepository) *CarInspectionFilesServer {
-	return &CarInspectionFilesServer{repo: repo}
+func NewCarInspectionFilesServer(repo *repository.CarInspectionFilesRepository, pageTokens *pagination.Tokens) *CarInspectionFilesServer {
+	return &CarInspectionFilesServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateCarInspectionFile creates a new car inspection file
@@ -50,7 +52,7 @@ func (s *CarInspectionFilesServer) CreateCarInspectionFile(ctx context.Context,
 		req.Modified,
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create car inspection file: %v", err)
+		return nil, fmt.Errorf("failed to create car inspection file: %w", err)
 	}
 
 	return &pb.CreateCarInspectionFileResponse{
@@ -66,10 +68,7 @@ func (s *CarInspectionFilesServer) GetCarInspectionFile(ctx context.Context, req
 
 	file, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionFileNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection file not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get car inspection file: %v", err)
+		return nil, fmt.Errorf("failed to get car inspection file: %w", err)
 	}
 
 	return &pb.GetCarInspectionFileResponse{
@@ -92,10 +91,7 @@ func (s *CarInspectionFilesServer) UpdateCarInspectionFile(ctx context.Context,
 	// Get the existing record first
 	existing, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionFileNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection file not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get car inspection file: %v", err)
+		return nil, fmt.Errorf("failed to get car inspection file: %w", err)
 	}
 
 	// Update mutable fields from the request
@@ -109,12 +105,10 @@ func (s *CarInspectionFilesServer) UpdateCarInspectionFile(ctx context.Context,
 		existing.ElectCertPublishdateM,
 		existing.ElectCertPublishdateD,
 		req.Modified,
+		req.Etag,
 	)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionFileNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection file not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update car inspection file: %v", err)
+		return nil, fmt.Errorf("failed to update car inspection file: %w", err)
 	}
 
 	return &pb.UpdateCarInspectionFileResponse{
@@ -131,12 +125,9 @@ func (s *CarInspectionFilesServer) DeleteCarInspectionFile(ctx context.Context,
 	// Generate timestamp for soft delete
 	deletedTimestamp := req.Uuid // In production, use actual timestamp
 
-	err := s.repo.Delete(ctx, req.Uuid, deletedTimestamp)
+	err := s.repo.Delete(ctx, req.Uuid, deletedTimestamp, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionFileNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection file not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete car inspection file: %v", err)
+		return nil, fmt.Errorf("failed to delete car inspection file: %w", err)
 	}
 
 	return &pb.DeleteCarInspectionFileResponse{
@@ -146,25 +137,15 @@ func (s *CarInspectionFilesServer) DeleteCarInspectionFile(ctx context.Context,
 
 // ListCarInspectionFiles retrieves all car inspection files with pagination
 func (s *CarInspectionFilesServer) ListCarInspectionFiles(ctx context.Context, req *pb.ListCarInspectionFilesRequest) (*pb.ListCarInspectionFilesResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	files, err := s.repo.List(ctx, limit+1, offset)
+	scope := []string{"ListCarInspectionFiles"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car inspection files: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(files) > limit {
-		files = files[:limit]
-		nextPageToken = "next"
+	files, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car inspection files: %w", err)
 	}
 
 	protoFiles := make([]*pb.CarInspectionFile, len(files))
@@ -174,7 +155,7 @@ func (s *CarInspectionFilesServer) ListCarInspectionFiles(ctx context.Context, r
 
 	return &pb.ListCarInspectionFilesResponse{
 		CarInspectionFiles: protoFiles,
-		NextPageToken:      nextPageToken,
+		NextPageToken:      s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -184,25 +165,15 @@ func (s *CarInspectionFilesServer) ListCarInspectionFilesByOrganization(ctx cont
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	files, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListCarInspectionFilesByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car inspection files by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(files) > limit {
-		files = files[:limit]
-		nextPageToken = "next"
+	files, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car inspection files by organization: %w", err)
 	}
 
 	protoFiles := make([]*pb.CarInspectionFile, len(files))
@@ -212,7 +183,7 @@ func (s *CarInspectionFilesServer) ListCarInspectionFilesByOrganization(ctx cont
 
 	return &pb.ListCarInspectionFilesByOrganizationResponse{
 		CarInspectionFiles: protoFiles,
-		NextPageToken:      nextPageToken,
+		NextPageToken:      s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -229,6 +200,7 @@ func toProtoCarInspectionFile(file *repository.CarInspectionFile) *pb.CarInspect
 		ElectCertPublishdateD: file.ElectCertPublishdateD,
 		Created:               file.Created,
 		Modified:              file.Modified,
+		Etag:                  file.Etag,
 	}
 	if file.Deleted != nil {
 		proto.Deleted = file.Deleted
@@ -2,24 +2,28 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/service"
 )
 
 // CarInspectionServer implements the gRPC CarInspectionService
 type CarInspectionServer struct {
 	pb.UnimplementedCarInspectionServiceServer
-	repo *repository.CarInspectionRepository
+	repo       *repository.CarInspectionRepository
+	svc        *service.CarInspectionService
+	pageTokens *pagination.Tokens
 }
 
 // NewCarInspectionServer creates a new gRPC server
-func NewCarInspectionServer(repo *repository.CarInspectionRepository) *CarInspectionServer {
-	return &CarInspectionServer{repo: repo}
+func NewCarInspectionServer(repo *repository.CarInspectionRepository, svc *service.CarInspectionService, pageTokens *pagination.Tokens) *CarInspectionServer {
+	return &CarInspectionServer{repo: repo, svc: svc, pageTokens: pageTokens}
 }
 
 // CreateCarInspection creates a new car inspection record
@@ -30,6 +34,17 @@ func (s *CarInspectionServer) CreateCarInspection(ctx context.Context, req *pb.C
 	if req.ElectCertMgNo == "" {
 		return nil, status.Error(codes.InvalidArgument, "elect_cert_mg_no is required")
 	}
+	for _, f := range req.Files {
+		if f.Type == "" {
+			return nil, status.Error(codes.InvalidArgument, "files.type is required")
+		}
+		if f.Created == "" {
+			return nil, status.Error(codes.InvalidArgument, "files.created is required")
+		}
+		if f.Modified == "" {
+			return nil, status.Error(codes.InvalidArgument, "files.modified is required")
+		}
+	}
 
 	inspection := &repository.CarInspection{
 		OrganizationID:                                              req.OrganizationId,
@@ -132,13 +147,28 @@ func (s *CarInspectionServer) CreateCarInspection(ctx context.Context, req *pb.C
 		Modified:                                                    req.Modified,
 	}
 
-	result, err := s.repo.Create(ctx, inspection)
+	files := make([]*repository.CarInspectionFile, len(req.Files))
+	for i, f := range req.Files {
+		files[i] = &repository.CarInspectionFile{
+			Type:     f.Type,
+			Created:  f.Created,
+			Modified: f.Modified,
+		}
+	}
+
+	result, createdFiles, err := s.svc.CreateWithFiles(ctx, inspection, files)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create car inspection: %v", err)
+		return nil, fmt.Errorf("failed to create car inspection: %w", err)
+	}
+
+	protoFiles := make([]*pb.CarInspectionFile, len(createdFiles))
+	for i, f := range createdFiles {
+		protoFiles[i] = toProtoCarInspectionFile(f)
 	}
 
 	return &pb.CreateCarInspectionResponse{
-		CarInspection: toProtoCarInspection(result),
+		CarInspection:      toProtoCarInspection(result),
+		CarInspectionFiles: protoFiles,
 	}, nil
 }
 
@@ -165,10 +195,7 @@ func (s *CarInspectionServer) GetCarInspection(ctx context.Context, req *pb.GetC
 
 	inspection, err := s.repo.GetByPrimaryKey(ctx, req.OrganizationId, req.ElectCertMgNo, req.ElectCertPublishdateE, req.ElectCertPublishdateY, req.ElectCertPublishdateM, req.ElectCertPublishdateD)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get car inspection: %v", err)
+		return nil, fmt.Errorf("failed to get car inspection: %w", err)
 	}
 
 	return &pb.GetCarInspectionResponse{
@@ -187,14 +214,9 @@ func (s *CarInspectionServer) UpdateCarInspection(ctx context.Context, req *pb.U
 	if req.Modified == "" {
 		return nil, status.Error(codes.InvalidArgument, "modified is required")
 	}
-
-	// Get the existing record first to preserve the created timestamp
-	existing, err := s.repo.GetByPrimaryKey(ctx, req.OrganizationId, req.ElectCertMgNo, req.ElectCertPublishdateE, req.ElectCertPublishdateY, req.ElectCertPublishdateM, req.ElectCertPublishdateD)
+	mask, err := updateMaskPaths(req, req.UpdateMask)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get car inspection: %v", err)
+		return nil, err
 	}
 
 	inspection := &repository.CarInspection{
@@ -294,16 +316,12 @@ func (s *CarInspectionServer) UpdateCarInspection(ctx context.Context, req *pb.U
 		TwodimensionCodeInfoSafeStdDate:                             req.TwodimensionCodeInfoSafeStdDate,
 		TwodimensionCodeInfoFuelClassCode:                           req.TwodimensionCodeInfoFuelClassCode,
 		RegistCarLightCar:                                           req.RegistCarLightCar,
-		Created:                                                     existing.Created,
 		Modified:                                                    req.Modified,
 	}
 
-	result, err := s.repo.Update(ctx, inspection)
+	result, err := s.repo.Update(ctx, inspection, mask, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update car inspection: %v", err)
+		return nil, fmt.Errorf("failed to update car inspection: %w", err)
 	}
 
 	return &pb.UpdateCarInspectionResponse{
@@ -311,7 +329,7 @@ func (s *CarInspectionServer) UpdateCarInspection(ctx context.Context, req *pb.U
 	}, nil
 }
 
-// DeleteCarInspection hard-deletes a car inspection
+// DeleteCarInspection hard-deletes a car inspection and soft-deletes its files
 func (s *CarInspectionServer) DeleteCarInspection(ctx context.Context, req *pb.DeleteCarInspectionRequest) (*pb.DeleteCarInspectionResponse, error) {
 	if req.OrganizationId == "" {
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
@@ -332,40 +350,32 @@ func (s *CarInspectionServer) DeleteCarInspection(ctx context.Context, req *pb.D
 		return nil, status.Error(codes.InvalidArgument, "elect_cert_publishdate_d is required")
 	}
 
-	err := s.repo.Delete(ctx, req.OrganizationId, req.ElectCertMgNo, req.ElectCertPublishdateE, req.ElectCertPublishdateY, req.ElectCertPublishdateM, req.ElectCertPublishdateD)
+	deletedFiles, err := s.svc.Delete(ctx, req.OrganizationId, req.ElectCertMgNo, req.ElectCertPublishdateE, req.ElectCertPublishdateY, req.ElectCertPublishdateM, req.ElectCertPublishdateD, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrCarInspectionNotFound) {
-			return nil, status.Error(codes.NotFound, "car inspection not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete car inspection: %v", err)
+		return nil, fmt.Errorf("failed to delete car inspection: %w", err)
 	}
 
 	return &pb.DeleteCarInspectionResponse{
-		Success: true,
+		Success:      true,
+		DeletedFiles: int32(deletedFiles),
 	}, nil
 }
 
 // ListCarInspections retrieves all car inspections with pagination
 func (s *CarInspectionServer) ListCarInspections(ctx context.Context, req *pb.ListCarInspectionsRequest) (*pb.ListCarInspectionsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	inspections, err := s.repo.List(ctx, limit+1, offset)
+	scope := []string{"ListCarInspections", req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car inspections: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(inspections) > limit {
-		inspections = inspections[:limit]
-		nextPageToken = "next"
+	inspections, next, err := s.repo.List(ctx, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car inspections: %w", err)
 	}
 
 	protoInspections := make([]*pb.CarInspection, len(inspections))
@@ -375,7 +385,7 @@ func (s *CarInspectionServer) ListCarInspections(ctx context.Context, req *pb.Li
 
 	return &pb.ListCarInspectionsResponse{
 		CarInspections: protoInspections,
-		NextPageToken:  nextPageToken,
+		NextPageToken:  s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -385,25 +395,19 @@ func (s *CarInspectionServer) ListCarInspectionsByOrganization(ctx context.Conte
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	inspections, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListCarInspectionsByOrganization", req.OrganizationId, req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list car inspections by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(inspections) > limit {
-		inspections = inspections[:limit]
-		nextPageToken = "next"
+	inspections, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car inspections by organization: %w", err)
 	}
 
 	protoInspections := make([]*pb.CarInspection, len(inspections))
@@ -413,7 +417,7 @@ func (s *CarInspectionServer) ListCarInspectionsByOrganization(ctx context.Conte
 
 	return &pb.ListCarInspectionsByOrganizationResponse{
 		CarInspections: protoInspections,
-		NextPageToken:  nextPageToken,
+		NextPageToken:  s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -518,5 +522,6 @@ func toProtoCarInspection(inspection *repository.CarInspection) *pb.CarInspectio
 		RegistCarLightCar:                                         inspection.RegistCarLightCar,
 		Created:                                                   inspection.Created,
 		Modified:                                                  inspection.Modified,
+		Etag:                                                      inspection.Etag,
 	}
 }
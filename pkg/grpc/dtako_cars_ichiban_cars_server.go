@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // DtakoCarsIchibanCarsServer implements the gRPC DtakoCarsIchibanCarsService
 type DtakoCarsIchibanCarsServer struct {
 	pb.UnimplementedDtakoCarsIchibanCarsServiceServer
-	repo *repository.DtakoCarsIchibanCarsRepository
+	repo       *repository.DtakoCarsIchibanCarsRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewDtakoCarsIchibanCarsServer creates a new gRPC server
-func NewDtakoCarsIchibanCarsServer(repo *repository.DtakoCarsIchibanCarsRepository) *DtakoCarsIchibanCarsServer {
-	return &DtakoCarsIchibanCarsServer{repo: repo}
+func NewDtakoCarsIchibanCarsServer(repo *repository.DtakoCarsIchibanCarsRepository, pageTokens *pagination.Tokens) *DtakoCarsIchibanCarsServer {
+	return &DtakoCarsIchibanCarsServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateDtakoCarsIchibanCars creates a new dtako_cars_ichiban_cars entry
@@ -38,7 +40,7 @@ func (s *DtakoCarsIchibanCarsServer) CreateDtakoCarsIchibanCars(ctx context.Cont
 
 	entry, err := s.repo.Create(ctx, req.IdDtako, req.OrganizationId, id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create dtako cars ichiban cars entry: %v", err)
+		return nil, fmt.Errorf("failed to create dtako cars ichiban cars entry: %w", err)
 	}
 
 	return &pb.CreateDtakoCarsIchibanCarsResponse{
@@ -57,10 +59,7 @@ func (s *DtakoCarsIchibanCarsServer) GetDtakoCarsIchibanCars(ctx context.Context
 
 	entry, err := s.repo.GetByDtakoAndOrg(ctx, req.IdDtako, req.OrganizationId)
 	if err != nil {
-		if errors.Is(err, repository.ErrDtakoCarsIchibanCarsNotFound) {
-			return nil, status.Error(codes.NotFound, "dtako cars ichiban cars entry not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get dtako cars ichiban cars entry: %v", err)
+		return nil, fmt.Errorf("failed to get dtako cars ichiban cars entry: %w", err)
 	}
 
 	return &pb.GetDtakoCarsIchibanCarsResponse{
@@ -82,12 +81,9 @@ func (s *DtakoCarsIchibanCarsServer) UpdateDtakoCarsIchibanCars(ctx context.Cont
 		id = req.Id
 	}
 
-	entry, err := s.repo.Update(ctx, req.IdDtako, req.OrganizationId, id)
+	entry, err := s.repo.Update(ctx, req.IdDtako, req.OrganizationId, id, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrDtakoCarsIchibanCarsNotFound) {
-			return nil, status.Error(codes.NotFound, "dtako cars ichiban cars entry not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update dtako cars ichiban cars entry: %v", err)
+		return nil, fmt.Errorf("failed to update dtako cars ichiban cars entry: %w", err)
 	}
 
 	return &pb.UpdateDtakoCarsIchibanCarsResponse{
@@ -104,12 +100,9 @@ func (s *DtakoCarsIchibanCarsServer) DeleteDtakoCarsIchibanCars(ctx context.Cont
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	err := s.repo.Delete(ctx, req.IdDtako, req.OrganizationId)
+	err := s.repo.Delete(ctx, req.IdDtako, req.OrganizationId, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrDtakoCarsIchibanCarsNotFound) {
-			return nil, status.Error(codes.NotFound, "dtako cars ichiban cars entry not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete dtako cars ichiban cars entry: %v", err)
+		return nil, fmt.Errorf("failed to delete dtako cars ichiban cars entry: %w", err)
 	}
 
 	return &pb.DeleteDtakoCarsIchibanCarsResponse{
@@ -130,25 +123,15 @@ func (s *DtakoCarsIchibanCarsServer) ListDtakoCarsIchibanCarsByOrganization(ctx
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	entries, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListDtakoCarsIchibanCarsByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list dtako cars ichiban cars by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(entries) > limit {
-		entries = entries[:limit]
-		nextPageToken = "next"
+	entries, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dtako cars ichiban cars by organization: %w", err)
 	}
 
 	protoEntries := make([]*pb.DtakoCarsIchibanCars, len(entries))
@@ -158,7 +141,7 @@ func (s *DtakoCarsIchibanCarsServer) ListDtakoCarsIchibanCarsByOrganization(ctx
 
 	return &pb.ListDtakoCarsIchibanCarsByOrganizationResponse{
 		DtakoCarsIchibanCars: protoEntries,
-		NextPageToken:        nextPageToken,
+		NextPageToken:        s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -167,6 +150,7 @@ func toProtoDtakoCarsIchibanCars(entry *repository.DtakoCarsIchibanCars) *pb.Dta
 	proto := &pb.DtakoCarsIchibanCars{
 		IdDtako:        entry.IdDtako,
 		OrganizationId: entry.OrganizationID,
+		Etag:           entry.Etag,
 	}
 
 	if entry.Id != nil {
@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // DtakologsServer implements the gRPC DtakologsService
 type DtakologsServer struct {
 	pb.UnimplementedDtakologsServiceServer
-	repo *repository.DtakologsRepository
+	repo       *repository.DtakologsRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewDtakologsServer creates a new gRPC server
-func NewDtakologsServer(repo *repository.DtakologsRepository) *DtakologsServer {
-	return &DtakologsServer{repo: repo}
+func NewDtakologsServer(repo *repository.DtakologsRepository, pageTokens *pagination.Tokens) *DtakologsServer {
+	return &DtakologsServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateDtakologs creates a new dtakologs record
@@ -97,7 +99,7 @@ func (s *DtakologsServer) CreateDtakologs(ctx context.Context, req *pb.CreateDta
 
 	err := s.repo.Create(ctx, d)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create dtakologs: %v", err)
+		return nil, fmt.Errorf("failed to create dtakologs: %w", err)
 	}
 
 	return &pb.CreateDtakologsResponse{
@@ -196,12 +198,9 @@ func (s *DtakologsServer) UpdateDtakologs(ctx context.Context, req *pb.UpdateDta
 		VehicleName:                  req.VehicleName,
 	}
 
-	err := s.repo.Update(ctx, d)
+	err := s.repo.Update(ctx, d, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrDtakologsNotFound) {
-			return nil, status.Error(codes.NotFound, "dtakologs not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update dtakologs: %v", err)
+		return nil, fmt.Errorf("failed to update dtakologs: %w", err)
 	}
 
 	return &pb.UpdateDtakologsResponse{
@@ -227,27 +226,19 @@ func (s *DtakologsServer) DeleteDtakologs(ctx context.Context, req *pb.DeleteDta
 
 // ListDtakologs retrieves all dtakologs records with pagination
 func (s *DtakologsServer) ListDtakologs(ctx context.Context, req *pb.ListDtakologsRequest) (*pb.ListDtakologsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	records, err := s.repo.List(ctx, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListDtakologs", req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list dtakologs: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	records, next, err := s.repo.List(ctx, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dtakologs: %w", err)
 	}
 
 	protoDtakologs := make([]*pb.Dtakologs, len(records))
@@ -257,7 +248,7 @@ func (s *DtakologsServer) ListDtakologs(ctx context.Context, req *pb.ListDtakolo
 
 	return &pb.ListDtakologsResponse{
 		Dtakologs:     protoDtakologs,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -267,27 +258,19 @@ func (s *DtakologsServer) ListDtakologsByOrganization(ctx context.Context, req *
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	records, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListDtakologsByOrganization", req.OrganizationId, req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list dtakologs by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(records) > limit {
-		records = records[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	records, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dtakologs by organization: %w", err)
 	}
 
 	protoDtakologs := make([]*pb.Dtakologs, len(records))
@@ -297,7 +280,7 @@ func (s *DtakologsServer) ListDtakologsByOrganization(ctx context.Context, req *
 
 	return &pb.ListDtakologsByOrganizationResponse{
 		Dtakologs:     protoDtakologs,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -335,6 +318,7 @@ func toProtoDtakologs(d *repository.Dtakologs) *pb.Dtakologs {
 		TempState:                   d.TempState,
 		VehicleCd:                   d.VehicleCd,
 		VehicleName:                 d.VehicleName,
+		Etag:                        d.Etag,
 	}
 
 	// Handle optional fields
@@ -0,0 +1,158 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+// errorDomain is the ErrorInfo domain of repository errors
+const errorDomain = "postgres-prod"
+
+// internalErrorMessage replaces the message of internal errors, which may contain SQL
+const internalErrorMessage = "internal error"
+
+// queryTimeoutMessage is sent when Postgres canceled a statement at its statement timeout
+const queryTimeoutMessage = "query timed out"
+
+// invalidPageTokenMessage is sent for page tokens not issued for the listing
+const invalidPageTokenMessage = "invalid page_token"
+
+// repositoryErrorCodes maps the repository error categories to gRPC codes
+var repositoryErrorCodes = []struct {
+	kind   error
+	code   codes.Code
+	reason string
+}{
+	{repository.ErrNotFound, codes.NotFound, "NOT_FOUND"},
+	{repository.ErrAlreadyExists, codes.AlreadyExists, "ALREADY_EXISTS"},
+	{repository.ErrForeignKeyViolation, codes.FailedPrecondition, "FOREIGN_KEY_VIOLATION"},
+	{repository.ErrCheckViolation, codes.InvalidArgument, "CHECK_VIOLATION"},
+	{repository.ErrConflict, codes.Aborted, "CONFLICT"},
+	{repository.ErrInvalidArgument, codes.InvalidArgument, "INVALID_ARGUMENT"},
+	{repository.ErrEtagMismatch, codes.Aborted, "ETAG_MISMATCH"},
+}
+
+// errorStatus converts an error returned by a handler to the status sent to the client:
+//   - repository errors (including classified Postgres errors) get the code of their
+//     category, their client-facing message and an ErrorInfo with the constraint and field
+//   - context errors become Canceled or DeadlineExceeded, and so do statements Postgres
+//     canceled at the statement timeout of the call
+//   - page tokens that were not issued for the listing become InvalidArgument
+//   - status errors are kept, except that Internal and Unknown messages are replaced
+//   - any other error becomes Internal
+//
+// internal reports whether the original error was hidden from the client.
+func errorStatus(err error) (st *status.Status, internal bool) {
+	if err == nil {
+		return status.New(codes.OK, ""), false
+	}
+
+	var repoErr *repository.Error
+	if errors.As(repository.Classify(err), &repoErr) {
+		return repositoryErrorStatus(repoErr), false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err), false
+	}
+	if db.IsQueryCanceled(err) {
+		return status.New(codes.DeadlineExceeded, queryTimeoutMessage), false
+	}
+	if errors.Is(err, pagination.ErrInvalidToken) {
+		return status.New(codes.InvalidArgument, invalidPageTokenMessage), false
+	}
+
+	if st, ok := status.FromError(err); ok {
+		if st.Code() == codes.Internal || st.Code() == codes.Unknown {
+			return status.New(st.Code(), internalErrorMessage), true
+		}
+		return st, false
+	}
+
+	return status.New(codes.Internal, internalErrorMessage), true
+}
+
+// repositoryErrorStatus builds the status of a repository error
+func repositoryErrorStatus(e *repository.Error) *status.Status {
+	code, reason := codes.Internal, "INTERNAL"
+	for _, m := range repositoryErrorCodes {
+		if errors.Is(e.Kind, m.kind) {
+			code, reason = m.code, m.reason
+			break
+		}
+	}
+
+	st := status.New(code, e.Message)
+	if e.Constraint == "" && e.Field == "" {
+		return st
+	}
+
+	info := &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: map[string]string{}}
+	if e.Constraint != "" {
+		info.Metadata["constraint"] = e.Constraint
+	}
+	if e.Field != "" {
+		info.Metadata["field"] = e.Field
+	}
+	details := []protoadapt.MessageV1{info}
+	if e.Field != "" {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: e.Field, Description: e.Message}},
+		})
+	}
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+// toStatusError converts a handler error for the client and logs errors that were hidden
+func toStatusError(fullMethod string, err error) error {
+	if err == nil {
+		return nil
+	}
+	st, internal := errorStatus(err)
+	if internal {
+		log.Printf("%s failed: %v", fullMethod, err)
+	}
+	return st.Err()
+}
+
+// ErrorUnaryInterceptor converts errors returned by the interceptors and handlers after it
+// to gRPC statuses (see errorStatus), so no SQL reaches clients. It runs first in the chain.
+func ErrorUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, toStatusError(info.FullMethod, err)
+	}
+}
+
+// ErrorStreamInterceptor converts errors of streaming RPCs like ErrorUnaryInterceptor
+func ErrorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return toStatusError(info.FullMethod, handler(srv, ss))
+	}
+}
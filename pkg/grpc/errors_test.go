@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+func TestErrorUnaryInterceptor(t *testing.T) {
+	uniqueErr := &pgconn.PgError{
+		Code:           "23505",
+		Message:        `duplicate key value violates unique constraint "organizations_slug_key"`,
+		Detail:         "Key (slug)=(acme) already exists.",
+		ConstraintName: "organizations_slug_key",
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{"nil", nil, codes.OK, ""},
+		{"not found", fmt.Errorf("failed to get kudgivt: %w", repository.ErrKudgivtNotFound), codes.NotFound, "kudgivt not found"},
+		{"unique violation", fmt.Errorf("failed to create organization: %w", uniqueErr), codes.AlreadyExists, "record already exists"},
+		{"foreign key violation", &pgconn.PgError{Code: "23503"}, codes.FailedPrecondition, "referenced record does not exist"},
+		{"check violation", &pgconn.PgError{Code: "23514"}, codes.InvalidArgument, "value violates a check constraint"},
+		{"deadlock", &pgconn.PgError{Code: "40P01"}, codes.Aborted, "concurrent update conflict, retry the request"},
+		{"other sql error", fmt.Errorf("failed to list: %w", &pgconn.PgError{Code: "42P01", Message: `relation "x" does not exist`}), codes.Internal, internalErrorMessage},
+		{"internal status", status.Error(codes.Internal, "failed to verify: SELECT 1"), codes.Internal, internalErrorMessage},
+		{"status", status.Error(codes.PermissionDenied, "denied"), codes.PermissionDenied, "denied"},
+		{"deadline", fmt.Errorf("query: %w", context.DeadlineExceeded), codes.DeadlineExceeded, ""},
+		{"statement timeout", fmt.Errorf("failed to list dtakologs: %w", &pgconn.PgError{Code: "57014"}), codes.DeadlineExceeded, queryTimeoutMessage},
+		{"invalid filter", &repository.Error{Kind: repository.ErrInvalidArgument, Message: `invalid filter: unknown field "x"`, Field: "filter"}, codes.InvalidArgument, `invalid filter: unknown field "x"`},
+		{"etag mismatch", fmt.Errorf("failed to update car inspection: %w", &repository.Error{Kind: repository.ErrEtagMismatch, Message: "record was modified since it was read", Field: "etag"}), codes.Aborted, "record was modified since it was read"},
+		{"page token", fmt.Errorf("failed to list kudgivts: %w", pagination.ErrInvalidToken), codes.InvalidArgument, invalidPageTokenMessage},
+		{"plain", errors.New("boom"), codes.Internal, internalErrorMessage},
+	}
+
+	interceptor := ErrorUnaryInterceptor()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, tt.err }
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/organization.X/Y"}, handler)
+			st := status.Convert(err)
+			if st.Code() != tt.wantCode {
+				t.Fatalf("code = %v, want %v", st.Code(), tt.wantCode)
+			}
+			if tt.wantMsg != "" && st.Message() != tt.wantMsg {
+				t.Errorf("message = %q, want %q", st.Message(), tt.wantMsg)
+			}
+			if strings.Contains(st.Message(), "relation") || strings.Contains(st.Message(), "SELECT") {
+				t.Errorf("message leaks SQL: %q", st.Message())
+			}
+		})
+	}
+}
+
+func TestErrorUnaryInterceptor_Details(t *testing.T) {
+	pgErr := &pgconn.PgError{Code: "23505", ConstraintName: "organizations_slug_key", Detail: "Key (slug)=(acme) already exists."}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, pgErr }
+	_, err := ErrorUnaryInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/organization.X/Y"}, handler)
+
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, d := range status.Convert(err).Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			badRequest = d
+		}
+	}
+	if info == nil {
+		t.Fatal("missing ErrorInfo")
+	}
+	if info.Reason != "ALREADY_EXISTS" || info.Metadata["constraint"] != "organizations_slug_key" || info.Metadata["field"] != "slug" {
+		t.Errorf("ErrorInfo = %v", info)
+	}
+	if badRequest == nil || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Field != "slug" {
+		t.Errorf("BadRequest = %v", badRequest)
+	}
+}
+
+func TestAuditEvent_StatusCode(t *testing.T) {
+	event := newAuditEvent(context.Background(), "/organization.KudgivtService/DeleteKudgivt", "Kudgivt", nil, nil, nil,
+		fmt.Errorf("failed to delete kudgivt: %w", repository.ErrKudgivtNotFound))
+	if event.StatusCode != codes.NotFound.String() {
+		t.Errorf("StatusCode = %q, want %q", event.StatusCode, codes.NotFound.String())
+	}
+}
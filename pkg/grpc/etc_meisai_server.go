@@ -3,12 +3,14 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -16,12 +18,13 @@ import (
 // ETCMeisaiServer implements the gRPC ETCMeisaiService
 type ETCMeisaiServer struct {
 	pb.UnimplementedETCMeisaiServiceServer
-	repo *repository.ETCMeisaiRepository
+	repo       *repository.ETCMeisaiRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewETCMeisaiServer creates a new gRPC server
-func NewETCMeisaiServer(repo *repository.ETCMeisaiRepository) *ETCMeisaiServer {
-	return &ETCMeisaiServer{repo: repo}
+func NewETCMeisaiServer(repo *repository.ETCMeisaiRepository, pageTokens *pagination.Tokens) *ETCMeisaiServer {
+	return &ETCMeisaiServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateETCMeisai creates a new ETC meisai record
@@ -84,7 +87,7 @@ func (s *ETCMeisaiServer) CreateETCMeisai(ctx context.Context, req *pb.CreateETC
 
 	result, err := s.repo.Create(ctx, orgID, meisai)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create etc_meisai: %v", err)
+		return nil, fmt.Errorf("failed to create etc_meisai: %w", err)
 	}
 
 	return &pb.CreateETCMeisaiResponse{
@@ -100,10 +103,7 @@ func (s *ETCMeisaiServer) GetETCMeisai(ctx context.Context, req *pb.GetETCMeisai
 
 	result, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, repository.ErrETCMeisaiNotFound) {
-			return nil, status.Error(codes.NotFound, "etc_meisai not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get etc_meisai: %v", err)
+		return nil, fmt.Errorf("failed to get etc_meisai: %w", err)
 	}
 
 	return &pb.GetETCMeisaiResponse{
@@ -124,7 +124,7 @@ func (s *ETCMeisaiServer) GetETCMeisaiByHash(ctx context.Context, req *pb.GetETC
 				Exists: false,
 			}, nil
 		}
-		return nil, status.Errorf(codes.Internal, "failed to get etc_meisai by hash: %v", err)
+		return nil, fmt.Errorf("failed to get etc_meisai by hash: %w", err)
 	}
 
 	return &pb.GetETCMeisaiByHashResponse{
@@ -142,10 +142,7 @@ func (s *ETCMeisaiServer) UpdateETCMeisai(ctx context.Context, req *pb.UpdateETC
 	// Get existing record
 	existing, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, repository.ErrETCMeisaiNotFound) {
-			return nil, status.Error(codes.NotFound, "etc_meisai not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get etc_meisai: %v", err)
+		return nil, fmt.Errorf("failed to get etc_meisai: %w", err)
 	}
 
 	// Update fields
@@ -189,9 +186,9 @@ func (s *ETCMeisaiServer) UpdateETCMeisai(ctx context.Context, req *pb.UpdateETC
 		existing.Hash = req.Hash
 	}
 
-	result, err := s.repo.Update(ctx, existing)
+	result, err := s.repo.Update(ctx, existing, req.Etag)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update etc_meisai: %v", err)
+		return nil, fmt.Errorf("failed to update etc_meisai: %w", err)
 	}
 
 	return &pb.UpdateETCMeisaiResponse{
@@ -205,12 +202,9 @@ func (s *ETCMeisaiServer) DeleteETCMeisai(ctx context.Context, req *pb.DeleteETC
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Id)
+	err := s.repo.Delete(ctx, req.Id, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrETCMeisaiNotFound) {
-			return nil, status.Error(codes.NotFound, "etc_meisai not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete etc_meisai: %v", err)
+		return nil, fmt.Errorf("failed to delete etc_meisai: %w", err)
 	}
 
 	return &pb.DeleteETCMeisaiResponse{
@@ -220,9 +214,15 @@ func (s *ETCMeisaiServer) DeleteETCMeisai(ctx context.Context, req *pb.DeleteETC
 
 // ListETCMeisai lists ETC meisai with optional filters
 func (s *ETCMeisaiServer) ListETCMeisai(ctx context.Context, req *pb.ListETCMeisaiRequest) (*pb.ListETCMeisaiResponse, error) {
+	// a token only continues the listing with the filters it was issued for
+	scope := []string{"ListETCMeisai", req.GetDateFrom(), req.GetDateTo(), req.GetEtcNum()}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
+	if err != nil {
+		return nil, err
+	}
+
 	params := repository.ETCMeisaiListParams{
-		PageSize:  int(req.PageSize),
-		PageToken: req.PageToken,
+		Page: pagination.Page{Size: int(req.PageSize), After: after},
 	}
 
 	if req.DateFrom != nil {
@@ -235,9 +235,9 @@ func (s *ETCMeisaiServer) ListETCMeisai(ctx context.Context, req *pb.ListETCMeis
 		params.EtcNum = req.EtcNum
 	}
 
-	results, totalCount, nextPageToken, err := s.repo.List(ctx, params)
+	results, totalCount, next, err := s.repo.List(ctx, params)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list etc_meisai: %v", err)
+		return nil, fmt.Errorf("failed to list etc_meisai: %w", err)
 	}
 
 	protoResults := make([]*pb.ETCMeisai, len(results))
@@ -247,7 +247,7 @@ func (s *ETCMeisaiServer) ListETCMeisai(ctx context.Context, req *pb.ListETCMeis
 
 	return &pb.ListETCMeisaiResponse{
 		EtcMeisaiList: protoResults,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 		TotalCount:    int32(totalCount),
 	}, nil
 }
@@ -298,7 +298,7 @@ func (s *ETCMeisaiServer) BulkCreateETCMeisai(ctx context.Context, req *pb.BulkC
 
 	createdCount, skippedCount, errs, err := s.repo.BulkCreate(ctx, orgID, records, req.SkipDuplicates)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to bulk create etc_meisai: %v", err)
+		return nil, fmt.Errorf("failed to bulk create etc_meisai: %w", err)
 	}
 
 	return &pb.BulkCreateETCMeisaiResponse{
@@ -323,6 +323,7 @@ func toProtoETCMeisai(m *repository.ETCMeisai) *pb.ETCMeisai {
 		Hash:           m.Hash,
 		CreatedAt:      timestamppb.New(m.CreatedAt),
 		UpdatedAt:      timestamppb.New(m.UpdatedAt),
+		Etag:           m.Etag,
 	}
 
 	if m.DateFr != nil {
@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // FileServer implements the gRPC FileService
 type FileServer struct {
 	pb.UnimplementedFileServiceServer
-	repo *repository.FileRepository
+	repo       *repository.FileRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewFileServer creates a new gRPC server
-func NewFileServer(repo *repository.FileRepository) *FileServer {
-	return &FileServer{repo: repo}
+func NewFileServer(repo *repository.FileRepository, pageTokens *pagination.Tokens) *FileServer {
+	return &FileServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateFile creates a new file
@@ -44,7 +46,7 @@ func (s *FileServer) CreateFile(ctx context.Context, req *pb.CreateFileRequest)
 
 	file, err := s.repo.Create(ctx, req.OrganizationId, req.Filename, req.Created, req.Type, blob)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create file: %v", err)
+		return nil, fmt.Errorf("failed to create file: %w", err)
 	}
 
 	return &pb.CreateFileResponse{
@@ -60,10 +62,7 @@ func (s *FileServer) GetFile(ctx context.Context, req *pb.GetFileRequest) (*pb.G
 
 	file, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrFileNotFound) {
-			return nil, status.Error(codes.NotFound, "file not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get file: %v", err)
+		return nil, fmt.Errorf("failed to get file: %w", err)
 	}
 
 	return &pb.GetFileResponse{
@@ -88,12 +87,9 @@ func (s *FileServer) UpdateFile(ctx context.Context, req *pb.UpdateFileRequest)
 		blob = req.Blob
 	}
 
-	file, err := s.repo.Update(ctx, req.Uuid, req.Filename, req.Type, blob)
+	file, err := s.repo.Update(ctx, req.Uuid, req.Filename, req.Type, blob, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrFileNotFound) {
-			return nil, status.Error(codes.NotFound, "file not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update file: %v", err)
+		return nil, fmt.Errorf("failed to update file: %w", err)
 	}
 
 	return &pb.UpdateFileResponse{
@@ -110,12 +106,9 @@ func (s *FileServer) DeleteFile(ctx context.Context, req *pb.DeleteFileRequest)
 		return nil, status.Error(codes.InvalidArgument, "deleted_timestamp is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Uuid, req.DeletedTimestamp)
+	err := s.repo.Delete(ctx, req.Uuid, req.DeletedTimestamp, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrFileNotFound) {
-			return nil, status.Error(codes.NotFound, "file not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete file: %v", err)
+		return nil, fmt.Errorf("failed to delete file: %w", err)
 	}
 
 	return &pb.DeleteFileResponse{
@@ -125,27 +118,15 @@ func (s *FileServer) DeleteFile(ctx context.Context, req *pb.DeleteFileRequest)
 
 // ListFiles retrieves files with pagination
 func (s *FileServer) ListFiles(ctx context.Context, req *pb.ListFilesRequest) (*pb.ListFilesResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	files, err := s.repo.List(ctx, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListFiles"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list files: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(files) > limit {
-		files = files[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	files, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
 	}
 
 	protoFiles := make([]*pb.File, len(files))
@@ -155,7 +136,7 @@ func (s *FileServer) ListFiles(ctx context.Context, req *pb.ListFilesRequest) (*
 
 	return &pb.ListFilesResponse{
 		Files:         protoFiles,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -165,27 +146,15 @@ func (s *FileServer) ListFilesByOrganization(ctx context.Context, req *pb.ListFi
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	files, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListFilesByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list files by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(files) > limit {
-		files = files[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	files, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files by organization: %w", err)
 	}
 
 	protoFiles := make([]*pb.File, len(files))
@@ -195,7 +164,7 @@ func (s *FileServer) ListFilesByOrganization(ctx context.Context, req *pb.ListFi
 
 	return &pb.ListFilesByOrganizationResponse{
 		Files:         protoFiles,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -208,6 +177,7 @@ func toProtoFile(file *repository.File) *pb.File {
 		Created:        file.Created,
 		Deleted:        file.Deleted,
 		Type:           file.Type,
+		Etag:           file.Etag,
 	}
 	if file.Blob != nil {
 		proto.Blob = file.Blob
@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // FlickrPhotoServer implements the gRPC FlickrPhotoService
 type FlickrPhotoServer struct {
 	pb.UnimplementedFlickrPhotoServiceServer
-	repo *repository.FlickrPhotoRepository
+	repo       *repository.FlickrPhotoRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewFlickrPhotoServer creates a new gRPC server
-func NewFlickrPhotoServer(repo *repository.FlickrPhotoRepository) *FlickrPhotoServer {
-	return &FlickrPhotoServer{repo: repo}
+func NewFlickrPhotoServer(repo *repository.FlickrPhotoRepository, pageTokens *pagination.Tokens) *FlickrPhotoServer {
+	return &FlickrPhotoServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateFlickrPhoto creates a new flickr photo
@@ -39,7 +41,7 @@ func (s *FlickrPhotoServer) CreateFlickrPhoto(ctx context.Context, req *pb.Creat
 
 	photo, err := s.repo.Create(ctx, req.Id, req.OrganizationId, req.Secret, req.Server)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create flickr photo: %v", err)
+		return nil, fmt.Errorf("failed to create flickr photo: %w", err)
 	}
 
 	return &pb.CreateFlickrPhotoResponse{
@@ -55,10 +57,7 @@ func (s *FlickrPhotoServer) GetFlickrPhoto(ctx context.Context, req *pb.GetFlick
 
 	photo, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, repository.ErrFlickrPhotoNotFound) {
-			return nil, status.Error(codes.NotFound, "flickr photo not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get flickr photo: %v", err)
+		return nil, fmt.Errorf("failed to get flickr photo: %w", err)
 	}
 
 	return &pb.GetFlickrPhotoResponse{
@@ -78,12 +77,9 @@ func (s *FlickrPhotoServer) UpdateFlickrPhoto(ctx context.Context, req *pb.Updat
 		return nil, status.Error(codes.InvalidArgument, "server is required")
 	}
 
-	photo, err := s.repo.Update(ctx, req.Id, req.Secret, req.Server)
+	photo, err := s.repo.Update(ctx, req.Id, req.Secret, req.Server, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrFlickrPhotoNotFound) {
-			return nil, status.Error(codes.NotFound, "flickr photo not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update flickr photo: %v", err)
+		return nil, fmt.Errorf("failed to update flickr photo: %w", err)
 	}
 
 	return &pb.UpdateFlickrPhotoResponse{
@@ -97,12 +93,9 @@ func (s *FlickrPhotoServer) DeleteFlickrPhoto(ctx context.Context, req *pb.Delet
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Id)
+	err := s.repo.Delete(ctx, req.Id, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrFlickrPhotoNotFound) {
-			return nil, status.Error(codes.NotFound, "flickr photo not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete flickr photo: %v", err)
+		return nil, fmt.Errorf("failed to delete flickr photo: %w", err)
 	}
 
 	return &pb.DeleteFlickrPhotoResponse{
@@ -112,27 +105,15 @@ func (s *FlickrPhotoServer) DeleteFlickrPhoto(ctx context.Context, req *pb.Delet
 
 // ListFlickrPhotos retrieves flickr photos with pagination
 func (s *FlickrPhotoServer) ListFlickrPhotos(ctx context.Context, req *pb.ListFlickrPhotosRequest) (*pb.ListFlickrPhotosResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	photos, err := s.repo.List(ctx, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListFlickrPhotos"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list flickr photos: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(photos) > limit {
-		photos = photos[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	photos, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flickr photos: %w", err)
 	}
 
 	protoPhotos := make([]*pb.FlickrPhoto, len(photos))
@@ -142,7 +123,7 @@ func (s *FlickrPhotoServer) ListFlickrPhotos(ctx context.Context, req *pb.ListFl
 
 	return &pb.ListFlickrPhotosResponse{
 		FlickrPhotos:  protoPhotos,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -152,27 +133,15 @@ func (s *FlickrPhotoServer) ListFlickrPhotosByOrganization(ctx context.Context,
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	photos, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListFlickrPhotosByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list flickr photos by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(photos) > limit {
-		photos = photos[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	photos, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flickr photos by organization: %w", err)
 	}
 
 	protoPhotos := make([]*pb.FlickrPhoto, len(photos))
@@ -182,7 +151,7 @@ func (s *FlickrPhotoServer) ListFlickrPhotosByOrganization(ctx context.Context,
 
 	return &pb.ListFlickrPhotosByOrganizationResponse{
 		FlickrPhotos:  protoPhotos,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -193,5 +162,6 @@ func toProtoFlickrPhoto(photo *repository.FlickrPhoto) *pb.FlickrPhoto {
 		OrganizationId: photo.OrganizationID,
 		Secret:         photo.Secret,
 		Server:         photo.Server,
+		Etag:           photo.Etag,
 	}
 }
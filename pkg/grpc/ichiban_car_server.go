@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // IchibanCarServer implements the gRPC IchibanCarService
 type IchibanCarServer struct {
 	pb.UnimplementedIchibanCarServiceServer
-	repo *repository.IchibanCarRepository
+	repo       *repository.IchibanCarRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewIchibanCarServer creates a new gRPC server
-func NewIchibanCarServer(repo *repository.IchibanCarRepository) *IchibanCarServer {
-	return &IchibanCarServer{repo: repo}
+func NewIchibanCarServer(repo *repository.IchibanCarRepository, pageTokens *pagination.Tokens) *IchibanCarServer {
+	return &IchibanCarServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateIchibanCar creates a new ichiban car
@@ -69,7 +71,7 @@ func (s *IchibanCarServer) CreateIchibanCar(ctx context.Context, req *pb.CreateI
 
 	car, err := s.repo.Create(ctx, id, req.OrganizationId, req.Id4, req.Shashu, name, nameR, sekisai, regDate, parchDate, scrapDate, bumonCodeID, driverID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create ichiban car: %v", err)
+		return nil, fmt.Errorf("failed to create ichiban car: %w", err)
 	}
 
 	return &pb.CreateIchibanCarResponse{
@@ -88,10 +90,7 @@ func (s *IchibanCarServer) GetIchibanCar(ctx context.Context, req *pb.GetIchiban
 
 	car, err := s.repo.GetByIDAndOrg(ctx, req.Id, req.OrganizationId)
 	if err != nil {
-		if errors.Is(err, repository.ErrIchibanCarNotFound) {
-			return nil, status.Error(codes.NotFound, "ichiban car not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get ichiban car: %v", err)
+		return nil, fmt.Errorf("failed to get ichiban car: %w", err)
 	}
 
 	return &pb.GetIchibanCarResponse{
@@ -144,12 +143,9 @@ func (s *IchibanCarServer) UpdateIchibanCar(ctx context.Context, req *pb.UpdateI
 		driverID = req.DriverId
 	}
 
-	car, err := s.repo.Update(ctx, req.Id, req.OrganizationId, req.Id4, req.Shashu, name, nameR, sekisai, regDate, parchDate, scrapDate, bumonCodeID, driverID)
+	car, err := s.repo.Update(ctx, req.Id, req.OrganizationId, req.Id4, req.Shashu, name, nameR, sekisai, regDate, parchDate, scrapDate, bumonCodeID, driverID, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrIchibanCarNotFound) {
-			return nil, status.Error(codes.NotFound, "ichiban car not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update ichiban car: %v", err)
+		return nil, fmt.Errorf("failed to update ichiban car: %w", err)
 	}
 
 	return &pb.UpdateIchibanCarResponse{
@@ -166,12 +162,9 @@ func (s *IchibanCarServer) DeleteIchibanCar(ctx context.Context, req *pb.DeleteI
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Id, req.OrganizationId)
+	err := s.repo.Delete(ctx, req.Id, req.OrganizationId, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrIchibanCarNotFound) {
-			return nil, status.Error(codes.NotFound, "ichiban car not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete ichiban car: %v", err)
+		return nil, fmt.Errorf("failed to delete ichiban car: %w", err)
 	}
 
 	return &pb.DeleteIchibanCarResponse{
@@ -181,25 +174,15 @@ func (s *IchibanCarServer) DeleteIchibanCar(ctx context.Context, req *pb.DeleteI
 
 // ListIchibanCars retrieves all ichiban cars with pagination
 func (s *IchibanCarServer) ListIchibanCars(ctx context.Context, req *pb.ListIchibanCarsRequest) (*pb.ListIchibanCarsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	cars, err := s.repo.List(ctx, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListIchibanCars"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list ichiban cars: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(cars) > limit {
-		cars = cars[:limit]
-		nextPageToken = "next"
+	cars, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ichiban cars: %w", err)
 	}
 
 	protoCars := make([]*pb.IchibanCar, len(cars))
@@ -209,7 +192,7 @@ func (s *IchibanCarServer) ListIchibanCars(ctx context.Context, req *pb.ListIchi
 
 	return &pb.ListIchibanCarsResponse{
 		IchibanCars:   protoCars,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -219,25 +202,15 @@ func (s *IchibanCarServer) ListIchibanCarsByOrganization(ctx context.Context, re
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	cars, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListIchibanCarsByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list ichiban cars by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(cars) > limit {
-		cars = cars[:limit]
-		nextPageToken = "next"
+	cars, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ichiban cars by organization: %w", err)
 	}
 
 	protoCars := make([]*pb.IchibanCar, len(cars))
@@ -247,7 +220,7 @@ func (s *IchibanCarServer) ListIchibanCarsByOrganization(ctx context.Context, re
 
 	return &pb.ListIchibanCarsByOrganizationResponse{
 		IchibanCars:   protoCars,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -258,6 +231,7 @@ func toProtoIchibanCar(car *repository.IchibanCar) *pb.IchibanCar {
 		OrganizationId: car.OrganizationID,
 		Id4:            car.ID4,
 		Shashu:         car.Shashu,
+		Etag:           car.Etag,
 	}
 
 	if car.Name != nil {
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"google.golang.org/grpc"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/auth"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
 
 // Context keys for user info
@@ -21,11 +23,20 @@ const (
 	UserEmailKey    contextKey = "user_email"
 	UserNameKey     contextKey = "user_name"
 	IsSuperadminKey contextKey = "is_superadmin"
+	SessionIDKey    contextKey = "session_id"
+	// TokenOrganizationIDKey and TokenRoleKey hold the signed org_id and role claims
+	TokenOrganizationIDKey contextKey = "token_organization_id"
+	TokenRoleKey           contextKey = "token_role"
+	// APIKeyIDKey is set instead of SessionIDKey when the caller authenticated with an API key;
+	// UserIDKey then holds the user who created the key
+	APIKeyIDKey contextKey = "api_key_id"
 )
 
 const (
 	// OrganizationIDHeader is the gRPC metadata key for organization ID
 	OrganizationIDHeader = "x-organization-id"
+	// APIKeyHeader is the gRPC metadata key for API keys of machine clients
+	APIKeyHeader = "x-api-key"
 )
 
 // skipRLSPrefixes are method prefixes that don't require x-organization-id header
@@ -33,7 +44,7 @@ var skipRLSPrefixes = []string{
 	"/grpc.health.v1.Health/",
 	"/grpc.reflection.v1alpha.ServerReflection/",
 	"/grpc.reflection.v1.ServerReflection/",
-	"/auth.AuthService/",
+	"/organization.AuthService/",
 	"/organization.OrganizationService/",
 	"/organization.AppUserService/",
 	"/organization.UserOrganizationService/",
@@ -49,9 +60,77 @@ func shouldSkipRLS(fullMethod string) bool {
 	return false
 }
 
-// RLSUnaryInterceptor extracts organization_id from gRPC metadata
-// and adds it to the context for RLS enforcement.
-func RLSUnaryInterceptor() grpc.UnaryServerInterceptor {
+// skipMembershipMethods still receive x-organization-id for RLS but are called by users
+// who are not members yet; they are authorized by the invitation token instead.
+var skipMembershipMethods = map[string]bool{
+	"/organization.InvitationService/GetInvitationByToken": true,
+	"/organization.InvitationService/AcceptInvitation":     true,
+}
+
+// resolveOrganizationContext determines the organization for RLS and returns a context
+// carrying it. The signed org_id claim of the access token is preferred: membership was
+// verified when the token was issued, and an x-organization-id header naming another
+// organization is rejected. Without the claim the header is used and membership is
+// verified against user_organizations.
+// Superadmins may access any organization without a user_organizations row.
+func resolveOrganizationContext(ctx context.Context, membership *MembershipCache, fullMethod string) (context.Context, error) {
+	// Extract organization_id from metadata
+	var headerOrgID string
+	headerPresent := false
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if orgIDs := md.Get(OrganizationIDHeader); len(orgIDs) > 0 {
+			headerOrgID, headerPresent = orgIDs[0], true
+		}
+	}
+
+	if headerPresent && headerOrgID == "" {
+		return nil, status.Error(codes.InvalidArgument, "x-organization-id cannot be empty")
+	}
+
+	// Invitation recipients act on an organization they do not belong to yet,
+	// so the header names the invitation's organization whatever the token says
+	if skipMembershipMethods[fullMethod] {
+		if !headerPresent {
+			return nil, status.Error(codes.InvalidArgument, "missing x-organization-id header")
+		}
+		return db.WithOrganizationID(ctx, headerOrgID), nil
+	}
+
+	if tokenOrgID, ok := GetTokenOrganizationIDFromContext(ctx); ok {
+		if headerPresent && headerOrgID != tokenOrgID {
+			return nil, status.Error(codes.PermissionDenied, "x-organization-id does not match the organization of the access token; call SwitchOrganization first")
+		}
+		return db.WithOrganizationID(ctx, tokenOrgID), nil
+	}
+
+	if !headerPresent {
+		return nil, status.Error(codes.InvalidArgument, "missing x-organization-id header")
+	}
+	orgID := headerOrgID
+
+	// Verify membership before trusting the header
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if !GetIsSuperadminFromContext(ctx) {
+		isMember, err := membership.IsMember(ctx, userID, orgID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to verify organization membership: %v", err)
+		}
+		if !isMember {
+			return nil, status.Error(codes.PermissionDenied, "not a member of this organization")
+		}
+	}
+
+	// Add organization_id to context for RLS
+	return db.WithOrganizationID(ctx, orgID), nil
+}
+
+// RLSUnaryInterceptor extracts organization_id from gRPC metadata, verifies
+// the caller's membership, and adds it to the context for RLS enforcement.
+func RLSUnaryInterceptor(membership *MembershipCache) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -63,32 +142,18 @@ func RLSUnaryInterceptor() grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
-		// Extract organization_id from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.InvalidArgument, "missing metadata")
-		}
-
-		orgIDs := md.Get(OrganizationIDHeader)
-		if len(orgIDs) == 0 {
-			return nil, status.Error(codes.InvalidArgument, "missing x-organization-id header")
-		}
-
-		orgID := orgIDs[0]
-		if orgID == "" {
-			return nil, status.Error(codes.InvalidArgument, "x-organization-id cannot be empty")
+		ctx, err := resolveOrganizationContext(ctx, membership, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
 
-		// Add organization_id to context for RLS
-		ctx = db.WithOrganizationID(ctx, orgID)
-
 		return handler(ctx, req)
 	}
 }
 
-// RLSStreamInterceptor extracts organization_id from gRPC metadata
-// for streaming RPCs and adds it to the context for RLS enforcement.
-func RLSStreamInterceptor() grpc.StreamServerInterceptor {
+// RLSStreamInterceptor extracts organization_id from gRPC metadata for streaming
+// RPCs, verifies the caller's membership, and adds it to the context for RLS enforcement.
+func RLSStreamInterceptor(membership *MembershipCache) grpc.StreamServerInterceptor {
 	return func(
 		srv interface{},
 		ss grpc.ServerStream,
@@ -100,28 +165,15 @@ func RLSStreamInterceptor() grpc.StreamServerInterceptor {
 			return handler(srv, ss)
 		}
 
-		ctx := ss.Context()
-
-		// Extract organization_id from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return status.Error(codes.InvalidArgument, "missing metadata")
-		}
-
-		orgIDs := md.Get(OrganizationIDHeader)
-		if len(orgIDs) == 0 {
-			return status.Error(codes.InvalidArgument, "missing x-organization-id header")
-		}
-
-		orgID := orgIDs[0]
-		if orgID == "" {
-			return status.Error(codes.InvalidArgument, "x-organization-id cannot be empty")
+		ctx, err := resolveOrganizationContext(ss.Context(), membership, info.FullMethod)
+		if err != nil {
+			return err
 		}
 
 		// Wrap stream with new context containing organization_id
 		wrapped := &wrappedServerStream{
 			ServerStream: ss,
-			ctx:          db.WithOrganizationID(ctx, orgID),
+			ctx:          ctx,
 		}
 
 		return handler(srv, wrapped)
@@ -138,12 +190,18 @@ func (w *wrappedServerStream) Context() context.Context {
 	return w.ctx
 }
 
-// skipAuthPrefixes are method prefixes that don't require JWT authentication
+// skipAuthPrefixes are method prefixes that don't require JWT authentication.
+// AuthService is listed per method because session management RPCs need the caller's identity.
 var skipAuthPrefixes = []string{
 	"/grpc.health.v1.Health/",
 	"/grpc.reflection.v1alpha.ServerReflection/",
 	"/grpc.reflection.v1.ServerReflection/",
-	"/auth.AuthService/",
+	"/organization.AuthService/AuthWithGoogle",
+	"/organization.AuthService/AuthWithLine",
+	"/organization.AuthService/RefreshToken",
+	"/organization.AuthService/GetAuthURL",
+	"/organization.AuthService/ValidateToken",
+	"/organization.AuthService/Logout",
 }
 
 // shouldSkipAuth checks if the method should skip JWT authentication
@@ -156,8 +214,124 @@ func shouldSkipAuth(fullMethod string) bool {
 	return false
 }
 
-// JWTUnaryInterceptor validates JWT token and adds user info to context
-func JWTUnaryInterceptor(jwtService *auth.JWTService) grpc.UnaryServerInterceptor {
+// authenticate validates the bearer token (or API key) in gRPC metadata and returns a
+// context carrying the user info. Shared by the unary and stream interceptors.
+func authenticate(ctx context.Context, jwtService *auth.JWTService, apiKeys APIKeyVerifier, fullMethod string) (context.Context, error) {
+	// Extract Authorization header from metadata
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		if keys := md.Get(APIKeyHeader); len(keys) > 0 && apiKeys != nil {
+			return authenticateAPIKey(ctx, apiKeys, keys[0], fullMethod)
+		}
+		return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	// Parse "Bearer <token>"
+	authHeader := authHeaders[0]
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
+	}
+	token := strings.TrimPrefix(authHeader, "Bearer ")
+
+	// Validate token
+	claims, err := jwtService.ValidateAccessToken(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+
+	// Add user info to context
+	ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
+	ctx = context.WithValue(ctx, UserEmailKey, claims.Email)
+	ctx = context.WithValue(ctx, UserNameKey, claims.DisplayName)
+	ctx = context.WithValue(ctx, IsSuperadminKey, claims.IsSuperadmin)
+	if claims.SessionID != "" {
+		ctx = context.WithValue(ctx, SessionIDKey, claims.SessionID)
+	}
+	if claims.OrganizationID != "" {
+		ctx = context.WithValue(ctx, TokenOrganizationIDKey, claims.OrganizationID)
+		ctx = context.WithValue(ctx, TokenRoleKey, claims.Role)
+	}
+
+	return ctx, nil
+}
+
+// APIKeyVerifier is the subset of auth.APIKeyVerifier used to authenticate x-api-key
+type APIKeyVerifier interface {
+	Verify(ctx context.Context, key string) (*repository.APIKey, error)
+}
+
+// apiKeyExcludedServices can never be granted to an API key: they act on the calling
+// user rather than on organization data, or would let a key manage credentials
+var apiKeyExcludedServices = map[string]bool{
+	"organization.AuthService":             true,
+	"organization.OrganizationService":     true,
+	"organization.AppUserService":          true,
+	"organization.UserOrganizationService": true,
+	"organization.InvitationService":       true,
+	"organization.ApiKeyService":           true,
+}
+
+// IsAPIKeyScopeAllowed reports whether a service may be listed in an API key's scopes
+func IsAPIKeyScopeAllowed(service string) bool {
+	return strings.HasPrefix(service, "organization.") && !apiKeyExcludedServices[service]
+}
+
+// serviceName returns the service of a full method name ("/organization.XService/M" -> "organization.XService")
+func serviceName(fullMethod string) string {
+	name := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// authenticateAPIKey validates an API key and returns a context that acts as the key:
+// bound to the key's organization and role like an org-scoped access token, and
+// limited to the services in the key's scopes. The key's creator is the user of the call.
+func authenticateAPIKey(ctx context.Context, apiKeys APIKeyVerifier, key, fullMethod string) (context.Context, error) {
+	apiKey, err := apiKeys.Verify(ctx, key)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidAPIKey), errors.Is(err, auth.ErrAPIKeyExpired), errors.Is(err, auth.ErrAPIKeyRevoked):
+			return nil, status.Errorf(codes.Unauthenticated, "invalid api key: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to verify api key: %v", err)
+	}
+
+	service := serviceName(fullMethod)
+	allowed := false
+	if IsAPIKeyScopeAllowed(service) {
+		for _, scope := range apiKey.Scopes {
+			if scope == service {
+				allowed = true
+				break
+			}
+		}
+	}
+	if !allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "api key is not allowed to call %s", service)
+	}
+
+	ctx = context.WithValue(ctx, UserIDKey, apiKey.CreatedBy)
+	ctx = context.WithValue(ctx, UserEmailKey, "")
+	ctx = context.WithValue(ctx, UserNameKey, apiKey.Name)
+	ctx = context.WithValue(ctx, IsSuperadminKey, false)
+	ctx = context.WithValue(ctx, APIKeyIDKey, apiKey.ID)
+	ctx = context.WithValue(ctx, TokenOrganizationIDKey, apiKey.OrganizationID)
+	ctx = context.WithValue(ctx, TokenRoleKey, apiKey.Role)
+
+	return ctx, nil
+}
+
+// JWTUnaryInterceptor validates JWT token and adds user info to context.
+// Requests without an Authorization header may authenticate with x-api-key instead
+// if apiKeys is not nil.
+func JWTUnaryInterceptor(jwtService *auth.JWTService, apiKeys APIKeyVerifier) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -169,37 +343,40 @@ func JWTUnaryInterceptor(jwtService *auth.JWTService) grpc.UnaryServerIntercepto
 			return handler(ctx, req)
 		}
 
-		// Extract Authorization header from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		ctx, err := authenticate(ctx, jwtService, apiKeys, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
 
-		authHeaders := md.Get("authorization")
-		if len(authHeaders) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
-		}
+		return handler(ctx, req)
+	}
+}
 
-		// Parse "Bearer <token>"
-		authHeader := authHeaders[0]
-		if !strings.HasPrefix(authHeader, "Bearer ") {
-			return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
+// JWTStreamInterceptor validates JWT token (or API key) for streaming RPCs and adds user info to the stream context
+func JWTStreamInterceptor(jwtService *auth.JWTService, apiKeys APIKeyVerifier) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		// Skip auth for public methods
+		if shouldSkipAuth(info.FullMethod) {
+			return handler(srv, ss)
 		}
-		token := strings.TrimPrefix(authHeader, "Bearer ")
 
-		// Validate token
-		claims, err := jwtService.ValidateAccessToken(token)
+		ctx, err := authenticate(ss.Context(), jwtService, apiKeys, info.FullMethod)
 		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+			return err
 		}
 
-		// Add user info to context
-		ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
-		ctx = context.WithValue(ctx, UserEmailKey, claims.Email)
-		ctx = context.WithValue(ctx, UserNameKey, claims.DisplayName)
-		ctx = context.WithValue(ctx, IsSuperadminKey, claims.IsSuperadmin)
+		// Wrap stream with new context containing user info
+		wrapped := &wrappedServerStream{
+			ServerStream: ss,
+			ctx:          ctx,
+		}
 
-		return handler(ctx, req)
+		return handler(srv, wrapped)
 	}
 }
 
@@ -220,3 +397,27 @@ func GetIsSuperadminFromContext(ctx context.Context) bool {
 	isSuperadmin, ok := ctx.Value(IsSuperadminKey).(bool)
 	return ok && isSuperadmin
 }
+
+// GetSessionIDFromContext extracts the session (refresh token family) ID of the access token
+func GetSessionIDFromContext(ctx context.Context) (string, bool) {
+	sessionID, ok := ctx.Value(SessionIDKey).(string)
+	return sessionID, ok && sessionID != ""
+}
+
+// GetTokenOrganizationIDFromContext extracts the org_id claim of the access token
+func GetTokenOrganizationIDFromContext(ctx context.Context) (string, bool) {
+	orgID, ok := ctx.Value(TokenOrganizationIDKey).(string)
+	return orgID, ok && orgID != ""
+}
+
+// GetAPIKeyIDFromContext extracts the ID of the API key the caller authenticated with
+func GetAPIKeyIDFromContext(ctx context.Context) (string, bool) {
+	keyID, ok := ctx.Value(APIKeyIDKey).(string)
+	return keyID, ok && keyID != ""
+}
+
+// GetTokenRoleFromContext extracts the role claim of the access token
+func GetTokenRoleFromContext(ctx context.Context) string {
+	role, _ := ctx.Value(TokenRoleKey).(string)
+	return role
+}
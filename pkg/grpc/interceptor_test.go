@@ -0,0 +1,513 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/auth"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+// fakeMembershipRepo implements MembershipRepository for testing
+type fakeMembershipRepo struct {
+	mu      sync.Mutex
+	members map[string]string // "userID/orgID" -> role
+	calls   int
+	err     error
+}
+
+func newFakeMembershipRepo() *fakeMembershipRepo {
+	return &fakeMembershipRepo{members: make(map[string]string)}
+}
+
+func (f *fakeMembershipRepo) add(userID, orgID, role string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.members[userID+"/"+orgID] = role
+}
+
+func (f *fakeMembershipRepo) remove(userID, orgID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.members, userID+"/"+orgID)
+}
+
+func (f *fakeMembershipRepo) GetByUserAndOrganization(ctx context.Context, userID, organizationID string) (*repository.UserOrganization, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	role, ok := f.members[userID+"/"+organizationID]
+	if !ok {
+		return nil, repository.ErrUserOrganizationNotFound
+	}
+	return &repository.UserOrganization{
+		ID:             "uo-" + userID,
+		UserID:         userID,
+		OrganizationID: organizationID,
+		Role:           role,
+	}, nil
+}
+
+// incomingContext builds a context as seen by the RLS interceptor after JWT validation
+func incomingContext(userID string, isSuperadmin bool, orgID string) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(OrganizationIDHeader, orgID))
+	if userID != "" {
+		ctx = context.WithValue(ctx, UserIDKey, userID)
+	}
+	return context.WithValue(ctx, IsSuperadminKey, isSuperadmin)
+}
+
+func TestRLSUnaryInterceptor_Membership(t *testing.T) {
+	const (
+		orgA = "11111111-1111-1111-1111-111111111111"
+		orgB = "22222222-2222-2222-2222-222222222222"
+	)
+
+	repo := newFakeMembershipRepo()
+	repo.add("user-a", orgA, "member")
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		wantCode codes.Code
+		wantOrg  string
+	}{
+		{
+			name:     "member",
+			ctx:      incomingContext("user-a", false, orgA),
+			method:   "/organization.CamFileService/ListCamFiles",
+			wantCode: codes.OK,
+			wantOrg:  orgA,
+		},
+		{
+			name:     "non-member",
+			ctx:      incomingContext("user-a", false, orgB),
+			method:   "/organization.CamFileService/ListCamFiles",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "non-member accepting invitation",
+			ctx:      incomingContext("user-a", false, orgB),
+			method:   "/organization.InvitationService/AcceptInvitation",
+			wantCode: codes.OK,
+			wantOrg:  orgB,
+		},
+		{
+			name:     "superadmin",
+			ctx:      incomingContext("admin", true, orgB),
+			method:   "/organization.CamFileService/ListCamFiles",
+			wantCode: codes.OK,
+			wantOrg:  orgB,
+		},
+		{
+			name:     "unauthenticated",
+			ctx:      incomingContext("", false, orgA),
+			method:   "/organization.CamFileService/ListCamFiles",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "empty header",
+			ctx:      incomingContext("user-a", false, ""),
+			method:   "/organization.CamFileService/ListCamFiles",
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "skipped method",
+			ctx:      context.Background(),
+			method:   "/grpc.health.v1.Health/Check",
+			wantCode: codes.OK,
+		},
+	}
+
+	interceptor := RLSUnaryInterceptor(NewMembershipCache(repo, time.Minute))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOrg string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				gotOrg, _ = db.GetOrganizationID(ctx)
+				return "ok", nil
+			}
+
+			_, err := interceptor(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("code = %v, want %v (err = %v)", status.Code(err), tt.wantCode, err)
+			}
+			if gotOrg != tt.wantOrg {
+				t.Errorf("organization_id = %q, want %q", gotOrg, tt.wantOrg)
+			}
+		})
+	}
+}
+
+// tokenContext builds a context for an access token bound to tokenOrgID, optionally sending a header
+func tokenContext(userID, tokenOrgID, role, headerOrgID string) context.Context {
+	ctx := context.Background()
+	if headerOrgID != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(OrganizationIDHeader, headerOrgID))
+	}
+	ctx = context.WithValue(ctx, UserIDKey, userID)
+	ctx = context.WithValue(ctx, TokenOrganizationIDKey, tokenOrgID)
+	return context.WithValue(ctx, TokenRoleKey, role)
+}
+
+func TestRLSUnaryInterceptor_TokenOrganization(t *testing.T) {
+	const (
+		orgA = "11111111-1111-1111-1111-111111111111"
+		orgB = "22222222-2222-2222-2222-222222222222"
+	)
+
+	// No membership rows: the signed claim alone must be enough
+	repo := newFakeMembershipRepo()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		wantCode codes.Code
+		wantOrg  string
+	}{
+		{
+			name:     "claim without header",
+			ctx:      tokenContext("user-a", orgA, RoleMember, ""),
+			method:   "/organization.CamFileService/ListCamFiles",
+			wantCode: codes.OK,
+			wantOrg:  orgA,
+		},
+		{
+			name:     "matching header",
+			ctx:      tokenContext("user-a", orgA, RoleMember, orgA),
+			method:   "/organization.CamFileService/ListCamFiles",
+			wantCode: codes.OK,
+			wantOrg:  orgA,
+		},
+		{
+			name:     "mismatching header",
+			ctx:      tokenContext("user-a", orgA, RoleMember, orgB),
+			method:   "/organization.CamFileService/ListCamFiles",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "accepting invitation to another organization",
+			ctx:      tokenContext("user-a", orgA, RoleMember, orgB),
+			method:   "/organization.InvitationService/AcceptInvitation",
+			wantCode: codes.OK,
+			wantOrg:  orgB,
+		},
+	}
+
+	interceptor := RLSUnaryInterceptor(NewMembershipCache(repo, time.Minute))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOrg string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				gotOrg, _ = db.GetOrganizationID(ctx)
+				return "ok", nil
+			}
+
+			_, err := interceptor(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("code = %v, want %v (err = %v)", status.Code(err), tt.wantCode, err)
+			}
+			if gotOrg != tt.wantOrg {
+				t.Errorf("organization_id = %q, want %q", gotOrg, tt.wantOrg)
+			}
+		})
+	}
+
+	if repo.calls != 0 {
+		t.Errorf("repository calls = %d, want 0", repo.calls)
+	}
+}
+
+func TestRLSUnaryInterceptor_RepositoryError(t *testing.T) {
+	repo := newFakeMembershipRepo()
+	repo.err = errors.New("connection refused")
+
+	interceptor := RLSUnaryInterceptor(NewMembershipCache(repo, time.Minute))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("handler should not be called")
+		return nil, nil
+	}
+
+	_, err := interceptor(incomingContext("user-a", false, "org"), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/organization.CamFileService/ListCamFiles"}, handler)
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("code = %v, want %v", status.Code(err), codes.Internal)
+	}
+}
+
+func TestMembershipCache_Expiry(t *testing.T) {
+	repo := newFakeMembershipRepo()
+	repo.add("user-a", "org-a", "member")
+
+	cache := NewMembershipCache(repo, 30*time.Second)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// First lookup hits the repository
+	if ok, err := cache.IsMember(ctx, "user-a", "org-a"); err != nil || !ok {
+		t.Fatalf("IsMember() = %v, %v; want true, nil", ok, err)
+	}
+	if repo.calls != 1 {
+		t.Fatalf("repository calls = %d, want 1", repo.calls)
+	}
+
+	// Membership revoked, but the cached entry is still valid
+	repo.remove("user-a", "org-a")
+	now = now.Add(10 * time.Second)
+	if ok, _ := cache.IsMember(ctx, "user-a", "org-a"); !ok {
+		t.Error("IsMember() within TTL = false, want cached true")
+	}
+	if repo.calls != 1 {
+		t.Errorf("repository calls = %d, want 1 (cached)", repo.calls)
+	}
+
+	// After expiry the repository is consulted again
+	now = now.Add(30 * time.Second)
+	if ok, _ := cache.IsMember(ctx, "user-a", "org-a"); ok {
+		t.Error("IsMember() after TTL = true, want false")
+	}
+	if repo.calls != 2 {
+		t.Errorf("repository calls = %d, want 2", repo.calls)
+	}
+
+	// Negative results are not cached
+	repo.add("user-a", "org-a", "member")
+	if ok, _ := cache.IsMember(ctx, "user-a", "org-a"); !ok {
+		t.Error("IsMember() after re-adding = false, want true")
+	}
+}
+
+func TestMembershipCache_Invalidate(t *testing.T) {
+	repo := newFakeMembershipRepo()
+	repo.add("user-a", "org-a", "member")
+
+	cache := NewMembershipCache(repo, time.Hour)
+	ctx := context.Background()
+
+	if ok, _ := cache.IsMember(ctx, "user-a", "org-a"); !ok {
+		t.Fatal("IsMember() = false, want true")
+	}
+
+	repo.remove("user-a", "org-a")
+	cache.Invalidate("user-a", "org-a")
+
+	if ok, _ := cache.IsMember(ctx, "user-a", "org-a"); ok {
+		t.Error("IsMember() after Invalidate = true, want false")
+	}
+}
+
+// fakeServerStream implements grpc.ServerStream for testing
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestJWTStreamInterceptor(t *testing.T) {
+	jwtService := auth.NewJWTService("test-secret", time.Minute, time.Hour)
+	token, err := jwtService.GenerateAccessToken("user-a", "a@example.com", "User A", true)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		method   string
+		wantCode codes.Code
+		wantUser string
+	}{
+		{
+			name:     "valid token",
+			md:       metadata.Pairs("authorization", "Bearer "+token),
+			method:   "/organization.DtakologsService/StreamDtakologs",
+			wantCode: codes.OK,
+			wantUser: "user-a",
+		},
+		{
+			name:     "missing token",
+			md:       metadata.MD{},
+			method:   "/organization.DtakologsService/StreamDtakologs",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "invalid token",
+			md:       metadata.Pairs("authorization", "Bearer not-a-jwt"),
+			method:   "/organization.DtakologsService/StreamDtakologs",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "skipped method",
+			md:       metadata.MD{},
+			method:   "/grpc.health.v1.Health/Watch",
+			wantCode: codes.OK,
+		},
+	}
+
+	interceptor := JWTStreamInterceptor(jwtService, nil)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := &fakeServerStream{ctx: metadata.NewIncomingContext(context.Background(), tt.md)}
+
+			var gotUser string
+			var gotSuperadmin bool
+			handler := func(srv interface{}, stream grpc.ServerStream) error {
+				gotUser, _ = GetUserIDFromContext(stream.Context())
+				gotSuperadmin = GetIsSuperadminFromContext(stream.Context())
+				return nil
+			}
+
+			err := interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: tt.method}, handler)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("code = %v, want %v (err = %v)", status.Code(err), tt.wantCode, err)
+			}
+			if gotUser != tt.wantUser {
+				t.Errorf("user_id = %q, want %q", gotUser, tt.wantUser)
+			}
+			if tt.wantUser != "" && !gotSuperadmin {
+				t.Error("is_superadmin = false, want true")
+			}
+		})
+	}
+}
+
+// fakeAPIKeyVerifier implements APIKeyVerifier for testing
+type fakeAPIKeyVerifier map[string]*repository.APIKey
+
+func (f fakeAPIKeyVerifier) Verify(ctx context.Context, key string) (*repository.APIKey, error) {
+	if key == "ppk_revoked" {
+		return nil, auth.ErrAPIKeyRevoked
+	}
+	apiKey, ok := f[key]
+	if !ok {
+		return nil, auth.ErrInvalidAPIKey
+	}
+	return apiKey, nil
+}
+
+func TestJWTUnaryInterceptor_APIKey(t *testing.T) {
+	const (
+		orgA = "11111111-1111-1111-1111-111111111111"
+		orgB = "22222222-2222-2222-2222-222222222222"
+	)
+
+	jwtService := auth.NewJWTService("test-secret", time.Minute, time.Hour)
+	apiKeys := fakeAPIKeyVerifier{
+		"ppk_uploader": {
+			ID:             "key-1",
+			OrganizationID: orgA,
+			Name:           "tachograph uploader",
+			CreatedBy:      "user-1",
+			Scopes:         []string{"organization.DtakologsService"},
+			Role:           RoleMember,
+		},
+		// Scopes are checked at use too, in case a key was stored with an excluded service
+		"ppk_legacy": {
+			ID:             "key-2",
+			OrganizationID: orgA,
+			Scopes:         []string{"organization.ApiKeyService"},
+			Role:           RoleMember,
+		},
+	}
+
+	// JWT and RLS interceptors chained as in main
+	jwtInterceptor := JWTUnaryInterceptor(jwtService, apiKeys)
+	rlsInterceptor := RLSUnaryInterceptor(NewMembershipCache(newFakeMembershipRepo(), time.Minute))
+	call := func(md metadata.MD, method string) (context.Context, error) {
+		var got context.Context
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		_, err := jwtInterceptor(metadata.NewIncomingContext(context.Background(), md), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return rlsInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				got = ctx
+				return nil, nil
+			})
+		})
+		return got, err
+	}
+
+	t.Run("key in scope", func(t *testing.T) {
+		ctx, err := call(metadata.Pairs(APIKeyHeader, "ppk_uploader"), "/organization.DtakologsService/CreateDtakologs")
+		if err != nil {
+			t.Fatalf("err = %v", err)
+		}
+		if userID, _ := GetUserIDFromContext(ctx); userID != "user-1" {
+			t.Errorf("user_id = %q, want user-1", userID)
+		}
+		if keyID, _ := GetAPIKeyIDFromContext(ctx); keyID != "key-1" {
+			t.Errorf("api_key_id = %q, want key-1", keyID)
+		}
+		if orgID, _ := db.GetOrganizationID(ctx); orgID != orgA {
+			t.Errorf("organization_id = %q, want %q", orgID, orgA)
+		}
+		if role := GetTokenRoleFromContext(ctx); role != RoleMember {
+			t.Errorf("role = %q, want member", role)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		method   string
+		wantCode codes.Code
+	}{
+		{"service outside scopes", metadata.Pairs(APIKeyHeader, "ppk_uploader"), "/organization.ETCMeisaiService/ListETCMeisai", codes.PermissionDenied},
+		{"excluded service in scopes", metadata.Pairs(APIKeyHeader, "ppk_legacy"), "/organization.ApiKeyService/CreateApiKey", codes.PermissionDenied},
+		{"other organization header", metadata.Pairs(APIKeyHeader, "ppk_uploader", OrganizationIDHeader, orgB), "/organization.DtakologsService/CreateDtakologs", codes.PermissionDenied},
+		{"unknown key", metadata.Pairs(APIKeyHeader, "ppk_unknown"), "/organization.DtakologsService/CreateDtakologs", codes.Unauthenticated},
+		{"revoked key", metadata.Pairs(APIKeyHeader, "ppk_revoked"), "/organization.DtakologsService/CreateDtakologs", codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := call(tt.md, tt.method); status.Code(err) != tt.wantCode {
+				t.Errorf("code = %v, want %v (err = %v)", status.Code(err), tt.wantCode, err)
+			}
+		})
+	}
+
+	// Without a verifier x-api-key is not a credential
+	_, err := JWTUnaryInterceptor(jwtService, nil)(
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyHeader, "ppk_uploader")), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/organization.DtakologsService/CreateDtakologs"},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil },
+	)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("nil verifier: code = %v, want Unauthenticated", status.Code(err))
+	}
+}
+
+func TestNormalizeAPIKeyScopes(t *testing.T) {
+	got, err := normalizeAPIKeyScopes([]string{"DtakologsService", "organization.ETCMeisaiService", "organization.DtakologsService"})
+	if err != nil {
+		t.Fatalf("err = %v", err)
+	}
+	if len(got) != 2 || got[0] != "organization.DtakologsService" || got[1] != "organization.ETCMeisaiService" {
+		t.Errorf("scopes = %v, want qualified and deduplicated", got)
+	}
+
+	for _, scopes := range [][]string{nil, {"NoSuchService"}, {"organization.Dtakologs"}, {"AuthService"}, {"ApiKeyService"}} {
+		if _, err := normalizeAPIKeyScopes(scopes); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("normalizeAPIKeyScopes(%v): code = %v, want InvalidArgument", scopes, status.Code(err))
+		}
+	}
+}
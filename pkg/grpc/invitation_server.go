@@ -10,16 +10,20 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/service"
 )
 
 // InvitationServer implements the gRPC InvitationService
 type InvitationServer struct {
 	pb.UnimplementedInvitationServiceServer
-	invRepo  *repository.InvitationRepository
-	orgRepo  *repository.OrganizationRepository
-	userRepo *repository.UserOrganizationRepository
+	invRepo    *repository.InvitationRepository
+	orgRepo    *repository.OrganizationRepository
+	userRepo   *repository.UserOrganizationRepository
+	invSvc     *service.InvitationService
+	pageTokens *pagination.Tokens
 }
 
 // NewInvitationServer creates a new gRPC server
@@ -27,11 +31,15 @@ func NewInvitationServer(
 	invRepo *repository.InvitationRepository,
 	orgRepo *repository.OrganizationRepository,
 	userRepo *repository.UserOrganizationRepository,
+	invSvc *service.InvitationService,
+	pageTokens *pagination.Tokens,
 ) *InvitationServer {
 	return &InvitationServer{
-		invRepo:  invRepo,
-		orgRepo:  orgRepo,
-		userRepo: userRepo,
+		invRepo:    invRepo,
+		orgRepo:    orgRepo,
+		userRepo:   userRepo,
+		invSvc:     invSvc,
+		pageTokens: pageTokens,
 	}
 }
 
@@ -62,10 +70,7 @@ func (s *InvitationServer) CreateInvitation(ctx context.Context, req *pb.CreateI
 	// Check if organization exists
 	_, err := s.orgRepo.GetByID(ctx, req.OrganizationId)
 	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, "organization not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+		return nil, fmt.Errorf("failed to get organization: %w", err)
 	}
 
 	// Check if there's already a pending invitation
@@ -81,12 +86,15 @@ func (s *InvitationServer) CreateInvitation(ctx context.Context, req *pb.CreateI
 	// Create new invitation
 	role := req.Role
 	if role == "" {
-		role = "member"
+		role = RoleMember
+	}
+	if !IsValidRole(role) || role == RoleOwner {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role: %s", role)
 	}
 
 	inv, err := s.invRepo.Create(ctx, req.OrganizationId, req.Email, role, userID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create invitation: %v", err)
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
 	}
 
 	return &pb.CreateInvitationResponse{
@@ -103,10 +111,7 @@ func (s *InvitationServer) GetInvitation(ctx context.Context, req *pb.GetInvitat
 
 	inv, err := s.invRepo.GetByID(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, repository.ErrInvitationNotFound) {
-			return nil, status.Error(codes.NotFound, "invitation not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get invitation: %v", err)
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
 	}
 
 	return &pb.GetInvitationResponse{
@@ -122,16 +127,13 @@ func (s *InvitationServer) GetInvitationByToken(ctx context.Context, req *pb.Get
 
 	inv, err := s.invRepo.GetByToken(ctx, req.Token)
 	if err != nil {
-		if errors.Is(err, repository.ErrInvitationNotFound) {
-			return nil, status.Error(codes.NotFound, "invitation not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get invitation: %v", err)
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
 	}
 
 	// Get organization details
 	org, err := s.orgRepo.GetByID(ctx, inv.OrganizationID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+		return nil, fmt.Errorf("failed to get organization: %w", err)
 	}
 
 	return &pb.GetInvitationByTokenResponse{
@@ -152,37 +154,22 @@ func (s *InvitationServer) AcceptInvitation(ctx context.Context, req *pb.AcceptI
 		return nil, status.Error(codes.Unauthenticated, "authentication required")
 	}
 
-	// Get invitation by token
-	inv, err := s.invRepo.GetByToken(ctx, req.Token)
+	// Accept the invitation and create the user_organization in one transaction
+	userOrg, err := s.invSvc.Accept(ctx, req.Token, userID)
 	if err != nil {
 		if errors.Is(err, repository.ErrInvitationNotFound) {
 			return nil, status.Error(codes.NotFound, "invitation not found")
 		}
-		return nil, status.Errorf(codes.Internal, "failed to get invitation: %v", err)
-	}
-
-	// Check if already a member
-	_, err = s.userRepo.GetByUserAndOrganization(ctx, userID, inv.OrganizationID)
-	if err == nil {
-		return nil, status.Error(codes.AlreadyExists, "already a member of this organization")
-	}
-
-	// Accept invitation
-	acceptedInv, err := s.invRepo.Accept(ctx, inv.ID, userID)
-	if err != nil {
+		if errors.Is(err, service.ErrAlreadyMember) {
+			return nil, status.Error(codes.AlreadyExists, "already a member of this organization")
+		}
 		if errors.Is(err, repository.ErrInvitationExpired) {
 			return nil, status.Error(codes.FailedPrecondition, "invitation expired")
 		}
 		if errors.Is(err, repository.ErrInvitationUsed) {
 			return nil, status.Error(codes.FailedPrecondition, "invitation already used")
 		}
-		return nil, status.Errorf(codes.Internal, "failed to accept invitation: %v", err)
-	}
-
-	// Create user_organization
-	userOrg, err := s.userRepo.Create(ctx, userID, acceptedInv.OrganizationID, acceptedInv.Role, false)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create user organization: %v", err)
+		return nil, fmt.Errorf("failed to accept invitation: %w", err)
 	}
 
 	return &pb.AcceptInvitationResponse{
@@ -199,10 +186,7 @@ func (s *InvitationServer) CancelInvitation(ctx context.Context, req *pb.CancelI
 
 	err := s.invRepo.Cancel(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, repository.ErrInvitationNotFound) {
-			return nil, status.Error(codes.NotFound, "invitation not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to cancel invitation: %v", err)
+		return nil, fmt.Errorf("failed to cancel invitation: %w", err)
 	}
 
 	return &pb.CancelInvitationResponse{
@@ -216,9 +200,15 @@ func (s *InvitationServer) ListInvitations(ctx context.Context, req *pb.ListInvi
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	invitations, err := s.invRepo.List(ctx, req.OrganizationId, req.Status, int(req.Limit), int(req.Offset))
+	scope := []string{"ListInvitations", req.OrganizationId, req.Status}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, next, err := s.invRepo.List(ctx, req.OrganizationId, req.Status, pagination.Page{Size: int(req.PageSize), After: after})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list invitations: %v", err)
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
 	}
 
 	protoInvitations := make([]*pb.Invitation, len(invitations))
@@ -227,7 +217,8 @@ func (s *InvitationServer) ListInvitations(ctx context.Context, req *pb.ListInvi
 	}
 
 	return &pb.ListInvitationsResponse{
-		Invitations: protoInvitations,
+		Invitations:   protoInvitations,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -242,7 +233,7 @@ func (s *InvitationServer) ResendInvitation(ctx context.Context, req *pb.ResendI
 		if errors.Is(err, repository.ErrInvitationNotFound) {
 			return nil, status.Error(codes.NotFound, "invitation not found or already accepted")
 		}
-		return nil, status.Errorf(codes.Internal, "failed to resend invitation: %v", err)
+		return nil, fmt.Errorf("failed to resend invitation: %w", err)
 	}
 
 	return &pb.ResendInvitationResponse{
@@ -275,4 +266,3 @@ func toProtoInvitation(inv *repository.Invitation) *pb.Invitation {
 
 	return pbInv
 }
-
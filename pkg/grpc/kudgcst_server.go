@@ -2,12 +2,13 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -15,12 +16,13 @@ import (
 // KudgcstServer implements the gRPC KudgcstService
 type KudgcstServer struct {
 	pb.UnimplementedKudgcstServiceServer
-	repo *repository.KudgcstRepository
+	repo       *repository.KudgcstRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewKudgcstServer creates a new gRPC server
-func NewKudgcstServer(repo *repository.KudgcstRepository) *KudgcstServer {
-	return &KudgcstServer{repo: repo}
+func NewKudgcstServer(repo *repository.KudgcstRepository, pageTokens *pagination.Tokens) *KudgcstServer {
+	return &KudgcstServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateKudgcst creates a new kudgcst record
@@ -74,7 +76,7 @@ func (s *KudgcstServer) CreateKudgcst(ctx context.Context, req *pb.CreateKudgcst
 
 	result, err := s.repo.Create(ctx, kudgcst)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create kudgcst: %v", err)
+		return nil, fmt.Errorf("failed to create kudgcst: %w", err)
 	}
 
 	return &pb.CreateKudgcstResponse{
@@ -90,10 +92,7 @@ func (s *KudgcstServer) GetKudgcst(ctx context.Context, req *pb.GetKudgcstReques
 
 	kudgcst, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgcstNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgcst not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get kudgcst: %v", err)
+		return nil, fmt.Errorf("failed to get kudgcst: %w", err)
 	}
 
 	return &pb.GetKudgcstResponse{
@@ -119,10 +118,7 @@ func (s *KudgcstServer) UpdateKudgcst(ctx context.Context, req *pb.UpdateKudgcst
 	// Get the existing record first to preserve created timestamp
 	existing, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgcstNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgcst not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get kudgcst: %v", err)
+		return nil, fmt.Errorf("failed to get kudgcst: %w", err)
 	}
 
 	kudgcst := &repository.Kudgcst{
@@ -161,12 +157,9 @@ func (s *KudgcstServer) UpdateKudgcst(ctx context.Context, req *pb.UpdateKudgcst
 		AssumedDistance:      existing.AssumedDistance,
 	}
 
-	result, err := s.repo.Update(ctx, kudgcst)
+	result, err := s.repo.Update(ctx, kudgcst, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgcstNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgcst not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update kudgcst: %v", err)
+		return nil, fmt.Errorf("failed to update kudgcst: %w", err)
 	}
 
 	return &pb.UpdateKudgcstResponse{
@@ -181,12 +174,9 @@ func (s *KudgcstServer) DeleteKudgcst(ctx context.Context, req *pb.DeleteKudgcst
 	}
 
 	deletedAt := time.Now().Format(time.RFC3339)
-	err := s.repo.Delete(ctx, req.Uuid, deletedAt)
+	err := s.repo.Delete(ctx, req.Uuid, deletedAt, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgcstNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgcst not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete kudgcst: %v", err)
+		return nil, fmt.Errorf("failed to delete kudgcst: %w", err)
 	}
 
 	return &pb.DeleteKudgcstResponse{
@@ -196,25 +186,19 @@ func (s *KudgcstServer) DeleteKudgcst(ctx context.Context, req *pb.DeleteKudgcst
 
 // ListKudgcsts retrieves kudgcst records with pagination
 func (s *KudgcstServer) ListKudgcsts(ctx context.Context, req *pb.ListKudgcstsRequest) (*pb.ListKudgcstsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In production, decode page_token to get offset
-	}
-
-	kudgcsts, err := s.repo.List(ctx, limit+1, offset)
+	scope := []string{"ListKudgcsts", req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list kudgcsts: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(kudgcsts) > limit {
-		kudgcsts = kudgcsts[:limit]
-		nextPageToken = "next"
+	kudgcsts, next, err := s.repo.List(ctx, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kudgcsts: %w", err)
 	}
 
 	protoKudgcsts := make([]*pb.Kudgcst, len(kudgcsts))
@@ -224,7 +208,7 @@ func (s *KudgcstServer) ListKudgcsts(ctx context.Context, req *pb.ListKudgcstsRe
 
 	return &pb.ListKudgcstsResponse{
 		Kudgcsts:      protoKudgcsts,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -234,25 +218,19 @@ func (s *KudgcstServer) ListKudgcstsByOrganization(ctx context.Context, req *pb.
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In production, decode page_token to get offset
-	}
-
-	kudgcsts, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListKudgcstsByOrganization", req.OrganizationId, req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list kudgcsts by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(kudgcsts) > limit {
-		kudgcsts = kudgcsts[:limit]
-		nextPageToken = "next"
+	kudgcsts, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kudgcsts by organization: %w", err)
 	}
 
 	protoKudgcsts := make([]*pb.Kudgcst, len(kudgcsts))
@@ -262,7 +240,7 @@ func (s *KudgcstServer) ListKudgcstsByOrganization(ctx context.Context, req *pb.
 
 	return &pb.ListKudgcstsByOrganizationResponse{
 		Kudgcsts:      protoKudgcsts,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -298,5 +276,6 @@ func toProtoKudgcst(k *repository.Kudgcst) *pb.Kudgcst {
 		SettlementTypeName: optionalFromPtr(k.SettlementTypeName),
 		StandardFare:      optionalFromPtr(k.StandardFare),
 		ContractFare:      optionalFromPtr(k.ContractFare),
+		Etag:              k.Etag,
 	}
 }
@@ -2,12 +2,13 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -15,12 +16,13 @@ import (
 // KudgfryServer implements the gRPC KudgfryService
 type KudgfryServer struct {
 	pb.UnimplementedKudgfryServiceServer
-	repo *repository.KudgfryRepository
+	repo       *repository.KudgfryRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewKudgfryServer creates a new gRPC server
-func NewKudgfryServer(repo *repository.KudgfryRepository) *KudgfryServer {
-	return &KudgfryServer{repo: repo}
+func NewKudgfryServer(repo *repository.KudgfryRepository, pageTokens *pagination.Tokens) *KudgfryServer {
+	return &KudgfryServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateKudgfry creates a new kudgfry record
@@ -68,7 +70,7 @@ func (s *KudgfryServer) CreateKudgfry(ctx context.Context, req *pb.CreateKudgfry
 
 	result, err := s.repo.Create(ctx, kudgfry)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create kudgfry: %v", err)
+		return nil, fmt.Errorf("failed to create kudgfry: %w", err)
 	}
 
 	return &pb.CreateKudgfryResponse{
@@ -84,10 +86,7 @@ func (s *KudgfryServer) GetKudgfry(ctx context.Context, req *pb.GetKudgfryReques
 
 	kudgfry, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgfryNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgfry not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get kudgfry: %v", err)
+		return nil, fmt.Errorf("failed to get kudgfry: %w", err)
 	}
 
 	return &pb.GetKudgfryResponse{
@@ -140,12 +139,9 @@ func (s *KudgfryServer) UpdateKudgfry(ctx context.Context, req *pb.UpdateKudgfry
 		MeterValue:                ptrFromOptional(req.MeterValue),
 	}
 
-	result, err := s.repo.Update(ctx, kudgfry)
+	result, err := s.repo.Update(ctx, kudgfry, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgfryNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgfry not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update kudgfry: %v", err)
+		return nil, fmt.Errorf("failed to update kudgfry: %w", err)
 	}
 
 	return &pb.UpdateKudgfryResponse{
@@ -159,12 +155,9 @@ func (s *KudgfryServer) DeleteKudgfry(ctx context.Context, req *pb.DeleteKudgfry
 		return nil, status.Error(codes.InvalidArgument, "uuid is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Uuid)
+	err := s.repo.Delete(ctx, req.Uuid, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgfryNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgfry not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete kudgfry: %v", err)
+		return nil, fmt.Errorf("failed to delete kudgfry: %w", err)
 	}
 
 	return &pb.DeleteKudgfryResponse{
@@ -174,25 +167,19 @@ func (s *KudgfryServer) DeleteKudgfry(ctx context.Context, req *pb.DeleteKudgfry
 
 // ListKudgfrys retrieves kudgfry records with pagination
 func (s *KudgfryServer) ListKudgfrys(ctx context.Context, req *pb.ListKudgfrysRequest) (*pb.ListKudgfrysResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In production, decode page_token to get offset
-	}
-
-	kudgfrys, err := s.repo.List(ctx, limit+1, offset)
+	scope := []string{"ListKudgfrys", req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list kudgfrys: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(kudgfrys) > limit {
-		kudgfrys = kudgfrys[:limit]
-		nextPageToken = "next"
+	kudgfrys, next, err := s.repo.List(ctx, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kudgfrys: %w", err)
 	}
 
 	protoKudgfrys := make([]*pb.Kudgfry, len(kudgfrys))
@@ -202,7 +189,7 @@ func (s *KudgfryServer) ListKudgfrys(ctx context.Context, req *pb.ListKudgfrysRe
 
 	return &pb.ListKudgfrysResponse{
 		Kudgfrys:      protoKudgfrys,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -212,25 +199,19 @@ func (s *KudgfryServer) ListKudgfrysByOrganization(ctx context.Context, req *pb.
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In production, decode page_token to get offset
-	}
-
-	kudgfrys, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListKudgfrysByOrganization", req.OrganizationId, req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list kudgfrys by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(kudgfrys) > limit {
-		kudgfrys = kudgfrys[:limit]
-		nextPageToken = "next"
+	kudgfrys, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kudgfrys by organization: %w", err)
 	}
 
 	protoKudgfrys := make([]*pb.Kudgfry, len(kudgfrys))
@@ -240,7 +221,7 @@ func (s *KudgfryServer) ListKudgfrysByOrganization(ctx context.Context, req *pb.
 
 	return &pb.ListKudgfrysByOrganizationResponse{
 		Kudgfrys:      protoKudgfrys,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -276,6 +257,7 @@ func toProtoKudgfry(k *repository.Kudgfry) *pb.Kudgfry {
 		OwnOtherType:              optionalFromPtr(k.OwnOtherType),
 		Mileage:                   optionalFromPtr(k.Mileage),
 		MeterValue:                optionalFromPtr(k.MeterValue),
+		Etag:                      k.Etag,
 	}
 }
 
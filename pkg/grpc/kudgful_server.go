@@ -2,12 +2,13 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -15,12 +16,13 @@ import (
 // KudgfulServer implements the gRPC KudgfulService
 type KudgfulServer struct {
 	pb.UnimplementedKudgfulServiceServer
-	repo *repository.KudgfulRepository
+	repo       *repository.KudgfulRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewKudgfulServer creates a new gRPC server
-func NewKudgfulServer(repo *repository.KudgfulRepository) *KudgfulServer {
-	return &KudgfulServer{repo: repo}
+func NewKudgfulServer(repo *repository.KudgfulRepository, pageTokens *pagination.Tokens) *KudgfulServer {
+	return &KudgfulServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateKudgful creates a new kudgful record
@@ -82,7 +84,7 @@ func (s *KudgfulServer) CreateKudgful(ctx context.Context, req *pb.CreateKudgful
 
 	result, err := s.repo.Create(ctx, kudgful)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create kudgful: %v", err)
+		return nil, fmt.Errorf("failed to create kudgful: %w", err)
 	}
 
 	return &pb.CreateKudgfulResponse{
@@ -98,10 +100,7 @@ func (s *KudgfulServer) GetKudgful(ctx context.Context, req *pb.GetKudgfulReques
 
 	kudgful, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgfulNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgful not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get kudgful: %v", err)
+		return nil, fmt.Errorf("failed to get kudgful: %w", err)
 	}
 
 	return &pb.GetKudgfulResponse{
@@ -170,12 +169,9 @@ func (s *KudgfulServer) UpdateKudgful(ctx context.Context, req *pb.UpdateKudgful
 		OverLimitMax:     ptrFromOptional(req.OverLimitMax),
 	}
 
-	result, err := s.repo.Update(ctx, kudgful)
+	result, err := s.repo.Update(ctx, kudgful, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgfulNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgful not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update kudgful: %v", err)
+		return nil, fmt.Errorf("failed to update kudgful: %w", err)
 	}
 
 	return &pb.UpdateKudgfulResponse{
@@ -190,12 +186,9 @@ func (s *KudgfulServer) DeleteKudgful(ctx context.Context, req *pb.DeleteKudgful
 	}
 
 	deletedAt := time.Now().Format(time.RFC3339)
-	err := s.repo.Delete(ctx, req.Uuid, deletedAt)
+	err := s.repo.Delete(ctx, req.Uuid, deletedAt, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgfulNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgful not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete kudgful: %v", err)
+		return nil, fmt.Errorf("failed to delete kudgful: %w", err)
 	}
 
 	return &pb.DeleteKudgfulResponse{
@@ -205,25 +198,19 @@ func (s *KudgfulServer) DeleteKudgful(ctx context.Context, req *pb.DeleteKudgful
 
 // ListKudgfuls retrieves kudgful records with pagination
 func (s *KudgfulServer) ListKudgfuls(ctx context.Context, req *pb.ListKudgfulsRequest) (*pb.ListKudgfulsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In production, decode page_token to get offset
-	}
-
-	kudgfuls, err := s.repo.List(ctx, limit+1, offset)
+	scope := []string{"ListKudgfuls", req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list kudgfuls: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(kudgfuls) > limit {
-		kudgfuls = kudgfuls[:limit]
-		nextPageToken = "next"
+	kudgfuls, next, err := s.repo.List(ctx, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kudgfuls: %w", err)
 	}
 
 	protoKudgfuls := make([]*pb.Kudgful, len(kudgfuls))
@@ -233,7 +220,7 @@ func (s *KudgfulServer) ListKudgfuls(ctx context.Context, req *pb.ListKudgfulsRe
 
 	return &pb.ListKudgfulsResponse{
 		Kudgfuls:      protoKudgfuls,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -243,25 +230,19 @@ func (s *KudgfulServer) ListKudgfulsByOrganization(ctx context.Context, req *pb.
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In production, decode page_token to get offset
-	}
-
-	kudgfuls, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListKudgfulsByOrganization", req.OrganizationId, req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list kudgfuls by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(kudgfuls) > limit {
-		kudgfuls = kudgfuls[:limit]
-		nextPageToken = "next"
+	kudgfuls, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kudgfuls by organization: %w", err)
 	}
 
 	protoKudgfuls := make([]*pb.Kudgful, len(kudgfuls))
@@ -271,7 +252,7 @@ func (s *KudgfulServer) ListKudgfulsByOrganization(ctx context.Context, req *pb.
 
 	return &pb.ListKudgfulsByOrganizationResponse{
 		Kudgfuls:      protoKudgfuls,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -318,5 +299,6 @@ func toProtoKudgful(k *repository.Kudgful) *pb.Kudgful {
 		EndGpsLat:        optionalFromPtr(k.EndGpsLat),
 		EndGpsLng:        optionalFromPtr(k.EndGpsLng),
 		OverLimitMax:     optionalFromPtr(k.OverLimitMax),
+		Etag:             k.Etag,
 	}
 }
@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // KudgivtServer implements the gRPC KudgivtService
 type KudgivtServer struct {
 	pb.UnimplementedKudgivtServiceServer
-	repo *repository.KudgivtRepository
+	repo       *repository.KudgivtRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewKudgivtServer creates a new gRPC server
-func NewKudgivtServer(repo *repository.KudgivtRepository) *KudgivtServer {
-	return &KudgivtServer{repo: repo}
+func NewKudgivtServer(repo *repository.KudgivtRepository, pageTokens *pagination.Tokens) *KudgivtServer {
+	return &KudgivtServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateKudgivt creates a new kudgivt record
@@ -160,7 +162,7 @@ func (s *KudgivtServer) CreateKudgivt(ctx context.Context, req *pb.CreateKudgivt
 
 	result, err := s.repo.Create(ctx, kudgivt)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create kudgivt: %v", err)
+		return nil, fmt.Errorf("failed to create kudgivt: %w", err)
 	}
 
 	return &pb.CreateKudgivtResponse{
@@ -176,10 +178,7 @@ func (s *KudgivtServer) GetKudgivt(ctx context.Context, req *pb.GetKudgivtReques
 
 	kudgivt, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgivtNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgivt not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get kudgivt: %v", err)
+		return nil, fmt.Errorf("failed to get kudgivt: %w", err)
 	}
 
 	return &pb.GetKudgivtResponse{
@@ -195,13 +194,17 @@ func (s *KudgivtServer) UpdateKudgivt(ctx context.Context, req *pb.UpdateKudgivt
 	if req.OrganizationId == "" {
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
-	if req.Hash == "" {
+	mask, err := updateMaskPaths(req, req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+	if req.Hash == "" && masked(mask, "hash") {
 		return nil, status.Error(codes.InvalidArgument, "hash is required")
 	}
-	if req.Created == "" {
+	if req.Created == "" && masked(mask, "created") {
 		return nil, status.Error(codes.InvalidArgument, "created is required")
 	}
-	if req.TargetDriverType == "" {
+	if req.TargetDriverType == "" && masked(mask, "target_driver_type") {
 		return nil, status.Error(codes.InvalidArgument, "target_driver_type is required")
 	}
 
@@ -300,39 +303,11 @@ func (s *KudgivtServer) UpdateKudgivt(ctx context.Context, req *pb.UpdateKudgivt
 		RapidCurveCount5:             ptrFromOptional(req.RapidCurveCount5),
 		RapidCurveMax:                ptrFromOptional(req.RapidCurveMax),
 		RapidCurveMaxSpeed:           ptrFromOptional(req.RapidCurveMaxSpeed),
-		ContinuousDriveOverCount:     nil,
-		ContinuousDriveMaxTime:       nil,
-		ContinuousDriveTotalTime:     nil,
-		WaveDriveCount:               nil,
-		WaveDriveMaxTime:             nil,
-		WaveDriveMaxSpeedDiff:        nil,
-		LocalSpeedScore:              nil,
-		ExpressSpeedScore:            nil,
-		DedicatedSpeedScore:          nil,
-		LocalDistanceScore:           nil,
-		ExpressDistanceScore:         nil,
-		DedicatedDistanceScore:       nil,
-		RapidAccelScore:              nil,
-		RapidDecelScore:              nil,
-		RapidCurveScore:              nil,
-		ActualLowSpeedRotationScore:  nil,
-		ActualHighSpeedRotationScore: nil,
-		EmptyLowSpeedRotationScore:   nil,
-		EmptyHighSpeedRotationScore:  nil,
-		IdlingScore:                  nil,
-		ContinuousDriveScore:         nil,
-		WaveDriveScore:               nil,
-		SafetyScore:                  nil,
-		EconomyScore:                 nil,
-		TotalScore:                   nil,
 	}
 
-	result, err := s.repo.Update(ctx, kudgivt)
+	result, err := s.repo.Update(ctx, kudgivt, mask, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgivtNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgivt not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update kudgivt: %v", err)
+		return nil, fmt.Errorf("failed to update kudgivt: %w", err)
 	}
 
 	return &pb.UpdateKudgivtResponse{
@@ -346,12 +321,9 @@ func (s *KudgivtServer) DeleteKudgivt(ctx context.Context, req *pb.DeleteKudgivt
 		return nil, status.Error(codes.InvalidArgument, "uuid is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Uuid)
+	err := s.repo.Delete(ctx, req.Uuid, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgivtNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgivt not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete kudgivt: %v", err)
+		return nil, fmt.Errorf("failed to delete kudgivt: %w", err)
 	}
 
 	return &pb.DeleteKudgivtResponse{
@@ -361,25 +333,19 @@ func (s *KudgivtServer) DeleteKudgivt(ctx context.Context, req *pb.DeleteKudgivt
 
 // ListKudgivts retrieves kudgivt records with pagination
 func (s *KudgivtServer) ListKudgivts(ctx context.Context, req *pb.ListKudgivtsRequest) (*pb.ListKudgivtsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In production, decode page_token to get offset
-	}
-
-	kudgivts, err := s.repo.List(ctx, limit+1, offset)
+	scope := []string{"ListKudgivts", req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list kudgivts: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(kudgivts) > limit {
-		kudgivts = kudgivts[:limit]
-		nextPageToken = "next"
+	kudgivts, next, err := s.repo.List(ctx, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kudgivts: %w", err)
 	}
 
 	protoKudgivts := make([]*pb.Kudgivt, len(kudgivts))
@@ -389,7 +355,7 @@ func (s *KudgivtServer) ListKudgivts(ctx context.Context, req *pb.ListKudgivtsRe
 
 	return &pb.ListKudgivtsResponse{
 		Kudgivts:      protoKudgivts,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -399,25 +365,19 @@ func (s *KudgivtServer) ListKudgivtsByOrganization(ctx context.Context, req *pb.
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In production, decode page_token to get offset
-	}
-
-	kudgivts, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListKudgivtsByOrganization", req.OrganizationId, req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list kudgivts by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(kudgivts) > limit {
-		kudgivts = kudgivts[:limit]
-		nextPageToken = "next"
+	kudgivts, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kudgivts by organization: %w", err)
 	}
 
 	protoKudgivts := make([]*pb.Kudgivt, len(kudgivts))
@@ -427,7 +387,7 @@ func (s *KudgivtServer) ListKudgivtsByOrganization(ctx context.Context, req *pb.
 
 	return &pb.ListKudgivtsByOrganizationResponse{
 		Kudgivts:      protoKudgivts,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -528,5 +488,6 @@ func toProtoKudgivt(k *repository.Kudgivt) *pb.Kudgivt {
 		RapidCurveCount5:   optionalFromPtr(k.RapidCurveCount5),
 		RapidCurveMax:      optionalFromPtr(k.RapidCurveMax),
 		RapidCurveMaxSpeed: optionalFromPtr(k.RapidCurveMaxSpeed),
+		Etag:               k.Etag,
 	}
 }
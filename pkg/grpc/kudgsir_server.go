@@ -2,12 +2,13 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -15,12 +16,13 @@ import (
 // KudgsirServer implements the gRPC KudgsirService
 type KudgsirServer struct {
 	pb.UnimplementedKudgsirServiceServer
-	repo *repository.KudgsirRepository
+	repo       *repository.KudgsirRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewKudgsirServer creates a new gRPC server
-func NewKudgsirServer(repo *repository.KudgsirRepository) *KudgsirServer {
-	return &KudgsirServer{repo: repo}
+func NewKudgsirServer(repo *repository.KudgsirRepository, pageTokens *pagination.Tokens) *KudgsirServer {
+	return &KudgsirServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateKudgsir creates a new kudgsir record
@@ -82,7 +84,7 @@ func (s *KudgsirServer) CreateKudgsir(ctx context.Context, req *pb.CreateKudgsir
 
 	result, err := s.repo.Create(ctx, kudgsir)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create kudgsir: %v", err)
+		return nil, fmt.Errorf("failed to create kudgsir: %w", err)
 	}
 
 	return &pb.CreateKudgsirResponse{
@@ -98,10 +100,7 @@ func (s *KudgsirServer) GetKudgsir(ctx context.Context, req *pb.GetKudgsirReques
 
 	kudgsir, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgsirNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgsir not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get kudgsir: %v", err)
+		return nil, fmt.Errorf("failed to get kudgsir: %w", err)
 	}
 
 	return &pb.GetKudgsirResponse{
@@ -165,12 +164,9 @@ func (s *KudgsirServer) UpdateKudgsir(ctx context.Context, req *pb.UpdateKudgsir
 		OverLimitMax:     ptrFromOptional(req.OverLimitMax),
 	}
 
-	result, err := s.repo.Update(ctx, kudgsir)
+	result, err := s.repo.Update(ctx, kudgsir, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgsirNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgsir not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update kudgsir: %v", err)
+		return nil, fmt.Errorf("failed to update kudgsir: %w", err)
 	}
 
 	return &pb.UpdateKudgsirResponse{
@@ -185,12 +181,9 @@ func (s *KudgsirServer) DeleteKudgsir(ctx context.Context, req *pb.DeleteKudgsir
 	}
 
 	deletedAt := time.Now().Format(time.RFC3339)
-	err := s.repo.Delete(ctx, req.Uuid, deletedAt)
+	err := s.repo.Delete(ctx, req.Uuid, deletedAt, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudgsirNotFound) {
-			return nil, status.Error(codes.NotFound, "kudgsir not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete kudgsir: %v", err)
+		return nil, fmt.Errorf("failed to delete kudgsir: %w", err)
 	}
 
 	return &pb.DeleteKudgsirResponse{
@@ -200,25 +193,19 @@ func (s *KudgsirServer) DeleteKudgsir(ctx context.Context, req *pb.DeleteKudgsir
 
 // ListKudgsirs retrieves kudgsir records with pagination
 func (s *KudgsirServer) ListKudgsirs(ctx context.Context, req *pb.ListKudgsirsRequest) (*pb.ListKudgsirsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In production, decode page_token to get offset
-	}
-
-	kudgsirs, err := s.repo.List(ctx, limit+1, offset)
+	scope := []string{"ListKudgsirs", req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list kudgsirs: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(kudgsirs) > limit {
-		kudgsirs = kudgsirs[:limit]
-		nextPageToken = "next"
+	kudgsirs, next, err := s.repo.List(ctx, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kudgsirs: %w", err)
 	}
 
 	protoKudgsirs := make([]*pb.Kudgsir, len(kudgsirs))
@@ -228,7 +215,7 @@ func (s *KudgsirServer) ListKudgsirs(ctx context.Context, req *pb.ListKudgsirsRe
 
 	return &pb.ListKudgsirsResponse{
 		Kudgsirs:      protoKudgsirs,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -238,25 +225,19 @@ func (s *KudgsirServer) ListKudgsirsByOrganization(ctx context.Context, req *pb.
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In production, decode page_token to get offset
-	}
-
-	kudgsirs, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListKudgsirsByOrganization", req.OrganizationId, req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list kudgsirs by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(kudgsirs) > limit {
-		kudgsirs = kudgsirs[:limit]
-		nextPageToken = "next"
+	kudgsirs, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kudgsirs by organization: %w", err)
 	}
 
 	protoKudgsirs := make([]*pb.Kudgsir, len(kudgsirs))
@@ -266,7 +247,7 @@ func (s *KudgsirServer) ListKudgsirsByOrganization(ctx context.Context, req *pb.
 
 	return &pb.ListKudgsirsByOrganizationResponse{
 		Kudgsirs:      protoKudgsirs,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -313,5 +294,6 @@ func toProtoKudgsir(k *repository.Kudgsir) *pb.Kudgsir {
 		EndGpsLat:        optionalFromPtr(k.EndGpsLat),
 		EndGpsLng:        optionalFromPtr(k.EndGpsLng),
 		OverLimitMax:     optionalFromPtr(k.OverLimitMax),
+		Etag:             k.Etag,
 	}
 }
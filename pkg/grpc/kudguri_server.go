@@ -2,12 +2,13 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -15,12 +16,13 @@ import (
 // KudguriServer implements the gRPC KudguriService
 type KudguriServer struct {
 	pb.UnimplementedKudguriServiceServer
-	repo *repository.KudguriRepository
+	repo       *repository.KudguriRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewKudguriServer creates a new gRPC server
-func NewKudguriServer(repo *repository.KudguriRepository) *KudguriServer {
-	return &KudguriServer{repo: repo}
+func NewKudguriServer(repo *repository.KudguriRepository, pageTokens *pagination.Tokens) *KudguriServer {
+	return &KudguriServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateKudguri creates a new kudguri record
@@ -85,7 +87,7 @@ func (s *KudguriServer) CreateKudguri(ctx context.Context, req *pb.CreateKudguri
 
 	result, err := s.repo.Create(ctx, kudguri)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create kudguri: %v", err)
+		return nil, fmt.Errorf("failed to create kudguri: %w", err)
 	}
 
 	return &pb.CreateKudguriResponse{
@@ -101,10 +103,7 @@ func (s *KudguriServer) GetKudguri(ctx context.Context, req *pb.GetKudguriReques
 
 	kudguri, err := s.repo.GetByUUID(ctx, req.Uuid)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudguriNotFound) {
-			return nil, status.Error(codes.NotFound, "kudguri not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get kudguri: %v", err)
+		return nil, fmt.Errorf("failed to get kudguri: %w", err)
 	}
 
 	return &pb.GetKudguriResponse{
@@ -176,12 +175,9 @@ func (s *KudguriServer) UpdateKudguri(ctx context.Context, req *pb.UpdateKudguri
 		OverLimitMax:     ptrFromOptional(req.OverLimitMax),
 	}
 
-	result, err := s.repo.Update(ctx, kudguri)
+	result, err := s.repo.Update(ctx, kudguri, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudguriNotFound) {
-			return nil, status.Error(codes.NotFound, "kudguri not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update kudguri: %v", err)
+		return nil, fmt.Errorf("failed to update kudguri: %w", err)
 	}
 
 	return &pb.UpdateKudguriResponse{
@@ -196,12 +192,9 @@ func (s *KudguriServer) DeleteKudguri(ctx context.Context, req *pb.DeleteKudguri
 	}
 
 	deletedAt := time.Now().Format(time.RFC3339)
-	err := s.repo.Delete(ctx, req.Uuid, deletedAt)
+	err := s.repo.Delete(ctx, req.Uuid, deletedAt, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrKudguriNotFound) {
-			return nil, status.Error(codes.NotFound, "kudguri not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete kudguri: %v", err)
+		return nil, fmt.Errorf("failed to delete kudguri: %w", err)
 	}
 
 	return &pb.DeleteKudguriResponse{
@@ -211,25 +204,19 @@ func (s *KudguriServer) DeleteKudguri(ctx context.Context, req *pb.DeleteKudguri
 
 // ListKudguris retrieves kudguri records with pagination
 func (s *KudguriServer) ListKudguris(ctx context.Context, req *pb.ListKudgurisRequest) (*pb.ListKudgurisResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In production, decode page_token to get offset
-	}
-
-	kudguris, err := s.repo.List(ctx, limit+1, offset)
+	scope := []string{"ListKudguris", req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list kudguris: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(kudguris) > limit {
-		kudguris = kudguris[:limit]
-		nextPageToken = "next"
+	kudguris, next, err := s.repo.List(ctx, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kudguris: %w", err)
 	}
 
 	protoKudguris := make([]*pb.Kudguri, len(kudguris))
@@ -239,7 +226,7 @@ func (s *KudguriServer) ListKudguris(ctx context.Context, req *pb.ListKudgurisRe
 
 	return &pb.ListKudgurisResponse{
 		Kudguris:      protoKudguris,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -249,25 +236,19 @@ func (s *KudguriServer) ListKudgurisByOrganization(ctx context.Context, req *pb.
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In production, decode page_token to get offset
-	}
-
-	kudguris, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListKudgurisByOrganization", req.OrganizationId, req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list kudguris by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(kudguris) > limit {
-		kudguris = kudguris[:limit]
-		nextPageToken = "next"
+	kudguris, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kudguris by organization: %w", err)
 	}
 
 	protoKudguris := make([]*pb.Kudguri, len(kudguris))
@@ -277,7 +258,7 @@ func (s *KudguriServer) ListKudgurisByOrganization(ctx context.Context, req *pb.
 
 	return &pb.ListKudgurisByOrganizationResponse{
 		Kudguris:      protoKudguris,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -326,5 +307,6 @@ func toProtoKudguri(k *repository.Kudguri) *pb.Kudguri {
 		EndGpsLat:        optionalFromPtr(k.EndGpsLat),
 		EndGpsLng:        optionalFromPtr(k.EndGpsLng),
 		OverLimitMax:     optionalFromPtr(k.OverLimitMax),
+		Etag:             k.Etag,
 	}
 }
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+// DefaultMembershipCacheTTL is how long a confirmed membership is trusted before re-checking the database
+const DefaultMembershipCacheTTL = 30 * time.Second
+
+// MembershipRepository is the subset of UserOrganizationRepository used for membership checks
+type MembershipRepository interface {
+	GetByUserAndOrganization(ctx context.Context, userID, organizationID string) (*repository.UserOrganization, error)
+}
+
+// membershipEntry is a cached user_organizations row
+type membershipEntry struct {
+	userOrg   *repository.UserOrganization
+	expiresAt time.Time
+}
+
+// MembershipCache resolves user_organizations rows with a short-lived in-memory cache.
+// Only confirmed memberships are cached, so a user who has just accepted an
+// invitation is not locked out until the entry expires.
+type MembershipCache struct {
+	repo MembershipRepository
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]membershipEntry
+}
+
+// NewMembershipCache creates a new membership cache
+func NewMembershipCache(repo MembershipRepository, ttl time.Duration) *MembershipCache {
+	if ttl <= 0 {
+		ttl = DefaultMembershipCacheTTL
+	}
+	return &MembershipCache{
+		repo:    repo,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]membershipEntry),
+	}
+}
+
+// Get returns the user_organizations row for the user and organization.
+// Returns repository.ErrUserOrganizationNotFound if the user is not a member.
+func (c *MembershipCache) Get(ctx context.Context, userID, organizationID string) (*repository.UserOrganization, error) {
+	key := userID + "/" + organizationID
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if ok && c.now().Before(entry.expiresAt) {
+		c.mu.Unlock()
+		return entry.userOrg, nil
+	}
+	delete(c.entries, key)
+	c.mu.Unlock()
+
+	userOrg, err := c.repo.GetByUserAndOrganization(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[key] = membershipEntry{userOrg: userOrg, expiresAt: c.now().Add(c.ttl)}
+	c.mu.Unlock()
+
+	return userOrg, nil
+}
+
+// IsMember reports whether the user belongs to the organization
+func (c *MembershipCache) IsMember(ctx context.Context, userID, organizationID string) (bool, error) {
+	_, err := c.Get(ctx, userID, organizationID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserOrganizationNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Invalidate drops the cached membership for the user and organization
+func (c *MembershipCache) Invalidate(userID, organizationID string) {
+	c.mu.Lock()
+	delete(c.entries, userID+"/"+organizationID)
+	c.mu.Unlock()
+}
@@ -2,12 +2,13 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -15,12 +16,13 @@ import (
 // OrganizationServer implements the gRPC OrganizationService
 type OrganizationServer struct {
 	pb.UnimplementedOrganizationServiceServer
-	repo *repository.OrganizationRepository
+	repo       *repository.OrganizationRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewOrganizationServer creates a new gRPC server
-func NewOrganizationServer(repo *repository.OrganizationRepository) *OrganizationServer {
-	return &OrganizationServer{repo: repo}
+func NewOrganizationServer(repo *repository.OrganizationRepository, pageTokens *pagination.Tokens) *OrganizationServer {
+	return &OrganizationServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateOrganization creates a new organization and links it to the current user as owner.
@@ -37,7 +39,7 @@ func (s *OrganizationServer) CreateOrganization(ctx context.Context, req *pb.Cre
 		// Fallback to old behavior if no JWT (for backwards compatibility or testing)
 		org, err := s.repo.Create(ctx, req.Name)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create organization: %v", err)
+			return nil, fmt.Errorf("failed to create organization: %w", err)
 		}
 		return &pb.CreateOrganizationResponse{
 			Organization: toProtoOrganization(org),
@@ -47,7 +49,7 @@ func (s *OrganizationServer) CreateOrganization(ctx context.Context, req *pb.Cre
 	// Create organization with owner link in a transaction
 	result, err := s.repo.CreateWithOwner(ctx, req.Name, userID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create organization: %v", err)
+		return nil, fmt.Errorf("failed to create organization: %w", err)
 	}
 
 	return &pb.CreateOrganizationResponse{
@@ -63,10 +65,7 @@ func (s *OrganizationServer) GetOrganization(ctx context.Context, req *pb.GetOrg
 
 	org, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, "organization not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
+		return nil, fmt.Errorf("failed to get organization: %w", err)
 	}
 
 	return &pb.GetOrganizationResponse{
@@ -86,12 +85,9 @@ func (s *OrganizationServer) UpdateOrganization(ctx context.Context, req *pb.Upd
 		return nil, status.Error(codes.InvalidArgument, "slug is required")
 	}
 
-	org, err := s.repo.Update(ctx, req.Id, req.Name, req.Slug)
+	org, err := s.repo.Update(ctx, req.Id, req.Name, req.Slug, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, "organization not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update organization: %v", err)
+		return nil, fmt.Errorf("failed to update organization: %w", err)
 	}
 
 	return &pb.UpdateOrganizationResponse{
@@ -105,12 +101,9 @@ func (s *OrganizationServer) DeleteOrganization(ctx context.Context, req *pb.Del
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Id)
+	err := s.repo.Delete(ctx, req.Id, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, "organization not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete organization: %v", err)
+		return nil, fmt.Errorf("failed to delete organization: %w", err)
 	}
 
 	return &pb.DeleteOrganizationResponse{
@@ -120,27 +113,15 @@ func (s *OrganizationServer) DeleteOrganization(ctx context.Context, req *pb.Del
 
 // ListOrganizations retrieves organizations with pagination
 func (s *OrganizationServer) ListOrganizations(ctx context.Context, req *pb.ListOrganizationsRequest) (*pb.ListOrganizationsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	orgs, err := s.repo.List(ctx, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListOrganizations"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list organizations: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(orgs) > limit {
-		orgs = orgs[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	orgs, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
 	}
 
 	protoOrgs := make([]*pb.Organization, len(orgs))
@@ -150,7 +131,7 @@ func (s *OrganizationServer) ListOrganizations(ctx context.Context, req *pb.List
 
 	return &pb.ListOrganizationsResponse{
 		Organizations: protoOrgs,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -162,6 +143,7 @@ func toProtoOrganization(org *repository.Organization) *pb.Organization {
 		Slug:      org.Slug,
 		CreatedAt: timestamppb.New(org.CreatedAt),
 		UpdatedAt: timestamppb.New(org.UpdatedAt),
+		Etag:      org.Etag,
 	}
 	if org.DeletedAt != nil {
 		proto.DeletedAt = timestamppb.New(*org.DeletedAt)
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+)
+
+// readRoutingContext marks Get*/List* calls read-only so an RLSPool with a read replica
+// may serve them there, and names the caller for read-your-writes
+func readRoutingContext(ctx context.Context, fullMethod string) context.Context {
+	if keyID, ok := GetAPIKeyIDFromContext(ctx); ok {
+		ctx = db.WithCallerID(ctx, "apikey:"+keyID)
+	} else if userID, ok := GetUserIDFromContext(ctx); ok {
+		ctx = db.WithCallerID(ctx, "user:"+userID)
+	}
+
+	if isReadOnlyMethod(fullMethod) {
+		ctx = db.WithReadOnly(ctx)
+	}
+	return ctx
+}
+
+// ReadRoutingUnaryInterceptor marks the context of Get*/List* RPCs read-only for replica
+// routing. It runs after the JWT interceptor, which identifies the caller.
+func ReadRoutingUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		return handler(readRoutingContext(ctx, info.FullMethod), req)
+	}
+}
+
+// ReadRoutingStreamInterceptor marks the context of Get*/List* streaming RPCs read-only
+// for replica routing
+func ReadRoutingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		wrapped := &wrappedServerStream{
+			ServerStream: ss,
+			ctx:          readRoutingContext(ss.Context(), info.FullMethod),
+		}
+		return handler(srv, wrapped)
+	}
+}
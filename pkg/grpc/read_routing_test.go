@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+)
+
+func TestReadRoutingUnaryInterceptor(t *testing.T) {
+	userCtx := context.WithValue(context.Background(), UserIDKey, "user-a")
+	keyCtx := context.WithValue(userCtx, APIKeyIDKey, "key-1")
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		method       string
+		wantReadOnly bool
+		wantCaller   string
+	}{
+		{"get", userCtx, "/organization.KudgivtService/GetKudgivt", true, "user:user-a"},
+		{"list", userCtx, "/organization.DtakologsService/ListDtakologs", true, "user:user-a"},
+		{"create", userCtx, "/organization.DtakologsService/CreateDtakologs", false, "user:user-a"},
+		{"api key", keyCtx, "/organization.DtakologsService/ListDtakologs", true, "apikey:key-1"},
+		{"anonymous", context.Background(), "/organization.AuthService/GetPublicKeys", true, ""},
+	}
+
+	interceptor := ReadRoutingUnaryInterceptor()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got context.Context
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				got = ctx
+				return nil, nil
+			}
+			if _, err := interceptor(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler); err != nil {
+				t.Fatalf("interceptor: %v", err)
+			}
+
+			if readOnly := db.IsReadOnly(got); readOnly != tt.wantReadOnly {
+				t.Errorf("IsReadOnly = %v, want %v", readOnly, tt.wantReadOnly)
+			}
+			caller, _ := db.GetCallerID(got)
+			if caller != tt.wantCaller {
+				t.Errorf("caller = %q, want %q", caller, tt.wantCaller)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// telemetryInstrumentationName names the tracer of the telemetry interceptors
+const telemetryInstrumentationName = "github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/grpc"
+
+// RPCMetrics is the subset of telemetry.Metrics used by the telemetry interceptors
+type RPCMetrics interface {
+	ObserveRPC(fullMethod string, code codes.Code, duration time.Duration)
+}
+
+// metadataCarrier adapts incoming gRPC metadata to propagation.TextMapCarrier
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// startRPCSpan starts the server span of a call, continuing the trace of the caller's
+// traceparent metadata. Query spans of the call become its children.
+func startRPCSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+	}
+
+	service, method := serviceName(fullMethod), fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	return otel.Tracer(telemetryInstrumentationName).Start(ctx, strings.TrimPrefix(fullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method),
+		),
+	)
+}
+
+// endRPCSpan records the outcome of a call on its span and metrics
+func endRPCSpan(span trace.Span, metrics RPCMetrics, fullMethod string, start time.Time, err error) {
+	st := status.Convert(err)
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(st.Code())))
+	if st.Code() != codes.OK {
+		span.SetStatus(otelcodes.Error, st.Message())
+	}
+	span.End()
+
+	metrics.ObserveRPC(fullMethod, st.Code(), time.Since(start))
+}
+
+// TelemetryUnaryInterceptor records latency and status code of every call and traces it.
+// It runs first in the chain, so it sees the status the client receives.
+func TelemetryUnaryInterceptor(metrics RPCMetrics) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+		ctx, span := startRPCSpan(ctx, info.FullMethod)
+
+		resp, err := handler(ctx, req)
+
+		endRPCSpan(span, metrics, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// TelemetryStreamInterceptor records and traces streaming RPCs like TelemetryUnaryInterceptor
+func TelemetryStreamInterceptor(metrics RPCMetrics) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		ctx, span := startRPCSpan(ss.Context(), info.FullMethod)
+
+		err := handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+
+		endRPCSpan(span, metrics, info.FullMethod, start, err)
+		return err
+	}
+}
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeRPCMetrics implements RPCMetrics for testing
+type fakeRPCMetrics struct {
+	method string
+	code   codes.Code
+}
+
+func (f *fakeRPCMetrics) ObserveRPC(fullMethod string, code codes.Code, duration time.Duration) {
+	f.method, f.code = fullMethod, code
+}
+
+func TestTelemetryUnaryInterceptor(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	}()
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01"))
+
+	metrics := &fakeRPCMetrics{}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "kudgivt not found")
+	}
+	method := "/organization.KudgivtService/GetKudgivt"
+	_, err := TelemetryUnaryInterceptor(metrics)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("err = %v, want the handler's error", err)
+	}
+
+	if metrics.method != method || metrics.code != codes.NotFound {
+		t.Errorf("ObserveRPC(%q, %v), want (%q, NotFound)", metrics.method, metrics.code, method)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("%d spans ended, want 1", len(spans))
+	}
+	if got := spans[0].SpanContext().TraceID().String(); got != traceID {
+		t.Errorf("span trace ID = %s, want the caller's %s", got, traceID)
+	}
+	if spans[0].Name() != "organization.KudgivtService/GetKudgivt" {
+		t.Errorf("span name = %q", spans[0].Name())
+	}
+}
@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
+)
+
+// MethodTimeout declares how long a method may run, including its database statements
+type MethodTimeout struct {
+	// Method is a full method name ("/organization.DtakologsService/ListDtakologs")
+	// or a service prefix ("/organization.DtakologsService/")
+	Method string
+	// Timeout is the deadline of the call; a shorter client deadline still applies.
+	// Zero leaves the call without a server deadline.
+	Timeout time.Duration
+}
+
+// DefaultMethodTimeouts returns the timeout table for methods that need more (or less)
+// time than the default
+func DefaultMethodTimeouts() []MethodTimeout {
+	return []MethodTimeout{
+		// Unfiltered scans of large tables
+		{Method: pb.DtakologsService_ListDtakologs_FullMethodName, Timeout: 30 * time.Second},
+		{Method: pb.DtakologsService_ListDtakologsByOrganization_FullMethodName, Timeout: 30 * time.Second},
+		{Method: pb.ETCMeisaiService_ListETCMeisai_FullMethodName, Timeout: 30 * time.Second},
+
+		// CSV import
+		{Method: pb.ETCMeisaiService_BulkCreateETCMeisai_FullMethodName, Timeout: 60 * time.Second},
+
+		// Token and key lookups run on every login and should never be slow
+		{Method: "/organization.AuthService/", Timeout: 5 * time.Second},
+
+		// Long-lived streams of the health and reflection services
+		{Method: grpc_health_v1.Health_Watch_FullMethodName, Timeout: 0},
+		{Method: "/grpc.reflection.v1.ServerReflection/", Timeout: 0},
+		{Method: "/grpc.reflection.v1alpha.ServerReflection/", Timeout: 0},
+	}
+}
+
+// Timeouts resolves the deadline of each method from a timeout table
+type Timeouts struct {
+	defaultTimeout time.Duration
+	exact          map[string]time.Duration
+	prefixes       []MethodTimeout
+}
+
+// NewTimeouts creates Timeouts from a table; methods without an entry get defaultTimeout
+func NewTimeouts(defaultTimeout time.Duration, timeouts []MethodTimeout) *Timeouts {
+	t := &Timeouts{
+		defaultTimeout: defaultTimeout,
+		exact:          make(map[string]time.Duration),
+	}
+	for _, mt := range timeouts {
+		if strings.HasSuffix(mt.Method, "/") {
+			t.prefixes = append(t.prefixes, mt)
+		} else {
+			t.exact[mt.Method] = mt.Timeout
+		}
+	}
+	return t
+}
+
+// For returns the timeout of a method. Exact method entries win over the longest
+// matching service prefix.
+func (t *Timeouts) For(fullMethod string) time.Duration {
+	if timeout, ok := t.exact[fullMethod]; ok {
+		return timeout
+	}
+	var match MethodTimeout
+	for _, mt := range t.prefixes {
+		if strings.HasPrefix(fullMethod, mt.Method) && len(mt.Method) > len(match.Method) {
+			match = mt
+		}
+	}
+	if match.Method != "" {
+		return match.Timeout
+	}
+	return t.defaultTimeout
+}
+
+// TimeoutUnaryInterceptor sets the deadline of each call from the timeout table, unless
+// the client asked for a shorter one. The RLS pool turns the deadline into the
+// statement_timeout of the call's queries, so a slow query is canceled by Postgres
+// instead of holding a pooled connection until the request is killed.
+func TimeoutUnaryInterceptor(timeouts *Timeouts) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		timeout := timeouts.For(info.FullMethod)
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
+
+// TimeoutStreamInterceptor sets the deadline of each streaming call from the timeout
+// table, like TimeoutUnaryInterceptor. The deadline covers the whole stream.
+func TimeoutStreamInterceptor(timeouts *Timeouts) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		timeout := timeouts.For(info.FullMethod)
+		if timeout <= 0 {
+			return handler(srv, ss)
+		}
+		ctx, cancel := context.WithTimeout(ss.Context(), timeout)
+		defer cancel()
+		return handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
+)
+
+func TestTimeouts_For(t *testing.T) {
+	timeouts := NewTimeouts(10*time.Second, []MethodTimeout{
+		{Method: "/organization.AuthService/", Timeout: 5 * time.Second},
+		{Method: pb.AuthService_RefreshToken_FullMethodName, Timeout: 2 * time.Second},
+		{Method: pb.DtakologsService_ListDtakologs_FullMethodName, Timeout: 30 * time.Second},
+	})
+
+	tests := map[string]time.Duration{
+		pb.DtakologsService_ListDtakologs_FullMethodName: 30 * time.Second,
+		pb.AuthService_RefreshToken_FullMethodName:       2 * time.Second,
+		pb.AuthService_Logout_FullMethodName:             5 * time.Second,
+		pb.KudgivtService_GetKudgivt_FullMethodName:      10 * time.Second,
+	}
+	for method, want := range tests {
+		if got := timeouts.For(method); got != want {
+			t.Errorf("For(%q) = %v, want %v", method, got, want)
+		}
+	}
+}
+
+func TestTimeoutUnaryInterceptor(t *testing.T) {
+	interceptor := TimeoutUnaryInterceptor(NewTimeouts(10*time.Second, []MethodTimeout{
+		{Method: pb.DtakologsService_ListDtakologs_FullMethodName, Timeout: 30 * time.Second},
+	}))
+
+	remaining := func(ctx context.Context, method string) time.Duration {
+		var left time.Duration
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				t.Fatal("handler context has no deadline")
+			}
+			left = time.Until(deadline)
+			return nil, nil
+		}
+		if _, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler); err != nil {
+			t.Fatalf("interceptor: %v", err)
+		}
+		return left
+	}
+
+	if left := remaining(context.Background(), pb.DtakologsService_ListDtakologs_FullMethodName); left <= 10*time.Second || left > 30*time.Second {
+		t.Errorf("ListDtakologs deadline in %v, want 30s", left)
+	}
+	if left := remaining(context.Background(), pb.KudgivtService_GetKudgivt_FullMethodName); left > 10*time.Second {
+		t.Errorf("GetKudgivt deadline in %v, want 10s", left)
+	}
+
+	// A shorter client deadline is kept
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if left := remaining(ctx, pb.DtakologsService_ListDtakologs_FullMethodName); left > time.Second {
+		t.Errorf("deadline in %v, want the client's 1s", left)
+	}
+}
+
+func TestTimeoutStreamInterceptor(t *testing.T) {
+	interceptor := TimeoutStreamInterceptor(NewTimeouts(10*time.Second, DefaultMethodTimeouts()))
+
+	call := func(method string) (deadline time.Time, ok bool) {
+		handler := func(srv interface{}, stream grpc.ServerStream) error {
+			deadline, ok = stream.Context().Deadline()
+			return nil
+		}
+		stream := &fakeServerStream{ctx: context.Background()}
+		if err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: method}, handler); err != nil {
+			t.Fatalf("interceptor: %v", err)
+		}
+		return deadline, ok
+	}
+
+	deadline, ok := call(pb.DtakologsService_ListDtakologs_FullMethodName)
+	if left := time.Until(deadline); !ok || left <= 10*time.Second || left > 30*time.Second {
+		t.Errorf("ListDtakologs deadline in %v (set %v), want 30s", left, ok)
+	}
+
+	// Health watches stay open as long as the client wants
+	if _, ok := call(grpc_health_v1.Health_Watch_FullMethodName); ok {
+		t.Error("Health/Watch got a deadline, want none")
+	}
+}
@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// updateMaskPaths returns the paths of an Update request's update_mask. They must name
+// fields of the request, the only values the RPC has to write; the repository then
+// rejects the ones that cannot be updated, such as keys.
+func updateMaskPaths(req proto.Message, mask *fieldmaskpb.FieldMask) ([]string, error) {
+	fields := req.ProtoReflect().Descriptor().Fields()
+	for _, path := range mask.GetPaths() {
+		if path != "*" && fields.ByName(protoreflect.Name(path)) == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask: unknown field %q", path)
+		}
+	}
+	return mask.GetPaths(), nil
+}
+
+// masked reports whether an update with the mask paths writes field
+func masked(paths []string, field string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+	for _, p := range paths {
+		if p == field || p == "*" {
+			return true
+		}
+	}
+	return false
+}
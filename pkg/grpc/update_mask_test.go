@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
+)
+
+func TestUpdateMaskPaths(t *testing.T) {
+	req := &pb.UpdateKudgivtRequest{}
+
+	paths, err := updateMaskPaths(req, &fieldmaskpb.FieldMask{Paths: []string{"vehicle_cd", "total_mileage"}})
+	if err != nil {
+		t.Fatalf("updateMaskPaths: %v", err)
+	}
+	if want := []string{"vehicle_cd", "total_mileage"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+
+	if paths, err := updateMaskPaths(req, nil); err != nil || paths != nil {
+		t.Errorf("nil mask: paths = %v, err = %v, want none", paths, err)
+	}
+
+	// safety_score is a kudgivt column, but not a field the request carries
+	for _, path := range []string{"safety_score", "vehicleCd", "kudguri.uuid"} {
+		_, err := updateMaskPaths(req, &fieldmaskpb.FieldMask{Paths: []string{path}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("path %q: err = %v, want InvalidArgument", path, err)
+		}
+	}
+}
+
+func TestMasked(t *testing.T) {
+	tests := []struct {
+		paths []string
+		field string
+		want  bool
+	}{
+		{nil, "hash", true},
+		{[]string{"*"}, "hash", true},
+		{[]string{"vehicle_cd", "hash"}, "hash", true},
+		{[]string{"vehicle_cd"}, "hash", false},
+	}
+	for _, tt := range tests {
+		if got := masked(tt.paths, tt.field); got != tt.want {
+			t.Errorf("masked(%q, %q) = %v, want %v", tt.paths, tt.field, got, tt.want)
+		}
+	}
+}
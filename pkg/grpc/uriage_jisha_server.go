@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // UriageJishaServer implements the gRPC UriageJishaService
 type UriageJishaServer struct {
 	pb.UnimplementedUriageJishaServiceServer
-	repo *repository.UriageJishaRepository
+	repo       *repository.UriageJishaRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewUriageJishaServer creates a new gRPC server
-func NewUriageJishaServer(repo *repository.UriageJishaRepository) *UriageJishaServer {
-	return &UriageJishaServer{repo: repo}
+func NewUriageJishaServer(repo *repository.UriageJishaRepository, pageTokens *pagination.Tokens) *UriageJishaServer {
+	return &UriageJishaServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateUriageJisha creates a new uriage jisha entry
@@ -44,7 +46,7 @@ func (s *UriageJishaServer) CreateUriageJisha(ctx context.Context, req *pb.Creat
 
 	uriageJisha, err := s.repo.Create(ctx, req.Bumon, req.OrganizationId, kingaku, typeVal, req.Date)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create uriage jisha: %v", err)
+		return nil, fmt.Errorf("failed to create uriage jisha: %w", err)
 	}
 
 	return &pb.CreateUriageJishaResponse{
@@ -66,10 +68,7 @@ func (s *UriageJishaServer) GetUriageJisha(ctx context.Context, req *pb.GetUriag
 
 	uriageJisha, err := s.repo.GetByPrimaryKey(ctx, req.Bumon, req.Date, req.OrganizationId)
 	if err != nil {
-		if errors.Is(err, repository.ErrUriageJishaNotFound) {
-			return nil, status.Error(codes.NotFound, "uriage jisha not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get uriage jisha: %v", err)
+		return nil, fmt.Errorf("failed to get uriage jisha: %w", err)
 	}
 
 	return &pb.GetUriageJishaResponse{
@@ -97,12 +96,9 @@ func (s *UriageJishaServer) UpdateUriageJisha(ctx context.Context, req *pb.Updat
 		typeVal = req.Type
 	}
 
-	uriageJisha, err := s.repo.Update(ctx, req.Bumon, req.Date, req.OrganizationId, kingaku, typeVal)
+	uriageJisha, err := s.repo.Update(ctx, req.Bumon, req.Date, req.OrganizationId, kingaku, typeVal, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrUriageJishaNotFound) {
-			return nil, status.Error(codes.NotFound, "uriage jisha not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update uriage jisha: %v", err)
+		return nil, fmt.Errorf("failed to update uriage jisha: %w", err)
 	}
 
 	return &pb.UpdateUriageJishaResponse{
@@ -122,12 +118,9 @@ func (s *UriageJishaServer) DeleteUriageJisha(ctx context.Context, req *pb.Delet
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Bumon, req.Date, req.OrganizationId)
+	err := s.repo.Delete(ctx, req.Bumon, req.Date, req.OrganizationId, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrUriageJishaNotFound) {
-			return nil, status.Error(codes.NotFound, "uriage jisha not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete uriage jisha: %v", err)
+		return nil, fmt.Errorf("failed to delete uriage jisha: %w", err)
 	}
 
 	return &pb.DeleteUriageJishaResponse{
@@ -137,25 +130,15 @@ func (s *UriageJishaServer) DeleteUriageJisha(ctx context.Context, req *pb.Delet
 
 // ListUriageJishas retrieves all uriage jisha entries with pagination
 func (s *UriageJishaServer) ListUriageJishas(ctx context.Context, req *pb.ListUriageJishasRequest) (*pb.ListUriageJishasResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	uriageJishas, err := s.repo.List(ctx, limit+1, offset)
+	scope := []string{"ListUriageJishas"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list uriage jishas: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(uriageJishas) > limit {
-		uriageJishas = uriageJishas[:limit]
-		nextPageToken = "next"
+	uriageJishas, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list uriage jishas: %w", err)
 	}
 
 	protoUriageJishas := make([]*pb.UriageJisha, len(uriageJishas))
@@ -165,7 +148,7 @@ func (s *UriageJishaServer) ListUriageJishas(ctx context.Context, req *pb.ListUr
 
 	return &pb.ListUriageJishasResponse{
 		UriageJishas:  protoUriageJishas,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -175,25 +158,15 @@ func (s *UriageJishaServer) ListUriageJishasByOrganization(ctx context.Context,
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	uriageJishas, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListUriageJishasByOrganization", req.OrganizationId}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list uriage jishas by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(uriageJishas) > limit {
-		uriageJishas = uriageJishas[:limit]
-		nextPageToken = "next"
+	uriageJishas, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list uriage jishas by organization: %w", err)
 	}
 
 	protoUriageJishas := make([]*pb.UriageJisha, len(uriageJishas))
@@ -203,7 +176,7 @@ func (s *UriageJishaServer) ListUriageJishasByOrganization(ctx context.Context,
 
 	return &pb.ListUriageJishasByOrganizationResponse{
 		UriageJishas:  protoUriageJishas,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -213,6 +186,7 @@ func toProtoUriageJisha(uriageJisha *repository.UriageJisha) *pb.UriageJisha {
 		Bumon:          uriageJisha.Bumon,
 		OrganizationId: uriageJisha.OrganizationID,
 		Date:           uriageJisha.Date,
+		Etag:           uriageJisha.Etag,
 	}
 
 	if uriageJisha.Kingaku != nil {
@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -14,12 +15,13 @@ import (
 // UriageServer implements the gRPC UriageService
 type UriageServer struct {
 	pb.UnimplementedUriageServiceServer
-	repo *repository.UriageRepository
+	repo       *repository.UriageRepository
+	pageTokens *pagination.Tokens
 }
 
 // NewUriageServer creates a new gRPC server
-func NewUriageServer(repo *repository.UriageRepository) *UriageServer {
-	return &UriageServer{repo: repo}
+func NewUriageServer(repo *repository.UriageRepository, pageTokens *pagination.Tokens) *UriageServer {
+	return &UriageServer{repo: repo, pageTokens: pageTokens}
 }
 
 // CreateUriage creates a new uriage entry
@@ -50,7 +52,7 @@ func (s *UriageServer) CreateUriage(ctx context.Context, req *pb.CreateUriageReq
 
 	uriage, err := s.repo.Create(ctx, req.Name, req.Bumon, req.OrganizationId, kingaku, uriageType, cam, req.Date)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create uriage: %v", err)
+		return nil, fmt.Errorf("failed to create uriage: %w", err)
 	}
 
 	return &pb.CreateUriageResponse{
@@ -75,10 +77,7 @@ func (s *UriageServer) GetUriage(ctx context.Context, req *pb.GetUriageRequest)
 
 	uriage, err := s.repo.GetByPrimaryKey(ctx, req.Name, req.Bumon, req.Date, req.OrganizationId)
 	if err != nil {
-		if errors.Is(err, repository.ErrUriageNotFound) {
-			return nil, status.Error(codes.NotFound, "uriage not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get uriage: %v", err)
+		return nil, fmt.Errorf("failed to get uriage: %w", err)
 	}
 
 	return &pb.GetUriageResponse{
@@ -112,12 +111,9 @@ func (s *UriageServer) UpdateUriage(ctx context.Context, req *pb.UpdateUriageReq
 		cam = req.Cam
 	}
 
-	uriage, err := s.repo.Update(ctx, req.Name, req.Bumon, req.Date, req.OrganizationId, kingaku, uriageType, cam)
+	uriage, err := s.repo.Update(ctx, req.Name, req.Bumon, req.Date, req.OrganizationId, kingaku, uriageType, cam, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrUriageNotFound) {
-			return nil, status.Error(codes.NotFound, "uriage not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update uriage: %v", err)
+		return nil, fmt.Errorf("failed to update uriage: %w", err)
 	}
 
 	return &pb.UpdateUriageResponse{
@@ -140,12 +136,9 @@ func (s *UriageServer) DeleteUriage(ctx context.Context, req *pb.DeleteUriageReq
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Name, req.Bumon, req.Date, req.OrganizationId)
+	err := s.repo.Delete(ctx, req.Name, req.Bumon, req.Date, req.OrganizationId, req.Etag)
 	if err != nil {
-		if errors.Is(err, repository.ErrUriageNotFound) {
-			return nil, status.Error(codes.NotFound, "uriage not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete uriage: %v", err)
+		return nil, fmt.Errorf("failed to delete uriage: %w", err)
 	}
 
 	return &pb.DeleteUriageResponse{
@@ -155,25 +148,19 @@ func (s *UriageServer) DeleteUriage(ctx context.Context, req *pb.DeleteUriageReq
 
 // ListUriages retrieves all uriage entries with pagination
 func (s *UriageServer) ListUriages(ctx context.Context, req *pb.ListUriagesRequest) (*pb.ListUriagesResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	uriages, err := s.repo.List(ctx, limit+1, offset)
+	scope := []string{"ListUriages", req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list uriages: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(uriages) > limit {
-		uriages = uriages[:limit]
-		nextPageToken = "next"
+	uriages, next, err := s.repo.List(ctx, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list uriages: %w", err)
 	}
 
 	protoUriages := make([]*pb.Uriage, len(uriages))
@@ -183,7 +170,7 @@ func (s *UriageServer) ListUriages(ctx context.Context, req *pb.ListUriagesReque
 
 	return &pb.ListUriagesResponse{
 		Uriages:       protoUriages,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -193,25 +180,19 @@ func (s *UriageServer) ListUriagesByOrganization(ctx context.Context, req *pb.Li
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
 
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-	}
-
-	uriages, err := s.repo.ListByOrganization(ctx, req.OrganizationId, limit+1, offset)
+	scope := []string{"ListUriagesByOrganization", req.OrganizationId, req.Filter, req.OrderBy}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list uriages by organization: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(uriages) > limit {
-		uriages = uriages[:limit]
-		nextPageToken = "next"
+	uriages, next, err := s.repo.ListByOrganization(ctx, req.OrganizationId, repository.ListOptions{
+		Filter:  req.Filter,
+		OrderBy: req.OrderBy,
+		Page:    pagination.Page{Size: int(req.PageSize), After: after},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list uriages by organization: %w", err)
 	}
 
 	protoUriages := make([]*pb.Uriage, len(uriages))
@@ -221,7 +202,7 @@ func (s *UriageServer) ListUriagesByOrganization(ctx context.Context, req *pb.Li
 
 	return &pb.ListUriagesByOrganizationResponse{
 		Uriages:       protoUriages,
-		NextPageToken: nextPageToken,
+		NextPageToken: s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -232,6 +213,7 @@ func toProtoUriage(uriage *repository.Uriage) *pb.Uriage {
 		Bumon:          uriage.Bumon,
 		OrganizationId: uriage.OrganizationID,
 		Date:           uriage.Date,
+		Etag:           uriage.Etag,
 	}
 
 	if uriage.Kingaku != nil {
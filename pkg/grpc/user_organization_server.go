@@ -2,12 +2,13 @@ package grpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb"
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
@@ -15,12 +16,38 @@ import (
 // UserOrganizationServer implements the gRPC UserOrganizationService
 type UserOrganizationServer struct {
 	pb.UnimplementedUserOrganizationServiceServer
-	repo *repository.UserOrganizationRepository
+	repo       *repository.UserOrganizationRepository
+	membership *MembershipCache
+	pageTokens *pagination.Tokens
 }
 
 // NewUserOrganizationServer creates a new gRPC server
-func NewUserOrganizationServer(repo *repository.UserOrganizationRepository) *UserOrganizationServer {
-	return &UserOrganizationServer{repo: repo}
+func NewUserOrganizationServer(repo *repository.UserOrganizationRepository, membership *MembershipCache, pageTokens *pagination.Tokens) *UserOrganizationServer {
+	return &UserOrganizationServer{repo: repo, membership: membership, pageTokens: pageTokens}
+}
+
+// checkRoleChange stops callers from granting a role above their own in orgID,
+// and from touching an owner's membership (current) unless they are an owner themselves
+func (s *UserOrganizationServer) checkRoleChange(ctx context.Context, orgID, current, role string) error {
+	if GetIsSuperadminFromContext(ctx) {
+		return nil
+	}
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	callerRole, err := memberRole(ctx, s.membership, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if current == RoleOwner && callerRole != RoleOwner {
+		return status.Error(codes.PermissionDenied, "only an owner can change an owner's membership")
+	}
+	if role != "" && !RoleAtLeast(callerRole, role) {
+		return status.Errorf(codes.PermissionDenied, "role %q cannot grant role %q", callerRole, role)
+	}
+	return nil
 }
 
 // CreateUserOrganization creates a new user organization
@@ -31,11 +58,22 @@ func (s *UserOrganizationServer) CreateUserOrganization(ctx context.Context, req
 	if req.OrganizationId == "" {
 		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
 	}
+	role := req.Role
+	if role == "" {
+		role = RoleMember
+	}
+	if !IsValidRole(role) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role: %s", role)
+	}
+	if err := s.checkRoleChange(ctx, req.OrganizationId, "", role); err != nil {
+		return nil, err
+	}
 
-	uo, err := s.repo.Create(ctx, req.UserId, req.OrganizationId, req.Role, req.IsDefault)
+	uo, err := s.repo.Create(ctx, req.UserId, req.OrganizationId, role, req.IsDefault)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create user organization: %v", err)
+		return nil, fmt.Errorf("failed to create user organization: %w", err)
 	}
+	s.membership.Invalidate(uo.UserID, uo.OrganizationID)
 
 	return &pb.CreateUserOrganizationResponse{
 		UserOrganization: toProtoUserOrganization(uo),
@@ -50,10 +88,7 @@ func (s *UserOrganizationServer) GetUserOrganization(ctx context.Context, req *p
 
 	uo, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, repository.ErrUserOrganizationNotFound) {
-			return nil, status.Error(codes.NotFound, "user organization not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get user organization: %v", err)
+		return nil, fmt.Errorf("failed to get user organization: %w", err)
 	}
 
 	return &pb.GetUserOrganizationResponse{
@@ -66,15 +101,28 @@ func (s *UserOrganizationServer) UpdateUserOrganization(ctx context.Context, req
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
+	if req.Role != "" && !IsValidRole(req.Role) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role: %s", req.Role)
+	}
 
-	uo, err := s.repo.Update(ctx, req.Id, req.Role, req.IsDefault)
+	existing, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, repository.ErrUserOrganizationNotFound) {
-			return nil, status.Error(codes.NotFound, "user organization not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update user organization: %v", err)
+		return nil, fmt.Errorf("failed to get user organization: %w", err)
+	}
+	role := req.Role
+	if role == "" {
+		role = existing.Role
+	}
+	if err := s.checkRoleChange(ctx, existing.OrganizationID, existing.Role, role); err != nil {
+		return nil, err
 	}
 
+	uo, err := s.repo.Update(ctx, req.Id, role, req.IsDefault, req.Etag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user organization: %w", err)
+	}
+	s.membership.Invalidate(existing.UserID, existing.OrganizationID)
+
 	return &pb.UpdateUserOrganizationResponse{
 		UserOrganization: toProtoUserOrganization(uo),
 	}, nil
@@ -86,14 +134,19 @@ func (s *UserOrganizationServer) DeleteUserOrganization(ctx context.Context, req
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	err := s.repo.Delete(ctx, req.Id)
+	existing, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, repository.ErrUserOrganizationNotFound) {
-			return nil, status.Error(codes.NotFound, "user organization not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete user organization: %v", err)
+		return nil, fmt.Errorf("failed to get user organization: %w", err)
+	}
+	if err := s.checkRoleChange(ctx, existing.OrganizationID, existing.Role, ""); err != nil {
+		return nil, err
 	}
 
+	if err := s.repo.Delete(ctx, req.Id, req.Etag); err != nil {
+		return nil, fmt.Errorf("failed to delete user organization: %w", err)
+	}
+	s.membership.Invalidate(existing.UserID, existing.OrganizationID)
+
 	return &pb.DeleteUserOrganizationResponse{
 		Success: true,
 	}, nil
@@ -101,27 +154,15 @@ func (s *UserOrganizationServer) DeleteUserOrganization(ctx context.Context, req
 
 // ListUserOrganizations retrieves user organizations with pagination
 func (s *UserOrganizationServer) ListUserOrganizations(ctx context.Context, req *pb.ListUserOrganizationsRequest) (*pb.ListUserOrganizationsResponse, error) {
-	limit := int(req.PageSize)
-	if limit <= 0 {
-		limit = 10
-	}
-
-	// Simple offset-based pagination using page_token as offset string
-	offset := 0
-	if req.PageToken != "" {
-		// In a real implementation, decode page_token to get offset
-		// For simplicity, we use 0 for empty token
-	}
-
-	uos, err := s.repo.List(ctx, limit+1, offset) // +1 to check if there's next page
+	scope := []string{"ListUserOrganizations"}
+	after, err := s.pageTokens.Decode(req.PageToken, scope...)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list user organizations: %v", err)
+		return nil, err
 	}
 
-	var nextPageToken string
-	if len(uos) > limit {
-		uos = uos[:limit]
-		nextPageToken = "next" // In production, encode the offset
+	uos, next, err := s.repo.List(ctx, pagination.Page{Size: int(req.PageSize), After: after})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user organizations: %w", err)
 	}
 
 	protoUOs := make([]*pb.UserOrganization, len(uos))
@@ -131,7 +172,7 @@ func (s *UserOrganizationServer) ListUserOrganizations(ctx context.Context, req
 
 	return &pb.ListUserOrganizationsResponse{
 		UserOrganizations: protoUOs,
-		NextPageToken:     nextPageToken,
+		NextPageToken:     s.pageTokens.Encode(next, scope...),
 	}, nil
 }
 
@@ -143,7 +184,7 @@ func (s *UserOrganizationServer) ListUserOrganizationsByUser(ctx context.Context
 
 	uos, err := s.repo.ListByUserID(ctx, req.UserId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list user organizations by user: %v", err)
+		return nil, fmt.Errorf("failed to list user organizations by user: %w", err)
 	}
 
 	protoUOs := make([]*pb.UserOrganization, len(uos))
@@ -164,7 +205,7 @@ func (s *UserOrganizationServer) ListUserOrganizationsByOrg(ctx context.Context,
 
 	uos, err := s.repo.ListByOrganizationID(ctx, req.OrganizationId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list user organizations by organization: %v", err)
+		return nil, fmt.Errorf("failed to list user organizations by organization: %w", err)
 	}
 
 	protoUOs := make([]*pb.UserOrganization, len(uos))
@@ -187,5 +228,6 @@ func toProtoUserOrganization(uo *repository.UserOrganization) *pb.UserOrganizati
 		IsDefault:      uo.IsDefault,
 		CreatedAt:      timestamppb.New(uo.CreatedAt),
 		UpdatedAt:      timestamppb.New(uo.UpdatedAt),
+		Etag:           uo.Etag,
 	}
 }
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUserOrganizationServer_CheckRoleChange(t *testing.T) {
+	const orgA = "11111111-1111-1111-1111-111111111111"
+
+	repo := newFakeMembershipRepo()
+	repo.add("owner", orgA, RoleOwner)
+	repo.add("admin", orgA, RoleAdmin)
+	s := &UserOrganizationServer{membership: NewMembershipCache(repo, time.Minute)}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		current  string
+		role     string
+		wantCode codes.Code
+	}{
+		{"admin grants member", userContext("admin", false, ""), "", RoleMember, codes.OK},
+		{"admin grants admin", userContext("admin", false, ""), "", RoleAdmin, codes.OK},
+		{"admin cannot grant owner", userContext("admin", false, ""), "", RoleOwner, codes.PermissionDenied},
+		{"admin cannot demote owner", userContext("admin", false, ""), RoleOwner, RoleMember, codes.PermissionDenied},
+		{"admin cannot remove owner", userContext("admin", false, ""), RoleOwner, "", codes.PermissionDenied},
+		{"admin removes member", userContext("admin", false, ""), RoleMember, "", codes.OK},
+		{"owner grants owner", userContext("owner", false, ""), "", RoleOwner, codes.OK},
+		{"owner demotes owner", userContext("owner", false, ""), RoleOwner, RoleAdmin, codes.OK},
+		{"non-member denied", userContext("stranger", false, ""), "", RoleViewer, codes.PermissionDenied},
+		{"superadmin bypasses", userContext("stranger", true, ""), RoleOwner, "", codes.OK},
+		{"unauthenticated", context.Background(), "", RoleViewer, codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.checkRoleChange(tt.ctx, orgA, tt.current, tt.role)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("checkRoleChange() code = %v, want %v (err: %v)", got, tt.wantCode, err)
+			}
+		})
+	}
+}
@@ -3,14 +3,16 @@ package http
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"net/url"
-	"time"
+	"path"
+	"strings"
 
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/auth"
-	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
 )
 
 // AuthHandler handles HTTP auth endpoints
@@ -18,9 +20,12 @@ type AuthHandler struct {
 	googleClient *auth.GoogleOAuthClient
 	lineClient   *auth.LineOAuthClient
 	jwtService   *auth.JWTService
-	appUserRepo  *repository.AppUserRepository
-	oauthRepo    *repository.OAuthAccountRepository
+	sessions     *auth.SessionService
+	states       *auth.StateSigner
+	identities   *auth.IdentityService
 	frontendURL  string
+	// redirectPaths are the path prefixes allowed as post-login redirects
+	redirectPaths []string
 }
 
 // NewAuthHandler creates a new AuthHandler
@@ -28,125 +33,282 @@ func NewAuthHandler(
 	googleClient *auth.GoogleOAuthClient,
 	lineClient *auth.LineOAuthClient,
 	jwtService *auth.JWTService,
-	appUserRepo *repository.AppUserRepository,
-	oauthRepo *repository.OAuthAccountRepository,
+	sessions *auth.SessionService,
+	states *auth.StateSigner,
+	identities *auth.IdentityService,
 	frontendURL string,
+	redirectPaths []string,
 ) *AuthHandler {
 	return &AuthHandler{
-		googleClient: googleClient,
-		lineClient:   lineClient,
-		jwtService:   jwtService,
-		appUserRepo:  appUserRepo,
-		oauthRepo:    oauthRepo,
-		frontendURL:  frontendURL,
+		googleClient:  googleClient,
+		lineClient:    lineClient,
+		jwtService:    jwtService,
+		sessions:      sessions,
+		states:        states,
+		identities:    identities,
+		frontendURL:   frontendURL,
+		redirectPaths: redirectPaths,
 	}
 }
 
 // HandleGoogleAuth redirects to Google OAuth
 func (h *AuthHandler) HandleGoogleAuth(w http.ResponseWriter, r *http.Request) {
-	state := r.URL.Query().Get("state")
-	if state == "" {
-		state = "default"
-	}
-	url := h.googleClient.GetAuthURL(state)
-	http.Redirect(w, r, url, http.StatusFound)
+	h.beginOAuth(w, r, "google", "", h.googleClient.GetAuthURL)
+}
+
+// HandleGoogleLink starts linking a Google account to the authenticated user
+func (h *AuthHandler) HandleGoogleLink(w http.ResponseWriter, r *http.Request) {
+	h.beginLink(w, r, "google", h.googleClient.GetAuthURL)
 }
 
 // HandleGoogleCallback handles Google OAuth callback
 func (h *AuthHandler) HandleGoogleCallback(w http.ResponseWriter, r *http.Request) {
-	code := r.URL.Query().Get("code")
-	if code == "" {
-		h.redirectWithError(w, r, "missing code parameter")
+	h.completeOAuth(w, r, "google", h.googleClient.FetchIdentity)
+}
+
+// HandleLineAuth redirects to LINE OAuth
+func (h *AuthHandler) HandleLineAuth(w http.ResponseWriter, r *http.Request) {
+	h.beginOAuth(w, r, "line", "", h.lineClient.GetAuthURL)
+}
+
+// HandleLineLink starts linking a LINE account to the authenticated user
+func (h *AuthHandler) HandleLineLink(w http.ResponseWriter, r *http.Request) {
+	h.beginLink(w, r, "line", h.lineClient.GetAuthURL)
+}
+
+// HandleLineCallback handles LINE OAuth callback
+func (h *AuthHandler) HandleLineCallback(w http.ResponseWriter, r *http.Request) {
+	h.completeOAuth(w, r, "line", h.lineClient.FetchIdentity)
+}
+
+// completeOAuth finishes a login or link flow once the provider redirected back
+func (h *AuthHandler) completeOAuth(w http.ResponseWriter, r *http.Request, provider string, fetchIdentity func(ctx context.Context, code, codeVerifier string) (*auth.ProviderIdentity, error)) {
+	callback, ok := h.verifyCallback(w, r, provider)
+	if !ok {
 		return
 	}
 
 	ctx := r.Context()
 
-	// Exchange code for tokens
-	tokenResp, err := h.googleClient.ExchangeCode(ctx, code)
+	// Exchange code for tokens and get user info
+	identity, err := fetchIdentity(ctx, callback.code, callback.verifier)
 	if err != nil {
-		log.Printf("Failed to exchange Google code: %v", err)
-		h.redirectWithError(w, r, "failed to exchange code")
+		log.Printf("Failed to fetch %s identity: %v", provider, err)
+		if errors.Is(err, auth.ErrCodeExchangeFailed) {
+			h.redirectWithError(w, r, "failed to exchange code")
+		} else {
+			h.redirectWithError(w, r, "failed to get user info")
+		}
 		return
 	}
 
-	// Get user info
-	userInfo, err := h.googleClient.GetUserInfo(ctx, tokenResp.AccessToken)
-	if err != nil {
-		log.Printf("Failed to get Google user info: %v", err)
-		h.redirectWithError(w, r, "failed to get user info")
+	if callback.state.LinkUser != "" {
+		h.completeLink(w, r, callback.state, identity)
 		return
 	}
 
 	// Find or create user and generate JWT
-	authResp, err := h.processOAuthLogin(ctx, "google", userInfo.ID, &userInfo.Email, userInfo.Name, &userInfo.Picture, tokenResp.AccessToken, tokenResp.RefreshToken, tokenResp.ExpiresIn)
+	authResp, err := h.processOAuthLogin(ctx, clientInfoFromRequest(r), identity)
 	if err != nil {
-		log.Printf("Failed to process Google login: %v", err)
+		log.Printf("Failed to process %s login: %v", provider, err)
 		h.redirectWithError(w, r, "failed to process login")
 		return
 	}
 
-	h.redirectWithToken(w, r, authResp)
+	h.redirectWithToken(w, r, authResp, callback.state.Redirect)
 }
 
-// HandleLineAuth redirects to LINE OAuth
-func (h *AuthHandler) HandleLineAuth(w http.ResponseWriter, r *http.Request) {
-	state := r.URL.Query().Get("state")
-	if state == "" {
-		state = "default"
+// beginLink starts a flow that links a provider identity to the caller. The caller
+// authenticates with a bearer token, or with an access_token form field when the form
+// is posted from the frontend (browsers cannot set headers on a top-level navigation).
+func (h *AuthHandler) beginLink(w http.ResponseWriter, r *http.Request, provider string, authURL func(state, codeChallenge string) string) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
 	}
-	url := h.lineClient.GetAuthURL(state)
-	http.Redirect(w, r, url, http.StatusFound)
-}
 
-// HandleLineCallback handles LINE OAuth callback
-func (h *AuthHandler) HandleLineCallback(w http.ResponseWriter, r *http.Request) {
-	code := r.URL.Query().Get("code")
-	if code == "" {
-		h.redirectWithError(w, r, "missing code parameter")
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		// A form any site can post must not start a link: the identity that signs in at the
+		// provider would be attached to whichever account's token the form carried
+		if !h.isFrontendOrigin(r.Header.Get("Origin")) {
+			http.Error(w, "cross-origin link request", http.StatusForbidden)
+			return
+		}
+		token = r.PostFormValue("access_token")
+	}
+	if token == "" {
+		http.Error(w, "authentication required", http.StatusUnauthorized)
 		return
 	}
 
-	ctx := r.Context()
-
-	// Exchange code for tokens
-	tokenResp, err := h.lineClient.ExchangeCode(ctx, code)
+	claims, err := h.jwtService.ValidateAccessToken(token)
 	if err != nil {
-		log.Printf("Failed to exchange LINE code: %v", err)
-		h.redirectWithError(w, r, "failed to exchange code")
+		http.Error(w, "invalid token", http.StatusUnauthorized)
 		return
 	}
 
-	// Get user info
-	userInfo, err := h.lineClient.GetUserInfo(ctx, tokenResp.AccessToken)
+	h.beginOAuth(w, r, provider, claims.UserID, authURL)
+}
+
+// isFrontendOrigin reports whether origin is the frontend's scheme and host
+func (h *AuthHandler) isFrontendOrigin(origin string) bool {
+	if origin == "" || h.frontendURL == "" {
+		return false
+	}
+	frontend, err := url.Parse(h.frontendURL)
 	if err != nil {
-		log.Printf("Failed to get LINE user info: %v", err)
-		h.redirectWithError(w, r, "failed to get user info")
-		return
+		return false
 	}
+	return origin == frontend.Scheme+"://"+frontend.Host
+}
 
-	// Try to get email from ID token
-	var email *string
-	if tokenResp.IDToken != "" {
-		if payload, err := h.lineClient.VerifyIDToken(ctx, tokenResp.IDToken); err == nil && payload.Email != "" {
-			email = &payload.Email
+// completeLink attaches a verified provider identity to the user who started the link flow
+func (h *AuthHandler) completeLink(w http.ResponseWriter, r *http.Request, state *auth.OAuthState, identity *auth.ProviderIdentity) {
+	if _, err := h.identities.Link(r.Context(), state.LinkUser, identity); err != nil {
+		log.Printf("Failed to link %s account: %v", identity.Provider, err)
+		switch {
+		case errors.Is(err, auth.ErrIdentityLinkedToOtherUser):
+			h.redirectWithError(w, r, "this account is already linked to another user")
+		case errors.Is(err, auth.ErrProviderAlreadyLinked):
+			h.redirectWithError(w, r, "another "+identity.Provider+" account is already linked")
+		default:
+			h.redirectWithError(w, r, "failed to link account")
 		}
+		return
 	}
 
-	var pictureURL *string
-	if userInfo.PictureURL != "" {
-		pictureURL = &userInfo.PictureURL
+	h.redirectLinked(w, r, identity.Provider, state.Redirect)
+}
+
+// oauthStateCookiePrefix names the per-provider cookie binding a flow to the browser
+const oauthStateCookiePrefix = "oauth_state_"
+
+// beginOAuth starts an authorization flow: it signs a state carrying the post-login
+// redirect, stores the nonce and PKCE verifier in a cookie and redirects to the provider.
+// linkUserID is set when the flow links the identity to that user instead of signing in.
+func (h *AuthHandler) beginOAuth(w http.ResponseWriter, r *http.Request, provider, linkUserID string, authURL func(state, codeChallenge string) string) {
+	redirect, ok := safeRedirectPath(r.URL.Query().Get("redirect"), h.redirectPaths)
+	if !ok {
+		http.Error(w, "redirect path is not allowed", http.StatusBadRequest)
+		return
 	}
 
-	// Find or create user and generate JWT
-	authResp, err := h.processOAuthLogin(ctx, "line", userInfo.UserID, email, userInfo.DisplayName, pictureURL, tokenResp.AccessToken, tokenResp.RefreshToken, tokenResp.ExpiresIn)
+	var flow *auth.OAuthFlow
+	var err error
+	if linkUserID != "" {
+		flow, err = h.states.BeginLink(provider, redirect, linkUserID)
+	} else {
+		flow, err = h.states.Begin(provider, redirect)
+	}
 	if err != nil {
-		log.Printf("Failed to process LINE login: %v", err)
-		h.redirectWithError(w, r, "failed to process login")
+		log.Printf("Failed to start %s login: %v", provider, err)
+		http.Error(w, "failed to start login", http.StatusInternalServerError)
 		return
 	}
 
-	h.redirectWithToken(w, r, authResp)
+	http.SetCookie(w, &http.Cookie{
+		Name:     oauthStateCookiePrefix + provider,
+		Value:    flow.Cookie,
+		Path:     "/auth/",
+		MaxAge:   int(h.states.TTL().Seconds()),
+		HttpOnly: true,
+		Secure:   isSecureRequest(r),
+		// Lax: the cookie must survive the top-level redirect back from the provider
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, authURL(flow.State, flow.CodeChallenge), http.StatusFound)
+}
+
+// oauthCallback is a provider callback whose state was verified
+type oauthCallback struct {
+	code     string
+	state    *auth.OAuthState
+	verifier string
+}
+
+// verifyCallback checks the state of a provider callback against the browser's state cookie.
+// The cookie is cleared either way so a state can only be used once. On failure the
+// error response has been written and ok is false.
+func (h *AuthHandler) verifyCallback(w http.ResponseWriter, r *http.Request, provider string) (*oauthCallback, bool) {
+	cookieName := oauthStateCookiePrefix + provider
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookieName,
+		Value:    "",
+		Path:     "/auth/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   isSecureRequest(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	query := r.URL.Query()
+	if providerErr := query.Get("error"); providerErr != "" {
+		h.redirectWithError(w, r, providerErr)
+		return nil, false
+	}
+
+	cookie, err := r.Cookie(cookieName)
+	if err != nil {
+		h.redirectWithError(w, r, "missing state cookie")
+		return nil, false
+	}
+
+	state, verifier, err := h.states.Verify(provider, query.Get("state"), cookie.Value)
+	if err != nil {
+		log.Printf("Rejected %s callback: %v", provider, err)
+		if errors.Is(err, auth.ErrStateExpired) {
+			h.redirectWithError(w, r, "login expired, please try again")
+		} else {
+			h.redirectWithError(w, r, "invalid state")
+		}
+		return nil, false
+	}
+
+	code := query.Get("code")
+	if code == "" {
+		h.redirectWithError(w, r, "missing code parameter")
+		return nil, false
+	}
+
+	return &oauthCallback{code: code, state: state, verifier: verifier}, true
+}
+
+// safeRedirectPath validates a post-login redirect. Only same-origin paths under one of
+// the allowed prefixes are accepted; anything that could leave the frontend is rejected.
+// An empty redirect is valid and means no redirect.
+func safeRedirectPath(redirect string, allowed []string) (string, bool) {
+	if redirect == "" {
+		return "", true
+	}
+	// "//host" and "/\host" are treated as absolute URLs by browsers
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.ContainsAny(redirect, "\\\r\n\t") {
+		return "", false
+	}
+
+	u, err := url.Parse(redirect)
+	if err != nil || u.Scheme != "" || u.Host != "" || u.User != nil {
+		return "", false
+	}
+	cleaned := path.Clean(u.Path)
+	if cleaned != u.Path && cleaned+"/" != u.Path {
+		// Reject dot segments that could escape an allowed prefix
+		return "", false
+	}
+
+	for _, prefix := range allowed {
+		if prefix == "/" || u.Path == prefix || strings.HasPrefix(u.Path, strings.TrimSuffix(prefix, "/")+"/") {
+			u.Fragment = ""
+			return u.String(), true
+		}
+	}
+	return "", false
+}
+
+// isSecureRequest reports whether the browser reached us over HTTPS (directly or via a proxy)
+func isSecureRequest(r *http.Request) bool {
+	return r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https"
 }
 
 // AuthResponse is the JSON response for auth endpoints
@@ -155,6 +317,9 @@ type AuthResponse struct {
 	RefreshToken string   `json:"refresh_token"`
 	ExpiresIn    int64    `json:"expires_in"`
 	User         UserInfo `json:"user"`
+	// Organization the access token is bound to (the user's default organization)
+	OrganizationID string `json:"organization_id,omitempty"`
+	Role           string `json:"role,omitempty"`
 }
 
 // UserInfo contains user information
@@ -166,45 +331,14 @@ type UserInfo struct {
 	IsSuperadmin bool    `json:"is_superadmin"`
 }
 
-func (h *AuthHandler) processOAuthLogin(ctx context.Context, provider, providerUserID string, email *string, displayName string, avatarURL *string, accessToken, refreshToken string, expiresIn int) (*AuthResponse, error) {
-	tokenExpiresAt := time.Now().Add(time.Duration(expiresIn) * time.Second)
-
-	// Try to find existing OAuth account
-	oauthAccount, err := h.oauthRepo.GetByProviderAndProviderUserID(ctx, provider, providerUserID)
-	var user *repository.AppUser
-
-	if err == nil {
-		// Found existing OAuth account, get the user
-		user, err = h.appUserRepo.GetByID(ctx, oauthAccount.AppUserID)
-		if err != nil {
-			return nil, err
-		}
-
-		// Update tokens
-		_, err = h.oauthRepo.UpdateTokens(ctx, oauthAccount.ID, &accessToken, &refreshToken, &tokenExpiresAt)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		// Create new user and OAuth account
-		user, err = h.appUserRepo.Create(ctx, email, displayName, avatarURL, false)
-		if err != nil {
-			return nil, err
-		}
-
-		_, err = h.oauthRepo.Create(ctx, user.ID, provider, providerUserID, email, &accessToken, &refreshToken, &tokenExpiresAt)
-		if err != nil {
-			_ = h.appUserRepo.Delete(ctx, user.ID)
-			return nil, err
-		}
+func (h *AuthHandler) processOAuthLogin(ctx context.Context, client auth.ClientInfo, identity *auth.ProviderIdentity) (*AuthResponse, error) {
+	user, err := h.identities.Login(ctx, identity)
+	if err != nil {
+		return nil, err
 	}
 
-	// Generate JWT
-	var userEmail string
-	if user.Email != nil {
-		userEmail = *user.Email
-	}
-	tokenPair, err := h.jwtService.GenerateTokenPair(user.ID, userEmail, user.DisplayName, user.IsSuperadmin)
+	// Start a session and generate JWT
+	tokenPair, err := h.sessions.StartSession(ctx, user, client)
 	if err != nil {
 		return nil, err
 	}
@@ -220,23 +354,56 @@ func (h *AuthHandler) processOAuthLogin(ctx context.Context, provider, providerU
 			AvatarURL:    user.AvatarURL,
 			IsSuperadmin: user.IsSuperadmin,
 		},
+		OrganizationID: tokenPair.OrganizationID,
+		Role:           tokenPair.Role,
 	}, nil
 }
 
+// clientInfoFromRequest describes the calling device for session listings
+func clientInfoFromRequest(r *http.Request) auth.ClientInfo {
+	client := auth.ClientInfo{UserAgent: r.UserAgent()}
+
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		client.IPAddress = strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	} else if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		client.IPAddress = host
+	}
+
+	return client
+}
+
+// HandleJWKS serves the public keys that verify our access tokens (RFC 7517)
+func (h *AuthHandler) HandleJWKS(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Verifiers refetch on an unknown kid, so a short cache is enough to pick up rotations
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	json.NewEncoder(w).Encode(h.jwtService.JWKS())
+}
+
 // RegisterRoutes registers auth HTTP routes
 func (h *AuthHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("/auth/google", h.HandleGoogleAuth)
+	mux.HandleFunc("/auth/google/link", h.HandleGoogleLink)
 	mux.HandleFunc("/auth/google/callback", h.HandleGoogleCallback)
 	mux.HandleFunc("/auth/line", h.HandleLineAuth)
+	mux.HandleFunc("/auth/line/link", h.HandleLineLink)
 	mux.HandleFunc("/auth/line/callback", h.HandleLineCallback)
+	mux.HandleFunc("/.well-known/jwks.json", h.HandleJWKS)
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
 }
 
-// redirectWithToken redirects to frontend with token in URL parameters
-func (h *AuthHandler) redirectWithToken(w http.ResponseWriter, r *http.Request, authResp *AuthResponse) {
+// redirectWithToken redirects to frontend with token in URL parameters.
+// redirect is the validated post-login path the frontend should navigate to.
+func (h *AuthHandler) redirectWithToken(w http.ResponseWriter, r *http.Request, authResp *AuthResponse, redirect string) {
 	if h.frontendURL == "" {
 		// Fallback to JSON response if no frontend URL configured
 		w.Header().Set("Content-Type", "application/json")
@@ -260,6 +427,38 @@ func (h *AuthHandler) redirectWithToken(w http.ResponseWriter, r *http.Request,
 		url.QueryEscape(authResp.RefreshToken),
 		authResp.ExpiresIn,
 	)
+	if authResp.OrganizationID != "" {
+		fragment += "&organization_id=" + url.QueryEscape(authResp.OrganizationID)
+	}
+	if redirect != "" {
+		fragment += "&redirect=" + url.QueryEscape(redirect)
+	}
+	redirectURL.Fragment = fragment
+
+	http.Redirect(w, r, redirectURL.String(), http.StatusFound)
+}
+
+// redirectLinked redirects to frontend after a provider account was linked
+func (h *AuthHandler) redirectLinked(w http.ResponseWriter, r *http.Request, provider, redirect string) {
+	if h.frontendURL == "" {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"linked": provider})
+		return
+	}
+
+	redirectURL, err := url.Parse(h.frontendURL)
+	if err != nil {
+		log.Printf("Invalid frontend URL: %v", err)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"linked": provider})
+		return
+	}
+
+	// Frontend: https://example.com/auth/callback#linked=line
+	fragment := "linked=" + url.QueryEscape(provider)
+	if redirect != "" {
+		fragment += "&redirect=" + url.QueryEscape(redirect)
+	}
 	redirectURL.Fragment = fragment
 
 	http.Redirect(w, r, redirectURL.String(), http.StatusFound)
@@ -0,0 +1,265 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/auth"
+)
+
+// newTestAuthHandler returns a handler whose Google client talks to provider.
+// The provider's userinfo endpoint fails, so a callback that passes state and PKCE
+// checks ends with "failed to get user info" before any database access.
+func newTestAuthHandler(provider *httptest.Server) *AuthHandler {
+	google := auth.NewGoogleOAuthClientWithEndpoints("client", "secret", "https://api.example.com/auth/google/callback", auth.OAuthEndpoints{
+		AuthURL:     provider.URL + "/authorize",
+		TokenURL:    provider.URL + "/token",
+		UserInfoURL: provider.URL + "/userinfo",
+	})
+	jwtService := auth.NewJWTService("test-secret", 15*time.Minute, time.Hour)
+	states := auth.NewStateSigner([]byte("test-key"), 10*time.Minute)
+	return NewAuthHandler(google, nil, jwtService, nil, states, nil, "https://app.example.com/auth/callback", []string{"/dashboard", "/settings/"})
+}
+
+// startLogin runs /auth/google and returns the state cookie and the provider authorization URL
+func startLogin(t *testing.T, h *AuthHandler, target string) (*http.Cookie, *url.URL) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.HandleGoogleAuth(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	if rec.Code != http.StatusFound {
+		t.Fatalf("HandleGoogleAuth status = %d, want 302", rec.Code)
+	}
+
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || !cookies[0].HttpOnly || cookies[0].SameSite != http.SameSiteLaxMode {
+		t.Fatalf("state cookie = %+v, want one HttpOnly SameSite=Lax cookie", cookies)
+	}
+	location, err := url.Parse(rec.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("parse Location: %v", err)
+	}
+	return cookies[0], location
+}
+
+// callback runs /auth/google/callback and returns the fragment of the frontend redirect
+func callback(t *testing.T, h *AuthHandler, query url.Values, cookie *http.Cookie) url.Values {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/auth/google/callback?"+query.Encode(), nil)
+	if cookie != nil {
+		req.AddCookie(cookie)
+	}
+	rec := httptest.NewRecorder()
+	h.HandleGoogleCallback(rec, req)
+
+	location, err := url.Parse(rec.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("parse Location: %v", err)
+	}
+	fragment, err := url.ParseQuery(location.Fragment)
+	if err != nil {
+		t.Fatalf("parse fragment: %v", err)
+	}
+	return fragment
+}
+
+func TestAuthHandler_GoogleStateAndPKCE(t *testing.T) {
+	var gotVerifier string
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			r.ParseForm()
+			gotVerifier = r.PostForm.Get("code_verifier")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token":"at","expires_in":3600,"token_type":"Bearer"}`))
+		default:
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}
+	}))
+	defer provider.Close()
+
+	h := newTestAuthHandler(provider)
+
+	t.Run("valid state", func(t *testing.T) {
+		cookie, authURL := startLogin(t, h, "/auth/google?redirect=/dashboard/reports")
+		q := authURL.Query()
+		if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+			t.Fatalf("authorization URL lacks PKCE: %v", q)
+		}
+
+		fragment := callback(t, h, url.Values{"code": {"the-code"}, "state": {q.Get("state")}}, cookie)
+		if got := fragment.Get("error"); got != "failed to get user info" {
+			t.Fatalf("error = %q, want the flow to reach the userinfo call", got)
+		}
+		if auth.PKCEChallenge(gotVerifier) != q.Get("code_challenge") {
+			t.Error("token request did not carry the verifier for the challenge")
+		}
+	})
+
+	t.Run("missing cookie", func(t *testing.T) {
+		_, authURL := startLogin(t, h, "/auth/google")
+		fragment := callback(t, h, url.Values{"code": {"the-code"}, "state": {authURL.Query().Get("state")}}, nil)
+		if got := fragment.Get("error"); got != "missing state cookie" {
+			t.Errorf("error = %q, want missing state cookie", got)
+		}
+	})
+
+	t.Run("state from another browser", func(t *testing.T) {
+		victimCookie, _ := startLogin(t, h, "/auth/google")
+		_, attackerURL := startLogin(t, h, "/auth/google")
+		fragment := callback(t, h, url.Values{"code": {"attacker-code"}, "state": {attackerURL.Query().Get("state")}}, victimCookie)
+		if got := fragment.Get("error"); got != "invalid state" {
+			t.Errorf("error = %q, want invalid state", got)
+		}
+	})
+
+	t.Run("caller supplied state", func(t *testing.T) {
+		cookie, _ := startLogin(t, h, "/auth/google")
+		fragment := callback(t, h, url.Values{"code": {"the-code"}, "state": {"default"}}, cookie)
+		if got := fragment.Get("error"); got != "invalid state" {
+			t.Errorf("error = %q, want invalid state", got)
+		}
+	})
+
+	t.Run("disallowed redirect", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.HandleGoogleAuth(rec, httptest.NewRequest(http.MethodGet, "/auth/google?redirect="+url.QueryEscape("//evil.example.com"), nil))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("status = %d, want 400", rec.Code)
+		}
+	})
+}
+
+func TestAuthHandler_GoogleLink(t *testing.T) {
+	provider := httptest.NewServer(http.NotFoundHandler())
+	defer provider.Close()
+
+	h := newTestAuthHandler(provider)
+	token, err := h.jwtService.GenerateAccessToken("user-1", "user@example.com", "User", false)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken: %v", err)
+	}
+
+	link := func(method, origin, bearer string, form url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/auth/google/link?redirect=/settings/accounts", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		if bearer != "" {
+			req.Header.Set("Authorization", "Bearer "+bearer)
+		}
+		rec := httptest.NewRecorder()
+		h.HandleGoogleLink(rec, req)
+		return rec
+	}
+
+	// linkUser returns the user a started link flow will attach the identity to
+	linkUser := func(t *testing.T, rec *httptest.ResponseRecorder) string {
+		t.Helper()
+		if rec.Code != http.StatusFound {
+			t.Fatalf("status = %d, want 302", rec.Code)
+		}
+		location, err := url.Parse(rec.Header().Get("Location"))
+		if err != nil {
+			t.Fatalf("parse Location: %v", err)
+		}
+		state, _, err := h.states.Verify("google", location.Query().Get("state"), rec.Result().Cookies()[0].Value)
+		if err != nil {
+			t.Fatalf("Verify: %v", err)
+		}
+		if state.Redirect != "/settings/accounts" {
+			t.Errorf("Redirect = %q, want /settings/accounts", state.Redirect)
+		}
+		return state.LinkUser
+	}
+
+	t.Run("form from the frontend", func(t *testing.T) {
+		rec := link(http.MethodPost, "https://app.example.com", "", url.Values{"access_token": {token}})
+		if got := linkUser(t, rec); got != "user-1" {
+			t.Errorf("LinkUser = %q, want user-1", got)
+		}
+	})
+
+	t.Run("bearer token", func(t *testing.T) {
+		rec := link(http.MethodPost, "", token, nil)
+		if got := linkUser(t, rec); got != "user-1" {
+			t.Errorf("LinkUser = %q, want user-1", got)
+		}
+	})
+
+	t.Run("login state is not a link", func(t *testing.T) {
+		cookie, authURL := startLogin(t, h, "/auth/google")
+		state, _, err := h.states.Verify("google", authURL.Query().Get("state"), cookie.Value)
+		if err != nil {
+			t.Fatalf("Verify: %v", err)
+		}
+		if state.LinkUser != "" {
+			t.Errorf("LinkUser = %q, want empty", state.LinkUser)
+		}
+	})
+
+	rejected := []struct {
+		name   string
+		method string
+		origin string
+		bearer string
+		form   url.Values
+		want   int
+	}{
+		{"form from another site", http.MethodPost, "https://evil.example.com", "", url.Values{"access_token": {token}}, http.StatusForbidden},
+		{"form without origin", http.MethodPost, "", "", url.Values{"access_token": {token}}, http.StatusForbidden},
+		{"no token", http.MethodPost, "https://app.example.com", "", nil, http.StatusUnauthorized},
+		{"invalid token", http.MethodPost, "", "not-a-token", nil, http.StatusUnauthorized},
+		{"GET", http.MethodGet, "https://app.example.com", token, nil, http.StatusMethodNotAllowed},
+	}
+	for _, tt := range rejected {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := link(tt.method, tt.origin, tt.bearer, tt.form)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if len(rec.Result().Cookies()) != 0 {
+				t.Error("rejected request set a state cookie")
+			}
+		})
+	}
+}
+
+func TestSafeRedirectPath(t *testing.T) {
+	allowed := []string{"/dashboard", "/settings/"}
+
+	tests := []struct {
+		redirect string
+		want     string
+		ok       bool
+	}{
+		{"", "", true},
+		{"/dashboard", "/dashboard", true},
+		{"/dashboard/reports?month=2024-01", "/dashboard/reports?month=2024-01", true},
+		{"/settings/profile", "/settings/profile", true},
+		{"/dashboard#section", "/dashboard", true},
+		{"/dashboardx", "", false},
+		{"/admin", "", false},
+		{"/dashboard/../admin", "", false},
+		{"//evil.example.com/dashboard", "", false},
+		{"/\\evil.example.com", "", false},
+		{"https://evil.example.com/dashboard", "", false},
+		{"dashboard", "", false},
+		{"/dashboard\r\nSet-Cookie: x=y", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := safeRedirectPath(tt.redirect, allowed)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("safeRedirectPath(%q) = %q, %v; want %q, %v", tt.redirect, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	if got, ok := safeRedirectPath("/anything", []string{"/"}); !ok || got != "/anything" {
+		t.Errorf("root prefix: got %q, %v", got, ok)
+	}
+}
@@ -0,0 +1,103 @@
+package migrate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad_Embedded(t *testing.T) {
+	migrations, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("Load: no migrations embedded")
+	}
+
+	for i, m := range migrations {
+		if m.Version != int64(i+1) {
+			t.Errorf("migration %d: version = %d, want %d (versions must be consecutive)", i, m.Version, i+1)
+		}
+		if strings.TrimSpace(m.Up) == "" || strings.TrimSpace(m.Down) == "" {
+			t.Errorf("migration %d_%s: empty up or down script", m.Version, m.Name)
+		}
+	}
+}
+
+func TestLoad_CoversRepositoryTables(t *testing.T) {
+	migrations, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	var up strings.Builder
+	for _, m := range migrations {
+		up.WriteString(m.Up)
+	}
+	schema := up.String()
+
+	tables := []string{
+		"organizations", "app_users", "user_organizations", "invitations", "oauth_accounts",
+		"refresh_tokens", "api_keys", "audit_log",
+		"files", "flickr_photo", "cam_files", "cam_file_exe", "cam_file_exe_stage",
+		"ichiban_cars", "dtako_cars_ichiban_cars", "uriage", "uriage_jisha",
+		"car_inspection", "car_inspection_files", "car_inspection_files_a", "car_inspection_files_b",
+		"car_inspection_deregistration", "car_inspection_deregistration_files",
+		"car_ins_sheet_ichiban_cars", "car_ins_sheet_ichiban_cars_a",
+		"kudguri", "kudgcst", "kudgfry", "kudgful", "kudgivt", "kudgsir",
+		"dtakologs", "etc_meisai",
+	}
+	for _, table := range tables {
+		if !strings.Contains(schema, "CREATE TABLE "+table+" (") {
+			t.Errorf("no migration creates table %s", table)
+		}
+	}
+}
+
+func TestLoadFS(t *testing.T) {
+	file := func(s string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(s)} }
+
+	t.Run("PairsAndSorts", func(t *testing.T) {
+		migrations, err := loadFS(fstest.MapFS{
+			"0002_second.up.sql":   file("CREATE TABLE b ();"),
+			"0002_second.down.sql": file("DROP TABLE b;"),
+			"0001_first.up.sql":    file("CREATE TABLE a ();"),
+			"0001_first.down.sql":  file("DROP TABLE a;"),
+		})
+		if err != nil {
+			t.Fatalf("loadFS: %v", err)
+		}
+		if len(migrations) != 2 {
+			t.Fatalf("loadFS: got %d migrations, want 2", len(migrations))
+		}
+		if migrations[0].Version != 1 || migrations[0].Name != "first" || migrations[0].Down != "DROP TABLE a;" {
+			t.Errorf("loadFS: first = %+v", migrations[0])
+		}
+		if migrations[1].Version != 2 || migrations[1].Up != "CREATE TABLE b ();" {
+			t.Errorf("loadFS: second = %+v", migrations[1])
+		}
+	})
+
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+		want error
+	}{
+		{"MissingDown", fstest.MapFS{"0001_a.up.sql": file("SELECT 1;")}, ErrMissingDown},
+		{"MissingUp", fstest.MapFS{"0001_a.down.sql": file("SELECT 1;")}, ErrMissingUp},
+		{"InvalidName", fstest.MapFS{"create_tables.sql": file("SELECT 1;")}, ErrInvalidFileName},
+		{"DuplicateVersion", fstest.MapFS{
+			"0001_a.up.sql": file("SELECT 1;"),
+			"0001_b.up.sql": file("SELECT 1;"),
+		}, ErrDuplicate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := loadFS(tt.fsys); !errors.Is(err, tt.want) {
+				t.Errorf("loadFS: err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS oauth_accounts;
+DROP TABLE IF EXISTS invitations;
+DROP TABLE IF EXISTS user_organizations;
+DROP TABLE IF EXISTS app_users;
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,105 @@
+-- Organizations, users and their memberships. These tables are shared by all
+-- organizations and are not protected by row level security.
+
+CREATE TABLE organizations (
+    id uuid NOT NULL,
+    name text NOT NULL,
+    slug text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    deleted_at timestamptz,
+    PRIMARY KEY (id),
+    UNIQUE (slug)
+);
+
+CREATE TABLE app_users (
+    id uuid NOT NULL,
+    email text,
+    display_name text NOT NULL,
+    avatar_url text,
+    is_superadmin boolean NOT NULL DEFAULT false,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    deleted_at timestamptz,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX app_users_email_key ON app_users (email) WHERE deleted_at IS NULL;
+
+CREATE TABLE user_organizations (
+    id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    role text NOT NULL,
+    is_default boolean NOT NULL DEFAULT false,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (id),
+    UNIQUE (user_id, organization_id),
+    FOREIGN KEY (user_id) REFERENCES app_users (id) ON DELETE CASCADE,
+    FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE
+);
+
+CREATE INDEX user_organizations_organization_id_idx ON user_organizations (organization_id);
+
+CREATE TABLE invitations (
+    id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    email text NOT NULL,
+    role text NOT NULL,
+    token text NOT NULL,
+    invited_by uuid NOT NULL,
+    status text NOT NULL DEFAULT 'pending',
+    expires_at timestamptz NOT NULL,
+    accepted_at timestamptz,
+    accepted_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (id),
+    UNIQUE (token),
+    FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE,
+    FOREIGN KEY (invited_by) REFERENCES app_users (id) ON DELETE CASCADE,
+    FOREIGN KEY (accepted_by) REFERENCES app_users (id) ON DELETE SET NULL
+);
+
+CREATE INDEX invitations_organization_id_idx ON invitations (organization_id);
+
+CREATE TABLE oauth_accounts (
+    id uuid NOT NULL,
+    app_user_id uuid NOT NULL,
+    provider text NOT NULL,
+    provider_user_id text NOT NULL,
+    email text,
+    access_token text,
+    refresh_token text,
+    token_expires_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (id),
+    UNIQUE (provider, provider_user_id),
+    FOREIGN KEY (app_user_id) REFERENCES app_users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX oauth_accounts_app_user_id_idx ON oauth_accounts (app_user_id);
+
+CREATE TABLE refresh_tokens (
+    id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    family_id uuid NOT NULL,
+    token_hash text NOT NULL,
+    user_agent text,
+    ip_address text,
+    issued_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL,
+    revoked_at timestamptz,
+    replaced_by uuid,
+    organization_id uuid,
+    PRIMARY KEY (id),
+    UNIQUE (token_hash),
+    FOREIGN KEY (user_id) REFERENCES app_users (id) ON DELETE CASCADE,
+    FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE SET NULL
+);
+
+CREATE INDEX refresh_tokens_family_id_idx ON refresh_tokens (family_id);
+
+CREATE INDEX refresh_tokens_user_id_idx ON refresh_tokens (user_id);
@@ -0,0 +1,25 @@
+DROP TABLE IF EXISTS etc_meisai;
+DROP TABLE IF EXISTS dtakologs;
+DROP TABLE IF EXISTS kudgsir;
+DROP TABLE IF EXISTS kudgivt;
+DROP TABLE IF EXISTS kudgful;
+DROP TABLE IF EXISTS kudgfry;
+DROP TABLE IF EXISTS kudgcst;
+DROP TABLE IF EXISTS kudguri;
+DROP TABLE IF EXISTS car_ins_sheet_ichiban_cars_a;
+DROP TABLE IF EXISTS car_ins_sheet_ichiban_cars;
+DROP TABLE IF EXISTS car_inspection_deregistration_files;
+DROP TABLE IF EXISTS car_inspection_deregistration;
+DROP TABLE IF EXISTS car_inspection_files_b;
+DROP TABLE IF EXISTS car_inspection_files_a;
+DROP TABLE IF EXISTS car_inspection_files;
+DROP TABLE IF EXISTS car_inspection;
+DROP TABLE IF EXISTS uriage_jisha;
+DROP TABLE IF EXISTS uriage;
+DROP TABLE IF EXISTS dtako_cars_ichiban_cars;
+DROP TABLE IF EXISTS ichiban_cars;
+DROP TABLE IF EXISTS cam_file_exe_stage;
+DROP TABLE IF EXISTS cam_file_exe;
+DROP TABLE IF EXISTS cam_files;
+DROP TABLE IF EXISTS flickr_photo;
+DROP TABLE IF EXISTS files;
@@ -0,0 +1,712 @@
+-- Organization data. Every table carries the owning organization, which the
+-- row level security policies of 0003 compare with app.current_organization_id.
+
+CREATE TABLE files (
+    uuid text NOT NULL,
+    organization_id text NOT NULL,
+    filename text NOT NULL,
+    created text NOT NULL,
+    deleted text NOT NULL DEFAULT '',
+    type text NOT NULL,
+    blob text,
+    PRIMARY KEY (uuid)
+);
+
+CREATE INDEX files_organization_id_idx ON files (organization_id);
+
+CREATE TABLE flickr_photo (
+    id text NOT NULL,
+    organization_id text NOT NULL,
+    secret text NOT NULL,
+    server text NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX flickr_photo_organization_id_idx ON flickr_photo (organization_id);
+
+CREATE TABLE cam_files (
+    name text NOT NULL,
+    organization_id text NOT NULL,
+    date text NOT NULL,
+    hour text NOT NULL,
+    type text NOT NULL,
+    cam text NOT NULL,
+    flickr_id text,
+    PRIMARY KEY (organization_id, name)
+);
+
+CREATE TABLE cam_file_exe (
+    name text NOT NULL,
+    cam text NOT NULL,
+    organization_id text NOT NULL,
+    stage integer NOT NULL,
+    PRIMARY KEY (organization_id, name, cam)
+);
+
+CREATE TABLE cam_file_exe_stage (
+    stage integer NOT NULL,
+    organization_id text NOT NULL,
+    name text NOT NULL,
+    PRIMARY KEY (organization_id, stage)
+);
+
+CREATE TABLE ichiban_cars (
+    id text NOT NULL,
+    organization_id text NOT NULL,
+    id4 text NOT NULL,
+    name text,
+    "name_R" text,
+    shashu text NOT NULL,
+    sekisai double precision,
+    reg_date text,
+    parch_date text,
+    scrap_date text,
+    bumon_code_id text,
+    driver_id text,
+    PRIMARY KEY (organization_id, id)
+);
+
+CREATE TABLE dtako_cars_ichiban_cars (
+    id_dtako text NOT NULL,
+    organization_id text NOT NULL,
+    id text,
+    PRIMARY KEY (organization_id, id_dtako)
+);
+
+CREATE TABLE uriage (
+    name text NOT NULL,
+    bumon text NOT NULL,
+    organization_id text NOT NULL,
+    kingaku integer,
+    type integer,
+    cam integer,
+    date text NOT NULL,
+    PRIMARY KEY (organization_id, name, bumon, date)
+);
+
+CREATE TABLE uriage_jisha (
+    bumon text NOT NULL,
+    organization_id text NOT NULL,
+    kingaku integer,
+    type integer,
+    date text NOT NULL,
+    PRIMARY KEY (organization_id, bumon, date)
+);
+
+CREATE TABLE car_inspection (
+    organization_id text NOT NULL,
+    "CertInfoImportFileVersion" text NOT NULL,
+    "AcceptOutputNo" text NOT NULL,
+    "FormType" text NOT NULL,
+    "ElectCertMgNo" text NOT NULL,
+    "CarId" text NOT NULL,
+    "ElectCertPublishdateE" text NOT NULL,
+    "ElectCertPublishdateY" text NOT NULL,
+    "ElectCertPublishdateM" text NOT NULL,
+    "ElectCertPublishdateD" text NOT NULL,
+    "GrantdateE" text NOT NULL,
+    "GrantdateY" text NOT NULL,
+    "GrantdateM" text NOT NULL,
+    "GrantdateD" text NOT NULL,
+    "TranspotationBureauChiefName" text NOT NULL,
+    "EntryNoCarNo" text NOT NULL,
+    "RegGrantdateE" text NOT NULL,
+    "RegGrantdateY" text NOT NULL,
+    "RegGrantdateM" text NOT NULL,
+    "RegGrantdateD" text NOT NULL,
+    "FirstRegistDateE" text NOT NULL,
+    "FirstRegistDateY" text NOT NULL,
+    "FirstRegistDateM" text NOT NULL,
+    "CarName" text NOT NULL,
+    "CarNameCode" text NOT NULL,
+    "CarNo" text NOT NULL,
+    "Model" text NOT NULL,
+    "EngineModel" text NOT NULL,
+    "OwnerNameLowLevelChar" text NOT NULL,
+    "OwnerNameHighLevelChar" text NOT NULL,
+    "OwnerAddressChar" text NOT NULL,
+    "OwnerAddressNumValue" text NOT NULL,
+    "OwnerAddressCode" text NOT NULL,
+    "UserNameLowLevelChar" text NOT NULL,
+    "UserNameHighLevelChar" text NOT NULL,
+    "UserAddressChar" text NOT NULL,
+    "UserAddressNumValue" text NOT NULL,
+    "UserAddressCode" text NOT NULL,
+    "UseHeadqurterChar" text NOT NULL,
+    "UseHeadqurterNumValue" text NOT NULL,
+    "UseHeadqurterCode" text NOT NULL,
+    "CarKind" text NOT NULL,
+    "Use" text NOT NULL,
+    "PrivateBusiness" text NOT NULL,
+    "CarShape" text NOT NULL,
+    "CarShapeCode" text NOT NULL,
+    "NoteCap" text NOT NULL,
+    "Cap" text NOT NULL,
+    "NoteMaxLoadage" text NOT NULL,
+    "MaxLoadage" text NOT NULL,
+    "NoteCarWgt" text NOT NULL,
+    "CarWgt" text NOT NULL,
+    "NoteCarTotalWgt" text NOT NULL,
+    "CarTotalWgt" text NOT NULL,
+    "NoteLength" text NOT NULL,
+    "Length" text NOT NULL,
+    "NoteWidth" text NOT NULL,
+    "Width" text NOT NULL,
+    "NoteHeight" text NOT NULL,
+    "Height" text NOT NULL,
+    "FfAxWgt" text NOT NULL,
+    "FrAxWgt" text NOT NULL,
+    "RfAxWgt" text NOT NULL,
+    "RrAxWgt" text NOT NULL,
+    "Displacement" text NOT NULL,
+    "FuelClass" text NOT NULL,
+    "ModelSpecifyNo" text NOT NULL,
+    "ClassifyAroundNo" text NOT NULL,
+    "ValidPeriodExpirDateE" text NOT NULL,
+    "ValidPeriodExpirDateY" text NOT NULL,
+    "ValidPeriodExpirDateM" text NOT NULL,
+    "ValidPeriodExpirDateD" text NOT NULL,
+    "NoteInfo" text NOT NULL,
+    "TwodimensionCodeInfoEntryNoCarNo" text NOT NULL,
+    "TwodimensionCodeInfoCarNo" text NOT NULL,
+    "TwodimensionCodeInfoValidPeriodExpirDate" text NOT NULL,
+    "TwodimensionCodeInfoModel" text NOT NULL,
+    "TwodimensionCodeInfoModelSpecifyNoClassifyAroundNo" text NOT NULL,
+    "TwodimensionCodeInfoCharInfo" text NOT NULL,
+    "TwodimensionCodeInfoEngineModel" text NOT NULL,
+    "TwodimensionCodeInfoCarNoStampPlace" text NOT NULL,
+    "TwodimensionCodeInfoFirstRegistDate" text NOT NULL,
+    "TwodimensionCodeInfoFfAxWgt" text NOT NULL,
+    "TwodimensionCodeInfoFrAxWgt" text NOT NULL,
+    "TwodimensionCodeInfoRfAxWgt" text NOT NULL,
+    "TwodimensionCodeInfoRrAxWgt" text NOT NULL,
+    "TwodimensionCodeInfoNoiseReg" text NOT NULL,
+    "TwodimensionCodeInfoNearNoiseReg" text NOT NULL,
+    "TwodimensionCodeInfoDriveMethod" text NOT NULL,
+    "TwodimensionCodeInfoOpacimeterMeasCar" text NOT NULL,
+    "TwodimensionCodeInfoNoxPmMeasMode" text NOT NULL,
+    "TwodimensionCodeInfoNoxValue" text NOT NULL,
+    "TwodimensionCodeInfoPmValue" text NOT NULL,
+    "TwodimensionCodeInfoSafeStdDate" text NOT NULL,
+    "TwodimensionCodeInfoFuelClassCode" text NOT NULL,
+    "RegistCarLightCar" text NOT NULL,
+    "Created" text NOT NULL,
+    "Modified" text NOT NULL,
+    PRIMARY KEY (organization_id, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD")
+);
+
+CREATE TABLE car_inspection_files (
+    uuid text NOT NULL,
+    organization_id text NOT NULL,
+    type text NOT NULL,
+    "ElectCertMgNo" text NOT NULL,
+    "ElectCertPublishdateE" text NOT NULL,
+    "ElectCertPublishdateY" text NOT NULL,
+    "ElectCertPublishdateM" text NOT NULL,
+    "ElectCertPublishdateD" text NOT NULL,
+    created text NOT NULL,
+    modified text NOT NULL,
+    deleted text,
+    PRIMARY KEY (uuid)
+);
+
+CREATE INDEX car_inspection_files_organization_id_idx ON car_inspection_files (organization_id);
+
+CREATE TABLE car_inspection_files_a (
+    uuid text NOT NULL,
+    organization_id text NOT NULL,
+    type text NOT NULL,
+    "ElectCertMgNo" text NOT NULL,
+    "GrantdateE" text NOT NULL,
+    "GrantdateY" text NOT NULL,
+    "GrantdateM" text NOT NULL,
+    "GrantdateD" text NOT NULL,
+    created text NOT NULL,
+    modified text NOT NULL,
+    deleted text,
+    PRIMARY KEY (uuid)
+);
+
+CREATE INDEX car_inspection_files_a_organization_id_idx ON car_inspection_files_a (organization_id);
+
+CREATE TABLE car_inspection_files_b (
+    uuid text NOT NULL,
+    organization_id text NOT NULL,
+    type text NOT NULL,
+    "ElectCertMgNo" text NOT NULL,
+    "GrantdateE" text NOT NULL,
+    "GrantdateY" text NOT NULL,
+    "GrantdateM" text NOT NULL,
+    "GrantdateD" text NOT NULL,
+    created text NOT NULL,
+    modified text NOT NULL,
+    deleted text,
+    PRIMARY KEY (uuid)
+);
+
+CREATE INDEX car_inspection_files_b_organization_id_idx ON car_inspection_files_b (organization_id);
+
+CREATE TABLE car_inspection_deregistration (
+    organization_id text NOT NULL,
+    "CarId" text NOT NULL,
+    "TwodimensionCodeInfoCarNo" text NOT NULL,
+    "CarNo" text NOT NULL,
+    "ValidPeriodExpirdateE" text NOT NULL,
+    "ValidPeriodExpirdateY" text NOT NULL,
+    "ValidPeriodExpirdateM" text NOT NULL,
+    "ValidPeriodExpirdateD" text NOT NULL,
+    "TwodimensionCodeInfoValidPeriodExpirdate" text NOT NULL,
+    PRIMARY KEY (organization_id, "CarId", "TwodimensionCodeInfoValidPeriodExpirdate")
+);
+
+CREATE TABLE car_inspection_deregistration_files (
+    organization_id text NOT NULL,
+    "CarId" text NOT NULL,
+    "TwodimensionCodeInfoValidPeriodExpirdate" text NOT NULL,
+    "fileUuid" text NOT NULL,
+    PRIMARY KEY (organization_id, "CarId", "TwodimensionCodeInfoValidPeriodExpirdate", "fileUuid")
+);
+
+CREATE TABLE car_ins_sheet_ichiban_cars (
+    organization_id text NOT NULL,
+    id_cars text,
+    "ElectCertMgNo" text NOT NULL,
+    "ElectCertPublishdateE" text NOT NULL,
+    "ElectCertPublishdateY" text NOT NULL,
+    "ElectCertPublishdateM" text NOT NULL,
+    "ElectCertPublishdateD" text NOT NULL,
+    PRIMARY KEY (organization_id, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD")
+);
+
+CREATE TABLE car_ins_sheet_ichiban_cars_a (
+    organization_id text NOT NULL,
+    id_cars text,
+    "ElectCertMgNo" text NOT NULL,
+    "GrantdateE" text NOT NULL,
+    "GrantdateY" text NOT NULL,
+    "GrantdateM" text NOT NULL,
+    "GrantdateD" text NOT NULL,
+    PRIMARY KEY (organization_id, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD")
+);
+
+CREATE TABLE kudguri (
+    uuid text NOT NULL,
+    "OrganizationID" text NOT NULL,
+    "Hash" text NOT NULL,
+    "Created" text NOT NULL,
+    "Deleted" text,
+    "unkouNo" text NOT NULL,
+    "kudguriUuid" text NOT NULL,
+    "ReadDate" text,
+    "OfficeCd" text,
+    "OfficeName" text,
+    "VehicleCd" text,
+    "VehicleName" text,
+    "DriverCd1" text,
+    "DriverName1" text,
+    "TargetDriverType" text NOT NULL,
+    "TargetDriverCd" text,
+    "TargetDriverName" text,
+    "StartDatetime" text,
+    "EndDatetime" text,
+    "EventCd" text,
+    "EventName" text,
+    "StartMileage" text,
+    "EndMileage" text,
+    "SectionTime" text,
+    "SectionDistance" text,
+    "StartCityCd" text,
+    "StartCityName" text,
+    "EndCityCd" text,
+    "EndCityName" text,
+    "StartPlaceCd" text,
+    "StartPlaceName" text,
+    "EndPlaceCd" text,
+    "EndPlaceName" text,
+    "StartGpsValid" text,
+    "StartGpsLat" text,
+    "StartGpsLng" text,
+    "EndGpsValid" text,
+    "EndGpsLat" text,
+    "EndGpsLng" text,
+    "OverLimitMax" text,
+    PRIMARY KEY (uuid)
+);
+
+CREATE INDEX kudguri_organization_id_idx ON kudguri ("OrganizationID");
+
+CREATE TABLE kudgcst (
+    "UUID" text NOT NULL,
+    "OrganizationID" text NOT NULL,
+    "Hash" text NOT NULL,
+    "Created" text NOT NULL,
+    "Deleted" text,
+    "KudguriUuid" text,
+    "UnkouNo" text,
+    "UnkouDate" text,
+    "ReadDate" text,
+    "OfficeCd" text,
+    "OfficeName" text,
+    "VehicleCd" text,
+    "VehicleName" text,
+    "DriverCd1" text,
+    "DriverName1" text,
+    "TargetDriverType" text NOT NULL,
+    "StartDatetime" text,
+    "EndDatetime" text,
+    "FerryCompanyCd" text,
+    "FerryCompanyName" text,
+    "BoardingPlaceCd" text,
+    "BoardingPlaceName" text,
+    "TripNumber" text,
+    "DropoffPlaceCd" text,
+    "DropoffPlaceName" text,
+    "SettlementType" text,
+    "SettlementTypeName" text,
+    "StandardFare" text,
+    "ContractFare" text,
+    "FerryVehicleType" text,
+    "FerryVehicleTypeName" text,
+    "AssumedDistance" text,
+    PRIMARY KEY ("UUID")
+);
+
+CREATE INDEX kudgcst_organization_id_idx ON kudgcst ("OrganizationID");
+
+CREATE TABLE kudgfry (
+    uuid text NOT NULL,
+    "OrganizationID" text NOT NULL,
+    "Hash" text NOT NULL,
+    "Created" text NOT NULL,
+    "Deleted" text,
+    "KudguriUuid" text,
+    "TargetDriverType" text NOT NULL,
+    "UnkouNo" text,
+    "UnkouDate" text,
+    "ReadDate" text,
+    "OfficeCd" text,
+    "OfficeName" text,
+    "VehicleCd" text,
+    "VehicleName" text,
+    "DriverCd1" text,
+    "DriverName1" text,
+    "DriverCd2" text,
+    "DriverName2" text,
+    "RelevantDatetime" text,
+    "RefuelInspectCategory" text,
+    "RefuelInspectCategoryName" text,
+    "RefuelInspectType" text,
+    "RefuelInspectTypeName" text,
+    "RefuelInspectKind" text,
+    "RefuelInspectKindName" text,
+    "RefillAmount" text,
+    "OwnOtherType" text,
+    mileage text,
+    "MeterValue" text,
+    PRIMARY KEY (uuid)
+);
+
+CREATE INDEX kudgfry_organization_id_idx ON kudgfry ("OrganizationID");
+
+CREATE TABLE kudgful (
+    "uuid" text NOT NULL,
+    "OrganizationID" text NOT NULL,
+    "Hash" text NOT NULL,
+    "Created" text NOT NULL,
+    "Deleted" text,
+    "KudguriUuid" text,
+    "UnkouNo" text,
+    "ReadDate" text,
+    "OfficeCd" text,
+    "OfficeName" text,
+    "VehicleCd" text,
+    "VehicleName" text,
+    "DriverCd1" text,
+    "DriverName1" text,
+    "TargetDriverType" text NOT NULL,
+    "TargetDriverCd" text,
+    "TargetDriverName" text,
+    "StartDatetime" text,
+    "EndDatetime" text,
+    "EventCd" text,
+    "EventName" text,
+    "StartMileage" text,
+    "EndMileage" text,
+    "SectionTime" text,
+    "SectionDistance" text,
+    "StartCityCd" text,
+    "StartCityName" text,
+    "EndCityCd" text,
+    "EndCityName" text,
+    "StartPlaceCd" text,
+    "StartPlaceName" text,
+    "EndPlaceCd" text,
+    "EndPlaceName" text,
+    "StartGpsValid" text,
+    "StartGpsLat" text,
+    "StartGpsLng" text,
+    "EndGpsValid" text,
+    "EndGpsLat" text,
+    "EndGpsLng" text,
+    "OverLimitMax" text,
+    PRIMARY KEY ("uuid")
+);
+
+CREATE INDEX kudgful_organization_id_idx ON kudgful ("OrganizationID");
+
+CREATE TABLE kudgivt (
+    "UUID" text NOT NULL,
+    "OrganizationID" text NOT NULL,
+    "Hash" text NOT NULL,
+    "Created" text NOT NULL,
+    "Deleted" text,
+    "KudguriUuid" text,
+    "UnkouNo" text,
+    "ReadDate" text,
+    "UnkouDate" text,
+    "OfficeCd" text,
+    "OfficeName" text,
+    "VehicleCd" text,
+    "VehicleName" text,
+    "DriverCd1" text,
+    "DriverName1" text,
+    "TargetDriverType" text NOT NULL,
+    "TargetDriverCd" text,
+    "TargetDriverName" text,
+    "ClockInDatetime" text,
+    "ClockOutDatetime" text,
+    "DepartureDatetime" text,
+    "ReturnDatetime" text,
+    "DepartureMeter" text,
+    "ReturnMeter" text,
+    "TotalMileage" text,
+    "DestinationCityName" text,
+    "DestinationPlaceName" text,
+    "ActualMileage" text,
+    "LocalDriveTime" text,
+    "ExpressDriveTime" text,
+    "BypassDriveTime" text,
+    "ActualDriveTime" text,
+    "EmptyDriveTime" text,
+    "Work1Time" text,
+    "Work2Time" text,
+    "Work3Time" text,
+    "Work4Time" text,
+    "Work5Time" text,
+    "Work6Time" text,
+    "Work7Time" text,
+    "Work8Time" text,
+    "Work9Time" text,
+    "Work10Time" text,
+    "State1Distance" text,
+    "State2Distance" text,
+    "State3Distance" text,
+    "State4Distance" text,
+    "State5Distance" text,
+    "State1Time" text,
+    "State2Time" text,
+    "State3Time" text,
+    "State4Time" text,
+    "State5Time" text,
+    "OwnMainFuel" text,
+    "OwnMainAdditive" text,
+    "OwnConsumable" text,
+    "OtherMainFuel" text,
+    "OtherMainAdditive" text,
+    "OtherConsumable" text,
+    "LocalSpeedOverMax" text,
+    "LocalSpeedOverTime" text,
+    "LocalSpeedOverCount" text,
+    "ExpressSpeedOverMax" text,
+    "ExpressSpeedOverTime" text,
+    "ExpressSpeedOverCount" text,
+    "DedicatedSpeedOverMax" text,
+    "DedicatedSpeedOverTime" text,
+    "DedicatedSpeedOverCount" text,
+    "IdlingTime" text,
+    "IdlingTimeCount" text,
+    "RotationOverMax" text,
+    "RotationOverCount" text,
+    "RotationOverTime" text,
+    "RapidAccelCount1" text,
+    "RapidAccelCount2" text,
+    "RapidAccelCount3" text,
+    "RapidAccelCount4" text,
+    "RapidAccelCount5" text,
+    "RapidAccelMax" text,
+    "RapidAccelMaxSpeed" text,
+    "RapidDecelCount1" text,
+    "RapidDecelCount2" text,
+    "RapidDecelCount3" text,
+    "RapidDecelCount4" text,
+    "RapidDecelCount5" text,
+    "RapidDecelMax" text,
+    "RapidDecelMaxSpeed" text,
+    "RapidCurveCount1" text,
+    "RapidCurveCount2" text,
+    "RapidCurveCount3" text,
+    "RapidCurveCount4" text,
+    "RapidCurveCount5" text,
+    "RapidCurveMax" text,
+    "RapidCurveMaxSpeed" text,
+    "ContinuousDriveOverCount" text,
+    "ContinuousDriveMaxTime" text,
+    "ContinuousDriveTotalTime" text,
+    "WaveDriveCount" text,
+    "WaveDriveMaxTime" text,
+    "WaveDriveMaxSpeedDiff" text,
+    "LocalSpeedScore" text,
+    "ExpressSpeedScore" text,
+    "DedicatedSpeedScore" text,
+    "LocalDistanceScore" text,
+    "ExpressDistanceScore" text,
+    "DedicatedDistanceScore" text,
+    "RapidAccelScore" text,
+    "RapidDecelScore" text,
+    "RapidCurveScore" text,
+    "ActualLowSpeedRotationScore" text,
+    "ActualHighSpeedRotationScore" text,
+    "EmptyLowSpeedRotationScore" text,
+    "EmptyHighSpeedRotationScore" text,
+    "IdlingScore" text,
+    "ContinuousDriveScore" text,
+    "WaveDriveScore" text,
+    "SafetyScore" text,
+    "EconomyScore" text,
+    "TotalScore" text,
+    PRIMARY KEY ("UUID")
+);
+
+CREATE INDEX kudgivt_organization_id_idx ON kudgivt ("OrganizationID");
+
+CREATE TABLE kudgsir (
+    uuid text NOT NULL,
+    organization_id text NOT NULL,
+    hash text NOT NULL,
+    created text NOT NULL,
+    deleted text,
+    kudguri_uuid text,
+    unkou_no text,
+    read_date text,
+    office_cd text,
+    office_name text,
+    vehicle_cd text,
+    vehicle_name text,
+    driver_cd_1 text,
+    driver_name_1 text,
+    target_driver_type text NOT NULL,
+    target_driver_cd text,
+    target_driver_name text,
+    start_datetime text,
+    end_datetime text,
+    event_cd text,
+    event_name text,
+    start_mileage text,
+    end_mileage text,
+    section_time text,
+    section_distance text,
+    start_city_cd text,
+    start_city_name text,
+    end_city_cd text,
+    end_city_name text,
+    start_place_cd text,
+    start_place_name text,
+    end_place_cd text,
+    end_place_name text,
+    start_gps_valid text,
+    start_gps_lat text,
+    start_gps_lng text,
+    end_gps_valid text,
+    end_gps_lat text,
+    end_gps_lng text,
+    over_limit_max text,
+    PRIMARY KEY (uuid)
+);
+
+CREATE INDEX kudgsir_organization_id_idx ON kudgsir (organization_id);
+
+CREATE TABLE dtakologs (
+    organization_id text NOT NULL,
+    __type text NOT NULL,
+    "AddressDispC" text,
+    "AddressDispP" text,
+    "AllState" text,
+    "AllStateEx" text,
+    "AllStateFontColor" text,
+    "AllStateFontColorIndex" integer NOT NULL,
+    "AllStateRyoutColor" text NOT NULL,
+    "BranchCD" integer NOT NULL,
+    "BranchName" text NOT NULL,
+    "ComuDateTime" text,
+    "CurrentWorkCD" integer NOT NULL,
+    "CurrentWorkName" text,
+    "DataDateTime" text NOT NULL,
+    "DataFilterType" integer NOT NULL,
+    "DispFlag" integer NOT NULL,
+    "DriverCD" integer NOT NULL,
+    "DriverName" text,
+    "EventVal" text,
+    "GpsDirection" integer NOT NULL,
+    "GpsEnable" integer NOT NULL,
+    "GpsLatiAndLong" text,
+    "GpsLatitude" integer NOT NULL,
+    "GpsLongitude" integer NOT NULL,
+    "GpsSatelliteNum" integer NOT NULL,
+    "OdoMeter" text,
+    "OperationState" integer NOT NULL,
+    "ReciveEventType" integer NOT NULL,
+    "RecivePacketType" integer NOT NULL,
+    "ReciveTypeColorName" text,
+    "ReciveTypeName" text,
+    "ReciveWorkCD" integer NOT NULL,
+    "Revo" integer NOT NULL,
+    "SettingTemp" text NOT NULL,
+    "SettingTemp1" text NOT NULL,
+    "SettingTemp3" text NOT NULL,
+    "SettingTemp4" text NOT NULL,
+    "Speed" real NOT NULL,
+    "StartWorkDateTime" text,
+    "State" text,
+    "State1" text,
+    "State2" text,
+    "State3" text,
+    "StateFlag" text NOT NULL,
+    "SubDriverCD" integer NOT NULL,
+    "Temp1" text,
+    "Temp2" text,
+    "Temp3" text,
+    "Temp4" text,
+    "TempState" integer NOT NULL,
+    "VehicleCD" integer NOT NULL,
+    "VehicleIconColor" text,
+    "VehicleIconLabelForDatetime" text,
+    "VehicleIconLabelForDriver" text,
+    "VehicleIconLabelForVehicle" text,
+    "VehicleName" text NOT NULL,
+    PRIMARY KEY (organization_id, "DataDateTime", "VehicleCD")
+);
+
+CREATE TABLE etc_meisai (
+    id bigint GENERATED BY DEFAULT AS IDENTITY,
+    organization_id text NOT NULL,
+    date_fr timestamptz,
+    date_to timestamptz NOT NULL,
+    date_to_date text NOT NULL,
+    ic_fr text NOT NULL,
+    ic_to text NOT NULL,
+    price_bf integer,
+    discount integer,
+    price integer NOT NULL,
+    shashu integer NOT NULL,
+    car_id_num integer,
+    etc_num text NOT NULL,
+    detail text,
+    dtako_row_id text,
+    hash text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (id),
+    UNIQUE (organization_id, hash)
+);
+
+CREATE INDEX etc_meisai_organization_id_date_to_idx ON etc_meisai (organization_id, date_to DESC, id DESC);
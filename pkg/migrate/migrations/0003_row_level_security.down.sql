@@ -0,0 +1,50 @@
+DROP POLICY IF EXISTS tenant_isolation ON etc_meisai;
+ALTER TABLE etc_meisai DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON dtakologs;
+ALTER TABLE dtakologs DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON kudgsir;
+ALTER TABLE kudgsir DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON kudgivt;
+ALTER TABLE kudgivt DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON kudgful;
+ALTER TABLE kudgful DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON kudgfry;
+ALTER TABLE kudgfry DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON kudgcst;
+ALTER TABLE kudgcst DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON kudguri;
+ALTER TABLE kudguri DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON car_ins_sheet_ichiban_cars_a;
+ALTER TABLE car_ins_sheet_ichiban_cars_a DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON car_ins_sheet_ichiban_cars;
+ALTER TABLE car_ins_sheet_ichiban_cars DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON car_inspection_deregistration_files;
+ALTER TABLE car_inspection_deregistration_files DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON car_inspection_deregistration;
+ALTER TABLE car_inspection_deregistration DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON car_inspection_files_b;
+ALTER TABLE car_inspection_files_b DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON car_inspection_files_a;
+ALTER TABLE car_inspection_files_a DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON car_inspection_files;
+ALTER TABLE car_inspection_files DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON car_inspection;
+ALTER TABLE car_inspection DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON uriage_jisha;
+ALTER TABLE uriage_jisha DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON uriage;
+ALTER TABLE uriage DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON dtako_cars_ichiban_cars;
+ALTER TABLE dtako_cars_ichiban_cars DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON ichiban_cars;
+ALTER TABLE ichiban_cars DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON cam_file_exe_stage;
+ALTER TABLE cam_file_exe_stage DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON cam_file_exe;
+ALTER TABLE cam_file_exe DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON cam_files;
+ALTER TABLE cam_files DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON flickr_photo;
+ALTER TABLE flickr_photo DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON files;
+ALTER TABLE files DISABLE ROW LEVEL SECURITY;
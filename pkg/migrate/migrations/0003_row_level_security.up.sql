@@ -0,0 +1,129 @@
+-- Each organization only sees and writes its own rows. The server sets
+-- app.current_organization_id per statement (see pkg/db/rls.go); without it no
+-- rows match. Table owners and superusers bypass these policies, so the
+-- application must connect as a role that does not own the tables.
+
+ALTER TABLE files ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON files
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE flickr_photo ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON flickr_photo
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE cam_files ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON cam_files
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE cam_file_exe ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON cam_file_exe
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE cam_file_exe_stage ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON cam_file_exe_stage
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE ichiban_cars ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON ichiban_cars
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE dtako_cars_ichiban_cars ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON dtako_cars_ichiban_cars
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE uriage ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON uriage
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE uriage_jisha ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON uriage_jisha
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE car_inspection ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON car_inspection
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE car_inspection_files ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON car_inspection_files
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE car_inspection_files_a ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON car_inspection_files_a
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE car_inspection_files_b ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON car_inspection_files_b
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE car_inspection_deregistration ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON car_inspection_deregistration
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE car_inspection_deregistration_files ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON car_inspection_deregistration_files
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE car_ins_sheet_ichiban_cars ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON car_ins_sheet_ichiban_cars
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE car_ins_sheet_ichiban_cars_a ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON car_ins_sheet_ichiban_cars_a
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE kudguri ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON kudguri
+    USING ("OrganizationID" = current_setting('app.current_organization_id', true))
+    WITH CHECK ("OrganizationID" = current_setting('app.current_organization_id', true));
+
+ALTER TABLE kudgcst ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON kudgcst
+    USING ("OrganizationID" = current_setting('app.current_organization_id', true))
+    WITH CHECK ("OrganizationID" = current_setting('app.current_organization_id', true));
+
+ALTER TABLE kudgfry ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON kudgfry
+    USING ("OrganizationID" = current_setting('app.current_organization_id', true))
+    WITH CHECK ("OrganizationID" = current_setting('app.current_organization_id', true));
+
+ALTER TABLE kudgful ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON kudgful
+    USING ("OrganizationID" = current_setting('app.current_organization_id', true))
+    WITH CHECK ("OrganizationID" = current_setting('app.current_organization_id', true));
+
+ALTER TABLE kudgivt ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON kudgivt
+    USING ("OrganizationID" = current_setting('app.current_organization_id', true))
+    WITH CHECK ("OrganizationID" = current_setting('app.current_organization_id', true));
+
+ALTER TABLE kudgsir ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON kudgsir
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE dtakologs ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON dtakologs
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
+
+ALTER TABLE etc_meisai ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON etc_meisai
+    USING (organization_id = current_setting('app.current_organization_id', true))
+    WITH CHECK (organization_id = current_setting('app.current_organization_id', true));
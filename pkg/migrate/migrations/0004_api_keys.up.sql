@@ -0,0 +1,23 @@
+-- API keys are looked up across organizations to authenticate a request, so the
+-- organization is checked by the server instead of by row level security.
+
+CREATE TABLE api_keys (
+    id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    name text NOT NULL,
+    prefix text NOT NULL,
+    key_hash text NOT NULL,
+    scopes text[] NOT NULL DEFAULT '{}',
+    role text NOT NULL,
+    created_by uuid NOT NULL,
+    expires_at timestamptz,
+    last_used_at timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (id),
+    UNIQUE (key_hash),
+    FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES app_users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX api_keys_organization_id_idx ON api_keys (organization_id);
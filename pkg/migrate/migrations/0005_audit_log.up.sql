@@ -0,0 +1,18 @@
+-- Audit entries outlive the rows, users and keys they refer to, so there are no
+-- foreign keys.
+
+CREATE TABLE audit_log (
+    id uuid NOT NULL,
+    organization_id text,
+    user_id text,
+    api_key_id text,
+    method text NOT NULL,
+    entity_type text NOT NULL,
+    entity_id text,
+    status_code text NOT NULL,
+    request jsonb,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX audit_log_organization_id_created_at_idx ON audit_log (organization_id, created_at DESC);
@@ -0,0 +1,144 @@
+// Package pagination implements keyset pagination for List RPCs: repositories list the
+// rows after the sort key of the previous page's last row, and servers hand that key to
+// clients as a signed, opaque page token.
+package pagination
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Key is the sort key of a row, one value per column of its Order in text form
+type Key []string
+
+// Time formats a timestamptz key value without losing precision
+func Time(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// Column is one column of a listing's sort order
+type Column struct {
+	Name string // SQL column, quoted as needed
+	Type string // SQL type key values are cast to, e.g. "text" or "timestamptz"
+	Desc bool
+}
+
+// Asc returns an ascending sort column
+func Asc(name, typ string) Column {
+	return Column{Name: name, Type: typ}
+}
+
+// Desc returns a descending sort column
+func Desc(name, typ string) Column {
+	return Column{Name: name, Type: typ, Desc: true}
+}
+
+// Order is the sort order of a listing. Its columns must identify a row (end with the
+// primary key), so that every row has exactly one position and pages never overlap.
+type Order []Column
+
+// OrderBy returns the body of the ORDER BY clause
+func (o Order) OrderBy() string {
+	cols := make([]string, len(o))
+	for i, c := range o {
+		cols[i] = c.Name
+		if c.Desc {
+			cols[i] += " DESC"
+		}
+	}
+	return strings.Join(cols, ", ")
+}
+
+// After returns a condition selecting the rows that sort after key, with the key values
+// as parameters $arg, $arg+1, ... A nil key (the first page) selects every row.
+// Orders in a single direction compare row values, which an index on the columns serves.
+func (o Order) After(key Key, arg int) (string, []any, error) {
+	if key == nil {
+		return "TRUE", nil, nil
+	}
+	if len(key) != len(o) {
+		return "", nil, ErrInvalidToken
+	}
+
+	args := make([]any, len(key))
+	params := make([]string, len(key))
+	for i, v := range key {
+		args[i] = v
+		params[i] = fmt.Sprintf("$%d::%s", arg+i, o[i].Type)
+	}
+
+	if o.uniform() {
+		cols := make([]string, len(o))
+		for i, c := range o {
+			cols[i] = c.Name
+		}
+		return fmt.Sprintf("(%s) %s (%s)", strings.Join(cols, ", "), o[0].op(), strings.Join(params, ", ")), args, nil
+	}
+
+	// (a > $1) OR (a = $1 AND b < $2) OR ...
+	terms := make([]string, len(o))
+	for i, c := range o {
+		conds := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			conds = append(conds, o[j].Name+" = "+params[j])
+		}
+		conds = append(conds, c.Name+" "+c.op()+" "+params[i])
+		terms[i] = "(" + strings.Join(conds, " AND ") + ")"
+	}
+	return "(" + strings.Join(terms, " OR ") + ")", args, nil
+}
+
+// uniform reports whether all columns sort in the same direction
+func (o Order) uniform() bool {
+	for _, c := range o {
+		if c.Desc != o[0].Desc {
+			return false
+		}
+	}
+	return true
+}
+
+// op is the comparison selecting the values that sort after a key value
+func (c Column) op() string {
+	if c.Desc {
+		return "<"
+	}
+	return ">"
+}
+
+// Page selects up to Size rows after the key After; a nil After is the first page
+type Page struct {
+	Size  int
+	After Key
+}
+
+// Limit returns the page size, defaulted and capped by the repository
+func (p Page) Limit(defaultSize, maxSize int) int {
+	if p.Size <= 0 {
+		return defaultSize
+	}
+	if p.Size > maxSize {
+		return maxSize
+	}
+	return p.Size
+}
+
+// Trim cuts rows fetched with limit+1 back to limit. If there was a further row, it
+// returns the key of the last row kept, the position the next page starts after.
+func Trim[T any](rows []T, limit int, key func(T) Key) ([]T, Key) {
+	if len(rows) <= limit {
+		return rows, nil
+	}
+	rows = rows[:limit]
+	return rows, key(rows[limit-1])
+}
+
+// TrimKeys is Trim for rows whose keys were selected along with them, keys[i] being
+// the key of rows[i]
+func TrimKeys[T any](rows []T, keys []Key, limit int) ([]T, Key) {
+	if len(rows) <= limit {
+		return rows, nil
+	}
+	return rows[:limit], keys[limit-1]
+}
@@ -0,0 +1,123 @@
+package pagination
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestOrder_After(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    Order
+		key      Key
+		arg      int
+		wantCond string
+		wantArgs []any
+		wantErr  error
+	}{
+		{
+			name:     "first page",
+			order:    Order{Desc("created", "text"), Desc("uuid", "text")},
+			key:      nil,
+			arg:      3,
+			wantCond: "TRUE",
+		},
+		{
+			name:     "single direction",
+			order:    Order{Desc("created_at", "timestamptz"), Desc("id", "uuid")},
+			key:      Key{"2026-01-01T00:00:00Z", "uuid-1"},
+			arg:      3,
+			wantCond: "(created_at, id) < ($3::timestamptz, $4::uuid)",
+			wantArgs: []any{"2026-01-01T00:00:00Z", "uuid-1"},
+		},
+		{
+			name:     "mixed directions",
+			order:    Order{Desc("date", "text"), Desc("hour", "text"), Asc("name", "text")},
+			key:      Key{"2026-01-01", "09", "a.jpg"},
+			arg:      2,
+			wantCond: "((date < $2::text) OR (date = $2::text AND hour < $3::text) OR (date = $2::text AND hour = $3::text AND name > $4::text))",
+			wantArgs: []any{"2026-01-01", "09", "a.jpg"},
+		},
+		{
+			name:    "key of another order",
+			order:   Order{Asc("id", "integer")},
+			key:     Key{"1", "2"},
+			arg:     2,
+			wantErr: ErrInvalidToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cond, args, err := tt.order.After(tt.key, tt.arg)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if cond != tt.wantCond {
+				t.Errorf("cond = %q, want %q", cond, tt.wantCond)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestOrder_OrderBy(t *testing.T) {
+	order := Order{Desc(`"DataDateTime"`, "timestamptz"), Asc(`"VehicleCD"`, "integer")}
+	if got, want := order.OrderBy(), `"DataDateTime" DESC, "VehicleCD"`; got != want {
+		t.Errorf("OrderBy = %q, want %q", got, want)
+	}
+}
+
+func TestPage_Limit(t *testing.T) {
+	tests := []struct {
+		size int
+		want int
+	}{
+		{0, 10},
+		{-1, 10},
+		{25, 25},
+		{100, 100},
+		{1000, 100},
+	}
+	for _, tt := range tests {
+		if got := (Page{Size: tt.size}).Limit(10, 100); got != tt.want {
+			t.Errorf("Limit(size %d) = %d, want %d", tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestTrim(t *testing.T) {
+	key := func(s string) Key { return Key{s} }
+
+	rows, next := Trim([]string{"a", "b"}, 2, key)
+	if len(rows) != 2 || next != nil {
+		t.Errorf("last page: rows = %v, next = %v, want 2 rows and no key", rows, next)
+	}
+
+	rows, next = Trim([]string{"a", "b", "c"}, 2, key)
+	if !reflect.DeepEqual(rows, []string{"a", "b"}) {
+		t.Errorf("rows = %v, want [a b]", rows)
+	}
+	if !reflect.DeepEqual(next, Key{"b"}) {
+		t.Errorf("next = %v, want [b]", next)
+	}
+}
+
+func TestTrimKeys(t *testing.T) {
+	keys := []Key{{"a"}, {"b"}, {"c"}}
+
+	rows, next := TrimKeys([]string{"a", "b", "c"}, keys, 3)
+	if len(rows) != 3 || next != nil {
+		t.Errorf("last page: rows = %v, next = %v, want 3 rows and no key", rows, next)
+	}
+
+	rows, next = TrimKeys([]string{"a", "b", "c"}, keys, 2)
+	if !reflect.DeepEqual(rows, []string{"a", "b"}) || !reflect.DeepEqual(next, Key{"b"}) {
+		t.Errorf("rows = %v, next = %v, want [a b] and [b]", rows, next)
+	}
+}
@@ -0,0 +1,55 @@
+package pagination
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTokens_Decode(t *testing.T) {
+	tokens := NewTokens([]byte("test-key"))
+	key := Key{"2026-01-01T00:00:00Z", "uuid-1"}
+
+	token := tokens.Encode(key, "ListFiles", "org-1")
+	got, err := tokens.Decode(token, "ListFiles", "org-1")
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !reflect.DeepEqual(got, key) {
+		t.Errorf("key = %v, want %v", got, key)
+	}
+
+	tests := []struct {
+		name   string
+		tokens *Tokens
+		token  string
+		scope  []string
+	}{
+		{"other organization", tokens, token, []string{"ListFiles", "org-2"}},
+		{"other method", tokens, token, []string{"ListKudgivts", "org-1"}},
+		{"shifted scope", tokens, token, []string{"ListFiles", "org", "-1"}},
+		{"tampered key", tokens, "W10" + token[3:], []string{"ListFiles", "org-1"}},
+		{"tampered signature", tokens, token + "x", []string{"ListFiles", "org-1"}},
+		{"no signature", tokens, "WyIxIl0", []string{"ListFiles", "org-1"}},
+		{"other secret", NewTokens([]byte("other-key")), token, []string{"ListFiles", "org-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.tokens.Decode(tt.token, tt.scope...); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("err = %v, want %v", err, ErrInvalidToken)
+			}
+		})
+	}
+}
+
+func TestTokens_FirstAndLastPage(t *testing.T) {
+	tokens := NewTokens([]byte("test-key"))
+
+	if token := tokens.Encode(nil, "ListFiles"); token != "" {
+		t.Errorf("Encode(nil) = %q, want empty", token)
+	}
+	key, err := tokens.Decode("", "ListFiles")
+	if err != nil || key != nil {
+		t.Errorf("Decode(\"\") = %v, %v, want nil key", key, err)
+	}
+}
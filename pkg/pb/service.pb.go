@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -31,6 +32,7 @@ type Organization struct {
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"` // Soft delete
+	Etag          string                 `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`                                  // Row version, see UpdateOrganizationRequest.etag
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -107,6 +109,13 @@ func (x *Organization) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Organization) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 // Request/Response messages
 type CreateOrganizationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -289,6 +298,7 @@ type UpdateOrganizationRequest struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Etag          string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the organization as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -344,6 +354,13 @@ func (x *UpdateOrganizationRequest) GetSlug() string {
 	return ""
 }
 
+func (x *UpdateOrganizationRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
@@ -391,6 +408,7 @@ func (x *UpdateOrganizationResponse) GetOrganization() *Organization {
 type DeleteOrganizationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the organization as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -432,6 +450,13 @@ func (x *DeleteOrganizationRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteOrganizationRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -590,6 +615,7 @@ type AppUser struct {
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	Etag          string                 `protobuf:"bytes,9,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateAppUserRequest.etag
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -680,6 +706,13 @@ func (x *AppUser) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *AppUser) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateAppUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         *string                `protobuf:"bytes,1,opt,name=email,proto3,oneof" json:"email,omitempty"` // nullable
@@ -974,6 +1007,7 @@ type UpdateAppUserRequest struct {
 	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 	AvatarUrl     *string                `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
 	IsSuperadmin  bool                   `protobuf:"varint,4,opt,name=is_superadmin,json=isSuperadmin,proto3" json:"is_superadmin,omitempty"`
+	Etag          string                 `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the user as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1036,6 +1070,13 @@ func (x *UpdateAppUserRequest) GetIsSuperadmin() bool {
 	return false
 }
 
+func (x *UpdateAppUserRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateAppUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AppUser       *AppUser               `protobuf:"bytes,1,opt,name=app_user,json=appUser,proto3" json:"app_user,omitempty"`
@@ -1083,6 +1124,7 @@ func (x *UpdateAppUserResponse) GetAppUser() *AppUser {
 type DeleteAppUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the user as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1124,6 +1166,13 @@ func (x *DeleteAppUserRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteAppUserRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteAppUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1281,6 +1330,7 @@ type UserOrganization struct {
 	IsDefault      bool                   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Etag           string                 `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateUserOrganizationRequest.etag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1364,6 +1414,13 @@ func (x *UserOrganization) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserOrganization) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateUserOrganizationRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1569,6 +1626,7 @@ type UpdateUserOrganizationRequest struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 	IsDefault     bool                   `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Etag          string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the membership as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1624,6 +1682,13 @@ func (x *UpdateUserOrganizationRequest) GetIsDefault() bool {
 	return false
 }
 
+func (x *UpdateUserOrganizationRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateUserOrganizationResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	UserOrganization *UserOrganization      `protobuf:"bytes,1,opt,name=user_organization,json=userOrganization,proto3" json:"user_organization,omitempty"`
@@ -1671,6 +1736,7 @@ func (x *UpdateUserOrganizationResponse) GetUserOrganization() *UserOrganization
 type DeleteUserOrganizationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the membership as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1712,6 +1778,13 @@ func (x *DeleteUserOrganizationRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteUserOrganizationRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteUserOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -2045,6 +2118,7 @@ type File struct {
 	Deleted        string                 `protobuf:"bytes,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	Type           string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
 	Blob           *string                `protobuf:"bytes,7,opt,name=blob,proto3,oneof" json:"blob,omitempty"`
+	Etag           string                 `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateFileRequest.etag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -2128,6 +2202,13 @@ func (x *File) GetBlob() string {
 	return ""
 }
 
+func (x *File) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateFileRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -2342,6 +2423,7 @@ type UpdateFileRequest struct {
 	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
 	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
 	Blob          *string                `protobuf:"bytes,4,opt,name=blob,proto3,oneof" json:"blob,omitempty"`
+	Etag          string                 `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2404,6 +2486,13 @@ func (x *UpdateFileRequest) GetBlob() string {
 	return ""
 }
 
+func (x *UpdateFileRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	File          *File                  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
@@ -2452,6 +2541,7 @@ type DeleteFileRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Uuid             string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	DeletedTimestamp string                 `protobuf:"bytes,2,opt,name=deleted_timestamp,json=deletedTimestamp,proto3" json:"deleted_timestamp,omitempty"`
+	Etag             string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -2500,6 +2590,13 @@ func (x *DeleteFileRequest) GetDeletedTimestamp() string {
 	return ""
 }
 
+func (x *DeleteFileRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -2766,6 +2863,7 @@ type FlickrPhoto struct {
 	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	Secret         string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
 	Server         string                 `protobuf:"bytes,4,opt,name=server,proto3" json:"server,omitempty"`
+	Etag           string                 `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateFlickrPhotoRequest.etag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -2828,6 +2926,13 @@ func (x *FlickrPhoto) GetServer() string {
 	return ""
 }
 
+func (x *FlickrPhoto) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateFlickrPhotoRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3033,6 +3138,7 @@ type UpdateFlickrPhotoRequest struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
 	Server        string                 `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
+	Etag          string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3088,6 +3194,13 @@ func (x *UpdateFlickrPhotoRequest) GetServer() string {
 	return ""
 }
 
+func (x *UpdateFlickrPhotoRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateFlickrPhotoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FlickrPhoto   *FlickrPhoto           `protobuf:"bytes,1,opt,name=flickr_photo,json=flickrPhoto,proto3" json:"flickr_photo,omitempty"`
@@ -3135,6 +3248,7 @@ func (x *UpdateFlickrPhotoResponse) GetFlickrPhoto() *FlickrPhoto {
 type DeleteFlickrPhotoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3176,6 +3290,13 @@ func (x *DeleteFlickrPhotoRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteFlickrPhotoRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteFlickrPhotoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -3445,6 +3566,7 @@ type CamFile struct {
 	Type           string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
 	Cam            string                 `protobuf:"bytes,6,opt,name=cam,proto3" json:"cam,omitempty"`
 	FlickrId       *string                `protobuf:"bytes,7,opt,name=flickr_id,json=flickrId,proto3,oneof" json:"flickr_id,omitempty"`
+	Etag           string                 `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateCamFileRequest.etag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -3528,6 +3650,13 @@ func (x *CamFile) GetFlickrId() string {
 	return ""
 }
 
+func (x *CamFile) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateCamFileRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -3769,6 +3898,7 @@ type UpdateCamFileRequest struct {
 	Type           string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
 	Cam            string                 `protobuf:"bytes,6,opt,name=cam,proto3" json:"cam,omitempty"`
 	FlickrId       *string                `protobuf:"bytes,7,opt,name=flickr_id,json=flickrId,proto3,oneof" json:"flickr_id,omitempty"`
+	Etag           string                 `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -3852,6 +3982,13 @@ func (x *UpdateCamFileRequest) GetFlickrId() string {
 	return ""
 }
 
+func (x *UpdateCamFileRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateCamFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CamFile       *CamFile               `protobuf:"bytes,1,opt,name=cam_file,json=camFile,proto3" json:"cam_file,omitempty"`
@@ -3900,6 +4037,7 @@ type DeleteCamFileRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Etag           string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -3948,6 +4086,13 @@ func (x *DeleteCamFileRequest) GetOrganizationId() string {
 	return ""
 }
 
+func (x *DeleteCamFileRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteCamFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -4101,8 +4246,12 @@ type ListCamFilesByOrganizationRequest struct {
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCamFilesByOrganizationRequest) Reset() {
@@ -4156,6 +4305,20 @@ func (x *ListCamFilesByOrganizationRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListCamFilesByOrganizationRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListCamFilesByOrganizationRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListCamFilesByOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CamFiles      []*CamFile             `protobuf:"bytes,1,rep,name=cam_files,json=camFiles,proto3" json:"cam_files,omitempty"`
@@ -4214,6 +4377,7 @@ type CamFileExe struct {
 	Cam            string                 `protobuf:"bytes,2,opt,name=cam,proto3" json:"cam,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	Stage          int32                  `protobuf:"varint,4,opt,name=stage,proto3" json:"stage,omitempty"`
+	Etag           string                 `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateCamFileExeRequest.etag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -4276,6 +4440,13 @@ func (x *CamFileExe) GetStage() int32 {
 	return 0
 }
 
+func (x *CamFileExe) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateCamFileExeRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -4498,6 +4669,7 @@ type UpdateCamFileExeRequest struct {
 	Cam            string                 `protobuf:"bytes,2,opt,name=cam,proto3" json:"cam,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	Stage          int32                  `protobuf:"varint,4,opt,name=stage,proto3" json:"stage,omitempty"`
+	Etag           string                 `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -4560,6 +4732,13 @@ func (x *UpdateCamFileExeRequest) GetStage() int32 {
 	return 0
 }
 
+func (x *UpdateCamFileExeRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateCamFileExeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CamFileExe    *CamFileExe            `protobuf:"bytes,1,opt,name=cam_file_exe,json=camFileExe,proto3" json:"cam_file_exe,omitempty"`
@@ -4609,6 +4788,7 @@ type DeleteCamFileExeRequest struct {
 	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Cam            string                 `protobuf:"bytes,2,opt,name=cam,proto3" json:"cam,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Etag           string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -4664,6 +4844,13 @@ func (x *DeleteCamFileExeRequest) GetOrganizationId() string {
 	return ""
 }
 
+func (x *DeleteCamFileExeRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteCamFileExeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -4929,6 +5116,7 @@ type CamFileExeStage struct {
 	Stage          int32                  `protobuf:"varint,1,opt,name=stage,proto3" json:"stage,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Etag           string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateCamFileExeStageRequest.etag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -4984,6 +5172,13 @@ func (x *CamFileExeStage) GetName() string {
 	return ""
 }
 
+func (x *CamFileExeStage) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateCamFileExeStageRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Stage          int32                  `protobuf:"varint,1,opt,name=stage,proto3" json:"stage,omitempty"`
@@ -5189,6 +5384,7 @@ type UpdateCamFileExeStageRequest struct {
 	Stage          int32                  `protobuf:"varint,1,opt,name=stage,proto3" json:"stage,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Etag           string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -5244,6 +5440,13 @@ func (x *UpdateCamFileExeStageRequest) GetName() string {
 	return ""
 }
 
+func (x *UpdateCamFileExeStageRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateCamFileExeStageResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	CamFileExeStage *CamFileExeStage       `protobuf:"bytes,1,opt,name=cam_file_exe_stage,json=camFileExeStage,proto3" json:"cam_file_exe_stage,omitempty"`
@@ -5292,6 +5495,7 @@ type DeleteCamFileExeStageRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Stage          int32                  `protobuf:"varint,1,opt,name=stage,proto3" json:"stage,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Etag           string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -5340,6 +5544,13 @@ func (x *DeleteCamFileExeStageRequest) GetOrganizationId() string {
 	return ""
 }
 
+func (x *DeleteCamFileExeStageRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteCamFileExeStageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -5614,6 +5825,7 @@ type IchibanCar struct {
 	ScrapDate      *string                `protobuf:"bytes,10,opt,name=scrap_date,json=scrapDate,proto3,oneof" json:"scrap_date,omitempty"`
 	BumonCodeId    *string                `protobuf:"bytes,11,opt,name=bumon_code_id,json=bumonCodeId,proto3,oneof" json:"bumon_code_id,omitempty"`
 	DriverId       *string                `protobuf:"bytes,12,opt,name=driver_id,json=driverId,proto3,oneof" json:"driver_id,omitempty"`
+	Etag           string                 `protobuf:"bytes,13,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateIchibanCarRequest.etag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -5732,6 +5944,13 @@ func (x *IchibanCar) GetDriverId() string {
 	return ""
 }
 
+func (x *IchibanCar) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateIchibanCarRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -6010,6 +6229,7 @@ type UpdateIchibanCarRequest struct {
 	ScrapDate      *string                `protobuf:"bytes,10,opt,name=scrap_date,json=scrapDate,proto3,oneof" json:"scrap_date,omitempty"`
 	BumonCodeId    *string                `protobuf:"bytes,11,opt,name=bumon_code_id,json=bumonCodeId,proto3,oneof" json:"bumon_code_id,omitempty"`
 	DriverId       *string                `protobuf:"bytes,12,opt,name=driver_id,json=driverId,proto3,oneof" json:"driver_id,omitempty"`
+	Etag           string                 `protobuf:"bytes,13,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -6128,6 +6348,13 @@ func (x *UpdateIchibanCarRequest) GetDriverId() string {
 	return ""
 }
 
+func (x *UpdateIchibanCarRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateIchibanCarResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IchibanCar    *IchibanCar            `protobuf:"bytes,1,opt,name=ichiban_car,json=ichibanCar,proto3" json:"ichiban_car,omitempty"`
@@ -6176,6 +6403,7 @@ type DeleteIchibanCarRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Etag           string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -6224,6 +6452,13 @@ func (x *DeleteIchibanCarRequest) GetOrganizationId() string {
 	return ""
 }
 
+func (x *DeleteIchibanCarRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteIchibanCarResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -6489,6 +6724,7 @@ type DtakoCarsIchibanCars struct {
 	IdDtako        string                 `protobuf:"bytes,1,opt,name=id_dtako,json=idDtako,proto3" json:"id_dtako,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	Id             *string                `protobuf:"bytes,3,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Etag           string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateDtakoCarsIchibanCarsRequest.etag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -6544,6 +6780,13 @@ func (x *DtakoCarsIchibanCars) GetId() string {
 	return ""
 }
 
+func (x *DtakoCarsIchibanCars) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateDtakoCarsIchibanCarsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	IdDtako        string                 `protobuf:"bytes,1,opt,name=id_dtako,json=idDtako,proto3" json:"id_dtako,omitempty"`
@@ -6749,6 +6992,7 @@ type UpdateDtakoCarsIchibanCarsRequest struct {
 	IdDtako        string                 `protobuf:"bytes,1,opt,name=id_dtako,json=idDtako,proto3" json:"id_dtako,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	Id             *string                `protobuf:"bytes,3,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Etag           string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -6804,6 +7048,13 @@ func (x *UpdateDtakoCarsIchibanCarsRequest) GetId() string {
 	return ""
 }
 
+func (x *UpdateDtakoCarsIchibanCarsRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateDtakoCarsIchibanCarsResponse struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	DtakoCarsIchibanCars *DtakoCarsIchibanCars  `protobuf:"bytes,1,opt,name=dtako_cars_ichiban_cars,json=dtakoCarsIchibanCars,proto3" json:"dtako_cars_ichiban_cars,omitempty"`
@@ -6852,6 +7103,7 @@ type DeleteDtakoCarsIchibanCarsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	IdDtako        string                 `protobuf:"bytes,1,opt,name=id_dtako,json=idDtako,proto3" json:"id_dtako,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Etag           string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -6900,6 +7152,13 @@ func (x *DeleteDtakoCarsIchibanCarsRequest) GetOrganizationId() string {
 	return ""
 }
 
+func (x *DeleteDtakoCarsIchibanCarsRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteDtakoCarsIchibanCarsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -7169,6 +7428,7 @@ type Uriage struct {
 	Type           *int32                 `protobuf:"varint,5,opt,name=type,proto3,oneof" json:"type,omitempty"`
 	Cam            *int32                 `protobuf:"varint,6,opt,name=cam,proto3,oneof" json:"cam,omitempty"`
 	Date           string                 `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`
+	Etag           string                 `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateUriageRequest.etag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -7252,6 +7512,13 @@ func (x *Uriage) GetDate() string {
 	return ""
 }
 
+func (x *Uriage) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateUriageRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -7509,6 +7776,7 @@ type UpdateUriageRequest struct {
 	Kingaku        *int32                 `protobuf:"varint,5,opt,name=kingaku,proto3,oneof" json:"kingaku,omitempty"`
 	Type           *int32                 `protobuf:"varint,6,opt,name=type,proto3,oneof" json:"type,omitempty"`
 	Cam            *int32                 `protobuf:"varint,7,opt,name=cam,proto3,oneof" json:"cam,omitempty"`
+	Etag           string                 `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -7592,6 +7860,13 @@ func (x *UpdateUriageRequest) GetCam() int32 {
 	return 0
 }
 
+func (x *UpdateUriageRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateUriageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uriage        *Uriage                `protobuf:"bytes,1,opt,name=uriage,proto3" json:"uriage,omitempty"`
@@ -7642,6 +7917,7 @@ type DeleteUriageRequest struct {
 	Bumon          string                 `protobuf:"bytes,2,opt,name=bumon,proto3" json:"bumon,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	Date           string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Etag           string                 `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -7704,6 +7980,13 @@ func (x *DeleteUriageRequest) GetDate() string {
 	return ""
 }
 
+func (x *DeleteUriageRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteUriageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -7749,9 +8032,13 @@ func (x *DeleteUriageResponse) GetSuccess() bool {
 }
 
 type ListUriagesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -7800,6 +8087,20 @@ func (x *ListUriagesRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListUriagesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListUriagesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListUriagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uriages       []*Uriage              `protobuf:"bytes,1,rep,name=uriages,proto3" json:"uriages,omitempty"`
@@ -7857,8 +8158,12 @@ type ListUriagesByOrganizationRequest struct {
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUriagesByOrganizationRequest) Reset() {
@@ -7912,6 +8217,20 @@ func (x *ListUriagesByOrganizationRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListUriagesByOrganizationRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListUriagesByOrganizationRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListUriagesByOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uriages       []*Uriage              `protobuf:"bytes,1,rep,name=uriages,proto3" json:"uriages,omitempty"`
@@ -7971,6 +8290,7 @@ type UriageJisha struct {
 	Kingaku        *int32                 `protobuf:"varint,3,opt,name=kingaku,proto3,oneof" json:"kingaku,omitempty"`
 	Type           *int32                 `protobuf:"varint,4,opt,name=type,proto3,oneof" json:"type,omitempty"`
 	Date           string                 `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Etag           string                 `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateUriageJishaRequest.etag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -8040,6 +8360,13 @@ func (x *UriageJisha) GetDate() string {
 	return ""
 }
 
+func (x *UriageJisha) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateUriageJishaRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Bumon          string                 `protobuf:"bytes,1,opt,name=bumon,proto3" json:"bumon,omitempty"`
@@ -8271,6 +8598,7 @@ type UpdateUriageJishaRequest struct {
 	Date           string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
 	Kingaku        *int32                 `protobuf:"varint,4,opt,name=kingaku,proto3,oneof" json:"kingaku,omitempty"`
 	Type           *int32                 `protobuf:"varint,5,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Etag           string                 `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -8340,6 +8668,13 @@ func (x *UpdateUriageJishaRequest) GetType() int32 {
 	return 0
 }
 
+func (x *UpdateUriageJishaRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateUriageJishaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UriageJisha   *UriageJisha           `protobuf:"bytes,1,opt,name=uriage_jisha,json=uriageJisha,proto3" json:"uriage_jisha,omitempty"`
@@ -8389,6 +8724,7 @@ type DeleteUriageJishaRequest struct {
 	Bumon          string                 `protobuf:"bytes,1,opt,name=bumon,proto3" json:"bumon,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	Date           string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Etag           string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -8444,6 +8780,13 @@ func (x *DeleteUriageJishaRequest) GetDate() string {
 	return ""
 }
 
+func (x *DeleteUriageJishaRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteUriageJishaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -8804,6 +9147,7 @@ type CarInspection struct {
 	RegistCarLightCar                                  string                 `protobuf:"bytes,96,opt,name=regist_car_light_car,json=registCarLightCar,proto3" json:"regist_car_light_car,omitempty"`
 	Created                                            string                 `protobuf:"bytes,97,opt,name=created,proto3" json:"created,omitempty"`
 	Modified                                           string                 `protobuf:"bytes,98,opt,name=modified,proto3" json:"modified,omitempty"`
+	Etag                                               string                 `protobuf:"bytes,99,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateCarInspectionRequest.etag
 	unknownFields                                      protoimpl.UnknownFields
 	sizeCache                                          protoimpl.SizeCache
 }
@@ -9524,6 +9868,13 @@ func (x *CarInspection) GetModified() string {
 	return ""
 }
 
+func (x *CarInspection) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateCarInspectionRequest struct {
 	state                                              protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId                                     string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -9624,8 +9975,10 @@ type CreateCarInspectionRequest struct {
 	RegistCarLightCar                                  string                 `protobuf:"bytes,96,opt,name=regist_car_light_car,json=registCarLightCar,proto3" json:"regist_car_light_car,omitempty"`
 	Created                                            string                 `protobuf:"bytes,97,opt,name=created,proto3" json:"created,omitempty"`
 	Modified                                           string                 `protobuf:"bytes,98,opt,name=modified,proto3" json:"modified,omitempty"`
-	unknownFields                                      protoimpl.UnknownFields
-	sizeCache                                          protoimpl.SizeCache
+	// Files created together with the inspection in one transaction
+	Files         []*CarInspectionFileInput `protobuf:"bytes,99,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateCarInspectionRequest) Reset() {
@@ -10344,16 +10697,86 @@ func (x *CreateCarInspectionRequest) GetModified() string {
 	return ""
 }
 
-type CreateCarInspectionResponse struct {
+func (x *CreateCarInspectionRequest) GetFiles() []*CarInspectionFileInput {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// CarInspectionFileInput is a car_inspection_files row created with its inspection;
+// the organization and certificate keys are taken from the inspection
+type CarInspectionFileInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CarInspection *CarInspection         `protobuf:"bytes,1,opt,name=car_inspection,json=carInspection,proto3" json:"car_inspection,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Created       string                 `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+	Modified      string                 `protobuf:"bytes,3,opt,name=modified,proto3" json:"modified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *CarInspectionFileInput) Reset() {
+	*x = CarInspectionFileInput{}
+	mi := &file_service_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CarInspectionFileInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarInspectionFileInput) ProtoMessage() {}
+
+func (x *CarInspectionFileInput) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarInspectionFileInput.ProtoReflect.Descriptor instead.
+func (*CarInspectionFileInput) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *CarInspectionFileInput) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CarInspectionFileInput) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *CarInspectionFileInput) GetModified() string {
+	if x != nil {
+		return x.Modified
+	}
+	return ""
+}
+
+type CreateCarInspectionResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CarInspection      *CarInspection         `protobuf:"bytes,1,opt,name=car_inspection,json=carInspection,proto3" json:"car_inspection,omitempty"`
+	CarInspectionFiles []*CarInspectionFile   `protobuf:"bytes,2,rep,name=car_inspection_files,json=carInspectionFiles,proto3" json:"car_inspection_files,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
 func (x *CreateCarInspectionResponse) Reset() {
 	*x = CreateCarInspectionResponse{}
-	mi := &file_service_proto_msgTypes[158]
+	mi := &file_service_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10365,7 +10788,7 @@ func (x *CreateCarInspectionResponse) String() string {
 func (*CreateCarInspectionResponse) ProtoMessage() {}
 
 func (x *CreateCarInspectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[158]
+	mi := &file_service_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10378,7 +10801,7 @@ func (x *CreateCarInspectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCarInspectionResponse.ProtoReflect.Descriptor instead.
 func (*CreateCarInspectionResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{158}
+	return file_service_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *CreateCarInspectionResponse) GetCarInspection() *CarInspection {
@@ -10388,6 +10811,13 @@ func (x *CreateCarInspectionResponse) GetCarInspection() *CarInspection {
 	return nil
 }
 
+func (x *CreateCarInspectionResponse) GetCarInspectionFiles() []*CarInspectionFile {
+	if x != nil {
+		return x.CarInspectionFiles
+	}
+	return nil
+}
+
 type GetCarInspectionRequest struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId        string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -10402,7 +10832,7 @@ type GetCarInspectionRequest struct {
 
 func (x *GetCarInspectionRequest) Reset() {
 	*x = GetCarInspectionRequest{}
-	mi := &file_service_proto_msgTypes[159]
+	mi := &file_service_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10414,7 +10844,7 @@ func (x *GetCarInspectionRequest) String() string {
 func (*GetCarInspectionRequest) ProtoMessage() {}
 
 func (x *GetCarInspectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[159]
+	mi := &file_service_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10427,7 +10857,7 @@ func (x *GetCarInspectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCarInspectionRequest.ProtoReflect.Descriptor instead.
 func (*GetCarInspectionRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{159}
+	return file_service_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *GetCarInspectionRequest) GetOrganizationId() string {
@@ -10481,7 +10911,7 @@ type GetCarInspectionResponse struct {
 
 func (x *GetCarInspectionResponse) Reset() {
 	*x = GetCarInspectionResponse{}
-	mi := &file_service_proto_msgTypes[160]
+	mi := &file_service_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10493,7 +10923,7 @@ func (x *GetCarInspectionResponse) String() string {
 func (*GetCarInspectionResponse) ProtoMessage() {}
 
 func (x *GetCarInspectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[160]
+	mi := &file_service_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10506,7 +10936,7 @@ func (x *GetCarInspectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCarInspectionResponse.ProtoReflect.Descriptor instead.
 func (*GetCarInspectionResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{160}
+	return file_service_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *GetCarInspectionResponse) GetCarInspection() *CarInspection {
@@ -10616,13 +11046,16 @@ type UpdateCarInspectionRequest struct {
 	TwodimensionCodeInfoFuelClassCode                  string `protobuf:"bytes,95,opt,name=twodimension_code_info_fuel_class_code,json=twodimensionCodeInfoFuelClassCode,proto3" json:"twodimension_code_info_fuel_class_code,omitempty"`
 	RegistCarLightCar                                  string `protobuf:"bytes,96,opt,name=regist_car_light_car,json=registCarLightCar,proto3" json:"regist_car_light_car,omitempty"`
 	Modified                                           string `protobuf:"bytes,97,opt,name=modified,proto3" json:"modified,omitempty"`
-	unknownFields                                      protoimpl.UnknownFields
-	sizeCache                                          protoimpl.SizeCache
+	// Fields to write, e.g. "car_no,valid_period_expir_date_y"; all fields when empty
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,98,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Etag          string                 `protobuf:"bytes,99,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateCarInspectionRequest) Reset() {
 	*x = UpdateCarInspectionRequest{}
-	mi := &file_service_proto_msgTypes[161]
+	mi := &file_service_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10634,7 +11067,7 @@ func (x *UpdateCarInspectionRequest) String() string {
 func (*UpdateCarInspectionRequest) ProtoMessage() {}
 
 func (x *UpdateCarInspectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[161]
+	mi := &file_service_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10647,7 +11080,7 @@ func (x *UpdateCarInspectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCarInspectionRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCarInspectionRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{161}
+	return file_service_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *UpdateCarInspectionRequest) GetOrganizationId() string {
@@ -11329,6 +11762,20 @@ func (x *UpdateCarInspectionRequest) GetModified() string {
 	return ""
 }
 
+func (x *UpdateCarInspectionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateCarInspectionRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateCarInspectionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CarInspection *CarInspection         `protobuf:"bytes,1,opt,name=car_inspection,json=carInspection,proto3" json:"car_inspection,omitempty"`
@@ -11338,7 +11785,7 @@ type UpdateCarInspectionResponse struct {
 
 func (x *UpdateCarInspectionResponse) Reset() {
 	*x = UpdateCarInspectionResponse{}
-	mi := &file_service_proto_msgTypes[162]
+	mi := &file_service_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11350,7 +11797,7 @@ func (x *UpdateCarInspectionResponse) String() string {
 func (*UpdateCarInspectionResponse) ProtoMessage() {}
 
 func (x *UpdateCarInspectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[162]
+	mi := &file_service_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11363,7 +11810,7 @@ func (x *UpdateCarInspectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCarInspectionResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCarInspectionResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{162}
+	return file_service_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *UpdateCarInspectionResponse) GetCarInspection() *CarInspection {
@@ -11381,13 +11828,14 @@ type DeleteCarInspectionRequest struct {
 	ElectCertPublishdateY string                 `protobuf:"bytes,4,opt,name=elect_cert_publishdate_y,json=electCertPublishdateY,proto3" json:"elect_cert_publishdate_y,omitempty"`
 	ElectCertPublishdateM string                 `protobuf:"bytes,5,opt,name=elect_cert_publishdate_m,json=electCertPublishdateM,proto3" json:"elect_cert_publishdate_m,omitempty"`
 	ElectCertPublishdateD string                 `protobuf:"bytes,6,opt,name=elect_cert_publishdate_d,json=electCertPublishdateD,proto3" json:"elect_cert_publishdate_d,omitempty"`
+	Etag                  string                 `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *DeleteCarInspectionRequest) Reset() {
 	*x = DeleteCarInspectionRequest{}
-	mi := &file_service_proto_msgTypes[163]
+	mi := &file_service_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11399,7 +11847,7 @@ func (x *DeleteCarInspectionRequest) String() string {
 func (*DeleteCarInspectionRequest) ProtoMessage() {}
 
 func (x *DeleteCarInspectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[163]
+	mi := &file_service_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11412,7 +11860,7 @@ func (x *DeleteCarInspectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCarInspectionRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCarInspectionRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{163}
+	return file_service_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *DeleteCarInspectionRequest) GetOrganizationId() string {
@@ -11457,16 +11905,25 @@ func (x *DeleteCarInspectionRequest) GetElectCertPublishdateD() string {
 	return ""
 }
 
+func (x *DeleteCarInspectionRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteCarInspectionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Number of car_inspection_files rows soft-deleted with the inspection
+	DeletedFiles  int32 `protobuf:"varint,2,opt,name=deleted_files,json=deletedFiles,proto3" json:"deleted_files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteCarInspectionResponse) Reset() {
 	*x = DeleteCarInspectionResponse{}
-	mi := &file_service_proto_msgTypes[164]
+	mi := &file_service_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11478,7 +11935,7 @@ func (x *DeleteCarInspectionResponse) String() string {
 func (*DeleteCarInspectionResponse) ProtoMessage() {}
 
 func (x *DeleteCarInspectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[164]
+	mi := &file_service_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11491,7 +11948,7 @@ func (x *DeleteCarInspectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCarInspectionResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCarInspectionResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{164}
+	return file_service_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *DeleteCarInspectionResponse) GetSuccess() bool {
@@ -11501,17 +11958,28 @@ func (x *DeleteCarInspectionResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *DeleteCarInspectionResponse) GetDeletedFiles() int32 {
+	if x != nil {
+		return x.DeletedFiles
+	}
+	return 0
+}
+
 type ListCarInspectionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCarInspectionsRequest) Reset() {
 	*x = ListCarInspectionsRequest{}
-	mi := &file_service_proto_msgTypes[165]
+	mi := &file_service_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11523,7 +11991,7 @@ func (x *ListCarInspectionsRequest) String() string {
 func (*ListCarInspectionsRequest) ProtoMessage() {}
 
 func (x *ListCarInspectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[165]
+	mi := &file_service_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11536,7 +12004,7 @@ func (x *ListCarInspectionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCarInspectionsRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{165}
+	return file_service_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *ListCarInspectionsRequest) GetPageSize() int32 {
@@ -11553,6 +12021,20 @@ func (x *ListCarInspectionsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListCarInspectionsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListCarInspectionsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListCarInspectionsResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	CarInspections []*CarInspection       `protobuf:"bytes,1,rep,name=car_inspections,json=carInspections,proto3" json:"car_inspections,omitempty"`
@@ -11563,7 +12045,7 @@ type ListCarInspectionsResponse struct {
 
 func (x *ListCarInspectionsResponse) Reset() {
 	*x = ListCarInspectionsResponse{}
-	mi := &file_service_proto_msgTypes[166]
+	mi := &file_service_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11575,7 +12057,7 @@ func (x *ListCarInspectionsResponse) String() string {
 func (*ListCarInspectionsResponse) ProtoMessage() {}
 
 func (x *ListCarInspectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[166]
+	mi := &file_service_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11588,7 +12070,7 @@ func (x *ListCarInspectionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCarInspectionsResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{166}
+	return file_service_proto_rawDescGZIP(), []int{167}
 }
 
 func (x *ListCarInspectionsResponse) GetCarInspections() []*CarInspection {
@@ -11610,13 +12092,17 @@ type ListCarInspectionsByOrganizationRequest struct {
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCarInspectionsByOrganizationRequest) Reset() {
 	*x = ListCarInspectionsByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[167]
+	mi := &file_service_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11628,7 +12114,7 @@ func (x *ListCarInspectionsByOrganizationRequest) String() string {
 func (*ListCarInspectionsByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListCarInspectionsByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[167]
+	mi := &file_service_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11641,7 +12127,7 @@ func (x *ListCarInspectionsByOrganizationRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use ListCarInspectionsByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionsByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{167}
+	return file_service_proto_rawDescGZIP(), []int{168}
 }
 
 func (x *ListCarInspectionsByOrganizationRequest) GetOrganizationId() string {
@@ -11665,6 +12151,20 @@ func (x *ListCarInspectionsByOrganizationRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListCarInspectionsByOrganizationRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListCarInspectionsByOrganizationRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListCarInspectionsByOrganizationResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	CarInspections []*CarInspection       `protobuf:"bytes,1,rep,name=car_inspections,json=carInspections,proto3" json:"car_inspections,omitempty"`
@@ -11675,7 +12175,7 @@ type ListCarInspectionsByOrganizationResponse struct {
 
 func (x *ListCarInspectionsByOrganizationResponse) Reset() {
 	*x = ListCarInspectionsByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[168]
+	mi := &file_service_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11687,7 +12187,7 @@ func (x *ListCarInspectionsByOrganizationResponse) String() string {
 func (*ListCarInspectionsByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListCarInspectionsByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[168]
+	mi := &file_service_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11700,7 +12200,7 @@ func (x *ListCarInspectionsByOrganizationResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use ListCarInspectionsByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionsByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{168}
+	return file_service_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *ListCarInspectionsByOrganizationResponse) GetCarInspections() []*CarInspection {
@@ -11730,13 +12230,14 @@ type CarInspectionFile struct {
 	Created               string                 `protobuf:"bytes,9,opt,name=created,proto3" json:"created,omitempty"`
 	Modified              string                 `protobuf:"bytes,10,opt,name=modified,proto3" json:"modified,omitempty"`
 	Deleted               *string                `protobuf:"bytes,11,opt,name=deleted,proto3,oneof" json:"deleted,omitempty"`
+	Etag                  string                 `protobuf:"bytes,12,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateCarInspectionFileRequest.etag
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *CarInspectionFile) Reset() {
 	*x = CarInspectionFile{}
-	mi := &file_service_proto_msgTypes[169]
+	mi := &file_service_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11748,7 +12249,7 @@ func (x *CarInspectionFile) String() string {
 func (*CarInspectionFile) ProtoMessage() {}
 
 func (x *CarInspectionFile) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[169]
+	mi := &file_service_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11761,7 +12262,7 @@ func (x *CarInspectionFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CarInspectionFile.ProtoReflect.Descriptor instead.
 func (*CarInspectionFile) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{169}
+	return file_service_proto_rawDescGZIP(), []int{170}
 }
 
 func (x *CarInspectionFile) GetUuid() string {
@@ -11841,6 +12342,13 @@ func (x *CarInspectionFile) GetDeleted() string {
 	return ""
 }
 
+func (x *CarInspectionFile) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateCarInspectionFileRequest struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId        string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -11858,7 +12366,7 @@ type CreateCarInspectionFileRequest struct {
 
 func (x *CreateCarInspectionFileRequest) Reset() {
 	*x = CreateCarInspectionFileRequest{}
-	mi := &file_service_proto_msgTypes[170]
+	mi := &file_service_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11870,7 +12378,7 @@ func (x *CreateCarInspectionFileRequest) String() string {
 func (*CreateCarInspectionFileRequest) ProtoMessage() {}
 
 func (x *CreateCarInspectionFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[170]
+	mi := &file_service_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11883,7 +12391,7 @@ func (x *CreateCarInspectionFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCarInspectionFileRequest.ProtoReflect.Descriptor instead.
 func (*CreateCarInspectionFileRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{170}
+	return file_service_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *CreateCarInspectionFileRequest) GetOrganizationId() string {
@@ -11958,7 +12466,7 @@ type CreateCarInspectionFileResponse struct {
 
 func (x *CreateCarInspectionFileResponse) Reset() {
 	*x = CreateCarInspectionFileResponse{}
-	mi := &file_service_proto_msgTypes[171]
+	mi := &file_service_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11970,7 +12478,7 @@ func (x *CreateCarInspectionFileResponse) String() string {
 func (*CreateCarInspectionFileResponse) ProtoMessage() {}
 
 func (x *CreateCarInspectionFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[171]
+	mi := &file_service_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11983,7 +12491,7 @@ func (x *CreateCarInspectionFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCarInspectionFileResponse.ProtoReflect.Descriptor instead.
 func (*CreateCarInspectionFileResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{171}
+	return file_service_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *CreateCarInspectionFileResponse) GetCarInspectionFile() *CarInspectionFile {
@@ -12002,7 +12510,7 @@ type GetCarInspectionFileRequest struct {
 
 func (x *GetCarInspectionFileRequest) Reset() {
 	*x = GetCarInspectionFileRequest{}
-	mi := &file_service_proto_msgTypes[172]
+	mi := &file_service_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12014,7 +12522,7 @@ func (x *GetCarInspectionFileRequest) String() string {
 func (*GetCarInspectionFileRequest) ProtoMessage() {}
 
 func (x *GetCarInspectionFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[172]
+	mi := &file_service_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12027,7 +12535,7 @@ func (x *GetCarInspectionFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCarInspectionFileRequest.ProtoReflect.Descriptor instead.
 func (*GetCarInspectionFileRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{172}
+	return file_service_proto_rawDescGZIP(), []int{173}
 }
 
 func (x *GetCarInspectionFileRequest) GetUuid() string {
@@ -12046,7 +12554,7 @@ type GetCarInspectionFileResponse struct {
 
 func (x *GetCarInspectionFileResponse) Reset() {
 	*x = GetCarInspectionFileResponse{}
-	mi := &file_service_proto_msgTypes[173]
+	mi := &file_service_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12058,7 +12566,7 @@ func (x *GetCarInspectionFileResponse) String() string {
 func (*GetCarInspectionFileResponse) ProtoMessage() {}
 
 func (x *GetCarInspectionFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[173]
+	mi := &file_service_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12071,7 +12579,7 @@ func (x *GetCarInspectionFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCarInspectionFileResponse.ProtoReflect.Descriptor instead.
 func (*GetCarInspectionFileResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{173}
+	return file_service_proto_rawDescGZIP(), []int{174}
 }
 
 func (x *GetCarInspectionFileResponse) GetCarInspectionFile() *CarInspectionFile {
@@ -12086,13 +12594,14 @@ type UpdateCarInspectionFileRequest struct {
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	Modified      string                 `protobuf:"bytes,3,opt,name=modified,proto3" json:"modified,omitempty"`
+	Etag          string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateCarInspectionFileRequest) Reset() {
 	*x = UpdateCarInspectionFileRequest{}
-	mi := &file_service_proto_msgTypes[174]
+	mi := &file_service_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12104,7 +12613,7 @@ func (x *UpdateCarInspectionFileRequest) String() string {
 func (*UpdateCarInspectionFileRequest) ProtoMessage() {}
 
 func (x *UpdateCarInspectionFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[174]
+	mi := &file_service_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12117,7 +12626,7 @@ func (x *UpdateCarInspectionFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCarInspectionFileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCarInspectionFileRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{174}
+	return file_service_proto_rawDescGZIP(), []int{175}
 }
 
 func (x *UpdateCarInspectionFileRequest) GetUuid() string {
@@ -12141,6 +12650,13 @@ func (x *UpdateCarInspectionFileRequest) GetModified() string {
 	return ""
 }
 
+func (x *UpdateCarInspectionFileRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateCarInspectionFileResponse struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	CarInspectionFile *CarInspectionFile     `protobuf:"bytes,1,opt,name=car_inspection_file,json=carInspectionFile,proto3" json:"car_inspection_file,omitempty"`
@@ -12150,7 +12666,7 @@ type UpdateCarInspectionFileResponse struct {
 
 func (x *UpdateCarInspectionFileResponse) Reset() {
 	*x = UpdateCarInspectionFileResponse{}
-	mi := &file_service_proto_msgTypes[175]
+	mi := &file_service_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12162,7 +12678,7 @@ func (x *UpdateCarInspectionFileResponse) String() string {
 func (*UpdateCarInspectionFileResponse) ProtoMessage() {}
 
 func (x *UpdateCarInspectionFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[175]
+	mi := &file_service_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12175,7 +12691,7 @@ func (x *UpdateCarInspectionFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCarInspectionFileResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCarInspectionFileResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{175}
+	return file_service_proto_rawDescGZIP(), []int{176}
 }
 
 func (x *UpdateCarInspectionFileResponse) GetCarInspectionFile() *CarInspectionFile {
@@ -12188,13 +12704,14 @@ func (x *UpdateCarInspectionFileResponse) GetCarInspectionFile() *CarInspectionF
 type DeleteCarInspectionFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteCarInspectionFileRequest) Reset() {
 	*x = DeleteCarInspectionFileRequest{}
-	mi := &file_service_proto_msgTypes[176]
+	mi := &file_service_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12206,7 +12723,7 @@ func (x *DeleteCarInspectionFileRequest) String() string {
 func (*DeleteCarInspectionFileRequest) ProtoMessage() {}
 
 func (x *DeleteCarInspectionFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[176]
+	mi := &file_service_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12219,7 +12736,7 @@ func (x *DeleteCarInspectionFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCarInspectionFileRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCarInspectionFileRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{176}
+	return file_service_proto_rawDescGZIP(), []int{177}
 }
 
 func (x *DeleteCarInspectionFileRequest) GetUuid() string {
@@ -12229,6 +12746,13 @@ func (x *DeleteCarInspectionFileRequest) GetUuid() string {
 	return ""
 }
 
+func (x *DeleteCarInspectionFileRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteCarInspectionFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -12238,7 +12762,7 @@ type DeleteCarInspectionFileResponse struct {
 
 func (x *DeleteCarInspectionFileResponse) Reset() {
 	*x = DeleteCarInspectionFileResponse{}
-	mi := &file_service_proto_msgTypes[177]
+	mi := &file_service_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12250,7 +12774,7 @@ func (x *DeleteCarInspectionFileResponse) String() string {
 func (*DeleteCarInspectionFileResponse) ProtoMessage() {}
 
 func (x *DeleteCarInspectionFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[177]
+	mi := &file_service_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12263,7 +12787,7 @@ func (x *DeleteCarInspectionFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCarInspectionFileResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCarInspectionFileResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{177}
+	return file_service_proto_rawDescGZIP(), []int{178}
 }
 
 func (x *DeleteCarInspectionFileResponse) GetSuccess() bool {
@@ -12283,7 +12807,7 @@ type ListCarInspectionFilesRequest struct {
 
 func (x *ListCarInspectionFilesRequest) Reset() {
 	*x = ListCarInspectionFilesRequest{}
-	mi := &file_service_proto_msgTypes[178]
+	mi := &file_service_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12295,7 +12819,7 @@ func (x *ListCarInspectionFilesRequest) String() string {
 func (*ListCarInspectionFilesRequest) ProtoMessage() {}
 
 func (x *ListCarInspectionFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[178]
+	mi := &file_service_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12308,7 +12832,7 @@ func (x *ListCarInspectionFilesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCarInspectionFilesRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionFilesRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{178}
+	return file_service_proto_rawDescGZIP(), []int{179}
 }
 
 func (x *ListCarInspectionFilesRequest) GetPageSize() int32 {
@@ -12335,7 +12859,7 @@ type ListCarInspectionFilesResponse struct {
 
 func (x *ListCarInspectionFilesResponse) Reset() {
 	*x = ListCarInspectionFilesResponse{}
-	mi := &file_service_proto_msgTypes[179]
+	mi := &file_service_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12347,7 +12871,7 @@ func (x *ListCarInspectionFilesResponse) String() string {
 func (*ListCarInspectionFilesResponse) ProtoMessage() {}
 
 func (x *ListCarInspectionFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[179]
+	mi := &file_service_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12360,7 +12884,7 @@ func (x *ListCarInspectionFilesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCarInspectionFilesResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionFilesResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{179}
+	return file_service_proto_rawDescGZIP(), []int{180}
 }
 
 func (x *ListCarInspectionFilesResponse) GetCarInspectionFiles() []*CarInspectionFile {
@@ -12388,7 +12912,7 @@ type ListCarInspectionFilesByOrganizationRequest struct {
 
 func (x *ListCarInspectionFilesByOrganizationRequest) Reset() {
 	*x = ListCarInspectionFilesByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[180]
+	mi := &file_service_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12400,7 +12924,7 @@ func (x *ListCarInspectionFilesByOrganizationRequest) String() string {
 func (*ListCarInspectionFilesByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListCarInspectionFilesByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[180]
+	mi := &file_service_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12413,7 +12937,7 @@ func (x *ListCarInspectionFilesByOrganizationRequest) ProtoReflect() protoreflec
 
 // Deprecated: Use ListCarInspectionFilesByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionFilesByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{180}
+	return file_service_proto_rawDescGZIP(), []int{181}
 }
 
 func (x *ListCarInspectionFilesByOrganizationRequest) GetOrganizationId() string {
@@ -12447,7 +12971,7 @@ type ListCarInspectionFilesByOrganizationResponse struct {
 
 func (x *ListCarInspectionFilesByOrganizationResponse) Reset() {
 	*x = ListCarInspectionFilesByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[181]
+	mi := &file_service_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12459,7 +12983,7 @@ func (x *ListCarInspectionFilesByOrganizationResponse) String() string {
 func (*ListCarInspectionFilesByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListCarInspectionFilesByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[181]
+	mi := &file_service_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12472,7 +12996,7 @@ func (x *ListCarInspectionFilesByOrganizationResponse) ProtoReflect() protorefle
 
 // Deprecated: Use ListCarInspectionFilesByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionFilesByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{181}
+	return file_service_proto_rawDescGZIP(), []int{182}
 }
 
 func (x *ListCarInspectionFilesByOrganizationResponse) GetCarInspectionFiles() []*CarInspectionFile {
@@ -12502,13 +13026,14 @@ type CarInspectionFilesA struct {
 	Created        string                 `protobuf:"bytes,9,opt,name=created,proto3" json:"created,omitempty"`
 	Modified       string                 `protobuf:"bytes,10,opt,name=modified,proto3" json:"modified,omitempty"`
 	Deleted        *string                `protobuf:"bytes,11,opt,name=deleted,proto3,oneof" json:"deleted,omitempty"`
+	Etag           string                 `protobuf:"bytes,12,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateCarInspectionFilesARequest.etag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CarInspectionFilesA) Reset() {
 	*x = CarInspectionFilesA{}
-	mi := &file_service_proto_msgTypes[182]
+	mi := &file_service_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12520,7 +13045,7 @@ func (x *CarInspectionFilesA) String() string {
 func (*CarInspectionFilesA) ProtoMessage() {}
 
 func (x *CarInspectionFilesA) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[182]
+	mi := &file_service_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12533,7 +13058,7 @@ func (x *CarInspectionFilesA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CarInspectionFilesA.ProtoReflect.Descriptor instead.
 func (*CarInspectionFilesA) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{182}
+	return file_service_proto_rawDescGZIP(), []int{183}
 }
 
 func (x *CarInspectionFilesA) GetUuid() string {
@@ -12613,6 +13138,13 @@ func (x *CarInspectionFilesA) GetDeleted() string {
 	return ""
 }
 
+func (x *CarInspectionFilesA) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateCarInspectionFilesARequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -12630,7 +13162,7 @@ type CreateCarInspectionFilesARequest struct {
 
 func (x *CreateCarInspectionFilesARequest) Reset() {
 	*x = CreateCarInspectionFilesARequest{}
-	mi := &file_service_proto_msgTypes[183]
+	mi := &file_service_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12642,7 +13174,7 @@ func (x *CreateCarInspectionFilesARequest) String() string {
 func (*CreateCarInspectionFilesARequest) ProtoMessage() {}
 
 func (x *CreateCarInspectionFilesARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[183]
+	mi := &file_service_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12655,7 +13187,7 @@ func (x *CreateCarInspectionFilesARequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCarInspectionFilesARequest.ProtoReflect.Descriptor instead.
 func (*CreateCarInspectionFilesARequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{183}
+	return file_service_proto_rawDescGZIP(), []int{184}
 }
 
 func (x *CreateCarInspectionFilesARequest) GetOrganizationId() string {
@@ -12730,7 +13262,7 @@ type CreateCarInspectionFilesAResponse struct {
 
 func (x *CreateCarInspectionFilesAResponse) Reset() {
 	*x = CreateCarInspectionFilesAResponse{}
-	mi := &file_service_proto_msgTypes[184]
+	mi := &file_service_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12742,7 +13274,7 @@ func (x *CreateCarInspectionFilesAResponse) String() string {
 func (*CreateCarInspectionFilesAResponse) ProtoMessage() {}
 
 func (x *CreateCarInspectionFilesAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[184]
+	mi := &file_service_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12755,7 +13287,7 @@ func (x *CreateCarInspectionFilesAResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateCarInspectionFilesAResponse.ProtoReflect.Descriptor instead.
 func (*CreateCarInspectionFilesAResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{184}
+	return file_service_proto_rawDescGZIP(), []int{185}
 }
 
 func (x *CreateCarInspectionFilesAResponse) GetCarInspectionFilesA() *CarInspectionFilesA {
@@ -12774,7 +13306,7 @@ type GetCarInspectionFilesARequest struct {
 
 func (x *GetCarInspectionFilesARequest) Reset() {
 	*x = GetCarInspectionFilesARequest{}
-	mi := &file_service_proto_msgTypes[185]
+	mi := &file_service_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12786,7 +13318,7 @@ func (x *GetCarInspectionFilesARequest) String() string {
 func (*GetCarInspectionFilesARequest) ProtoMessage() {}
 
 func (x *GetCarInspectionFilesARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[185]
+	mi := &file_service_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12799,7 +13331,7 @@ func (x *GetCarInspectionFilesARequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCarInspectionFilesARequest.ProtoReflect.Descriptor instead.
 func (*GetCarInspectionFilesARequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{185}
+	return file_service_proto_rawDescGZIP(), []int{186}
 }
 
 func (x *GetCarInspectionFilesARequest) GetUuid() string {
@@ -12818,7 +13350,7 @@ type GetCarInspectionFilesAResponse struct {
 
 func (x *GetCarInspectionFilesAResponse) Reset() {
 	*x = GetCarInspectionFilesAResponse{}
-	mi := &file_service_proto_msgTypes[186]
+	mi := &file_service_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12830,7 +13362,7 @@ func (x *GetCarInspectionFilesAResponse) String() string {
 func (*GetCarInspectionFilesAResponse) ProtoMessage() {}
 
 func (x *GetCarInspectionFilesAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[186]
+	mi := &file_service_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12843,7 +13375,7 @@ func (x *GetCarInspectionFilesAResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCarInspectionFilesAResponse.ProtoReflect.Descriptor instead.
 func (*GetCarInspectionFilesAResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{186}
+	return file_service_proto_rawDescGZIP(), []int{187}
 }
 
 func (x *GetCarInspectionFilesAResponse) GetCarInspectionFilesA() *CarInspectionFilesA {
@@ -12858,13 +13390,14 @@ type UpdateCarInspectionFilesARequest struct {
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	Modified      string                 `protobuf:"bytes,3,opt,name=modified,proto3" json:"modified,omitempty"`
+	Etag          string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateCarInspectionFilesARequest) Reset() {
 	*x = UpdateCarInspectionFilesARequest{}
-	mi := &file_service_proto_msgTypes[187]
+	mi := &file_service_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12876,7 +13409,7 @@ func (x *UpdateCarInspectionFilesARequest) String() string {
 func (*UpdateCarInspectionFilesARequest) ProtoMessage() {}
 
 func (x *UpdateCarInspectionFilesARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[187]
+	mi := &file_service_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12889,7 +13422,7 @@ func (x *UpdateCarInspectionFilesARequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCarInspectionFilesARequest.ProtoReflect.Descriptor instead.
 func (*UpdateCarInspectionFilesARequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{187}
+	return file_service_proto_rawDescGZIP(), []int{188}
 }
 
 func (x *UpdateCarInspectionFilesARequest) GetUuid() string {
@@ -12913,6 +13446,13 @@ func (x *UpdateCarInspectionFilesARequest) GetModified() string {
 	return ""
 }
 
+func (x *UpdateCarInspectionFilesARequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateCarInspectionFilesAResponse struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	CarInspectionFilesA *CarInspectionFilesA   `protobuf:"bytes,1,opt,name=car_inspection_files_a,json=carInspectionFilesA,proto3" json:"car_inspection_files_a,omitempty"`
@@ -12922,7 +13462,7 @@ type UpdateCarInspectionFilesAResponse struct {
 
 func (x *UpdateCarInspectionFilesAResponse) Reset() {
 	*x = UpdateCarInspectionFilesAResponse{}
-	mi := &file_service_proto_msgTypes[188]
+	mi := &file_service_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12934,7 +13474,7 @@ func (x *UpdateCarInspectionFilesAResponse) String() string {
 func (*UpdateCarInspectionFilesAResponse) ProtoMessage() {}
 
 func (x *UpdateCarInspectionFilesAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[188]
+	mi := &file_service_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12947,7 +13487,7 @@ func (x *UpdateCarInspectionFilesAResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateCarInspectionFilesAResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCarInspectionFilesAResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{188}
+	return file_service_proto_rawDescGZIP(), []int{189}
 }
 
 func (x *UpdateCarInspectionFilesAResponse) GetCarInspectionFilesA() *CarInspectionFilesA {
@@ -12960,13 +13500,14 @@ func (x *UpdateCarInspectionFilesAResponse) GetCarInspectionFilesA() *CarInspect
 type DeleteCarInspectionFilesARequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteCarInspectionFilesARequest) Reset() {
 	*x = DeleteCarInspectionFilesARequest{}
-	mi := &file_service_proto_msgTypes[189]
+	mi := &file_service_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12978,7 +13519,7 @@ func (x *DeleteCarInspectionFilesARequest) String() string {
 func (*DeleteCarInspectionFilesARequest) ProtoMessage() {}
 
 func (x *DeleteCarInspectionFilesARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[189]
+	mi := &file_service_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12991,7 +13532,7 @@ func (x *DeleteCarInspectionFilesARequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCarInspectionFilesARequest.ProtoReflect.Descriptor instead.
 func (*DeleteCarInspectionFilesARequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{189}
+	return file_service_proto_rawDescGZIP(), []int{190}
 }
 
 func (x *DeleteCarInspectionFilesARequest) GetUuid() string {
@@ -13001,6 +13542,13 @@ func (x *DeleteCarInspectionFilesARequest) GetUuid() string {
 	return ""
 }
 
+func (x *DeleteCarInspectionFilesARequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteCarInspectionFilesAResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -13010,7 +13558,7 @@ type DeleteCarInspectionFilesAResponse struct {
 
 func (x *DeleteCarInspectionFilesAResponse) Reset() {
 	*x = DeleteCarInspectionFilesAResponse{}
-	mi := &file_service_proto_msgTypes[190]
+	mi := &file_service_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13022,7 +13570,7 @@ func (x *DeleteCarInspectionFilesAResponse) String() string {
 func (*DeleteCarInspectionFilesAResponse) ProtoMessage() {}
 
 func (x *DeleteCarInspectionFilesAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[190]
+	mi := &file_service_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13035,7 +13583,7 @@ func (x *DeleteCarInspectionFilesAResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DeleteCarInspectionFilesAResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCarInspectionFilesAResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{190}
+	return file_service_proto_rawDescGZIP(), []int{191}
 }
 
 func (x *DeleteCarInspectionFilesAResponse) GetSuccess() bool {
@@ -13055,7 +13603,7 @@ type ListCarInspectionFilesAsRequest struct {
 
 func (x *ListCarInspectionFilesAsRequest) Reset() {
 	*x = ListCarInspectionFilesAsRequest{}
-	mi := &file_service_proto_msgTypes[191]
+	mi := &file_service_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13067,7 +13615,7 @@ func (x *ListCarInspectionFilesAsRequest) String() string {
 func (*ListCarInspectionFilesAsRequest) ProtoMessage() {}
 
 func (x *ListCarInspectionFilesAsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[191]
+	mi := &file_service_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13080,7 +13628,7 @@ func (x *ListCarInspectionFilesAsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCarInspectionFilesAsRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionFilesAsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{191}
+	return file_service_proto_rawDescGZIP(), []int{192}
 }
 
 func (x *ListCarInspectionFilesAsRequest) GetPageSize() int32 {
@@ -13107,7 +13655,7 @@ type ListCarInspectionFilesAsResponse struct {
 
 func (x *ListCarInspectionFilesAsResponse) Reset() {
 	*x = ListCarInspectionFilesAsResponse{}
-	mi := &file_service_proto_msgTypes[192]
+	mi := &file_service_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13119,7 +13667,7 @@ func (x *ListCarInspectionFilesAsResponse) String() string {
 func (*ListCarInspectionFilesAsResponse) ProtoMessage() {}
 
 func (x *ListCarInspectionFilesAsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[192]
+	mi := &file_service_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13132,7 +13680,7 @@ func (x *ListCarInspectionFilesAsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCarInspectionFilesAsResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionFilesAsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{192}
+	return file_service_proto_rawDescGZIP(), []int{193}
 }
 
 func (x *ListCarInspectionFilesAsResponse) GetCarInspectionFilesAs() []*CarInspectionFilesA {
@@ -13160,7 +13708,7 @@ type ListCarInspectionFilesAsByOrganizationRequest struct {
 
 func (x *ListCarInspectionFilesAsByOrganizationRequest) Reset() {
 	*x = ListCarInspectionFilesAsByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[193]
+	mi := &file_service_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13172,7 +13720,7 @@ func (x *ListCarInspectionFilesAsByOrganizationRequest) String() string {
 func (*ListCarInspectionFilesAsByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListCarInspectionFilesAsByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[193]
+	mi := &file_service_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13185,7 +13733,7 @@ func (x *ListCarInspectionFilesAsByOrganizationRequest) ProtoReflect() protorefl
 
 // Deprecated: Use ListCarInspectionFilesAsByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionFilesAsByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{193}
+	return file_service_proto_rawDescGZIP(), []int{194}
 }
 
 func (x *ListCarInspectionFilesAsByOrganizationRequest) GetOrganizationId() string {
@@ -13219,7 +13767,7 @@ type ListCarInspectionFilesAsByOrganizationResponse struct {
 
 func (x *ListCarInspectionFilesAsByOrganizationResponse) Reset() {
 	*x = ListCarInspectionFilesAsByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[194]
+	mi := &file_service_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13231,7 +13779,7 @@ func (x *ListCarInspectionFilesAsByOrganizationResponse) String() string {
 func (*ListCarInspectionFilesAsByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListCarInspectionFilesAsByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[194]
+	mi := &file_service_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13244,7 +13792,7 @@ func (x *ListCarInspectionFilesAsByOrganizationResponse) ProtoReflect() protoref
 
 // Deprecated: Use ListCarInspectionFilesAsByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionFilesAsByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{194}
+	return file_service_proto_rawDescGZIP(), []int{195}
 }
 
 func (x *ListCarInspectionFilesAsByOrganizationResponse) GetCarInspectionFilesAs() []*CarInspectionFilesA {
@@ -13274,13 +13822,14 @@ type CarInspectionFilesB struct {
 	Created        string                 `protobuf:"bytes,9,opt,name=created,proto3" json:"created,omitempty"`
 	Modified       string                 `protobuf:"bytes,10,opt,name=modified,proto3" json:"modified,omitempty"`
 	Deleted        *string                `protobuf:"bytes,11,opt,name=deleted,proto3,oneof" json:"deleted,omitempty"`
+	Etag           string                 `protobuf:"bytes,12,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateCarInspectionFilesBRequest.etag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CarInspectionFilesB) Reset() {
 	*x = CarInspectionFilesB{}
-	mi := &file_service_proto_msgTypes[195]
+	mi := &file_service_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13292,7 +13841,7 @@ func (x *CarInspectionFilesB) String() string {
 func (*CarInspectionFilesB) ProtoMessage() {}
 
 func (x *CarInspectionFilesB) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[195]
+	mi := &file_service_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13305,7 +13854,7 @@ func (x *CarInspectionFilesB) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CarInspectionFilesB.ProtoReflect.Descriptor instead.
 func (*CarInspectionFilesB) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{195}
+	return file_service_proto_rawDescGZIP(), []int{196}
 }
 
 func (x *CarInspectionFilesB) GetUuid() string {
@@ -13385,6 +13934,13 @@ func (x *CarInspectionFilesB) GetDeleted() string {
 	return ""
 }
 
+func (x *CarInspectionFilesB) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateCarInspectionFilesBRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -13402,7 +13958,7 @@ type CreateCarInspectionFilesBRequest struct {
 
 func (x *CreateCarInspectionFilesBRequest) Reset() {
 	*x = CreateCarInspectionFilesBRequest{}
-	mi := &file_service_proto_msgTypes[196]
+	mi := &file_service_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13414,7 +13970,7 @@ func (x *CreateCarInspectionFilesBRequest) String() string {
 func (*CreateCarInspectionFilesBRequest) ProtoMessage() {}
 
 func (x *CreateCarInspectionFilesBRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[196]
+	mi := &file_service_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13427,7 +13983,7 @@ func (x *CreateCarInspectionFilesBRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCarInspectionFilesBRequest.ProtoReflect.Descriptor instead.
 func (*CreateCarInspectionFilesBRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{196}
+	return file_service_proto_rawDescGZIP(), []int{197}
 }
 
 func (x *CreateCarInspectionFilesBRequest) GetOrganizationId() string {
@@ -13502,7 +14058,7 @@ type CreateCarInspectionFilesBResponse struct {
 
 func (x *CreateCarInspectionFilesBResponse) Reset() {
 	*x = CreateCarInspectionFilesBResponse{}
-	mi := &file_service_proto_msgTypes[197]
+	mi := &file_service_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13514,7 +14070,7 @@ func (x *CreateCarInspectionFilesBResponse) String() string {
 func (*CreateCarInspectionFilesBResponse) ProtoMessage() {}
 
 func (x *CreateCarInspectionFilesBResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[197]
+	mi := &file_service_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13527,7 +14083,7 @@ func (x *CreateCarInspectionFilesBResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateCarInspectionFilesBResponse.ProtoReflect.Descriptor instead.
 func (*CreateCarInspectionFilesBResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{197}
+	return file_service_proto_rawDescGZIP(), []int{198}
 }
 
 func (x *CreateCarInspectionFilesBResponse) GetCarInspectionFilesB() *CarInspectionFilesB {
@@ -13546,7 +14102,7 @@ type GetCarInspectionFilesBRequest struct {
 
 func (x *GetCarInspectionFilesBRequest) Reset() {
 	*x = GetCarInspectionFilesBRequest{}
-	mi := &file_service_proto_msgTypes[198]
+	mi := &file_service_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13558,7 +14114,7 @@ func (x *GetCarInspectionFilesBRequest) String() string {
 func (*GetCarInspectionFilesBRequest) ProtoMessage() {}
 
 func (x *GetCarInspectionFilesBRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[198]
+	mi := &file_service_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13571,7 +14127,7 @@ func (x *GetCarInspectionFilesBRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCarInspectionFilesBRequest.ProtoReflect.Descriptor instead.
 func (*GetCarInspectionFilesBRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{198}
+	return file_service_proto_rawDescGZIP(), []int{199}
 }
 
 func (x *GetCarInspectionFilesBRequest) GetUuid() string {
@@ -13590,7 +14146,7 @@ type GetCarInspectionFilesBResponse struct {
 
 func (x *GetCarInspectionFilesBResponse) Reset() {
 	*x = GetCarInspectionFilesBResponse{}
-	mi := &file_service_proto_msgTypes[199]
+	mi := &file_service_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13602,7 +14158,7 @@ func (x *GetCarInspectionFilesBResponse) String() string {
 func (*GetCarInspectionFilesBResponse) ProtoMessage() {}
 
 func (x *GetCarInspectionFilesBResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[199]
+	mi := &file_service_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13615,7 +14171,7 @@ func (x *GetCarInspectionFilesBResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCarInspectionFilesBResponse.ProtoReflect.Descriptor instead.
 func (*GetCarInspectionFilesBResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{199}
+	return file_service_proto_rawDescGZIP(), []int{200}
 }
 
 func (x *GetCarInspectionFilesBResponse) GetCarInspectionFilesB() *CarInspectionFilesB {
@@ -13630,13 +14186,14 @@ type UpdateCarInspectionFilesBRequest struct {
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	Modified      string                 `protobuf:"bytes,3,opt,name=modified,proto3" json:"modified,omitempty"`
+	Etag          string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateCarInspectionFilesBRequest) Reset() {
 	*x = UpdateCarInspectionFilesBRequest{}
-	mi := &file_service_proto_msgTypes[200]
+	mi := &file_service_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13648,7 +14205,7 @@ func (x *UpdateCarInspectionFilesBRequest) String() string {
 func (*UpdateCarInspectionFilesBRequest) ProtoMessage() {}
 
 func (x *UpdateCarInspectionFilesBRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[200]
+	mi := &file_service_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13661,7 +14218,7 @@ func (x *UpdateCarInspectionFilesBRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCarInspectionFilesBRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCarInspectionFilesBRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{200}
+	return file_service_proto_rawDescGZIP(), []int{201}
 }
 
 func (x *UpdateCarInspectionFilesBRequest) GetUuid() string {
@@ -13685,6 +14242,13 @@ func (x *UpdateCarInspectionFilesBRequest) GetModified() string {
 	return ""
 }
 
+func (x *UpdateCarInspectionFilesBRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateCarInspectionFilesBResponse struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	CarInspectionFilesB *CarInspectionFilesB   `protobuf:"bytes,1,opt,name=car_inspection_files_b,json=carInspectionFilesB,proto3" json:"car_inspection_files_b,omitempty"`
@@ -13694,7 +14258,7 @@ type UpdateCarInspectionFilesBResponse struct {
 
 func (x *UpdateCarInspectionFilesBResponse) Reset() {
 	*x = UpdateCarInspectionFilesBResponse{}
-	mi := &file_service_proto_msgTypes[201]
+	mi := &file_service_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13706,7 +14270,7 @@ func (x *UpdateCarInspectionFilesBResponse) String() string {
 func (*UpdateCarInspectionFilesBResponse) ProtoMessage() {}
 
 func (x *UpdateCarInspectionFilesBResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[201]
+	mi := &file_service_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13719,7 +14283,7 @@ func (x *UpdateCarInspectionFilesBResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateCarInspectionFilesBResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCarInspectionFilesBResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{201}
+	return file_service_proto_rawDescGZIP(), []int{202}
 }
 
 func (x *UpdateCarInspectionFilesBResponse) GetCarInspectionFilesB() *CarInspectionFilesB {
@@ -13732,13 +14296,14 @@ func (x *UpdateCarInspectionFilesBResponse) GetCarInspectionFilesB() *CarInspect
 type DeleteCarInspectionFilesBRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteCarInspectionFilesBRequest) Reset() {
 	*x = DeleteCarInspectionFilesBRequest{}
-	mi := &file_service_proto_msgTypes[202]
+	mi := &file_service_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13750,7 +14315,7 @@ func (x *DeleteCarInspectionFilesBRequest) String() string {
 func (*DeleteCarInspectionFilesBRequest) ProtoMessage() {}
 
 func (x *DeleteCarInspectionFilesBRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[202]
+	mi := &file_service_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13763,7 +14328,7 @@ func (x *DeleteCarInspectionFilesBRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCarInspectionFilesBRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCarInspectionFilesBRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{202}
+	return file_service_proto_rawDescGZIP(), []int{203}
 }
 
 func (x *DeleteCarInspectionFilesBRequest) GetUuid() string {
@@ -13773,6 +14338,13 @@ func (x *DeleteCarInspectionFilesBRequest) GetUuid() string {
 	return ""
 }
 
+func (x *DeleteCarInspectionFilesBRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteCarInspectionFilesBResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -13782,7 +14354,7 @@ type DeleteCarInspectionFilesBResponse struct {
 
 func (x *DeleteCarInspectionFilesBResponse) Reset() {
 	*x = DeleteCarInspectionFilesBResponse{}
-	mi := &file_service_proto_msgTypes[203]
+	mi := &file_service_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13794,7 +14366,7 @@ func (x *DeleteCarInspectionFilesBResponse) String() string {
 func (*DeleteCarInspectionFilesBResponse) ProtoMessage() {}
 
 func (x *DeleteCarInspectionFilesBResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[203]
+	mi := &file_service_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13807,7 +14379,7 @@ func (x *DeleteCarInspectionFilesBResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DeleteCarInspectionFilesBResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCarInspectionFilesBResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{203}
+	return file_service_proto_rawDescGZIP(), []int{204}
 }
 
 func (x *DeleteCarInspectionFilesBResponse) GetSuccess() bool {
@@ -13827,7 +14399,7 @@ type ListCarInspectionFilesBsRequest struct {
 
 func (x *ListCarInspectionFilesBsRequest) Reset() {
 	*x = ListCarInspectionFilesBsRequest{}
-	mi := &file_service_proto_msgTypes[204]
+	mi := &file_service_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13839,7 +14411,7 @@ func (x *ListCarInspectionFilesBsRequest) String() string {
 func (*ListCarInspectionFilesBsRequest) ProtoMessage() {}
 
 func (x *ListCarInspectionFilesBsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[204]
+	mi := &file_service_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13852,7 +14424,7 @@ func (x *ListCarInspectionFilesBsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCarInspectionFilesBsRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionFilesBsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{204}
+	return file_service_proto_rawDescGZIP(), []int{205}
 }
 
 func (x *ListCarInspectionFilesBsRequest) GetPageSize() int32 {
@@ -13879,7 +14451,7 @@ type ListCarInspectionFilesBsResponse struct {
 
 func (x *ListCarInspectionFilesBsResponse) Reset() {
 	*x = ListCarInspectionFilesBsResponse{}
-	mi := &file_service_proto_msgTypes[205]
+	mi := &file_service_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13891,7 +14463,7 @@ func (x *ListCarInspectionFilesBsResponse) String() string {
 func (*ListCarInspectionFilesBsResponse) ProtoMessage() {}
 
 func (x *ListCarInspectionFilesBsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[205]
+	mi := &file_service_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13904,7 +14476,7 @@ func (x *ListCarInspectionFilesBsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCarInspectionFilesBsResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionFilesBsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{205}
+	return file_service_proto_rawDescGZIP(), []int{206}
 }
 
 func (x *ListCarInspectionFilesBsResponse) GetCarInspectionFilesBs() []*CarInspectionFilesB {
@@ -13932,7 +14504,7 @@ type ListCarInspectionFilesBsByOrganizationRequest struct {
 
 func (x *ListCarInspectionFilesBsByOrganizationRequest) Reset() {
 	*x = ListCarInspectionFilesBsByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[206]
+	mi := &file_service_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13944,7 +14516,7 @@ func (x *ListCarInspectionFilesBsByOrganizationRequest) String() string {
 func (*ListCarInspectionFilesBsByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListCarInspectionFilesBsByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[206]
+	mi := &file_service_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13957,7 +14529,7 @@ func (x *ListCarInspectionFilesBsByOrganizationRequest) ProtoReflect() protorefl
 
 // Deprecated: Use ListCarInspectionFilesBsByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionFilesBsByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{206}
+	return file_service_proto_rawDescGZIP(), []int{207}
 }
 
 func (x *ListCarInspectionFilesBsByOrganizationRequest) GetOrganizationId() string {
@@ -13991,7 +14563,7 @@ type ListCarInspectionFilesBsByOrganizationResponse struct {
 
 func (x *ListCarInspectionFilesBsByOrganizationResponse) Reset() {
 	*x = ListCarInspectionFilesBsByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[207]
+	mi := &file_service_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14003,7 +14575,7 @@ func (x *ListCarInspectionFilesBsByOrganizationResponse) String() string {
 func (*ListCarInspectionFilesBsByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListCarInspectionFilesBsByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[207]
+	mi := &file_service_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14016,7 +14588,7 @@ func (x *ListCarInspectionFilesBsByOrganizationResponse) ProtoReflect() protoref
 
 // Deprecated: Use ListCarInspectionFilesBsByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionFilesBsByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{207}
+	return file_service_proto_rawDescGZIP(), []int{208}
 }
 
 func (x *ListCarInspectionFilesBsByOrganizationResponse) GetCarInspectionFilesBs() []*CarInspectionFilesB {
@@ -14044,13 +14616,14 @@ type CarInspectionDeregistration struct {
 	ValidPeriodExpirDateM                    string                 `protobuf:"bytes,7,opt,name=valid_period_expir_date_m,json=validPeriodExpirDateM,proto3" json:"valid_period_expir_date_m,omitempty"`
 	ValidPeriodExpirDateD                    string                 `protobuf:"bytes,8,opt,name=valid_period_expir_date_d,json=validPeriodExpirDateD,proto3" json:"valid_period_expir_date_d,omitempty"`
 	TwodimensionCodeInfoValidPeriodExpirDate string                 `protobuf:"bytes,9,opt,name=twodimension_code_info_valid_period_expir_date,json=twodimensionCodeInfoValidPeriodExpirDate,proto3" json:"twodimension_code_info_valid_period_expir_date,omitempty"`
+	Etag                                     string                 `protobuf:"bytes,10,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateCarInspectionDeregistrationRequest.etag
 	unknownFields                            protoimpl.UnknownFields
 	sizeCache                                protoimpl.SizeCache
 }
 
 func (x *CarInspectionDeregistration) Reset() {
 	*x = CarInspectionDeregistration{}
-	mi := &file_service_proto_msgTypes[208]
+	mi := &file_service_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14062,7 +14635,7 @@ func (x *CarInspectionDeregistration) String() string {
 func (*CarInspectionDeregistration) ProtoMessage() {}
 
 func (x *CarInspectionDeregistration) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[208]
+	mi := &file_service_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14075,7 +14648,7 @@ func (x *CarInspectionDeregistration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CarInspectionDeregistration.ProtoReflect.Descriptor instead.
 func (*CarInspectionDeregistration) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{208}
+	return file_service_proto_rawDescGZIP(), []int{209}
 }
 
 func (x *CarInspectionDeregistration) GetOrganizationId() string {
@@ -14141,6 +14714,13 @@ func (x *CarInspectionDeregistration) GetTwodimensionCodeInfoValidPeriodExpirDat
 	return ""
 }
 
+func (x *CarInspectionDeregistration) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateCarInspectionDeregistrationRequest struct {
 	state                                    protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId                           string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -14158,7 +14738,7 @@ type CreateCarInspectionDeregistrationRequest struct {
 
 func (x *CreateCarInspectionDeregistrationRequest) Reset() {
 	*x = CreateCarInspectionDeregistrationRequest{}
-	mi := &file_service_proto_msgTypes[209]
+	mi := &file_service_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14170,7 +14750,7 @@ func (x *CreateCarInspectionDeregistrationRequest) String() string {
 func (*CreateCarInspectionDeregistrationRequest) ProtoMessage() {}
 
 func (x *CreateCarInspectionDeregistrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[209]
+	mi := &file_service_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14183,7 +14763,7 @@ func (x *CreateCarInspectionDeregistrationRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use CreateCarInspectionDeregistrationRequest.ProtoReflect.Descriptor instead.
 func (*CreateCarInspectionDeregistrationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{209}
+	return file_service_proto_rawDescGZIP(), []int{210}
 }
 
 func (x *CreateCarInspectionDeregistrationRequest) GetOrganizationId() string {
@@ -14258,7 +14838,7 @@ type CreateCarInspectionDeregistrationResponse struct {
 
 func (x *CreateCarInspectionDeregistrationResponse) Reset() {
 	*x = CreateCarInspectionDeregistrationResponse{}
-	mi := &file_service_proto_msgTypes[210]
+	mi := &file_service_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14270,7 +14850,7 @@ func (x *CreateCarInspectionDeregistrationResponse) String() string {
 func (*CreateCarInspectionDeregistrationResponse) ProtoMessage() {}
 
 func (x *CreateCarInspectionDeregistrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[210]
+	mi := &file_service_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14283,7 +14863,7 @@ func (x *CreateCarInspectionDeregistrationResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use CreateCarInspectionDeregistrationResponse.ProtoReflect.Descriptor instead.
 func (*CreateCarInspectionDeregistrationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{210}
+	return file_service_proto_rawDescGZIP(), []int{211}
 }
 
 func (x *CreateCarInspectionDeregistrationResponse) GetCarInspectionDeregistration() *CarInspectionDeregistration {
@@ -14304,7 +14884,7 @@ type GetCarInspectionDeregistrationRequest struct {
 
 func (x *GetCarInspectionDeregistrationRequest) Reset() {
 	*x = GetCarInspectionDeregistrationRequest{}
-	mi := &file_service_proto_msgTypes[211]
+	mi := &file_service_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14316,7 +14896,7 @@ func (x *GetCarInspectionDeregistrationRequest) String() string {
 func (*GetCarInspectionDeregistrationRequest) ProtoMessage() {}
 
 func (x *GetCarInspectionDeregistrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[211]
+	mi := &file_service_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14329,7 +14909,7 @@ func (x *GetCarInspectionDeregistrationRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use GetCarInspectionDeregistrationRequest.ProtoReflect.Descriptor instead.
 func (*GetCarInspectionDeregistrationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{211}
+	return file_service_proto_rawDescGZIP(), []int{212}
 }
 
 func (x *GetCarInspectionDeregistrationRequest) GetOrganizationId() string {
@@ -14362,7 +14942,7 @@ type GetCarInspectionDeregistrationResponse struct {
 
 func (x *GetCarInspectionDeregistrationResponse) Reset() {
 	*x = GetCarInspectionDeregistrationResponse{}
-	mi := &file_service_proto_msgTypes[212]
+	mi := &file_service_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14374,7 +14954,7 @@ func (x *GetCarInspectionDeregistrationResponse) String() string {
 func (*GetCarInspectionDeregistrationResponse) ProtoMessage() {}
 
 func (x *GetCarInspectionDeregistrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[212]
+	mi := &file_service_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14387,7 +14967,7 @@ func (x *GetCarInspectionDeregistrationResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetCarInspectionDeregistrationResponse.ProtoReflect.Descriptor instead.
 func (*GetCarInspectionDeregistrationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{212}
+	return file_service_proto_rawDescGZIP(), []int{213}
 }
 
 func (x *GetCarInspectionDeregistrationResponse) GetCarInspectionDeregistration() *CarInspectionDeregistration {
@@ -14408,13 +14988,14 @@ type UpdateCarInspectionDeregistrationRequest struct {
 	ValidPeriodExpirDateY                    string                 `protobuf:"bytes,7,opt,name=valid_period_expir_date_y,json=validPeriodExpirDateY,proto3" json:"valid_period_expir_date_y,omitempty"`
 	ValidPeriodExpirDateM                    string                 `protobuf:"bytes,8,opt,name=valid_period_expir_date_m,json=validPeriodExpirDateM,proto3" json:"valid_period_expir_date_m,omitempty"`
 	ValidPeriodExpirDateD                    string                 `protobuf:"bytes,9,opt,name=valid_period_expir_date_d,json=validPeriodExpirDateD,proto3" json:"valid_period_expir_date_d,omitempty"`
+	Etag                                     string                 `protobuf:"bytes,10,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields                            protoimpl.UnknownFields
 	sizeCache                                protoimpl.SizeCache
 }
 
 func (x *UpdateCarInspectionDeregistrationRequest) Reset() {
 	*x = UpdateCarInspectionDeregistrationRequest{}
-	mi := &file_service_proto_msgTypes[213]
+	mi := &file_service_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14426,7 +15007,7 @@ func (x *UpdateCarInspectionDeregistrationRequest) String() string {
 func (*UpdateCarInspectionDeregistrationRequest) ProtoMessage() {}
 
 func (x *UpdateCarInspectionDeregistrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[213]
+	mi := &file_service_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14439,7 +15020,7 @@ func (x *UpdateCarInspectionDeregistrationRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use UpdateCarInspectionDeregistrationRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCarInspectionDeregistrationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{213}
+	return file_service_proto_rawDescGZIP(), []int{214}
 }
 
 func (x *UpdateCarInspectionDeregistrationRequest) GetOrganizationId() string {
@@ -14505,6 +15086,13 @@ func (x *UpdateCarInspectionDeregistrationRequest) GetValidPeriodExpirDateD() st
 	return ""
 }
 
+func (x *UpdateCarInspectionDeregistrationRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateCarInspectionDeregistrationResponse struct {
 	state                       protoimpl.MessageState       `protogen:"open.v1"`
 	CarInspectionDeregistration *CarInspectionDeregistration `protobuf:"bytes,1,opt,name=car_inspection_deregistration,json=carInspectionDeregistration,proto3" json:"car_inspection_deregistration,omitempty"`
@@ -14514,7 +15102,7 @@ type UpdateCarInspectionDeregistrationResponse struct {
 
 func (x *UpdateCarInspectionDeregistrationResponse) Reset() {
 	*x = UpdateCarInspectionDeregistrationResponse{}
-	mi := &file_service_proto_msgTypes[214]
+	mi := &file_service_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14526,7 +15114,7 @@ func (x *UpdateCarInspectionDeregistrationResponse) String() string {
 func (*UpdateCarInspectionDeregistrationResponse) ProtoMessage() {}
 
 func (x *UpdateCarInspectionDeregistrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[214]
+	mi := &file_service_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14539,7 +15127,7 @@ func (x *UpdateCarInspectionDeregistrationResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use UpdateCarInspectionDeregistrationResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCarInspectionDeregistrationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{214}
+	return file_service_proto_rawDescGZIP(), []int{215}
 }
 
 func (x *UpdateCarInspectionDeregistrationResponse) GetCarInspectionDeregistration() *CarInspectionDeregistration {
@@ -14554,13 +15142,14 @@ type DeleteCarInspectionDeregistrationRequest struct {
 	OrganizationId                           string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	CarId                                    string                 `protobuf:"bytes,2,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
 	TwodimensionCodeInfoValidPeriodExpirDate string                 `protobuf:"bytes,3,opt,name=twodimension_code_info_valid_period_expir_date,json=twodimensionCodeInfoValidPeriodExpirDate,proto3" json:"twodimension_code_info_valid_period_expir_date,omitempty"`
+	Etag                                     string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields                            protoimpl.UnknownFields
 	sizeCache                                protoimpl.SizeCache
 }
 
 func (x *DeleteCarInspectionDeregistrationRequest) Reset() {
 	*x = DeleteCarInspectionDeregistrationRequest{}
-	mi := &file_service_proto_msgTypes[215]
+	mi := &file_service_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14572,7 +15161,7 @@ func (x *DeleteCarInspectionDeregistrationRequest) String() string {
 func (*DeleteCarInspectionDeregistrationRequest) ProtoMessage() {}
 
 func (x *DeleteCarInspectionDeregistrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[215]
+	mi := &file_service_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14585,7 +15174,7 @@ func (x *DeleteCarInspectionDeregistrationRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use DeleteCarInspectionDeregistrationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCarInspectionDeregistrationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{215}
+	return file_service_proto_rawDescGZIP(), []int{216}
 }
 
 func (x *DeleteCarInspectionDeregistrationRequest) GetOrganizationId() string {
@@ -14609,6 +15198,13 @@ func (x *DeleteCarInspectionDeregistrationRequest) GetTwodimensionCodeInfoValidP
 	return ""
 }
 
+func (x *DeleteCarInspectionDeregistrationRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteCarInspectionDeregistrationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -14618,7 +15214,7 @@ type DeleteCarInspectionDeregistrationResponse struct {
 
 func (x *DeleteCarInspectionDeregistrationResponse) Reset() {
 	*x = DeleteCarInspectionDeregistrationResponse{}
-	mi := &file_service_proto_msgTypes[216]
+	mi := &file_service_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14630,7 +15226,7 @@ func (x *DeleteCarInspectionDeregistrationResponse) String() string {
 func (*DeleteCarInspectionDeregistrationResponse) ProtoMessage() {}
 
 func (x *DeleteCarInspectionDeregistrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[216]
+	mi := &file_service_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14643,7 +15239,7 @@ func (x *DeleteCarInspectionDeregistrationResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use DeleteCarInspectionDeregistrationResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCarInspectionDeregistrationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{216}
+	return file_service_proto_rawDescGZIP(), []int{217}
 }
 
 func (x *DeleteCarInspectionDeregistrationResponse) GetSuccess() bool {
@@ -14663,7 +15259,7 @@ type ListCarInspectionDeregistrationsRequest struct {
 
 func (x *ListCarInspectionDeregistrationsRequest) Reset() {
 	*x = ListCarInspectionDeregistrationsRequest{}
-	mi := &file_service_proto_msgTypes[217]
+	mi := &file_service_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14675,7 +15271,7 @@ func (x *ListCarInspectionDeregistrationsRequest) String() string {
 func (*ListCarInspectionDeregistrationsRequest) ProtoMessage() {}
 
 func (x *ListCarInspectionDeregistrationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[217]
+	mi := &file_service_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14688,7 +15284,7 @@ func (x *ListCarInspectionDeregistrationsRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use ListCarInspectionDeregistrationsRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionDeregistrationsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{217}
+	return file_service_proto_rawDescGZIP(), []int{218}
 }
 
 func (x *ListCarInspectionDeregistrationsRequest) GetPageSize() int32 {
@@ -14715,7 +15311,7 @@ type ListCarInspectionDeregistrationsResponse struct {
 
 func (x *ListCarInspectionDeregistrationsResponse) Reset() {
 	*x = ListCarInspectionDeregistrationsResponse{}
-	mi := &file_service_proto_msgTypes[218]
+	mi := &file_service_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14727,7 +15323,7 @@ func (x *ListCarInspectionDeregistrationsResponse) String() string {
 func (*ListCarInspectionDeregistrationsResponse) ProtoMessage() {}
 
 func (x *ListCarInspectionDeregistrationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[218]
+	mi := &file_service_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14740,7 +15336,7 @@ func (x *ListCarInspectionDeregistrationsResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use ListCarInspectionDeregistrationsResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionDeregistrationsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{218}
+	return file_service_proto_rawDescGZIP(), []int{219}
 }
 
 func (x *ListCarInspectionDeregistrationsResponse) GetCarInspectionDeregistrations() []*CarInspectionDeregistration {
@@ -14768,7 +15364,7 @@ type ListCarInspectionDeregistrationsByOrganizationRequest struct {
 
 func (x *ListCarInspectionDeregistrationsByOrganizationRequest) Reset() {
 	*x = ListCarInspectionDeregistrationsByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[219]
+	mi := &file_service_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14780,7 +15376,7 @@ func (x *ListCarInspectionDeregistrationsByOrganizationRequest) String() string
 func (*ListCarInspectionDeregistrationsByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListCarInspectionDeregistrationsByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[219]
+	mi := &file_service_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14793,7 +15389,7 @@ func (x *ListCarInspectionDeregistrationsByOrganizationRequest) ProtoReflect() p
 
 // Deprecated: Use ListCarInspectionDeregistrationsByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionDeregistrationsByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{219}
+	return file_service_proto_rawDescGZIP(), []int{220}
 }
 
 func (x *ListCarInspectionDeregistrationsByOrganizationRequest) GetOrganizationId() string {
@@ -14827,7 +15423,7 @@ type ListCarInspectionDeregistrationsByOrganizationResponse struct {
 
 func (x *ListCarInspectionDeregistrationsByOrganizationResponse) Reset() {
 	*x = ListCarInspectionDeregistrationsByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[220]
+	mi := &file_service_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14839,7 +15435,7 @@ func (x *ListCarInspectionDeregistrationsByOrganizationResponse) String() string
 func (*ListCarInspectionDeregistrationsByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListCarInspectionDeregistrationsByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[220]
+	mi := &file_service_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14852,7 +15448,7 @@ func (x *ListCarInspectionDeregistrationsByOrganizationResponse) ProtoReflect()
 
 // Deprecated: Use ListCarInspectionDeregistrationsByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionDeregistrationsByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{220}
+	return file_service_proto_rawDescGZIP(), []int{221}
 }
 
 func (x *ListCarInspectionDeregistrationsByOrganizationResponse) GetCarInspectionDeregistrations() []*CarInspectionDeregistration {
@@ -14881,7 +15477,7 @@ type CarInspectionDeregistrationFiles struct {
 
 func (x *CarInspectionDeregistrationFiles) Reset() {
 	*x = CarInspectionDeregistrationFiles{}
-	mi := &file_service_proto_msgTypes[221]
+	mi := &file_service_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14893,7 +15489,7 @@ func (x *CarInspectionDeregistrationFiles) String() string {
 func (*CarInspectionDeregistrationFiles) ProtoMessage() {}
 
 func (x *CarInspectionDeregistrationFiles) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[221]
+	mi := &file_service_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14906,7 +15502,7 @@ func (x *CarInspectionDeregistrationFiles) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CarInspectionDeregistrationFiles.ProtoReflect.Descriptor instead.
 func (*CarInspectionDeregistrationFiles) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{221}
+	return file_service_proto_rawDescGZIP(), []int{222}
 }
 
 func (x *CarInspectionDeregistrationFiles) GetOrganizationId() string {
@@ -14949,7 +15545,7 @@ type CreateCarInspectionDeregistrationFilesRequest struct {
 
 func (x *CreateCarInspectionDeregistrationFilesRequest) Reset() {
 	*x = CreateCarInspectionDeregistrationFilesRequest{}
-	mi := &file_service_proto_msgTypes[222]
+	mi := &file_service_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14961,7 +15557,7 @@ func (x *CreateCarInspectionDeregistrationFilesRequest) String() string {
 func (*CreateCarInspectionDeregistrationFilesRequest) ProtoMessage() {}
 
 func (x *CreateCarInspectionDeregistrationFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[222]
+	mi := &file_service_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14974,7 +15570,7 @@ func (x *CreateCarInspectionDeregistrationFilesRequest) ProtoReflect() protorefl
 
 // Deprecated: Use CreateCarInspectionDeregistrationFilesRequest.ProtoReflect.Descriptor instead.
 func (*CreateCarInspectionDeregistrationFilesRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{222}
+	return file_service_proto_rawDescGZIP(), []int{223}
 }
 
 func (x *CreateCarInspectionDeregistrationFilesRequest) GetOrganizationId() string {
@@ -15014,7 +15610,7 @@ type CreateCarInspectionDeregistrationFilesResponse struct {
 
 func (x *CreateCarInspectionDeregistrationFilesResponse) Reset() {
 	*x = CreateCarInspectionDeregistrationFilesResponse{}
-	mi := &file_service_proto_msgTypes[223]
+	mi := &file_service_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15026,7 +15622,7 @@ func (x *CreateCarInspectionDeregistrationFilesResponse) String() string {
 func (*CreateCarInspectionDeregistrationFilesResponse) ProtoMessage() {}
 
 func (x *CreateCarInspectionDeregistrationFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[223]
+	mi := &file_service_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15039,7 +15635,7 @@ func (x *CreateCarInspectionDeregistrationFilesResponse) ProtoReflect() protoref
 
 // Deprecated: Use CreateCarInspectionDeregistrationFilesResponse.ProtoReflect.Descriptor instead.
 func (*CreateCarInspectionDeregistrationFilesResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{223}
+	return file_service_proto_rawDescGZIP(), []int{224}
 }
 
 func (x *CreateCarInspectionDeregistrationFilesResponse) GetCarInspectionDeregistrationFiles() *CarInspectionDeregistrationFiles {
@@ -15061,7 +15657,7 @@ type GetCarInspectionDeregistrationFilesRequest struct {
 
 func (x *GetCarInspectionDeregistrationFilesRequest) Reset() {
 	*x = GetCarInspectionDeregistrationFilesRequest{}
-	mi := &file_service_proto_msgTypes[224]
+	mi := &file_service_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15073,7 +15669,7 @@ func (x *GetCarInspectionDeregistrationFilesRequest) String() string {
 func (*GetCarInspectionDeregistrationFilesRequest) ProtoMessage() {}
 
 func (x *GetCarInspectionDeregistrationFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[224]
+	mi := &file_service_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15086,7 +15682,7 @@ func (x *GetCarInspectionDeregistrationFilesRequest) ProtoReflect() protoreflect
 
 // Deprecated: Use GetCarInspectionDeregistrationFilesRequest.ProtoReflect.Descriptor instead.
 func (*GetCarInspectionDeregistrationFilesRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{224}
+	return file_service_proto_rawDescGZIP(), []int{225}
 }
 
 func (x *GetCarInspectionDeregistrationFilesRequest) GetOrganizationId() string {
@@ -15126,7 +15722,7 @@ type GetCarInspectionDeregistrationFilesResponse struct {
 
 func (x *GetCarInspectionDeregistrationFilesResponse) Reset() {
 	*x = GetCarInspectionDeregistrationFilesResponse{}
-	mi := &file_service_proto_msgTypes[225]
+	mi := &file_service_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15138,7 +15734,7 @@ func (x *GetCarInspectionDeregistrationFilesResponse) String() string {
 func (*GetCarInspectionDeregistrationFilesResponse) ProtoMessage() {}
 
 func (x *GetCarInspectionDeregistrationFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[225]
+	mi := &file_service_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15151,7 +15747,7 @@ func (x *GetCarInspectionDeregistrationFilesResponse) ProtoReflect() protoreflec
 
 // Deprecated: Use GetCarInspectionDeregistrationFilesResponse.ProtoReflect.Descriptor instead.
 func (*GetCarInspectionDeregistrationFilesResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{225}
+	return file_service_proto_rawDescGZIP(), []int{226}
 }
 
 func (x *GetCarInspectionDeregistrationFilesResponse) GetCarInspectionDeregistrationFiles() *CarInspectionDeregistrationFiles {
@@ -15173,7 +15769,7 @@ type UpdateCarInspectionDeregistrationFilesRequest struct {
 
 func (x *UpdateCarInspectionDeregistrationFilesRequest) Reset() {
 	*x = UpdateCarInspectionDeregistrationFilesRequest{}
-	mi := &file_service_proto_msgTypes[226]
+	mi := &file_service_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15185,7 +15781,7 @@ func (x *UpdateCarInspectionDeregistrationFilesRequest) String() string {
 func (*UpdateCarInspectionDeregistrationFilesRequest) ProtoMessage() {}
 
 func (x *UpdateCarInspectionDeregistrationFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[226]
+	mi := &file_service_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15198,7 +15794,7 @@ func (x *UpdateCarInspectionDeregistrationFilesRequest) ProtoReflect() protorefl
 
 // Deprecated: Use UpdateCarInspectionDeregistrationFilesRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCarInspectionDeregistrationFilesRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{226}
+	return file_service_proto_rawDescGZIP(), []int{227}
 }
 
 func (x *UpdateCarInspectionDeregistrationFilesRequest) GetOrganizationId() string {
@@ -15238,7 +15834,7 @@ type UpdateCarInspectionDeregistrationFilesResponse struct {
 
 func (x *UpdateCarInspectionDeregistrationFilesResponse) Reset() {
 	*x = UpdateCarInspectionDeregistrationFilesResponse{}
-	mi := &file_service_proto_msgTypes[227]
+	mi := &file_service_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15250,7 +15846,7 @@ func (x *UpdateCarInspectionDeregistrationFilesResponse) String() string {
 func (*UpdateCarInspectionDeregistrationFilesResponse) ProtoMessage() {}
 
 func (x *UpdateCarInspectionDeregistrationFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[227]
+	mi := &file_service_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15263,7 +15859,7 @@ func (x *UpdateCarInspectionDeregistrationFilesResponse) ProtoReflect() protoref
 
 // Deprecated: Use UpdateCarInspectionDeregistrationFilesResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCarInspectionDeregistrationFilesResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{227}
+	return file_service_proto_rawDescGZIP(), []int{228}
 }
 
 func (x *UpdateCarInspectionDeregistrationFilesResponse) GetCarInspectionDeregistrationFiles() *CarInspectionDeregistrationFiles {
@@ -15285,7 +15881,7 @@ type DeleteCarInspectionDeregistrationFilesRequest struct {
 
 func (x *DeleteCarInspectionDeregistrationFilesRequest) Reset() {
 	*x = DeleteCarInspectionDeregistrationFilesRequest{}
-	mi := &file_service_proto_msgTypes[228]
+	mi := &file_service_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15297,7 +15893,7 @@ func (x *DeleteCarInspectionDeregistrationFilesRequest) String() string {
 func (*DeleteCarInspectionDeregistrationFilesRequest) ProtoMessage() {}
 
 func (x *DeleteCarInspectionDeregistrationFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[228]
+	mi := &file_service_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15310,7 +15906,7 @@ func (x *DeleteCarInspectionDeregistrationFilesRequest) ProtoReflect() protorefl
 
 // Deprecated: Use DeleteCarInspectionDeregistrationFilesRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCarInspectionDeregistrationFilesRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{228}
+	return file_service_proto_rawDescGZIP(), []int{229}
 }
 
 func (x *DeleteCarInspectionDeregistrationFilesRequest) GetOrganizationId() string {
@@ -15350,7 +15946,7 @@ type DeleteCarInspectionDeregistrationFilesResponse struct {
 
 func (x *DeleteCarInspectionDeregistrationFilesResponse) Reset() {
 	*x = DeleteCarInspectionDeregistrationFilesResponse{}
-	mi := &file_service_proto_msgTypes[229]
+	mi := &file_service_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15362,7 +15958,7 @@ func (x *DeleteCarInspectionDeregistrationFilesResponse) String() string {
 func (*DeleteCarInspectionDeregistrationFilesResponse) ProtoMessage() {}
 
 func (x *DeleteCarInspectionDeregistrationFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[229]
+	mi := &file_service_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15375,7 +15971,7 @@ func (x *DeleteCarInspectionDeregistrationFilesResponse) ProtoReflect() protoref
 
 // Deprecated: Use DeleteCarInspectionDeregistrationFilesResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCarInspectionDeregistrationFilesResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{229}
+	return file_service_proto_rawDescGZIP(), []int{230}
 }
 
 func (x *DeleteCarInspectionDeregistrationFilesResponse) GetSuccess() bool {
@@ -15395,7 +15991,7 @@ type ListCarInspectionDeregistrationFilessRequest struct {
 
 func (x *ListCarInspectionDeregistrationFilessRequest) Reset() {
 	*x = ListCarInspectionDeregistrationFilessRequest{}
-	mi := &file_service_proto_msgTypes[230]
+	mi := &file_service_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15407,7 +16003,7 @@ func (x *ListCarInspectionDeregistrationFilessRequest) String() string {
 func (*ListCarInspectionDeregistrationFilessRequest) ProtoMessage() {}
 
 func (x *ListCarInspectionDeregistrationFilessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[230]
+	mi := &file_service_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15420,7 +16016,7 @@ func (x *ListCarInspectionDeregistrationFilessRequest) ProtoReflect() protorefle
 
 // Deprecated: Use ListCarInspectionDeregistrationFilessRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionDeregistrationFilessRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{230}
+	return file_service_proto_rawDescGZIP(), []int{231}
 }
 
 func (x *ListCarInspectionDeregistrationFilessRequest) GetPageSize() int32 {
@@ -15447,7 +16043,7 @@ type ListCarInspectionDeregistrationFilessResponse struct {
 
 func (x *ListCarInspectionDeregistrationFilessResponse) Reset() {
 	*x = ListCarInspectionDeregistrationFilessResponse{}
-	mi := &file_service_proto_msgTypes[231]
+	mi := &file_service_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15459,7 +16055,7 @@ func (x *ListCarInspectionDeregistrationFilessResponse) String() string {
 func (*ListCarInspectionDeregistrationFilessResponse) ProtoMessage() {}
 
 func (x *ListCarInspectionDeregistrationFilessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[231]
+	mi := &file_service_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15472,7 +16068,7 @@ func (x *ListCarInspectionDeregistrationFilessResponse) ProtoReflect() protorefl
 
 // Deprecated: Use ListCarInspectionDeregistrationFilessResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionDeregistrationFilessResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{231}
+	return file_service_proto_rawDescGZIP(), []int{232}
 }
 
 func (x *ListCarInspectionDeregistrationFilessResponse) GetCarInspectionDeregistrationFiless() []*CarInspectionDeregistrationFiles {
@@ -15500,7 +16096,7 @@ type ListCarInspectionDeregistrationFilessByOrganizationRequest struct {
 
 func (x *ListCarInspectionDeregistrationFilessByOrganizationRequest) Reset() {
 	*x = ListCarInspectionDeregistrationFilessByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[232]
+	mi := &file_service_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15512,7 +16108,7 @@ func (x *ListCarInspectionDeregistrationFilessByOrganizationRequest) String() st
 func (*ListCarInspectionDeregistrationFilessByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListCarInspectionDeregistrationFilessByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[232]
+	mi := &file_service_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15525,7 +16121,7 @@ func (x *ListCarInspectionDeregistrationFilessByOrganizationRequest) ProtoReflec
 
 // Deprecated: Use ListCarInspectionDeregistrationFilessByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionDeregistrationFilessByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{232}
+	return file_service_proto_rawDescGZIP(), []int{233}
 }
 
 func (x *ListCarInspectionDeregistrationFilessByOrganizationRequest) GetOrganizationId() string {
@@ -15559,7 +16155,7 @@ type ListCarInspectionDeregistrationFilessByOrganizationResponse struct {
 
 func (x *ListCarInspectionDeregistrationFilessByOrganizationResponse) Reset() {
 	*x = ListCarInspectionDeregistrationFilessByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[233]
+	mi := &file_service_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15571,7 +16167,7 @@ func (x *ListCarInspectionDeregistrationFilessByOrganizationResponse) String() s
 func (*ListCarInspectionDeregistrationFilessByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListCarInspectionDeregistrationFilessByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[233]
+	mi := &file_service_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15584,7 +16180,7 @@ func (x *ListCarInspectionDeregistrationFilessByOrganizationResponse) ProtoRefle
 
 // Deprecated: Use ListCarInspectionDeregistrationFilessByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInspectionDeregistrationFilessByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{233}
+	return file_service_proto_rawDescGZIP(), []int{234}
 }
 
 func (x *ListCarInspectionDeregistrationFilessByOrganizationResponse) GetCarInspectionDeregistrationFiless() []*CarInspectionDeregistrationFiles {
@@ -15610,13 +16206,14 @@ type CarInsSheetIchibanCars struct {
 	ElectCertPublishdateY string                 `protobuf:"bytes,5,opt,name=elect_cert_publishdate_y,json=electCertPublishdateY,proto3" json:"elect_cert_publishdate_y,omitempty"`
 	ElectCertPublishdateM string                 `protobuf:"bytes,6,opt,name=elect_cert_publishdate_m,json=electCertPublishdateM,proto3" json:"elect_cert_publishdate_m,omitempty"`
 	ElectCertPublishdateD string                 `protobuf:"bytes,7,opt,name=elect_cert_publishdate_d,json=electCertPublishdateD,proto3" json:"elect_cert_publishdate_d,omitempty"`
+	Etag                  string                 `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateCarInsSheetIchibanCarsRequest.etag
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *CarInsSheetIchibanCars) Reset() {
 	*x = CarInsSheetIchibanCars{}
-	mi := &file_service_proto_msgTypes[234]
+	mi := &file_service_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15628,7 +16225,7 @@ func (x *CarInsSheetIchibanCars) String() string {
 func (*CarInsSheetIchibanCars) ProtoMessage() {}
 
 func (x *CarInsSheetIchibanCars) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[234]
+	mi := &file_service_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15641,7 +16238,7 @@ func (x *CarInsSheetIchibanCars) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CarInsSheetIchibanCars.ProtoReflect.Descriptor instead.
 func (*CarInsSheetIchibanCars) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{234}
+	return file_service_proto_rawDescGZIP(), []int{235}
 }
 
 func (x *CarInsSheetIchibanCars) GetOrganizationId() string {
@@ -15693,6 +16290,13 @@ func (x *CarInsSheetIchibanCars) GetElectCertPublishdateD() string {
 	return ""
 }
 
+func (x *CarInsSheetIchibanCars) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateCarInsSheetIchibanCarsRequest struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId        string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -15708,7 +16312,7 @@ type CreateCarInsSheetIchibanCarsRequest struct {
 
 func (x *CreateCarInsSheetIchibanCarsRequest) Reset() {
 	*x = CreateCarInsSheetIchibanCarsRequest{}
-	mi := &file_service_proto_msgTypes[235]
+	mi := &file_service_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15720,7 +16324,7 @@ func (x *CreateCarInsSheetIchibanCarsRequest) String() string {
 func (*CreateCarInsSheetIchibanCarsRequest) ProtoMessage() {}
 
 func (x *CreateCarInsSheetIchibanCarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[235]
+	mi := &file_service_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15733,7 +16337,7 @@ func (x *CreateCarInsSheetIchibanCarsRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use CreateCarInsSheetIchibanCarsRequest.ProtoReflect.Descriptor instead.
 func (*CreateCarInsSheetIchibanCarsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{235}
+	return file_service_proto_rawDescGZIP(), []int{236}
 }
 
 func (x *CreateCarInsSheetIchibanCarsRequest) GetOrganizationId() string {
@@ -15794,7 +16398,7 @@ type CreateCarInsSheetIchibanCarsResponse struct {
 
 func (x *CreateCarInsSheetIchibanCarsResponse) Reset() {
 	*x = CreateCarInsSheetIchibanCarsResponse{}
-	mi := &file_service_proto_msgTypes[236]
+	mi := &file_service_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15806,7 +16410,7 @@ func (x *CreateCarInsSheetIchibanCarsResponse) String() string {
 func (*CreateCarInsSheetIchibanCarsResponse) ProtoMessage() {}
 
 func (x *CreateCarInsSheetIchibanCarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[236]
+	mi := &file_service_proto_msgTypes[237]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15819,7 +16423,7 @@ func (x *CreateCarInsSheetIchibanCarsResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use CreateCarInsSheetIchibanCarsResponse.ProtoReflect.Descriptor instead.
 func (*CreateCarInsSheetIchibanCarsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{236}
+	return file_service_proto_rawDescGZIP(), []int{237}
 }
 
 func (x *CreateCarInsSheetIchibanCarsResponse) GetCarInsSheetIchibanCars() *CarInsSheetIchibanCars {
@@ -15843,7 +16447,7 @@ type GetCarInsSheetIchibanCarsRequest struct {
 
 func (x *GetCarInsSheetIchibanCarsRequest) Reset() {
 	*x = GetCarInsSheetIchibanCarsRequest{}
-	mi := &file_service_proto_msgTypes[237]
+	mi := &file_service_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15855,7 +16459,7 @@ func (x *GetCarInsSheetIchibanCarsRequest) String() string {
 func (*GetCarInsSheetIchibanCarsRequest) ProtoMessage() {}
 
 func (x *GetCarInsSheetIchibanCarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[237]
+	mi := &file_service_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15868,7 +16472,7 @@ func (x *GetCarInsSheetIchibanCarsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCarInsSheetIchibanCarsRequest.ProtoReflect.Descriptor instead.
 func (*GetCarInsSheetIchibanCarsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{237}
+	return file_service_proto_rawDescGZIP(), []int{238}
 }
 
 func (x *GetCarInsSheetIchibanCarsRequest) GetOrganizationId() string {
@@ -15922,7 +16526,7 @@ type GetCarInsSheetIchibanCarsResponse struct {
 
 func (x *GetCarInsSheetIchibanCarsResponse) Reset() {
 	*x = GetCarInsSheetIchibanCarsResponse{}
-	mi := &file_service_proto_msgTypes[238]
+	mi := &file_service_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15934,7 +16538,7 @@ func (x *GetCarInsSheetIchibanCarsResponse) String() string {
 func (*GetCarInsSheetIchibanCarsResponse) ProtoMessage() {}
 
 func (x *GetCarInsSheetIchibanCarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[238]
+	mi := &file_service_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15947,7 +16551,7 @@ func (x *GetCarInsSheetIchibanCarsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetCarInsSheetIchibanCarsResponse.ProtoReflect.Descriptor instead.
 func (*GetCarInsSheetIchibanCarsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{238}
+	return file_service_proto_rawDescGZIP(), []int{239}
 }
 
 func (x *GetCarInsSheetIchibanCarsResponse) GetCarInsSheetIchibanCars() *CarInsSheetIchibanCars {
@@ -15966,13 +16570,14 @@ type UpdateCarInsSheetIchibanCarsRequest struct {
 	ElectCertPublishdateM string                 `protobuf:"bytes,5,opt,name=elect_cert_publishdate_m,json=electCertPublishdateM,proto3" json:"elect_cert_publishdate_m,omitempty"`
 	ElectCertPublishdateD string                 `protobuf:"bytes,6,opt,name=elect_cert_publishdate_d,json=electCertPublishdateD,proto3" json:"elect_cert_publishdate_d,omitempty"`
 	IdCars                *string                `protobuf:"bytes,7,opt,name=id_cars,json=idCars,proto3,oneof" json:"id_cars,omitempty"`
+	Etag                  string                 `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *UpdateCarInsSheetIchibanCarsRequest) Reset() {
 	*x = UpdateCarInsSheetIchibanCarsRequest{}
-	mi := &file_service_proto_msgTypes[239]
+	mi := &file_service_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15984,7 +16589,7 @@ func (x *UpdateCarInsSheetIchibanCarsRequest) String() string {
 func (*UpdateCarInsSheetIchibanCarsRequest) ProtoMessage() {}
 
 func (x *UpdateCarInsSheetIchibanCarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[239]
+	mi := &file_service_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15997,7 +16602,7 @@ func (x *UpdateCarInsSheetIchibanCarsRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UpdateCarInsSheetIchibanCarsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCarInsSheetIchibanCarsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{239}
+	return file_service_proto_rawDescGZIP(), []int{240}
 }
 
 func (x *UpdateCarInsSheetIchibanCarsRequest) GetOrganizationId() string {
@@ -16049,6 +16654,13 @@ func (x *UpdateCarInsSheetIchibanCarsRequest) GetIdCars() string {
 	return ""
 }
 
+func (x *UpdateCarInsSheetIchibanCarsRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateCarInsSheetIchibanCarsResponse struct {
 	state                  protoimpl.MessageState  `protogen:"open.v1"`
 	CarInsSheetIchibanCars *CarInsSheetIchibanCars `protobuf:"bytes,1,opt,name=car_ins_sheet_ichiban_cars,json=carInsSheetIchibanCars,proto3" json:"car_ins_sheet_ichiban_cars,omitempty"`
@@ -16058,7 +16670,7 @@ type UpdateCarInsSheetIchibanCarsResponse struct {
 
 func (x *UpdateCarInsSheetIchibanCarsResponse) Reset() {
 	*x = UpdateCarInsSheetIchibanCarsResponse{}
-	mi := &file_service_proto_msgTypes[240]
+	mi := &file_service_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16070,7 +16682,7 @@ func (x *UpdateCarInsSheetIchibanCarsResponse) String() string {
 func (*UpdateCarInsSheetIchibanCarsResponse) ProtoMessage() {}
 
 func (x *UpdateCarInsSheetIchibanCarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[240]
+	mi := &file_service_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16083,7 +16695,7 @@ func (x *UpdateCarInsSheetIchibanCarsResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use UpdateCarInsSheetIchibanCarsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCarInsSheetIchibanCarsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{240}
+	return file_service_proto_rawDescGZIP(), []int{241}
 }
 
 func (x *UpdateCarInsSheetIchibanCarsResponse) GetCarInsSheetIchibanCars() *CarInsSheetIchibanCars {
@@ -16101,13 +16713,14 @@ type DeleteCarInsSheetIchibanCarsRequest struct {
 	ElectCertPublishdateY string                 `protobuf:"bytes,4,opt,name=elect_cert_publishdate_y,json=electCertPublishdateY,proto3" json:"elect_cert_publishdate_y,omitempty"`
 	ElectCertPublishdateM string                 `protobuf:"bytes,5,opt,name=elect_cert_publishdate_m,json=electCertPublishdateM,proto3" json:"elect_cert_publishdate_m,omitempty"`
 	ElectCertPublishdateD string                 `protobuf:"bytes,6,opt,name=elect_cert_publishdate_d,json=electCertPublishdateD,proto3" json:"elect_cert_publishdate_d,omitempty"`
+	Etag                  string                 `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *DeleteCarInsSheetIchibanCarsRequest) Reset() {
 	*x = DeleteCarInsSheetIchibanCarsRequest{}
-	mi := &file_service_proto_msgTypes[241]
+	mi := &file_service_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16119,7 +16732,7 @@ func (x *DeleteCarInsSheetIchibanCarsRequest) String() string {
 func (*DeleteCarInsSheetIchibanCarsRequest) ProtoMessage() {}
 
 func (x *DeleteCarInsSheetIchibanCarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[241]
+	mi := &file_service_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16132,7 +16745,7 @@ func (x *DeleteCarInsSheetIchibanCarsRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use DeleteCarInsSheetIchibanCarsRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCarInsSheetIchibanCarsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{241}
+	return file_service_proto_rawDescGZIP(), []int{242}
 }
 
 func (x *DeleteCarInsSheetIchibanCarsRequest) GetOrganizationId() string {
@@ -16177,6 +16790,13 @@ func (x *DeleteCarInsSheetIchibanCarsRequest) GetElectCertPublishdateD() string
 	return ""
 }
 
+func (x *DeleteCarInsSheetIchibanCarsRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteCarInsSheetIchibanCarsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -16186,7 +16806,7 @@ type DeleteCarInsSheetIchibanCarsResponse struct {
 
 func (x *DeleteCarInsSheetIchibanCarsResponse) Reset() {
 	*x = DeleteCarInsSheetIchibanCarsResponse{}
-	mi := &file_service_proto_msgTypes[242]
+	mi := &file_service_proto_msgTypes[243]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16198,7 +16818,7 @@ func (x *DeleteCarInsSheetIchibanCarsResponse) String() string {
 func (*DeleteCarInsSheetIchibanCarsResponse) ProtoMessage() {}
 
 func (x *DeleteCarInsSheetIchibanCarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[242]
+	mi := &file_service_proto_msgTypes[243]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16211,7 +16831,7 @@ func (x *DeleteCarInsSheetIchibanCarsResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use DeleteCarInsSheetIchibanCarsResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCarInsSheetIchibanCarsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{242}
+	return file_service_proto_rawDescGZIP(), []int{243}
 }
 
 func (x *DeleteCarInsSheetIchibanCarsResponse) GetSuccess() bool {
@@ -16231,7 +16851,7 @@ type ListCarInsSheetIchibanCarssRequest struct {
 
 func (x *ListCarInsSheetIchibanCarssRequest) Reset() {
 	*x = ListCarInsSheetIchibanCarssRequest{}
-	mi := &file_service_proto_msgTypes[243]
+	mi := &file_service_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16243,7 +16863,7 @@ func (x *ListCarInsSheetIchibanCarssRequest) String() string {
 func (*ListCarInsSheetIchibanCarssRequest) ProtoMessage() {}
 
 func (x *ListCarInsSheetIchibanCarssRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[243]
+	mi := &file_service_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16256,7 +16876,7 @@ func (x *ListCarInsSheetIchibanCarssRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListCarInsSheetIchibanCarssRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInsSheetIchibanCarssRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{243}
+	return file_service_proto_rawDescGZIP(), []int{244}
 }
 
 func (x *ListCarInsSheetIchibanCarssRequest) GetPageSize() int32 {
@@ -16283,7 +16903,7 @@ type ListCarInsSheetIchibanCarssResponse struct {
 
 func (x *ListCarInsSheetIchibanCarssResponse) Reset() {
 	*x = ListCarInsSheetIchibanCarssResponse{}
-	mi := &file_service_proto_msgTypes[244]
+	mi := &file_service_proto_msgTypes[245]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16295,7 +16915,7 @@ func (x *ListCarInsSheetIchibanCarssResponse) String() string {
 func (*ListCarInsSheetIchibanCarssResponse) ProtoMessage() {}
 
 func (x *ListCarInsSheetIchibanCarssResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[244]
+	mi := &file_service_proto_msgTypes[245]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16308,7 +16928,7 @@ func (x *ListCarInsSheetIchibanCarssResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ListCarInsSheetIchibanCarssResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInsSheetIchibanCarssResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{244}
+	return file_service_proto_rawDescGZIP(), []int{245}
 }
 
 func (x *ListCarInsSheetIchibanCarssResponse) GetCarInsSheetIchibanCarss() []*CarInsSheetIchibanCars {
@@ -16336,7 +16956,7 @@ type ListCarInsSheetIchibanCarssByOrganizationRequest struct {
 
 func (x *ListCarInsSheetIchibanCarssByOrganizationRequest) Reset() {
 	*x = ListCarInsSheetIchibanCarssByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[245]
+	mi := &file_service_proto_msgTypes[246]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16348,7 +16968,7 @@ func (x *ListCarInsSheetIchibanCarssByOrganizationRequest) String() string {
 func (*ListCarInsSheetIchibanCarssByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListCarInsSheetIchibanCarssByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[245]
+	mi := &file_service_proto_msgTypes[246]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16361,7 +16981,7 @@ func (x *ListCarInsSheetIchibanCarssByOrganizationRequest) ProtoReflect() protor
 
 // Deprecated: Use ListCarInsSheetIchibanCarssByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInsSheetIchibanCarssByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{245}
+	return file_service_proto_rawDescGZIP(), []int{246}
 }
 
 func (x *ListCarInsSheetIchibanCarssByOrganizationRequest) GetOrganizationId() string {
@@ -16395,7 +17015,7 @@ type ListCarInsSheetIchibanCarssByOrganizationResponse struct {
 
 func (x *ListCarInsSheetIchibanCarssByOrganizationResponse) Reset() {
 	*x = ListCarInsSheetIchibanCarssByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[246]
+	mi := &file_service_proto_msgTypes[247]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16407,7 +17027,7 @@ func (x *ListCarInsSheetIchibanCarssByOrganizationResponse) String() string {
 func (*ListCarInsSheetIchibanCarssByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListCarInsSheetIchibanCarssByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[246]
+	mi := &file_service_proto_msgTypes[247]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16420,7 +17040,7 @@ func (x *ListCarInsSheetIchibanCarssByOrganizationResponse) ProtoReflect() proto
 
 // Deprecated: Use ListCarInsSheetIchibanCarssByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInsSheetIchibanCarssByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{246}
+	return file_service_proto_rawDescGZIP(), []int{247}
 }
 
 func (x *ListCarInsSheetIchibanCarssByOrganizationResponse) GetCarInsSheetIchibanCarss() []*CarInsSheetIchibanCars {
@@ -16446,13 +17066,14 @@ type CarInsSheetIchibanCarsA struct {
 	GrantdateY     string                 `protobuf:"bytes,5,opt,name=grantdate_y,json=grantdateY,proto3" json:"grantdate_y,omitempty"`
 	GrantdateM     string                 `protobuf:"bytes,6,opt,name=grantdate_m,json=grantdateM,proto3" json:"grantdate_m,omitempty"`
 	GrantdateD     string                 `protobuf:"bytes,7,opt,name=grantdate_d,json=grantdateD,proto3" json:"grantdate_d,omitempty"`
+	Etag           string                 `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateCarInsSheetIchibanCarsARequest.etag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CarInsSheetIchibanCarsA) Reset() {
 	*x = CarInsSheetIchibanCarsA{}
-	mi := &file_service_proto_msgTypes[247]
+	mi := &file_service_proto_msgTypes[248]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16464,7 +17085,7 @@ func (x *CarInsSheetIchibanCarsA) String() string {
 func (*CarInsSheetIchibanCarsA) ProtoMessage() {}
 
 func (x *CarInsSheetIchibanCarsA) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[247]
+	mi := &file_service_proto_msgTypes[248]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16477,7 +17098,7 @@ func (x *CarInsSheetIchibanCarsA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CarInsSheetIchibanCarsA.ProtoReflect.Descriptor instead.
 func (*CarInsSheetIchibanCarsA) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{247}
+	return file_service_proto_rawDescGZIP(), []int{248}
 }
 
 func (x *CarInsSheetIchibanCarsA) GetOrganizationId() string {
@@ -16529,6 +17150,13 @@ func (x *CarInsSheetIchibanCarsA) GetGrantdateD() string {
 	return ""
 }
 
+func (x *CarInsSheetIchibanCarsA) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateCarInsSheetIchibanCarsARequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -16544,7 +17172,7 @@ type CreateCarInsSheetIchibanCarsARequest struct {
 
 func (x *CreateCarInsSheetIchibanCarsARequest) Reset() {
 	*x = CreateCarInsSheetIchibanCarsARequest{}
-	mi := &file_service_proto_msgTypes[248]
+	mi := &file_service_proto_msgTypes[249]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16556,7 +17184,7 @@ func (x *CreateCarInsSheetIchibanCarsARequest) String() string {
 func (*CreateCarInsSheetIchibanCarsARequest) ProtoMessage() {}
 
 func (x *CreateCarInsSheetIchibanCarsARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[248]
+	mi := &file_service_proto_msgTypes[249]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16569,7 +17197,7 @@ func (x *CreateCarInsSheetIchibanCarsARequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use CreateCarInsSheetIchibanCarsARequest.ProtoReflect.Descriptor instead.
 func (*CreateCarInsSheetIchibanCarsARequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{248}
+	return file_service_proto_rawDescGZIP(), []int{249}
 }
 
 func (x *CreateCarInsSheetIchibanCarsARequest) GetOrganizationId() string {
@@ -16630,7 +17258,7 @@ type CreateCarInsSheetIchibanCarsAResponse struct {
 
 func (x *CreateCarInsSheetIchibanCarsAResponse) Reset() {
 	*x = CreateCarInsSheetIchibanCarsAResponse{}
-	mi := &file_service_proto_msgTypes[249]
+	mi := &file_service_proto_msgTypes[250]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16642,7 +17270,7 @@ func (x *CreateCarInsSheetIchibanCarsAResponse) String() string {
 func (*CreateCarInsSheetIchibanCarsAResponse) ProtoMessage() {}
 
 func (x *CreateCarInsSheetIchibanCarsAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[249]
+	mi := &file_service_proto_msgTypes[250]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16655,7 +17283,7 @@ func (x *CreateCarInsSheetIchibanCarsAResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use CreateCarInsSheetIchibanCarsAResponse.ProtoReflect.Descriptor instead.
 func (*CreateCarInsSheetIchibanCarsAResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{249}
+	return file_service_proto_rawDescGZIP(), []int{250}
 }
 
 func (x *CreateCarInsSheetIchibanCarsAResponse) GetCarInsSheetIchibanCarsA() *CarInsSheetIchibanCarsA {
@@ -16679,7 +17307,7 @@ type GetCarInsSheetIchibanCarsARequest struct {
 
 func (x *GetCarInsSheetIchibanCarsARequest) Reset() {
 	*x = GetCarInsSheetIchibanCarsARequest{}
-	mi := &file_service_proto_msgTypes[250]
+	mi := &file_service_proto_msgTypes[251]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16691,7 +17319,7 @@ func (x *GetCarInsSheetIchibanCarsARequest) String() string {
 func (*GetCarInsSheetIchibanCarsARequest) ProtoMessage() {}
 
 func (x *GetCarInsSheetIchibanCarsARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[250]
+	mi := &file_service_proto_msgTypes[251]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16704,7 +17332,7 @@ func (x *GetCarInsSheetIchibanCarsARequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetCarInsSheetIchibanCarsARequest.ProtoReflect.Descriptor instead.
 func (*GetCarInsSheetIchibanCarsARequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{250}
+	return file_service_proto_rawDescGZIP(), []int{251}
 }
 
 func (x *GetCarInsSheetIchibanCarsARequest) GetOrganizationId() string {
@@ -16758,7 +17386,7 @@ type GetCarInsSheetIchibanCarsAResponse struct {
 
 func (x *GetCarInsSheetIchibanCarsAResponse) Reset() {
 	*x = GetCarInsSheetIchibanCarsAResponse{}
-	mi := &file_service_proto_msgTypes[251]
+	mi := &file_service_proto_msgTypes[252]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16770,7 +17398,7 @@ func (x *GetCarInsSheetIchibanCarsAResponse) String() string {
 func (*GetCarInsSheetIchibanCarsAResponse) ProtoMessage() {}
 
 func (x *GetCarInsSheetIchibanCarsAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[251]
+	mi := &file_service_proto_msgTypes[252]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16783,7 +17411,7 @@ func (x *GetCarInsSheetIchibanCarsAResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetCarInsSheetIchibanCarsAResponse.ProtoReflect.Descriptor instead.
 func (*GetCarInsSheetIchibanCarsAResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{251}
+	return file_service_proto_rawDescGZIP(), []int{252}
 }
 
 func (x *GetCarInsSheetIchibanCarsAResponse) GetCarInsSheetIchibanCarsA() *CarInsSheetIchibanCarsA {
@@ -16802,13 +17430,14 @@ type UpdateCarInsSheetIchibanCarsARequest struct {
 	GrantdateM     string                 `protobuf:"bytes,5,opt,name=grantdate_m,json=grantdateM,proto3" json:"grantdate_m,omitempty"`
 	GrantdateD     string                 `protobuf:"bytes,6,opt,name=grantdate_d,json=grantdateD,proto3" json:"grantdate_d,omitempty"`
 	IdCars         *string                `protobuf:"bytes,7,opt,name=id_cars,json=idCars,proto3,oneof" json:"id_cars,omitempty"`
+	Etag           string                 `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateCarInsSheetIchibanCarsARequest) Reset() {
 	*x = UpdateCarInsSheetIchibanCarsARequest{}
-	mi := &file_service_proto_msgTypes[252]
+	mi := &file_service_proto_msgTypes[253]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16820,7 +17449,7 @@ func (x *UpdateCarInsSheetIchibanCarsARequest) String() string {
 func (*UpdateCarInsSheetIchibanCarsARequest) ProtoMessage() {}
 
 func (x *UpdateCarInsSheetIchibanCarsARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[252]
+	mi := &file_service_proto_msgTypes[253]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16833,7 +17462,7 @@ func (x *UpdateCarInsSheetIchibanCarsARequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use UpdateCarInsSheetIchibanCarsARequest.ProtoReflect.Descriptor instead.
 func (*UpdateCarInsSheetIchibanCarsARequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{252}
+	return file_service_proto_rawDescGZIP(), []int{253}
 }
 
 func (x *UpdateCarInsSheetIchibanCarsARequest) GetOrganizationId() string {
@@ -16885,6 +17514,13 @@ func (x *UpdateCarInsSheetIchibanCarsARequest) GetIdCars() string {
 	return ""
 }
 
+func (x *UpdateCarInsSheetIchibanCarsARequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateCarInsSheetIchibanCarsAResponse struct {
 	state                   protoimpl.MessageState   `protogen:"open.v1"`
 	CarInsSheetIchibanCarsA *CarInsSheetIchibanCarsA `protobuf:"bytes,1,opt,name=car_ins_sheet_ichiban_cars_a,json=carInsSheetIchibanCarsA,proto3" json:"car_ins_sheet_ichiban_cars_a,omitempty"`
@@ -16894,7 +17530,7 @@ type UpdateCarInsSheetIchibanCarsAResponse struct {
 
 func (x *UpdateCarInsSheetIchibanCarsAResponse) Reset() {
 	*x = UpdateCarInsSheetIchibanCarsAResponse{}
-	mi := &file_service_proto_msgTypes[253]
+	mi := &file_service_proto_msgTypes[254]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16906,7 +17542,7 @@ func (x *UpdateCarInsSheetIchibanCarsAResponse) String() string {
 func (*UpdateCarInsSheetIchibanCarsAResponse) ProtoMessage() {}
 
 func (x *UpdateCarInsSheetIchibanCarsAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[253]
+	mi := &file_service_proto_msgTypes[254]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16919,7 +17555,7 @@ func (x *UpdateCarInsSheetIchibanCarsAResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use UpdateCarInsSheetIchibanCarsAResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCarInsSheetIchibanCarsAResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{253}
+	return file_service_proto_rawDescGZIP(), []int{254}
 }
 
 func (x *UpdateCarInsSheetIchibanCarsAResponse) GetCarInsSheetIchibanCarsA() *CarInsSheetIchibanCarsA {
@@ -16937,13 +17573,14 @@ type DeleteCarInsSheetIchibanCarsARequest struct {
 	GrantdateY     string                 `protobuf:"bytes,4,opt,name=grantdate_y,json=grantdateY,proto3" json:"grantdate_y,omitempty"`
 	GrantdateM     string                 `protobuf:"bytes,5,opt,name=grantdate_m,json=grantdateM,proto3" json:"grantdate_m,omitempty"`
 	GrantdateD     string                 `protobuf:"bytes,6,opt,name=grantdate_d,json=grantdateD,proto3" json:"grantdate_d,omitempty"`
+	Etag           string                 `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DeleteCarInsSheetIchibanCarsARequest) Reset() {
 	*x = DeleteCarInsSheetIchibanCarsARequest{}
-	mi := &file_service_proto_msgTypes[254]
+	mi := &file_service_proto_msgTypes[255]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -16955,7 +17592,7 @@ func (x *DeleteCarInsSheetIchibanCarsARequest) String() string {
 func (*DeleteCarInsSheetIchibanCarsARequest) ProtoMessage() {}
 
 func (x *DeleteCarInsSheetIchibanCarsARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[254]
+	mi := &file_service_proto_msgTypes[255]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16968,7 +17605,7 @@ func (x *DeleteCarInsSheetIchibanCarsARequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use DeleteCarInsSheetIchibanCarsARequest.ProtoReflect.Descriptor instead.
 func (*DeleteCarInsSheetIchibanCarsARequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{254}
+	return file_service_proto_rawDescGZIP(), []int{255}
 }
 
 func (x *DeleteCarInsSheetIchibanCarsARequest) GetOrganizationId() string {
@@ -17013,6 +17650,13 @@ func (x *DeleteCarInsSheetIchibanCarsARequest) GetGrantdateD() string {
 	return ""
 }
 
+func (x *DeleteCarInsSheetIchibanCarsARequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteCarInsSheetIchibanCarsAResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -17022,7 +17666,7 @@ type DeleteCarInsSheetIchibanCarsAResponse struct {
 
 func (x *DeleteCarInsSheetIchibanCarsAResponse) Reset() {
 	*x = DeleteCarInsSheetIchibanCarsAResponse{}
-	mi := &file_service_proto_msgTypes[255]
+	mi := &file_service_proto_msgTypes[256]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17034,7 +17678,7 @@ func (x *DeleteCarInsSheetIchibanCarsAResponse) String() string {
 func (*DeleteCarInsSheetIchibanCarsAResponse) ProtoMessage() {}
 
 func (x *DeleteCarInsSheetIchibanCarsAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[255]
+	mi := &file_service_proto_msgTypes[256]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17047,7 +17691,7 @@ func (x *DeleteCarInsSheetIchibanCarsAResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use DeleteCarInsSheetIchibanCarsAResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCarInsSheetIchibanCarsAResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{255}
+	return file_service_proto_rawDescGZIP(), []int{256}
 }
 
 func (x *DeleteCarInsSheetIchibanCarsAResponse) GetSuccess() bool {
@@ -17067,7 +17711,7 @@ type ListCarInsSheetIchibanCarsAsRequest struct {
 
 func (x *ListCarInsSheetIchibanCarsAsRequest) Reset() {
 	*x = ListCarInsSheetIchibanCarsAsRequest{}
-	mi := &file_service_proto_msgTypes[256]
+	mi := &file_service_proto_msgTypes[257]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17079,7 +17723,7 @@ func (x *ListCarInsSheetIchibanCarsAsRequest) String() string {
 func (*ListCarInsSheetIchibanCarsAsRequest) ProtoMessage() {}
 
 func (x *ListCarInsSheetIchibanCarsAsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[256]
+	mi := &file_service_proto_msgTypes[257]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17092,7 +17736,7 @@ func (x *ListCarInsSheetIchibanCarsAsRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ListCarInsSheetIchibanCarsAsRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInsSheetIchibanCarsAsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{256}
+	return file_service_proto_rawDescGZIP(), []int{257}
 }
 
 func (x *ListCarInsSheetIchibanCarsAsRequest) GetPageSize() int32 {
@@ -17119,7 +17763,7 @@ type ListCarInsSheetIchibanCarsAsResponse struct {
 
 func (x *ListCarInsSheetIchibanCarsAsResponse) Reset() {
 	*x = ListCarInsSheetIchibanCarsAsResponse{}
-	mi := &file_service_proto_msgTypes[257]
+	mi := &file_service_proto_msgTypes[258]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17131,7 +17775,7 @@ func (x *ListCarInsSheetIchibanCarsAsResponse) String() string {
 func (*ListCarInsSheetIchibanCarsAsResponse) ProtoMessage() {}
 
 func (x *ListCarInsSheetIchibanCarsAsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[257]
+	mi := &file_service_proto_msgTypes[258]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17144,7 +17788,7 @@ func (x *ListCarInsSheetIchibanCarsAsResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ListCarInsSheetIchibanCarsAsResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInsSheetIchibanCarsAsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{257}
+	return file_service_proto_rawDescGZIP(), []int{258}
 }
 
 func (x *ListCarInsSheetIchibanCarsAsResponse) GetCarInsSheetIchibanCarsAs() []*CarInsSheetIchibanCarsA {
@@ -17172,7 +17816,7 @@ type ListCarInsSheetIchibanCarsAsByOrganizationRequest struct {
 
 func (x *ListCarInsSheetIchibanCarsAsByOrganizationRequest) Reset() {
 	*x = ListCarInsSheetIchibanCarsAsByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[258]
+	mi := &file_service_proto_msgTypes[259]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17184,7 +17828,7 @@ func (x *ListCarInsSheetIchibanCarsAsByOrganizationRequest) String() string {
 func (*ListCarInsSheetIchibanCarsAsByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListCarInsSheetIchibanCarsAsByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[258]
+	mi := &file_service_proto_msgTypes[259]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17197,7 +17841,7 @@ func (x *ListCarInsSheetIchibanCarsAsByOrganizationRequest) ProtoReflect() proto
 
 // Deprecated: Use ListCarInsSheetIchibanCarsAsByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListCarInsSheetIchibanCarsAsByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{258}
+	return file_service_proto_rawDescGZIP(), []int{259}
 }
 
 func (x *ListCarInsSheetIchibanCarsAsByOrganizationRequest) GetOrganizationId() string {
@@ -17231,7 +17875,7 @@ type ListCarInsSheetIchibanCarsAsByOrganizationResponse struct {
 
 func (x *ListCarInsSheetIchibanCarsAsByOrganizationResponse) Reset() {
 	*x = ListCarInsSheetIchibanCarsAsByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[259]
+	mi := &file_service_proto_msgTypes[260]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17243,7 +17887,7 @@ func (x *ListCarInsSheetIchibanCarsAsByOrganizationResponse) String() string {
 func (*ListCarInsSheetIchibanCarsAsByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListCarInsSheetIchibanCarsAsByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[259]
+	mi := &file_service_proto_msgTypes[260]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17256,7 +17900,7 @@ func (x *ListCarInsSheetIchibanCarsAsByOrganizationResponse) ProtoReflect() prot
 
 // Deprecated: Use ListCarInsSheetIchibanCarsAsByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListCarInsSheetIchibanCarsAsByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{259}
+	return file_service_proto_rawDescGZIP(), []int{260}
 }
 
 func (x *ListCarInsSheetIchibanCarsAsByOrganizationResponse) GetCarInsSheetIchibanCarsAs() []*CarInsSheetIchibanCarsA {
@@ -17304,13 +17948,14 @@ type Kudgfry struct {
 	OwnOtherType              *string                `protobuf:"bytes,27,opt,name=own_other_type,json=ownOtherType,proto3,oneof" json:"own_other_type,omitempty"`
 	Mileage                   *string                `protobuf:"bytes,28,opt,name=mileage,proto3,oneof" json:"mileage,omitempty"`
 	MeterValue                *string                `protobuf:"bytes,29,opt,name=meter_value,json=meterValue,proto3,oneof" json:"meter_value,omitempty"`
+	Etag                      string                 `protobuf:"bytes,30,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateKudgfryRequest.etag
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *Kudgfry) Reset() {
 	*x = Kudgfry{}
-	mi := &file_service_proto_msgTypes[260]
+	mi := &file_service_proto_msgTypes[261]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17322,7 +17967,7 @@ func (x *Kudgfry) String() string {
 func (*Kudgfry) ProtoMessage() {}
 
 func (x *Kudgfry) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[260]
+	mi := &file_service_proto_msgTypes[261]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17335,7 +17980,7 @@ func (x *Kudgfry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Kudgfry.ProtoReflect.Descriptor instead.
 func (*Kudgfry) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{260}
+	return file_service_proto_rawDescGZIP(), []int{261}
 }
 
 func (x *Kudgfry) GetUuid() string {
@@ -17541,6 +18186,13 @@ func (x *Kudgfry) GetMeterValue() string {
 	return ""
 }
 
+func (x *Kudgfry) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateKudgfryRequest struct {
 	state                     protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId            string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -17577,7 +18229,7 @@ type CreateKudgfryRequest struct {
 
 func (x *CreateKudgfryRequest) Reset() {
 	*x = CreateKudgfryRequest{}
-	mi := &file_service_proto_msgTypes[261]
+	mi := &file_service_proto_msgTypes[262]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17589,7 +18241,7 @@ func (x *CreateKudgfryRequest) String() string {
 func (*CreateKudgfryRequest) ProtoMessage() {}
 
 func (x *CreateKudgfryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[261]
+	mi := &file_service_proto_msgTypes[262]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17602,7 +18254,7 @@ func (x *CreateKudgfryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKudgfryRequest.ProtoReflect.Descriptor instead.
 func (*CreateKudgfryRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{261}
+	return file_service_proto_rawDescGZIP(), []int{262}
 }
 
 func (x *CreateKudgfryRequest) GetOrganizationId() string {
@@ -17810,7 +18462,7 @@ type CreateKudgfryResponse struct {
 
 func (x *CreateKudgfryResponse) Reset() {
 	*x = CreateKudgfryResponse{}
-	mi := &file_service_proto_msgTypes[262]
+	mi := &file_service_proto_msgTypes[263]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17822,7 +18474,7 @@ func (x *CreateKudgfryResponse) String() string {
 func (*CreateKudgfryResponse) ProtoMessage() {}
 
 func (x *CreateKudgfryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[262]
+	mi := &file_service_proto_msgTypes[263]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17835,7 +18487,7 @@ func (x *CreateKudgfryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKudgfryResponse.ProtoReflect.Descriptor instead.
 func (*CreateKudgfryResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{262}
+	return file_service_proto_rawDescGZIP(), []int{263}
 }
 
 func (x *CreateKudgfryResponse) GetKudgfry() *Kudgfry {
@@ -17854,7 +18506,7 @@ type GetKudgfryRequest struct {
 
 func (x *GetKudgfryRequest) Reset() {
 	*x = GetKudgfryRequest{}
-	mi := &file_service_proto_msgTypes[263]
+	mi := &file_service_proto_msgTypes[264]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17866,7 +18518,7 @@ func (x *GetKudgfryRequest) String() string {
 func (*GetKudgfryRequest) ProtoMessage() {}
 
 func (x *GetKudgfryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[263]
+	mi := &file_service_proto_msgTypes[264]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17879,7 +18531,7 @@ func (x *GetKudgfryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKudgfryRequest.ProtoReflect.Descriptor instead.
 func (*GetKudgfryRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{263}
+	return file_service_proto_rawDescGZIP(), []int{264}
 }
 
 func (x *GetKudgfryRequest) GetUuid() string {
@@ -17898,7 +18550,7 @@ type GetKudgfryResponse struct {
 
 func (x *GetKudgfryResponse) Reset() {
 	*x = GetKudgfryResponse{}
-	mi := &file_service_proto_msgTypes[264]
+	mi := &file_service_proto_msgTypes[265]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17910,7 +18562,7 @@ func (x *GetKudgfryResponse) String() string {
 func (*GetKudgfryResponse) ProtoMessage() {}
 
 func (x *GetKudgfryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[264]
+	mi := &file_service_proto_msgTypes[265]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17923,7 +18575,7 @@ func (x *GetKudgfryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKudgfryResponse.ProtoReflect.Descriptor instead.
 func (*GetKudgfryResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{264}
+	return file_service_proto_rawDescGZIP(), []int{265}
 }
 
 func (x *GetKudgfryResponse) GetKudgfry() *Kudgfry {
@@ -17964,13 +18616,14 @@ type UpdateKudgfryRequest struct {
 	OwnOtherType              *string                `protobuf:"bytes,27,opt,name=own_other_type,json=ownOtherType,proto3,oneof" json:"own_other_type,omitempty"`
 	Mileage                   *string                `protobuf:"bytes,28,opt,name=mileage,proto3,oneof" json:"mileage,omitempty"`
 	MeterValue                *string                `protobuf:"bytes,29,opt,name=meter_value,json=meterValue,proto3,oneof" json:"meter_value,omitempty"`
+	Etag                      string                 `protobuf:"bytes,30,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *UpdateKudgfryRequest) Reset() {
 	*x = UpdateKudgfryRequest{}
-	mi := &file_service_proto_msgTypes[265]
+	mi := &file_service_proto_msgTypes[266]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -17982,7 +18635,7 @@ func (x *UpdateKudgfryRequest) String() string {
 func (*UpdateKudgfryRequest) ProtoMessage() {}
 
 func (x *UpdateKudgfryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[265]
+	mi := &file_service_proto_msgTypes[266]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17995,7 +18648,7 @@ func (x *UpdateKudgfryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKudgfryRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKudgfryRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{265}
+	return file_service_proto_rawDescGZIP(), []int{266}
 }
 
 func (x *UpdateKudgfryRequest) GetUuid() string {
@@ -18201,6 +18854,13 @@ func (x *UpdateKudgfryRequest) GetMeterValue() string {
 	return ""
 }
 
+func (x *UpdateKudgfryRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateKudgfryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgfry       *Kudgfry               `protobuf:"bytes,1,opt,name=kudgfry,proto3" json:"kudgfry,omitempty"`
@@ -18210,7 +18870,7 @@ type UpdateKudgfryResponse struct {
 
 func (x *UpdateKudgfryResponse) Reset() {
 	*x = UpdateKudgfryResponse{}
-	mi := &file_service_proto_msgTypes[266]
+	mi := &file_service_proto_msgTypes[267]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18222,7 +18882,7 @@ func (x *UpdateKudgfryResponse) String() string {
 func (*UpdateKudgfryResponse) ProtoMessage() {}
 
 func (x *UpdateKudgfryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[266]
+	mi := &file_service_proto_msgTypes[267]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18235,7 +18895,7 @@ func (x *UpdateKudgfryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKudgfryResponse.ProtoReflect.Descriptor instead.
 func (*UpdateKudgfryResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{266}
+	return file_service_proto_rawDescGZIP(), []int{267}
 }
 
 func (x *UpdateKudgfryResponse) GetKudgfry() *Kudgfry {
@@ -18248,13 +18908,14 @@ func (x *UpdateKudgfryResponse) GetKudgfry() *Kudgfry {
 type DeleteKudgfryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteKudgfryRequest) Reset() {
 	*x = DeleteKudgfryRequest{}
-	mi := &file_service_proto_msgTypes[267]
+	mi := &file_service_proto_msgTypes[268]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18266,7 +18927,7 @@ func (x *DeleteKudgfryRequest) String() string {
 func (*DeleteKudgfryRequest) ProtoMessage() {}
 
 func (x *DeleteKudgfryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[267]
+	mi := &file_service_proto_msgTypes[268]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18279,7 +18940,7 @@ func (x *DeleteKudgfryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKudgfryRequest.ProtoReflect.Descriptor instead.
 func (*DeleteKudgfryRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{267}
+	return file_service_proto_rawDescGZIP(), []int{268}
 }
 
 func (x *DeleteKudgfryRequest) GetUuid() string {
@@ -18289,6 +18950,13 @@ func (x *DeleteKudgfryRequest) GetUuid() string {
 	return ""
 }
 
+func (x *DeleteKudgfryRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteKudgfryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -18298,7 +18966,7 @@ type DeleteKudgfryResponse struct {
 
 func (x *DeleteKudgfryResponse) Reset() {
 	*x = DeleteKudgfryResponse{}
-	mi := &file_service_proto_msgTypes[268]
+	mi := &file_service_proto_msgTypes[269]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18310,7 +18978,7 @@ func (x *DeleteKudgfryResponse) String() string {
 func (*DeleteKudgfryResponse) ProtoMessage() {}
 
 func (x *DeleteKudgfryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[268]
+	mi := &file_service_proto_msgTypes[269]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18323,7 +18991,7 @@ func (x *DeleteKudgfryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKudgfryResponse.ProtoReflect.Descriptor instead.
 func (*DeleteKudgfryResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{268}
+	return file_service_proto_rawDescGZIP(), []int{269}
 }
 
 func (x *DeleteKudgfryResponse) GetSuccess() bool {
@@ -18334,16 +19002,20 @@ func (x *DeleteKudgfryResponse) GetSuccess() bool {
 }
 
 type ListKudgfrysRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKudgfrysRequest) Reset() {
 	*x = ListKudgfrysRequest{}
-	mi := &file_service_proto_msgTypes[269]
+	mi := &file_service_proto_msgTypes[270]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18355,7 +19027,7 @@ func (x *ListKudgfrysRequest) String() string {
 func (*ListKudgfrysRequest) ProtoMessage() {}
 
 func (x *ListKudgfrysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[269]
+	mi := &file_service_proto_msgTypes[270]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18368,7 +19040,7 @@ func (x *ListKudgfrysRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKudgfrysRequest.ProtoReflect.Descriptor instead.
 func (*ListKudgfrysRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{269}
+	return file_service_proto_rawDescGZIP(), []int{270}
 }
 
 func (x *ListKudgfrysRequest) GetPageSize() int32 {
@@ -18385,6 +19057,20 @@ func (x *ListKudgfrysRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListKudgfrysRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKudgfrysRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListKudgfrysResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgfrys      []*Kudgfry             `protobuf:"bytes,1,rep,name=kudgfrys,proto3" json:"kudgfrys,omitempty"`
@@ -18395,7 +19081,7 @@ type ListKudgfrysResponse struct {
 
 func (x *ListKudgfrysResponse) Reset() {
 	*x = ListKudgfrysResponse{}
-	mi := &file_service_proto_msgTypes[270]
+	mi := &file_service_proto_msgTypes[271]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18407,7 +19093,7 @@ func (x *ListKudgfrysResponse) String() string {
 func (*ListKudgfrysResponse) ProtoMessage() {}
 
 func (x *ListKudgfrysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[270]
+	mi := &file_service_proto_msgTypes[271]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18420,7 +19106,7 @@ func (x *ListKudgfrysResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKudgfrysResponse.ProtoReflect.Descriptor instead.
 func (*ListKudgfrysResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{270}
+	return file_service_proto_rawDescGZIP(), []int{271}
 }
 
 func (x *ListKudgfrysResponse) GetKudgfrys() []*Kudgfry {
@@ -18442,13 +19128,17 @@ type ListKudgfrysByOrganizationRequest struct {
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKudgfrysByOrganizationRequest) Reset() {
 	*x = ListKudgfrysByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[271]
+	mi := &file_service_proto_msgTypes[272]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18460,7 +19150,7 @@ func (x *ListKudgfrysByOrganizationRequest) String() string {
 func (*ListKudgfrysByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListKudgfrysByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[271]
+	mi := &file_service_proto_msgTypes[272]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18473,7 +19163,7 @@ func (x *ListKudgfrysByOrganizationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListKudgfrysByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListKudgfrysByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{271}
+	return file_service_proto_rawDescGZIP(), []int{272}
 }
 
 func (x *ListKudgfrysByOrganizationRequest) GetOrganizationId() string {
@@ -18497,6 +19187,20 @@ func (x *ListKudgfrysByOrganizationRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListKudgfrysByOrganizationRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKudgfrysByOrganizationRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListKudgfrysByOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgfrys      []*Kudgfry             `protobuf:"bytes,1,rep,name=kudgfrys,proto3" json:"kudgfrys,omitempty"`
@@ -18507,7 +19211,7 @@ type ListKudgfrysByOrganizationResponse struct {
 
 func (x *ListKudgfrysByOrganizationResponse) Reset() {
 	*x = ListKudgfrysByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[272]
+	mi := &file_service_proto_msgTypes[273]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18519,7 +19223,7 @@ func (x *ListKudgfrysByOrganizationResponse) String() string {
 func (*ListKudgfrysByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListKudgfrysByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[272]
+	mi := &file_service_proto_msgTypes[273]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18532,7 +19236,7 @@ func (x *ListKudgfrysByOrganizationResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListKudgfrysByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListKudgfrysByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{272}
+	return file_service_proto_rawDescGZIP(), []int{273}
 }
 
 func (x *ListKudgfrysByOrganizationResponse) GetKudgfrys() []*Kudgfry {
@@ -18591,13 +19295,14 @@ type Kudguri struct {
 	EndGpsLat        *string                `protobuf:"bytes,38,opt,name=end_gps_lat,json=endGpsLat,proto3,oneof" json:"end_gps_lat,omitempty"`
 	EndGpsLng        *string                `protobuf:"bytes,39,opt,name=end_gps_lng,json=endGpsLng,proto3,oneof" json:"end_gps_lng,omitempty"`
 	OverLimitMax     *string                `protobuf:"bytes,40,opt,name=over_limit_max,json=overLimitMax,proto3,oneof" json:"over_limit_max,omitempty"`
+	Etag             string                 `protobuf:"bytes,41,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateKudguriRequest.etag
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Kudguri) Reset() {
 	*x = Kudguri{}
-	mi := &file_service_proto_msgTypes[273]
+	mi := &file_service_proto_msgTypes[274]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18609,7 +19314,7 @@ func (x *Kudguri) String() string {
 func (*Kudguri) ProtoMessage() {}
 
 func (x *Kudguri) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[273]
+	mi := &file_service_proto_msgTypes[274]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18622,7 +19327,7 @@ func (x *Kudguri) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Kudguri.ProtoReflect.Descriptor instead.
 func (*Kudguri) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{273}
+	return file_service_proto_rawDescGZIP(), []int{274}
 }
 
 func (x *Kudguri) GetUuid() string {
@@ -18905,6 +19610,13 @@ func (x *Kudguri) GetOverLimitMax() string {
 	return ""
 }
 
+func (x *Kudguri) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateKudguriRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -18952,7 +19664,7 @@ type CreateKudguriRequest struct {
 
 func (x *CreateKudguriRequest) Reset() {
 	*x = CreateKudguriRequest{}
-	mi := &file_service_proto_msgTypes[274]
+	mi := &file_service_proto_msgTypes[275]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -18964,7 +19676,7 @@ func (x *CreateKudguriRequest) String() string {
 func (*CreateKudguriRequest) ProtoMessage() {}
 
 func (x *CreateKudguriRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[274]
+	mi := &file_service_proto_msgTypes[275]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18977,7 +19689,7 @@ func (x *CreateKudguriRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKudguriRequest.ProtoReflect.Descriptor instead.
 func (*CreateKudguriRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{274}
+	return file_service_proto_rawDescGZIP(), []int{275}
 }
 
 func (x *CreateKudguriRequest) GetOrganizationId() string {
@@ -19262,7 +19974,7 @@ type CreateKudguriResponse struct {
 
 func (x *CreateKudguriResponse) Reset() {
 	*x = CreateKudguriResponse{}
-	mi := &file_service_proto_msgTypes[275]
+	mi := &file_service_proto_msgTypes[276]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -19274,7 +19986,7 @@ func (x *CreateKudguriResponse) String() string {
 func (*CreateKudguriResponse) ProtoMessage() {}
 
 func (x *CreateKudguriResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[275]
+	mi := &file_service_proto_msgTypes[276]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -19287,7 +19999,7 @@ func (x *CreateKudguriResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKudguriResponse.ProtoReflect.Descriptor instead.
 func (*CreateKudguriResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{275}
+	return file_service_proto_rawDescGZIP(), []int{276}
 }
 
 func (x *CreateKudguriResponse) GetKudguri() *Kudguri {
@@ -19306,7 +20018,7 @@ type GetKudguriRequest struct {
 
 func (x *GetKudguriRequest) Reset() {
 	*x = GetKudguriRequest{}
-	mi := &file_service_proto_msgTypes[276]
+	mi := &file_service_proto_msgTypes[277]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -19318,7 +20030,7 @@ func (x *GetKudguriRequest) String() string {
 func (*GetKudguriRequest) ProtoMessage() {}
 
 func (x *GetKudguriRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[276]
+	mi := &file_service_proto_msgTypes[277]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -19331,7 +20043,7 @@ func (x *GetKudguriRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKudguriRequest.ProtoReflect.Descriptor instead.
 func (*GetKudguriRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{276}
+	return file_service_proto_rawDescGZIP(), []int{277}
 }
 
 func (x *GetKudguriRequest) GetUuid() string {
@@ -19350,7 +20062,7 @@ type GetKudguriResponse struct {
 
 func (x *GetKudguriResponse) Reset() {
 	*x = GetKudguriResponse{}
-	mi := &file_service_proto_msgTypes[277]
+	mi := &file_service_proto_msgTypes[278]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -19362,7 +20074,7 @@ func (x *GetKudguriResponse) String() string {
 func (*GetKudguriResponse) ProtoMessage() {}
 
 func (x *GetKudguriResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[277]
+	mi := &file_service_proto_msgTypes[278]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -19375,7 +20087,7 @@ func (x *GetKudguriResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKudguriResponse.ProtoReflect.Descriptor instead.
 func (*GetKudguriResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{277}
+	return file_service_proto_rawDescGZIP(), []int{278}
 }
 
 func (x *GetKudguriResponse) GetKudguri() *Kudguri {
@@ -19427,13 +20139,14 @@ type UpdateKudguriRequest struct {
 	EndGpsLat        *string                `protobuf:"bytes,38,opt,name=end_gps_lat,json=endGpsLat,proto3,oneof" json:"end_gps_lat,omitempty"`
 	EndGpsLng        *string                `protobuf:"bytes,39,opt,name=end_gps_lng,json=endGpsLng,proto3,oneof" json:"end_gps_lng,omitempty"`
 	OverLimitMax     *string                `protobuf:"bytes,40,opt,name=over_limit_max,json=overLimitMax,proto3,oneof" json:"over_limit_max,omitempty"`
+	Etag             string                 `protobuf:"bytes,41,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *UpdateKudguriRequest) Reset() {
 	*x = UpdateKudguriRequest{}
-	mi := &file_service_proto_msgTypes[278]
+	mi := &file_service_proto_msgTypes[279]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -19445,7 +20158,7 @@ func (x *UpdateKudguriRequest) String() string {
 func (*UpdateKudguriRequest) ProtoMessage() {}
 
 func (x *UpdateKudguriRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[278]
+	mi := &file_service_proto_msgTypes[279]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -19458,7 +20171,7 @@ func (x *UpdateKudguriRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKudguriRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKudguriRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{278}
+	return file_service_proto_rawDescGZIP(), []int{279}
 }
 
 func (x *UpdateKudguriRequest) GetUuid() string {
@@ -19741,6 +20454,13 @@ func (x *UpdateKudguriRequest) GetOverLimitMax() string {
 	return ""
 }
 
+func (x *UpdateKudguriRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateKudguriResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudguri       *Kudguri               `protobuf:"bytes,1,opt,name=kudguri,proto3" json:"kudguri,omitempty"`
@@ -19750,7 +20470,7 @@ type UpdateKudguriResponse struct {
 
 func (x *UpdateKudguriResponse) Reset() {
 	*x = UpdateKudguriResponse{}
-	mi := &file_service_proto_msgTypes[279]
+	mi := &file_service_proto_msgTypes[280]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -19762,7 +20482,7 @@ func (x *UpdateKudguriResponse) String() string {
 func (*UpdateKudguriResponse) ProtoMessage() {}
 
 func (x *UpdateKudguriResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[279]
+	mi := &file_service_proto_msgTypes[280]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -19775,7 +20495,7 @@ func (x *UpdateKudguriResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKudguriResponse.ProtoReflect.Descriptor instead.
 func (*UpdateKudguriResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{279}
+	return file_service_proto_rawDescGZIP(), []int{280}
 }
 
 func (x *UpdateKudguriResponse) GetKudguri() *Kudguri {
@@ -19788,13 +20508,14 @@ func (x *UpdateKudguriResponse) GetKudguri() *Kudguri {
 type DeleteKudguriRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteKudguriRequest) Reset() {
 	*x = DeleteKudguriRequest{}
-	mi := &file_service_proto_msgTypes[280]
+	mi := &file_service_proto_msgTypes[281]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -19806,7 +20527,7 @@ func (x *DeleteKudguriRequest) String() string {
 func (*DeleteKudguriRequest) ProtoMessage() {}
 
 func (x *DeleteKudguriRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[280]
+	mi := &file_service_proto_msgTypes[281]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -19819,7 +20540,7 @@ func (x *DeleteKudguriRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKudguriRequest.ProtoReflect.Descriptor instead.
 func (*DeleteKudguriRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{280}
+	return file_service_proto_rawDescGZIP(), []int{281}
 }
 
 func (x *DeleteKudguriRequest) GetUuid() string {
@@ -19829,6 +20550,13 @@ func (x *DeleteKudguriRequest) GetUuid() string {
 	return ""
 }
 
+func (x *DeleteKudguriRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteKudguriResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -19838,7 +20566,7 @@ type DeleteKudguriResponse struct {
 
 func (x *DeleteKudguriResponse) Reset() {
 	*x = DeleteKudguriResponse{}
-	mi := &file_service_proto_msgTypes[281]
+	mi := &file_service_proto_msgTypes[282]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -19850,7 +20578,7 @@ func (x *DeleteKudguriResponse) String() string {
 func (*DeleteKudguriResponse) ProtoMessage() {}
 
 func (x *DeleteKudguriResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[281]
+	mi := &file_service_proto_msgTypes[282]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -19863,7 +20591,7 @@ func (x *DeleteKudguriResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKudguriResponse.ProtoReflect.Descriptor instead.
 func (*DeleteKudguriResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{281}
+	return file_service_proto_rawDescGZIP(), []int{282}
 }
 
 func (x *DeleteKudguriResponse) GetSuccess() bool {
@@ -19874,16 +20602,20 @@ func (x *DeleteKudguriResponse) GetSuccess() bool {
 }
 
 type ListKudgurisRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKudgurisRequest) Reset() {
 	*x = ListKudgurisRequest{}
-	mi := &file_service_proto_msgTypes[282]
+	mi := &file_service_proto_msgTypes[283]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -19895,7 +20627,7 @@ func (x *ListKudgurisRequest) String() string {
 func (*ListKudgurisRequest) ProtoMessage() {}
 
 func (x *ListKudgurisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[282]
+	mi := &file_service_proto_msgTypes[283]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -19908,7 +20640,7 @@ func (x *ListKudgurisRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKudgurisRequest.ProtoReflect.Descriptor instead.
 func (*ListKudgurisRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{282}
+	return file_service_proto_rawDescGZIP(), []int{283}
 }
 
 func (x *ListKudgurisRequest) GetPageSize() int32 {
@@ -19925,6 +20657,20 @@ func (x *ListKudgurisRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListKudgurisRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKudgurisRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListKudgurisResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudguris      []*Kudguri             `protobuf:"bytes,1,rep,name=kudguris,proto3" json:"kudguris,omitempty"`
@@ -19935,7 +20681,7 @@ type ListKudgurisResponse struct {
 
 func (x *ListKudgurisResponse) Reset() {
 	*x = ListKudgurisResponse{}
-	mi := &file_service_proto_msgTypes[283]
+	mi := &file_service_proto_msgTypes[284]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -19947,7 +20693,7 @@ func (x *ListKudgurisResponse) String() string {
 func (*ListKudgurisResponse) ProtoMessage() {}
 
 func (x *ListKudgurisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[283]
+	mi := &file_service_proto_msgTypes[284]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -19960,7 +20706,7 @@ func (x *ListKudgurisResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKudgurisResponse.ProtoReflect.Descriptor instead.
 func (*ListKudgurisResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{283}
+	return file_service_proto_rawDescGZIP(), []int{284}
 }
 
 func (x *ListKudgurisResponse) GetKudguris() []*Kudguri {
@@ -19982,13 +20728,17 @@ type ListKudgurisByOrganizationRequest struct {
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKudgurisByOrganizationRequest) Reset() {
 	*x = ListKudgurisByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[284]
+	mi := &file_service_proto_msgTypes[285]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -20000,7 +20750,7 @@ func (x *ListKudgurisByOrganizationRequest) String() string {
 func (*ListKudgurisByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListKudgurisByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[284]
+	mi := &file_service_proto_msgTypes[285]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -20013,7 +20763,7 @@ func (x *ListKudgurisByOrganizationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListKudgurisByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListKudgurisByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{284}
+	return file_service_proto_rawDescGZIP(), []int{285}
 }
 
 func (x *ListKudgurisByOrganizationRequest) GetOrganizationId() string {
@@ -20037,6 +20787,20 @@ func (x *ListKudgurisByOrganizationRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListKudgurisByOrganizationRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKudgurisByOrganizationRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListKudgurisByOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudguris      []*Kudguri             `protobuf:"bytes,1,rep,name=kudguris,proto3" json:"kudguris,omitempty"`
@@ -20047,7 +20811,7 @@ type ListKudgurisByOrganizationResponse struct {
 
 func (x *ListKudgurisByOrganizationResponse) Reset() {
 	*x = ListKudgurisByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[285]
+	mi := &file_service_proto_msgTypes[286]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -20059,7 +20823,7 @@ func (x *ListKudgurisByOrganizationResponse) String() string {
 func (*ListKudgurisByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListKudgurisByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[285]
+	mi := &file_service_proto_msgTypes[286]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -20072,7 +20836,7 @@ func (x *ListKudgurisByOrganizationResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListKudgurisByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListKudgurisByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{285}
+	return file_service_proto_rawDescGZIP(), []int{286}
 }
 
 func (x *ListKudgurisByOrganizationResponse) GetKudguris() []*Kudguri {
@@ -20120,13 +20884,14 @@ type Kudgcst struct {
 	SettlementTypeName *string                `protobuf:"bytes,27,opt,name=settlement_type_name,json=settlementTypeName,proto3,oneof" json:"settlement_type_name,omitempty"`
 	StandardFare       *string                `protobuf:"bytes,28,opt,name=standard_fare,json=standardFare,proto3,oneof" json:"standard_fare,omitempty"`
 	ContractFare       *string                `protobuf:"bytes,29,opt,name=contract_fare,json=contractFare,proto3,oneof" json:"contract_fare,omitempty"`
+	Etag               string                 `protobuf:"bytes,30,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateKudgcstRequest.etag
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Kudgcst) Reset() {
 	*x = Kudgcst{}
-	mi := &file_service_proto_msgTypes[286]
+	mi := &file_service_proto_msgTypes[287]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -20138,7 +20903,7 @@ func (x *Kudgcst) String() string {
 func (*Kudgcst) ProtoMessage() {}
 
 func (x *Kudgcst) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[286]
+	mi := &file_service_proto_msgTypes[287]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -20151,7 +20916,7 @@ func (x *Kudgcst) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Kudgcst.ProtoReflect.Descriptor instead.
 func (*Kudgcst) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{286}
+	return file_service_proto_rawDescGZIP(), []int{287}
 }
 
 func (x *Kudgcst) GetUuid() string {
@@ -20357,6 +21122,13 @@ func (x *Kudgcst) GetContractFare() string {
 	return ""
 }
 
+func (x *Kudgcst) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateKudgcstRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId     string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -20393,7 +21165,7 @@ type CreateKudgcstRequest struct {
 
 func (x *CreateKudgcstRequest) Reset() {
 	*x = CreateKudgcstRequest{}
-	mi := &file_service_proto_msgTypes[287]
+	mi := &file_service_proto_msgTypes[288]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -20405,7 +21177,7 @@ func (x *CreateKudgcstRequest) String() string {
 func (*CreateKudgcstRequest) ProtoMessage() {}
 
 func (x *CreateKudgcstRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[287]
+	mi := &file_service_proto_msgTypes[288]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -20418,7 +21190,7 @@ func (x *CreateKudgcstRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKudgcstRequest.ProtoReflect.Descriptor instead.
 func (*CreateKudgcstRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{287}
+	return file_service_proto_rawDescGZIP(), []int{288}
 }
 
 func (x *CreateKudgcstRequest) GetOrganizationId() string {
@@ -20626,7 +21398,7 @@ type CreateKudgcstResponse struct {
 
 func (x *CreateKudgcstResponse) Reset() {
 	*x = CreateKudgcstResponse{}
-	mi := &file_service_proto_msgTypes[288]
+	mi := &file_service_proto_msgTypes[289]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -20638,7 +21410,7 @@ func (x *CreateKudgcstResponse) String() string {
 func (*CreateKudgcstResponse) ProtoMessage() {}
 
 func (x *CreateKudgcstResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[288]
+	mi := &file_service_proto_msgTypes[289]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -20651,7 +21423,7 @@ func (x *CreateKudgcstResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKudgcstResponse.ProtoReflect.Descriptor instead.
 func (*CreateKudgcstResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{288}
+	return file_service_proto_rawDescGZIP(), []int{289}
 }
 
 func (x *CreateKudgcstResponse) GetKudgcst() *Kudgcst {
@@ -20670,7 +21442,7 @@ type GetKudgcstRequest struct {
 
 func (x *GetKudgcstRequest) Reset() {
 	*x = GetKudgcstRequest{}
-	mi := &file_service_proto_msgTypes[289]
+	mi := &file_service_proto_msgTypes[290]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -20682,7 +21454,7 @@ func (x *GetKudgcstRequest) String() string {
 func (*GetKudgcstRequest) ProtoMessage() {}
 
 func (x *GetKudgcstRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[289]
+	mi := &file_service_proto_msgTypes[290]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -20695,7 +21467,7 @@ func (x *GetKudgcstRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKudgcstRequest.ProtoReflect.Descriptor instead.
 func (*GetKudgcstRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{289}
+	return file_service_proto_rawDescGZIP(), []int{290}
 }
 
 func (x *GetKudgcstRequest) GetUuid() string {
@@ -20714,7 +21486,7 @@ type GetKudgcstResponse struct {
 
 func (x *GetKudgcstResponse) Reset() {
 	*x = GetKudgcstResponse{}
-	mi := &file_service_proto_msgTypes[290]
+	mi := &file_service_proto_msgTypes[291]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -20726,7 +21498,7 @@ func (x *GetKudgcstResponse) String() string {
 func (*GetKudgcstResponse) ProtoMessage() {}
 
 func (x *GetKudgcstResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[290]
+	mi := &file_service_proto_msgTypes[291]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -20739,7 +21511,7 @@ func (x *GetKudgcstResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKudgcstResponse.ProtoReflect.Descriptor instead.
 func (*GetKudgcstResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{290}
+	return file_service_proto_rawDescGZIP(), []int{291}
 }
 
 func (x *GetKudgcstResponse) GetKudgcst() *Kudgcst {
@@ -20780,13 +21552,14 @@ type UpdateKudgcstRequest struct {
 	SettlementTypeName *string                `protobuf:"bytes,27,opt,name=settlement_type_name,json=settlementTypeName,proto3,oneof" json:"settlement_type_name,omitempty"`
 	StandardFare       *string                `protobuf:"bytes,28,opt,name=standard_fare,json=standardFare,proto3,oneof" json:"standard_fare,omitempty"`
 	ContractFare       *string                `protobuf:"bytes,29,opt,name=contract_fare,json=contractFare,proto3,oneof" json:"contract_fare,omitempty"`
+	Etag               string                 `protobuf:"bytes,30,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UpdateKudgcstRequest) Reset() {
 	*x = UpdateKudgcstRequest{}
-	mi := &file_service_proto_msgTypes[291]
+	mi := &file_service_proto_msgTypes[292]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -20798,7 +21571,7 @@ func (x *UpdateKudgcstRequest) String() string {
 func (*UpdateKudgcstRequest) ProtoMessage() {}
 
 func (x *UpdateKudgcstRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[291]
+	mi := &file_service_proto_msgTypes[292]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -20811,7 +21584,7 @@ func (x *UpdateKudgcstRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKudgcstRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKudgcstRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{291}
+	return file_service_proto_rawDescGZIP(), []int{292}
 }
 
 func (x *UpdateKudgcstRequest) GetUuid() string {
@@ -21017,6 +21790,13 @@ func (x *UpdateKudgcstRequest) GetContractFare() string {
 	return ""
 }
 
+func (x *UpdateKudgcstRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateKudgcstResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgcst       *Kudgcst               `protobuf:"bytes,1,opt,name=kudgcst,proto3" json:"kudgcst,omitempty"`
@@ -21026,7 +21806,7 @@ type UpdateKudgcstResponse struct {
 
 func (x *UpdateKudgcstResponse) Reset() {
 	*x = UpdateKudgcstResponse{}
-	mi := &file_service_proto_msgTypes[292]
+	mi := &file_service_proto_msgTypes[293]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -21038,7 +21818,7 @@ func (x *UpdateKudgcstResponse) String() string {
 func (*UpdateKudgcstResponse) ProtoMessage() {}
 
 func (x *UpdateKudgcstResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[292]
+	mi := &file_service_proto_msgTypes[293]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -21051,7 +21831,7 @@ func (x *UpdateKudgcstResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKudgcstResponse.ProtoReflect.Descriptor instead.
 func (*UpdateKudgcstResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{292}
+	return file_service_proto_rawDescGZIP(), []int{293}
 }
 
 func (x *UpdateKudgcstResponse) GetKudgcst() *Kudgcst {
@@ -21064,13 +21844,14 @@ func (x *UpdateKudgcstResponse) GetKudgcst() *Kudgcst {
 type DeleteKudgcstRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteKudgcstRequest) Reset() {
 	*x = DeleteKudgcstRequest{}
-	mi := &file_service_proto_msgTypes[293]
+	mi := &file_service_proto_msgTypes[294]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -21082,7 +21863,7 @@ func (x *DeleteKudgcstRequest) String() string {
 func (*DeleteKudgcstRequest) ProtoMessage() {}
 
 func (x *DeleteKudgcstRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[293]
+	mi := &file_service_proto_msgTypes[294]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -21095,7 +21876,7 @@ func (x *DeleteKudgcstRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKudgcstRequest.ProtoReflect.Descriptor instead.
 func (*DeleteKudgcstRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{293}
+	return file_service_proto_rawDescGZIP(), []int{294}
 }
 
 func (x *DeleteKudgcstRequest) GetUuid() string {
@@ -21105,6 +21886,13 @@ func (x *DeleteKudgcstRequest) GetUuid() string {
 	return ""
 }
 
+func (x *DeleteKudgcstRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteKudgcstResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -21114,7 +21902,7 @@ type DeleteKudgcstResponse struct {
 
 func (x *DeleteKudgcstResponse) Reset() {
 	*x = DeleteKudgcstResponse{}
-	mi := &file_service_proto_msgTypes[294]
+	mi := &file_service_proto_msgTypes[295]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -21126,7 +21914,7 @@ func (x *DeleteKudgcstResponse) String() string {
 func (*DeleteKudgcstResponse) ProtoMessage() {}
 
 func (x *DeleteKudgcstResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[294]
+	mi := &file_service_proto_msgTypes[295]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -21139,7 +21927,7 @@ func (x *DeleteKudgcstResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKudgcstResponse.ProtoReflect.Descriptor instead.
 func (*DeleteKudgcstResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{294}
+	return file_service_proto_rawDescGZIP(), []int{295}
 }
 
 func (x *DeleteKudgcstResponse) GetSuccess() bool {
@@ -21150,16 +21938,20 @@ func (x *DeleteKudgcstResponse) GetSuccess() bool {
 }
 
 type ListKudgcstsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKudgcstsRequest) Reset() {
 	*x = ListKudgcstsRequest{}
-	mi := &file_service_proto_msgTypes[295]
+	mi := &file_service_proto_msgTypes[296]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -21171,7 +21963,7 @@ func (x *ListKudgcstsRequest) String() string {
 func (*ListKudgcstsRequest) ProtoMessage() {}
 
 func (x *ListKudgcstsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[295]
+	mi := &file_service_proto_msgTypes[296]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -21184,7 +21976,7 @@ func (x *ListKudgcstsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKudgcstsRequest.ProtoReflect.Descriptor instead.
 func (*ListKudgcstsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{295}
+	return file_service_proto_rawDescGZIP(), []int{296}
 }
 
 func (x *ListKudgcstsRequest) GetPageSize() int32 {
@@ -21201,6 +21993,20 @@ func (x *ListKudgcstsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListKudgcstsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKudgcstsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListKudgcstsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgcsts      []*Kudgcst             `protobuf:"bytes,1,rep,name=kudgcsts,proto3" json:"kudgcsts,omitempty"`
@@ -21211,7 +22017,7 @@ type ListKudgcstsResponse struct {
 
 func (x *ListKudgcstsResponse) Reset() {
 	*x = ListKudgcstsResponse{}
-	mi := &file_service_proto_msgTypes[296]
+	mi := &file_service_proto_msgTypes[297]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -21223,7 +22029,7 @@ func (x *ListKudgcstsResponse) String() string {
 func (*ListKudgcstsResponse) ProtoMessage() {}
 
 func (x *ListKudgcstsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[296]
+	mi := &file_service_proto_msgTypes[297]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -21236,7 +22042,7 @@ func (x *ListKudgcstsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKudgcstsResponse.ProtoReflect.Descriptor instead.
 func (*ListKudgcstsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{296}
+	return file_service_proto_rawDescGZIP(), []int{297}
 }
 
 func (x *ListKudgcstsResponse) GetKudgcsts() []*Kudgcst {
@@ -21258,13 +22064,17 @@ type ListKudgcstsByOrganizationRequest struct {
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKudgcstsByOrganizationRequest) Reset() {
 	*x = ListKudgcstsByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[297]
+	mi := &file_service_proto_msgTypes[298]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -21276,7 +22086,7 @@ func (x *ListKudgcstsByOrganizationRequest) String() string {
 func (*ListKudgcstsByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListKudgcstsByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[297]
+	mi := &file_service_proto_msgTypes[298]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -21289,7 +22099,7 @@ func (x *ListKudgcstsByOrganizationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListKudgcstsByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListKudgcstsByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{297}
+	return file_service_proto_rawDescGZIP(), []int{298}
 }
 
 func (x *ListKudgcstsByOrganizationRequest) GetOrganizationId() string {
@@ -21313,6 +22123,20 @@ func (x *ListKudgcstsByOrganizationRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListKudgcstsByOrganizationRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKudgcstsByOrganizationRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListKudgcstsByOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgcsts      []*Kudgcst             `protobuf:"bytes,1,rep,name=kudgcsts,proto3" json:"kudgcsts,omitempty"`
@@ -21323,7 +22147,7 @@ type ListKudgcstsByOrganizationResponse struct {
 
 func (x *ListKudgcstsByOrganizationResponse) Reset() {
 	*x = ListKudgcstsByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[298]
+	mi := &file_service_proto_msgTypes[299]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -21335,7 +22159,7 @@ func (x *ListKudgcstsByOrganizationResponse) String() string {
 func (*ListKudgcstsByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListKudgcstsByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[298]
+	mi := &file_service_proto_msgTypes[299]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -21348,7 +22172,7 @@ func (x *ListKudgcstsByOrganizationResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListKudgcstsByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListKudgcstsByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{298}
+	return file_service_proto_rawDescGZIP(), []int{299}
 }
 
 func (x *ListKudgcstsByOrganizationResponse) GetKudgcsts() []*Kudgcst {
@@ -21407,13 +22231,14 @@ type Kudgful struct {
 	EndGpsLat        *string                `protobuf:"bytes,38,opt,name=end_gps_lat,json=endGpsLat,proto3,oneof" json:"end_gps_lat,omitempty"`
 	EndGpsLng        *string                `protobuf:"bytes,39,opt,name=end_gps_lng,json=endGpsLng,proto3,oneof" json:"end_gps_lng,omitempty"`
 	OverLimitMax     *string                `protobuf:"bytes,40,opt,name=over_limit_max,json=overLimitMax,proto3,oneof" json:"over_limit_max,omitempty"`
+	Etag             string                 `protobuf:"bytes,41,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateKudgfulRequest.etag
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Kudgful) Reset() {
 	*x = Kudgful{}
-	mi := &file_service_proto_msgTypes[299]
+	mi := &file_service_proto_msgTypes[300]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -21425,7 +22250,7 @@ func (x *Kudgful) String() string {
 func (*Kudgful) ProtoMessage() {}
 
 func (x *Kudgful) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[299]
+	mi := &file_service_proto_msgTypes[300]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -21438,7 +22263,7 @@ func (x *Kudgful) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Kudgful.ProtoReflect.Descriptor instead.
 func (*Kudgful) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{299}
+	return file_service_proto_rawDescGZIP(), []int{300}
 }
 
 func (x *Kudgful) GetUuid() string {
@@ -21721,6 +22546,13 @@ func (x *Kudgful) GetOverLimitMax() string {
 	return ""
 }
 
+func (x *Kudgful) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateKudgfulRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -21768,7 +22600,7 @@ type CreateKudgfulRequest struct {
 
 func (x *CreateKudgfulRequest) Reset() {
 	*x = CreateKudgfulRequest{}
-	mi := &file_service_proto_msgTypes[300]
+	mi := &file_service_proto_msgTypes[301]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -21780,7 +22612,7 @@ func (x *CreateKudgfulRequest) String() string {
 func (*CreateKudgfulRequest) ProtoMessage() {}
 
 func (x *CreateKudgfulRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[300]
+	mi := &file_service_proto_msgTypes[301]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -21793,7 +22625,7 @@ func (x *CreateKudgfulRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKudgfulRequest.ProtoReflect.Descriptor instead.
 func (*CreateKudgfulRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{300}
+	return file_service_proto_rawDescGZIP(), []int{301}
 }
 
 func (x *CreateKudgfulRequest) GetOrganizationId() string {
@@ -22078,7 +22910,7 @@ type CreateKudgfulResponse struct {
 
 func (x *CreateKudgfulResponse) Reset() {
 	*x = CreateKudgfulResponse{}
-	mi := &file_service_proto_msgTypes[301]
+	mi := &file_service_proto_msgTypes[302]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -22090,7 +22922,7 @@ func (x *CreateKudgfulResponse) String() string {
 func (*CreateKudgfulResponse) ProtoMessage() {}
 
 func (x *CreateKudgfulResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[301]
+	mi := &file_service_proto_msgTypes[302]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22103,7 +22935,7 @@ func (x *CreateKudgfulResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKudgfulResponse.ProtoReflect.Descriptor instead.
 func (*CreateKudgfulResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{301}
+	return file_service_proto_rawDescGZIP(), []int{302}
 }
 
 func (x *CreateKudgfulResponse) GetKudgful() *Kudgful {
@@ -22122,7 +22954,7 @@ type GetKudgfulRequest struct {
 
 func (x *GetKudgfulRequest) Reset() {
 	*x = GetKudgfulRequest{}
-	mi := &file_service_proto_msgTypes[302]
+	mi := &file_service_proto_msgTypes[303]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -22134,7 +22966,7 @@ func (x *GetKudgfulRequest) String() string {
 func (*GetKudgfulRequest) ProtoMessage() {}
 
 func (x *GetKudgfulRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[302]
+	mi := &file_service_proto_msgTypes[303]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22147,7 +22979,7 @@ func (x *GetKudgfulRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKudgfulRequest.ProtoReflect.Descriptor instead.
 func (*GetKudgfulRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{302}
+	return file_service_proto_rawDescGZIP(), []int{303}
 }
 
 func (x *GetKudgfulRequest) GetUuid() string {
@@ -22166,7 +22998,7 @@ type GetKudgfulResponse struct {
 
 func (x *GetKudgfulResponse) Reset() {
 	*x = GetKudgfulResponse{}
-	mi := &file_service_proto_msgTypes[303]
+	mi := &file_service_proto_msgTypes[304]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -22178,7 +23010,7 @@ func (x *GetKudgfulResponse) String() string {
 func (*GetKudgfulResponse) ProtoMessage() {}
 
 func (x *GetKudgfulResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[303]
+	mi := &file_service_proto_msgTypes[304]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22191,7 +23023,7 @@ func (x *GetKudgfulResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKudgfulResponse.ProtoReflect.Descriptor instead.
 func (*GetKudgfulResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{303}
+	return file_service_proto_rawDescGZIP(), []int{304}
 }
 
 func (x *GetKudgfulResponse) GetKudgful() *Kudgful {
@@ -22243,13 +23075,14 @@ type UpdateKudgfulRequest struct {
 	EndGpsLat        *string                `protobuf:"bytes,38,opt,name=end_gps_lat,json=endGpsLat,proto3,oneof" json:"end_gps_lat,omitempty"`
 	EndGpsLng        *string                `protobuf:"bytes,39,opt,name=end_gps_lng,json=endGpsLng,proto3,oneof" json:"end_gps_lng,omitempty"`
 	OverLimitMax     *string                `protobuf:"bytes,40,opt,name=over_limit_max,json=overLimitMax,proto3,oneof" json:"over_limit_max,omitempty"`
+	Etag             string                 `protobuf:"bytes,41,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *UpdateKudgfulRequest) Reset() {
 	*x = UpdateKudgfulRequest{}
-	mi := &file_service_proto_msgTypes[304]
+	mi := &file_service_proto_msgTypes[305]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -22261,7 +23094,7 @@ func (x *UpdateKudgfulRequest) String() string {
 func (*UpdateKudgfulRequest) ProtoMessage() {}
 
 func (x *UpdateKudgfulRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[304]
+	mi := &file_service_proto_msgTypes[305]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22274,7 +23107,7 @@ func (x *UpdateKudgfulRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKudgfulRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKudgfulRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{304}
+	return file_service_proto_rawDescGZIP(), []int{305}
 }
 
 func (x *UpdateKudgfulRequest) GetUuid() string {
@@ -22557,6 +23390,13 @@ func (x *UpdateKudgfulRequest) GetOverLimitMax() string {
 	return ""
 }
 
+func (x *UpdateKudgfulRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateKudgfulResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgful       *Kudgful               `protobuf:"bytes,1,opt,name=kudgful,proto3" json:"kudgful,omitempty"`
@@ -22566,7 +23406,7 @@ type UpdateKudgfulResponse struct {
 
 func (x *UpdateKudgfulResponse) Reset() {
 	*x = UpdateKudgfulResponse{}
-	mi := &file_service_proto_msgTypes[305]
+	mi := &file_service_proto_msgTypes[306]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -22578,7 +23418,7 @@ func (x *UpdateKudgfulResponse) String() string {
 func (*UpdateKudgfulResponse) ProtoMessage() {}
 
 func (x *UpdateKudgfulResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[305]
+	mi := &file_service_proto_msgTypes[306]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22591,7 +23431,7 @@ func (x *UpdateKudgfulResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKudgfulResponse.ProtoReflect.Descriptor instead.
 func (*UpdateKudgfulResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{305}
+	return file_service_proto_rawDescGZIP(), []int{306}
 }
 
 func (x *UpdateKudgfulResponse) GetKudgful() *Kudgful {
@@ -22604,13 +23444,14 @@ func (x *UpdateKudgfulResponse) GetKudgful() *Kudgful {
 type DeleteKudgfulRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteKudgfulRequest) Reset() {
 	*x = DeleteKudgfulRequest{}
-	mi := &file_service_proto_msgTypes[306]
+	mi := &file_service_proto_msgTypes[307]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -22622,7 +23463,7 @@ func (x *DeleteKudgfulRequest) String() string {
 func (*DeleteKudgfulRequest) ProtoMessage() {}
 
 func (x *DeleteKudgfulRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[306]
+	mi := &file_service_proto_msgTypes[307]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22635,7 +23476,7 @@ func (x *DeleteKudgfulRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKudgfulRequest.ProtoReflect.Descriptor instead.
 func (*DeleteKudgfulRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{306}
+	return file_service_proto_rawDescGZIP(), []int{307}
 }
 
 func (x *DeleteKudgfulRequest) GetUuid() string {
@@ -22645,6 +23486,13 @@ func (x *DeleteKudgfulRequest) GetUuid() string {
 	return ""
 }
 
+func (x *DeleteKudgfulRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteKudgfulResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -22654,7 +23502,7 @@ type DeleteKudgfulResponse struct {
 
 func (x *DeleteKudgfulResponse) Reset() {
 	*x = DeleteKudgfulResponse{}
-	mi := &file_service_proto_msgTypes[307]
+	mi := &file_service_proto_msgTypes[308]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -22666,7 +23514,7 @@ func (x *DeleteKudgfulResponse) String() string {
 func (*DeleteKudgfulResponse) ProtoMessage() {}
 
 func (x *DeleteKudgfulResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[307]
+	mi := &file_service_proto_msgTypes[308]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22679,7 +23527,7 @@ func (x *DeleteKudgfulResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKudgfulResponse.ProtoReflect.Descriptor instead.
 func (*DeleteKudgfulResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{307}
+	return file_service_proto_rawDescGZIP(), []int{308}
 }
 
 func (x *DeleteKudgfulResponse) GetSuccess() bool {
@@ -22690,16 +23538,20 @@ func (x *DeleteKudgfulResponse) GetSuccess() bool {
 }
 
 type ListKudgfulsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKudgfulsRequest) Reset() {
 	*x = ListKudgfulsRequest{}
-	mi := &file_service_proto_msgTypes[308]
+	mi := &file_service_proto_msgTypes[309]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -22711,7 +23563,7 @@ func (x *ListKudgfulsRequest) String() string {
 func (*ListKudgfulsRequest) ProtoMessage() {}
 
 func (x *ListKudgfulsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[308]
+	mi := &file_service_proto_msgTypes[309]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22724,7 +23576,7 @@ func (x *ListKudgfulsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKudgfulsRequest.ProtoReflect.Descriptor instead.
 func (*ListKudgfulsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{308}
+	return file_service_proto_rawDescGZIP(), []int{309}
 }
 
 func (x *ListKudgfulsRequest) GetPageSize() int32 {
@@ -22741,6 +23593,20 @@ func (x *ListKudgfulsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListKudgfulsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKudgfulsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListKudgfulsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgfuls      []*Kudgful             `protobuf:"bytes,1,rep,name=kudgfuls,proto3" json:"kudgfuls,omitempty"`
@@ -22751,7 +23617,7 @@ type ListKudgfulsResponse struct {
 
 func (x *ListKudgfulsResponse) Reset() {
 	*x = ListKudgfulsResponse{}
-	mi := &file_service_proto_msgTypes[309]
+	mi := &file_service_proto_msgTypes[310]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -22763,7 +23629,7 @@ func (x *ListKudgfulsResponse) String() string {
 func (*ListKudgfulsResponse) ProtoMessage() {}
 
 func (x *ListKudgfulsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[309]
+	mi := &file_service_proto_msgTypes[310]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22776,7 +23642,7 @@ func (x *ListKudgfulsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKudgfulsResponse.ProtoReflect.Descriptor instead.
 func (*ListKudgfulsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{309}
+	return file_service_proto_rawDescGZIP(), []int{310}
 }
 
 func (x *ListKudgfulsResponse) GetKudgfuls() []*Kudgful {
@@ -22798,13 +23664,17 @@ type ListKudgfulsByOrganizationRequest struct {
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKudgfulsByOrganizationRequest) Reset() {
 	*x = ListKudgfulsByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[310]
+	mi := &file_service_proto_msgTypes[311]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -22816,7 +23686,7 @@ func (x *ListKudgfulsByOrganizationRequest) String() string {
 func (*ListKudgfulsByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListKudgfulsByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[310]
+	mi := &file_service_proto_msgTypes[311]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22829,7 +23699,7 @@ func (x *ListKudgfulsByOrganizationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListKudgfulsByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListKudgfulsByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{310}
+	return file_service_proto_rawDescGZIP(), []int{311}
 }
 
 func (x *ListKudgfulsByOrganizationRequest) GetOrganizationId() string {
@@ -22853,6 +23723,20 @@ func (x *ListKudgfulsByOrganizationRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListKudgfulsByOrganizationRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKudgfulsByOrganizationRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListKudgfulsByOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgfuls      []*Kudgful             `protobuf:"bytes,1,rep,name=kudgfuls,proto3" json:"kudgfuls,omitempty"`
@@ -22863,7 +23747,7 @@ type ListKudgfulsByOrganizationResponse struct {
 
 func (x *ListKudgfulsByOrganizationResponse) Reset() {
 	*x = ListKudgfulsByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[311]
+	mi := &file_service_proto_msgTypes[312]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -22875,7 +23759,7 @@ func (x *ListKudgfulsByOrganizationResponse) String() string {
 func (*ListKudgfulsByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListKudgfulsByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[311]
+	mi := &file_service_proto_msgTypes[312]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22888,7 +23772,7 @@ func (x *ListKudgfulsByOrganizationResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListKudgfulsByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListKudgfulsByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{311}
+	return file_service_proto_rawDescGZIP(), []int{312}
 }
 
 func (x *ListKudgfulsByOrganizationResponse) GetKudgfuls() []*Kudgful {
@@ -22947,13 +23831,14 @@ type Kudgsir struct {
 	EndGpsLat        *string                `protobuf:"bytes,38,opt,name=end_gps_lat,json=endGpsLat,proto3,oneof" json:"end_gps_lat,omitempty"`
 	EndGpsLng        *string                `protobuf:"bytes,39,opt,name=end_gps_lng,json=endGpsLng,proto3,oneof" json:"end_gps_lng,omitempty"`
 	OverLimitMax     *string                `protobuf:"bytes,40,opt,name=over_limit_max,json=overLimitMax,proto3,oneof" json:"over_limit_max,omitempty"`
+	Etag             string                 `protobuf:"bytes,41,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateKudgsirRequest.etag
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Kudgsir) Reset() {
 	*x = Kudgsir{}
-	mi := &file_service_proto_msgTypes[312]
+	mi := &file_service_proto_msgTypes[313]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -22965,7 +23850,7 @@ func (x *Kudgsir) String() string {
 func (*Kudgsir) ProtoMessage() {}
 
 func (x *Kudgsir) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[312]
+	mi := &file_service_proto_msgTypes[313]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22978,7 +23863,7 @@ func (x *Kudgsir) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Kudgsir.ProtoReflect.Descriptor instead.
 func (*Kudgsir) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{312}
+	return file_service_proto_rawDescGZIP(), []int{313}
 }
 
 func (x *Kudgsir) GetUuid() string {
@@ -23261,6 +24146,13 @@ func (x *Kudgsir) GetOverLimitMax() string {
 	return ""
 }
 
+func (x *Kudgsir) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateKudgsirRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -23308,7 +24200,7 @@ type CreateKudgsirRequest struct {
 
 func (x *CreateKudgsirRequest) Reset() {
 	*x = CreateKudgsirRequest{}
-	mi := &file_service_proto_msgTypes[313]
+	mi := &file_service_proto_msgTypes[314]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -23320,7 +24212,7 @@ func (x *CreateKudgsirRequest) String() string {
 func (*CreateKudgsirRequest) ProtoMessage() {}
 
 func (x *CreateKudgsirRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[313]
+	mi := &file_service_proto_msgTypes[314]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -23333,7 +24225,7 @@ func (x *CreateKudgsirRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKudgsirRequest.ProtoReflect.Descriptor instead.
 func (*CreateKudgsirRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{313}
+	return file_service_proto_rawDescGZIP(), []int{314}
 }
 
 func (x *CreateKudgsirRequest) GetOrganizationId() string {
@@ -23618,7 +24510,7 @@ type CreateKudgsirResponse struct {
 
 func (x *CreateKudgsirResponse) Reset() {
 	*x = CreateKudgsirResponse{}
-	mi := &file_service_proto_msgTypes[314]
+	mi := &file_service_proto_msgTypes[315]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -23630,7 +24522,7 @@ func (x *CreateKudgsirResponse) String() string {
 func (*CreateKudgsirResponse) ProtoMessage() {}
 
 func (x *CreateKudgsirResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[314]
+	mi := &file_service_proto_msgTypes[315]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -23643,7 +24535,7 @@ func (x *CreateKudgsirResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKudgsirResponse.ProtoReflect.Descriptor instead.
 func (*CreateKudgsirResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{314}
+	return file_service_proto_rawDescGZIP(), []int{315}
 }
 
 func (x *CreateKudgsirResponse) GetKudgsir() *Kudgsir {
@@ -23662,7 +24554,7 @@ type GetKudgsirRequest struct {
 
 func (x *GetKudgsirRequest) Reset() {
 	*x = GetKudgsirRequest{}
-	mi := &file_service_proto_msgTypes[315]
+	mi := &file_service_proto_msgTypes[316]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -23674,7 +24566,7 @@ func (x *GetKudgsirRequest) String() string {
 func (*GetKudgsirRequest) ProtoMessage() {}
 
 func (x *GetKudgsirRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[315]
+	mi := &file_service_proto_msgTypes[316]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -23687,7 +24579,7 @@ func (x *GetKudgsirRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKudgsirRequest.ProtoReflect.Descriptor instead.
 func (*GetKudgsirRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{315}
+	return file_service_proto_rawDescGZIP(), []int{316}
 }
 
 func (x *GetKudgsirRequest) GetUuid() string {
@@ -23706,7 +24598,7 @@ type GetKudgsirResponse struct {
 
 func (x *GetKudgsirResponse) Reset() {
 	*x = GetKudgsirResponse{}
-	mi := &file_service_proto_msgTypes[316]
+	mi := &file_service_proto_msgTypes[317]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -23718,7 +24610,7 @@ func (x *GetKudgsirResponse) String() string {
 func (*GetKudgsirResponse) ProtoMessage() {}
 
 func (x *GetKudgsirResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[316]
+	mi := &file_service_proto_msgTypes[317]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -23731,7 +24623,7 @@ func (x *GetKudgsirResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKudgsirResponse.ProtoReflect.Descriptor instead.
 func (*GetKudgsirResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{316}
+	return file_service_proto_rawDescGZIP(), []int{317}
 }
 
 func (x *GetKudgsirResponse) GetKudgsir() *Kudgsir {
@@ -23783,13 +24675,14 @@ type UpdateKudgsirRequest struct {
 	EndGpsLat        *string                `protobuf:"bytes,38,opt,name=end_gps_lat,json=endGpsLat,proto3,oneof" json:"end_gps_lat,omitempty"`
 	EndGpsLng        *string                `protobuf:"bytes,39,opt,name=end_gps_lng,json=endGpsLng,proto3,oneof" json:"end_gps_lng,omitempty"`
 	OverLimitMax     *string                `protobuf:"bytes,40,opt,name=over_limit_max,json=overLimitMax,proto3,oneof" json:"over_limit_max,omitempty"`
+	Etag             string                 `protobuf:"bytes,41,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *UpdateKudgsirRequest) Reset() {
 	*x = UpdateKudgsirRequest{}
-	mi := &file_service_proto_msgTypes[317]
+	mi := &file_service_proto_msgTypes[318]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -23801,7 +24694,7 @@ func (x *UpdateKudgsirRequest) String() string {
 func (*UpdateKudgsirRequest) ProtoMessage() {}
 
 func (x *UpdateKudgsirRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[317]
+	mi := &file_service_proto_msgTypes[318]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -23814,7 +24707,7 @@ func (x *UpdateKudgsirRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKudgsirRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKudgsirRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{317}
+	return file_service_proto_rawDescGZIP(), []int{318}
 }
 
 func (x *UpdateKudgsirRequest) GetUuid() string {
@@ -24097,6 +24990,13 @@ func (x *UpdateKudgsirRequest) GetOverLimitMax() string {
 	return ""
 }
 
+func (x *UpdateKudgsirRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateKudgsirResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgsir       *Kudgsir               `protobuf:"bytes,1,opt,name=kudgsir,proto3" json:"kudgsir,omitempty"`
@@ -24106,7 +25006,7 @@ type UpdateKudgsirResponse struct {
 
 func (x *UpdateKudgsirResponse) Reset() {
 	*x = UpdateKudgsirResponse{}
-	mi := &file_service_proto_msgTypes[318]
+	mi := &file_service_proto_msgTypes[319]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -24118,7 +25018,7 @@ func (x *UpdateKudgsirResponse) String() string {
 func (*UpdateKudgsirResponse) ProtoMessage() {}
 
 func (x *UpdateKudgsirResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[318]
+	mi := &file_service_proto_msgTypes[319]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -24131,7 +25031,7 @@ func (x *UpdateKudgsirResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKudgsirResponse.ProtoReflect.Descriptor instead.
 func (*UpdateKudgsirResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{318}
+	return file_service_proto_rawDescGZIP(), []int{319}
 }
 
 func (x *UpdateKudgsirResponse) GetKudgsir() *Kudgsir {
@@ -24144,13 +25044,14 @@ func (x *UpdateKudgsirResponse) GetKudgsir() *Kudgsir {
 type DeleteKudgsirRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteKudgsirRequest) Reset() {
 	*x = DeleteKudgsirRequest{}
-	mi := &file_service_proto_msgTypes[319]
+	mi := &file_service_proto_msgTypes[320]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -24162,7 +25063,7 @@ func (x *DeleteKudgsirRequest) String() string {
 func (*DeleteKudgsirRequest) ProtoMessage() {}
 
 func (x *DeleteKudgsirRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[319]
+	mi := &file_service_proto_msgTypes[320]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -24175,7 +25076,7 @@ func (x *DeleteKudgsirRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKudgsirRequest.ProtoReflect.Descriptor instead.
 func (*DeleteKudgsirRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{319}
+	return file_service_proto_rawDescGZIP(), []int{320}
 }
 
 func (x *DeleteKudgsirRequest) GetUuid() string {
@@ -24185,6 +25086,13 @@ func (x *DeleteKudgsirRequest) GetUuid() string {
 	return ""
 }
 
+func (x *DeleteKudgsirRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteKudgsirResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -24194,7 +25102,7 @@ type DeleteKudgsirResponse struct {
 
 func (x *DeleteKudgsirResponse) Reset() {
 	*x = DeleteKudgsirResponse{}
-	mi := &file_service_proto_msgTypes[320]
+	mi := &file_service_proto_msgTypes[321]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -24206,7 +25114,7 @@ func (x *DeleteKudgsirResponse) String() string {
 func (*DeleteKudgsirResponse) ProtoMessage() {}
 
 func (x *DeleteKudgsirResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[320]
+	mi := &file_service_proto_msgTypes[321]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -24219,7 +25127,7 @@ func (x *DeleteKudgsirResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKudgsirResponse.ProtoReflect.Descriptor instead.
 func (*DeleteKudgsirResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{320}
+	return file_service_proto_rawDescGZIP(), []int{321}
 }
 
 func (x *DeleteKudgsirResponse) GetSuccess() bool {
@@ -24230,16 +25138,20 @@ func (x *DeleteKudgsirResponse) GetSuccess() bool {
 }
 
 type ListKudgsirsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKudgsirsRequest) Reset() {
 	*x = ListKudgsirsRequest{}
-	mi := &file_service_proto_msgTypes[321]
+	mi := &file_service_proto_msgTypes[322]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -24251,7 +25163,7 @@ func (x *ListKudgsirsRequest) String() string {
 func (*ListKudgsirsRequest) ProtoMessage() {}
 
 func (x *ListKudgsirsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[321]
+	mi := &file_service_proto_msgTypes[322]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -24264,7 +25176,7 @@ func (x *ListKudgsirsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKudgsirsRequest.ProtoReflect.Descriptor instead.
 func (*ListKudgsirsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{321}
+	return file_service_proto_rawDescGZIP(), []int{322}
 }
 
 func (x *ListKudgsirsRequest) GetPageSize() int32 {
@@ -24281,6 +25193,20 @@ func (x *ListKudgsirsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListKudgsirsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKudgsirsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListKudgsirsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgsirs      []*Kudgsir             `protobuf:"bytes,1,rep,name=kudgsirs,proto3" json:"kudgsirs,omitempty"`
@@ -24291,7 +25217,7 @@ type ListKudgsirsResponse struct {
 
 func (x *ListKudgsirsResponse) Reset() {
 	*x = ListKudgsirsResponse{}
-	mi := &file_service_proto_msgTypes[322]
+	mi := &file_service_proto_msgTypes[323]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -24303,7 +25229,7 @@ func (x *ListKudgsirsResponse) String() string {
 func (*ListKudgsirsResponse) ProtoMessage() {}
 
 func (x *ListKudgsirsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[322]
+	mi := &file_service_proto_msgTypes[323]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -24316,7 +25242,7 @@ func (x *ListKudgsirsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKudgsirsResponse.ProtoReflect.Descriptor instead.
 func (*ListKudgsirsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{322}
+	return file_service_proto_rawDescGZIP(), []int{323}
 }
 
 func (x *ListKudgsirsResponse) GetKudgsirs() []*Kudgsir {
@@ -24338,13 +25264,17 @@ type ListKudgsirsByOrganizationRequest struct {
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKudgsirsByOrganizationRequest) Reset() {
 	*x = ListKudgsirsByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[323]
+	mi := &file_service_proto_msgTypes[324]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -24356,7 +25286,7 @@ func (x *ListKudgsirsByOrganizationRequest) String() string {
 func (*ListKudgsirsByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListKudgsirsByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[323]
+	mi := &file_service_proto_msgTypes[324]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -24369,7 +25299,7 @@ func (x *ListKudgsirsByOrganizationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListKudgsirsByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListKudgsirsByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{323}
+	return file_service_proto_rawDescGZIP(), []int{324}
 }
 
 func (x *ListKudgsirsByOrganizationRequest) GetOrganizationId() string {
@@ -24393,6 +25323,20 @@ func (x *ListKudgsirsByOrganizationRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListKudgsirsByOrganizationRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKudgsirsByOrganizationRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListKudgsirsByOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgsirs      []*Kudgsir             `protobuf:"bytes,1,rep,name=kudgsirs,proto3" json:"kudgsirs,omitempty"`
@@ -24403,7 +25347,7 @@ type ListKudgsirsByOrganizationResponse struct {
 
 func (x *ListKudgsirsByOrganizationResponse) Reset() {
 	*x = ListKudgsirsByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[324]
+	mi := &file_service_proto_msgTypes[325]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -24415,7 +25359,7 @@ func (x *ListKudgsirsByOrganizationResponse) String() string {
 func (*ListKudgsirsByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListKudgsirsByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[324]
+	mi := &file_service_proto_msgTypes[325]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -24428,7 +25372,7 @@ func (x *ListKudgsirsByOrganizationResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListKudgsirsByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListKudgsirsByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{324}
+	return file_service_proto_rawDescGZIP(), []int{325}
 }
 
 func (x *ListKudgsirsByOrganizationResponse) GetKudgsirs() []*Kudgsir {
@@ -24541,13 +25485,14 @@ type Kudgivt struct {
 	RapidCurveCount5        *string                `protobuf:"bytes,92,opt,name=rapid_curve_count5,json=rapidCurveCount5,proto3,oneof" json:"rapid_curve_count5,omitempty"`
 	RapidCurveMax           *string                `protobuf:"bytes,93,opt,name=rapid_curve_max,json=rapidCurveMax,proto3,oneof" json:"rapid_curve_max,omitempty"`
 	RapidCurveMaxSpeed      *string                `protobuf:"bytes,94,opt,name=rapid_curve_max_speed,json=rapidCurveMaxSpeed,proto3,oneof" json:"rapid_curve_max_speed,omitempty"`
+	Etag                    string                 `protobuf:"bytes,95,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateKudgivtRequest.etag
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
 
 func (x *Kudgivt) Reset() {
 	*x = Kudgivt{}
-	mi := &file_service_proto_msgTypes[325]
+	mi := &file_service_proto_msgTypes[326]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -24559,7 +25504,7 @@ func (x *Kudgivt) String() string {
 func (*Kudgivt) ProtoMessage() {}
 
 func (x *Kudgivt) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[325]
+	mi := &file_service_proto_msgTypes[326]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -24572,7 +25517,7 @@ func (x *Kudgivt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Kudgivt.ProtoReflect.Descriptor instead.
 func (*Kudgivt) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{325}
+	return file_service_proto_rawDescGZIP(), []int{326}
 }
 
 func (x *Kudgivt) GetUuid() string {
@@ -25233,6 +26178,13 @@ func (x *Kudgivt) GetRapidCurveMaxSpeed() string {
 	return ""
 }
 
+func (x *Kudgivt) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateKudgivtRequest struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId          string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -25334,7 +26286,7 @@ type CreateKudgivtRequest struct {
 
 func (x *CreateKudgivtRequest) Reset() {
 	*x = CreateKudgivtRequest{}
-	mi := &file_service_proto_msgTypes[326]
+	mi := &file_service_proto_msgTypes[327]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -25346,7 +26298,7 @@ func (x *CreateKudgivtRequest) String() string {
 func (*CreateKudgivtRequest) ProtoMessage() {}
 
 func (x *CreateKudgivtRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[326]
+	mi := &file_service_proto_msgTypes[327]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -25359,7 +26311,7 @@ func (x *CreateKudgivtRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKudgivtRequest.ProtoReflect.Descriptor instead.
 func (*CreateKudgivtRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{326}
+	return file_service_proto_rawDescGZIP(), []int{327}
 }
 
 func (x *CreateKudgivtRequest) GetOrganizationId() string {
@@ -26022,7 +26974,7 @@ type CreateKudgivtResponse struct {
 
 func (x *CreateKudgivtResponse) Reset() {
 	*x = CreateKudgivtResponse{}
-	mi := &file_service_proto_msgTypes[327]
+	mi := &file_service_proto_msgTypes[328]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -26034,7 +26986,7 @@ func (x *CreateKudgivtResponse) String() string {
 func (*CreateKudgivtResponse) ProtoMessage() {}
 
 func (x *CreateKudgivtResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[327]
+	mi := &file_service_proto_msgTypes[328]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -26047,7 +26999,7 @@ func (x *CreateKudgivtResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKudgivtResponse.ProtoReflect.Descriptor instead.
 func (*CreateKudgivtResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{327}
+	return file_service_proto_rawDescGZIP(), []int{328}
 }
 
 func (x *CreateKudgivtResponse) GetKudgivt() *Kudgivt {
@@ -26066,7 +27018,7 @@ type GetKudgivtRequest struct {
 
 func (x *GetKudgivtRequest) Reset() {
 	*x = GetKudgivtRequest{}
-	mi := &file_service_proto_msgTypes[328]
+	mi := &file_service_proto_msgTypes[329]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -26078,7 +27030,7 @@ func (x *GetKudgivtRequest) String() string {
 func (*GetKudgivtRequest) ProtoMessage() {}
 
 func (x *GetKudgivtRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[328]
+	mi := &file_service_proto_msgTypes[329]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -26091,7 +27043,7 @@ func (x *GetKudgivtRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKudgivtRequest.ProtoReflect.Descriptor instead.
 func (*GetKudgivtRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{328}
+	return file_service_proto_rawDescGZIP(), []int{329}
 }
 
 func (x *GetKudgivtRequest) GetUuid() string {
@@ -26110,7 +27062,7 @@ type GetKudgivtResponse struct {
 
 func (x *GetKudgivtResponse) Reset() {
 	*x = GetKudgivtResponse{}
-	mi := &file_service_proto_msgTypes[329]
+	mi := &file_service_proto_msgTypes[330]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -26122,7 +27074,7 @@ func (x *GetKudgivtResponse) String() string {
 func (*GetKudgivtResponse) ProtoMessage() {}
 
 func (x *GetKudgivtResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[329]
+	mi := &file_service_proto_msgTypes[330]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -26135,7 +27087,7 @@ func (x *GetKudgivtResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKudgivtResponse.ProtoReflect.Descriptor instead.
 func (*GetKudgivtResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{329}
+	return file_service_proto_rawDescGZIP(), []int{330}
 }
 
 func (x *GetKudgivtResponse) GetKudgivt() *Kudgivt {
@@ -26241,13 +27193,16 @@ type UpdateKudgivtRequest struct {
 	RapidCurveCount5        *string                `protobuf:"bytes,92,opt,name=rapid_curve_count5,json=rapidCurveCount5,proto3,oneof" json:"rapid_curve_count5,omitempty"`
 	RapidCurveMax           *string                `protobuf:"bytes,93,opt,name=rapid_curve_max,json=rapidCurveMax,proto3,oneof" json:"rapid_curve_max,omitempty"`
 	RapidCurveMaxSpeed      *string                `protobuf:"bytes,94,opt,name=rapid_curve_max_speed,json=rapidCurveMaxSpeed,proto3,oneof" json:"rapid_curve_max_speed,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// Fields to write, e.g. "vehicle_cd,total_mileage"; all fields when empty
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,95,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Etag          string                 `protobuf:"bytes,96,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateKudgivtRequest) Reset() {
 	*x = UpdateKudgivtRequest{}
-	mi := &file_service_proto_msgTypes[330]
+	mi := &file_service_proto_msgTypes[331]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -26259,7 +27214,7 @@ func (x *UpdateKudgivtRequest) String() string {
 func (*UpdateKudgivtRequest) ProtoMessage() {}
 
 func (x *UpdateKudgivtRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[330]
+	mi := &file_service_proto_msgTypes[331]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -26272,7 +27227,7 @@ func (x *UpdateKudgivtRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKudgivtRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKudgivtRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{330}
+	return file_service_proto_rawDescGZIP(), []int{331}
 }
 
 func (x *UpdateKudgivtRequest) GetUuid() string {
@@ -26933,6 +27888,20 @@ func (x *UpdateKudgivtRequest) GetRapidCurveMaxSpeed() string {
 	return ""
 }
 
+func (x *UpdateKudgivtRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateKudgivtRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateKudgivtResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgivt       *Kudgivt               `protobuf:"bytes,1,opt,name=kudgivt,proto3" json:"kudgivt,omitempty"`
@@ -26942,7 +27911,7 @@ type UpdateKudgivtResponse struct {
 
 func (x *UpdateKudgivtResponse) Reset() {
 	*x = UpdateKudgivtResponse{}
-	mi := &file_service_proto_msgTypes[331]
+	mi := &file_service_proto_msgTypes[332]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -26954,7 +27923,7 @@ func (x *UpdateKudgivtResponse) String() string {
 func (*UpdateKudgivtResponse) ProtoMessage() {}
 
 func (x *UpdateKudgivtResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[331]
+	mi := &file_service_proto_msgTypes[332]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -26967,7 +27936,7 @@ func (x *UpdateKudgivtResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKudgivtResponse.ProtoReflect.Descriptor instead.
 func (*UpdateKudgivtResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{331}
+	return file_service_proto_rawDescGZIP(), []int{332}
 }
 
 func (x *UpdateKudgivtResponse) GetKudgivt() *Kudgivt {
@@ -26980,13 +27949,14 @@ func (x *UpdateKudgivtResponse) GetKudgivt() *Kudgivt {
 type DeleteKudgivtRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteKudgivtRequest) Reset() {
 	*x = DeleteKudgivtRequest{}
-	mi := &file_service_proto_msgTypes[332]
+	mi := &file_service_proto_msgTypes[333]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -26998,7 +27968,7 @@ func (x *DeleteKudgivtRequest) String() string {
 func (*DeleteKudgivtRequest) ProtoMessage() {}
 
 func (x *DeleteKudgivtRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[332]
+	mi := &file_service_proto_msgTypes[333]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -27011,7 +27981,7 @@ func (x *DeleteKudgivtRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKudgivtRequest.ProtoReflect.Descriptor instead.
 func (*DeleteKudgivtRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{332}
+	return file_service_proto_rawDescGZIP(), []int{333}
 }
 
 func (x *DeleteKudgivtRequest) GetUuid() string {
@@ -27021,6 +27991,13 @@ func (x *DeleteKudgivtRequest) GetUuid() string {
 	return ""
 }
 
+func (x *DeleteKudgivtRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteKudgivtResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -27030,7 +28007,7 @@ type DeleteKudgivtResponse struct {
 
 func (x *DeleteKudgivtResponse) Reset() {
 	*x = DeleteKudgivtResponse{}
-	mi := &file_service_proto_msgTypes[333]
+	mi := &file_service_proto_msgTypes[334]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -27042,7 +28019,7 @@ func (x *DeleteKudgivtResponse) String() string {
 func (*DeleteKudgivtResponse) ProtoMessage() {}
 
 func (x *DeleteKudgivtResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[333]
+	mi := &file_service_proto_msgTypes[334]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -27055,7 +28032,7 @@ func (x *DeleteKudgivtResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKudgivtResponse.ProtoReflect.Descriptor instead.
 func (*DeleteKudgivtResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{333}
+	return file_service_proto_rawDescGZIP(), []int{334}
 }
 
 func (x *DeleteKudgivtResponse) GetSuccess() bool {
@@ -27066,16 +28043,20 @@ func (x *DeleteKudgivtResponse) GetSuccess() bool {
 }
 
 type ListKudgivtsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKudgivtsRequest) Reset() {
 	*x = ListKudgivtsRequest{}
-	mi := &file_service_proto_msgTypes[334]
+	mi := &file_service_proto_msgTypes[335]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -27087,7 +28068,7 @@ func (x *ListKudgivtsRequest) String() string {
 func (*ListKudgivtsRequest) ProtoMessage() {}
 
 func (x *ListKudgivtsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[334]
+	mi := &file_service_proto_msgTypes[335]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -27100,7 +28081,7 @@ func (x *ListKudgivtsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKudgivtsRequest.ProtoReflect.Descriptor instead.
 func (*ListKudgivtsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{334}
+	return file_service_proto_rawDescGZIP(), []int{335}
 }
 
 func (x *ListKudgivtsRequest) GetPageSize() int32 {
@@ -27117,6 +28098,20 @@ func (x *ListKudgivtsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListKudgivtsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKudgivtsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListKudgivtsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgivts      []*Kudgivt             `protobuf:"bytes,1,rep,name=kudgivts,proto3" json:"kudgivts,omitempty"`
@@ -27127,7 +28122,7 @@ type ListKudgivtsResponse struct {
 
 func (x *ListKudgivtsResponse) Reset() {
 	*x = ListKudgivtsResponse{}
-	mi := &file_service_proto_msgTypes[335]
+	mi := &file_service_proto_msgTypes[336]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -27139,7 +28134,7 @@ func (x *ListKudgivtsResponse) String() string {
 func (*ListKudgivtsResponse) ProtoMessage() {}
 
 func (x *ListKudgivtsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[335]
+	mi := &file_service_proto_msgTypes[336]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -27152,7 +28147,7 @@ func (x *ListKudgivtsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKudgivtsResponse.ProtoReflect.Descriptor instead.
 func (*ListKudgivtsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{335}
+	return file_service_proto_rawDescGZIP(), []int{336}
 }
 
 func (x *ListKudgivtsResponse) GetKudgivts() []*Kudgivt {
@@ -27174,13 +28169,17 @@ type ListKudgivtsByOrganizationRequest struct {
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListKudgivtsByOrganizationRequest) Reset() {
 	*x = ListKudgivtsByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[336]
+	mi := &file_service_proto_msgTypes[337]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -27192,7 +28191,7 @@ func (x *ListKudgivtsByOrganizationRequest) String() string {
 func (*ListKudgivtsByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListKudgivtsByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[336]
+	mi := &file_service_proto_msgTypes[337]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -27205,7 +28204,7 @@ func (x *ListKudgivtsByOrganizationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListKudgivtsByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListKudgivtsByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{336}
+	return file_service_proto_rawDescGZIP(), []int{337}
 }
 
 func (x *ListKudgivtsByOrganizationRequest) GetOrganizationId() string {
@@ -27229,6 +28228,20 @@ func (x *ListKudgivtsByOrganizationRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListKudgivtsByOrganizationRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKudgivtsByOrganizationRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListKudgivtsByOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Kudgivts      []*Kudgivt             `protobuf:"bytes,1,rep,name=kudgivts,proto3" json:"kudgivts,omitempty"`
@@ -27239,7 +28252,7 @@ type ListKudgivtsByOrganizationResponse struct {
 
 func (x *ListKudgivtsByOrganizationResponse) Reset() {
 	*x = ListKudgivtsByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[337]
+	mi := &file_service_proto_msgTypes[338]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -27251,7 +28264,7 @@ func (x *ListKudgivtsByOrganizationResponse) String() string {
 func (*ListKudgivtsByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListKudgivtsByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[337]
+	mi := &file_service_proto_msgTypes[338]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -27264,7 +28277,7 @@ func (x *ListKudgivtsByOrganizationResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListKudgivtsByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListKudgivtsByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{337}
+	return file_service_proto_rawDescGZIP(), []int{338}
 }
 
 func (x *ListKudgivtsByOrganizationResponse) GetKudgivts() []*Kudgivt {
@@ -27340,13 +28353,14 @@ type Dtakologs struct {
 	VehicleIconLabelForDriver   *string                `protobuf:"bytes,55,opt,name=vehicle_icon_label_for_driver,json=vehicleIconLabelForDriver,proto3,oneof" json:"vehicle_icon_label_for_driver,omitempty"`
 	VehicleIconLabelForVehicle  *string                `protobuf:"bytes,56,opt,name=vehicle_icon_label_for_vehicle,json=vehicleIconLabelForVehicle,proto3,oneof" json:"vehicle_icon_label_for_vehicle,omitempty"`
 	VehicleName                 string                 `protobuf:"bytes,57,opt,name=vehicle_name,json=vehicleName,proto3" json:"vehicle_name,omitempty"`
+	Etag                        string                 `protobuf:"bytes,58,opt,name=etag,proto3" json:"etag,omitempty"` // Row version, see UpdateDtakologsRequest.etag
 	unknownFields               protoimpl.UnknownFields
 	sizeCache                   protoimpl.SizeCache
 }
 
 func (x *Dtakologs) Reset() {
 	*x = Dtakologs{}
-	mi := &file_service_proto_msgTypes[338]
+	mi := &file_service_proto_msgTypes[339]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -27358,7 +28372,7 @@ func (x *Dtakologs) String() string {
 func (*Dtakologs) ProtoMessage() {}
 
 func (x *Dtakologs) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[338]
+	mi := &file_service_proto_msgTypes[339]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -27371,7 +28385,7 @@ func (x *Dtakologs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Dtakologs.ProtoReflect.Descriptor instead.
 func (*Dtakologs) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{338}
+	return file_service_proto_rawDescGZIP(), []int{339}
 }
 
 func (x *Dtakologs) GetOrganizationId() string {
@@ -27773,6 +28787,13 @@ func (x *Dtakologs) GetVehicleName() string {
 	return ""
 }
 
+func (x *Dtakologs) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type CreateDtakologsRequest struct {
 	state                       protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId              string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -27838,7 +28859,7 @@ type CreateDtakologsRequest struct {
 
 func (x *CreateDtakologsRequest) Reset() {
 	*x = CreateDtakologsRequest{}
-	mi := &file_service_proto_msgTypes[339]
+	mi := &file_service_proto_msgTypes[340]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -27850,7 +28871,7 @@ func (x *CreateDtakologsRequest) String() string {
 func (*CreateDtakologsRequest) ProtoMessage() {}
 
 func (x *CreateDtakologsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[339]
+	mi := &file_service_proto_msgTypes[340]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -27863,7 +28884,7 @@ func (x *CreateDtakologsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDtakologsRequest.ProtoReflect.Descriptor instead.
 func (*CreateDtakologsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{339}
+	return file_service_proto_rawDescGZIP(), []int{340}
 }
 
 func (x *CreateDtakologsRequest) GetOrganizationId() string {
@@ -28274,7 +29295,7 @@ type CreateDtakologsResponse struct {
 
 func (x *CreateDtakologsResponse) Reset() {
 	*x = CreateDtakologsResponse{}
-	mi := &file_service_proto_msgTypes[340]
+	mi := &file_service_proto_msgTypes[341]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -28286,7 +29307,7 @@ func (x *CreateDtakologsResponse) String() string {
 func (*CreateDtakologsResponse) ProtoMessage() {}
 
 func (x *CreateDtakologsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[340]
+	mi := &file_service_proto_msgTypes[341]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -28299,7 +29320,7 @@ func (x *CreateDtakologsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDtakologsResponse.ProtoReflect.Descriptor instead.
 func (*CreateDtakologsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{340}
+	return file_service_proto_rawDescGZIP(), []int{341}
 }
 
 func (x *CreateDtakologsResponse) GetDtakologs() *Dtakologs {
@@ -28319,7 +29340,7 @@ type GetDtakologsRequest struct {
 
 func (x *GetDtakologsRequest) Reset() {
 	*x = GetDtakologsRequest{}
-	mi := &file_service_proto_msgTypes[341]
+	mi := &file_service_proto_msgTypes[342]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -28331,7 +29352,7 @@ func (x *GetDtakologsRequest) String() string {
 func (*GetDtakologsRequest) ProtoMessage() {}
 
 func (x *GetDtakologsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[341]
+	mi := &file_service_proto_msgTypes[342]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -28344,7 +29365,7 @@ func (x *GetDtakologsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDtakologsRequest.ProtoReflect.Descriptor instead.
 func (*GetDtakologsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{341}
+	return file_service_proto_rawDescGZIP(), []int{342}
 }
 
 func (x *GetDtakologsRequest) GetOrganizationId() string {
@@ -28370,7 +29391,7 @@ type GetDtakologsResponse struct {
 
 func (x *GetDtakologsResponse) Reset() {
 	*x = GetDtakologsResponse{}
-	mi := &file_service_proto_msgTypes[342]
+	mi := &file_service_proto_msgTypes[343]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -28382,7 +29403,7 @@ func (x *GetDtakologsResponse) String() string {
 func (*GetDtakologsResponse) ProtoMessage() {}
 
 func (x *GetDtakologsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[342]
+	mi := &file_service_proto_msgTypes[343]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -28395,7 +29416,7 @@ func (x *GetDtakologsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDtakologsResponse.ProtoReflect.Descriptor instead.
 func (*GetDtakologsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{342}
+	return file_service_proto_rawDescGZIP(), []int{343}
 }
 
 func (x *GetDtakologsResponse) GetDtakologs() *Dtakologs {
@@ -28464,13 +29485,14 @@ type UpdateDtakologsRequest struct {
 	VehicleIconLabelForDriver   *string                `protobuf:"bytes,55,opt,name=vehicle_icon_label_for_driver,json=vehicleIconLabelForDriver,proto3,oneof" json:"vehicle_icon_label_for_driver,omitempty"`
 	VehicleIconLabelForVehicle  *string                `protobuf:"bytes,56,opt,name=vehicle_icon_label_for_vehicle,json=vehicleIconLabelForVehicle,proto3,oneof" json:"vehicle_icon_label_for_vehicle,omitempty"`
 	VehicleName                 string                 `protobuf:"bytes,57,opt,name=vehicle_name,json=vehicleName,proto3" json:"vehicle_name,omitempty"`
+	Etag                        string                 `protobuf:"bytes,58,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields               protoimpl.UnknownFields
 	sizeCache                   protoimpl.SizeCache
 }
 
 func (x *UpdateDtakologsRequest) Reset() {
 	*x = UpdateDtakologsRequest{}
-	mi := &file_service_proto_msgTypes[343]
+	mi := &file_service_proto_msgTypes[344]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -28482,7 +29504,7 @@ func (x *UpdateDtakologsRequest) String() string {
 func (*UpdateDtakologsRequest) ProtoMessage() {}
 
 func (x *UpdateDtakologsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[343]
+	mi := &file_service_proto_msgTypes[344]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -28495,7 +29517,7 @@ func (x *UpdateDtakologsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDtakologsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDtakologsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{343}
+	return file_service_proto_rawDescGZIP(), []int{344}
 }
 
 func (x *UpdateDtakologsRequest) GetOrganizationId() string {
@@ -28897,6 +29919,13 @@ func (x *UpdateDtakologsRequest) GetVehicleName() string {
 	return ""
 }
 
+func (x *UpdateDtakologsRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type UpdateDtakologsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Dtakologs     *Dtakologs             `protobuf:"bytes,1,opt,name=dtakologs,proto3" json:"dtakologs,omitempty"`
@@ -28906,7 +29935,7 @@ type UpdateDtakologsResponse struct {
 
 func (x *UpdateDtakologsResponse) Reset() {
 	*x = UpdateDtakologsResponse{}
-	mi := &file_service_proto_msgTypes[344]
+	mi := &file_service_proto_msgTypes[345]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -28918,7 +29947,7 @@ func (x *UpdateDtakologsResponse) String() string {
 func (*UpdateDtakologsResponse) ProtoMessage() {}
 
 func (x *UpdateDtakologsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[344]
+	mi := &file_service_proto_msgTypes[345]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -28931,7 +29960,7 @@ func (x *UpdateDtakologsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDtakologsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateDtakologsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{344}
+	return file_service_proto_rawDescGZIP(), []int{345}
 }
 
 func (x *UpdateDtakologsResponse) GetDtakologs() *Dtakologs {
@@ -28945,13 +29974,14 @@ type DeleteDtakologsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Etag           string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DeleteDtakologsRequest) Reset() {
 	*x = DeleteDtakologsRequest{}
-	mi := &file_service_proto_msgTypes[345]
+	mi := &file_service_proto_msgTypes[346]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -28963,7 +29993,7 @@ func (x *DeleteDtakologsRequest) String() string {
 func (*DeleteDtakologsRequest) ProtoMessage() {}
 
 func (x *DeleteDtakologsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[345]
+	mi := &file_service_proto_msgTypes[346]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -28976,7 +30006,7 @@ func (x *DeleteDtakologsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDtakologsRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDtakologsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{345}
+	return file_service_proto_rawDescGZIP(), []int{346}
 }
 
 func (x *DeleteDtakologsRequest) GetOrganizationId() string {
@@ -28993,6 +30023,13 @@ func (x *DeleteDtakologsRequest) GetType() string {
 	return ""
 }
 
+func (x *DeleteDtakologsRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteDtakologsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -29002,7 +30039,7 @@ type DeleteDtakologsResponse struct {
 
 func (x *DeleteDtakologsResponse) Reset() {
 	*x = DeleteDtakologsResponse{}
-	mi := &file_service_proto_msgTypes[346]
+	mi := &file_service_proto_msgTypes[347]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -29014,7 +30051,7 @@ func (x *DeleteDtakologsResponse) String() string {
 func (*DeleteDtakologsResponse) ProtoMessage() {}
 
 func (x *DeleteDtakologsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[346]
+	mi := &file_service_proto_msgTypes[347]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29027,7 +30064,7 @@ func (x *DeleteDtakologsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDtakologsResponse.ProtoReflect.Descriptor instead.
 func (*DeleteDtakologsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{346}
+	return file_service_proto_rawDescGZIP(), []int{347}
 }
 
 func (x *DeleteDtakologsResponse) GetSuccess() bool {
@@ -29038,16 +30075,20 @@ func (x *DeleteDtakologsResponse) GetSuccess() bool {
 }
 
 type ListDtakologsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListDtakologsRequest) Reset() {
 	*x = ListDtakologsRequest{}
-	mi := &file_service_proto_msgTypes[347]
+	mi := &file_service_proto_msgTypes[348]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -29059,7 +30100,7 @@ func (x *ListDtakologsRequest) String() string {
 func (*ListDtakologsRequest) ProtoMessage() {}
 
 func (x *ListDtakologsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[347]
+	mi := &file_service_proto_msgTypes[348]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29072,7 +30113,7 @@ func (x *ListDtakologsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDtakologsRequest.ProtoReflect.Descriptor instead.
 func (*ListDtakologsRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{347}
+	return file_service_proto_rawDescGZIP(), []int{348}
 }
 
 func (x *ListDtakologsRequest) GetPageSize() int32 {
@@ -29089,6 +30130,20 @@ func (x *ListDtakologsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListDtakologsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListDtakologsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListDtakologsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Dtakologs     []*Dtakologs           `protobuf:"bytes,1,rep,name=dtakologs,proto3" json:"dtakologs,omitempty"`
@@ -29099,7 +30154,7 @@ type ListDtakologsResponse struct {
 
 func (x *ListDtakologsResponse) Reset() {
 	*x = ListDtakologsResponse{}
-	mi := &file_service_proto_msgTypes[348]
+	mi := &file_service_proto_msgTypes[349]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -29111,7 +30166,7 @@ func (x *ListDtakologsResponse) String() string {
 func (*ListDtakologsResponse) ProtoMessage() {}
 
 func (x *ListDtakologsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[348]
+	mi := &file_service_proto_msgTypes[349]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29124,7 +30179,7 @@ func (x *ListDtakologsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDtakologsResponse.ProtoReflect.Descriptor instead.
 func (*ListDtakologsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{348}
+	return file_service_proto_rawDescGZIP(), []int{349}
 }
 
 func (x *ListDtakologsResponse) GetDtakologs() []*Dtakologs {
@@ -29146,13 +30201,17 @@ type ListDtakologsByOrganizationRequest struct {
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// AIP-160 style filter over the fields allowed for the listing (see README)
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields to sort by, each optionally followed by "desc"
+	OrderBy       string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListDtakologsByOrganizationRequest) Reset() {
 	*x = ListDtakologsByOrganizationRequest{}
-	mi := &file_service_proto_msgTypes[349]
+	mi := &file_service_proto_msgTypes[350]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -29164,7 +30223,7 @@ func (x *ListDtakologsByOrganizationRequest) String() string {
 func (*ListDtakologsByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListDtakologsByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[349]
+	mi := &file_service_proto_msgTypes[350]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29177,7 +30236,7 @@ func (x *ListDtakologsByOrganizationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListDtakologsByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListDtakologsByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{349}
+	return file_service_proto_rawDescGZIP(), []int{350}
 }
 
 func (x *ListDtakologsByOrganizationRequest) GetOrganizationId() string {
@@ -29201,6 +30260,20 @@ func (x *ListDtakologsByOrganizationRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListDtakologsByOrganizationRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListDtakologsByOrganizationRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListDtakologsByOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Dtakologs     []*Dtakologs           `protobuf:"bytes,1,rep,name=dtakologs,proto3" json:"dtakologs,omitempty"`
@@ -29211,7 +30284,7 @@ type ListDtakologsByOrganizationResponse struct {
 
 func (x *ListDtakologsByOrganizationResponse) Reset() {
 	*x = ListDtakologsByOrganizationResponse{}
-	mi := &file_service_proto_msgTypes[350]
+	mi := &file_service_proto_msgTypes[351]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -29223,7 +30296,7 @@ func (x *ListDtakologsByOrganizationResponse) String() string {
 func (*ListDtakologsByOrganizationResponse) ProtoMessage() {}
 
 func (x *ListDtakologsByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[350]
+	mi := &file_service_proto_msgTypes[351]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29236,7 +30309,7 @@ func (x *ListDtakologsByOrganizationResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ListDtakologsByOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ListDtakologsByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{350}
+	return file_service_proto_rawDescGZIP(), []int{351}
 }
 
 func (x *ListDtakologsByOrganizationResponse) GetDtakologs() []*Dtakologs {
@@ -29254,18 +30327,20 @@ func (x *ListDtakologsByOrganizationResponse) GetNextPageToken() string {
 }
 
 type AuthResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // seconds until access token expires
-	User          *AppUser               `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken    string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken   string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresIn      int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // seconds until access token expires
+	User           *AppUser               `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	OrganizationId *string                `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // organization the access token is bound to
+	Role           *string                `protobuf:"bytes,6,opt,name=role,proto3,oneof" json:"role,omitempty"`                                           // caller's role in that organization
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *AuthResponse) Reset() {
 	*x = AuthResponse{}
-	mi := &file_service_proto_msgTypes[351]
+	mi := &file_service_proto_msgTypes[352]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -29277,7 +30352,7 @@ func (x *AuthResponse) String() string {
 func (*AuthResponse) ProtoMessage() {}
 
 func (x *AuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[351]
+	mi := &file_service_proto_msgTypes[352]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29290,7 +30365,7 @@ func (x *AuthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
 func (*AuthResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{351}
+	return file_service_proto_rawDescGZIP(), []int{352}
 }
 
 func (x *AuthResponse) GetAccessToken() string {
@@ -29321,16 +30396,31 @@ func (x *AuthResponse) GetUser() *AppUser {
 	return nil
 }
 
+func (x *AuthResponse) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetRole() string {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return ""
+}
+
 type AuthWithGoogleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // authorization code from Google OAuth
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                           // authorization code from Google OAuth
+	CodeVerifier  *string                `protobuf:"bytes,2,opt,name=code_verifier,json=codeVerifier,proto3,oneof" json:"code_verifier,omitempty"` // PKCE verifier if the URL was requested with code_challenge
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AuthWithGoogleRequest) Reset() {
 	*x = AuthWithGoogleRequest{}
-	mi := &file_service_proto_msgTypes[352]
+	mi := &file_service_proto_msgTypes[353]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -29342,7 +30432,7 @@ func (x *AuthWithGoogleRequest) String() string {
 func (*AuthWithGoogleRequest) ProtoMessage() {}
 
 func (x *AuthWithGoogleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[352]
+	mi := &file_service_proto_msgTypes[353]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29355,7 +30445,7 @@ func (x *AuthWithGoogleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthWithGoogleRequest.ProtoReflect.Descriptor instead.
 func (*AuthWithGoogleRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{352}
+	return file_service_proto_rawDescGZIP(), []int{353}
 }
 
 func (x *AuthWithGoogleRequest) GetCode() string {
@@ -29365,16 +30455,24 @@ func (x *AuthWithGoogleRequest) GetCode() string {
 	return ""
 }
 
+func (x *AuthWithGoogleRequest) GetCodeVerifier() string {
+	if x != nil && x.CodeVerifier != nil {
+		return *x.CodeVerifier
+	}
+	return ""
+}
+
 type AuthWithLineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // authorization code from LINE OAuth
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                           // authorization code from LINE OAuth
+	CodeVerifier  *string                `protobuf:"bytes,2,opt,name=code_verifier,json=codeVerifier,proto3,oneof" json:"code_verifier,omitempty"` // PKCE verifier if the URL was requested with code_challenge
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AuthWithLineRequest) Reset() {
 	*x = AuthWithLineRequest{}
-	mi := &file_service_proto_msgTypes[353]
+	mi := &file_service_proto_msgTypes[354]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -29386,7 +30484,7 @@ func (x *AuthWithLineRequest) String() string {
 func (*AuthWithLineRequest) ProtoMessage() {}
 
 func (x *AuthWithLineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[353]
+	mi := &file_service_proto_msgTypes[354]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29399,7 +30497,7 @@ func (x *AuthWithLineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthWithLineRequest.ProtoReflect.Descriptor instead.
 func (*AuthWithLineRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{353}
+	return file_service_proto_rawDescGZIP(), []int{354}
 }
 
 func (x *AuthWithLineRequest) GetCode() string {
@@ -29409,6 +30507,13 @@ func (x *AuthWithLineRequest) GetCode() string {
 	return ""
 }
 
+func (x *AuthWithLineRequest) GetCodeVerifier() string {
+	if x != nil && x.CodeVerifier != nil {
+		return *x.CodeVerifier
+	}
+	return ""
+}
+
 type RefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
@@ -29418,7 +30523,7 @@ type RefreshTokenRequest struct {
 
 func (x *RefreshTokenRequest) Reset() {
 	*x = RefreshTokenRequest{}
-	mi := &file_service_proto_msgTypes[354]
+	mi := &file_service_proto_msgTypes[355]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -29430,7 +30535,7 @@ func (x *RefreshTokenRequest) String() string {
 func (*RefreshTokenRequest) ProtoMessage() {}
 
 func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[354]
+	mi := &file_service_proto_msgTypes[355]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29443,7 +30548,7 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{354}
+	return file_service_proto_rawDescGZIP(), []int{355}
 }
 
 func (x *RefreshTokenRequest) GetRefreshToken() string {
@@ -29455,15 +30560,16 @@ func (x *RefreshTokenRequest) GetRefreshToken() string {
 
 type GetAuthURLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // 'google' or 'line'
-	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`       // CSRF protection state
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`                                      // 'google' or 'line'
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`                                            // CSRF protection state
+	CodeChallenge *string                `protobuf:"bytes,3,opt,name=code_challenge,json=codeChallenge,proto3,oneof" json:"code_challenge,omitempty"` // PKCE S256 challenge (recommended)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAuthURLRequest) Reset() {
 	*x = GetAuthURLRequest{}
-	mi := &file_service_proto_msgTypes[355]
+	mi := &file_service_proto_msgTypes[356]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -29475,7 +30581,7 @@ func (x *GetAuthURLRequest) String() string {
 func (*GetAuthURLRequest) ProtoMessage() {}
 
 func (x *GetAuthURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[355]
+	mi := &file_service_proto_msgTypes[356]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29488,7 +30594,7 @@ func (x *GetAuthURLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuthURLRequest.ProtoReflect.Descriptor instead.
 func (*GetAuthURLRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{355}
+	return file_service_proto_rawDescGZIP(), []int{356}
 }
 
 func (x *GetAuthURLRequest) GetProvider() string {
@@ -29505,6 +30611,13 @@ func (x *GetAuthURLRequest) GetState() string {
 	return ""
 }
 
+func (x *GetAuthURLRequest) GetCodeChallenge() string {
+	if x != nil && x.CodeChallenge != nil {
+		return *x.CodeChallenge
+	}
+	return ""
+}
+
 type GetAuthURLResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
@@ -29514,7 +30627,7 @@ type GetAuthURLResponse struct {
 
 func (x *GetAuthURLResponse) Reset() {
 	*x = GetAuthURLResponse{}
-	mi := &file_service_proto_msgTypes[356]
+	mi := &file_service_proto_msgTypes[357]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -29526,7 +30639,7 @@ func (x *GetAuthURLResponse) String() string {
 func (*GetAuthURLResponse) ProtoMessage() {}
 
 func (x *GetAuthURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[356]
+	mi := &file_service_proto_msgTypes[357]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29539,7 +30652,7 @@ func (x *GetAuthURLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuthURLResponse.ProtoReflect.Descriptor instead.
 func (*GetAuthURLResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{356}
+	return file_service_proto_rawDescGZIP(), []int{357}
 }
 
 func (x *GetAuthURLResponse) GetUrl() string {
@@ -29558,7 +30671,7 @@ type ValidateTokenRequest struct {
 
 func (x *ValidateTokenRequest) Reset() {
 	*x = ValidateTokenRequest{}
-	mi := &file_service_proto_msgTypes[357]
+	mi := &file_service_proto_msgTypes[358]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -29570,7 +30683,7 @@ func (x *ValidateTokenRequest) String() string {
 func (*ValidateTokenRequest) ProtoMessage() {}
 
 func (x *ValidateTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[357]
+	mi := &file_service_proto_msgTypes[358]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29583,7 +30696,7 @@ func (x *ValidateTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateTokenRequest.ProtoReflect.Descriptor instead.
 func (*ValidateTokenRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{357}
+	return file_service_proto_rawDescGZIP(), []int{358}
 }
 
 func (x *ValidateTokenRequest) GetAccessToken() string {
@@ -29603,7 +30716,7 @@ type ValidateTokenResponse struct {
 
 func (x *ValidateTokenResponse) Reset() {
 	*x = ValidateTokenResponse{}
-	mi := &file_service_proto_msgTypes[358]
+	mi := &file_service_proto_msgTypes[359]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -29615,7 +30728,7 @@ func (x *ValidateTokenResponse) String() string {
 func (*ValidateTokenResponse) ProtoMessage() {}
 
 func (x *ValidateTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[358]
+	mi := &file_service_proto_msgTypes[359]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29628,7 +30741,7 @@ func (x *ValidateTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateTokenResponse.ProtoReflect.Descriptor instead.
 func (*ValidateTokenResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{358}
+	return file_service_proto_rawDescGZIP(), []int{359}
 }
 
 func (x *ValidateTokenResponse) GetValid() bool {
@@ -29645,39 +30758,28 @@ func (x *ValidateTokenResponse) GetUser() *AppUser {
 	return nil
 }
 
-type Invitation struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Email          string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // 'admin', 'member', 'viewer'
-	Token          string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
-	InvitedBy      string                 `protobuf:"bytes,6,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"` // user_id of inviter
-	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                        // 'pending', 'accepted', 'expired', 'cancelled'
-	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	AcceptedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=accepted_at,json=acceptedAt,proto3,oneof" json:"accepted_at,omitempty"`
-	AcceptedBy     *string                `protobuf:"bytes,10,opt,name=accepted_by,json=acceptedBy,proto3,oneof" json:"accepted_by,omitempty"` // user_id who accepted
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // revokes the session this token belongs to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Invitation) Reset() {
-	*x = Invitation{}
-	mi := &file_service_proto_msgTypes[359]
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_service_proto_msgTypes[360]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Invitation) String() string {
+func (x *LogoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Invitation) ProtoMessage() {}
+func (*LogoutRequest) ProtoMessage() {}
 
-func (x *Invitation) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[359]
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[360]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29688,119 +30790,83 @@ func (x *Invitation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
-func (*Invitation) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{359}
-}
-
-func (x *Invitation) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Invitation) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
-}
-
-func (x *Invitation) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{360}
 }
 
-func (x *Invitation) GetRole() string {
+func (x *LogoutRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Role
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *Invitation) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Invitation) GetInvitedBy() string {
-	if x != nil {
-		return x.InvitedBy
-	}
-	return ""
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_service_proto_msgTypes[361]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Invitation) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpiresAt
-	}
-	return nil
-}
+func (*LogoutResponse) ProtoMessage() {}
 
-func (x *Invitation) GetAcceptedAt() *timestamppb.Timestamp {
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[361]
 	if x != nil {
-		return x.AcceptedAt
-	}
-	return nil
-}
-
-func (x *Invitation) GetAcceptedBy() string {
-	if x != nil && x.AcceptedBy != nil {
-		return *x.AcceptedBy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{361}
 }
 
-func (x *Invitation) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *LogoutResponse) GetSuccess() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type CreateInvitationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Email          string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // optional, defaults to 'member'
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type RevokeAllSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateInvitationRequest) Reset() {
-	*x = CreateInvitationRequest{}
-	mi := &file_service_proto_msgTypes[360]
+func (x *RevokeAllSessionsRequest) Reset() {
+	*x = RevokeAllSessionsRequest{}
+	mi := &file_service_proto_msgTypes[362]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateInvitationRequest) String() string {
+func (x *RevokeAllSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInvitationRequest) ProtoMessage() {}
+func (*RevokeAllSessionsRequest) ProtoMessage() {}
 
-func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[360]
+func (x *RevokeAllSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[362]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29811,55 +30877,33 @@ func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInvitationRequest.ProtoReflect.Descriptor instead.
-func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{360}
-}
-
-func (x *CreateInvitationRequest) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
-}
-
-func (x *CreateInvitationRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *CreateInvitationRequest) GetRole() string {
-	if x != nil {
-		return x.Role
-	}
-	return ""
+// Deprecated: Use RevokeAllSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{362}
 }
 
-type CreateInvitationResponse struct {
+type RevokeAllSessionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
-	InviteUrl     string                 `protobuf:"bytes,2,opt,name=invite_url,json=inviteUrl,proto3" json:"invite_url,omitempty"` // Full URL for invitation
+	RevokedCount  int32                  `protobuf:"varint,1,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateInvitationResponse) Reset() {
-	*x = CreateInvitationResponse{}
-	mi := &file_service_proto_msgTypes[361]
+func (x *RevokeAllSessionsResponse) Reset() {
+	*x = RevokeAllSessionsResponse{}
+	mi := &file_service_proto_msgTypes[363]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateInvitationResponse) String() string {
+func (x *RevokeAllSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInvitationResponse) ProtoMessage() {}
+func (*RevokeAllSessionsResponse) ProtoMessage() {}
 
-func (x *CreateInvitationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[361]
+func (x *RevokeAllSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[363]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29870,47 +30914,46 @@ func (x *CreateInvitationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInvitationResponse.ProtoReflect.Descriptor instead.
-func (*CreateInvitationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{361}
-}
-
-func (x *CreateInvitationResponse) GetInvitation() *Invitation {
-	if x != nil {
-		return x.Invitation
-	}
-	return nil
+// Deprecated: Use RevokeAllSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{363}
 }
 
-func (x *CreateInvitationResponse) GetInviteUrl() string {
+func (x *RevokeAllSessionsResponse) GetRevokedCount() int32 {
 	if x != nil {
-		return x.InviteUrl
+		return x.RevokedCount
 	}
-	return ""
+	return 0
 }
 
-type GetInvitationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Session is one signed-in device (a refresh token family)
+type Session struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAgent       *string                `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent,omitempty"`
+	IpAddress       *string                `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address,omitempty"`
+	LastRefreshedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_refreshed_at,json=lastRefreshedAt,proto3" json:"last_refreshed_at,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Current         bool                   `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"` // true for the session of refresh_token in the request
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetInvitationRequest) Reset() {
-	*x = GetInvitationRequest{}
-	mi := &file_service_proto_msgTypes[362]
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_service_proto_msgTypes[364]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInvitationRequest) String() string {
+func (x *Session) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInvitationRequest) ProtoMessage() {}
+func (*Session) ProtoMessage() {}
 
-func (x *GetInvitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[362]
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[364]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29921,128 +30964,74 @@ func (x *GetInvitationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInvitationRequest.ProtoReflect.Descriptor instead.
-func (*GetInvitationRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{362}
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{364}
 }
 
-func (x *GetInvitationRequest) GetId() string {
+func (x *Session) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetInvitationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetInvitationResponse) Reset() {
-	*x = GetInvitationResponse{}
-	mi := &file_service_proto_msgTypes[363]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetInvitationResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetInvitationResponse) ProtoMessage() {}
-
-func (x *GetInvitationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[363]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Session) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetInvitationResponse.ProtoReflect.Descriptor instead.
-func (*GetInvitationResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{363}
+func (x *Session) GetIpAddress() string {
+	if x != nil && x.IpAddress != nil {
+		return *x.IpAddress
+	}
+	return ""
 }
 
-func (x *GetInvitationResponse) GetInvitation() *Invitation {
+func (x *Session) GetLastRefreshedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Invitation
+		return x.LastRefreshedAt
 	}
 	return nil
 }
 
-type GetInvitationByTokenRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetInvitationByTokenRequest) Reset() {
-	*x = GetInvitationByTokenRequest{}
-	mi := &file_service_proto_msgTypes[364]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetInvitationByTokenRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetInvitationByTokenRequest) ProtoMessage() {}
-
-func (x *GetInvitationByTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[364]
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ExpiresAt
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetInvitationByTokenRequest.ProtoReflect.Descriptor instead.
-func (*GetInvitationByTokenRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{364}
+	return nil
 }
 
-func (x *GetInvitationByTokenRequest) GetToken() string {
+func (x *Session) GetCurrent() bool {
 	if x != nil {
-		return x.Token
+		return x.Current
 	}
-	return ""
+	return false
 }
 
-type GetInvitationByTokenResponse struct {
+type ListSessionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
-	Organization  *Organization          `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"` // Include org details for display
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // optional, used to mark the current session
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInvitationByTokenResponse) Reset() {
-	*x = GetInvitationByTokenResponse{}
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
 	mi := &file_service_proto_msgTypes[365]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInvitationByTokenResponse) String() string {
+func (x *ListSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInvitationByTokenResponse) ProtoMessage() {}
+func (*ListSessionsRequest) ProtoMessage() {}
 
-func (x *GetInvitationByTokenResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[365]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -30054,46 +31043,39 @@ func (x *GetInvitationByTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInvitationByTokenResponse.ProtoReflect.Descriptor instead.
-func (*GetInvitationByTokenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{365}
 }
 
-func (x *GetInvitationByTokenResponse) GetInvitation() *Invitation {
-	if x != nil {
-		return x.Invitation
-	}
-	return nil
-}
-
-func (x *GetInvitationByTokenResponse) GetOrganization() *Organization {
+func (x *ListSessionsRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Organization
+		return x.RefreshToken
 	}
-	return nil
+	return ""
 }
 
-type AcceptInvitationRequest struct {
+type ListSessionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcceptInvitationRequest) Reset() {
-	*x = AcceptInvitationRequest{}
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
 	mi := &file_service_proto_msgTypes[366]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptInvitationRequest) String() string {
+func (x *ListSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptInvitationRequest) ProtoMessage() {}
+func (*ListSessionsResponse) ProtoMessage() {}
 
-func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[366]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -30105,40 +31087,39 @@ func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
-func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{366}
 }
 
-func (x *AcceptInvitationRequest) GetToken() string {
+func (x *ListSessionsResponse) GetSessions() []*Session {
 	if x != nil {
-		return x.Token
+		return x.Sessions
 	}
-	return ""
+	return nil
 }
 
-type AcceptInvitationResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	UserOrganization *UserOrganization      `protobuf:"bytes,2,opt,name=user_organization,json=userOrganization,proto3" json:"user_organization,omitempty"` // Created user_organization
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type SwitchOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *AcceptInvitationResponse) Reset() {
-	*x = AcceptInvitationResponse{}
+func (x *SwitchOrganizationRequest) Reset() {
+	*x = SwitchOrganizationRequest{}
 	mi := &file_service_proto_msgTypes[367]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptInvitationResponse) String() string {
+func (x *SwitchOrganizationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptInvitationResponse) ProtoMessage() {}
+func (*SwitchOrganizationRequest) ProtoMessage() {}
 
-func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
+func (x *SwitchOrganizationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[367]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -30150,46 +31131,42 @@ func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptInvitationResponse.ProtoReflect.Descriptor instead.
-func (*AcceptInvitationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SwitchOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*SwitchOrganizationRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{367}
 }
 
-func (x *AcceptInvitationResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *AcceptInvitationResponse) GetUserOrganization() *UserOrganization {
+func (x *SwitchOrganizationRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.UserOrganization
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-type CancelInvitationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SwitchOrganizationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken    string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"` // bound to the requested organization (org_id, role claims)
+	ExpiresIn      int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CancelInvitationRequest) Reset() {
-	*x = CancelInvitationRequest{}
+func (x *SwitchOrganizationResponse) Reset() {
+	*x = SwitchOrganizationResponse{}
 	mi := &file_service_proto_msgTypes[368]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelInvitationRequest) String() string {
+func (x *SwitchOrganizationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelInvitationRequest) ProtoMessage() {}
+func (*SwitchOrganizationResponse) ProtoMessage() {}
 
-func (x *CancelInvitationRequest) ProtoReflect() protoreflect.Message {
+func (x *SwitchOrganizationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[368]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -30201,39 +31178,64 @@ func (x *CancelInvitationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelInvitationRequest.ProtoReflect.Descriptor instead.
-func (*CancelInvitationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SwitchOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*SwitchOrganizationResponse) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{368}
 }
 
-func (x *CancelInvitationRequest) GetId() string {
+func (x *SwitchOrganizationResponse) GetAccessToken() string {
 	if x != nil {
-		return x.Id
+		return x.AccessToken
 	}
 	return ""
 }
 
-type CancelInvitationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SwitchOrganizationResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
 }
 
-func (x *CancelInvitationResponse) Reset() {
-	*x = CancelInvitationResponse{}
+func (x *SwitchOrganizationResponse) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SwitchOrganizationResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// LinkedAccount is a provider identity the user can sign in with
+type LinkedAccount struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Provider       string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // 'google', 'line'
+	ProviderUserId string                 `protobuf:"bytes,2,opt,name=provider_user_id,json=providerUserId,proto3" json:"provider_user_id,omitempty"`
+	Email          *string                `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LinkedAccount) Reset() {
+	*x = LinkedAccount{}
 	mi := &file_service_proto_msgTypes[369]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelInvitationResponse) String() string {
+func (x *LinkedAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelInvitationResponse) ProtoMessage() {}
+func (*LinkedAccount) ProtoMessage() {}
 
-func (x *CancelInvitationResponse) ProtoReflect() protoreflect.Message {
+func (x *LinkedAccount) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[369]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -30245,42 +31247,62 @@ func (x *CancelInvitationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelInvitationResponse.ProtoReflect.Descriptor instead.
-func (*CancelInvitationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LinkedAccount.ProtoReflect.Descriptor instead.
+func (*LinkedAccount) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{369}
 }
 
-func (x *CancelInvitationResponse) GetSuccess() bool {
+func (x *LinkedAccount) GetProvider() string {
 	if x != nil {
-		return x.Success
+		return x.Provider
 	}
-	return false
+	return ""
 }
 
-type ListInvitationsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // optional filter: 'pending', 'accepted', etc.
-	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset         int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *LinkedAccount) GetProviderUserId() string {
+	if x != nil {
+		return x.ProviderUserId
+	}
+	return ""
 }
 
-func (x *ListInvitationsRequest) Reset() {
-	*x = ListInvitationsRequest{}
+func (x *LinkedAccount) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *LinkedAccount) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type LinkAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`                                   // 'google', 'line'
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                                           // authorization code of the identity to link
+	CodeVerifier  *string                `protobuf:"bytes,3,opt,name=code_verifier,json=codeVerifier,proto3,oneof" json:"code_verifier,omitempty"` // PKCE verifier for code
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkAccountRequest) Reset() {
+	*x = LinkAccountRequest{}
 	mi := &file_service_proto_msgTypes[370]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInvitationsRequest) String() string {
+func (x *LinkAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInvitationsRequest) ProtoMessage() {}
+func (*LinkAccountRequest) ProtoMessage() {}
 
-func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+func (x *LinkAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[370]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -30292,60 +31314,53 @@ func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
-func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LinkAccountRequest.ProtoReflect.Descriptor instead.
+func (*LinkAccountRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{370}
 }
 
-func (x *ListInvitationsRequest) GetOrganizationId() string {
+func (x *LinkAccountRequest) GetProvider() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *ListInvitationsRequest) GetStatus() string {
+func (x *LinkAccountRequest) GetCode() string {
 	if x != nil {
-		return x.Status
+		return x.Code
 	}
 	return ""
 }
 
-func (x *ListInvitationsRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListInvitationsRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
+func (x *LinkAccountRequest) GetCodeVerifier() string {
+	if x != nil && x.CodeVerifier != nil {
+		return *x.CodeVerifier
 	}
-	return 0
+	return ""
 }
 
-type ListInvitationsResponse struct {
+type LinkAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	Account       *LinkedAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListInvitationsResponse) Reset() {
-	*x = ListInvitationsResponse{}
+func (x *LinkAccountResponse) Reset() {
+	*x = LinkAccountResponse{}
 	mi := &file_service_proto_msgTypes[371]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInvitationsResponse) String() string {
+func (x *LinkAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInvitationsResponse) ProtoMessage() {}
+func (*LinkAccountResponse) ProtoMessage() {}
 
-func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+func (x *LinkAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[371]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -30357,39 +31372,39 @@ func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
-func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LinkAccountResponse.ProtoReflect.Descriptor instead.
+func (*LinkAccountResponse) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{371}
 }
 
-func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
+func (x *LinkAccountResponse) GetAccount() *LinkedAccount {
 	if x != nil {
-		return x.Invitations
+		return x.Account
 	}
 	return nil
 }
 
-type ResendInvitationRequest struct {
+type UnlinkAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResendInvitationRequest) Reset() {
-	*x = ResendInvitationRequest{}
+func (x *UnlinkAccountRequest) Reset() {
+	*x = UnlinkAccountRequest{}
 	mi := &file_service_proto_msgTypes[372]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResendInvitationRequest) String() string {
+func (x *UnlinkAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResendInvitationRequest) ProtoMessage() {}
+func (*UnlinkAccountRequest) ProtoMessage() {}
 
-func (x *ResendInvitationRequest) ProtoReflect() protoreflect.Message {
+func (x *UnlinkAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[372]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -30401,40 +31416,39 @@ func (x *ResendInvitationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResendInvitationRequest.ProtoReflect.Descriptor instead.
-func (*ResendInvitationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlinkAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkAccountRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{372}
 }
 
-func (x *ResendInvitationRequest) GetId() string {
+func (x *UnlinkAccountRequest) GetProvider() string {
 	if x != nil {
-		return x.Id
+		return x.Provider
 	}
 	return ""
 }
 
-type ResendInvitationResponse struct {
+type UnlinkAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
-	InviteUrl     string                 `protobuf:"bytes,2,opt,name=invite_url,json=inviteUrl,proto3" json:"invite_url,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResendInvitationResponse) Reset() {
-	*x = ResendInvitationResponse{}
+func (x *UnlinkAccountResponse) Reset() {
+	*x = UnlinkAccountResponse{}
 	mi := &file_service_proto_msgTypes[373]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResendInvitationResponse) String() string {
+func (x *UnlinkAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResendInvitationResponse) ProtoMessage() {}
+func (*UnlinkAccountResponse) ProtoMessage() {}
 
-func (x *ResendInvitationResponse) ProtoReflect() protoreflect.Message {
+func (x *UnlinkAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[373]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -30446,63 +31460,38 @@ func (x *ResendInvitationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResendInvitationResponse.ProtoReflect.Descriptor instead.
-func (*ResendInvitationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlinkAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkAccountResponse) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{373}
 }
 
-func (x *ResendInvitationResponse) GetInvitation() *Invitation {
-	if x != nil {
-		return x.Invitation
-	}
-	return nil
-}
-
-func (x *ResendInvitationResponse) GetInviteUrl() string {
+func (x *UnlinkAccountResponse) GetSuccess() bool {
 	if x != nil {
-		return x.InviteUrl
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type ETCMeisai struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DateFr         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_fr,json=dateFr,proto3,oneof" json:"date_fr,omitempty"`                // 利用開始日時
-	DateTo         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                      // 利用終了日時
-	DateToDate     string                 `protobuf:"bytes,5,opt,name=date_to_date,json=dateToDate,proto3" json:"date_to_date,omitempty"`        // 利用終了日（YYYY-MM-DD形式）
-	IcFr           string                 `protobuf:"bytes,6,opt,name=ic_fr,json=icFr,proto3" json:"ic_fr,omitempty"`                            // 入口IC
-	IcTo           string                 `protobuf:"bytes,7,opt,name=ic_to,json=icTo,proto3" json:"ic_to,omitempty"`                            // 出口IC
-	PriceBf        *int32                 `protobuf:"varint,8,opt,name=price_bf,json=priceBf,proto3,oneof" json:"price_bf,omitempty"`            // 割引前料金
-	Discount       *int32                 `protobuf:"varint,9,opt,name=discount,proto3,oneof" json:"discount,omitempty"`                         // 割引額
-	Price          int32                  `protobuf:"varint,10,opt,name=price,proto3" json:"price,omitempty"`                                    // 料金
-	Shashu         int32                  `protobuf:"varint,11,opt,name=shashu,proto3" json:"shashu,omitempty"`                                  // 車種
-	CarIdNum       *int32                 `protobuf:"varint,12,opt,name=car_id_num,json=carIdNum,proto3,oneof" json:"car_id_num,omitempty"`      // 車両ID番号
-	EtcNum         string                 `protobuf:"bytes,13,opt,name=etc_num,json=etcNum,proto3" json:"etc_num,omitempty"`                     // ETCカード番号
-	Detail         *string                `protobuf:"bytes,14,opt,name=detail,proto3,oneof" json:"detail,omitempty"`                             // 明細
-	DtakoRowId     *string                `protobuf:"bytes,15,opt,name=dtako_row_id,json=dtakoRowId,proto3,oneof" json:"dtako_row_id,omitempty"` // dtako連携用行ID
-	Hash           string                 `protobuf:"bytes,16,opt,name=hash,proto3" json:"hash,omitempty"`                                       // レコードハッシュ（差分インポート用）
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListLinkedAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ETCMeisai) Reset() {
-	*x = ETCMeisai{}
+func (x *ListLinkedAccountsRequest) Reset() {
+	*x = ListLinkedAccountsRequest{}
 	mi := &file_service_proto_msgTypes[374]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ETCMeisai) String() string {
+func (x *ListLinkedAccountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ETCMeisai) ProtoMessage() {}
+func (*ListLinkedAccountsRequest) ProtoMessage() {}
 
-func (x *ETCMeisai) ProtoReflect() protoreflect.Message {
+func (x *ListLinkedAccountsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[374]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -30514,172 +31503,1484 @@ func (x *ETCMeisai) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ETCMeisai.ProtoReflect.Descriptor instead.
-func (*ETCMeisai) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListLinkedAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListLinkedAccountsRequest) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{374}
 }
 
-func (x *ETCMeisai) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+type ListLinkedAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*LinkedAccount       `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ETCMeisai) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
+func (x *ListLinkedAccountsResponse) Reset() {
+	*x = ListLinkedAccountsResponse{}
+	mi := &file_service_proto_msgTypes[375]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ETCMeisai) GetDateFr() *timestamppb.Timestamp {
+func (x *ListLinkedAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinkedAccountsResponse) ProtoMessage() {}
+
+func (x *ListLinkedAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[375]
 	if x != nil {
-		return x.DateFr
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ETCMeisai) GetDateTo() *timestamppb.Timestamp {
+// Deprecated: Use ListLinkedAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListLinkedAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{375}
+}
+
+func (x *ListLinkedAccountsResponse) GetAccounts() []*LinkedAccount {
 	if x != nil {
-		return x.DateTo
+		return x.Accounts
 	}
 	return nil
 }
 
-func (x *ETCMeisai) GetDateToDate() string {
-	if x != nil {
-		return x.DateToDate
-	}
-	return ""
+type Invitation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Email          string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // 'admin', 'member', 'viewer'
+	Token          string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	InvitedBy      string                 `protobuf:"bytes,6,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"` // user_id of inviter
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                        // 'pending', 'accepted', 'expired', 'cancelled'
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	AcceptedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=accepted_at,json=acceptedAt,proto3,oneof" json:"accepted_at,omitempty"`
+	AcceptedBy     *string                `protobuf:"bytes,10,opt,name=accepted_by,json=acceptedBy,proto3,oneof" json:"accepted_by,omitempty"` // user_id who accepted
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ETCMeisai) GetIcFr() string {
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_service_proto_msgTypes[376]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[376]
 	if x != nil {
-		return x.IcFr
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ETCMeisai) GetIcTo() string {
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{376}
+}
+
+func (x *Invitation) GetId() string {
 	if x != nil {
-		return x.IcTo
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ETCMeisai) GetPriceBf() int32 {
-	if x != nil && x.PriceBf != nil {
-		return *x.PriceBf
+func (x *Invitation) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
 	}
-	return 0
+	return ""
 }
 
-func (x *ETCMeisai) GetDiscount() int32 {
-	if x != nil && x.Discount != nil {
-		return *x.Discount
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
 	}
-	return 0
+	return ""
 }
 
-func (x *ETCMeisai) GetPrice() int32 {
+func (x *Invitation) GetRole() string {
 	if x != nil {
-		return x.Price
+		return x.Role
 	}
-	return 0
+	return ""
 }
 
-func (x *ETCMeisai) GetShashu() int32 {
+func (x *Invitation) GetToken() string {
 	if x != nil {
-		return x.Shashu
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *ETCMeisai) GetCarIdNum() int32 {
-	if x != nil && x.CarIdNum != nil {
-		return *x.CarIdNum
+func (x *Invitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
 	}
-	return 0
+	return ""
 }
 
-func (x *ETCMeisai) GetEtcNum() string {
+func (x *Invitation) GetStatus() string {
 	if x != nil {
-		return x.EtcNum
+		return x.Status
 	}
 	return ""
 }
 
-func (x *ETCMeisai) GetDetail() string {
-	if x != nil && x.Detail != nil {
-		return *x.Detail
+func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ETCMeisai) GetDtakoRowId() string {
-	if x != nil && x.DtakoRowId != nil {
-		return *x.DtakoRowId
+func (x *Invitation) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ETCMeisai) GetHash() string {
-	if x != nil {
-		return x.Hash
+func (x *Invitation) GetAcceptedBy() string {
+	if x != nil && x.AcceptedBy != nil {
+		return *x.AcceptedBy
 	}
 	return ""
 }
 
-func (x *ETCMeisai) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *ETCMeisai) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Invitation) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-type CreateETCMeisaiRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DateFr        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date_fr,json=dateFr,proto3,oneof" json:"date_fr,omitempty"`
-	DateTo        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
-	DateToDate    string                 `protobuf:"bytes,3,opt,name=date_to_date,json=dateToDate,proto3" json:"date_to_date,omitempty"`
-	IcFr          string                 `protobuf:"bytes,4,opt,name=ic_fr,json=icFr,proto3" json:"ic_fr,omitempty"`
-	IcTo          string                 `protobuf:"bytes,5,opt,name=ic_to,json=icTo,proto3" json:"ic_to,omitempty"`
-	PriceBf       *int32                 `protobuf:"varint,6,opt,name=price_bf,json=priceBf,proto3,oneof" json:"price_bf,omitempty"`
-	Discount      *int32                 `protobuf:"varint,7,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
-	Price         int32                  `protobuf:"varint,8,opt,name=price,proto3" json:"price,omitempty"`
-	Shashu        int32                  `protobuf:"varint,9,opt,name=shashu,proto3" json:"shashu,omitempty"`
-	CarIdNum      *int32                 `protobuf:"varint,10,opt,name=car_id_num,json=carIdNum,proto3,oneof" json:"car_id_num,omitempty"`
-	EtcNum        string                 `protobuf:"bytes,11,opt,name=etc_num,json=etcNum,proto3" json:"etc_num,omitempty"`
-	Detail        *string                `protobuf:"bytes,12,opt,name=detail,proto3,oneof" json:"detail,omitempty"`
-	DtakoRowId    *string                `protobuf:"bytes,13,opt,name=dtako_row_id,json=dtakoRowId,proto3,oneof" json:"dtako_row_id,omitempty"`
-	Hash          string                 `protobuf:"bytes,14,opt,name=hash,proto3" json:"hash,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateInvitationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Email          string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // optional, defaults to 'member'
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateETCMeisaiRequest) Reset() {
-	*x = CreateETCMeisaiRequest{}
-	mi := &file_service_proto_msgTypes[375]
+func (x *CreateInvitationRequest) Reset() {
+	*x = CreateInvitationRequest{}
+	mi := &file_service_proto_msgTypes[377]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateETCMeisaiRequest) String() string {
+func (x *CreateInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationRequest) ProtoMessage() {}
+
+func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[377]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{377}
+}
+
+func (x *CreateInvitationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateInvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateInvitationRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type CreateInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	InviteUrl     string                 `protobuf:"bytes,2,opt,name=invite_url,json=inviteUrl,proto3" json:"invite_url,omitempty"` // Full URL for invitation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvitationResponse) Reset() {
+	*x = CreateInvitationResponse{}
+	mi := &file_service_proto_msgTypes[378]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationResponse) ProtoMessage() {}
+
+func (x *CreateInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[378]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CreateInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{378}
+}
+
+func (x *CreateInvitationResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+func (x *CreateInvitationResponse) GetInviteUrl() string {
+	if x != nil {
+		return x.InviteUrl
+	}
+	return ""
+}
+
+type GetInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitationRequest) Reset() {
+	*x = GetInvitationRequest{}
+	mi := &file_service_proto_msgTypes[379]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitationRequest) ProtoMessage() {}
+
+func (x *GetInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[379]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitationRequest.ProtoReflect.Descriptor instead.
+func (*GetInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{379}
+}
+
+func (x *GetInvitationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitationResponse) Reset() {
+	*x = GetInvitationResponse{}
+	mi := &file_service_proto_msgTypes[380]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitationResponse) ProtoMessage() {}
+
+func (x *GetInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[380]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitationResponse.ProtoReflect.Descriptor instead.
+func (*GetInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{380}
+}
+
+func (x *GetInvitationResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type GetInvitationByTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitationByTokenRequest) Reset() {
+	*x = GetInvitationByTokenRequest{}
+	mi := &file_service_proto_msgTypes[381]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitationByTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitationByTokenRequest) ProtoMessage() {}
+
+func (x *GetInvitationByTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[381]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitationByTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetInvitationByTokenRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{381}
+}
+
+func (x *GetInvitationByTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetInvitationByTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	Organization  *Organization          `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"` // Include org details for display
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitationByTokenResponse) Reset() {
+	*x = GetInvitationByTokenResponse{}
+	mi := &file_service_proto_msgTypes[382]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitationByTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitationByTokenResponse) ProtoMessage() {}
+
+func (x *GetInvitationByTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[382]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitationByTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetInvitationByTokenResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{382}
+}
+
+func (x *GetInvitationByTokenResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+func (x *GetInvitationByTokenResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+type AcceptInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_service_proto_msgTypes[383]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[383]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{383}
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AcceptInvitationResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	UserOrganization *UserOrganization      `protobuf:"bytes,2,opt,name=user_organization,json=userOrganization,proto3" json:"user_organization,omitempty"` // Created user_organization
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationResponse) Reset() {
+	*x = AcceptInvitationResponse{}
+	mi := &file_service_proto_msgTypes[384]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[384]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{384}
+}
+
+func (x *AcceptInvitationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AcceptInvitationResponse) GetUserOrganization() *UserOrganization {
+	if x != nil {
+		return x.UserOrganization
+	}
+	return nil
+}
+
+type CancelInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelInvitationRequest) Reset() {
+	*x = CancelInvitationRequest{}
+	mi := &file_service_proto_msgTypes[385]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvitationRequest) ProtoMessage() {}
+
+func (x *CancelInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[385]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CancelInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{385}
+}
+
+func (x *CancelInvitationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelInvitationResponse) Reset() {
+	*x = CancelInvitationResponse{}
+	mi := &file_service_proto_msgTypes[386]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvitationResponse) ProtoMessage() {}
+
+func (x *CancelInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[386]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CancelInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{386}
+}
+
+func (x *CancelInvitationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListInvitationsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // optional filter: 'pending', 'accepted', etc.
+	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_service_proto_msgTypes[387]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[387]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{387}
+}
+
+func (x *ListInvitationsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListInvitationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListInvitationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInvitationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_service_proto_msgTypes[388]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[388]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{388}
+}
+
+func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+func (x *ListInvitationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ResendInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendInvitationRequest) Reset() {
+	*x = ResendInvitationRequest{}
+	mi := &file_service_proto_msgTypes[389]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInvitationRequest) ProtoMessage() {}
+
+func (x *ResendInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[389]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInvitationRequest.ProtoReflect.Descriptor instead.
+func (*ResendInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{389}
+}
+
+func (x *ResendInvitationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ResendInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	InviteUrl     string                 `protobuf:"bytes,2,opt,name=invite_url,json=inviteUrl,proto3" json:"invite_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendInvitationResponse) Reset() {
+	*x = ResendInvitationResponse{}
+	mi := &file_service_proto_msgTypes[390]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInvitationResponse) ProtoMessage() {}
+
+func (x *ResendInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[390]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInvitationResponse.ProtoReflect.Descriptor instead.
+func (*ResendInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{390}
+}
+
+func (x *ResendInvitationResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+func (x *ResendInvitationResponse) GetInviteUrl() string {
+	if x != nil {
+		return x.InviteUrl
+	}
+	return ""
+}
+
+type ETCMeisai struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DateFr         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_fr,json=dateFr,proto3,oneof" json:"date_fr,omitempty"`                // 利用開始日時
+	DateTo         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                      // 利用終了日時
+	DateToDate     string                 `protobuf:"bytes,5,opt,name=date_to_date,json=dateToDate,proto3" json:"date_to_date,omitempty"`        // 利用終了日（YYYY-MM-DD形式）
+	IcFr           string                 `protobuf:"bytes,6,opt,name=ic_fr,json=icFr,proto3" json:"ic_fr,omitempty"`                            // 入口IC
+	IcTo           string                 `protobuf:"bytes,7,opt,name=ic_to,json=icTo,proto3" json:"ic_to,omitempty"`                            // 出口IC
+	PriceBf        *int32                 `protobuf:"varint,8,opt,name=price_bf,json=priceBf,proto3,oneof" json:"price_bf,omitempty"`            // 割引前料金
+	Discount       *int32                 `protobuf:"varint,9,opt,name=discount,proto3,oneof" json:"discount,omitempty"`                         // 割引額
+	Price          int32                  `protobuf:"varint,10,opt,name=price,proto3" json:"price,omitempty"`                                    // 料金
+	Shashu         int32                  `protobuf:"varint,11,opt,name=shashu,proto3" json:"shashu,omitempty"`                                  // 車種
+	CarIdNum       *int32                 `protobuf:"varint,12,opt,name=car_id_num,json=carIdNum,proto3,oneof" json:"car_id_num,omitempty"`      // 車両ID番号
+	EtcNum         string                 `protobuf:"bytes,13,opt,name=etc_num,json=etcNum,proto3" json:"etc_num,omitempty"`                     // ETCカード番号
+	Detail         *string                `protobuf:"bytes,14,opt,name=detail,proto3,oneof" json:"detail,omitempty"`                             // 明細
+	DtakoRowId     *string                `protobuf:"bytes,15,opt,name=dtako_row_id,json=dtakoRowId,proto3,oneof" json:"dtako_row_id,omitempty"` // dtako連携用行ID
+	Hash           string                 `protobuf:"bytes,16,opt,name=hash,proto3" json:"hash,omitempty"`                                       // レコードハッシュ（差分インポート用）
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Etag           string                 `protobuf:"bytes,19,opt,name=etag,proto3" json:"etag,omitempty"` // 行バージョン（更新・削除時の競合検出用）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ETCMeisai) Reset() {
+	*x = ETCMeisai{}
+	mi := &file_service_proto_msgTypes[391]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ETCMeisai) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ETCMeisai) ProtoMessage() {}
+
+func (x *ETCMeisai) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[391]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ETCMeisai.ProtoReflect.Descriptor instead.
+func (*ETCMeisai) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{391}
+}
+
+func (x *ETCMeisai) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ETCMeisai) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ETCMeisai) GetDateFr() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFr
+	}
+	return nil
+}
+
+func (x *ETCMeisai) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *ETCMeisai) GetDateToDate() string {
+	if x != nil {
+		return x.DateToDate
+	}
+	return ""
+}
+
+func (x *ETCMeisai) GetIcFr() string {
+	if x != nil {
+		return x.IcFr
+	}
+	return ""
+}
+
+func (x *ETCMeisai) GetIcTo() string {
+	if x != nil {
+		return x.IcTo
+	}
+	return ""
+}
+
+func (x *ETCMeisai) GetPriceBf() int32 {
+	if x != nil && x.PriceBf != nil {
+		return *x.PriceBf
+	}
+	return 0
+}
+
+func (x *ETCMeisai) GetDiscount() int32 {
+	if x != nil && x.Discount != nil {
+		return *x.Discount
+	}
+	return 0
+}
+
+func (x *ETCMeisai) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ETCMeisai) GetShashu() int32 {
+	if x != nil {
+		return x.Shashu
+	}
+	return 0
+}
+
+func (x *ETCMeisai) GetCarIdNum() int32 {
+	if x != nil && x.CarIdNum != nil {
+		return *x.CarIdNum
+	}
+	return 0
+}
+
+func (x *ETCMeisai) GetEtcNum() string {
+	if x != nil {
+		return x.EtcNum
+	}
+	return ""
+}
+
+func (x *ETCMeisai) GetDetail() string {
+	if x != nil && x.Detail != nil {
+		return *x.Detail
+	}
+	return ""
+}
+
+func (x *ETCMeisai) GetDtakoRowId() string {
+	if x != nil && x.DtakoRowId != nil {
+		return *x.DtakoRowId
+	}
+	return ""
+}
+
+func (x *ETCMeisai) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ETCMeisai) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ETCMeisai) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ETCMeisai) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type CreateETCMeisaiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DateFr        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date_fr,json=dateFr,proto3,oneof" json:"date_fr,omitempty"`
+	DateTo        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	DateToDate    string                 `protobuf:"bytes,3,opt,name=date_to_date,json=dateToDate,proto3" json:"date_to_date,omitempty"`
+	IcFr          string                 `protobuf:"bytes,4,opt,name=ic_fr,json=icFr,proto3" json:"ic_fr,omitempty"`
+	IcTo          string                 `protobuf:"bytes,5,opt,name=ic_to,json=icTo,proto3" json:"ic_to,omitempty"`
+	PriceBf       *int32                 `protobuf:"varint,6,opt,name=price_bf,json=priceBf,proto3,oneof" json:"price_bf,omitempty"`
+	Discount      *int32                 `protobuf:"varint,7,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
+	Price         int32                  `protobuf:"varint,8,opt,name=price,proto3" json:"price,omitempty"`
+	Shashu        int32                  `protobuf:"varint,9,opt,name=shashu,proto3" json:"shashu,omitempty"`
+	CarIdNum      *int32                 `protobuf:"varint,10,opt,name=car_id_num,json=carIdNum,proto3,oneof" json:"car_id_num,omitempty"`
+	EtcNum        string                 `protobuf:"bytes,11,opt,name=etc_num,json=etcNum,proto3" json:"etc_num,omitempty"`
+	Detail        *string                `protobuf:"bytes,12,opt,name=detail,proto3,oneof" json:"detail,omitempty"`
+	DtakoRowId    *string                `protobuf:"bytes,13,opt,name=dtako_row_id,json=dtakoRowId,proto3,oneof" json:"dtako_row_id,omitempty"`
+	Hash          string                 `protobuf:"bytes,14,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateETCMeisaiRequest) Reset() {
+	*x = CreateETCMeisaiRequest{}
+	mi := &file_service_proto_msgTypes[392]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateETCMeisaiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*CreateETCMeisaiRequest) ProtoMessage() {}
 
-func (x *CreateETCMeisaiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[375]
+func (x *CreateETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[392]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*CreateETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{392}
+}
+
+func (x *CreateETCMeisaiRequest) GetDateFr() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFr
+	}
+	return nil
+}
+
+func (x *CreateETCMeisaiRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *CreateETCMeisaiRequest) GetDateToDate() string {
+	if x != nil {
+		return x.DateToDate
+	}
+	return ""
+}
+
+func (x *CreateETCMeisaiRequest) GetIcFr() string {
+	if x != nil {
+		return x.IcFr
+	}
+	return ""
+}
+
+func (x *CreateETCMeisaiRequest) GetIcTo() string {
+	if x != nil {
+		return x.IcTo
+	}
+	return ""
+}
+
+func (x *CreateETCMeisaiRequest) GetPriceBf() int32 {
+	if x != nil && x.PriceBf != nil {
+		return *x.PriceBf
+	}
+	return 0
+}
+
+func (x *CreateETCMeisaiRequest) GetDiscount() int32 {
+	if x != nil && x.Discount != nil {
+		return *x.Discount
+	}
+	return 0
+}
+
+func (x *CreateETCMeisaiRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateETCMeisaiRequest) GetShashu() int32 {
+	if x != nil {
+		return x.Shashu
+	}
+	return 0
+}
+
+func (x *CreateETCMeisaiRequest) GetCarIdNum() int32 {
+	if x != nil && x.CarIdNum != nil {
+		return *x.CarIdNum
+	}
+	return 0
+}
+
+func (x *CreateETCMeisaiRequest) GetEtcNum() string {
+	if x != nil {
+		return x.EtcNum
+	}
+	return ""
+}
+
+func (x *CreateETCMeisaiRequest) GetDetail() string {
+	if x != nil && x.Detail != nil {
+		return *x.Detail
+	}
+	return ""
+}
+
+func (x *CreateETCMeisaiRequest) GetDtakoRowId() string {
+	if x != nil && x.DtakoRowId != nil {
+		return *x.DtakoRowId
+	}
+	return ""
+}
+
+func (x *CreateETCMeisaiRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type CreateETCMeisaiResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EtcMeisai     *ETCMeisai             `protobuf:"bytes,1,opt,name=etc_meisai,json=etcMeisai,proto3" json:"etc_meisai,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateETCMeisaiResponse) Reset() {
+	*x = CreateETCMeisaiResponse{}
+	mi := &file_service_proto_msgTypes[393]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateETCMeisaiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateETCMeisaiResponse) ProtoMessage() {}
+
+func (x *CreateETCMeisaiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[393]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateETCMeisaiResponse.ProtoReflect.Descriptor instead.
+func (*CreateETCMeisaiResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{393}
+}
+
+func (x *CreateETCMeisaiResponse) GetEtcMeisai() *ETCMeisai {
+	if x != nil {
+		return x.EtcMeisai
+	}
+	return nil
+}
+
+type GetETCMeisaiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetETCMeisaiRequest) Reset() {
+	*x = GetETCMeisaiRequest{}
+	mi := &file_service_proto_msgTypes[394]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetETCMeisaiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetETCMeisaiRequest) ProtoMessage() {}
+
+func (x *GetETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[394]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*GetETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{394}
+}
+
+func (x *GetETCMeisaiRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetETCMeisaiResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EtcMeisai     *ETCMeisai             `protobuf:"bytes,1,opt,name=etc_meisai,json=etcMeisai,proto3" json:"etc_meisai,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetETCMeisaiResponse) Reset() {
+	*x = GetETCMeisaiResponse{}
+	mi := &file_service_proto_msgTypes[395]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetETCMeisaiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetETCMeisaiResponse) ProtoMessage() {}
+
+func (x *GetETCMeisaiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[395]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetETCMeisaiResponse.ProtoReflect.Descriptor instead.
+func (*GetETCMeisaiResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{395}
+}
+
+func (x *GetETCMeisaiResponse) GetEtcMeisai() *ETCMeisai {
+	if x != nil {
+		return x.EtcMeisai
+	}
+	return nil
+}
+
+type GetETCMeisaiByHashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetETCMeisaiByHashRequest) Reset() {
+	*x = GetETCMeisaiByHashRequest{}
+	mi := &file_service_proto_msgTypes[396]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetETCMeisaiByHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetETCMeisaiByHashRequest) ProtoMessage() {}
+
+func (x *GetETCMeisaiByHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[396]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetETCMeisaiByHashRequest.ProtoReflect.Descriptor instead.
+func (*GetETCMeisaiByHashRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{396}
+}
+
+func (x *GetETCMeisaiByHashRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type GetETCMeisaiByHashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EtcMeisai     *ETCMeisai             `protobuf:"bytes,1,opt,name=etc_meisai,json=etcMeisai,proto3" json:"etc_meisai,omitempty"`
+	Exists        bool                   `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetETCMeisaiByHashResponse) Reset() {
+	*x = GetETCMeisaiByHashResponse{}
+	mi := &file_service_proto_msgTypes[397]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetETCMeisaiByHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetETCMeisaiByHashResponse) ProtoMessage() {}
+
+func (x *GetETCMeisaiByHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[397]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetETCMeisaiByHashResponse.ProtoReflect.Descriptor instead.
+func (*GetETCMeisaiByHashResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{397}
+}
+
+func (x *GetETCMeisaiByHashResponse) GetEtcMeisai() *ETCMeisai {
+	if x != nil {
+		return x.EtcMeisai
+	}
+	return nil
+}
+
+func (x *GetETCMeisaiByHashResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+type UpdateETCMeisaiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateFr        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_fr,json=dateFr,proto3,oneof" json:"date_fr,omitempty"`
+	DateTo        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	DateToDate    string                 `protobuf:"bytes,4,opt,name=date_to_date,json=dateToDate,proto3" json:"date_to_date,omitempty"`
+	IcFr          string                 `protobuf:"bytes,5,opt,name=ic_fr,json=icFr,proto3" json:"ic_fr,omitempty"`
+	IcTo          string                 `protobuf:"bytes,6,opt,name=ic_to,json=icTo,proto3" json:"ic_to,omitempty"`
+	PriceBf       *int32                 `protobuf:"varint,7,opt,name=price_bf,json=priceBf,proto3,oneof" json:"price_bf,omitempty"`
+	Discount      *int32                 `protobuf:"varint,8,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
+	Price         int32                  `protobuf:"varint,9,opt,name=price,proto3" json:"price,omitempty"`
+	Shashu        int32                  `protobuf:"varint,10,opt,name=shashu,proto3" json:"shashu,omitempty"`
+	CarIdNum      *int32                 `protobuf:"varint,11,opt,name=car_id_num,json=carIdNum,proto3,oneof" json:"car_id_num,omitempty"`
+	EtcNum        string                 `protobuf:"bytes,12,opt,name=etc_num,json=etcNum,proto3" json:"etc_num,omitempty"`
+	Detail        *string                `protobuf:"bytes,13,opt,name=detail,proto3,oneof" json:"detail,omitempty"`
+	DtakoRowId    *string                `protobuf:"bytes,14,opt,name=dtako_row_id,json=dtakoRowId,proto3,oneof" json:"dtako_row_id,omitempty"`
+	Hash          string                 `protobuf:"bytes,15,opt,name=hash,proto3" json:"hash,omitempty"`
+	Etag          string                 `protobuf:"bytes,16,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateETCMeisaiRequest) Reset() {
+	*x = UpdateETCMeisaiRequest{}
+	mi := &file_service_proto_msgTypes[398]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateETCMeisaiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateETCMeisaiRequest) ProtoMessage() {}
+
+func (x *UpdateETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[398]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -30690,131 +32991,544 @@ func (x *CreateETCMeisaiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateETCMeisaiRequest.ProtoReflect.Descriptor instead.
-func (*CreateETCMeisaiRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{375}
+// Deprecated: Use UpdateETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*UpdateETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{398}
 }
 
-func (x *CreateETCMeisaiRequest) GetDateFr() *timestamppb.Timestamp {
+func (x *UpdateETCMeisaiRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateETCMeisaiRequest) GetDateFr() *timestamppb.Timestamp {
 	if x != nil {
 		return x.DateFr
 	}
 	return nil
 }
 
-func (x *CreateETCMeisaiRequest) GetDateTo() *timestamppb.Timestamp {
+func (x *UpdateETCMeisaiRequest) GetDateTo() *timestamppb.Timestamp {
 	if x != nil {
 		return x.DateTo
 	}
 	return nil
 }
 
-func (x *CreateETCMeisaiRequest) GetDateToDate() string {
+func (x *UpdateETCMeisaiRequest) GetDateToDate() string {
 	if x != nil {
 		return x.DateToDate
 	}
 	return ""
 }
 
-func (x *CreateETCMeisaiRequest) GetIcFr() string {
+func (x *UpdateETCMeisaiRequest) GetIcFr() string {
 	if x != nil {
 		return x.IcFr
 	}
 	return ""
 }
 
-func (x *CreateETCMeisaiRequest) GetIcTo() string {
+func (x *UpdateETCMeisaiRequest) GetIcTo() string {
 	if x != nil {
 		return x.IcTo
 	}
 	return ""
 }
 
-func (x *CreateETCMeisaiRequest) GetPriceBf() int32 {
+func (x *UpdateETCMeisaiRequest) GetPriceBf() int32 {
 	if x != nil && x.PriceBf != nil {
 		return *x.PriceBf
 	}
 	return 0
 }
 
-func (x *CreateETCMeisaiRequest) GetDiscount() int32 {
+func (x *UpdateETCMeisaiRequest) GetDiscount() int32 {
 	if x != nil && x.Discount != nil {
 		return *x.Discount
 	}
 	return 0
 }
 
-func (x *CreateETCMeisaiRequest) GetPrice() int32 {
+func (x *UpdateETCMeisaiRequest) GetPrice() int32 {
 	if x != nil {
 		return x.Price
 	}
 	return 0
 }
 
-func (x *CreateETCMeisaiRequest) GetShashu() int32 {
+func (x *UpdateETCMeisaiRequest) GetShashu() int32 {
 	if x != nil {
 		return x.Shashu
 	}
 	return 0
 }
 
-func (x *CreateETCMeisaiRequest) GetCarIdNum() int32 {
+func (x *UpdateETCMeisaiRequest) GetCarIdNum() int32 {
 	if x != nil && x.CarIdNum != nil {
 		return *x.CarIdNum
 	}
 	return 0
 }
 
-func (x *CreateETCMeisaiRequest) GetEtcNum() string {
+func (x *UpdateETCMeisaiRequest) GetEtcNum() string {
 	if x != nil {
 		return x.EtcNum
 	}
 	return ""
 }
 
-func (x *CreateETCMeisaiRequest) GetDetail() string {
+func (x *UpdateETCMeisaiRequest) GetDetail() string {
 	if x != nil && x.Detail != nil {
 		return *x.Detail
 	}
 	return ""
 }
 
-func (x *CreateETCMeisaiRequest) GetDtakoRowId() string {
+func (x *UpdateETCMeisaiRequest) GetDtakoRowId() string {
 	if x != nil && x.DtakoRowId != nil {
 		return *x.DtakoRowId
 	}
 	return ""
 }
 
-func (x *CreateETCMeisaiRequest) GetHash() string {
+func (x *UpdateETCMeisaiRequest) GetHash() string {
 	if x != nil {
 		return x.Hash
 	}
 	return ""
 }
 
-type CreateETCMeisaiResponse struct {
+func (x *UpdateETCMeisaiRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type UpdateETCMeisaiResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EtcMeisai     *ETCMeisai             `protobuf:"bytes,1,opt,name=etc_meisai,json=etcMeisai,proto3" json:"etc_meisai,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateETCMeisaiResponse) Reset() {
-	*x = CreateETCMeisaiResponse{}
-	mi := &file_service_proto_msgTypes[376]
+func (x *UpdateETCMeisaiResponse) Reset() {
+	*x = UpdateETCMeisaiResponse{}
+	mi := &file_service_proto_msgTypes[399]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateETCMeisaiResponse) String() string {
+func (x *UpdateETCMeisaiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateETCMeisaiResponse) ProtoMessage() {}
+func (*UpdateETCMeisaiResponse) ProtoMessage() {}
 
-func (x *CreateETCMeisaiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[376]
+func (x *UpdateETCMeisaiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[399]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateETCMeisaiResponse.ProtoReflect.Descriptor instead.
+func (*UpdateETCMeisaiResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{399}
+}
+
+func (x *UpdateETCMeisaiResponse) GetEtcMeisai() *ETCMeisai {
+	if x != nil {
+		return x.EtcMeisai
+	}
+	return nil
+}
+
+type DeleteETCMeisaiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"` // Etag of the record as read; ABORTED if it changed since, unchecked when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteETCMeisaiRequest) Reset() {
+	*x = DeleteETCMeisaiRequest{}
+	mi := &file_service_proto_msgTypes[400]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteETCMeisaiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteETCMeisaiRequest) ProtoMessage() {}
+
+func (x *DeleteETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[400]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*DeleteETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{400}
+}
+
+func (x *DeleteETCMeisaiRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteETCMeisaiRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DeleteETCMeisaiResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteETCMeisaiResponse) Reset() {
+	*x = DeleteETCMeisaiResponse{}
+	mi := &file_service_proto_msgTypes[401]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteETCMeisaiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteETCMeisaiResponse) ProtoMessage() {}
+
+func (x *DeleteETCMeisaiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[401]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteETCMeisaiResponse.ProtoReflect.Descriptor instead.
+func (*DeleteETCMeisaiResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{401}
+}
+
+func (x *DeleteETCMeisaiResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListETCMeisaiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	DateFrom      *string                `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3,oneof" json:"date_from,omitempty"` // YYYY-MM-DD filter
+	DateTo        *string                `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3,oneof" json:"date_to,omitempty"`       // YYYY-MM-DD filter
+	EtcNum        *string                `protobuf:"bytes,5,opt,name=etc_num,json=etcNum,proto3,oneof" json:"etc_num,omitempty"`       // ETCカード番号filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListETCMeisaiRequest) Reset() {
+	*x = ListETCMeisaiRequest{}
+	mi := &file_service_proto_msgTypes[402]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListETCMeisaiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListETCMeisaiRequest) ProtoMessage() {}
+
+func (x *ListETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[402]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*ListETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{402}
+}
+
+func (x *ListETCMeisaiRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListETCMeisaiRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListETCMeisaiRequest) GetDateFrom() string {
+	if x != nil && x.DateFrom != nil {
+		return *x.DateFrom
+	}
+	return ""
+}
+
+func (x *ListETCMeisaiRequest) GetDateTo() string {
+	if x != nil && x.DateTo != nil {
+		return *x.DateTo
+	}
+	return ""
+}
+
+func (x *ListETCMeisaiRequest) GetEtcNum() string {
+	if x != nil && x.EtcNum != nil {
+		return *x.EtcNum
+	}
+	return ""
+}
+
+type ListETCMeisaiResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EtcMeisaiList []*ETCMeisai           `protobuf:"bytes,1,rep,name=etc_meisai_list,json=etcMeisaiList,proto3" json:"etc_meisai_list,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListETCMeisaiResponse) Reset() {
+	*x = ListETCMeisaiResponse{}
+	mi := &file_service_proto_msgTypes[403]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListETCMeisaiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListETCMeisaiResponse) ProtoMessage() {}
+
+func (x *ListETCMeisaiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[403]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListETCMeisaiResponse.ProtoReflect.Descriptor instead.
+func (*ListETCMeisaiResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{403}
+}
+
+func (x *ListETCMeisaiResponse) GetEtcMeisaiList() []*ETCMeisai {
+	if x != nil {
+		return x.EtcMeisaiList
+	}
+	return nil
+}
+
+func (x *ListETCMeisaiResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListETCMeisaiResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Bulk import support
+type BulkCreateETCMeisaiRequest struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Records        []*CreateETCMeisaiRequest `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	SkipDuplicates bool                      `protobuf:"varint,2,opt,name=skip_duplicates,json=skipDuplicates,proto3" json:"skip_duplicates,omitempty"` // Skip if hash already exists
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkCreateETCMeisaiRequest) Reset() {
+	*x = BulkCreateETCMeisaiRequest{}
+	mi := &file_service_proto_msgTypes[404]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateETCMeisaiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateETCMeisaiRequest) ProtoMessage() {}
+
+func (x *BulkCreateETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[404]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{404}
+}
+
+func (x *BulkCreateETCMeisaiRequest) GetRecords() []*CreateETCMeisaiRequest {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *BulkCreateETCMeisaiRequest) GetSkipDuplicates() bool {
+	if x != nil {
+		return x.SkipDuplicates
+	}
+	return false
+}
+
+type BulkCreateETCMeisaiResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedCount  int32                  `protobuf:"varint,1,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	SkippedCount  int32                  `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateETCMeisaiResponse) Reset() {
+	*x = BulkCreateETCMeisaiResponse{}
+	mi := &file_service_proto_msgTypes[405]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateETCMeisaiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateETCMeisaiResponse) ProtoMessage() {}
+
+func (x *BulkCreateETCMeisaiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[405]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateETCMeisaiResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateETCMeisaiResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{405}
+}
+
+func (x *BulkCreateETCMeisaiResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *BulkCreateETCMeisaiResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *BulkCreateETCMeisaiResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ApiKey struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix         string                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"` // leading characters of the key, safe to display
+	Scopes         []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"` // services the key may call, e.g. 'organization.DtakologsService'
+	Role           string                 `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`     // 'member', 'viewer'
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	LastUsedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at,omitempty"`
+	RevokedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=revoked_at,json=revokedAt,proto3,oneof" json:"revoked_at,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // user_id of creator
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_service_proto_msgTypes[406]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[406]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -30822,43 +33536,117 @@ func (x *CreateETCMeisaiResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{406}
+}
+
+func (x *ApiKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiKey) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKey) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ApiKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
 }
 
-// Deprecated: Use CreateETCMeisaiResponse.ProtoReflect.Descriptor instead.
-func (*CreateETCMeisaiResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{376}
+func (x *ApiKey) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
 }
 
-func (x *CreateETCMeisaiResponse) GetEtcMeisai() *ETCMeisai {
+func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EtcMeisai
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type GetETCMeisaiRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateApiKeyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes         []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`                              // at least one service
+	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                                  // optional, defaults to 'member'
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // never expires if unset
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetETCMeisaiRequest) Reset() {
-	*x = GetETCMeisaiRequest{}
-	mi := &file_service_proto_msgTypes[377]
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_service_proto_msgTypes[407]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetETCMeisaiRequest) String() string {
+func (x *CreateApiKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetETCMeisaiRequest) ProtoMessage() {}
+func (*CreateApiKeyRequest) ProtoMessage() {}
 
-func (x *GetETCMeisaiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[377]
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[407]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -30869,84 +33657,69 @@ func (x *GetETCMeisaiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetETCMeisaiRequest.ProtoReflect.Descriptor instead.
-func (*GetETCMeisaiRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{377}
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{407}
 }
 
-func (x *GetETCMeisaiRequest) GetId() int64 {
+func (x *CreateApiKeyRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Id
+		return x.OrganizationId
 	}
-	return 0
-}
-
-type GetETCMeisaiResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EtcMeisai     *ETCMeisai             `protobuf:"bytes,1,opt,name=etc_meisai,json=etcMeisai,proto3" json:"etc_meisai,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetETCMeisaiResponse) Reset() {
-	*x = GetETCMeisaiResponse{}
-	mi := &file_service_proto_msgTypes[378]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *GetETCMeisaiResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*GetETCMeisaiResponse) ProtoMessage() {}
-
-func (x *GetETCMeisaiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[378]
+func (x *CreateApiKeyRequest) GetScopes() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Scopes
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetETCMeisaiResponse.ProtoReflect.Descriptor instead.
-func (*GetETCMeisaiResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{378}
+func (x *CreateApiKeyRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
 }
 
-func (x *GetETCMeisaiResponse) GetEtcMeisai() *ETCMeisai {
+func (x *CreateApiKeyRequest) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EtcMeisai
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type GetETCMeisaiByHashRequest struct {
+type CreateApiKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"` // the secret key; only returned here, never stored
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetETCMeisaiByHashRequest) Reset() {
-	*x = GetETCMeisaiByHashRequest{}
-	mi := &file_service_proto_msgTypes[379]
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_service_proto_msgTypes[408]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetETCMeisaiByHashRequest) String() string {
+func (x *CreateApiKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetETCMeisaiByHashRequest) ProtoMessage() {}
+func (*CreateApiKeyResponse) ProtoMessage() {}
 
-func (x *GetETCMeisaiByHashRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[379]
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[408]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -30957,41 +33730,47 @@ func (x *GetETCMeisaiByHashRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetETCMeisaiByHashRequest.ProtoReflect.Descriptor instead.
-func (*GetETCMeisaiByHashRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{379}
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{408}
 }
 
-func (x *GetETCMeisaiByHashRequest) GetHash() string {
+func (x *CreateApiKeyResponse) GetApiKey() *ApiKey {
 	if x != nil {
-		return x.Hash
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
 	}
 	return ""
 }
 
-type GetETCMeisaiByHashResponse struct {
+type GetApiKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EtcMeisai     *ETCMeisai             `protobuf:"bytes,1,opt,name=etc_meisai,json=etcMeisai,proto3" json:"etc_meisai,omitempty"`
-	Exists        bool                   `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetETCMeisaiByHashResponse) Reset() {
-	*x = GetETCMeisaiByHashResponse{}
-	mi := &file_service_proto_msgTypes[380]
+func (x *GetApiKeyRequest) Reset() {
+	*x = GetApiKeyRequest{}
+	mi := &file_service_proto_msgTypes[409]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetETCMeisaiByHashResponse) String() string {
+func (x *GetApiKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetETCMeisaiByHashResponse) ProtoMessage() {}
+func (*GetApiKeyRequest) ProtoMessage() {}
 
-func (x *GetETCMeisaiByHashResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[380]
+func (x *GetApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[409]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -31002,61 +33781,40 @@ func (x *GetETCMeisaiByHashResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetETCMeisaiByHashResponse.ProtoReflect.Descriptor instead.
-func (*GetETCMeisaiByHashResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{380}
-}
-
-func (x *GetETCMeisaiByHashResponse) GetEtcMeisai() *ETCMeisai {
-	if x != nil {
-		return x.EtcMeisai
-	}
-	return nil
+// Deprecated: Use GetApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{409}
 }
 
-func (x *GetETCMeisaiByHashResponse) GetExists() bool {
+func (x *GetApiKeyRequest) GetId() string {
 	if x != nil {
-		return x.Exists
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-type UpdateETCMeisaiRequest struct {
+type GetApiKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	DateFr        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_fr,json=dateFr,proto3,oneof" json:"date_fr,omitempty"`
-	DateTo        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
-	DateToDate    string                 `protobuf:"bytes,4,opt,name=date_to_date,json=dateToDate,proto3" json:"date_to_date,omitempty"`
-	IcFr          string                 `protobuf:"bytes,5,opt,name=ic_fr,json=icFr,proto3" json:"ic_fr,omitempty"`
-	IcTo          string                 `protobuf:"bytes,6,opt,name=ic_to,json=icTo,proto3" json:"ic_to,omitempty"`
-	PriceBf       *int32                 `protobuf:"varint,7,opt,name=price_bf,json=priceBf,proto3,oneof" json:"price_bf,omitempty"`
-	Discount      *int32                 `protobuf:"varint,8,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
-	Price         int32                  `protobuf:"varint,9,opt,name=price,proto3" json:"price,omitempty"`
-	Shashu        int32                  `protobuf:"varint,10,opt,name=shashu,proto3" json:"shashu,omitempty"`
-	CarIdNum      *int32                 `protobuf:"varint,11,opt,name=car_id_num,json=carIdNum,proto3,oneof" json:"car_id_num,omitempty"`
-	EtcNum        string                 `protobuf:"bytes,12,opt,name=etc_num,json=etcNum,proto3" json:"etc_num,omitempty"`
-	Detail        *string                `protobuf:"bytes,13,opt,name=detail,proto3,oneof" json:"detail,omitempty"`
-	DtakoRowId    *string                `protobuf:"bytes,14,opt,name=dtako_row_id,json=dtakoRowId,proto3,oneof" json:"dtako_row_id,omitempty"`
-	Hash          string                 `protobuf:"bytes,15,opt,name=hash,proto3" json:"hash,omitempty"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateETCMeisaiRequest) Reset() {
-	*x = UpdateETCMeisaiRequest{}
-	mi := &file_service_proto_msgTypes[381]
+func (x *GetApiKeyResponse) Reset() {
+	*x = GetApiKeyResponse{}
+	mi := &file_service_proto_msgTypes[410]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateETCMeisaiRequest) String() string {
+func (x *GetApiKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateETCMeisaiRequest) ProtoMessage() {}
+func (*GetApiKeyResponse) ProtoMessage() {}
 
-func (x *UpdateETCMeisaiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[381]
+func (x *GetApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[410]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -31067,138 +33825,92 @@ func (x *UpdateETCMeisaiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateETCMeisaiRequest.ProtoReflect.Descriptor instead.
-func (*UpdateETCMeisaiRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{381}
-}
-
-func (x *UpdateETCMeisaiRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UpdateETCMeisaiRequest) GetDateFr() *timestamppb.Timestamp {
-	if x != nil {
-		return x.DateFr
-	}
-	return nil
+// Deprecated: Use GetApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{410}
 }
 
-func (x *UpdateETCMeisaiRequest) GetDateTo() *timestamppb.Timestamp {
+func (x *GetApiKeyResponse) GetApiKey() *ApiKey {
 	if x != nil {
-		return x.DateTo
+		return x.ApiKey
 	}
 	return nil
 }
 
-func (x *UpdateETCMeisaiRequest) GetDateToDate() string {
-	if x != nil {
-		return x.DateToDate
-	}
-	return ""
-}
-
-func (x *UpdateETCMeisaiRequest) GetIcFr() string {
-	if x != nil {
-		return x.IcFr
-	}
-	return ""
-}
-
-func (x *UpdateETCMeisaiRequest) GetIcTo() string {
-	if x != nil {
-		return x.IcTo
-	}
-	return ""
+type ListApiKeysRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	IncludeRevoked bool                   `protobuf:"varint,2,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateETCMeisaiRequest) GetPriceBf() int32 {
-	if x != nil && x.PriceBf != nil {
-		return *x.PriceBf
-	}
-	return 0
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_service_proto_msgTypes[411]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateETCMeisaiRequest) GetDiscount() int32 {
-	if x != nil && x.Discount != nil {
-		return *x.Discount
-	}
-	return 0
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateETCMeisaiRequest) GetPrice() int32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
+func (*ListApiKeysRequest) ProtoMessage() {}
 
-func (x *UpdateETCMeisaiRequest) GetShashu() int32 {
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[411]
 	if x != nil {
-		return x.Shashu
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateETCMeisaiRequest) GetCarIdNum() int32 {
-	if x != nil && x.CarIdNum != nil {
-		return *x.CarIdNum
-	}
-	return 0
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{411}
 }
 
-func (x *UpdateETCMeisaiRequest) GetEtcNum() string {
+func (x *ListApiKeysRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.EtcNum
-	}
-	return ""
-}
-
-func (x *UpdateETCMeisaiRequest) GetDetail() string {
-	if x != nil && x.Detail != nil {
-		return *x.Detail
-	}
-	return ""
-}
-
-func (x *UpdateETCMeisaiRequest) GetDtakoRowId() string {
-	if x != nil && x.DtakoRowId != nil {
-		return *x.DtakoRowId
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *UpdateETCMeisaiRequest) GetHash() string {
+func (x *ListApiKeysRequest) GetIncludeRevoked() bool {
 	if x != nil {
-		return x.Hash
+		return x.IncludeRevoked
 	}
-	return ""
+	return false
 }
 
-type UpdateETCMeisaiResponse struct {
+type ListApiKeysResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EtcMeisai     *ETCMeisai             `protobuf:"bytes,1,opt,name=etc_meisai,json=etcMeisai,proto3" json:"etc_meisai,omitempty"`
+	ApiKeys       []*ApiKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateETCMeisaiResponse) Reset() {
-	*x = UpdateETCMeisaiResponse{}
-	mi := &file_service_proto_msgTypes[382]
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_service_proto_msgTypes[412]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateETCMeisaiResponse) String() string {
+func (x *ListApiKeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateETCMeisaiResponse) ProtoMessage() {}
+func (*ListApiKeysResponse) ProtoMessage() {}
 
-func (x *UpdateETCMeisaiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[382]
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[412]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -31209,40 +33921,40 @@ func (x *UpdateETCMeisaiResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateETCMeisaiResponse.ProtoReflect.Descriptor instead.
-func (*UpdateETCMeisaiResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{382}
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{412}
 }
 
-func (x *UpdateETCMeisaiResponse) GetEtcMeisai() *ETCMeisai {
+func (x *ListApiKeysResponse) GetApiKeys() []*ApiKey {
 	if x != nil {
-		return x.EtcMeisai
+		return x.ApiKeys
 	}
 	return nil
 }
 
-type DeleteETCMeisaiRequest struct {
+type RevokeApiKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteETCMeisaiRequest) Reset() {
-	*x = DeleteETCMeisaiRequest{}
-	mi := &file_service_proto_msgTypes[383]
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_service_proto_msgTypes[413]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteETCMeisaiRequest) String() string {
+func (x *RevokeApiKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteETCMeisaiRequest) ProtoMessage() {}
+func (*RevokeApiKeyRequest) ProtoMessage() {}
 
-func (x *DeleteETCMeisaiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[383]
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[413]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -31253,40 +33965,40 @@ func (x *DeleteETCMeisaiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteETCMeisaiRequest.ProtoReflect.Descriptor instead.
-func (*DeleteETCMeisaiRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{383}
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{413}
 }
 
-func (x *DeleteETCMeisaiRequest) GetId() int64 {
+func (x *RevokeApiKeyRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type DeleteETCMeisaiResponse struct {
+type RevokeApiKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteETCMeisaiResponse) Reset() {
-	*x = DeleteETCMeisaiResponse{}
-	mi := &file_service_proto_msgTypes[384]
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_service_proto_msgTypes[414]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteETCMeisaiResponse) String() string {
+func (x *RevokeApiKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteETCMeisaiResponse) ProtoMessage() {}
+func (*RevokeApiKeyResponse) ProtoMessage() {}
 
-func (x *DeleteETCMeisaiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[384]
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[414]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -31297,44 +34009,49 @@ func (x *DeleteETCMeisaiResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteETCMeisaiResponse.ProtoReflect.Descriptor instead.
-func (*DeleteETCMeisaiResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{384}
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{414}
 }
 
-func (x *DeleteETCMeisaiResponse) GetSuccess() bool {
+func (x *RevokeApiKeyResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type ListETCMeisaiRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	DateFrom      *string                `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3,oneof" json:"date_from,omitempty"` // YYYY-MM-DD filter
-	DateTo        *string                `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3,oneof" json:"date_to,omitempty"`       // YYYY-MM-DD filter
-	EtcNum        *string                `protobuf:"bytes,5,opt,name=etc_num,json=etcNum,proto3,oneof" json:"etc_num,omitempty"`       // ETCカード番号filter
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AuditEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId *string                `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
+	UserId         *string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"` // unset when the caller used an API key
+	ApiKeyId       *string                `protobuf:"bytes,4,opt,name=api_key_id,json=apiKeyId,proto3,oneof" json:"api_key_id,omitempty"`
+	Method         string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`                              // full gRPC method, e.g. '/organization.CarInspectionService/UpdateCarInspection'
+	EntityType     string                 `protobuf:"bytes,6,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`    // e.g. 'CarInspection'
+	EntityId       *string                `protobuf:"bytes,7,opt,name=entity_id,json=entityId,proto3,oneof" json:"entity_id,omitempty"`    // primary key; 'k1=v1&k2=v2' for composite keys
+	StatusCode     string                 `protobuf:"bytes,8,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`    // gRPC status code, e.g. 'OK', 'PermissionDenied'
+	RequestJson    string                 `protobuf:"bytes,9,opt,name=request_json,json=requestJson,proto3" json:"request_json,omitempty"` // request fields (for updates, the fields written), credentials redacted
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListETCMeisaiRequest) Reset() {
-	*x = ListETCMeisaiRequest{}
-	mi := &file_service_proto_msgTypes[385]
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_service_proto_msgTypes[415]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListETCMeisaiRequest) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListETCMeisaiRequest) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *ListETCMeisaiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[385]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[415]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -31345,70 +34062,111 @@ func (x *ListETCMeisaiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListETCMeisaiRequest.ProtoReflect.Descriptor instead.
-func (*ListETCMeisaiRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{385}
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{415}
 }
 
-func (x *ListETCMeisaiRequest) GetPageSize() int32 {
+func (x *AuditEvent) GetId() string {
 	if x != nil {
-		return x.PageSize
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ListETCMeisaiRequest) GetPageToken() string {
+func (x *AuditEvent) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetApiKeyId() string {
+	if x != nil && x.ApiKeyId != nil {
+		return *x.ApiKeyId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetMethod() string {
 	if x != nil {
-		return x.PageToken
+		return x.Method
 	}
 	return ""
 }
 
-func (x *ListETCMeisaiRequest) GetDateFrom() string {
-	if x != nil && x.DateFrom != nil {
-		return *x.DateFrom
+func (x *AuditEvent) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
 	}
 	return ""
 }
 
-func (x *ListETCMeisaiRequest) GetDateTo() string {
-	if x != nil && x.DateTo != nil {
-		return *x.DateTo
+func (x *AuditEvent) GetEntityId() string {
+	if x != nil && x.EntityId != nil {
+		return *x.EntityId
 	}
 	return ""
 }
 
-func (x *ListETCMeisaiRequest) GetEtcNum() string {
-	if x != nil && x.EtcNum != nil {
-		return *x.EtcNum
+func (x *AuditEvent) GetStatusCode() string {
+	if x != nil {
+		return x.StatusCode
 	}
 	return ""
 }
 
-type ListETCMeisaiResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EtcMeisaiList []*ETCMeisai           `protobuf:"bytes,1,rep,name=etc_meisai_list,json=etcMeisaiList,proto3" json:"etc_meisai_list,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AuditEvent) GetRequestJson() string {
+	if x != nil {
+		return x.RequestJson
+	}
+	return ""
 }
 
-func (x *ListETCMeisaiResponse) Reset() {
-	*x = ListETCMeisaiResponse{}
-	mi := &file_service_proto_msgTypes[386]
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional filters
+	Method         string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	EntityType     string                 `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId       string                 `protobuf:"bytes,5,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"` // inclusive
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`       // exclusive
+	PageSize       int32                  `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_service_proto_msgTypes[416]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListETCMeisaiResponse) String() string {
+func (x *ListAuditEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListETCMeisaiResponse) ProtoMessage() {}
+func (*ListAuditEventsRequest) ProtoMessage() {}
 
-func (x *ListETCMeisaiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[386]
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[416]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -31419,109 +34177,97 @@ func (x *ListETCMeisaiResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListETCMeisaiResponse.ProtoReflect.Descriptor instead.
-func (*ListETCMeisaiResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{386}
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{416}
 }
 
-func (x *ListETCMeisaiResponse) GetEtcMeisaiList() []*ETCMeisai {
+func (x *ListAuditEventsRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.EtcMeisaiList
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListETCMeisaiResponse) GetNextPageToken() string {
+func (x *ListAuditEventsRequest) GetUserId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ListETCMeisaiResponse) GetTotalCount() int32 {
+func (x *ListAuditEventsRequest) GetMethod() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Method
 	}
-	return 0
-}
-
-// Bulk import support
-type BulkCreateETCMeisaiRequest struct {
-	state          protoimpl.MessageState    `protogen:"open.v1"`
-	Records        []*CreateETCMeisaiRequest `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
-	SkipDuplicates bool                      `protobuf:"varint,2,opt,name=skip_duplicates,json=skipDuplicates,proto3" json:"skip_duplicates,omitempty"` // Skip if hash already exists
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	return ""
 }
 
-func (x *BulkCreateETCMeisaiRequest) Reset() {
-	*x = BulkCreateETCMeisaiRequest{}
-	mi := &file_service_proto_msgTypes[387]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListAuditEventsRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
 }
 
-func (x *BulkCreateETCMeisaiRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListAuditEventsRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
 }
 
-func (*BulkCreateETCMeisaiRequest) ProtoMessage() {}
-
-func (x *BulkCreateETCMeisaiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[387]
+func (x *ListAuditEventsRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StartTime
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use BulkCreateETCMeisaiRequest.ProtoReflect.Descriptor instead.
-func (*BulkCreateETCMeisaiRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{387}
+func (x *ListAuditEventsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
 }
 
-func (x *BulkCreateETCMeisaiRequest) GetRecords() []*CreateETCMeisaiRequest {
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Records
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *BulkCreateETCMeisaiRequest) GetSkipDuplicates() bool {
+func (x *ListAuditEventsRequest) GetPageToken() string {
 	if x != nil {
-		return x.SkipDuplicates
+		return x.PageToken
 	}
-	return false
+	return ""
 }
 
-type BulkCreateETCMeisaiResponse struct {
+type ListAuditEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CreatedCount  int32                  `protobuf:"varint,1,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
-	SkippedCount  int32                  `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
-	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkCreateETCMeisaiResponse) Reset() {
-	*x = BulkCreateETCMeisaiResponse{}
-	mi := &file_service_proto_msgTypes[388]
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_service_proto_msgTypes[417]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkCreateETCMeisaiResponse) String() string {
+func (x *ListAuditEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkCreateETCMeisaiResponse) ProtoMessage() {}
+func (*ListAuditEventsResponse) ProtoMessage() {}
 
-func (x *BulkCreateETCMeisaiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[388]
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[417]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -31532,37 +34278,30 @@ func (x *BulkCreateETCMeisaiResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkCreateETCMeisaiResponse.ProtoReflect.Descriptor instead.
-func (*BulkCreateETCMeisaiResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{388}
-}
-
-func (x *BulkCreateETCMeisaiResponse) GetCreatedCount() int32 {
-	if x != nil {
-		return x.CreatedCount
-	}
-	return 0
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{417}
 }
 
-func (x *BulkCreateETCMeisaiResponse) GetSkippedCount() int32 {
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
 	if x != nil {
-		return x.SkippedCount
+		return x.Events
 	}
-	return 0
+	return nil
 }
 
-func (x *BulkCreateETCMeisaiResponse) GetErrors() []string {
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Errors
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
 var File_service_proto protoreflect.FileDescriptor
 
 const file_service_proto_rawDesc = "" +
 	"\n" +
-	"\rservice.proto\x12\forganization\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8b\x02\n" +
+	"\rservice.proto\x12\forganization\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\"\x9f\x02\n" +
 	"\fOrganization\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -31572,7 +34311,8 @@ const file_service_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
 	"\n" +
-	"deleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tdeletedAt\x88\x01\x01B\r\n" +
+	"deleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tdeletedAt\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etagB\r\n" +
 	"\v_deleted_at\"/\n" +
 	"\x19CreateOrganizationRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"\\\n" +
@@ -31581,15 +34321,17 @@ const file_service_proto_rawDesc = "" +
 	"\x16GetOrganizationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"Y\n" +
 	"\x17GetOrganizationResponse\x12>\n" +
-	"\forganization\x18\x01 \x01(\v2\x1a.organization.OrganizationR\forganization\"S\n" +
+	"\forganization\x18\x01 \x01(\v2\x1a.organization.OrganizationR\forganization\"g\n" +
 	"\x19UpdateOrganizationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04slug\x18\x03 \x01(\tR\x04slug\"\\\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"\\\n" +
 	"\x1aUpdateOrganizationResponse\x12>\n" +
-	"\forganization\x18\x01 \x01(\v2\x1a.organization.OrganizationR\forganization\"+\n" +
+	"\forganization\x18\x01 \x01(\v2\x1a.organization.OrganizationR\forganization\"?\n" +
 	"\x19DeleteOrganizationRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"6\n" +
 	"\x1aDeleteOrganizationResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"V\n" +
 	"\x18ListOrganizationsRequest\x12\x1b\n" +
@@ -31598,7 +34340,7 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\"\x85\x01\n" +
 	"\x19ListOrganizationsResponse\x12@\n" +
 	"\rorganizations\x18\x01 \x03(\v2\x1a.organization.OrganizationR\rorganizations\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xfe\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x92\x03\n" +
 	"\aAppUser\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05email\x18\x02 \x01(\tH\x00R\x05email\x88\x01\x01\x12!\n" +
@@ -31611,7 +34353,8 @@ const file_service_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
 	"\n" +
-	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tdeletedAt\x88\x01\x01B\b\n" +
+	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tdeletedAt\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\t \x01(\tR\x04etagB\b\n" +
 	"\x06_emailB\r\n" +
 	"\v_avatar_urlB\r\n" +
 	"\v_deleted_at\"\xb6\x01\n" +
@@ -31632,18 +34375,20 @@ const file_service_proto_rawDesc = "" +
 	"\x18GetAppUserByEmailRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"M\n" +
 	"\x19GetAppUserByEmailResponse\x120\n" +
-	"\bapp_user\x18\x01 \x01(\v2\x15.organization.AppUserR\aappUser\"\xa1\x01\n" +
+	"\bapp_user\x18\x01 \x01(\v2\x15.organization.AppUserR\aappUser\"\xb5\x01\n" +
 	"\x14UpdateAppUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\"\n" +
 	"\n" +
 	"avatar_url\x18\x03 \x01(\tH\x00R\tavatarUrl\x88\x01\x01\x12#\n" +
-	"\ris_superadmin\x18\x04 \x01(\bR\fisSuperadminB\r\n" +
+	"\ris_superadmin\x18\x04 \x01(\bR\fisSuperadmin\x12\x12\n" +
+	"\x04etag\x18\x05 \x01(\tR\x04etagB\r\n" +
 	"\v_avatar_url\"I\n" +
 	"\x15UpdateAppUserResponse\x120\n" +
-	"\bapp_user\x18\x01 \x01(\v2\x15.organization.AppUserR\aappUser\"&\n" +
+	"\bapp_user\x18\x01 \x01(\v2\x15.organization.AppUserR\aappUser\":\n" +
 	"\x14DeleteAppUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"1\n" +
 	"\x15DeleteAppUserResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
 	"\x13ListAppUsersRequest\x12\x1b\n" +
@@ -31652,7 +34397,7 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\"r\n" +
 	"\x14ListAppUsersResponse\x122\n" +
 	"\tapp_users\x18\x01 \x03(\v2\x15.organization.AppUserR\bappUsers\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8d\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa1\x02\n" +
 	"\x10UserOrganization\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
@@ -31663,7 +34408,8 @@ const file_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x94\x01\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etag\"\x94\x01\n" +
 	"\x1dCreateUserOrganizationRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -31675,16 +34421,18 @@ const file_service_proto_rawDesc = "" +
 	"\x1aGetUserOrganizationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"j\n" +
 	"\x1bGetUserOrganizationResponse\x12K\n" +
-	"\x11user_organization\x18\x01 \x01(\v2\x1e.organization.UserOrganizationR\x10userOrganization\"b\n" +
+	"\x11user_organization\x18\x01 \x01(\v2\x1e.organization.UserOrganizationR\x10userOrganization\"v\n" +
 	"\x1dUpdateUserOrganizationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1d\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\"m\n" +
+	"is_default\x18\x03 \x01(\bR\tisDefault\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"m\n" +
 	"\x1eUpdateUserOrganizationResponse\x12K\n" +
-	"\x11user_organization\x18\x01 \x01(\v2\x1e.organization.UserOrganizationR\x10userOrganization\"/\n" +
+	"\x11user_organization\x18\x01 \x01(\v2\x1e.organization.UserOrganizationR\x10userOrganization\"C\n" +
 	"\x1dDeleteUserOrganizationRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\":\n" +
 	"\x1eDeleteUserOrganizationResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"Z\n" +
 	"\x1cListUserOrganizationsRequest\x12\x1b\n" +
@@ -31701,7 +34449,7 @@ const file_service_proto_rawDesc = "" +
 	"!ListUserOrganizationsByOrgRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"s\n" +
 	"\"ListUserOrganizationsByOrgResponse\x12M\n" +
-	"\x12user_organizations\x18\x01 \x03(\v2\x1e.organization.UserOrganizationR\x11userOrganizations\"\xc9\x01\n" +
+	"\x12user_organizations\x18\x01 \x03(\v2\x1e.organization.UserOrganizationR\x11userOrganizations\"\xdd\x01\n" +
 	"\x04File\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x1a\n" +
@@ -31709,7 +34457,8 @@ const file_service_proto_rawDesc = "" +
 	"\acreated\x18\x04 \x01(\tR\acreated\x12\x18\n" +
 	"\adeleted\x18\x05 \x01(\tR\adeleted\x12\x12\n" +
 	"\x04type\x18\x06 \x01(\tR\x04type\x12\x17\n" +
-	"\x04blob\x18\a \x01(\tH\x00R\x04blob\x88\x01\x01B\a\n" +
+	"\x04blob\x18\a \x01(\tH\x00R\x04blob\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etagB\a\n" +
 	"\x05_blob\"\xa8\x01\n" +
 	"\x11CreateFileRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1a\n" +
@@ -31723,18 +34472,20 @@ const file_service_proto_rawDesc = "" +
 	"\x0eGetFileRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"9\n" +
 	"\x0fGetFileResponse\x12&\n" +
-	"\x04file\x18\x01 \x01(\v2\x12.organization.FileR\x04file\"y\n" +
+	"\x04file\x18\x01 \x01(\v2\x12.organization.FileR\x04file\"\x8d\x01\n" +
 	"\x11UpdateFileRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1a\n" +
 	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\tR\x04type\x12\x17\n" +
-	"\x04blob\x18\x04 \x01(\tH\x00R\x04blob\x88\x01\x01B\a\n" +
+	"\x04blob\x18\x04 \x01(\tH\x00R\x04blob\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\x05 \x01(\tR\x04etagB\a\n" +
 	"\x05_blob\"<\n" +
 	"\x12UpdateFileResponse\x12&\n" +
-	"\x04file\x18\x01 \x01(\v2\x12.organization.FileR\x04file\"T\n" +
+	"\x04file\x18\x01 \x01(\v2\x12.organization.FileR\x04file\"h\n" +
 	"\x11DeleteFileRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12+\n" +
-	"\x11deleted_timestamp\x18\x02 \x01(\tR\x10deletedTimestamp\".\n" +
+	"\x11deleted_timestamp\x18\x02 \x01(\tR\x10deletedTimestamp\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\".\n" +
 	"\x12DeleteFileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"N\n" +
 	"\x10ListFilesRequest\x12\x1b\n" +
@@ -31751,12 +34502,13 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"s\n" +
 	"\x1fListFilesByOrganizationResponse\x12(\n" +
 	"\x05files\x18\x01 \x03(\v2\x12.organization.FileR\x05files\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"v\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8a\x01\n" +
 	"\vFlickrPhoto\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x16\n" +
 	"\x06secret\x18\x03 \x01(\tR\x06secret\x12\x16\n" +
-	"\x06server\x18\x04 \x01(\tR\x06server\"\x83\x01\n" +
+	"\x06server\x18\x04 \x01(\tR\x06server\x12\x12\n" +
+	"\x04etag\x18\x05 \x01(\tR\x04etag\"\x83\x01\n" +
 	"\x18CreateFlickrPhotoRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x16\n" +
@@ -31767,15 +34519,17 @@ const file_service_proto_rawDesc = "" +
 	"\x15GetFlickrPhotoRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
 	"\x16GetFlickrPhotoResponse\x12<\n" +
-	"\fflickr_photo\x18\x01 \x01(\v2\x19.organization.FlickrPhotoR\vflickrPhoto\"Z\n" +
+	"\fflickr_photo\x18\x01 \x01(\v2\x19.organization.FlickrPhotoR\vflickrPhoto\"n\n" +
 	"\x18UpdateFlickrPhotoRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x16\n" +
-	"\x06server\x18\x03 \x01(\tR\x06server\"Y\n" +
+	"\x06server\x18\x03 \x01(\tR\x06server\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"Y\n" +
 	"\x19UpdateFlickrPhotoResponse\x12<\n" +
-	"\fflickr_photo\x18\x01 \x01(\v2\x19.organization.FlickrPhotoR\vflickrPhoto\"*\n" +
+	"\fflickr_photo\x18\x01 \x01(\v2\x19.organization.FlickrPhotoR\vflickrPhoto\">\n" +
 	"\x18DeleteFlickrPhotoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"5\n" +
 	"\x19DeleteFlickrPhotoResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"U\n" +
 	"\x17ListFlickrPhotosRequest\x12\x1b\n" +
@@ -31792,7 +34546,7 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"\x90\x01\n" +
 	"&ListFlickrPhotosByOrganizationResponse\x12>\n" +
 	"\rflickr_photos\x18\x01 \x03(\v2\x19.organization.FlickrPhotoR\fflickrPhotos\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc4\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd8\x01\n" +
 	"\aCamFile\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -31800,7 +34554,8 @@ const file_service_proto_rawDesc = "" +
 	"\x04hour\x18\x04 \x01(\tR\x04hour\x12\x12\n" +
 	"\x04type\x18\x05 \x01(\tR\x04type\x12\x10\n" +
 	"\x03cam\x18\x06 \x01(\tR\x03cam\x12 \n" +
-	"\tflickr_id\x18\a \x01(\tH\x00R\bflickrId\x88\x01\x01B\f\n" +
+	"\tflickr_id\x18\a \x01(\tH\x00R\bflickrId\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etagB\f\n" +
 	"\n" +
 	"_flickr_id\"\xd1\x01\n" +
 	"\x14CreateCamFileRequest\x12\x12\n" +
@@ -31819,7 +34574,7 @@ const file_service_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"F\n" +
 	"\x12GetCamFileResponse\x120\n" +
-	"\bcam_file\x18\x01 \x01(\v2\x15.organization.CamFileR\acamFile\"\xd1\x01\n" +
+	"\bcam_file\x18\x01 \x01(\v2\x15.organization.CamFileR\acamFile\"\xe5\x01\n" +
 	"\x14UpdateCamFileRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -31827,14 +34582,16 @@ const file_service_proto_rawDesc = "" +
 	"\x04hour\x18\x04 \x01(\tR\x04hour\x12\x12\n" +
 	"\x04type\x18\x05 \x01(\tR\x04type\x12\x10\n" +
 	"\x03cam\x18\x06 \x01(\tR\x03cam\x12 \n" +
-	"\tflickr_id\x18\a \x01(\tH\x00R\bflickrId\x88\x01\x01B\f\n" +
+	"\tflickr_id\x18\a \x01(\tH\x00R\bflickrId\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etagB\f\n" +
 	"\n" +
 	"_flickr_id\"I\n" +
 	"\x15UpdateCamFileResponse\x120\n" +
-	"\bcam_file\x18\x01 \x01(\v2\x15.organization.CamFileR\acamFile\"S\n" +
+	"\bcam_file\x18\x01 \x01(\v2\x15.organization.CamFileR\acamFile\"g\n" +
 	"\x14DeleteCamFileRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12'\n" +
-	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"1\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"1\n" +
 	"\x15DeleteCamFileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
 	"\x13ListCamFilesRequest\x12\x1b\n" +
@@ -31843,21 +34600,24 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\"r\n" +
 	"\x14ListCamFilesResponse\x122\n" +
 	"\tcam_files\x18\x01 \x03(\v2\x15.organization.CamFileR\bcamFiles\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x88\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbb\x01\n" +
 	"!ListCamFilesByOrganizationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"\x80\x01\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"\x80\x01\n" +
 	"\"ListCamFilesByOrganizationResponse\x122\n" +
 	"\tcam_files\x18\x01 \x03(\v2\x15.organization.CamFileR\bcamFiles\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"q\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x85\x01\n" +
 	"\n" +
 	"CamFileExe\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03cam\x18\x02 \x01(\tR\x03cam\x12'\n" +
 	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12\x14\n" +
-	"\x05stage\x18\x04 \x01(\x05R\x05stage\"~\n" +
+	"\x05stage\x18\x04 \x01(\x05R\x05stage\x12\x12\n" +
+	"\x04etag\x18\x05 \x01(\tR\x04etag\"~\n" +
 	"\x17CreateCamFileExeRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03cam\x18\x02 \x01(\tR\x03cam\x12'\n" +
@@ -31872,19 +34632,21 @@ const file_service_proto_rawDesc = "" +
 	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\"S\n" +
 	"\x15GetCamFileExeResponse\x12:\n" +
 	"\fcam_file_exe\x18\x01 \x01(\v2\x18.organization.CamFileExeR\n" +
-	"camFileExe\"~\n" +
+	"camFileExe\"\x92\x01\n" +
 	"\x17UpdateCamFileExeRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03cam\x18\x02 \x01(\tR\x03cam\x12'\n" +
 	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12\x14\n" +
-	"\x05stage\x18\x04 \x01(\x05R\x05stage\"V\n" +
+	"\x05stage\x18\x04 \x01(\x05R\x05stage\x12\x12\n" +
+	"\x04etag\x18\x05 \x01(\tR\x04etag\"V\n" +
 	"\x18UpdateCamFileExeResponse\x12:\n" +
 	"\fcam_file_exe\x18\x01 \x01(\v2\x18.organization.CamFileExeR\n" +
-	"camFileExe\"h\n" +
+	"camFileExe\"|\n" +
 	"\x17DeleteCamFileExeRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03cam\x18\x02 \x01(\tR\x03cam\x12'\n" +
-	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\"4\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"4\n" +
 	"\x18DeleteCamFileExeResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"T\n" +
 	"\x16ListCamFileExesRequest\x12\x1b\n" +
@@ -31901,11 +34663,12 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"\x8d\x01\n" +
 	"%ListCamFileExesByOrganizationResponse\x12<\n" +
 	"\rcam_file_exes\x18\x01 \x03(\v2\x18.organization.CamFileExeR\vcamFileExes\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"d\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"x\n" +
 	"\x0fCamFileExeStage\x12\x14\n" +
 	"\x05stage\x18\x01 \x01(\x05R\x05stage\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"q\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"q\n" +
 	"\x1cCreateCamFileExeStageRequest\x12\x14\n" +
 	"\x05stage\x18\x01 \x01(\x05R\x05stage\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -31916,16 +34679,18 @@ const file_service_proto_rawDesc = "" +
 	"\x05stage\x18\x01 \x01(\x05R\x05stage\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"h\n" +
 	"\x1aGetCamFileExeStageResponse\x12J\n" +
-	"\x12cam_file_exe_stage\x18\x01 \x01(\v2\x1d.organization.CamFileExeStageR\x0fcamFileExeStage\"q\n" +
+	"\x12cam_file_exe_stage\x18\x01 \x01(\v2\x1d.organization.CamFileExeStageR\x0fcamFileExeStage\"\x85\x01\n" +
 	"\x1cUpdateCamFileExeStageRequest\x12\x14\n" +
 	"\x05stage\x18\x01 \x01(\x05R\x05stage\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"k\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"k\n" +
 	"\x1dUpdateCamFileExeStageResponse\x12J\n" +
-	"\x12cam_file_exe_stage\x18\x01 \x01(\v2\x1d.organization.CamFileExeStageR\x0fcamFileExeStage\"]\n" +
+	"\x12cam_file_exe_stage\x18\x01 \x01(\v2\x1d.organization.CamFileExeStageR\x0fcamFileExeStage\"q\n" +
 	"\x1cDeleteCamFileExeStageRequest\x12\x14\n" +
 	"\x05stage\x18\x01 \x01(\x05R\x05stage\x12'\n" +
-	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"9\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"9\n" +
 	"\x1dDeleteCamFileExeStageResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"Y\n" +
 	"\x1bListCamFileExeStagesRequest\x12\x1b\n" +
@@ -31942,7 +34707,7 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"\xa2\x01\n" +
 	"*ListCamFileExeStagesByOrganizationResponse\x12L\n" +
 	"\x13cam_file_exe_stages\x18\x01 \x03(\v2\x1d.organization.CamFileExeStageR\x10camFileExeStages\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe1\x03\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf5\x03\n" +
 	"\n" +
 	"IchibanCar\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
@@ -31959,7 +34724,8 @@ const file_service_proto_rawDesc = "" +
 	"scrap_date\x18\n" +
 	" \x01(\tH\x05R\tscrapDate\x88\x01\x01\x12'\n" +
 	"\rbumon_code_id\x18\v \x01(\tH\x06R\vbumonCodeId\x88\x01\x01\x12 \n" +
-	"\tdriver_id\x18\f \x01(\tH\aR\bdriverId\x88\x01\x01B\a\n" +
+	"\tdriver_id\x18\f \x01(\tH\aR\bdriverId\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\r \x01(\tR\x04etagB\a\n" +
 	"\x05_nameB\t\n" +
 	"\a_name_rB\n" +
 	"\n" +
@@ -32003,7 +34769,7 @@ const file_service_proto_rawDesc = "" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"R\n" +
 	"\x15GetIchibanCarResponse\x129\n" +
 	"\vichiban_car\x18\x01 \x01(\v2\x18.organization.IchibanCarR\n" +
-	"ichibanCar\"\xee\x03\n" +
+	"ichibanCar\"\x82\x04\n" +
 	"\x17UpdateIchibanCarRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x10\n" +
@@ -32019,7 +34785,8 @@ const file_service_proto_rawDesc = "" +
 	"scrap_date\x18\n" +
 	" \x01(\tH\x05R\tscrapDate\x88\x01\x01\x12'\n" +
 	"\rbumon_code_id\x18\v \x01(\tH\x06R\vbumonCodeId\x88\x01\x01\x12 \n" +
-	"\tdriver_id\x18\f \x01(\tH\aR\bdriverId\x88\x01\x01B\a\n" +
+	"\tdriver_id\x18\f \x01(\tH\aR\bdriverId\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\r \x01(\tR\x04etagB\a\n" +
 	"\x05_nameB\t\n" +
 	"\a_name_rB\n" +
 	"\n" +
@@ -32032,10 +34799,11 @@ const file_service_proto_rawDesc = "" +
 	"_driver_id\"U\n" +
 	"\x18UpdateIchibanCarResponse\x129\n" +
 	"\vichiban_car\x18\x01 \x01(\v2\x18.organization.IchibanCarR\n" +
-	"ichibanCar\"R\n" +
+	"ichibanCar\"f\n" +
 	"\x17DeleteIchibanCarRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
-	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"4\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"4\n" +
 	"\x18DeleteIchibanCarResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"T\n" +
 	"\x16ListIchibanCarsRequest\x12\x1b\n" +
@@ -32052,11 +34820,12 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"\x8c\x01\n" +
 	"%ListIchibanCarsByOrganizationResponse\x12;\n" +
 	"\fichiban_cars\x18\x01 \x03(\v2\x18.organization.IchibanCarR\vichibanCars\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"v\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8a\x01\n" +
 	"\x14DtakoCarsIchibanCars\x12\x19\n" +
 	"\bid_dtako\x18\x01 \x01(\tR\aidDtako\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x13\n" +
-	"\x02id\x18\x03 \x01(\tH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x02id\x18\x03 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etagB\x05\n" +
 	"\x03_id\"\x83\x01\n" +
 	"!CreateDtakoCarsIchibanCarsRequest\x12\x19\n" +
 	"\bid_dtako\x18\x01 \x01(\tR\aidDtako\x12'\n" +
@@ -32069,17 +34838,19 @@ const file_service_proto_rawDesc = "" +
 	"\bid_dtako\x18\x01 \x01(\tR\aidDtako\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"|\n" +
 	"\x1fGetDtakoCarsIchibanCarsResponse\x12Y\n" +
-	"\x17dtako_cars_ichiban_cars\x18\x01 \x01(\v2\".organization.DtakoCarsIchibanCarsR\x14dtakoCarsIchibanCars\"\x83\x01\n" +
+	"\x17dtako_cars_ichiban_cars\x18\x01 \x01(\v2\".organization.DtakoCarsIchibanCarsR\x14dtakoCarsIchibanCars\"\x97\x01\n" +
 	"!UpdateDtakoCarsIchibanCarsRequest\x12\x19\n" +
 	"\bid_dtako\x18\x01 \x01(\tR\aidDtako\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x13\n" +
-	"\x02id\x18\x03 \x01(\tH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x02id\x18\x03 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etagB\x05\n" +
 	"\x03_id\"\x7f\n" +
 	"\"UpdateDtakoCarsIchibanCarsResponse\x12Y\n" +
-	"\x17dtako_cars_ichiban_cars\x18\x01 \x01(\v2\".organization.DtakoCarsIchibanCarsR\x14dtakoCarsIchibanCars\"g\n" +
+	"\x17dtako_cars_ichiban_cars\x18\x01 \x01(\v2\".organization.DtakoCarsIchibanCarsR\x14dtakoCarsIchibanCars\"{\n" +
 	"!DeleteDtakoCarsIchibanCarsRequest\x12\x19\n" +
 	"\bid_dtako\x18\x01 \x01(\tR\aidDtako\x12'\n" +
-	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\">\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\">\n" +
 	"\"DeleteDtakoCarsIchibanCarsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"]\n" +
 	"\x1fListDtakoCarsIchibanCarsRequest\x12\x1b\n" +
@@ -32096,7 +34867,7 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"\xb3\x01\n" +
 	".ListDtakoCarsIchibanCarsByOrganizationResponse\x12Y\n" +
 	"\x17dtako_cars_ichiban_cars\x18\x01 \x03(\v2\".organization.DtakoCarsIchibanCarsR\x14dtakoCarsIchibanCars\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xdb\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xef\x01\n" +
 	"\x06Uriage\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05bumon\x18\x02 \x01(\tR\x05bumon\x12'\n" +
@@ -32104,7 +34875,8 @@ const file_service_proto_rawDesc = "" +
 	"\akingaku\x18\x04 \x01(\x05H\x00R\akingaku\x88\x01\x01\x12\x17\n" +
 	"\x04type\x18\x05 \x01(\x05H\x01R\x04type\x88\x01\x01\x12\x15\n" +
 	"\x03cam\x18\x06 \x01(\x05H\x02R\x03cam\x88\x01\x01\x12\x12\n" +
-	"\x04date\x18\a \x01(\tR\x04dateB\n" +
+	"\x04date\x18\a \x01(\tR\x04date\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_kingakuB\a\n" +
 	"\x05_typeB\x06\n" +
@@ -32129,7 +34901,7 @@ const file_service_proto_rawDesc = "" +
 	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12\x12\n" +
 	"\x04date\x18\x04 \x01(\tR\x04date\"A\n" +
 	"\x11GetUriageResponse\x12,\n" +
-	"\x06uriage\x18\x01 \x01(\v2\x14.organization.UriageR\x06uriage\"\xe8\x01\n" +
+	"\x06uriage\x18\x01 \x01(\v2\x14.organization.UriageR\x06uriage\"\xfc\x01\n" +
 	"\x13UpdateUriageRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05bumon\x18\x02 \x01(\tR\x05bumon\x12'\n" +
@@ -32137,41 +34909,48 @@ const file_service_proto_rawDesc = "" +
 	"\x04date\x18\x04 \x01(\tR\x04date\x12\x1d\n" +
 	"\akingaku\x18\x05 \x01(\x05H\x00R\akingaku\x88\x01\x01\x12\x17\n" +
 	"\x04type\x18\x06 \x01(\x05H\x01R\x04type\x88\x01\x01\x12\x15\n" +
-	"\x03cam\x18\a \x01(\x05H\x02R\x03cam\x88\x01\x01B\n" +
+	"\x03cam\x18\a \x01(\x05H\x02R\x03cam\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_kingakuB\a\n" +
 	"\x05_typeB\x06\n" +
 	"\x04_cam\"D\n" +
 	"\x14UpdateUriageResponse\x12,\n" +
-	"\x06uriage\x18\x01 \x01(\v2\x14.organization.UriageR\x06uriage\"|\n" +
+	"\x06uriage\x18\x01 \x01(\v2\x14.organization.UriageR\x06uriage\"\x90\x01\n" +
 	"\x13DeleteUriageRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05bumon\x18\x02 \x01(\tR\x05bumon\x12'\n" +
 	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12\x12\n" +
-	"\x04date\x18\x04 \x01(\tR\x04date\"0\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x12\n" +
+	"\x04etag\x18\x05 \x01(\tR\x04etag\"0\n" +
 	"\x14DeleteUriageResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"P\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x83\x01\n" +
 	"\x12ListUriagesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"m\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"m\n" +
 	"\x13ListUriagesResponse\x12.\n" +
 	"\auriages\x18\x01 \x03(\v2\x14.organization.UriageR\auriages\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x87\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xba\x01\n" +
 	" ListUriagesByOrganizationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"{\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"{\n" +
 	"!ListUriagesByOrganizationResponse\x12.\n" +
 	"\auriages\x18\x01 \x03(\v2\x14.organization.UriageR\auriages\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xad\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc1\x01\n" +
 	"\vUriageJisha\x12\x14\n" +
 	"\x05bumon\x18\x01 \x01(\tR\x05bumon\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x1d\n" +
 	"\akingaku\x18\x03 \x01(\x05H\x00R\akingaku\x88\x01\x01\x12\x17\n" +
 	"\x04type\x18\x04 \x01(\x05H\x01R\x04type\x88\x01\x01\x12\x12\n" +
-	"\x04date\x18\x05 \x01(\tR\x04dateB\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\x12\x12\n" +
+	"\x04etag\x18\x06 \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_kingakuB\a\n" +
 	"\x05_type\"\xba\x01\n" +
@@ -32191,22 +34970,24 @@ const file_service_proto_rawDesc = "" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
 	"\x04date\x18\x03 \x01(\tR\x04date\"V\n" +
 	"\x16GetUriageJishaResponse\x12<\n" +
-	"\furiage_jisha\x18\x01 \x01(\v2\x19.organization.UriageJishaR\vuriageJisha\"\xba\x01\n" +
+	"\furiage_jisha\x18\x01 \x01(\v2\x19.organization.UriageJishaR\vuriageJisha\"\xce\x01\n" +
 	"\x18UpdateUriageJishaRequest\x12\x14\n" +
 	"\x05bumon\x18\x01 \x01(\tR\x05bumon\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
 	"\x04date\x18\x03 \x01(\tR\x04date\x12\x1d\n" +
 	"\akingaku\x18\x04 \x01(\x05H\x00R\akingaku\x88\x01\x01\x12\x17\n" +
-	"\x04type\x18\x05 \x01(\x05H\x01R\x04type\x88\x01\x01B\n" +
+	"\x04type\x18\x05 \x01(\x05H\x01R\x04type\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\x06 \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_kingakuB\a\n" +
 	"\x05_type\"Y\n" +
 	"\x19UpdateUriageJishaResponse\x12<\n" +
-	"\furiage_jisha\x18\x01 \x01(\v2\x19.organization.UriageJishaR\vuriageJisha\"m\n" +
+	"\furiage_jisha\x18\x01 \x01(\v2\x19.organization.UriageJishaR\vuriageJisha\"\x81\x01\n" +
 	"\x18DeleteUriageJishaRequest\x12\x14\n" +
 	"\x05bumon\x18\x01 \x01(\tR\x05bumon\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
-	"\x04date\x18\x03 \x01(\tR\x04date\"5\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"5\n" +
 	"\x19DeleteUriageJishaResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"U\n" +
 	"\x17ListUriageJishasRequest\x12\x1b\n" +
@@ -32223,7 +35004,7 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"\x90\x01\n" +
 	"&ListUriageJishasByOrganizationResponse\x12>\n" +
 	"\ruriage_jishas\x18\x01 \x03(\v2\x19.organization.UriageJishaR\furiageJishas\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc7%\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xdb%\n" +
 	"\rCarInspection\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12@\n" +
 	"\x1dcert_info_import_file_version\x18\x02 \x01(\tR\x19certInfoImportFileVersion\x12(\n" +
@@ -32333,7 +35114,8 @@ const file_service_proto_rawDesc = "" +
 	"&twodimension_code_info_fuel_class_code\x18_ \x01(\tR!twodimensionCodeInfoFuelClassCode\x12/\n" +
 	"\x14regist_car_light_car\x18` \x01(\tR\x11registCarLightCar\x12\x18\n" +
 	"\acreated\x18a \x01(\tR\acreated\x12\x1a\n" +
-	"\bmodified\x18b \x01(\tR\bmodified\"\xd4%\n" +
+	"\bmodified\x18b \x01(\tR\bmodified\x12\x12\n" +
+	"\x04etag\x18c \x01(\tR\x04etag\"\x90&\n" +
 	"\x1aCreateCarInspectionRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12@\n" +
 	"\x1dcert_info_import_file_version\x18\x02 \x01(\tR\x19certInfoImportFileVersion\x12(\n" +
@@ -32443,9 +35225,15 @@ const file_service_proto_rawDesc = "" +
 	"&twodimension_code_info_fuel_class_code\x18_ \x01(\tR!twodimensionCodeInfoFuelClassCode\x12/\n" +
 	"\x14regist_car_light_car\x18` \x01(\tR\x11registCarLightCar\x12\x18\n" +
 	"\acreated\x18a \x01(\tR\acreated\x12\x1a\n" +
-	"\bmodified\x18b \x01(\tR\bmodified\"a\n" +
+	"\bmodified\x18b \x01(\tR\bmodified\x12:\n" +
+	"\x05files\x18c \x03(\v2$.organization.CarInspectionFileInputR\x05files\"b\n" +
+	"\x16CarInspectionFileInput\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\tR\acreated\x12\x1a\n" +
+	"\bmodified\x18\x03 \x01(\tR\bmodified\"\xb4\x01\n" +
 	"\x1bCreateCarInspectionResponse\x12B\n" +
-	"\x0ecar_inspection\x18\x01 \x01(\v2\x1b.organization.CarInspectionR\rcarInspection\"\xcf\x02\n" +
+	"\x0ecar_inspection\x18\x01 \x01(\v2\x1b.organization.CarInspectionR\rcarInspection\x12Q\n" +
+	"\x14car_inspection_files\x18\x02 \x03(\v2\x1f.organization.CarInspectionFileR\x12carInspectionFiles\"\xcf\x02\n" +
 	"\x17GetCarInspectionRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
 	"\x10elect_cert_mg_no\x18\x02 \x01(\tR\relectCertMgNo\x127\n" +
@@ -32454,7 +35242,7 @@ const file_service_proto_rawDesc = "" +
 	"\x18elect_cert_publishdate_m\x18\x05 \x01(\tR\x15electCertPublishdateM\x127\n" +
 	"\x18elect_cert_publishdate_d\x18\x06 \x01(\tR\x15electCertPublishdateD\"^\n" +
 	"\x18GetCarInspectionResponse\x12B\n" +
-	"\x0ecar_inspection\x18\x01 \x01(\v2\x1b.organization.CarInspectionR\rcarInspection\"\xba%\n" +
+	"\x0ecar_inspection\x18\x01 \x01(\v2\x1b.organization.CarInspectionR\rcarInspection\"\x8b&\n" +
 	"\x1aUpdateCarInspectionRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
 	"\x10elect_cert_mg_no\x18\x02 \x01(\tR\relectCertMgNo\x127\n" +
@@ -32563,33 +35351,42 @@ const file_service_proto_rawDesc = "" +
 	"$twodimension_code_info_safe_std_date\x18^ \x01(\tR\x1ftwodimensionCodeInfoSafeStdDate\x12Q\n" +
 	"&twodimension_code_info_fuel_class_code\x18_ \x01(\tR!twodimensionCodeInfoFuelClassCode\x12/\n" +
 	"\x14regist_car_light_car\x18` \x01(\tR\x11registCarLightCar\x12\x1a\n" +
-	"\bmodified\x18a \x01(\tR\bmodified\"a\n" +
+	"\bmodified\x18a \x01(\tR\bmodified\x12;\n" +
+	"\vupdate_mask\x18b \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18c \x01(\tR\x04etag\"a\n" +
 	"\x1bUpdateCarInspectionResponse\x12B\n" +
-	"\x0ecar_inspection\x18\x01 \x01(\v2\x1b.organization.CarInspectionR\rcarInspection\"\xd2\x02\n" +
+	"\x0ecar_inspection\x18\x01 \x01(\v2\x1b.organization.CarInspectionR\rcarInspection\"\xe6\x02\n" +
 	"\x1aDeleteCarInspectionRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
 	"\x10elect_cert_mg_no\x18\x02 \x01(\tR\relectCertMgNo\x127\n" +
 	"\x18elect_cert_publishdate_e\x18\x03 \x01(\tR\x15electCertPublishdateE\x127\n" +
 	"\x18elect_cert_publishdate_y\x18\x04 \x01(\tR\x15electCertPublishdateY\x127\n" +
 	"\x18elect_cert_publishdate_m\x18\x05 \x01(\tR\x15electCertPublishdateM\x127\n" +
-	"\x18elect_cert_publishdate_d\x18\x06 \x01(\tR\x15electCertPublishdateD\"7\n" +
+	"\x18elect_cert_publishdate_d\x18\x06 \x01(\tR\x15electCertPublishdateD\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etag\"\\\n" +
 	"\x1bDeleteCarInspectionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"W\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rdeleted_files\x18\x02 \x01(\x05R\fdeletedFiles\"\x8a\x01\n" +
 	"\x19ListCarInspectionsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"\x8a\x01\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"\x8a\x01\n" +
 	"\x1aListCarInspectionsResponse\x12D\n" +
 	"\x0fcar_inspections\x18\x01 \x03(\v2\x1b.organization.CarInspectionR\x0ecarInspections\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8e\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc1\x01\n" +
 	"'ListCarInspectionsByOrganizationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"\x98\x01\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"\x98\x01\n" +
 	"(ListCarInspectionsByOrganizationResponse\x12D\n" +
 	"\x0fcar_inspections\x18\x01 \x03(\v2\x1b.organization.CarInspectionR\x0ecarInspections\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd2\x03\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe6\x03\n" +
 	"\x11CarInspectionFile\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -32602,7 +35399,8 @@ const file_service_proto_rawDesc = "" +
 	"\acreated\x18\t \x01(\tR\acreated\x12\x1a\n" +
 	"\bmodified\x18\n" +
 	" \x01(\tR\bmodified\x12\x1d\n" +
-	"\adeleted\x18\v \x01(\tH\x00R\adeleted\x88\x01\x01B\n" +
+	"\adeleted\x18\v \x01(\tH\x00R\adeleted\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\f \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deleted\"\xa0\x03\n" +
 	"\x1eCreateCarInspectionFileRequest\x12'\n" +
@@ -32620,15 +35418,17 @@ const file_service_proto_rawDesc = "" +
 	"\x1bGetCarInspectionFileRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"o\n" +
 	"\x1cGetCarInspectionFileResponse\x12O\n" +
-	"\x13car_inspection_file\x18\x01 \x01(\v2\x1f.organization.CarInspectionFileR\x11carInspectionFile\"d\n" +
+	"\x13car_inspection_file\x18\x01 \x01(\v2\x1f.organization.CarInspectionFileR\x11carInspectionFile\"x\n" +
 	"\x1eUpdateCarInspectionFileRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
-	"\bmodified\x18\x03 \x01(\tR\bmodified\"r\n" +
+	"\bmodified\x18\x03 \x01(\tR\bmodified\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"r\n" +
 	"\x1fUpdateCarInspectionFileResponse\x12O\n" +
-	"\x13car_inspection_file\x18\x01 \x01(\v2\x1f.organization.CarInspectionFileR\x11carInspectionFile\"4\n" +
+	"\x13car_inspection_file\x18\x01 \x01(\v2\x1f.organization.CarInspectionFileR\x11carInspectionFile\"H\n" +
 	"\x1eDeleteCarInspectionFileRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\";\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\";\n" +
 	"\x1fDeleteCarInspectionFileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"[\n" +
 	"\x1dListCarInspectionFilesRequest\x12\x1b\n" +
@@ -32645,7 +35445,7 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"\xa9\x01\n" +
 	",ListCarInspectionFilesByOrganizationResponse\x12Q\n" +
 	"\x14car_inspection_files\x18\x01 \x03(\v2\x1f.organization.CarInspectionFileR\x12carInspectionFiles\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf4\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x88\x03\n" +
 	"\x13CarInspectionFilesA\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -32662,7 +35462,8 @@ const file_service_proto_rawDesc = "" +
 	"\acreated\x18\t \x01(\tR\acreated\x12\x1a\n" +
 	"\bmodified\x18\n" +
 	" \x01(\tR\bmodified\x12\x1d\n" +
-	"\adeleted\x18\v \x01(\tH\x00R\adeleted\x88\x01\x01B\n" +
+	"\adeleted\x18\v \x01(\tH\x00R\adeleted\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\f \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deleted\"\xc2\x02\n" +
 	" CreateCarInspectionFilesARequest\x12'\n" +
@@ -32684,15 +35485,17 @@ const file_service_proto_rawDesc = "" +
 	"\x1dGetCarInspectionFilesARequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"x\n" +
 	"\x1eGetCarInspectionFilesAResponse\x12V\n" +
-	"\x16car_inspection_files_a\x18\x01 \x01(\v2!.organization.CarInspectionFilesAR\x13carInspectionFilesA\"f\n" +
+	"\x16car_inspection_files_a\x18\x01 \x01(\v2!.organization.CarInspectionFilesAR\x13carInspectionFilesA\"z\n" +
 	" UpdateCarInspectionFilesARequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
-	"\bmodified\x18\x03 \x01(\tR\bmodified\"{\n" +
+	"\bmodified\x18\x03 \x01(\tR\bmodified\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"{\n" +
 	"!UpdateCarInspectionFilesAResponse\x12V\n" +
-	"\x16car_inspection_files_a\x18\x01 \x01(\v2!.organization.CarInspectionFilesAR\x13carInspectionFilesA\"6\n" +
+	"\x16car_inspection_files_a\x18\x01 \x01(\v2!.organization.CarInspectionFilesAR\x13carInspectionFilesA\"J\n" +
 	" DeleteCarInspectionFilesARequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"=\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"=\n" +
 	"!DeleteCarInspectionFilesAResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"]\n" +
 	"\x1fListCarInspectionFilesAsRequest\x12\x1b\n" +
@@ -32709,7 +35512,7 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"\xb2\x01\n" +
 	".ListCarInspectionFilesAsByOrganizationResponse\x12X\n" +
 	"\x17car_inspection_files_as\x18\x01 \x03(\v2!.organization.CarInspectionFilesAR\x14carInspectionFilesAs\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf4\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x88\x03\n" +
 	"\x13CarInspectionFilesB\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -32726,7 +35529,8 @@ const file_service_proto_rawDesc = "" +
 	"\acreated\x18\t \x01(\tR\acreated\x12\x1a\n" +
 	"\bmodified\x18\n" +
 	" \x01(\tR\bmodified\x12\x1d\n" +
-	"\adeleted\x18\v \x01(\tH\x00R\adeleted\x88\x01\x01B\n" +
+	"\adeleted\x18\v \x01(\tH\x00R\adeleted\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\f \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deleted\"\xc2\x02\n" +
 	" CreateCarInspectionFilesBRequest\x12'\n" +
@@ -32748,15 +35552,17 @@ const file_service_proto_rawDesc = "" +
 	"\x1dGetCarInspectionFilesBRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"x\n" +
 	"\x1eGetCarInspectionFilesBResponse\x12V\n" +
-	"\x16car_inspection_files_b\x18\x01 \x01(\v2!.organization.CarInspectionFilesBR\x13carInspectionFilesB\"f\n" +
+	"\x16car_inspection_files_b\x18\x01 \x01(\v2!.organization.CarInspectionFilesBR\x13carInspectionFilesB\"z\n" +
 	" UpdateCarInspectionFilesBRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
-	"\bmodified\x18\x03 \x01(\tR\bmodified\"{\n" +
+	"\bmodified\x18\x03 \x01(\tR\bmodified\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"{\n" +
 	"!UpdateCarInspectionFilesBResponse\x12V\n" +
-	"\x16car_inspection_files_b\x18\x01 \x01(\v2!.organization.CarInspectionFilesBR\x13carInspectionFilesB\"6\n" +
+	"\x16car_inspection_files_b\x18\x01 \x01(\v2!.organization.CarInspectionFilesBR\x13carInspectionFilesB\"J\n" +
 	" DeleteCarInspectionFilesBRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"=\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"=\n" +
 	"!DeleteCarInspectionFilesBResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"]\n" +
 	"\x1fListCarInspectionFilesBsRequest\x12\x1b\n" +
@@ -32773,7 +35579,7 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"\xb2\x01\n" +
 	".ListCarInspectionFilesBsByOrganizationResponse\x12X\n" +
 	"\x17car_inspection_files_bs\x18\x01 \x03(\v2!.organization.CarInspectionFilesBR\x14carInspectionFilesBs\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x80\x04\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x94\x04\n" +
 	"\x1bCarInspectionDeregistration\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
 	"\x06car_id\x18\x02 \x01(\tR\x05carId\x12@\n" +
@@ -32783,7 +35589,9 @@ const file_service_proto_rawDesc = "" +
 	"\x19valid_period_expir_date_y\x18\x06 \x01(\tR\x15validPeriodExpirDateY\x128\n" +
 	"\x19valid_period_expir_date_m\x18\a \x01(\tR\x15validPeriodExpirDateM\x128\n" +
 	"\x19valid_period_expir_date_d\x18\b \x01(\tR\x15validPeriodExpirDateD\x12`\n" +
-	".twodimension_code_info_valid_period_expir_date\x18\t \x01(\tR(twodimensionCodeInfoValidPeriodExpirDate\"\x8d\x04\n" +
+	".twodimension_code_info_valid_period_expir_date\x18\t \x01(\tR(twodimensionCodeInfoValidPeriodExpirDate\x12\x12\n" +
+	"\x04etag\x18\n" +
+	" \x01(\tR\x04etag\"\x8d\x04\n" +
 	"(CreateCarInspectionDeregistrationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
 	"\x06car_id\x18\x02 \x01(\tR\x05carId\x12@\n" +
@@ -32801,7 +35609,7 @@ const file_service_proto_rawDesc = "" +
 	"\x06car_id\x18\x02 \x01(\tR\x05carId\x12`\n" +
 	".twodimension_code_info_valid_period_expir_date\x18\x03 \x01(\tR(twodimensionCodeInfoValidPeriodExpirDate\"\x97\x01\n" +
 	"&GetCarInspectionDeregistrationResponse\x12m\n" +
-	"\x1dcar_inspection_deregistration\x18\x01 \x01(\v2).organization.CarInspectionDeregistrationR\x1bcarInspectionDeregistration\"\x8d\x04\n" +
+	"\x1dcar_inspection_deregistration\x18\x01 \x01(\v2).organization.CarInspectionDeregistrationR\x1bcarInspectionDeregistration\"\xa1\x04\n" +
 	"(UpdateCarInspectionDeregistrationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
 	"\x06car_id\x18\x02 \x01(\tR\x05carId\x12`\n" +
@@ -32811,13 +35619,16 @@ const file_service_proto_rawDesc = "" +
 	"\x19valid_period_expir_date_e\x18\x06 \x01(\tR\x15validPeriodExpirDateE\x128\n" +
 	"\x19valid_period_expir_date_y\x18\a \x01(\tR\x15validPeriodExpirDateY\x128\n" +
 	"\x19valid_period_expir_date_m\x18\b \x01(\tR\x15validPeriodExpirDateM\x128\n" +
-	"\x19valid_period_expir_date_d\x18\t \x01(\tR\x15validPeriodExpirDateD\"\x9a\x01\n" +
+	"\x19valid_period_expir_date_d\x18\t \x01(\tR\x15validPeriodExpirDateD\x12\x12\n" +
+	"\x04etag\x18\n" +
+	" \x01(\tR\x04etag\"\x9a\x01\n" +
 	")UpdateCarInspectionDeregistrationResponse\x12m\n" +
-	"\x1dcar_inspection_deregistration\x18\x01 \x01(\v2).organization.CarInspectionDeregistrationR\x1bcarInspectionDeregistration\"\xcc\x01\n" +
+	"\x1dcar_inspection_deregistration\x18\x01 \x01(\v2).organization.CarInspectionDeregistrationR\x1bcarInspectionDeregistration\"\xe0\x01\n" +
 	"(DeleteCarInspectionDeregistrationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x15\n" +
 	"\x06car_id\x18\x02 \x01(\tR\x05carId\x12`\n" +
-	".twodimension_code_info_valid_period_expir_date\x18\x03 \x01(\tR(twodimensionCodeInfoValidPeriodExpirDate\"E\n" +
+	".twodimension_code_info_valid_period_expir_date\x18\x03 \x01(\tR(twodimensionCodeInfoValidPeriodExpirDate\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"E\n" +
 	")DeleteCarInspectionDeregistrationResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"e\n" +
 	"'ListCarInspectionDeregistrationsRequest\x12\x1b\n" +
@@ -32882,7 +35693,7 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"\xe6\x01\n" +
 	";ListCarInspectionDeregistrationFilessByOrganizationResponse\x12\x7f\n" +
 	"$car_inspection_deregistration_filess\x18\x01 \x03(\v2..organization.CarInspectionDeregistrationFilesR!carInspectionDeregistrationFiless\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf8\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8c\x03\n" +
 	"\x16CarInsSheetIchibanCars\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1c\n" +
 	"\aid_cars\x18\x02 \x01(\tH\x00R\x06idCars\x88\x01\x01\x12'\n" +
@@ -32890,7 +35701,8 @@ const file_service_proto_rawDesc = "" +
 	"\x18elect_cert_publishdate_e\x18\x04 \x01(\tR\x15electCertPublishdateE\x127\n" +
 	"\x18elect_cert_publishdate_y\x18\x05 \x01(\tR\x15electCertPublishdateY\x127\n" +
 	"\x18elect_cert_publishdate_m\x18\x06 \x01(\tR\x15electCertPublishdateM\x127\n" +
-	"\x18elect_cert_publishdate_d\x18\a \x01(\tR\x15electCertPublishdateDB\n" +
+	"\x18elect_cert_publishdate_d\x18\a \x01(\tR\x15electCertPublishdateD\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_id_cars\"\x85\x03\n" +
 	"#CreateCarInsSheetIchibanCarsRequest\x12'\n" +
@@ -32913,7 +35725,7 @@ const file_service_proto_rawDesc = "" +
 	"\x18elect_cert_publishdate_m\x18\x05 \x01(\tR\x15electCertPublishdateM\x127\n" +
 	"\x18elect_cert_publishdate_d\x18\x06 \x01(\tR\x15electCertPublishdateD\"\x85\x01\n" +
 	"!GetCarInsSheetIchibanCarsResponse\x12`\n" +
-	"\x1acar_ins_sheet_ichiban_cars\x18\x01 \x01(\v2$.organization.CarInsSheetIchibanCarsR\x16carInsSheetIchibanCars\"\x85\x03\n" +
+	"\x1acar_ins_sheet_ichiban_cars\x18\x01 \x01(\v2$.organization.CarInsSheetIchibanCarsR\x16carInsSheetIchibanCars\"\x99\x03\n" +
 	"#UpdateCarInsSheetIchibanCarsRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
 	"\x10elect_cert_mg_no\x18\x02 \x01(\tR\relectCertMgNo\x127\n" +
@@ -32921,18 +35733,20 @@ const file_service_proto_rawDesc = "" +
 	"\x18elect_cert_publishdate_y\x18\x04 \x01(\tR\x15electCertPublishdateY\x127\n" +
 	"\x18elect_cert_publishdate_m\x18\x05 \x01(\tR\x15electCertPublishdateM\x127\n" +
 	"\x18elect_cert_publishdate_d\x18\x06 \x01(\tR\x15electCertPublishdateD\x12\x1c\n" +
-	"\aid_cars\x18\a \x01(\tH\x00R\x06idCars\x88\x01\x01B\n" +
+	"\aid_cars\x18\a \x01(\tH\x00R\x06idCars\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_id_cars\"\x88\x01\n" +
 	"$UpdateCarInsSheetIchibanCarsResponse\x12`\n" +
-	"\x1acar_ins_sheet_ichiban_cars\x18\x01 \x01(\v2$.organization.CarInsSheetIchibanCarsR\x16carInsSheetIchibanCars\"\xdb\x02\n" +
+	"\x1acar_ins_sheet_ichiban_cars\x18\x01 \x01(\v2$.organization.CarInsSheetIchibanCarsR\x16carInsSheetIchibanCars\"\xef\x02\n" +
 	"#DeleteCarInsSheetIchibanCarsRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
 	"\x10elect_cert_mg_no\x18\x02 \x01(\tR\relectCertMgNo\x127\n" +
 	"\x18elect_cert_publishdate_e\x18\x03 \x01(\tR\x15electCertPublishdateE\x127\n" +
 	"\x18elect_cert_publishdate_y\x18\x04 \x01(\tR\x15electCertPublishdateY\x127\n" +
 	"\x18elect_cert_publishdate_m\x18\x05 \x01(\tR\x15electCertPublishdateM\x127\n" +
-	"\x18elect_cert_publishdate_d\x18\x06 \x01(\tR\x15electCertPublishdateD\"@\n" +
+	"\x18elect_cert_publishdate_d\x18\x06 \x01(\tR\x15electCertPublishdateD\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etag\"@\n" +
 	"$DeleteCarInsSheetIchibanCarsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"`\n" +
 	"\"ListCarInsSheetIchibanCarssRequest\x12\x1b\n" +
@@ -32949,7 +35763,7 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"\xbf\x01\n" +
 	"1ListCarInsSheetIchibanCarssByOrganizationResponse\x12b\n" +
 	"\x1bcar_ins_sheet_ichiban_carss\x18\x01 \x03(\v2$.organization.CarInsSheetIchibanCarsR\x17carInsSheetIchibanCarss\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x99\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xad\x02\n" +
 	"\x17CarInsSheetIchibanCarsA\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1c\n" +
 	"\aid_cars\x18\x02 \x01(\tH\x00R\x06idCars\x88\x01\x01\x12'\n" +
@@ -32961,7 +35775,8 @@ const file_service_proto_rawDesc = "" +
 	"\vgrantdate_m\x18\x06 \x01(\tR\n" +
 	"grantdateM\x12\x1f\n" +
 	"\vgrantdate_d\x18\a \x01(\tR\n" +
-	"grantdateDB\n" +
+	"grantdateD\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_id_cars\"\xa6\x02\n" +
 	"$CreateCarInsSheetIchibanCarsARequest\x12'\n" +
@@ -32992,7 +35807,7 @@ const file_service_proto_rawDesc = "" +
 	"\vgrantdate_d\x18\x06 \x01(\tR\n" +
 	"grantdateD\"\x8a\x01\n" +
 	"\"GetCarInsSheetIchibanCarsAResponse\x12d\n" +
-	"\x1ccar_ins_sheet_ichiban_cars_a\x18\x01 \x01(\v2%.organization.CarInsSheetIchibanCarsAR\x17carInsSheetIchibanCarsA\"\xa6\x02\n" +
+	"\x1ccar_ins_sheet_ichiban_cars_a\x18\x01 \x01(\v2%.organization.CarInsSheetIchibanCarsAR\x17carInsSheetIchibanCarsA\"\xba\x02\n" +
 	"$UpdateCarInsSheetIchibanCarsARequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
 	"\x10elect_cert_mg_no\x18\x02 \x01(\tR\relectCertMgNo\x12\x1f\n" +
@@ -33004,11 +35819,12 @@ const file_service_proto_rawDesc = "" +
 	"grantdateM\x12\x1f\n" +
 	"\vgrantdate_d\x18\x06 \x01(\tR\n" +
 	"grantdateD\x12\x1c\n" +
-	"\aid_cars\x18\a \x01(\tH\x00R\x06idCars\x88\x01\x01B\n" +
+	"\aid_cars\x18\a \x01(\tH\x00R\x06idCars\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_id_cars\"\x8d\x01\n" +
 	"%UpdateCarInsSheetIchibanCarsAResponse\x12d\n" +
-	"\x1ccar_ins_sheet_ichiban_cars_a\x18\x01 \x01(\v2%.organization.CarInsSheetIchibanCarsAR\x17carInsSheetIchibanCarsA\"\xfc\x01\n" +
+	"\x1ccar_ins_sheet_ichiban_cars_a\x18\x01 \x01(\v2%.organization.CarInsSheetIchibanCarsAR\x17carInsSheetIchibanCarsA\"\x90\x02\n" +
 	"$DeleteCarInsSheetIchibanCarsARequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
 	"\x10elect_cert_mg_no\x18\x02 \x01(\tR\relectCertMgNo\x12\x1f\n" +
@@ -33019,7 +35835,8 @@ const file_service_proto_rawDesc = "" +
 	"\vgrantdate_m\x18\x05 \x01(\tR\n" +
 	"grantdateM\x12\x1f\n" +
 	"\vgrantdate_d\x18\x06 \x01(\tR\n" +
-	"grantdateD\"A\n" +
+	"grantdateD\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etag\"A\n" +
 	"%DeleteCarInsSheetIchibanCarsAResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"a\n" +
 	"#ListCarInsSheetIchibanCarsAsRequest\x12\x1b\n" +
@@ -33036,7 +35853,7 @@ const file_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"\xc4\x01\n" +
 	"2ListCarInsSheetIchibanCarsAsByOrganizationResponse\x12f\n" +
 	"\x1dcar_ins_sheet_ichiban_cars_as\x18\x01 \x03(\v2%.organization.CarInsSheetIchibanCarsAR\x18carInsSheetIchibanCarsAs\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf3\f\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x87\r\n" +
 	"\aKudgfry\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -33074,7 +35891,8 @@ const file_service_proto_rawDesc = "" +
 	"\x0eown_other_type\x18\x1b \x01(\tH\x15R\fownOtherType\x88\x01\x01\x12\x1d\n" +
 	"\amileage\x18\x1c \x01(\tH\x16R\amileage\x88\x01\x01\x12$\n" +
 	"\vmeter_value\x18\x1d \x01(\tH\x17R\n" +
-	"meterValue\x88\x01\x01B\n" +
+	"meterValue\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\x1e \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deletedB\x0f\n" +
 	"\r_kudguri_uuidB\v\n" +
@@ -33173,7 +35991,7 @@ const file_service_proto_rawDesc = "" +
 	"\x11GetKudgfryRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"E\n" +
 	"\x12GetKudgfryResponse\x12/\n" +
-	"\akudgfry\x18\x01 \x01(\v2\x15.organization.KudgfryR\akudgfry\"\x80\r\n" +
+	"\akudgfry\x18\x01 \x01(\v2\x15.organization.KudgfryR\akudgfry\"\x94\r\n" +
 	"\x14UpdateKudgfryRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -33211,7 +36029,8 @@ const file_service_proto_rawDesc = "" +
 	"\x0eown_other_type\x18\x1b \x01(\tH\x15R\fownOtherType\x88\x01\x01\x12\x1d\n" +
 	"\amileage\x18\x1c \x01(\tH\x16R\amileage\x88\x01\x01\x12$\n" +
 	"\vmeter_value\x18\x1d \x01(\tH\x17R\n" +
-	"meterValue\x88\x01\x01B\n" +
+	"meterValue\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\x1e \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deletedB\x0f\n" +
 	"\r_kudguri_uuidB\v\n" +
@@ -33241,26 +36060,31 @@ const file_service_proto_rawDesc = "" +
 	"\b_mileageB\x0e\n" +
 	"\f_meter_value\"H\n" +
 	"\x15UpdateKudgfryResponse\x12/\n" +
-	"\akudgfry\x18\x01 \x01(\v2\x15.organization.KudgfryR\akudgfry\"*\n" +
+	"\akudgfry\x18\x01 \x01(\v2\x15.organization.KudgfryR\akudgfry\">\n" +
 	"\x14DeleteKudgfryRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"1\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"1\n" +
 	"\x15DeleteKudgfryResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x84\x01\n" +
 	"\x13ListKudgfrysRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"q\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"q\n" +
 	"\x14ListKudgfrysResponse\x121\n" +
 	"\bkudgfrys\x18\x01 \x03(\v2\x15.organization.KudgfryR\bkudgfrys\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x88\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbb\x01\n" +
 	"!ListKudgfrysByOrganizationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"\x7f\n" +
 	"\"ListKudgfrysByOrganizationResponse\x121\n" +
 	"\bkudgfrys\x18\x01 \x03(\v2\x15.organization.KudgfryR\bkudgfrys\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf5\x10\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x89\x11\n" +
 	"\aKudguri\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -33309,7 +36133,8 @@ const file_service_proto_rawDesc = "" +
 	"\rend_gps_valid\x18% \x01(\tH\x1fR\vendGpsValid\x88\x01\x01\x12#\n" +
 	"\vend_gps_lat\x18& \x01(\tH R\tendGpsLat\x88\x01\x01\x12#\n" +
 	"\vend_gps_lng\x18' \x01(\tH!R\tendGpsLng\x88\x01\x01\x12)\n" +
-	"\x0eover_limit_max\x18( \x01(\tH\"R\foverLimitMax\x88\x01\x01B\n" +
+	"\x0eover_limit_max\x18( \x01(\tH\"R\foverLimitMax\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18) \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deletedB\v\n" +
 	"\t_unkou_noB\x0f\n" +
@@ -33439,7 +36264,7 @@ const file_service_proto_rawDesc = "" +
 	"\x11GetKudguriRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"E\n" +
 	"\x12GetKudguriResponse\x12/\n" +
-	"\akudguri\x18\x01 \x01(\v2\x15.organization.KudguriR\akudguri\"\x82\x11\n" +
+	"\akudguri\x18\x01 \x01(\v2\x15.organization.KudguriR\akudguri\"\x96\x11\n" +
 	"\x14UpdateKudguriRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -33488,7 +36313,8 @@ const file_service_proto_rawDesc = "" +
 	"\rend_gps_valid\x18% \x01(\tH\x1fR\vendGpsValid\x88\x01\x01\x12#\n" +
 	"\vend_gps_lat\x18& \x01(\tH R\tendGpsLat\x88\x01\x01\x12#\n" +
 	"\vend_gps_lng\x18' \x01(\tH!R\tendGpsLng\x88\x01\x01\x12)\n" +
-	"\x0eover_limit_max\x18( \x01(\tH\"R\foverLimitMax\x88\x01\x01B\n" +
+	"\x0eover_limit_max\x18( \x01(\tH\"R\foverLimitMax\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18) \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deletedB\v\n" +
 	"\t_unkou_noB\x0f\n" +
@@ -33528,26 +36354,31 @@ const file_service_proto_rawDesc = "" +
 	"\f_end_gps_lngB\x11\n" +
 	"\x0f_over_limit_max\"H\n" +
 	"\x15UpdateKudguriResponse\x12/\n" +
-	"\akudguri\x18\x01 \x01(\v2\x15.organization.KudguriR\akudguri\"*\n" +
+	"\akudguri\x18\x01 \x01(\v2\x15.organization.KudguriR\akudguri\">\n" +
 	"\x14DeleteKudguriRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"1\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"1\n" +
 	"\x15DeleteKudguriResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x84\x01\n" +
 	"\x13ListKudgurisRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"q\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"q\n" +
 	"\x14ListKudgurisResponse\x121\n" +
 	"\bkudguris\x18\x01 \x03(\v2\x15.organization.KudguriR\bkudguris\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x88\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbb\x01\n" +
 	"!ListKudgurisByOrganizationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"\x7f\n" +
 	"\"ListKudgurisByOrganizationResponse\x121\n" +
 	"\bkudguris\x18\x01 \x03(\v2\x15.organization.KudguriR\bkudguris\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbc\f\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd0\f\n" +
 	"\aKudgcst\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -33584,7 +36415,8 @@ const file_service_proto_rawDesc = "" +
 	"\x0fsettlement_type\x18\x1a \x01(\tH\x14R\x0esettlementType\x88\x01\x01\x125\n" +
 	"\x14settlement_type_name\x18\x1b \x01(\tH\x15R\x12settlementTypeName\x88\x01\x01\x12(\n" +
 	"\rstandard_fare\x18\x1c \x01(\tH\x16R\fstandardFare\x88\x01\x01\x12(\n" +
-	"\rcontract_fare\x18\x1d \x01(\tH\x17R\fcontractFare\x88\x01\x01B\n" +
+	"\rcontract_fare\x18\x1d \x01(\tH\x17R\fcontractFare\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\x1e \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deletedB\x0f\n" +
 	"\r_kudguri_uuidB\v\n" +
@@ -33680,7 +36512,7 @@ const file_service_proto_rawDesc = "" +
 	"\x11GetKudgcstRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"E\n" +
 	"\x12GetKudgcstResponse\x12/\n" +
-	"\akudgcst\x18\x01 \x01(\v2\x15.organization.KudgcstR\akudgcst\"\xc9\f\n" +
+	"\akudgcst\x18\x01 \x01(\v2\x15.organization.KudgcstR\akudgcst\"\xdd\f\n" +
 	"\x14UpdateKudgcstRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -33717,7 +36549,8 @@ const file_service_proto_rawDesc = "" +
 	"\x0fsettlement_type\x18\x1a \x01(\tH\x14R\x0esettlementType\x88\x01\x01\x125\n" +
 	"\x14settlement_type_name\x18\x1b \x01(\tH\x15R\x12settlementTypeName\x88\x01\x01\x12(\n" +
 	"\rstandard_fare\x18\x1c \x01(\tH\x16R\fstandardFare\x88\x01\x01\x12(\n" +
-	"\rcontract_fare\x18\x1d \x01(\tH\x17R\fcontractFare\x88\x01\x01B\n" +
+	"\rcontract_fare\x18\x1d \x01(\tH\x17R\fcontractFare\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\x1e \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deletedB\x0f\n" +
 	"\r_kudguri_uuidB\v\n" +
@@ -33746,26 +36579,31 @@ const file_service_proto_rawDesc = "" +
 	"\x0e_standard_fareB\x10\n" +
 	"\x0e_contract_fare\"H\n" +
 	"\x15UpdateKudgcstResponse\x12/\n" +
-	"\akudgcst\x18\x01 \x01(\v2\x15.organization.KudgcstR\akudgcst\"*\n" +
+	"\akudgcst\x18\x01 \x01(\v2\x15.organization.KudgcstR\akudgcst\">\n" +
 	"\x14DeleteKudgcstRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"1\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"1\n" +
 	"\x15DeleteKudgcstResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x84\x01\n" +
 	"\x13ListKudgcstsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"q\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"q\n" +
 	"\x14ListKudgcstsResponse\x121\n" +
 	"\bkudgcsts\x18\x01 \x03(\v2\x15.organization.KudgcstR\bkudgcsts\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x88\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbb\x01\n" +
 	"!ListKudgcstsByOrganizationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"\x7f\n" +
 	"\"ListKudgcstsByOrganizationResponse\x121\n" +
 	"\bkudgcsts\x18\x01 \x03(\v2\x15.organization.KudgcstR\bkudgcsts\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf5\x10\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x89\x11\n" +
 	"\aKudgful\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -33814,7 +36652,8 @@ const file_service_proto_rawDesc = "" +
 	"\rend_gps_valid\x18% \x01(\tH\x1fR\vendGpsValid\x88\x01\x01\x12#\n" +
 	"\vend_gps_lat\x18& \x01(\tH R\tendGpsLat\x88\x01\x01\x12#\n" +
 	"\vend_gps_lng\x18' \x01(\tH!R\tendGpsLng\x88\x01\x01\x12)\n" +
-	"\x0eover_limit_max\x18( \x01(\tH\"R\foverLimitMax\x88\x01\x01B\n" +
+	"\x0eover_limit_max\x18( \x01(\tH\"R\foverLimitMax\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18) \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deletedB\v\n" +
 	"\t_unkou_noB\x0f\n" +
@@ -33944,7 +36783,7 @@ const file_service_proto_rawDesc = "" +
 	"\x11GetKudgfulRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"E\n" +
 	"\x12GetKudgfulResponse\x12/\n" +
-	"\akudgful\x18\x01 \x01(\v2\x15.organization.KudgfulR\akudgful\"\x82\x11\n" +
+	"\akudgful\x18\x01 \x01(\v2\x15.organization.KudgfulR\akudgful\"\x96\x11\n" +
 	"\x14UpdateKudgfulRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -33993,7 +36832,8 @@ const file_service_proto_rawDesc = "" +
 	"\rend_gps_valid\x18% \x01(\tH\x1fR\vendGpsValid\x88\x01\x01\x12#\n" +
 	"\vend_gps_lat\x18& \x01(\tH R\tendGpsLat\x88\x01\x01\x12#\n" +
 	"\vend_gps_lng\x18' \x01(\tH!R\tendGpsLng\x88\x01\x01\x12)\n" +
-	"\x0eover_limit_max\x18( \x01(\tH\"R\foverLimitMax\x88\x01\x01B\n" +
+	"\x0eover_limit_max\x18( \x01(\tH\"R\foverLimitMax\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18) \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deletedB\v\n" +
 	"\t_unkou_noB\x0f\n" +
@@ -34033,26 +36873,31 @@ const file_service_proto_rawDesc = "" +
 	"\f_end_gps_lngB\x11\n" +
 	"\x0f_over_limit_max\"H\n" +
 	"\x15UpdateKudgfulResponse\x12/\n" +
-	"\akudgful\x18\x01 \x01(\v2\x15.organization.KudgfulR\akudgful\"*\n" +
+	"\akudgful\x18\x01 \x01(\v2\x15.organization.KudgfulR\akudgful\">\n" +
 	"\x14DeleteKudgfulRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"1\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"1\n" +
 	"\x15DeleteKudgfulResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x84\x01\n" +
 	"\x13ListKudgfulsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"q\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"q\n" +
 	"\x14ListKudgfulsResponse\x121\n" +
 	"\bkudgfuls\x18\x01 \x03(\v2\x15.organization.KudgfulR\bkudgfuls\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x88\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbb\x01\n" +
 	"!ListKudgfulsByOrganizationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"\x7f\n" +
 	"\"ListKudgfulsByOrganizationResponse\x121\n" +
 	"\bkudgfuls\x18\x01 \x03(\v2\x15.organization.KudgfulR\bkudgfuls\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf5\x10\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x89\x11\n" +
 	"\aKudgsir\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -34101,7 +36946,8 @@ const file_service_proto_rawDesc = "" +
 	"\rend_gps_valid\x18% \x01(\tH\x1fR\vendGpsValid\x88\x01\x01\x12#\n" +
 	"\vend_gps_lat\x18& \x01(\tH R\tendGpsLat\x88\x01\x01\x12#\n" +
 	"\vend_gps_lng\x18' \x01(\tH!R\tendGpsLng\x88\x01\x01\x12)\n" +
-	"\x0eover_limit_max\x18( \x01(\tH\"R\foverLimitMax\x88\x01\x01B\n" +
+	"\x0eover_limit_max\x18( \x01(\tH\"R\foverLimitMax\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18) \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deletedB\v\n" +
 	"\t_unkou_noB\x0f\n" +
@@ -34231,7 +37077,7 @@ const file_service_proto_rawDesc = "" +
 	"\x11GetKudgsirRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"E\n" +
 	"\x12GetKudgsirResponse\x12/\n" +
-	"\akudgsir\x18\x01 \x01(\v2\x15.organization.KudgsirR\akudgsir\"\x82\x11\n" +
+	"\akudgsir\x18\x01 \x01(\v2\x15.organization.KudgsirR\akudgsir\"\x96\x11\n" +
 	"\x14UpdateKudgsirRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -34280,7 +37126,8 @@ const file_service_proto_rawDesc = "" +
 	"\rend_gps_valid\x18% \x01(\tH\x1fR\vendGpsValid\x88\x01\x01\x12#\n" +
 	"\vend_gps_lat\x18& \x01(\tH R\tendGpsLat\x88\x01\x01\x12#\n" +
 	"\vend_gps_lng\x18' \x01(\tH!R\tendGpsLng\x88\x01\x01\x12)\n" +
-	"\x0eover_limit_max\x18( \x01(\tH\"R\foverLimitMax\x88\x01\x01B\n" +
+	"\x0eover_limit_max\x18( \x01(\tH\"R\foverLimitMax\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18) \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deletedB\v\n" +
 	"\t_unkou_noB\x0f\n" +
@@ -34320,26 +37167,31 @@ const file_service_proto_rawDesc = "" +
 	"\f_end_gps_lngB\x11\n" +
 	"\x0f_over_limit_max\"H\n" +
 	"\x15UpdateKudgsirResponse\x12/\n" +
-	"\akudgsir\x18\x01 \x01(\v2\x15.organization.KudgsirR\akudgsir\"*\n" +
+	"\akudgsir\x18\x01 \x01(\v2\x15.organization.KudgsirR\akudgsir\">\n" +
 	"\x14DeleteKudgsirRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"1\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"1\n" +
 	"\x15DeleteKudgsirResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x84\x01\n" +
 	"\x13ListKudgsirsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"q\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"q\n" +
 	"\x14ListKudgsirsResponse\x121\n" +
 	"\bkudgsirs\x18\x01 \x03(\v2\x15.organization.KudgsirR\bkudgsirs\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x88\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbb\x01\n" +
 	"!ListKudgsirsByOrganizationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"\x7f\n" +
 	"\"ListKudgsirsByOrganizationResponse\x121\n" +
 	"\bkudgsirs\x18\x01 \x03(\v2\x15.organization.KudgsirR\bkudgsirs\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xee/\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x820\n" +
 	"\aKudgivt\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -34456,7 +37308,8 @@ const file_service_proto_rawDesc = "" +
 	"\x12rapid_curve_count4\x18[ \x01(\tHUR\x10rapidCurveCount4\x88\x01\x01\x121\n" +
 	"\x12rapid_curve_count5\x18\\ \x01(\tHVR\x10rapidCurveCount5\x88\x01\x01\x12+\n" +
 	"\x0frapid_curve_max\x18] \x01(\tHWR\rrapidCurveMax\x88\x01\x01\x126\n" +
-	"\x15rapid_curve_max_speed\x18^ \x01(\tHXR\x12rapidCurveMaxSpeed\x88\x01\x01B\n" +
+	"\x15rapid_curve_max_speed\x18^ \x01(\tHXR\x12rapidCurveMaxSpeed\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18_ \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deletedB\x0f\n" +
 	"\r_kudguri_uuidB\v\n" +
@@ -34762,7 +37615,7 @@ const file_service_proto_rawDesc = "" +
 	"\x11GetKudgivtRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"E\n" +
 	"\x12GetKudgivtResponse\x12/\n" +
-	"\akudgivt\x18\x01 \x01(\v2\x15.organization.KudgivtR\akudgivt\"\xfb/\n" +
+	"\akudgivt\x18\x01 \x01(\v2\x15.organization.KudgivtR\akudgivt\"\xcc0\n" +
 	"\x14UpdateKudgivtRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -34879,7 +37732,10 @@ const file_service_proto_rawDesc = "" +
 	"\x12rapid_curve_count4\x18[ \x01(\tHUR\x10rapidCurveCount4\x88\x01\x01\x121\n" +
 	"\x12rapid_curve_count5\x18\\ \x01(\tHVR\x10rapidCurveCount5\x88\x01\x01\x12+\n" +
 	"\x0frapid_curve_max\x18] \x01(\tHWR\rrapidCurveMax\x88\x01\x01\x126\n" +
-	"\x15rapid_curve_max_speed\x18^ \x01(\tHXR\x12rapidCurveMaxSpeed\x88\x01\x01B\n" +
+	"\x15rapid_curve_max_speed\x18^ \x01(\tHXR\x12rapidCurveMaxSpeed\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18_ \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18` \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_deletedB\x0f\n" +
 	"\r_kudguri_uuidB\v\n" +
@@ -34973,26 +37829,31 @@ const file_service_proto_rawDesc = "" +
 	"\x10_rapid_curve_maxB\x18\n" +
 	"\x16_rapid_curve_max_speed\"H\n" +
 	"\x15UpdateKudgivtResponse\x12/\n" +
-	"\akudgivt\x18\x01 \x01(\v2\x15.organization.KudgivtR\akudgivt\"*\n" +
+	"\akudgivt\x18\x01 \x01(\v2\x15.organization.KudgivtR\akudgivt\">\n" +
 	"\x14DeleteKudgivtRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"1\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"1\n" +
 	"\x15DeleteKudgivtResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x84\x01\n" +
 	"\x13ListKudgivtsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"q\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"q\n" +
 	"\x14ListKudgivtsResponse\x121\n" +
 	"\bkudgivts\x18\x01 \x03(\v2\x15.organization.KudgivtR\bkudgivts\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x88\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbb\x01\n" +
 	"!ListKudgivtsByOrganizationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"\x7f\n" +
 	"\"ListKudgivtsByOrganizationResponse\x121\n" +
 	"\bkudgivts\x18\x01 \x03(\v2\x15.organization.KudgivtR\bkudgivts\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa3\x15\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb7\x15\n" +
 	"\tDtakologs\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12)\n" +
@@ -35059,7 +37920,8 @@ const file_service_proto_rawDesc = "" +
 	"\x1fvehicle_icon_label_for_datetime\x186 \x01(\tH\x17R\x1bvehicleIconLabelForDatetime\x88\x01\x01\x12E\n" +
 	"\x1dvehicle_icon_label_for_driver\x187 \x01(\tH\x18R\x19vehicleIconLabelForDriver\x88\x01\x01\x12G\n" +
 	"\x1evehicle_icon_label_for_vehicle\x188 \x01(\tH\x19R\x1avehicleIconLabelForVehicle\x88\x01\x01\x12!\n" +
-	"\fvehicle_name\x189 \x01(\tR\vvehicleNameB\x11\n" +
+	"\fvehicle_name\x189 \x01(\tR\vvehicleName\x12\x12\n" +
+	"\x04etag\x18: \x01(\tR\x04etagB\x11\n" +
 	"\x0f_address_disp_cB\x11\n" +
 	"\x0f_address_disp_pB\f\n" +
 	"\n" +
@@ -35191,7 +38053,7 @@ const file_service_proto_rawDesc = "" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\"M\n" +
 	"\x14GetDtakologsResponse\x125\n" +
-	"\tdtakologs\x18\x01 \x01(\v2\x17.organization.DtakologsR\tdtakologs\"\xb0\x15\n" +
+	"\tdtakologs\x18\x01 \x01(\v2\x17.organization.DtakologsR\tdtakologs\"\xc4\x15\n" +
 	"\x16UpdateDtakologsRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12)\n" +
@@ -35258,7 +38120,8 @@ const file_service_proto_rawDesc = "" +
 	"\x1fvehicle_icon_label_for_datetime\x186 \x01(\tH\x17R\x1bvehicleIconLabelForDatetime\x88\x01\x01\x12E\n" +
 	"\x1dvehicle_icon_label_for_driver\x187 \x01(\tH\x18R\x19vehicleIconLabelForDriver\x88\x01\x01\x12G\n" +
 	"\x1evehicle_icon_label_for_vehicle\x188 \x01(\tH\x19R\x1avehicleIconLabelForVehicle\x88\x01\x01\x12!\n" +
-	"\fvehicle_name\x189 \x01(\tR\vvehicleNameB\x11\n" +
+	"\fvehicle_name\x189 \x01(\tR\vvehicleName\x12\x12\n" +
+	"\x04etag\x18: \x01(\tR\x04etagB\x11\n" +
 	"\x0f_address_disp_cB\x11\n" +
 	"\x0f_address_disp_pB\f\n" +
 	"\n" +
@@ -35289,49 +38152,116 @@ const file_service_proto_rawDesc = "" +
 	"\x1e_vehicle_icon_label_for_driverB!\n" +
 	"\x1f_vehicle_icon_label_for_vehicle\"P\n" +
 	"\x17UpdateDtakologsResponse\x125\n" +
-	"\tdtakologs\x18\x01 \x01(\v2\x17.organization.DtakologsR\tdtakologs\"U\n" +
+	"\tdtakologs\x18\x01 \x01(\v2\x17.organization.DtakologsR\tdtakologs\"i\n" +
 	"\x16DeleteDtakologsRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
-	"\x04type\x18\x02 \x01(\tR\x04type\"3\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"3\n" +
 	"\x17DeleteDtakologsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"R\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x85\x01\n" +
 	"\x14ListDtakologsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"v\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"v\n" +
 	"\x15ListDtakologsResponse\x125\n" +
 	"\tdtakologs\x18\x01 \x03(\v2\x17.organization.DtakologsR\tdtakologs\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x89\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbc\x01\n" +
 	"\"ListDtakologsByOrganizationRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"\x84\x01\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"\x84\x01\n" +
 	"#ListDtakologsByOrganizationResponse\x125\n" +
 	"\tdtakologs\x18\x01 \x03(\v2\x17.organization.DtakologsR\tdtakologs\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa0\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x84\x02\n" +
 	"\fAuthResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
 	"\n" +
 	"expires_in\x18\x03 \x01(\x03R\texpiresIn\x12)\n" +
-	"\x04user\x18\x04 \x01(\v2\x15.organization.AppUserR\x04user\"+\n" +
+	"\x04user\x18\x04 \x01(\v2\x15.organization.AppUserR\x04user\x12,\n" +
+	"\x0forganization_id\x18\x05 \x01(\tH\x00R\x0eorganizationId\x88\x01\x01\x12\x17\n" +
+	"\x04role\x18\x06 \x01(\tH\x01R\x04role\x88\x01\x01B\x12\n" +
+	"\x10_organization_idB\a\n" +
+	"\x05_role\"g\n" +
 	"\x15AuthWithGoogleRequest\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\")\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12(\n" +
+	"\rcode_verifier\x18\x02 \x01(\tH\x00R\fcodeVerifier\x88\x01\x01B\x10\n" +
+	"\x0e_code_verifier\"e\n" +
 	"\x13AuthWithLineRequest\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\":\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12(\n" +
+	"\rcode_verifier\x18\x02 \x01(\tH\x00R\fcodeVerifier\x88\x01\x01B\x10\n" +
+	"\x0e_code_verifier\":\n" +
 	"\x13RefreshTokenRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"E\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\x84\x01\n" +
 	"\x11GetAuthURLRequest\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
-	"\x05state\x18\x02 \x01(\tR\x05state\"&\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12*\n" +
+	"\x0ecode_challenge\x18\x03 \x01(\tH\x00R\rcodeChallenge\x88\x01\x01B\x11\n" +
+	"\x0f_code_challenge\"&\n" +
 	"\x12GetAuthURLResponse\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\"9\n" +
 	"\x14ValidateTokenRequest\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"X\n" +
 	"\x15ValidateTokenResponse\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12)\n" +
-	"\x04user\x18\x02 \x01(\v2\x15.organization.AppUserR\x04user\"\xf5\x03\n" +
+	"\x04user\x18\x02 \x01(\v2\x15.organization.AppUserR\x04user\"4\n" +
+	"\rLogoutRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"*\n" +
+	"\x0eLogoutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1a\n" +
+	"\x18RevokeAllSessionsRequest\"@\n" +
+	"\x19RevokeAllSessionsResponse\x12#\n" +
+	"\rrevoked_count\x18\x01 \x01(\x05R\frevokedCount\"\x9c\x02\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tH\x00R\tuserAgent\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tH\x01R\tipAddress\x88\x01\x01\x12F\n" +
+	"\x11last_refreshed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0flastRefreshedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\acurrent\x18\x06 \x01(\bR\acurrentB\r\n" +
+	"\v_user_agentB\r\n" +
+	"\v_ip_address\":\n" +
+	"\x13ListSessionsRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"I\n" +
+	"\x14ListSessionsResponse\x121\n" +
+	"\bsessions\x18\x01 \x03(\v2\x15.organization.SessionR\bsessions\"D\n" +
+	"\x19SwitchOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x9b\x01\n" +
+	"\x1aSwitchOrganizationResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"\xb5\x01\n" +
+	"\rLinkedAccount\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12(\n" +
+	"\x10provider_user_id\x18\x02 \x01(\tR\x0eproviderUserId\x12\x19\n" +
+	"\x05email\x18\x03 \x01(\tH\x00R\x05email\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\b\n" +
+	"\x06_email\"\x80\x01\n" +
+	"\x12LinkAccountRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12(\n" +
+	"\rcode_verifier\x18\x03 \x01(\tH\x00R\fcodeVerifier\x88\x01\x01B\x10\n" +
+	"\x0e_code_verifier\"L\n" +
+	"\x13LinkAccountResponse\x125\n" +
+	"\aaccount\x18\x01 \x01(\v2\x1b.organization.LinkedAccountR\aaccount\"2\n" +
+	"\x14UnlinkAccountRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"1\n" +
+	"\x15UnlinkAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1b\n" +
+	"\x19ListLinkedAccountsRequest\"U\n" +
+	"\x1aListLinkedAccountsResponse\x127\n" +
+	"\baccounts\x18\x01 \x03(\v2\x1b.organization.LinkedAccountR\baccounts\"\xf5\x03\n" +
 	"\n" +
 	"Invitation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
@@ -35386,14 +38316,16 @@ const file_service_proto_rawDesc = "" +
 	"\x17CancelInvitationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
 	"\x18CancelInvitationResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x87\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x95\x01\n" +
 	"\x16ListInvitationsRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\"U\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"}\n" +
 	"\x17ListInvitationsResponse\x12:\n" +
-	"\vinvitations\x18\x01 \x03(\v2\x18.organization.InvitationR\vinvitations\")\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x18.organization.InvitationR\vinvitations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\")\n" +
 	"\x17ResendInvitationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"s\n" +
 	"\x18ResendInvitationResponse\x128\n" +
@@ -35401,7 +38333,7 @@ const file_service_proto_rawDesc = "" +
 	"invitation\x18\x01 \x01(\v2\x18.organization.InvitationR\n" +
 	"invitation\x12\x1d\n" +
 	"\n" +
-	"invite_url\x18\x02 \x01(\tR\tinviteUrl\"\xc9\x05\n" +
+	"invite_url\x18\x02 \x01(\tR\tinviteUrl\"\xdd\x05\n" +
 	"\tETCMeisai\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x128\n" +
@@ -35426,7 +38358,8 @@ const file_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\n" +
+	"updated_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04etag\x18\x13 \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_date_frB\v\n" +
 	"\t_price_bfB\v\n" +
@@ -35473,7 +38406,7 @@ const file_service_proto_rawDesc = "" +
 	"\x1aGetETCMeisaiByHashResponse\x126\n" +
 	"\n" +
 	"etc_meisai\x18\x01 \x01(\v2\x17.organization.ETCMeisaiR\tetcMeisai\x12\x16\n" +
-	"\x06exists\x18\x02 \x01(\bR\x06exists\"\xb7\x04\n" +
+	"\x06exists\x18\x02 \x01(\bR\x06exists\"\xcb\x04\n" +
 	"\x16UpdateETCMeisaiRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x128\n" +
 	"\adate_fr\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x06dateFr\x88\x01\x01\x123\n" +
@@ -35493,7 +38426,8 @@ const file_service_proto_rawDesc = "" +
 	"\x06detail\x18\r \x01(\tH\x04R\x06detail\x88\x01\x01\x12%\n" +
 	"\fdtako_row_id\x18\x0e \x01(\tH\x05R\n" +
 	"dtakoRowId\x88\x01\x01\x12\x12\n" +
-	"\x04hash\x18\x0f \x01(\tR\x04hashB\n" +
+	"\x04hash\x18\x0f \x01(\tR\x04hash\x12\x12\n" +
+	"\x04etag\x18\x10 \x01(\tR\x04etagB\n" +
 	"\n" +
 	"\b_date_frB\v\n" +
 	"\t_price_bfB\v\n" +
@@ -35503,9 +38437,10 @@ const file_service_proto_rawDesc = "" +
 	"\r_dtako_row_id\"Q\n" +
 	"\x17UpdateETCMeisaiResponse\x126\n" +
 	"\n" +
-	"etc_meisai\x18\x01 \x01(\v2\x17.organization.ETCMeisaiR\tetcMeisai\"(\n" +
+	"etc_meisai\x18\x01 \x01(\v2\x17.organization.ETCMeisaiR\tetcMeisai\"<\n" +
 	"\x16DeleteETCMeisaiRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"3\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"3\n" +
 	"\x17DeleteETCMeisaiResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd6\x01\n" +
 	"\x14ListETCMeisaiRequest\x12\x1b\n" +
@@ -35532,7 +38467,93 @@ const file_service_proto_rawDesc = "" +
 	"\x1bBulkCreateETCMeisaiResponse\x12#\n" +
 	"\rcreated_count\x18\x01 \x01(\x05R\fcreatedCount\x12#\n" +
 	"\rskipped_count\x18\x02 \x01(\x05R\fskippedCount\x12\x16\n" +
-	"\x06errors\x18\x03 \x03(\tR\x06errors2\x96\x04\n" +
+	"\x06errors\x18\x03 \x03(\tR\x06errors\"\xe5\x03\n" +
+	"\x06ApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\x12>\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x12A\n" +
+	"\flast_used_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"lastUsedAt\x88\x01\x01\x12>\n" +
+	"\n" +
+	"revoked_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x02R\trevokedAt\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_expires_atB\x0f\n" +
+	"\r_last_used_atB\r\n" +
+	"\v_revoked_at\"\xcd\x01\n" +
+	"\x13CreateApiKeyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12>\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"W\n" +
+	"\x14CreateApiKeyResponse\x12-\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x14.organization.ApiKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"\"\n" +
+	"\x10GetApiKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x11GetApiKeyResponse\x12-\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x14.organization.ApiKeyR\x06apiKey\"f\n" +
+	"\x12ListApiKeysRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
+	"\x0finclude_revoked\x18\x02 \x01(\bR\x0eincludeRevoked\"F\n" +
+	"\x13ListApiKeysResponse\x12/\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x14.organization.ApiKeyR\aapiKeys\"%\n" +
+	"\x13RevokeApiKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"0\n" +
+	"\x14RevokeApiKeyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa2\x03\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x0forganization_id\x18\x02 \x01(\tH\x00R\x0eorganizationId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\tH\x01R\x06userId\x88\x01\x01\x12!\n" +
+	"\n" +
+	"api_key_id\x18\x04 \x01(\tH\x02R\bapiKeyId\x88\x01\x01\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12\x1f\n" +
+	"\ventity_type\x18\x06 \x01(\tR\n" +
+	"entityType\x12 \n" +
+	"\tentity_id\x18\a \x01(\tH\x03R\bentityId\x88\x01\x01\x12\x1f\n" +
+	"\vstatus_code\x18\b \x01(\tR\n" +
+	"statusCode\x12!\n" +
+	"\frequest_json\x18\t \x01(\tR\vrequestJson\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x12\n" +
+	"\x10_organization_idB\n" +
+	"\n" +
+	"\b_user_idB\r\n" +
+	"\v_api_key_idB\f\n" +
+	"\n" +
+	"_entity_id\"\x84\x03\n" +
+	"\x16ListAuditEventsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x1f\n" +
+	"\ventity_type\x18\x04 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x05 \x01(\tR\bentityId\x12>\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\b \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\t \x01(\tR\tpageTokenB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_time\"s\n" +
+	"\x17ListAuditEventsResponse\x120\n" +
+	"\x06events\x18\x01 \x03(\v2\x18.organization.AuditEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\x96\x04\n" +
 	"\x13OrganizationService\x12g\n" +
 	"\x12CreateOrganization\x12'.organization.CreateOrganizationRequest\x1a(.organization.CreateOrganizationResponse\x12^\n" +
 	"\x0fGetOrganization\x12$.organization.GetOrganizationRequest\x1a%.organization.GetOrganizationResponse\x12g\n" +
@@ -35732,14 +38753,21 @@ const file_service_proto_rawDesc = "" +
 	"\x0fUpdateDtakologs\x12$.organization.UpdateDtakologsRequest\x1a%.organization.UpdateDtakologsResponse\x12^\n" +
 	"\x0fDeleteDtakologs\x12$.organization.DeleteDtakologsRequest\x1a%.organization.DeleteDtakologsResponse\x12X\n" +
 	"\rListDtakologs\x12\".organization.ListDtakologsRequest\x1a#.organization.ListDtakologsResponse\x12\x82\x01\n" +
-	"\x1bListDtakologsByOrganization\x120.organization.ListDtakologsByOrganizationRequest\x1a1.organization.ListDtakologsByOrganizationResponse2\xa9\x03\n" +
+	"\x1bListDtakologsByOrganization\x120.organization.ListDtakologsByOrganizationRequest\x1a1.organization.ListDtakologsByOrganizationResponse2\xab\b\n" +
 	"\vAuthService\x12Q\n" +
 	"\x0eAuthWithGoogle\x12#.organization.AuthWithGoogleRequest\x1a\x1a.organization.AuthResponse\x12M\n" +
 	"\fAuthWithLine\x12!.organization.AuthWithLineRequest\x1a\x1a.organization.AuthResponse\x12M\n" +
 	"\fRefreshToken\x12!.organization.RefreshTokenRequest\x1a\x1a.organization.AuthResponse\x12O\n" +
 	"\n" +
 	"GetAuthURL\x12\x1f.organization.GetAuthURLRequest\x1a .organization.GetAuthURLResponse\x12X\n" +
-	"\rValidateToken\x12\".organization.ValidateTokenRequest\x1a#.organization.ValidateTokenResponse2\xc8\x05\n" +
+	"\rValidateToken\x12\".organization.ValidateTokenRequest\x1a#.organization.ValidateTokenResponse\x12C\n" +
+	"\x06Logout\x12\x1b.organization.LogoutRequest\x1a\x1c.organization.LogoutResponse\x12d\n" +
+	"\x11RevokeAllSessions\x12&.organization.RevokeAllSessionsRequest\x1a'.organization.RevokeAllSessionsResponse\x12U\n" +
+	"\fListSessions\x12!.organization.ListSessionsRequest\x1a\".organization.ListSessionsResponse\x12g\n" +
+	"\x12SwitchOrganization\x12'.organization.SwitchOrganizationRequest\x1a(.organization.SwitchOrganizationResponse\x12R\n" +
+	"\vLinkAccount\x12 .organization.LinkAccountRequest\x1a!.organization.LinkAccountResponse\x12X\n" +
+	"\rUnlinkAccount\x12\".organization.UnlinkAccountRequest\x1a#.organization.UnlinkAccountResponse\x12g\n" +
+	"\x12ListLinkedAccounts\x12'.organization.ListLinkedAccountsRequest\x1a(.organization.ListLinkedAccountsResponse2\xc8\x05\n" +
 	"\x11InvitationService\x12a\n" +
 	"\x10CreateInvitation\x12%.organization.CreateInvitationRequest\x1a&.organization.CreateInvitationResponse\x12X\n" +
 	"\rGetInvitation\x12\".organization.GetInvitationRequest\x1a#.organization.GetInvitationResponse\x12m\n" +
@@ -35755,7 +38783,14 @@ const file_service_proto_rawDesc = "" +
 	"\x0fUpdateETCMeisai\x12$.organization.UpdateETCMeisaiRequest\x1a%.organization.UpdateETCMeisaiResponse\x12^\n" +
 	"\x0fDeleteETCMeisai\x12$.organization.DeleteETCMeisaiRequest\x1a%.organization.DeleteETCMeisaiResponse\x12X\n" +
 	"\rListETCMeisai\x12\".organization.ListETCMeisaiRequest\x1a#.organization.ListETCMeisaiResponse\x12j\n" +
-	"\x13BulkCreateETCMeisai\x12(.organization.BulkCreateETCMeisaiRequest\x1a).organization.BulkCreateETCMeisaiResponseB\xa7\x01\n" +
+	"\x13BulkCreateETCMeisai\x12(.organization.BulkCreateETCMeisaiRequest\x1a).organization.BulkCreateETCMeisaiResponse2\xdf\x02\n" +
+	"\rApiKeyService\x12U\n" +
+	"\fCreateApiKey\x12!.organization.CreateApiKeyRequest\x1a\".organization.CreateApiKeyResponse\x12L\n" +
+	"\tGetApiKey\x12\x1e.organization.GetApiKeyRequest\x1a\x1f.organization.GetApiKeyResponse\x12R\n" +
+	"\vListApiKeys\x12 .organization.ListApiKeysRequest\x1a!.organization.ListApiKeysResponse\x12U\n" +
+	"\fRevokeApiKey\x12!.organization.RevokeApiKeyRequest\x1a\".organization.RevokeApiKeyResponse2q\n" +
+	"\x0fAuditLogService\x12^\n" +
+	"\x0fListAuditEvents\x12$.organization.ListAuditEventsRequest\x1a%.organization.ListAuditEventsResponseB\xa7\x01\n" +
 	"\x10com.organizationB\fServiceProtoP\x01Z5github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pb\xa2\x02\x03OXX\xaa\x02\fOrganization\xca\x02\fOrganization\xe2\x02\x18Organization\\GPBMetadata\xea\x02\fOrganizationb\x06proto3"
 
 var (
@@ -35770,7 +38805,7 @@ func file_service_proto_rawDescGZIP() []byte {
 	return file_service_proto_rawDescData
 }
 
-var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 389)
+var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 418)
 var file_service_proto_goTypes = []any{
 	(*Organization)(nil),                        // 0: organization.Organization
 	(*CreateOrganizationRequest)(nil),           // 1: organization.CreateOrganizationRequest
@@ -35930,257 +38965,287 @@ var file_service_proto_goTypes = []any{
 	(*ListUriageJishasByOrganizationResponse)(nil),                      // 155: organization.ListUriageJishasByOrganizationResponse
 	(*CarInspection)(nil),                                               // 156: organization.CarInspection
 	(*CreateCarInspectionRequest)(nil),                                  // 157: organization.CreateCarInspectionRequest
-	(*CreateCarInspectionResponse)(nil),                                 // 158: organization.CreateCarInspectionResponse
-	(*GetCarInspectionRequest)(nil),                                     // 159: organization.GetCarInspectionRequest
-	(*GetCarInspectionResponse)(nil),                                    // 160: organization.GetCarInspectionResponse
-	(*UpdateCarInspectionRequest)(nil),                                  // 161: organization.UpdateCarInspectionRequest
-	(*UpdateCarInspectionResponse)(nil),                                 // 162: organization.UpdateCarInspectionResponse
-	(*DeleteCarInspectionRequest)(nil),                                  // 163: organization.DeleteCarInspectionRequest
-	(*DeleteCarInspectionResponse)(nil),                                 // 164: organization.DeleteCarInspectionResponse
-	(*ListCarInspectionsRequest)(nil),                                   // 165: organization.ListCarInspectionsRequest
-	(*ListCarInspectionsResponse)(nil),                                  // 166: organization.ListCarInspectionsResponse
-	(*ListCarInspectionsByOrganizationRequest)(nil),                     // 167: organization.ListCarInspectionsByOrganizationRequest
-	(*ListCarInspectionsByOrganizationResponse)(nil),                    // 168: organization.ListCarInspectionsByOrganizationResponse
-	(*CarInspectionFile)(nil),                                           // 169: organization.CarInspectionFile
-	(*CreateCarInspectionFileRequest)(nil),                              // 170: organization.CreateCarInspectionFileRequest
-	(*CreateCarInspectionFileResponse)(nil),                             // 171: organization.CreateCarInspectionFileResponse
-	(*GetCarInspectionFileRequest)(nil),                                 // 172: organization.GetCarInspectionFileRequest
-	(*GetCarInspectionFileResponse)(nil),                                // 173: organization.GetCarInspectionFileResponse
-	(*UpdateCarInspectionFileRequest)(nil),                              // 174: organization.UpdateCarInspectionFileRequest
-	(*UpdateCarInspectionFileResponse)(nil),                             // 175: organization.UpdateCarInspectionFileResponse
-	(*DeleteCarInspectionFileRequest)(nil),                              // 176: organization.DeleteCarInspectionFileRequest
-	(*DeleteCarInspectionFileResponse)(nil),                             // 177: organization.DeleteCarInspectionFileResponse
-	(*ListCarInspectionFilesRequest)(nil),                               // 178: organization.ListCarInspectionFilesRequest
-	(*ListCarInspectionFilesResponse)(nil),                              // 179: organization.ListCarInspectionFilesResponse
-	(*ListCarInspectionFilesByOrganizationRequest)(nil),                 // 180: organization.ListCarInspectionFilesByOrganizationRequest
-	(*ListCarInspectionFilesByOrganizationResponse)(nil),                // 181: organization.ListCarInspectionFilesByOrganizationResponse
-	(*CarInspectionFilesA)(nil),                                         // 182: organization.CarInspectionFilesA
-	(*CreateCarInspectionFilesARequest)(nil),                            // 183: organization.CreateCarInspectionFilesARequest
-	(*CreateCarInspectionFilesAResponse)(nil),                           // 184: organization.CreateCarInspectionFilesAResponse
-	(*GetCarInspectionFilesARequest)(nil),                               // 185: organization.GetCarInspectionFilesARequest
-	(*GetCarInspectionFilesAResponse)(nil),                              // 186: organization.GetCarInspectionFilesAResponse
-	(*UpdateCarInspectionFilesARequest)(nil),                            // 187: organization.UpdateCarInspectionFilesARequest
-	(*UpdateCarInspectionFilesAResponse)(nil),                           // 188: organization.UpdateCarInspectionFilesAResponse
-	(*DeleteCarInspectionFilesARequest)(nil),                            // 189: organization.DeleteCarInspectionFilesARequest
-	(*DeleteCarInspectionFilesAResponse)(nil),                           // 190: organization.DeleteCarInspectionFilesAResponse
-	(*ListCarInspectionFilesAsRequest)(nil),                             // 191: organization.ListCarInspectionFilesAsRequest
-	(*ListCarInspectionFilesAsResponse)(nil),                            // 192: organization.ListCarInspectionFilesAsResponse
-	(*ListCarInspectionFilesAsByOrganizationRequest)(nil),               // 193: organization.ListCarInspectionFilesAsByOrganizationRequest
-	(*ListCarInspectionFilesAsByOrganizationResponse)(nil),              // 194: organization.ListCarInspectionFilesAsByOrganizationResponse
-	(*CarInspectionFilesB)(nil),                                         // 195: organization.CarInspectionFilesB
-	(*CreateCarInspectionFilesBRequest)(nil),                            // 196: organization.CreateCarInspectionFilesBRequest
-	(*CreateCarInspectionFilesBResponse)(nil),                           // 197: organization.CreateCarInspectionFilesBResponse
-	(*GetCarInspectionFilesBRequest)(nil),                               // 198: organization.GetCarInspectionFilesBRequest
-	(*GetCarInspectionFilesBResponse)(nil),                              // 199: organization.GetCarInspectionFilesBResponse
-	(*UpdateCarInspectionFilesBRequest)(nil),                            // 200: organization.UpdateCarInspectionFilesBRequest
-	(*UpdateCarInspectionFilesBResponse)(nil),                           // 201: organization.UpdateCarInspectionFilesBResponse
-	(*DeleteCarInspectionFilesBRequest)(nil),                            // 202: organization.DeleteCarInspectionFilesBRequest
-	(*DeleteCarInspectionFilesBResponse)(nil),                           // 203: organization.DeleteCarInspectionFilesBResponse
-	(*ListCarInspectionFilesBsRequest)(nil),                             // 204: organization.ListCarInspectionFilesBsRequest
-	(*ListCarInspectionFilesBsResponse)(nil),                            // 205: organization.ListCarInspectionFilesBsResponse
-	(*ListCarInspectionFilesBsByOrganizationRequest)(nil),               // 206: organization.ListCarInspectionFilesBsByOrganizationRequest
-	(*ListCarInspectionFilesBsByOrganizationResponse)(nil),              // 207: organization.ListCarInspectionFilesBsByOrganizationResponse
-	(*CarInspectionDeregistration)(nil),                                 // 208: organization.CarInspectionDeregistration
-	(*CreateCarInspectionDeregistrationRequest)(nil),                    // 209: organization.CreateCarInspectionDeregistrationRequest
-	(*CreateCarInspectionDeregistrationResponse)(nil),                   // 210: organization.CreateCarInspectionDeregistrationResponse
-	(*GetCarInspectionDeregistrationRequest)(nil),                       // 211: organization.GetCarInspectionDeregistrationRequest
-	(*GetCarInspectionDeregistrationResponse)(nil),                      // 212: organization.GetCarInspectionDeregistrationResponse
-	(*UpdateCarInspectionDeregistrationRequest)(nil),                    // 213: organization.UpdateCarInspectionDeregistrationRequest
-	(*UpdateCarInspectionDeregistrationResponse)(nil),                   // 214: organization.UpdateCarInspectionDeregistrationResponse
-	(*DeleteCarInspectionDeregistrationRequest)(nil),                    // 215: organization.DeleteCarInspectionDeregistrationRequest
-	(*DeleteCarInspectionDeregistrationResponse)(nil),                   // 216: organization.DeleteCarInspectionDeregistrationResponse
-	(*ListCarInspectionDeregistrationsRequest)(nil),                     // 217: organization.ListCarInspectionDeregistrationsRequest
-	(*ListCarInspectionDeregistrationsResponse)(nil),                    // 218: organization.ListCarInspectionDeregistrationsResponse
-	(*ListCarInspectionDeregistrationsByOrganizationRequest)(nil),       // 219: organization.ListCarInspectionDeregistrationsByOrganizationRequest
-	(*ListCarInspectionDeregistrationsByOrganizationResponse)(nil),      // 220: organization.ListCarInspectionDeregistrationsByOrganizationResponse
-	(*CarInspectionDeregistrationFiles)(nil),                            // 221: organization.CarInspectionDeregistrationFiles
-	(*CreateCarInspectionDeregistrationFilesRequest)(nil),               // 222: organization.CreateCarInspectionDeregistrationFilesRequest
-	(*CreateCarInspectionDeregistrationFilesResponse)(nil),              // 223: organization.CreateCarInspectionDeregistrationFilesResponse
-	(*GetCarInspectionDeregistrationFilesRequest)(nil),                  // 224: organization.GetCarInspectionDeregistrationFilesRequest
-	(*GetCarInspectionDeregistrationFilesResponse)(nil),                 // 225: organization.GetCarInspectionDeregistrationFilesResponse
-	(*UpdateCarInspectionDeregistrationFilesRequest)(nil),               // 226: organization.UpdateCarInspectionDeregistrationFilesRequest
-	(*UpdateCarInspectionDeregistrationFilesResponse)(nil),              // 227: organization.UpdateCarInspectionDeregistrationFilesResponse
-	(*DeleteCarInspectionDeregistrationFilesRequest)(nil),               // 228: organization.DeleteCarInspectionDeregistrationFilesRequest
-	(*DeleteCarInspectionDeregistrationFilesResponse)(nil),              // 229: organization.DeleteCarInspectionDeregistrationFilesResponse
-	(*ListCarInspectionDeregistrationFilessRequest)(nil),                // 230: organization.ListCarInspectionDeregistrationFilessRequest
-	(*ListCarInspectionDeregistrationFilessResponse)(nil),               // 231: organization.ListCarInspectionDeregistrationFilessResponse
-	(*ListCarInspectionDeregistrationFilessByOrganizationRequest)(nil),  // 232: organization.ListCarInspectionDeregistrationFilessByOrganizationRequest
-	(*ListCarInspectionDeregistrationFilessByOrganizationResponse)(nil), // 233: organization.ListCarInspectionDeregistrationFilessByOrganizationResponse
-	(*CarInsSheetIchibanCars)(nil),                                      // 234: organization.CarInsSheetIchibanCars
-	(*CreateCarInsSheetIchibanCarsRequest)(nil),                         // 235: organization.CreateCarInsSheetIchibanCarsRequest
-	(*CreateCarInsSheetIchibanCarsResponse)(nil),                        // 236: organization.CreateCarInsSheetIchibanCarsResponse
-	(*GetCarInsSheetIchibanCarsRequest)(nil),                            // 237: organization.GetCarInsSheetIchibanCarsRequest
-	(*GetCarInsSheetIchibanCarsResponse)(nil),                           // 238: organization.GetCarInsSheetIchibanCarsResponse
-	(*UpdateCarInsSheetIchibanCarsRequest)(nil),                         // 239: organization.UpdateCarInsSheetIchibanCarsRequest
-	(*UpdateCarInsSheetIchibanCarsResponse)(nil),                        // 240: organization.UpdateCarInsSheetIchibanCarsResponse
-	(*DeleteCarInsSheetIchibanCarsRequest)(nil),                         // 241: organization.DeleteCarInsSheetIchibanCarsRequest
-	(*DeleteCarInsSheetIchibanCarsResponse)(nil),                        // 242: organization.DeleteCarInsSheetIchibanCarsResponse
-	(*ListCarInsSheetIchibanCarssRequest)(nil),                          // 243: organization.ListCarInsSheetIchibanCarssRequest
-	(*ListCarInsSheetIchibanCarssResponse)(nil),                         // 244: organization.ListCarInsSheetIchibanCarssResponse
-	(*ListCarInsSheetIchibanCarssByOrganizationRequest)(nil),            // 245: organization.ListCarInsSheetIchibanCarssByOrganizationRequest
-	(*ListCarInsSheetIchibanCarssByOrganizationResponse)(nil),           // 246: organization.ListCarInsSheetIchibanCarssByOrganizationResponse
-	(*CarInsSheetIchibanCarsA)(nil),                                     // 247: organization.CarInsSheetIchibanCarsA
-	(*CreateCarInsSheetIchibanCarsARequest)(nil),                        // 248: organization.CreateCarInsSheetIchibanCarsARequest
-	(*CreateCarInsSheetIchibanCarsAResponse)(nil),                       // 249: organization.CreateCarInsSheetIchibanCarsAResponse
-	(*GetCarInsSheetIchibanCarsARequest)(nil),                           // 250: organization.GetCarInsSheetIchibanCarsARequest
-	(*GetCarInsSheetIchibanCarsAResponse)(nil),                          // 251: organization.GetCarInsSheetIchibanCarsAResponse
-	(*UpdateCarInsSheetIchibanCarsARequest)(nil),                        // 252: organization.UpdateCarInsSheetIchibanCarsARequest
-	(*UpdateCarInsSheetIchibanCarsAResponse)(nil),                       // 253: organization.UpdateCarInsSheetIchibanCarsAResponse
-	(*DeleteCarInsSheetIchibanCarsARequest)(nil),                        // 254: organization.DeleteCarInsSheetIchibanCarsARequest
-	(*DeleteCarInsSheetIchibanCarsAResponse)(nil),                       // 255: organization.DeleteCarInsSheetIchibanCarsAResponse
-	(*ListCarInsSheetIchibanCarsAsRequest)(nil),                         // 256: organization.ListCarInsSheetIchibanCarsAsRequest
-	(*ListCarInsSheetIchibanCarsAsResponse)(nil),                        // 257: organization.ListCarInsSheetIchibanCarsAsResponse
-	(*ListCarInsSheetIchibanCarsAsByOrganizationRequest)(nil),           // 258: organization.ListCarInsSheetIchibanCarsAsByOrganizationRequest
-	(*ListCarInsSheetIchibanCarsAsByOrganizationResponse)(nil),          // 259: organization.ListCarInsSheetIchibanCarsAsByOrganizationResponse
-	(*Kudgfry)(nil),                                                     // 260: organization.Kudgfry
-	(*CreateKudgfryRequest)(nil),                                        // 261: organization.CreateKudgfryRequest
-	(*CreateKudgfryResponse)(nil),                                       // 262: organization.CreateKudgfryResponse
-	(*GetKudgfryRequest)(nil),                                           // 263: organization.GetKudgfryRequest
-	(*GetKudgfryResponse)(nil),                                          // 264: organization.GetKudgfryResponse
-	(*UpdateKudgfryRequest)(nil),                                        // 265: organization.UpdateKudgfryRequest
-	(*UpdateKudgfryResponse)(nil),                                       // 266: organization.UpdateKudgfryResponse
-	(*DeleteKudgfryRequest)(nil),                                        // 267: organization.DeleteKudgfryRequest
-	(*DeleteKudgfryResponse)(nil),                                       // 268: organization.DeleteKudgfryResponse
-	(*ListKudgfrysRequest)(nil),                                         // 269: organization.ListKudgfrysRequest
-	(*ListKudgfrysResponse)(nil),                                        // 270: organization.ListKudgfrysResponse
-	(*ListKudgfrysByOrganizationRequest)(nil),                           // 271: organization.ListKudgfrysByOrganizationRequest
-	(*ListKudgfrysByOrganizationResponse)(nil),                          // 272: organization.ListKudgfrysByOrganizationResponse
-	(*Kudguri)(nil),                                                     // 273: organization.Kudguri
-	(*CreateKudguriRequest)(nil),                                        // 274: organization.CreateKudguriRequest
-	(*CreateKudguriResponse)(nil),                                       // 275: organization.CreateKudguriResponse
-	(*GetKudguriRequest)(nil),                                           // 276: organization.GetKudguriRequest
-	(*GetKudguriResponse)(nil),                                          // 277: organization.GetKudguriResponse
-	(*UpdateKudguriRequest)(nil),                                        // 278: organization.UpdateKudguriRequest
-	(*UpdateKudguriResponse)(nil),                                       // 279: organization.UpdateKudguriResponse
-	(*DeleteKudguriRequest)(nil),                                        // 280: organization.DeleteKudguriRequest
-	(*DeleteKudguriResponse)(nil),                                       // 281: organization.DeleteKudguriResponse
-	(*ListKudgurisRequest)(nil),                                         // 282: organization.ListKudgurisRequest
-	(*ListKudgurisResponse)(nil),                                        // 283: organization.ListKudgurisResponse
-	(*ListKudgurisByOrganizationRequest)(nil),                           // 284: organization.ListKudgurisByOrganizationRequest
-	(*ListKudgurisByOrganizationResponse)(nil),                          // 285: organization.ListKudgurisByOrganizationResponse
-	(*Kudgcst)(nil),                                                     // 286: organization.Kudgcst
-	(*CreateKudgcstRequest)(nil),                                        // 287: organization.CreateKudgcstRequest
-	(*CreateKudgcstResponse)(nil),                                       // 288: organization.CreateKudgcstResponse
-	(*GetKudgcstRequest)(nil),                                           // 289: organization.GetKudgcstRequest
-	(*GetKudgcstResponse)(nil),                                          // 290: organization.GetKudgcstResponse
-	(*UpdateKudgcstRequest)(nil),                                        // 291: organization.UpdateKudgcstRequest
-	(*UpdateKudgcstResponse)(nil),                                       // 292: organization.UpdateKudgcstResponse
-	(*DeleteKudgcstRequest)(nil),                                        // 293: organization.DeleteKudgcstRequest
-	(*DeleteKudgcstResponse)(nil),                                       // 294: organization.DeleteKudgcstResponse
-	(*ListKudgcstsRequest)(nil),                                         // 295: organization.ListKudgcstsRequest
-	(*ListKudgcstsResponse)(nil),                                        // 296: organization.ListKudgcstsResponse
-	(*ListKudgcstsByOrganizationRequest)(nil),                           // 297: organization.ListKudgcstsByOrganizationRequest
-	(*ListKudgcstsByOrganizationResponse)(nil),                          // 298: organization.ListKudgcstsByOrganizationResponse
-	(*Kudgful)(nil),                                                     // 299: organization.Kudgful
-	(*CreateKudgfulRequest)(nil),                                        // 300: organization.CreateKudgfulRequest
-	(*CreateKudgfulResponse)(nil),                                       // 301: organization.CreateKudgfulResponse
-	(*GetKudgfulRequest)(nil),                                           // 302: organization.GetKudgfulRequest
-	(*GetKudgfulResponse)(nil),                                          // 303: organization.GetKudgfulResponse
-	(*UpdateKudgfulRequest)(nil),                                        // 304: organization.UpdateKudgfulRequest
-	(*UpdateKudgfulResponse)(nil),                                       // 305: organization.UpdateKudgfulResponse
-	(*DeleteKudgfulRequest)(nil),                                        // 306: organization.DeleteKudgfulRequest
-	(*DeleteKudgfulResponse)(nil),                                       // 307: organization.DeleteKudgfulResponse
-	(*ListKudgfulsRequest)(nil),                                         // 308: organization.ListKudgfulsRequest
-	(*ListKudgfulsResponse)(nil),                                        // 309: organization.ListKudgfulsResponse
-	(*ListKudgfulsByOrganizationRequest)(nil),                           // 310: organization.ListKudgfulsByOrganizationRequest
-	(*ListKudgfulsByOrganizationResponse)(nil),                          // 311: organization.ListKudgfulsByOrganizationResponse
-	(*Kudgsir)(nil),                                                     // 312: organization.Kudgsir
-	(*CreateKudgsirRequest)(nil),                                        // 313: organization.CreateKudgsirRequest
-	(*CreateKudgsirResponse)(nil),                                       // 314: organization.CreateKudgsirResponse
-	(*GetKudgsirRequest)(nil),                                           // 315: organization.GetKudgsirRequest
-	(*GetKudgsirResponse)(nil),                                          // 316: organization.GetKudgsirResponse
-	(*UpdateKudgsirRequest)(nil),                                        // 317: organization.UpdateKudgsirRequest
-	(*UpdateKudgsirResponse)(nil),                                       // 318: organization.UpdateKudgsirResponse
-	(*DeleteKudgsirRequest)(nil),                                        // 319: organization.DeleteKudgsirRequest
-	(*DeleteKudgsirResponse)(nil),                                       // 320: organization.DeleteKudgsirResponse
-	(*ListKudgsirsRequest)(nil),                                         // 321: organization.ListKudgsirsRequest
-	(*ListKudgsirsResponse)(nil),                                        // 322: organization.ListKudgsirsResponse
-	(*ListKudgsirsByOrganizationRequest)(nil),                           // 323: organization.ListKudgsirsByOrganizationRequest
-	(*ListKudgsirsByOrganizationResponse)(nil),                          // 324: organization.ListKudgsirsByOrganizationResponse
-	(*Kudgivt)(nil),                                                     // 325: organization.Kudgivt
-	(*CreateKudgivtRequest)(nil),                                        // 326: organization.CreateKudgivtRequest
-	(*CreateKudgivtResponse)(nil),                                       // 327: organization.CreateKudgivtResponse
-	(*GetKudgivtRequest)(nil),                                           // 328: organization.GetKudgivtRequest
-	(*GetKudgivtResponse)(nil),                                          // 329: organization.GetKudgivtResponse
-	(*UpdateKudgivtRequest)(nil),                                        // 330: organization.UpdateKudgivtRequest
-	(*UpdateKudgivtResponse)(nil),                                       // 331: organization.UpdateKudgivtResponse
-	(*DeleteKudgivtRequest)(nil),                                        // 332: organization.DeleteKudgivtRequest
-	(*DeleteKudgivtResponse)(nil),                                       // 333: organization.DeleteKudgivtResponse
-	(*ListKudgivtsRequest)(nil),                                         // 334: organization.ListKudgivtsRequest
-	(*ListKudgivtsResponse)(nil),                                        // 335: organization.ListKudgivtsResponse
-	(*ListKudgivtsByOrganizationRequest)(nil),                           // 336: organization.ListKudgivtsByOrganizationRequest
-	(*ListKudgivtsByOrganizationResponse)(nil),                          // 337: organization.ListKudgivtsByOrganizationResponse
-	(*Dtakologs)(nil),                                                   // 338: organization.Dtakologs
-	(*CreateDtakologsRequest)(nil),                                      // 339: organization.CreateDtakologsRequest
-	(*CreateDtakologsResponse)(nil),                                     // 340: organization.CreateDtakologsResponse
-	(*GetDtakologsRequest)(nil),                                         // 341: organization.GetDtakologsRequest
-	(*GetDtakologsResponse)(nil),                                        // 342: organization.GetDtakologsResponse
-	(*UpdateDtakologsRequest)(nil),                                      // 343: organization.UpdateDtakologsRequest
-	(*UpdateDtakologsResponse)(nil),                                     // 344: organization.UpdateDtakologsResponse
-	(*DeleteDtakologsRequest)(nil),                                      // 345: organization.DeleteDtakologsRequest
-	(*DeleteDtakologsResponse)(nil),                                     // 346: organization.DeleteDtakologsResponse
-	(*ListDtakologsRequest)(nil),                                        // 347: organization.ListDtakologsRequest
-	(*ListDtakologsResponse)(nil),                                       // 348: organization.ListDtakologsResponse
-	(*ListDtakologsByOrganizationRequest)(nil),                          // 349: organization.ListDtakologsByOrganizationRequest
-	(*ListDtakologsByOrganizationResponse)(nil),                         // 350: organization.ListDtakologsByOrganizationResponse
-	(*AuthResponse)(nil),                                                // 351: organization.AuthResponse
-	(*AuthWithGoogleRequest)(nil),                                       // 352: organization.AuthWithGoogleRequest
-	(*AuthWithLineRequest)(nil),                                         // 353: organization.AuthWithLineRequest
-	(*RefreshTokenRequest)(nil),                                         // 354: organization.RefreshTokenRequest
-	(*GetAuthURLRequest)(nil),                                           // 355: organization.GetAuthURLRequest
-	(*GetAuthURLResponse)(nil),                                          // 356: organization.GetAuthURLResponse
-	(*ValidateTokenRequest)(nil),                                        // 357: organization.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),                                       // 358: organization.ValidateTokenResponse
-	(*Invitation)(nil),                                                  // 359: organization.Invitation
-	(*CreateInvitationRequest)(nil),                                     // 360: organization.CreateInvitationRequest
-	(*CreateInvitationResponse)(nil),                                    // 361: organization.CreateInvitationResponse
-	(*GetInvitationRequest)(nil),                                        // 362: organization.GetInvitationRequest
-	(*GetInvitationResponse)(nil),                                       // 363: organization.GetInvitationResponse
-	(*GetInvitationByTokenRequest)(nil),                                 // 364: organization.GetInvitationByTokenRequest
-	(*GetInvitationByTokenResponse)(nil),                                // 365: organization.GetInvitationByTokenResponse
-	(*AcceptInvitationRequest)(nil),                                     // 366: organization.AcceptInvitationRequest
-	(*AcceptInvitationResponse)(nil),                                    // 367: organization.AcceptInvitationResponse
-	(*CancelInvitationRequest)(nil),                                     // 368: organization.CancelInvitationRequest
-	(*CancelInvitationResponse)(nil),                                    // 369: organization.CancelInvitationResponse
-	(*ListInvitationsRequest)(nil),                                      // 370: organization.ListInvitationsRequest
-	(*ListInvitationsResponse)(nil),                                     // 371: organization.ListInvitationsResponse
-	(*ResendInvitationRequest)(nil),                                     // 372: organization.ResendInvitationRequest
-	(*ResendInvitationResponse)(nil),                                    // 373: organization.ResendInvitationResponse
-	(*ETCMeisai)(nil),                                                   // 374: organization.ETCMeisai
-	(*CreateETCMeisaiRequest)(nil),                                      // 375: organization.CreateETCMeisaiRequest
-	(*CreateETCMeisaiResponse)(nil),                                     // 376: organization.CreateETCMeisaiResponse
-	(*GetETCMeisaiRequest)(nil),                                         // 377: organization.GetETCMeisaiRequest
-	(*GetETCMeisaiResponse)(nil),                                        // 378: organization.GetETCMeisaiResponse
-	(*GetETCMeisaiByHashRequest)(nil),                                   // 379: organization.GetETCMeisaiByHashRequest
-	(*GetETCMeisaiByHashResponse)(nil),                                  // 380: organization.GetETCMeisaiByHashResponse
-	(*UpdateETCMeisaiRequest)(nil),                                      // 381: organization.UpdateETCMeisaiRequest
-	(*UpdateETCMeisaiResponse)(nil),                                     // 382: organization.UpdateETCMeisaiResponse
-	(*DeleteETCMeisaiRequest)(nil),                                      // 383: organization.DeleteETCMeisaiRequest
-	(*DeleteETCMeisaiResponse)(nil),                                     // 384: organization.DeleteETCMeisaiResponse
-	(*ListETCMeisaiRequest)(nil),                                        // 385: organization.ListETCMeisaiRequest
-	(*ListETCMeisaiResponse)(nil),                                       // 386: organization.ListETCMeisaiResponse
-	(*BulkCreateETCMeisaiRequest)(nil),                                  // 387: organization.BulkCreateETCMeisaiRequest
-	(*BulkCreateETCMeisaiResponse)(nil),                                 // 388: organization.BulkCreateETCMeisaiResponse
-	(*timestamppb.Timestamp)(nil),                                       // 389: google.protobuf.Timestamp
+	(*CarInspectionFileInput)(nil),                                      // 158: organization.CarInspectionFileInput
+	(*CreateCarInspectionResponse)(nil),                                 // 159: organization.CreateCarInspectionResponse
+	(*GetCarInspectionRequest)(nil),                                     // 160: organization.GetCarInspectionRequest
+	(*GetCarInspectionResponse)(nil),                                    // 161: organization.GetCarInspectionResponse
+	(*UpdateCarInspectionRequest)(nil),                                  // 162: organization.UpdateCarInspectionRequest
+	(*UpdateCarInspectionResponse)(nil),                                 // 163: organization.UpdateCarInspectionResponse
+	(*DeleteCarInspectionRequest)(nil),                                  // 164: organization.DeleteCarInspectionRequest
+	(*DeleteCarInspectionResponse)(nil),                                 // 165: organization.DeleteCarInspectionResponse
+	(*ListCarInspectionsRequest)(nil),                                   // 166: organization.ListCarInspectionsRequest
+	(*ListCarInspectionsResponse)(nil),                                  // 167: organization.ListCarInspectionsResponse
+	(*ListCarInspectionsByOrganizationRequest)(nil),                     // 168: organization.ListCarInspectionsByOrganizationRequest
+	(*ListCarInspectionsByOrganizationResponse)(nil),                    // 169: organization.ListCarInspectionsByOrganizationResponse
+	(*CarInspectionFile)(nil),                                           // 170: organization.CarInspectionFile
+	(*CreateCarInspectionFileRequest)(nil),                              // 171: organization.CreateCarInspectionFileRequest
+	(*CreateCarInspectionFileResponse)(nil),                             // 172: organization.CreateCarInspectionFileResponse
+	(*GetCarInspectionFileRequest)(nil),                                 // 173: organization.GetCarInspectionFileRequest
+	(*GetCarInspectionFileResponse)(nil),                                // 174: organization.GetCarInspectionFileResponse
+	(*UpdateCarInspectionFileRequest)(nil),                              // 175: organization.UpdateCarInspectionFileRequest
+	(*UpdateCarInspectionFileResponse)(nil),                             // 176: organization.UpdateCarInspectionFileResponse
+	(*DeleteCarInspectionFileRequest)(nil),                              // 177: organization.DeleteCarInspectionFileRequest
+	(*DeleteCarInspectionFileResponse)(nil),                             // 178: organization.DeleteCarInspectionFileResponse
+	(*ListCarInspectionFilesRequest)(nil),                               // 179: organization.ListCarInspectionFilesRequest
+	(*ListCarInspectionFilesResponse)(nil),                              // 180: organization.ListCarInspectionFilesResponse
+	(*ListCarInspectionFilesByOrganizationRequest)(nil),                 // 181: organization.ListCarInspectionFilesByOrganizationRequest
+	(*ListCarInspectionFilesByOrganizationResponse)(nil),                // 182: organization.ListCarInspectionFilesByOrganizationResponse
+	(*CarInspectionFilesA)(nil),                                         // 183: organization.CarInspectionFilesA
+	(*CreateCarInspectionFilesARequest)(nil),                            // 184: organization.CreateCarInspectionFilesARequest
+	(*CreateCarInspectionFilesAResponse)(nil),                           // 185: organization.CreateCarInspectionFilesAResponse
+	(*GetCarInspectionFilesARequest)(nil),                               // 186: organization.GetCarInspectionFilesARequest
+	(*GetCarInspectionFilesAResponse)(nil),                              // 187: organization.GetCarInspectionFilesAResponse
+	(*UpdateCarInspectionFilesARequest)(nil),                            // 188: organization.UpdateCarInspectionFilesARequest
+	(*UpdateCarInspectionFilesAResponse)(nil),                           // 189: organization.UpdateCarInspectionFilesAResponse
+	(*DeleteCarInspectionFilesARequest)(nil),                            // 190: organization.DeleteCarInspectionFilesARequest
+	(*DeleteCarInspectionFilesAResponse)(nil),                           // 191: organization.DeleteCarInspectionFilesAResponse
+	(*ListCarInspectionFilesAsRequest)(nil),                             // 192: organization.ListCarInspectionFilesAsRequest
+	(*ListCarInspectionFilesAsResponse)(nil),                            // 193: organization.ListCarInspectionFilesAsResponse
+	(*ListCarInspectionFilesAsByOrganizationRequest)(nil),               // 194: organization.ListCarInspectionFilesAsByOrganizationRequest
+	(*ListCarInspectionFilesAsByOrganizationResponse)(nil),              // 195: organization.ListCarInspectionFilesAsByOrganizationResponse
+	(*CarInspectionFilesB)(nil),                                         // 196: organization.CarInspectionFilesB
+	(*CreateCarInspectionFilesBRequest)(nil),                            // 197: organization.CreateCarInspectionFilesBRequest
+	(*CreateCarInspectionFilesBResponse)(nil),                           // 198: organization.CreateCarInspectionFilesBResponse
+	(*GetCarInspectionFilesBRequest)(nil),                               // 199: organization.GetCarInspectionFilesBRequest
+	(*GetCarInspectionFilesBResponse)(nil),                              // 200: organization.GetCarInspectionFilesBResponse
+	(*UpdateCarInspectionFilesBRequest)(nil),                            // 201: organization.UpdateCarInspectionFilesBRequest
+	(*UpdateCarInspectionFilesBResponse)(nil),                           // 202: organization.UpdateCarInspectionFilesBResponse
+	(*DeleteCarInspectionFilesBRequest)(nil),                            // 203: organization.DeleteCarInspectionFilesBRequest
+	(*DeleteCarInspectionFilesBResponse)(nil),                           // 204: organization.DeleteCarInspectionFilesBResponse
+	(*ListCarInspectionFilesBsRequest)(nil),                             // 205: organization.ListCarInspectionFilesBsRequest
+	(*ListCarInspectionFilesBsResponse)(nil),                            // 206: organization.ListCarInspectionFilesBsResponse
+	(*ListCarInspectionFilesBsByOrganizationRequest)(nil),               // 207: organization.ListCarInspectionFilesBsByOrganizationRequest
+	(*ListCarInspectionFilesBsByOrganizationResponse)(nil),              // 208: organization.ListCarInspectionFilesBsByOrganizationResponse
+	(*CarInspectionDeregistration)(nil),                                 // 209: organization.CarInspectionDeregistration
+	(*CreateCarInspectionDeregistrationRequest)(nil),                    // 210: organization.CreateCarInspectionDeregistrationRequest
+	(*CreateCarInspectionDeregistrationResponse)(nil),                   // 211: organization.CreateCarInspectionDeregistrationResponse
+	(*GetCarInspectionDeregistrationRequest)(nil),                       // 212: organization.GetCarInspectionDeregistrationRequest
+	(*GetCarInspectionDeregistrationResponse)(nil),                      // 213: organization.GetCarInspectionDeregistrationResponse
+	(*UpdateCarInspectionDeregistrationRequest)(nil),                    // 214: organization.UpdateCarInspectionDeregistrationRequest
+	(*UpdateCarInspectionDeregistrationResponse)(nil),                   // 215: organization.UpdateCarInspectionDeregistrationResponse
+	(*DeleteCarInspectionDeregistrationRequest)(nil),                    // 216: organization.DeleteCarInspectionDeregistrationRequest
+	(*DeleteCarInspectionDeregistrationResponse)(nil),                   // 217: organization.DeleteCarInspectionDeregistrationResponse
+	(*ListCarInspectionDeregistrationsRequest)(nil),                     // 218: organization.ListCarInspectionDeregistrationsRequest
+	(*ListCarInspectionDeregistrationsResponse)(nil),                    // 219: organization.ListCarInspectionDeregistrationsResponse
+	(*ListCarInspectionDeregistrationsByOrganizationRequest)(nil),       // 220: organization.ListCarInspectionDeregistrationsByOrganizationRequest
+	(*ListCarInspectionDeregistrationsByOrganizationResponse)(nil),      // 221: organization.ListCarInspectionDeregistrationsByOrganizationResponse
+	(*CarInspectionDeregistrationFiles)(nil),                            // 222: organization.CarInspectionDeregistrationFiles
+	(*CreateCarInspectionDeregistrationFilesRequest)(nil),               // 223: organization.CreateCarInspectionDeregistrationFilesRequest
+	(*CreateCarInspectionDeregistrationFilesResponse)(nil),              // 224: organization.CreateCarInspectionDeregistrationFilesResponse
+	(*GetCarInspectionDeregistrationFilesRequest)(nil),                  // 225: organization.GetCarInspectionDeregistrationFilesRequest
+	(*GetCarInspectionDeregistrationFilesResponse)(nil),                 // 226: organization.GetCarInspectionDeregistrationFilesResponse
+	(*UpdateCarInspectionDeregistrationFilesRequest)(nil),               // 227: organization.UpdateCarInspectionDeregistrationFilesRequest
+	(*UpdateCarInspectionDeregistrationFilesResponse)(nil),              // 228: organization.UpdateCarInspectionDeregistrationFilesResponse
+	(*DeleteCarInspectionDeregistrationFilesRequest)(nil),               // 229: organization.DeleteCarInspectionDeregistrationFilesRequest
+	(*DeleteCarInspectionDeregistrationFilesResponse)(nil),              // 230: organization.DeleteCarInspectionDeregistrationFilesResponse
+	(*ListCarInspectionDeregistrationFilessRequest)(nil),                // 231: organization.ListCarInspectionDeregistrationFilessRequest
+	(*ListCarInspectionDeregistrationFilessResponse)(nil),               // 232: organization.ListCarInspectionDeregistrationFilessResponse
+	(*ListCarInspectionDeregistrationFilessByOrganizationRequest)(nil),  // 233: organization.ListCarInspectionDeregistrationFilessByOrganizationRequest
+	(*ListCarInspectionDeregistrationFilessByOrganizationResponse)(nil), // 234: organization.ListCarInspectionDeregistrationFilessByOrganizationResponse
+	(*CarInsSheetIchibanCars)(nil),                                      // 235: organization.CarInsSheetIchibanCars
+	(*CreateCarInsSheetIchibanCarsRequest)(nil),                         // 236: organization.CreateCarInsSheetIchibanCarsRequest
+	(*CreateCarInsSheetIchibanCarsResponse)(nil),                        // 237: organization.CreateCarInsSheetIchibanCarsResponse
+	(*GetCarInsSheetIchibanCarsRequest)(nil),                            // 238: organization.GetCarInsSheetIchibanCarsRequest
+	(*GetCarInsSheetIchibanCarsResponse)(nil),                           // 239: organization.GetCarInsSheetIchibanCarsResponse
+	(*UpdateCarInsSheetIchibanCarsRequest)(nil),                         // 240: organization.UpdateCarInsSheetIchibanCarsRequest
+	(*UpdateCarInsSheetIchibanCarsResponse)(nil),                        // 241: organization.UpdateCarInsSheetIchibanCarsResponse
+	(*DeleteCarInsSheetIchibanCarsRequest)(nil),                         // 242: organization.DeleteCarInsSheetIchibanCarsRequest
+	(*DeleteCarInsSheetIchibanCarsResponse)(nil),                        // 243: organization.DeleteCarInsSheetIchibanCarsResponse
+	(*ListCarInsSheetIchibanCarssRequest)(nil),                          // 244: organization.ListCarInsSheetIchibanCarssRequest
+	(*ListCarInsSheetIchibanCarssResponse)(nil),                         // 245: organization.ListCarInsSheetIchibanCarssResponse
+	(*ListCarInsSheetIchibanCarssByOrganizationRequest)(nil),            // 246: organization.ListCarInsSheetIchibanCarssByOrganizationRequest
+	(*ListCarInsSheetIchibanCarssByOrganizationResponse)(nil),           // 247: organization.ListCarInsSheetIchibanCarssByOrganizationResponse
+	(*CarInsSheetIchibanCarsA)(nil),                                     // 248: organization.CarInsSheetIchibanCarsA
+	(*CreateCarInsSheetIchibanCarsARequest)(nil),                        // 249: organization.CreateCarInsSheetIchibanCarsARequest
+	(*CreateCarInsSheetIchibanCarsAResponse)(nil),                       // 250: organization.CreateCarInsSheetIchibanCarsAResponse
+	(*GetCarInsSheetIchibanCarsARequest)(nil),                           // 251: organization.GetCarInsSheetIchibanCarsARequest
+	(*GetCarInsSheetIchibanCarsAResponse)(nil),                          // 252: organization.GetCarInsSheetIchibanCarsAResponse
+	(*UpdateCarInsSheetIchibanCarsARequest)(nil),                        // 253: organization.UpdateCarInsSheetIchibanCarsARequest
+	(*UpdateCarInsSheetIchibanCarsAResponse)(nil),                       // 254: organization.UpdateCarInsSheetIchibanCarsAResponse
+	(*DeleteCarInsSheetIchibanCarsARequest)(nil),                        // 255: organization.DeleteCarInsSheetIchibanCarsARequest
+	(*DeleteCarInsSheetIchibanCarsAResponse)(nil),                       // 256: organization.DeleteCarInsSheetIchibanCarsAResponse
+	(*ListCarInsSheetIchibanCarsAsRequest)(nil),                         // 257: organization.ListCarInsSheetIchibanCarsAsRequest
+	(*ListCarInsSheetIchibanCarsAsResponse)(nil),                        // 258: organization.ListCarInsSheetIchibanCarsAsResponse
+	(*ListCarInsSheetIchibanCarsAsByOrganizationRequest)(nil),           // 259: organization.ListCarInsSheetIchibanCarsAsByOrganizationRequest
+	(*ListCarInsSheetIchibanCarsAsByOrganizationResponse)(nil),          // 260: organization.ListCarInsSheetIchibanCarsAsByOrganizationResponse
+	(*Kudgfry)(nil),                                                     // 261: organization.Kudgfry
+	(*CreateKudgfryRequest)(nil),                                        // 262: organization.CreateKudgfryRequest
+	(*CreateKudgfryResponse)(nil),                                       // 263: organization.CreateKudgfryResponse
+	(*GetKudgfryRequest)(nil),                                           // 264: organization.GetKudgfryRequest
+	(*GetKudgfryResponse)(nil),                                          // 265: organization.GetKudgfryResponse
+	(*UpdateKudgfryRequest)(nil),                                        // 266: organization.UpdateKudgfryRequest
+	(*UpdateKudgfryResponse)(nil),                                       // 267: organization.UpdateKudgfryResponse
+	(*DeleteKudgfryRequest)(nil),                                        // 268: organization.DeleteKudgfryRequest
+	(*DeleteKudgfryResponse)(nil),                                       // 269: organization.DeleteKudgfryResponse
+	(*ListKudgfrysRequest)(nil),                                         // 270: organization.ListKudgfrysRequest
+	(*ListKudgfrysResponse)(nil),                                        // 271: organization.ListKudgfrysResponse
+	(*ListKudgfrysByOrganizationRequest)(nil),                           // 272: organization.ListKudgfrysByOrganizationRequest
+	(*ListKudgfrysByOrganizationResponse)(nil),                          // 273: organization.ListKudgfrysByOrganizationResponse
+	(*Kudguri)(nil),                                                     // 274: organization.Kudguri
+	(*CreateKudguriRequest)(nil),                                        // 275: organization.CreateKudguriRequest
+	(*CreateKudguriResponse)(nil),                                       // 276: organization.CreateKudguriResponse
+	(*GetKudguriRequest)(nil),                                           // 277: organization.GetKudguriRequest
+	(*GetKudguriResponse)(nil),                                          // 278: organization.GetKudguriResponse
+	(*UpdateKudguriRequest)(nil),                                        // 279: organization.UpdateKudguriRequest
+	(*UpdateKudguriResponse)(nil),                                       // 280: organization.UpdateKudguriResponse
+	(*DeleteKudguriRequest)(nil),                                        // 281: organization.DeleteKudguriRequest
+	(*DeleteKudguriResponse)(nil),                                       // 282: organization.DeleteKudguriResponse
+	(*ListKudgurisRequest)(nil),                                         // 283: organization.ListKudgurisRequest
+	(*ListKudgurisResponse)(nil),                                        // 284: organization.ListKudgurisResponse
+	(*ListKudgurisByOrganizationRequest)(nil),                           // 285: organization.ListKudgurisByOrganizationRequest
+	(*ListKudgurisByOrganizationResponse)(nil),                          // 286: organization.ListKudgurisByOrganizationResponse
+	(*Kudgcst)(nil),                                                     // 287: organization.Kudgcst
+	(*CreateKudgcstRequest)(nil),                                        // 288: organization.CreateKudgcstRequest
+	(*CreateKudgcstResponse)(nil),                                       // 289: organization.CreateKudgcstResponse
+	(*GetKudgcstRequest)(nil),                                           // 290: organization.GetKudgcstRequest
+	(*GetKudgcstResponse)(nil),                                          // 291: organization.GetKudgcstResponse
+	(*UpdateKudgcstRequest)(nil),                                        // 292: organization.UpdateKudgcstRequest
+	(*UpdateKudgcstResponse)(nil),                                       // 293: organization.UpdateKudgcstResponse
+	(*DeleteKudgcstRequest)(nil),                                        // 294: organization.DeleteKudgcstRequest
+	(*DeleteKudgcstResponse)(nil),                                       // 295: organization.DeleteKudgcstResponse
+	(*ListKudgcstsRequest)(nil),                                         // 296: organization.ListKudgcstsRequest
+	(*ListKudgcstsResponse)(nil),                                        // 297: organization.ListKudgcstsResponse
+	(*ListKudgcstsByOrganizationRequest)(nil),                           // 298: organization.ListKudgcstsByOrganizationRequest
+	(*ListKudgcstsByOrganizationResponse)(nil),                          // 299: organization.ListKudgcstsByOrganizationResponse
+	(*Kudgful)(nil),                                                     // 300: organization.Kudgful
+	(*CreateKudgfulRequest)(nil),                                        // 301: organization.CreateKudgfulRequest
+	(*CreateKudgfulResponse)(nil),                                       // 302: organization.CreateKudgfulResponse
+	(*GetKudgfulRequest)(nil),                                           // 303: organization.GetKudgfulRequest
+	(*GetKudgfulResponse)(nil),                                          // 304: organization.GetKudgfulResponse
+	(*UpdateKudgfulRequest)(nil),                                        // 305: organization.UpdateKudgfulRequest
+	(*UpdateKudgfulResponse)(nil),                                       // 306: organization.UpdateKudgfulResponse
+	(*DeleteKudgfulRequest)(nil),                                        // 307: organization.DeleteKudgfulRequest
+	(*DeleteKudgfulResponse)(nil),                                       // 308: organization.DeleteKudgfulResponse
+	(*ListKudgfulsRequest)(nil),                                         // 309: organization.ListKudgfulsRequest
+	(*ListKudgfulsResponse)(nil),                                        // 310: organization.ListKudgfulsResponse
+	(*ListKudgfulsByOrganizationRequest)(nil),                           // 311: organization.ListKudgfulsByOrganizationRequest
+	(*ListKudgfulsByOrganizationResponse)(nil),                          // 312: organization.ListKudgfulsByOrganizationResponse
+	(*Kudgsir)(nil),                                                     // 313: organization.Kudgsir
+	(*CreateKudgsirRequest)(nil),                                        // 314: organization.CreateKudgsirRequest
+	(*CreateKudgsirResponse)(nil),                                       // 315: organization.CreateKudgsirResponse
+	(*GetKudgsirRequest)(nil),                                           // 316: organization.GetKudgsirRequest
+	(*GetKudgsirResponse)(nil),                                          // 317: organization.GetKudgsirResponse
+	(*UpdateKudgsirRequest)(nil),                                        // 318: organization.UpdateKudgsirRequest
+	(*UpdateKudgsirResponse)(nil),                                       // 319: organization.UpdateKudgsirResponse
+	(*DeleteKudgsirRequest)(nil),                                        // 320: organization.DeleteKudgsirRequest
+	(*DeleteKudgsirResponse)(nil),                                       // 321: organization.DeleteKudgsirResponse
+	(*ListKudgsirsRequest)(nil),                                         // 322: organization.ListKudgsirsRequest
+	(*ListKudgsirsResponse)(nil),                                        // 323: organization.ListKudgsirsResponse
+	(*ListKudgsirsByOrganizationRequest)(nil),                           // 324: organization.ListKudgsirsByOrganizationRequest
+	(*ListKudgsirsByOrganizationResponse)(nil),                          // 325: organization.ListKudgsirsByOrganizationResponse
+	(*Kudgivt)(nil),                                                     // 326: organization.Kudgivt
+	(*CreateKudgivtRequest)(nil),                                        // 327: organization.CreateKudgivtRequest
+	(*CreateKudgivtResponse)(nil),                                       // 328: organization.CreateKudgivtResponse
+	(*GetKudgivtRequest)(nil),                                           // 329: organization.GetKudgivtRequest
+	(*GetKudgivtResponse)(nil),                                          // 330: organization.GetKudgivtResponse
+	(*UpdateKudgivtRequest)(nil),                                        // 331: organization.UpdateKudgivtRequest
+	(*UpdateKudgivtResponse)(nil),                                       // 332: organization.UpdateKudgivtResponse
+	(*DeleteKudgivtRequest)(nil),                                        // 333: organization.DeleteKudgivtRequest
+	(*DeleteKudgivtResponse)(nil),                                       // 334: organization.DeleteKudgivtResponse
+	(*ListKudgivtsRequest)(nil),                                         // 335: organization.ListKudgivtsRequest
+	(*ListKudgivtsResponse)(nil),                                        // 336: organization.ListKudgivtsResponse
+	(*ListKudgivtsByOrganizationRequest)(nil),                           // 337: organization.ListKudgivtsByOrganizationRequest
+	(*ListKudgivtsByOrganizationResponse)(nil),                          // 338: organization.ListKudgivtsByOrganizationResponse
+	(*Dtakologs)(nil),                                                   // 339: organization.Dtakologs
+	(*CreateDtakologsRequest)(nil),                                      // 340: organization.CreateDtakologsRequest
+	(*CreateDtakologsResponse)(nil),                                     // 341: organization.CreateDtakologsResponse
+	(*GetDtakologsRequest)(nil),                                         // 342: organization.GetDtakologsRequest
+	(*GetDtakologsResponse)(nil),                                        // 343: organization.GetDtakologsResponse
+	(*UpdateDtakologsRequest)(nil),                                      // 344: organization.UpdateDtakologsRequest
+	(*UpdateDtakologsResponse)(nil),                                     // 345: organization.UpdateDtakologsResponse
+	(*DeleteDtakologsRequest)(nil),                                      // 346: organization.DeleteDtakologsRequest
+	(*DeleteDtakologsResponse)(nil),                                     // 347: organization.DeleteDtakologsResponse
+	(*ListDtakologsRequest)(nil),                                        // 348: organization.ListDtakologsRequest
+	(*ListDtakologsResponse)(nil),                                       // 349: organization.ListDtakologsResponse
+	(*ListDtakologsByOrganizationRequest)(nil),                          // 350: organization.ListDtakologsByOrganizationRequest
+	(*ListDtakologsByOrganizationResponse)(nil),                         // 351: organization.ListDtakologsByOrganizationResponse
+	(*AuthResponse)(nil),                                                // 352: organization.AuthResponse
+	(*AuthWithGoogleRequest)(nil),                                       // 353: organization.AuthWithGoogleRequest
+	(*AuthWithLineRequest)(nil),                                         // 354: organization.AuthWithLineRequest
+	(*RefreshTokenRequest)(nil),                                         // 355: organization.RefreshTokenRequest
+	(*GetAuthURLRequest)(nil),                                           // 356: organization.GetAuthURLRequest
+	(*GetAuthURLResponse)(nil),                                          // 357: organization.GetAuthURLResponse
+	(*ValidateTokenRequest)(nil),                                        // 358: organization.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),                                       // 359: organization.ValidateTokenResponse
+	(*LogoutRequest)(nil),                                               // 360: organization.LogoutRequest
+	(*LogoutResponse)(nil),                                              // 361: organization.LogoutResponse
+	(*RevokeAllSessionsRequest)(nil),                                    // 362: organization.RevokeAllSessionsRequest
+	(*RevokeAllSessionsResponse)(nil),                                   // 363: organization.RevokeAllSessionsResponse
+	(*Session)(nil),                                                     // 364: organization.Session
+	(*ListSessionsRequest)(nil),                                         // 365: organization.ListSessionsRequest
+	(*ListSessionsResponse)(nil),                                        // 366: organization.ListSessionsResponse
+	(*SwitchOrganizationRequest)(nil),                                   // 367: organization.SwitchOrganizationRequest
+	(*SwitchOrganizationResponse)(nil),                                  // 368: organization.SwitchOrganizationResponse
+	(*LinkedAccount)(nil),                                               // 369: organization.LinkedAccount
+	(*LinkAccountRequest)(nil),                                          // 370: organization.LinkAccountRequest
+	(*LinkAccountResponse)(nil),                                         // 371: organization.LinkAccountResponse
+	(*UnlinkAccountRequest)(nil),                                        // 372: organization.UnlinkAccountRequest
+	(*UnlinkAccountResponse)(nil),                                       // 373: organization.UnlinkAccountResponse
+	(*ListLinkedAccountsRequest)(nil),                                   // 374: organization.ListLinkedAccountsRequest
+	(*ListLinkedAccountsResponse)(nil),                                  // 375: organization.ListLinkedAccountsResponse
+	(*Invitation)(nil),                                                  // 376: organization.Invitation
+	(*CreateInvitationRequest)(nil),                                     // 377: organization.CreateInvitationRequest
+	(*CreateInvitationResponse)(nil),                                    // 378: organization.CreateInvitationResponse
+	(*GetInvitationRequest)(nil),                                        // 379: organization.GetInvitationRequest
+	(*GetInvitationResponse)(nil),                                       // 380: organization.GetInvitationResponse
+	(*GetInvitationByTokenRequest)(nil),                                 // 381: organization.GetInvitationByTokenRequest
+	(*GetInvitationByTokenResponse)(nil),                                // 382: organization.GetInvitationByTokenResponse
+	(*AcceptInvitationRequest)(nil),                                     // 383: organization.AcceptInvitationRequest
+	(*AcceptInvitationResponse)(nil),                                    // 384: organization.AcceptInvitationResponse
+	(*CancelInvitationRequest)(nil),                                     // 385: organization.CancelInvitationRequest
+	(*CancelInvitationResponse)(nil),                                    // 386: organization.CancelInvitationResponse
+	(*ListInvitationsRequest)(nil),                                      // 387: organization.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),                                     // 388: organization.ListInvitationsResponse
+	(*ResendInvitationRequest)(nil),                                     // 389: organization.ResendInvitationRequest
+	(*ResendInvitationResponse)(nil),                                    // 390: organization.ResendInvitationResponse
+	(*ETCMeisai)(nil),                                                   // 391: organization.ETCMeisai
+	(*CreateETCMeisaiRequest)(nil),                                      // 392: organization.CreateETCMeisaiRequest
+	(*CreateETCMeisaiResponse)(nil),                                     // 393: organization.CreateETCMeisaiResponse
+	(*GetETCMeisaiRequest)(nil),                                         // 394: organization.GetETCMeisaiRequest
+	(*GetETCMeisaiResponse)(nil),                                        // 395: organization.GetETCMeisaiResponse
+	(*GetETCMeisaiByHashRequest)(nil),                                   // 396: organization.GetETCMeisaiByHashRequest
+	(*GetETCMeisaiByHashResponse)(nil),                                  // 397: organization.GetETCMeisaiByHashResponse
+	(*UpdateETCMeisaiRequest)(nil),                                      // 398: organization.UpdateETCMeisaiRequest
+	(*UpdateETCMeisaiResponse)(nil),                                     // 399: organization.UpdateETCMeisaiResponse
+	(*DeleteETCMeisaiRequest)(nil),                                      // 400: organization.DeleteETCMeisaiRequest
+	(*DeleteETCMeisaiResponse)(nil),                                     // 401: organization.DeleteETCMeisaiResponse
+	(*ListETCMeisaiRequest)(nil),                                        // 402: organization.ListETCMeisaiRequest
+	(*ListETCMeisaiResponse)(nil),                                       // 403: organization.ListETCMeisaiResponse
+	(*BulkCreateETCMeisaiRequest)(nil),                                  // 404: organization.BulkCreateETCMeisaiRequest
+	(*BulkCreateETCMeisaiResponse)(nil),                                 // 405: organization.BulkCreateETCMeisaiResponse
+	(*ApiKey)(nil),                                                      // 406: organization.ApiKey
+	(*CreateApiKeyRequest)(nil),                                         // 407: organization.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),                                        // 408: organization.CreateApiKeyResponse
+	(*GetApiKeyRequest)(nil),                                            // 409: organization.GetApiKeyRequest
+	(*GetApiKeyResponse)(nil),                                           // 410: organization.GetApiKeyResponse
+	(*ListApiKeysRequest)(nil),                                          // 411: organization.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),                                         // 412: organization.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),                                         // 413: organization.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),                                        // 414: organization.RevokeApiKeyResponse
+	(*AuditEvent)(nil),                                                  // 415: organization.AuditEvent
+	(*ListAuditEventsRequest)(nil),                                      // 416: organization.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),                                     // 417: organization.ListAuditEventsResponse
+	(*timestamppb.Timestamp)(nil),                                       // 418: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                                       // 419: google.protobuf.FieldMask
 }
 var file_service_proto_depIdxs = []int32{
-	389, // 0: organization.Organization.created_at:type_name -> google.protobuf.Timestamp
-	389, // 1: organization.Organization.updated_at:type_name -> google.protobuf.Timestamp
-	389, // 2: organization.Organization.deleted_at:type_name -> google.protobuf.Timestamp
+	418, // 0: organization.Organization.created_at:type_name -> google.protobuf.Timestamp
+	418, // 1: organization.Organization.updated_at:type_name -> google.protobuf.Timestamp
+	418, // 2: organization.Organization.deleted_at:type_name -> google.protobuf.Timestamp
 	0,   // 3: organization.CreateOrganizationResponse.organization:type_name -> organization.Organization
 	0,   // 4: organization.GetOrganizationResponse.organization:type_name -> organization.Organization
 	0,   // 5: organization.UpdateOrganizationResponse.organization:type_name -> organization.Organization
 	0,   // 6: organization.ListOrganizationsResponse.organizations:type_name -> organization.Organization
-	389, // 7: organization.AppUser.created_at:type_name -> google.protobuf.Timestamp
-	389, // 8: organization.AppUser.updated_at:type_name -> google.protobuf.Timestamp
-	389, // 9: organization.AppUser.deleted_at:type_name -> google.protobuf.Timestamp
+	418, // 7: organization.AppUser.created_at:type_name -> google.protobuf.Timestamp
+	418, // 8: organization.AppUser.updated_at:type_name -> google.protobuf.Timestamp
+	418, // 9: organization.AppUser.deleted_at:type_name -> google.protobuf.Timestamp
 	11,  // 10: organization.CreateAppUserResponse.app_user:type_name -> organization.AppUser
 	11,  // 11: organization.GetAppUserResponse.app_user:type_name -> organization.AppUser
 	11,  // 12: organization.GetAppUserByEmailResponse.app_user:type_name -> organization.AppUser
 	11,  // 13: organization.UpdateAppUserResponse.app_user:type_name -> organization.AppUser
 	11,  // 14: organization.ListAppUsersResponse.app_users:type_name -> organization.AppUser
-	389, // 15: organization.UserOrganization.created_at:type_name -> google.protobuf.Timestamp
-	389, // 16: organization.UserOrganization.updated_at:type_name -> google.protobuf.Timestamp
+	418, // 15: organization.UserOrganization.created_at:type_name -> google.protobuf.Timestamp
+	418, // 16: organization.UserOrganization.updated_at:type_name -> google.protobuf.Timestamp
 	24,  // 17: organization.CreateUserOrganizationResponse.user_organization:type_name -> organization.UserOrganization
 	24,  // 18: organization.GetUserOrganizationResponse.user_organization:type_name -> organization.UserOrganization
 	24,  // 19: organization.UpdateUserOrganizationResponse.user_organization:type_name -> organization.UserOrganization
@@ -36232,475 +39297,521 @@ var file_service_proto_depIdxs = []int32{
 	143, // 65: organization.UpdateUriageJishaResponse.uriage_jisha:type_name -> organization.UriageJisha
 	143, // 66: organization.ListUriageJishasResponse.uriage_jishas:type_name -> organization.UriageJisha
 	143, // 67: organization.ListUriageJishasByOrganizationResponse.uriage_jishas:type_name -> organization.UriageJisha
-	156, // 68: organization.CreateCarInspectionResponse.car_inspection:type_name -> organization.CarInspection
-	156, // 69: organization.GetCarInspectionResponse.car_inspection:type_name -> organization.CarInspection
-	156, // 70: organization.UpdateCarInspectionResponse.car_inspection:type_name -> organization.CarInspection
-	156, // 71: organization.ListCarInspectionsResponse.car_inspections:type_name -> organization.CarInspection
-	156, // 72: organization.ListCarInspectionsByOrganizationResponse.car_inspections:type_name -> organization.CarInspection
-	169, // 73: organization.CreateCarInspectionFileResponse.car_inspection_file:type_name -> organization.CarInspectionFile
-	169, // 74: organization.GetCarInspectionFileResponse.car_inspection_file:type_name -> organization.CarInspectionFile
-	169, // 75: organization.UpdateCarInspectionFileResponse.car_inspection_file:type_name -> organization.CarInspectionFile
-	169, // 76: organization.ListCarInspectionFilesResponse.car_inspection_files:type_name -> organization.CarInspectionFile
-	169, // 77: organization.ListCarInspectionFilesByOrganizationResponse.car_inspection_files:type_name -> organization.CarInspectionFile
-	182, // 78: organization.CreateCarInspectionFilesAResponse.car_inspection_files_a:type_name -> organization.CarInspectionFilesA
-	182, // 79: organization.GetCarInspectionFilesAResponse.car_inspection_files_a:type_name -> organization.CarInspectionFilesA
-	182, // 80: organization.UpdateCarInspectionFilesAResponse.car_inspection_files_a:type_name -> organization.CarInspectionFilesA
-	182, // 81: organization.ListCarInspectionFilesAsResponse.car_inspection_files_as:type_name -> organization.CarInspectionFilesA
-	182, // 82: organization.ListCarInspectionFilesAsByOrganizationResponse.car_inspection_files_as:type_name -> organization.CarInspectionFilesA
-	195, // 83: organization.CreateCarInspectionFilesBResponse.car_inspection_files_b:type_name -> organization.CarInspectionFilesB
-	195, // 84: organization.GetCarInspectionFilesBResponse.car_inspection_files_b:type_name -> organization.CarInspectionFilesB
-	195, // 85: organization.UpdateCarInspectionFilesBResponse.car_inspection_files_b:type_name -> organization.CarInspectionFilesB
-	195, // 86: organization.ListCarInspectionFilesBsResponse.car_inspection_files_bs:type_name -> organization.CarInspectionFilesB
-	195, // 87: organization.ListCarInspectionFilesBsByOrganizationResponse.car_inspection_files_bs:type_name -> organization.CarInspectionFilesB
-	208, // 88: organization.CreateCarInspectionDeregistrationResponse.car_inspection_deregistration:type_name -> organization.CarInspectionDeregistration
-	208, // 89: organization.GetCarInspectionDeregistrationResponse.car_inspection_deregistration:type_name -> organization.CarInspectionDeregistration
-	208, // 90: organization.UpdateCarInspectionDeregistrationResponse.car_inspection_deregistration:type_name -> organization.CarInspectionDeregistration
-	208, // 91: organization.ListCarInspectionDeregistrationsResponse.car_inspection_deregistrations:type_name -> organization.CarInspectionDeregistration
-	208, // 92: organization.ListCarInspectionDeregistrationsByOrganizationResponse.car_inspection_deregistrations:type_name -> organization.CarInspectionDeregistration
-	221, // 93: organization.CreateCarInspectionDeregistrationFilesResponse.car_inspection_deregistration_files:type_name -> organization.CarInspectionDeregistrationFiles
-	221, // 94: organization.GetCarInspectionDeregistrationFilesResponse.car_inspection_deregistration_files:type_name -> organization.CarInspectionDeregistrationFiles
-	221, // 95: organization.UpdateCarInspectionDeregistrationFilesResponse.car_inspection_deregistration_files:type_name -> organization.CarInspectionDeregistrationFiles
-	221, // 96: organization.ListCarInspectionDeregistrationFilessResponse.car_inspection_deregistration_filess:type_name -> organization.CarInspectionDeregistrationFiles
-	221, // 97: organization.ListCarInspectionDeregistrationFilessByOrganizationResponse.car_inspection_deregistration_filess:type_name -> organization.CarInspectionDeregistrationFiles
-	234, // 98: organization.CreateCarInsSheetIchibanCarsResponse.car_ins_sheet_ichiban_cars:type_name -> organization.CarInsSheetIchibanCars
-	234, // 99: organization.GetCarInsSheetIchibanCarsResponse.car_ins_sheet_ichiban_cars:type_name -> organization.CarInsSheetIchibanCars
-	234, // 100: organization.UpdateCarInsSheetIchibanCarsResponse.car_ins_sheet_ichiban_cars:type_name -> organization.CarInsSheetIchibanCars
-	234, // 101: organization.ListCarInsSheetIchibanCarssResponse.car_ins_sheet_ichiban_carss:type_name -> organization.CarInsSheetIchibanCars
-	234, // 102: organization.ListCarInsSheetIchibanCarssByOrganizationResponse.car_ins_sheet_ichiban_carss:type_name -> organization.CarInsSheetIchibanCars
-	247, // 103: organization.CreateCarInsSheetIchibanCarsAResponse.car_ins_sheet_ichiban_cars_a:type_name -> organization.CarInsSheetIchibanCarsA
-	247, // 104: organization.GetCarInsSheetIchibanCarsAResponse.car_ins_sheet_ichiban_cars_a:type_name -> organization.CarInsSheetIchibanCarsA
-	247, // 105: organization.UpdateCarInsSheetIchibanCarsAResponse.car_ins_sheet_ichiban_cars_a:type_name -> organization.CarInsSheetIchibanCarsA
-	247, // 106: organization.ListCarInsSheetIchibanCarsAsResponse.car_ins_sheet_ichiban_cars_as:type_name -> organization.CarInsSheetIchibanCarsA
-	247, // 107: organization.ListCarInsSheetIchibanCarsAsByOrganizationResponse.car_ins_sheet_ichiban_cars_as:type_name -> organization.CarInsSheetIchibanCarsA
-	260, // 108: organization.CreateKudgfryResponse.kudgfry:type_name -> organization.Kudgfry
-	260, // 109: organization.GetKudgfryResponse.kudgfry:type_name -> organization.Kudgfry
-	260, // 110: organization.UpdateKudgfryResponse.kudgfry:type_name -> organization.Kudgfry
-	260, // 111: organization.ListKudgfrysResponse.kudgfrys:type_name -> organization.Kudgfry
-	260, // 112: organization.ListKudgfrysByOrganizationResponse.kudgfrys:type_name -> organization.Kudgfry
-	273, // 113: organization.CreateKudguriResponse.kudguri:type_name -> organization.Kudguri
-	273, // 114: organization.GetKudguriResponse.kudguri:type_name -> organization.Kudguri
-	273, // 115: organization.UpdateKudguriResponse.kudguri:type_name -> organization.Kudguri
-	273, // 116: organization.ListKudgurisResponse.kudguris:type_name -> organization.Kudguri
-	273, // 117: organization.ListKudgurisByOrganizationResponse.kudguris:type_name -> organization.Kudguri
-	286, // 118: organization.CreateKudgcstResponse.kudgcst:type_name -> organization.Kudgcst
-	286, // 119: organization.GetKudgcstResponse.kudgcst:type_name -> organization.Kudgcst
-	286, // 120: organization.UpdateKudgcstResponse.kudgcst:type_name -> organization.Kudgcst
-	286, // 121: organization.ListKudgcstsResponse.kudgcsts:type_name -> organization.Kudgcst
-	286, // 122: organization.ListKudgcstsByOrganizationResponse.kudgcsts:type_name -> organization.Kudgcst
-	299, // 123: organization.CreateKudgfulResponse.kudgful:type_name -> organization.Kudgful
-	299, // 124: organization.GetKudgfulResponse.kudgful:type_name -> organization.Kudgful
-	299, // 125: organization.UpdateKudgfulResponse.kudgful:type_name -> organization.Kudgful
-	299, // 126: organization.ListKudgfulsResponse.kudgfuls:type_name -> organization.Kudgful
-	299, // 127: organization.ListKudgfulsByOrganizationResponse.kudgfuls:type_name -> organization.Kudgful
-	312, // 128: organization.CreateKudgsirResponse.kudgsir:type_name -> organization.Kudgsir
-	312, // 129: organization.GetKudgsirResponse.kudgsir:type_name -> organization.Kudgsir
-	312, // 130: organization.UpdateKudgsirResponse.kudgsir:type_name -> organization.Kudgsir
-	312, // 131: organization.ListKudgsirsResponse.kudgsirs:type_name -> organization.Kudgsir
-	312, // 132: organization.ListKudgsirsByOrganizationResponse.kudgsirs:type_name -> organization.Kudgsir
-	325, // 133: organization.CreateKudgivtResponse.kudgivt:type_name -> organization.Kudgivt
-	325, // 134: organization.GetKudgivtResponse.kudgivt:type_name -> organization.Kudgivt
-	325, // 135: organization.UpdateKudgivtResponse.kudgivt:type_name -> organization.Kudgivt
-	325, // 136: organization.ListKudgivtsResponse.kudgivts:type_name -> organization.Kudgivt
-	325, // 137: organization.ListKudgivtsByOrganizationResponse.kudgivts:type_name -> organization.Kudgivt
-	338, // 138: organization.CreateDtakologsResponse.dtakologs:type_name -> organization.Dtakologs
-	338, // 139: organization.GetDtakologsResponse.dtakologs:type_name -> organization.Dtakologs
-	338, // 140: organization.UpdateDtakologsResponse.dtakologs:type_name -> organization.Dtakologs
-	338, // 141: organization.ListDtakologsResponse.dtakologs:type_name -> organization.Dtakologs
-	338, // 142: organization.ListDtakologsByOrganizationResponse.dtakologs:type_name -> organization.Dtakologs
-	11,  // 143: organization.AuthResponse.user:type_name -> organization.AppUser
-	11,  // 144: organization.ValidateTokenResponse.user:type_name -> organization.AppUser
-	389, // 145: organization.Invitation.expires_at:type_name -> google.protobuf.Timestamp
-	389, // 146: organization.Invitation.accepted_at:type_name -> google.protobuf.Timestamp
-	389, // 147: organization.Invitation.created_at:type_name -> google.protobuf.Timestamp
-	389, // 148: organization.Invitation.updated_at:type_name -> google.protobuf.Timestamp
-	359, // 149: organization.CreateInvitationResponse.invitation:type_name -> organization.Invitation
-	359, // 150: organization.GetInvitationResponse.invitation:type_name -> organization.Invitation
-	359, // 151: organization.GetInvitationByTokenResponse.invitation:type_name -> organization.Invitation
-	0,   // 152: organization.GetInvitationByTokenResponse.organization:type_name -> organization.Organization
-	24,  // 153: organization.AcceptInvitationResponse.user_organization:type_name -> organization.UserOrganization
-	359, // 154: organization.ListInvitationsResponse.invitations:type_name -> organization.Invitation
-	359, // 155: organization.ResendInvitationResponse.invitation:type_name -> organization.Invitation
-	389, // 156: organization.ETCMeisai.date_fr:type_name -> google.protobuf.Timestamp
-	389, // 157: organization.ETCMeisai.date_to:type_name -> google.protobuf.Timestamp
-	389, // 158: organization.ETCMeisai.created_at:type_name -> google.protobuf.Timestamp
-	389, // 159: organization.ETCMeisai.updated_at:type_name -> google.protobuf.Timestamp
-	389, // 160: organization.CreateETCMeisaiRequest.date_fr:type_name -> google.protobuf.Timestamp
-	389, // 161: organization.CreateETCMeisaiRequest.date_to:type_name -> google.protobuf.Timestamp
-	374, // 162: organization.CreateETCMeisaiResponse.etc_meisai:type_name -> organization.ETCMeisai
-	374, // 163: organization.GetETCMeisaiResponse.etc_meisai:type_name -> organization.ETCMeisai
-	374, // 164: organization.GetETCMeisaiByHashResponse.etc_meisai:type_name -> organization.ETCMeisai
-	389, // 165: organization.UpdateETCMeisaiRequest.date_fr:type_name -> google.protobuf.Timestamp
-	389, // 166: organization.UpdateETCMeisaiRequest.date_to:type_name -> google.protobuf.Timestamp
-	374, // 167: organization.UpdateETCMeisaiResponse.etc_meisai:type_name -> organization.ETCMeisai
-	374, // 168: organization.ListETCMeisaiResponse.etc_meisai_list:type_name -> organization.ETCMeisai
-	375, // 169: organization.BulkCreateETCMeisaiRequest.records:type_name -> organization.CreateETCMeisaiRequest
-	1,   // 170: organization.OrganizationService.CreateOrganization:input_type -> organization.CreateOrganizationRequest
-	3,   // 171: organization.OrganizationService.GetOrganization:input_type -> organization.GetOrganizationRequest
-	5,   // 172: organization.OrganizationService.UpdateOrganization:input_type -> organization.UpdateOrganizationRequest
-	7,   // 173: organization.OrganizationService.DeleteOrganization:input_type -> organization.DeleteOrganizationRequest
-	9,   // 174: organization.OrganizationService.ListOrganizations:input_type -> organization.ListOrganizationsRequest
-	12,  // 175: organization.AppUserService.CreateAppUser:input_type -> organization.CreateAppUserRequest
-	14,  // 176: organization.AppUserService.GetAppUser:input_type -> organization.GetAppUserRequest
-	16,  // 177: organization.AppUserService.GetAppUserByEmail:input_type -> organization.GetAppUserByEmailRequest
-	18,  // 178: organization.AppUserService.UpdateAppUser:input_type -> organization.UpdateAppUserRequest
-	20,  // 179: organization.AppUserService.DeleteAppUser:input_type -> organization.DeleteAppUserRequest
-	22,  // 180: organization.AppUserService.ListAppUsers:input_type -> organization.ListAppUsersRequest
-	25,  // 181: organization.UserOrganizationService.CreateUserOrganization:input_type -> organization.CreateUserOrganizationRequest
-	27,  // 182: organization.UserOrganizationService.GetUserOrganization:input_type -> organization.GetUserOrganizationRequest
-	29,  // 183: organization.UserOrganizationService.UpdateUserOrganization:input_type -> organization.UpdateUserOrganizationRequest
-	31,  // 184: organization.UserOrganizationService.DeleteUserOrganization:input_type -> organization.DeleteUserOrganizationRequest
-	33,  // 185: organization.UserOrganizationService.ListUserOrganizations:input_type -> organization.ListUserOrganizationsRequest
-	35,  // 186: organization.UserOrganizationService.ListUserOrganizationsByUser:input_type -> organization.ListUserOrganizationsByUserRequest
-	37,  // 187: organization.UserOrganizationService.ListUserOrganizationsByOrg:input_type -> organization.ListUserOrganizationsByOrgRequest
-	40,  // 188: organization.FileService.CreateFile:input_type -> organization.CreateFileRequest
-	42,  // 189: organization.FileService.GetFile:input_type -> organization.GetFileRequest
-	44,  // 190: organization.FileService.UpdateFile:input_type -> organization.UpdateFileRequest
-	46,  // 191: organization.FileService.DeleteFile:input_type -> organization.DeleteFileRequest
-	48,  // 192: organization.FileService.ListFiles:input_type -> organization.ListFilesRequest
-	50,  // 193: organization.FileService.ListFilesByOrganization:input_type -> organization.ListFilesByOrganizationRequest
-	53,  // 194: organization.FlickrPhotoService.CreateFlickrPhoto:input_type -> organization.CreateFlickrPhotoRequest
-	55,  // 195: organization.FlickrPhotoService.GetFlickrPhoto:input_type -> organization.GetFlickrPhotoRequest
-	57,  // 196: organization.FlickrPhotoService.UpdateFlickrPhoto:input_type -> organization.UpdateFlickrPhotoRequest
-	59,  // 197: organization.FlickrPhotoService.DeleteFlickrPhoto:input_type -> organization.DeleteFlickrPhotoRequest
-	61,  // 198: organization.FlickrPhotoService.ListFlickrPhotos:input_type -> organization.ListFlickrPhotosRequest
-	63,  // 199: organization.FlickrPhotoService.ListFlickrPhotosByOrganization:input_type -> organization.ListFlickrPhotosByOrganizationRequest
-	66,  // 200: organization.CamFileService.CreateCamFile:input_type -> organization.CreateCamFileRequest
-	68,  // 201: organization.CamFileService.GetCamFile:input_type -> organization.GetCamFileRequest
-	70,  // 202: organization.CamFileService.UpdateCamFile:input_type -> organization.UpdateCamFileRequest
-	72,  // 203: organization.CamFileService.DeleteCamFile:input_type -> organization.DeleteCamFileRequest
-	74,  // 204: organization.CamFileService.ListCamFiles:input_type -> organization.ListCamFilesRequest
-	76,  // 205: organization.CamFileService.ListCamFilesByOrganization:input_type -> organization.ListCamFilesByOrganizationRequest
-	79,  // 206: organization.CamFileExeService.CreateCamFileExe:input_type -> organization.CreateCamFileExeRequest
-	81,  // 207: organization.CamFileExeService.GetCamFileExe:input_type -> organization.GetCamFileExeRequest
-	83,  // 208: organization.CamFileExeService.UpdateCamFileExe:input_type -> organization.UpdateCamFileExeRequest
-	85,  // 209: organization.CamFileExeService.DeleteCamFileExe:input_type -> organization.DeleteCamFileExeRequest
-	87,  // 210: organization.CamFileExeService.ListCamFileExes:input_type -> organization.ListCamFileExesRequest
-	89,  // 211: organization.CamFileExeService.ListCamFileExesByOrganization:input_type -> organization.ListCamFileExesByOrganizationRequest
-	92,  // 212: organization.CamFileExeStageService.CreateCamFileExeStage:input_type -> organization.CreateCamFileExeStageRequest
-	94,  // 213: organization.CamFileExeStageService.GetCamFileExeStage:input_type -> organization.GetCamFileExeStageRequest
-	96,  // 214: organization.CamFileExeStageService.UpdateCamFileExeStage:input_type -> organization.UpdateCamFileExeStageRequest
-	98,  // 215: organization.CamFileExeStageService.DeleteCamFileExeStage:input_type -> organization.DeleteCamFileExeStageRequest
-	100, // 216: organization.CamFileExeStageService.ListCamFileExeStages:input_type -> organization.ListCamFileExeStagesRequest
-	102, // 217: organization.CamFileExeStageService.ListCamFileExeStagesByOrganization:input_type -> organization.ListCamFileExeStagesByOrganizationRequest
-	105, // 218: organization.IchibanCarService.CreateIchibanCar:input_type -> organization.CreateIchibanCarRequest
-	107, // 219: organization.IchibanCarService.GetIchibanCar:input_type -> organization.GetIchibanCarRequest
-	109, // 220: organization.IchibanCarService.UpdateIchibanCar:input_type -> organization.UpdateIchibanCarRequest
-	111, // 221: organization.IchibanCarService.DeleteIchibanCar:input_type -> organization.DeleteIchibanCarRequest
-	113, // 222: organization.IchibanCarService.ListIchibanCars:input_type -> organization.ListIchibanCarsRequest
-	115, // 223: organization.IchibanCarService.ListIchibanCarsByOrganization:input_type -> organization.ListIchibanCarsByOrganizationRequest
-	118, // 224: organization.DtakoCarsIchibanCarsService.CreateDtakoCarsIchibanCars:input_type -> organization.CreateDtakoCarsIchibanCarsRequest
-	120, // 225: organization.DtakoCarsIchibanCarsService.GetDtakoCarsIchibanCars:input_type -> organization.GetDtakoCarsIchibanCarsRequest
-	122, // 226: organization.DtakoCarsIchibanCarsService.UpdateDtakoCarsIchibanCars:input_type -> organization.UpdateDtakoCarsIchibanCarsRequest
-	124, // 227: organization.DtakoCarsIchibanCarsService.DeleteDtakoCarsIchibanCars:input_type -> organization.DeleteDtakoCarsIchibanCarsRequest
-	126, // 228: organization.DtakoCarsIchibanCarsService.ListDtakoCarsIchibanCars:input_type -> organization.ListDtakoCarsIchibanCarsRequest
-	128, // 229: organization.DtakoCarsIchibanCarsService.ListDtakoCarsIchibanCarsByOrganization:input_type -> organization.ListDtakoCarsIchibanCarsByOrganizationRequest
-	131, // 230: organization.UriageService.CreateUriage:input_type -> organization.CreateUriageRequest
-	133, // 231: organization.UriageService.GetUriage:input_type -> organization.GetUriageRequest
-	135, // 232: organization.UriageService.UpdateUriage:input_type -> organization.UpdateUriageRequest
-	137, // 233: organization.UriageService.DeleteUriage:input_type -> organization.DeleteUriageRequest
-	139, // 234: organization.UriageService.ListUriages:input_type -> organization.ListUriagesRequest
-	141, // 235: organization.UriageService.ListUriagesByOrganization:input_type -> organization.ListUriagesByOrganizationRequest
-	144, // 236: organization.UriageJishaService.CreateUriageJisha:input_type -> organization.CreateUriageJishaRequest
-	146, // 237: organization.UriageJishaService.GetUriageJisha:input_type -> organization.GetUriageJishaRequest
-	148, // 238: organization.UriageJishaService.UpdateUriageJisha:input_type -> organization.UpdateUriageJishaRequest
-	150, // 239: organization.UriageJishaService.DeleteUriageJisha:input_type -> organization.DeleteUriageJishaRequest
-	152, // 240: organization.UriageJishaService.ListUriageJishas:input_type -> organization.ListUriageJishasRequest
-	154, // 241: organization.UriageJishaService.ListUriageJishasByOrganization:input_type -> organization.ListUriageJishasByOrganizationRequest
-	157, // 242: organization.CarInspectionService.CreateCarInspection:input_type -> organization.CreateCarInspectionRequest
-	159, // 243: organization.CarInspectionService.GetCarInspection:input_type -> organization.GetCarInspectionRequest
-	161, // 244: organization.CarInspectionService.UpdateCarInspection:input_type -> organization.UpdateCarInspectionRequest
-	163, // 245: organization.CarInspectionService.DeleteCarInspection:input_type -> organization.DeleteCarInspectionRequest
-	165, // 246: organization.CarInspectionService.ListCarInspections:input_type -> organization.ListCarInspectionsRequest
-	167, // 247: organization.CarInspectionService.ListCarInspectionsByOrganization:input_type -> organization.ListCarInspectionsByOrganizationRequest
-	170, // 248: organization.CarInspectionFilesService.CreateCarInspectionFile:input_type -> organization.CreateCarInspectionFileRequest
-	172, // 249: organization.CarInspectionFilesService.GetCarInspectionFile:input_type -> organization.GetCarInspectionFileRequest
-	174, // 250: organization.CarInspectionFilesService.UpdateCarInspectionFile:input_type -> organization.UpdateCarInspectionFileRequest
-	176, // 251: organization.CarInspectionFilesService.DeleteCarInspectionFile:input_type -> organization.DeleteCarInspectionFileRequest
-	178, // 252: organization.CarInspectionFilesService.ListCarInspectionFiles:input_type -> organization.ListCarInspectionFilesRequest
-	180, // 253: organization.CarInspectionFilesService.ListCarInspectionFilesByOrganization:input_type -> organization.ListCarInspectionFilesByOrganizationRequest
-	183, // 254: organization.CarInspectionFilesAService.CreateCarInspectionFilesA:input_type -> organization.CreateCarInspectionFilesARequest
-	185, // 255: organization.CarInspectionFilesAService.GetCarInspectionFilesA:input_type -> organization.GetCarInspectionFilesARequest
-	187, // 256: organization.CarInspectionFilesAService.UpdateCarInspectionFilesA:input_type -> organization.UpdateCarInspectionFilesARequest
-	189, // 257: organization.CarInspectionFilesAService.DeleteCarInspectionFilesA:input_type -> organization.DeleteCarInspectionFilesARequest
-	191, // 258: organization.CarInspectionFilesAService.ListCarInspectionFilesAs:input_type -> organization.ListCarInspectionFilesAsRequest
-	193, // 259: organization.CarInspectionFilesAService.ListCarInspectionFilesAsByOrganization:input_type -> organization.ListCarInspectionFilesAsByOrganizationRequest
-	196, // 260: organization.CarInspectionFilesBService.CreateCarInspectionFilesB:input_type -> organization.CreateCarInspectionFilesBRequest
-	198, // 261: organization.CarInspectionFilesBService.GetCarInspectionFilesB:input_type -> organization.GetCarInspectionFilesBRequest
-	200, // 262: organization.CarInspectionFilesBService.UpdateCarInspectionFilesB:input_type -> organization.UpdateCarInspectionFilesBRequest
-	202, // 263: organization.CarInspectionFilesBService.DeleteCarInspectionFilesB:input_type -> organization.DeleteCarInspectionFilesBRequest
-	204, // 264: organization.CarInspectionFilesBService.ListCarInspectionFilesBs:input_type -> organization.ListCarInspectionFilesBsRequest
-	206, // 265: organization.CarInspectionFilesBService.ListCarInspectionFilesBsByOrganization:input_type -> organization.ListCarInspectionFilesBsByOrganizationRequest
-	209, // 266: organization.CarInspectionDeregistrationService.CreateCarInspectionDeregistration:input_type -> organization.CreateCarInspectionDeregistrationRequest
-	211, // 267: organization.CarInspectionDeregistrationService.GetCarInspectionDeregistration:input_type -> organization.GetCarInspectionDeregistrationRequest
-	213, // 268: organization.CarInspectionDeregistrationService.UpdateCarInspectionDeregistration:input_type -> organization.UpdateCarInspectionDeregistrationRequest
-	215, // 269: organization.CarInspectionDeregistrationService.DeleteCarInspectionDeregistration:input_type -> organization.DeleteCarInspectionDeregistrationRequest
-	217, // 270: organization.CarInspectionDeregistrationService.ListCarInspectionDeregistrations:input_type -> organization.ListCarInspectionDeregistrationsRequest
-	219, // 271: organization.CarInspectionDeregistrationService.ListCarInspectionDeregistrationsByOrganization:input_type -> organization.ListCarInspectionDeregistrationsByOrganizationRequest
-	222, // 272: organization.CarInspectionDeregistrationFilesService.CreateCarInspectionDeregistrationFiles:input_type -> organization.CreateCarInspectionDeregistrationFilesRequest
-	224, // 273: organization.CarInspectionDeregistrationFilesService.GetCarInspectionDeregistrationFiles:input_type -> organization.GetCarInspectionDeregistrationFilesRequest
-	226, // 274: organization.CarInspectionDeregistrationFilesService.UpdateCarInspectionDeregistrationFiles:input_type -> organization.UpdateCarInspectionDeregistrationFilesRequest
-	228, // 275: organization.CarInspectionDeregistrationFilesService.DeleteCarInspectionDeregistrationFiles:input_type -> organization.DeleteCarInspectionDeregistrationFilesRequest
-	230, // 276: organization.CarInspectionDeregistrationFilesService.ListCarInspectionDeregistrationFiless:input_type -> organization.ListCarInspectionDeregistrationFilessRequest
-	232, // 277: organization.CarInspectionDeregistrationFilesService.ListCarInspectionDeregistrationFilessByOrganization:input_type -> organization.ListCarInspectionDeregistrationFilessByOrganizationRequest
-	235, // 278: organization.CarInsSheetIchibanCarsService.CreateCarInsSheetIchibanCars:input_type -> organization.CreateCarInsSheetIchibanCarsRequest
-	237, // 279: organization.CarInsSheetIchibanCarsService.GetCarInsSheetIchibanCars:input_type -> organization.GetCarInsSheetIchibanCarsRequest
-	239, // 280: organization.CarInsSheetIchibanCarsService.UpdateCarInsSheetIchibanCars:input_type -> organization.UpdateCarInsSheetIchibanCarsRequest
-	241, // 281: organization.CarInsSheetIchibanCarsService.DeleteCarInsSheetIchibanCars:input_type -> organization.DeleteCarInsSheetIchibanCarsRequest
-	243, // 282: organization.CarInsSheetIchibanCarsService.ListCarInsSheetIchibanCarss:input_type -> organization.ListCarInsSheetIchibanCarssRequest
-	245, // 283: organization.CarInsSheetIchibanCarsService.ListCarInsSheetIchibanCarssByOrganization:input_type -> organization.ListCarInsSheetIchibanCarssByOrganizationRequest
-	248, // 284: organization.CarInsSheetIchibanCarsAService.CreateCarInsSheetIchibanCarsA:input_type -> organization.CreateCarInsSheetIchibanCarsARequest
-	250, // 285: organization.CarInsSheetIchibanCarsAService.GetCarInsSheetIchibanCarsA:input_type -> organization.GetCarInsSheetIchibanCarsARequest
-	252, // 286: organization.CarInsSheetIchibanCarsAService.UpdateCarInsSheetIchibanCarsA:input_type -> organization.UpdateCarInsSheetIchibanCarsARequest
-	254, // 287: organization.CarInsSheetIchibanCarsAService.DeleteCarInsSheetIchibanCarsA:input_type -> organization.DeleteCarInsSheetIchibanCarsARequest
-	256, // 288: organization.CarInsSheetIchibanCarsAService.ListCarInsSheetIchibanCarsAs:input_type -> organization.ListCarInsSheetIchibanCarsAsRequest
-	258, // 289: organization.CarInsSheetIchibanCarsAService.ListCarInsSheetIchibanCarsAsByOrganization:input_type -> organization.ListCarInsSheetIchibanCarsAsByOrganizationRequest
-	261, // 290: organization.KudgfryService.CreateKudgfry:input_type -> organization.CreateKudgfryRequest
-	263, // 291: organization.KudgfryService.GetKudgfry:input_type -> organization.GetKudgfryRequest
-	265, // 292: organization.KudgfryService.UpdateKudgfry:input_type -> organization.UpdateKudgfryRequest
-	267, // 293: organization.KudgfryService.DeleteKudgfry:input_type -> organization.DeleteKudgfryRequest
-	269, // 294: organization.KudgfryService.ListKudgfrys:input_type -> organization.ListKudgfrysRequest
-	271, // 295: organization.KudgfryService.ListKudgfrysByOrganization:input_type -> organization.ListKudgfrysByOrganizationRequest
-	274, // 296: organization.KudguriService.CreateKudguri:input_type -> organization.CreateKudguriRequest
-	276, // 297: organization.KudguriService.GetKudguri:input_type -> organization.GetKudguriRequest
-	278, // 298: organization.KudguriService.UpdateKudguri:input_type -> organization.UpdateKudguriRequest
-	280, // 299: organization.KudguriService.DeleteKudguri:input_type -> organization.DeleteKudguriRequest
-	282, // 300: organization.KudguriService.ListKudguris:input_type -> organization.ListKudgurisRequest
-	284, // 301: organization.KudguriService.ListKudgurisByOrganization:input_type -> organization.ListKudgurisByOrganizationRequest
-	287, // 302: organization.KudgcstService.CreateKudgcst:input_type -> organization.CreateKudgcstRequest
-	289, // 303: organization.KudgcstService.GetKudgcst:input_type -> organization.GetKudgcstRequest
-	291, // 304: organization.KudgcstService.UpdateKudgcst:input_type -> organization.UpdateKudgcstRequest
-	293, // 305: organization.KudgcstService.DeleteKudgcst:input_type -> organization.DeleteKudgcstRequest
-	295, // 306: organization.KudgcstService.ListKudgcsts:input_type -> organization.ListKudgcstsRequest
-	297, // 307: organization.KudgcstService.ListKudgcstsByOrganization:input_type -> organization.ListKudgcstsByOrganizationRequest
-	300, // 308: organization.KudgfulService.CreateKudgful:input_type -> organization.CreateKudgfulRequest
-	302, // 309: organization.KudgfulService.GetKudgful:input_type -> organization.GetKudgfulRequest
-	304, // 310: organization.KudgfulService.UpdateKudgful:input_type -> organization.UpdateKudgfulRequest
-	306, // 311: organization.KudgfulService.DeleteKudgful:input_type -> organization.DeleteKudgfulRequest
-	308, // 312: organization.KudgfulService.ListKudgfuls:input_type -> organization.ListKudgfulsRequest
-	310, // 313: organization.KudgfulService.ListKudgfulsByOrganization:input_type -> organization.ListKudgfulsByOrganizationRequest
-	313, // 314: organization.KudgsirService.CreateKudgsir:input_type -> organization.CreateKudgsirRequest
-	315, // 315: organization.KudgsirService.GetKudgsir:input_type -> organization.GetKudgsirRequest
-	317, // 316: organization.KudgsirService.UpdateKudgsir:input_type -> organization.UpdateKudgsirRequest
-	319, // 317: organization.KudgsirService.DeleteKudgsir:input_type -> organization.DeleteKudgsirRequest
-	321, // 318: organization.KudgsirService.ListKudgsirs:input_type -> organization.ListKudgsirsRequest
-	323, // 319: organization.KudgsirService.ListKudgsirsByOrganization:input_type -> organization.ListKudgsirsByOrganizationRequest
-	326, // 320: organization.KudgivtService.CreateKudgivt:input_type -> organization.CreateKudgivtRequest
-	328, // 321: organization.KudgivtService.GetKudgivt:input_type -> organization.GetKudgivtRequest
-	330, // 322: organization.KudgivtService.UpdateKudgivt:input_type -> organization.UpdateKudgivtRequest
-	332, // 323: organization.KudgivtService.DeleteKudgivt:input_type -> organization.DeleteKudgivtRequest
-	334, // 324: organization.KudgivtService.ListKudgivts:input_type -> organization.ListKudgivtsRequest
-	336, // 325: organization.KudgivtService.ListKudgivtsByOrganization:input_type -> organization.ListKudgivtsByOrganizationRequest
-	339, // 326: organization.DtakologsService.CreateDtakologs:input_type -> organization.CreateDtakologsRequest
-	341, // 327: organization.DtakologsService.GetDtakologs:input_type -> organization.GetDtakologsRequest
-	343, // 328: organization.DtakologsService.UpdateDtakologs:input_type -> organization.UpdateDtakologsRequest
-	345, // 329: organization.DtakologsService.DeleteDtakologs:input_type -> organization.DeleteDtakologsRequest
-	347, // 330: organization.DtakologsService.ListDtakologs:input_type -> organization.ListDtakologsRequest
-	349, // 331: organization.DtakologsService.ListDtakologsByOrganization:input_type -> organization.ListDtakologsByOrganizationRequest
-	352, // 332: organization.AuthService.AuthWithGoogle:input_type -> organization.AuthWithGoogleRequest
-	353, // 333: organization.AuthService.AuthWithLine:input_type -> organization.AuthWithLineRequest
-	354, // 334: organization.AuthService.RefreshToken:input_type -> organization.RefreshTokenRequest
-	355, // 335: organization.AuthService.GetAuthURL:input_type -> organization.GetAuthURLRequest
-	357, // 336: organization.AuthService.ValidateToken:input_type -> organization.ValidateTokenRequest
-	360, // 337: organization.InvitationService.CreateInvitation:input_type -> organization.CreateInvitationRequest
-	362, // 338: organization.InvitationService.GetInvitation:input_type -> organization.GetInvitationRequest
-	364, // 339: organization.InvitationService.GetInvitationByToken:input_type -> organization.GetInvitationByTokenRequest
-	366, // 340: organization.InvitationService.AcceptInvitation:input_type -> organization.AcceptInvitationRequest
-	368, // 341: organization.InvitationService.CancelInvitation:input_type -> organization.CancelInvitationRequest
-	370, // 342: organization.InvitationService.ListInvitations:input_type -> organization.ListInvitationsRequest
-	372, // 343: organization.InvitationService.ResendInvitation:input_type -> organization.ResendInvitationRequest
-	375, // 344: organization.ETCMeisaiService.CreateETCMeisai:input_type -> organization.CreateETCMeisaiRequest
-	377, // 345: organization.ETCMeisaiService.GetETCMeisai:input_type -> organization.GetETCMeisaiRequest
-	379, // 346: organization.ETCMeisaiService.GetETCMeisaiByHash:input_type -> organization.GetETCMeisaiByHashRequest
-	381, // 347: organization.ETCMeisaiService.UpdateETCMeisai:input_type -> organization.UpdateETCMeisaiRequest
-	383, // 348: organization.ETCMeisaiService.DeleteETCMeisai:input_type -> organization.DeleteETCMeisaiRequest
-	385, // 349: organization.ETCMeisaiService.ListETCMeisai:input_type -> organization.ListETCMeisaiRequest
-	387, // 350: organization.ETCMeisaiService.BulkCreateETCMeisai:input_type -> organization.BulkCreateETCMeisaiRequest
-	2,   // 351: organization.OrganizationService.CreateOrganization:output_type -> organization.CreateOrganizationResponse
-	4,   // 352: organization.OrganizationService.GetOrganization:output_type -> organization.GetOrganizationResponse
-	6,   // 353: organization.OrganizationService.UpdateOrganization:output_type -> organization.UpdateOrganizationResponse
-	8,   // 354: organization.OrganizationService.DeleteOrganization:output_type -> organization.DeleteOrganizationResponse
-	10,  // 355: organization.OrganizationService.ListOrganizations:output_type -> organization.ListOrganizationsResponse
-	13,  // 356: organization.AppUserService.CreateAppUser:output_type -> organization.CreateAppUserResponse
-	15,  // 357: organization.AppUserService.GetAppUser:output_type -> organization.GetAppUserResponse
-	17,  // 358: organization.AppUserService.GetAppUserByEmail:output_type -> organization.GetAppUserByEmailResponse
-	19,  // 359: organization.AppUserService.UpdateAppUser:output_type -> organization.UpdateAppUserResponse
-	21,  // 360: organization.AppUserService.DeleteAppUser:output_type -> organization.DeleteAppUserResponse
-	23,  // 361: organization.AppUserService.ListAppUsers:output_type -> organization.ListAppUsersResponse
-	26,  // 362: organization.UserOrganizationService.CreateUserOrganization:output_type -> organization.CreateUserOrganizationResponse
-	28,  // 363: organization.UserOrganizationService.GetUserOrganization:output_type -> organization.GetUserOrganizationResponse
-	30,  // 364: organization.UserOrganizationService.UpdateUserOrganization:output_type -> organization.UpdateUserOrganizationResponse
-	32,  // 365: organization.UserOrganizationService.DeleteUserOrganization:output_type -> organization.DeleteUserOrganizationResponse
-	34,  // 366: organization.UserOrganizationService.ListUserOrganizations:output_type -> organization.ListUserOrganizationsResponse
-	36,  // 367: organization.UserOrganizationService.ListUserOrganizationsByUser:output_type -> organization.ListUserOrganizationsByUserResponse
-	38,  // 368: organization.UserOrganizationService.ListUserOrganizationsByOrg:output_type -> organization.ListUserOrganizationsByOrgResponse
-	41,  // 369: organization.FileService.CreateFile:output_type -> organization.CreateFileResponse
-	43,  // 370: organization.FileService.GetFile:output_type -> organization.GetFileResponse
-	45,  // 371: organization.FileService.UpdateFile:output_type -> organization.UpdateFileResponse
-	47,  // 372: organization.FileService.DeleteFile:output_type -> organization.DeleteFileResponse
-	49,  // 373: organization.FileService.ListFiles:output_type -> organization.ListFilesResponse
-	51,  // 374: organization.FileService.ListFilesByOrganization:output_type -> organization.ListFilesByOrganizationResponse
-	54,  // 375: organization.FlickrPhotoService.CreateFlickrPhoto:output_type -> organization.CreateFlickrPhotoResponse
-	56,  // 376: organization.FlickrPhotoService.GetFlickrPhoto:output_type -> organization.GetFlickrPhotoResponse
-	58,  // 377: organization.FlickrPhotoService.UpdateFlickrPhoto:output_type -> organization.UpdateFlickrPhotoResponse
-	60,  // 378: organization.FlickrPhotoService.DeleteFlickrPhoto:output_type -> organization.DeleteFlickrPhotoResponse
-	62,  // 379: organization.FlickrPhotoService.ListFlickrPhotos:output_type -> organization.ListFlickrPhotosResponse
-	64,  // 380: organization.FlickrPhotoService.ListFlickrPhotosByOrganization:output_type -> organization.ListFlickrPhotosByOrganizationResponse
-	67,  // 381: organization.CamFileService.CreateCamFile:output_type -> organization.CreateCamFileResponse
-	69,  // 382: organization.CamFileService.GetCamFile:output_type -> organization.GetCamFileResponse
-	71,  // 383: organization.CamFileService.UpdateCamFile:output_type -> organization.UpdateCamFileResponse
-	73,  // 384: organization.CamFileService.DeleteCamFile:output_type -> organization.DeleteCamFileResponse
-	75,  // 385: organization.CamFileService.ListCamFiles:output_type -> organization.ListCamFilesResponse
-	77,  // 386: organization.CamFileService.ListCamFilesByOrganization:output_type -> organization.ListCamFilesByOrganizationResponse
-	80,  // 387: organization.CamFileExeService.CreateCamFileExe:output_type -> organization.CreateCamFileExeResponse
-	82,  // 388: organization.CamFileExeService.GetCamFileExe:output_type -> organization.GetCamFileExeResponse
-	84,  // 389: organization.CamFileExeService.UpdateCamFileExe:output_type -> organization.UpdateCamFileExeResponse
-	86,  // 390: organization.CamFileExeService.DeleteCamFileExe:output_type -> organization.DeleteCamFileExeResponse
-	88,  // 391: organization.CamFileExeService.ListCamFileExes:output_type -> organization.ListCamFileExesResponse
-	90,  // 392: organization.CamFileExeService.ListCamFileExesByOrganization:output_type -> organization.ListCamFileExesByOrganizationResponse
-	93,  // 393: organization.CamFileExeStageService.CreateCamFileExeStage:output_type -> organization.CreateCamFileExeStageResponse
-	95,  // 394: organization.CamFileExeStageService.GetCamFileExeStage:output_type -> organization.GetCamFileExeStageResponse
-	97,  // 395: organization.CamFileExeStageService.UpdateCamFileExeStage:output_type -> organization.UpdateCamFileExeStageResponse
-	99,  // 396: organization.CamFileExeStageService.DeleteCamFileExeStage:output_type -> organization.DeleteCamFileExeStageResponse
-	101, // 397: organization.CamFileExeStageService.ListCamFileExeStages:output_type -> organization.ListCamFileExeStagesResponse
-	103, // 398: organization.CamFileExeStageService.ListCamFileExeStagesByOrganization:output_type -> organization.ListCamFileExeStagesByOrganizationResponse
-	106, // 399: organization.IchibanCarService.CreateIchibanCar:output_type -> organization.CreateIchibanCarResponse
-	108, // 400: organization.IchibanCarService.GetIchibanCar:output_type -> organization.GetIchibanCarResponse
-	110, // 401: organization.IchibanCarService.UpdateIchibanCar:output_type -> organization.UpdateIchibanCarResponse
-	112, // 402: organization.IchibanCarService.DeleteIchibanCar:output_type -> organization.DeleteIchibanCarResponse
-	114, // 403: organization.IchibanCarService.ListIchibanCars:output_type -> organization.ListIchibanCarsResponse
-	116, // 404: organization.IchibanCarService.ListIchibanCarsByOrganization:output_type -> organization.ListIchibanCarsByOrganizationResponse
-	119, // 405: organization.DtakoCarsIchibanCarsService.CreateDtakoCarsIchibanCars:output_type -> organization.CreateDtakoCarsIchibanCarsResponse
-	121, // 406: organization.DtakoCarsIchibanCarsService.GetDtakoCarsIchibanCars:output_type -> organization.GetDtakoCarsIchibanCarsResponse
-	123, // 407: organization.DtakoCarsIchibanCarsService.UpdateDtakoCarsIchibanCars:output_type -> organization.UpdateDtakoCarsIchibanCarsResponse
-	125, // 408: organization.DtakoCarsIchibanCarsService.DeleteDtakoCarsIchibanCars:output_type -> organization.DeleteDtakoCarsIchibanCarsResponse
-	127, // 409: organization.DtakoCarsIchibanCarsService.ListDtakoCarsIchibanCars:output_type -> organization.ListDtakoCarsIchibanCarsResponse
-	129, // 410: organization.DtakoCarsIchibanCarsService.ListDtakoCarsIchibanCarsByOrganization:output_type -> organization.ListDtakoCarsIchibanCarsByOrganizationResponse
-	132, // 411: organization.UriageService.CreateUriage:output_type -> organization.CreateUriageResponse
-	134, // 412: organization.UriageService.GetUriage:output_type -> organization.GetUriageResponse
-	136, // 413: organization.UriageService.UpdateUriage:output_type -> organization.UpdateUriageResponse
-	138, // 414: organization.UriageService.DeleteUriage:output_type -> organization.DeleteUriageResponse
-	140, // 415: organization.UriageService.ListUriages:output_type -> organization.ListUriagesResponse
-	142, // 416: organization.UriageService.ListUriagesByOrganization:output_type -> organization.ListUriagesByOrganizationResponse
-	145, // 417: organization.UriageJishaService.CreateUriageJisha:output_type -> organization.CreateUriageJishaResponse
-	147, // 418: organization.UriageJishaService.GetUriageJisha:output_type -> organization.GetUriageJishaResponse
-	149, // 419: organization.UriageJishaService.UpdateUriageJisha:output_type -> organization.UpdateUriageJishaResponse
-	151, // 420: organization.UriageJishaService.DeleteUriageJisha:output_type -> organization.DeleteUriageJishaResponse
-	153, // 421: organization.UriageJishaService.ListUriageJishas:output_type -> organization.ListUriageJishasResponse
-	155, // 422: organization.UriageJishaService.ListUriageJishasByOrganization:output_type -> organization.ListUriageJishasByOrganizationResponse
-	158, // 423: organization.CarInspectionService.CreateCarInspection:output_type -> organization.CreateCarInspectionResponse
-	160, // 424: organization.CarInspectionService.GetCarInspection:output_type -> organization.GetCarInspectionResponse
-	162, // 425: organization.CarInspectionService.UpdateCarInspection:output_type -> organization.UpdateCarInspectionResponse
-	164, // 426: organization.CarInspectionService.DeleteCarInspection:output_type -> organization.DeleteCarInspectionResponse
-	166, // 427: organization.CarInspectionService.ListCarInspections:output_type -> organization.ListCarInspectionsResponse
-	168, // 428: organization.CarInspectionService.ListCarInspectionsByOrganization:output_type -> organization.ListCarInspectionsByOrganizationResponse
-	171, // 429: organization.CarInspectionFilesService.CreateCarInspectionFile:output_type -> organization.CreateCarInspectionFileResponse
-	173, // 430: organization.CarInspectionFilesService.GetCarInspectionFile:output_type -> organization.GetCarInspectionFileResponse
-	175, // 431: organization.CarInspectionFilesService.UpdateCarInspectionFile:output_type -> organization.UpdateCarInspectionFileResponse
-	177, // 432: organization.CarInspectionFilesService.DeleteCarInspectionFile:output_type -> organization.DeleteCarInspectionFileResponse
-	179, // 433: organization.CarInspectionFilesService.ListCarInspectionFiles:output_type -> organization.ListCarInspectionFilesResponse
-	181, // 434: organization.CarInspectionFilesService.ListCarInspectionFilesByOrganization:output_type -> organization.ListCarInspectionFilesByOrganizationResponse
-	184, // 435: organization.CarInspectionFilesAService.CreateCarInspectionFilesA:output_type -> organization.CreateCarInspectionFilesAResponse
-	186, // 436: organization.CarInspectionFilesAService.GetCarInspectionFilesA:output_type -> organization.GetCarInspectionFilesAResponse
-	188, // 437: organization.CarInspectionFilesAService.UpdateCarInspectionFilesA:output_type -> organization.UpdateCarInspectionFilesAResponse
-	190, // 438: organization.CarInspectionFilesAService.DeleteCarInspectionFilesA:output_type -> organization.DeleteCarInspectionFilesAResponse
-	192, // 439: organization.CarInspectionFilesAService.ListCarInspectionFilesAs:output_type -> organization.ListCarInspectionFilesAsResponse
-	194, // 440: organization.CarInspectionFilesAService.ListCarInspectionFilesAsByOrganization:output_type -> organization.ListCarInspectionFilesAsByOrganizationResponse
-	197, // 441: organization.CarInspectionFilesBService.CreateCarInspectionFilesB:output_type -> organization.CreateCarInspectionFilesBResponse
-	199, // 442: organization.CarInspectionFilesBService.GetCarInspectionFilesB:output_type -> organization.GetCarInspectionFilesBResponse
-	201, // 443: organization.CarInspectionFilesBService.UpdateCarInspectionFilesB:output_type -> organization.UpdateCarInspectionFilesBResponse
-	203, // 444: organization.CarInspectionFilesBService.DeleteCarInspectionFilesB:output_type -> organization.DeleteCarInspectionFilesBResponse
-	205, // 445: organization.CarInspectionFilesBService.ListCarInspectionFilesBs:output_type -> organization.ListCarInspectionFilesBsResponse
-	207, // 446: organization.CarInspectionFilesBService.ListCarInspectionFilesBsByOrganization:output_type -> organization.ListCarInspectionFilesBsByOrganizationResponse
-	210, // 447: organization.CarInspectionDeregistrationService.CreateCarInspectionDeregistration:output_type -> organization.CreateCarInspectionDeregistrationResponse
-	212, // 448: organization.CarInspectionDeregistrationService.GetCarInspectionDeregistration:output_type -> organization.GetCarInspectionDeregistrationResponse
-	214, // 449: organization.CarInspectionDeregistrationService.UpdateCarInspectionDeregistration:output_type -> organization.UpdateCarInspectionDeregistrationResponse
-	216, // 450: organization.CarInspectionDeregistrationService.DeleteCarInspectionDeregistration:output_type -> organization.DeleteCarInspectionDeregistrationResponse
-	218, // 451: organization.CarInspectionDeregistrationService.ListCarInspectionDeregistrations:output_type -> organization.ListCarInspectionDeregistrationsResponse
-	220, // 452: organization.CarInspectionDeregistrationService.ListCarInspectionDeregistrationsByOrganization:output_type -> organization.ListCarInspectionDeregistrationsByOrganizationResponse
-	223, // 453: organization.CarInspectionDeregistrationFilesService.CreateCarInspectionDeregistrationFiles:output_type -> organization.CreateCarInspectionDeregistrationFilesResponse
-	225, // 454: organization.CarInspectionDeregistrationFilesService.GetCarInspectionDeregistrationFiles:output_type -> organization.GetCarInspectionDeregistrationFilesResponse
-	227, // 455: organization.CarInspectionDeregistrationFilesService.UpdateCarInspectionDeregistrationFiles:output_type -> organization.UpdateCarInspectionDeregistrationFilesResponse
-	229, // 456: organization.CarInspectionDeregistrationFilesService.DeleteCarInspectionDeregistrationFiles:output_type -> organization.DeleteCarInspectionDeregistrationFilesResponse
-	231, // 457: organization.CarInspectionDeregistrationFilesService.ListCarInspectionDeregistrationFiless:output_type -> organization.ListCarInspectionDeregistrationFilessResponse
-	233, // 458: organization.CarInspectionDeregistrationFilesService.ListCarInspectionDeregistrationFilessByOrganization:output_type -> organization.ListCarInspectionDeregistrationFilessByOrganizationResponse
-	236, // 459: organization.CarInsSheetIchibanCarsService.CreateCarInsSheetIchibanCars:output_type -> organization.CreateCarInsSheetIchibanCarsResponse
-	238, // 460: organization.CarInsSheetIchibanCarsService.GetCarInsSheetIchibanCars:output_type -> organization.GetCarInsSheetIchibanCarsResponse
-	240, // 461: organization.CarInsSheetIchibanCarsService.UpdateCarInsSheetIchibanCars:output_type -> organization.UpdateCarInsSheetIchibanCarsResponse
-	242, // 462: organization.CarInsSheetIchibanCarsService.DeleteCarInsSheetIchibanCars:output_type -> organization.DeleteCarInsSheetIchibanCarsResponse
-	244, // 463: organization.CarInsSheetIchibanCarsService.ListCarInsSheetIchibanCarss:output_type -> organization.ListCarInsSheetIchibanCarssResponse
-	246, // 464: organization.CarInsSheetIchibanCarsService.ListCarInsSheetIchibanCarssByOrganization:output_type -> organization.ListCarInsSheetIchibanCarssByOrganizationResponse
-	249, // 465: organization.CarInsSheetIchibanCarsAService.CreateCarInsSheetIchibanCarsA:output_type -> organization.CreateCarInsSheetIchibanCarsAResponse
-	251, // 466: organization.CarInsSheetIchibanCarsAService.GetCarInsSheetIchibanCarsA:output_type -> organization.GetCarInsSheetIchibanCarsAResponse
-	253, // 467: organization.CarInsSheetIchibanCarsAService.UpdateCarInsSheetIchibanCarsA:output_type -> organization.UpdateCarInsSheetIchibanCarsAResponse
-	255, // 468: organization.CarInsSheetIchibanCarsAService.DeleteCarInsSheetIchibanCarsA:output_type -> organization.DeleteCarInsSheetIchibanCarsAResponse
-	257, // 469: organization.CarInsSheetIchibanCarsAService.ListCarInsSheetIchibanCarsAs:output_type -> organization.ListCarInsSheetIchibanCarsAsResponse
-	259, // 470: organization.CarInsSheetIchibanCarsAService.ListCarInsSheetIchibanCarsAsByOrganization:output_type -> organization.ListCarInsSheetIchibanCarsAsByOrganizationResponse
-	262, // 471: organization.KudgfryService.CreateKudgfry:output_type -> organization.CreateKudgfryResponse
-	264, // 472: organization.KudgfryService.GetKudgfry:output_type -> organization.GetKudgfryResponse
-	266, // 473: organization.KudgfryService.UpdateKudgfry:output_type -> organization.UpdateKudgfryResponse
-	268, // 474: organization.KudgfryService.DeleteKudgfry:output_type -> organization.DeleteKudgfryResponse
-	270, // 475: organization.KudgfryService.ListKudgfrys:output_type -> organization.ListKudgfrysResponse
-	272, // 476: organization.KudgfryService.ListKudgfrysByOrganization:output_type -> organization.ListKudgfrysByOrganizationResponse
-	275, // 477: organization.KudguriService.CreateKudguri:output_type -> organization.CreateKudguriResponse
-	277, // 478: organization.KudguriService.GetKudguri:output_type -> organization.GetKudguriResponse
-	279, // 479: organization.KudguriService.UpdateKudguri:output_type -> organization.UpdateKudguriResponse
-	281, // 480: organization.KudguriService.DeleteKudguri:output_type -> organization.DeleteKudguriResponse
-	283, // 481: organization.KudguriService.ListKudguris:output_type -> organization.ListKudgurisResponse
-	285, // 482: organization.KudguriService.ListKudgurisByOrganization:output_type -> organization.ListKudgurisByOrganizationResponse
-	288, // 483: organization.KudgcstService.CreateKudgcst:output_type -> organization.CreateKudgcstResponse
-	290, // 484: organization.KudgcstService.GetKudgcst:output_type -> organization.GetKudgcstResponse
-	292, // 485: organization.KudgcstService.UpdateKudgcst:output_type -> organization.UpdateKudgcstResponse
-	294, // 486: organization.KudgcstService.DeleteKudgcst:output_type -> organization.DeleteKudgcstResponse
-	296, // 487: organization.KudgcstService.ListKudgcsts:output_type -> organization.ListKudgcstsResponse
-	298, // 488: organization.KudgcstService.ListKudgcstsByOrganization:output_type -> organization.ListKudgcstsByOrganizationResponse
-	301, // 489: organization.KudgfulService.CreateKudgful:output_type -> organization.CreateKudgfulResponse
-	303, // 490: organization.KudgfulService.GetKudgful:output_type -> organization.GetKudgfulResponse
-	305, // 491: organization.KudgfulService.UpdateKudgful:output_type -> organization.UpdateKudgfulResponse
-	307, // 492: organization.KudgfulService.DeleteKudgful:output_type -> organization.DeleteKudgfulResponse
-	309, // 493: organization.KudgfulService.ListKudgfuls:output_type -> organization.ListKudgfulsResponse
-	311, // 494: organization.KudgfulService.ListKudgfulsByOrganization:output_type -> organization.ListKudgfulsByOrganizationResponse
-	314, // 495: organization.KudgsirService.CreateKudgsir:output_type -> organization.CreateKudgsirResponse
-	316, // 496: organization.KudgsirService.GetKudgsir:output_type -> organization.GetKudgsirResponse
-	318, // 497: organization.KudgsirService.UpdateKudgsir:output_type -> organization.UpdateKudgsirResponse
-	320, // 498: organization.KudgsirService.DeleteKudgsir:output_type -> organization.DeleteKudgsirResponse
-	322, // 499: organization.KudgsirService.ListKudgsirs:output_type -> organization.ListKudgsirsResponse
-	324, // 500: organization.KudgsirService.ListKudgsirsByOrganization:output_type -> organization.ListKudgsirsByOrganizationResponse
-	327, // 501: organization.KudgivtService.CreateKudgivt:output_type -> organization.CreateKudgivtResponse
-	329, // 502: organization.KudgivtService.GetKudgivt:output_type -> organization.GetKudgivtResponse
-	331, // 503: organization.KudgivtService.UpdateKudgivt:output_type -> organization.UpdateKudgivtResponse
-	333, // 504: organization.KudgivtService.DeleteKudgivt:output_type -> organization.DeleteKudgivtResponse
-	335, // 505: organization.KudgivtService.ListKudgivts:output_type -> organization.ListKudgivtsResponse
-	337, // 506: organization.KudgivtService.ListKudgivtsByOrganization:output_type -> organization.ListKudgivtsByOrganizationResponse
-	340, // 507: organization.DtakologsService.CreateDtakologs:output_type -> organization.CreateDtakologsResponse
-	342, // 508: organization.DtakologsService.GetDtakologs:output_type -> organization.GetDtakologsResponse
-	344, // 509: organization.DtakologsService.UpdateDtakologs:output_type -> organization.UpdateDtakologsResponse
-	346, // 510: organization.DtakologsService.DeleteDtakologs:output_type -> organization.DeleteDtakologsResponse
-	348, // 511: organization.DtakologsService.ListDtakologs:output_type -> organization.ListDtakologsResponse
-	350, // 512: organization.DtakologsService.ListDtakologsByOrganization:output_type -> organization.ListDtakologsByOrganizationResponse
-	351, // 513: organization.AuthService.AuthWithGoogle:output_type -> organization.AuthResponse
-	351, // 514: organization.AuthService.AuthWithLine:output_type -> organization.AuthResponse
-	351, // 515: organization.AuthService.RefreshToken:output_type -> organization.AuthResponse
-	356, // 516: organization.AuthService.GetAuthURL:output_type -> organization.GetAuthURLResponse
-	358, // 517: organization.AuthService.ValidateToken:output_type -> organization.ValidateTokenResponse
-	361, // 518: organization.InvitationService.CreateInvitation:output_type -> organization.CreateInvitationResponse
-	363, // 519: organization.InvitationService.GetInvitation:output_type -> organization.GetInvitationResponse
-	365, // 520: organization.InvitationService.GetInvitationByToken:output_type -> organization.GetInvitationByTokenResponse
-	367, // 521: organization.InvitationService.AcceptInvitation:output_type -> organization.AcceptInvitationResponse
-	369, // 522: organization.InvitationService.CancelInvitation:output_type -> organization.CancelInvitationResponse
-	371, // 523: organization.InvitationService.ListInvitations:output_type -> organization.ListInvitationsResponse
-	373, // 524: organization.InvitationService.ResendInvitation:output_type -> organization.ResendInvitationResponse
-	376, // 525: organization.ETCMeisaiService.CreateETCMeisai:output_type -> organization.CreateETCMeisaiResponse
-	378, // 526: organization.ETCMeisaiService.GetETCMeisai:output_type -> organization.GetETCMeisaiResponse
-	380, // 527: organization.ETCMeisaiService.GetETCMeisaiByHash:output_type -> organization.GetETCMeisaiByHashResponse
-	382, // 528: organization.ETCMeisaiService.UpdateETCMeisai:output_type -> organization.UpdateETCMeisaiResponse
-	384, // 529: organization.ETCMeisaiService.DeleteETCMeisai:output_type -> organization.DeleteETCMeisaiResponse
-	386, // 530: organization.ETCMeisaiService.ListETCMeisai:output_type -> organization.ListETCMeisaiResponse
-	388, // 531: organization.ETCMeisaiService.BulkCreateETCMeisai:output_type -> organization.BulkCreateETCMeisaiResponse
-	351, // [351:532] is the sub-list for method output_type
-	170, // [170:351] is the sub-list for method input_type
-	170, // [170:170] is the sub-list for extension type_name
-	170, // [170:170] is the sub-list for extension extendee
-	0,   // [0:170] is the sub-list for field type_name
+	158, // 68: organization.CreateCarInspectionRequest.files:type_name -> organization.CarInspectionFileInput
+	156, // 69: organization.CreateCarInspectionResponse.car_inspection:type_name -> organization.CarInspection
+	170, // 70: organization.CreateCarInspectionResponse.car_inspection_files:type_name -> organization.CarInspectionFile
+	156, // 71: organization.GetCarInspectionResponse.car_inspection:type_name -> organization.CarInspection
+	419, // 72: organization.UpdateCarInspectionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	156, // 73: organization.UpdateCarInspectionResponse.car_inspection:type_name -> organization.CarInspection
+	156, // 74: organization.ListCarInspectionsResponse.car_inspections:type_name -> organization.CarInspection
+	156, // 75: organization.ListCarInspectionsByOrganizationResponse.car_inspections:type_name -> organization.CarInspection
+	170, // 76: organization.CreateCarInspectionFileResponse.car_inspection_file:type_name -> organization.CarInspectionFile
+	170, // 77: organization.GetCarInspectionFileResponse.car_inspection_file:type_name -> organization.CarInspectionFile
+	170, // 78: organization.UpdateCarInspectionFileResponse.car_inspection_file:type_name -> organization.CarInspectionFile
+	170, // 79: organization.ListCarInspectionFilesResponse.car_inspection_files:type_name -> organization.CarInspectionFile
+	170, // 80: organization.ListCarInspectionFilesByOrganizationResponse.car_inspection_files:type_name -> organization.CarInspectionFile
+	183, // 81: organization.CreateCarInspectionFilesAResponse.car_inspection_files_a:type_name -> organization.CarInspectionFilesA
+	183, // 82: organization.GetCarInspectionFilesAResponse.car_inspection_files_a:type_name -> organization.CarInspectionFilesA
+	183, // 83: organization.UpdateCarInspectionFilesAResponse.car_inspection_files_a:type_name -> organization.CarInspectionFilesA
+	183, // 84: organization.ListCarInspectionFilesAsResponse.car_inspection_files_as:type_name -> organization.CarInspectionFilesA
+	183, // 85: organization.ListCarInspectionFilesAsByOrganizationResponse.car_inspection_files_as:type_name -> organization.CarInspectionFilesA
+	196, // 86: organization.CreateCarInspectionFilesBResponse.car_inspection_files_b:type_name -> organization.CarInspectionFilesB
+	196, // 87: organization.GetCarInspectionFilesBResponse.car_inspection_files_b:type_name -> organization.CarInspectionFilesB
+	196, // 88: organization.UpdateCarInspectionFilesBResponse.car_inspection_files_b:type_name -> organization.CarInspectionFilesB
+	196, // 89: organization.ListCarInspectionFilesBsResponse.car_inspection_files_bs:type_name -> organization.CarInspectionFilesB
+	196, // 90: organization.ListCarInspectionFilesBsByOrganizationResponse.car_inspection_files_bs:type_name -> organization.CarInspectionFilesB
+	209, // 91: organization.CreateCarInspectionDeregistrationResponse.car_inspection_deregistration:type_name -> organization.CarInspectionDeregistration
+	209, // 92: organization.GetCarInspectionDeregistrationResponse.car_inspection_deregistration:type_name -> organization.CarInspectionDeregistration
+	209, // 93: organization.UpdateCarInspectionDeregistrationResponse.car_inspection_deregistration:type_name -> organization.CarInspectionDeregistration
+	209, // 94: organization.ListCarInspectionDeregistrationsResponse.car_inspection_deregistrations:type_name -> organization.CarInspectionDeregistration
+	209, // 95: organization.ListCarInspectionDeregistrationsByOrganizationResponse.car_inspection_deregistrations:type_name -> organization.CarInspectionDeregistration
+	222, // 96: organization.CreateCarInspectionDeregistrationFilesResponse.car_inspection_deregistration_files:type_name -> organization.CarInspectionDeregistrationFiles
+	222, // 97: organization.GetCarInspectionDeregistrationFilesResponse.car_inspection_deregistration_files:type_name -> organization.CarInspectionDeregistrationFiles
+	222, // 98: organization.UpdateCarInspectionDeregistrationFilesResponse.car_inspection_deregistration_files:type_name -> organization.CarInspectionDeregistrationFiles
+	222, // 99: organization.ListCarInspectionDeregistrationFilessResponse.car_inspection_deregistration_filess:type_name -> organization.CarInspectionDeregistrationFiles
+	222, // 100: organization.ListCarInspectionDeregistrationFilessByOrganizationResponse.car_inspection_deregistration_filess:type_name -> organization.CarInspectionDeregistrationFiles
+	235, // 101: organization.CreateCarInsSheetIchibanCarsResponse.car_ins_sheet_ichiban_cars:type_name -> organization.CarInsSheetIchibanCars
+	235, // 102: organization.GetCarInsSheetIchibanCarsResponse.car_ins_sheet_ichiban_cars:type_name -> organization.CarInsSheetIchibanCars
+	235, // 103: organization.UpdateCarInsSheetIchibanCarsResponse.car_ins_sheet_ichiban_cars:type_name -> organization.CarInsSheetIchibanCars
+	235, // 104: organization.ListCarInsSheetIchibanCarssResponse.car_ins_sheet_ichiban_carss:type_name -> organization.CarInsSheetIchibanCars
+	235, // 105: organization.ListCarInsSheetIchibanCarssByOrganizationResponse.car_ins_sheet_ichiban_carss:type_name -> organization.CarInsSheetIchibanCars
+	248, // 106: organization.CreateCarInsSheetIchibanCarsAResponse.car_ins_sheet_ichiban_cars_a:type_name -> organization.CarInsSheetIchibanCarsA
+	248, // 107: organization.GetCarInsSheetIchibanCarsAResponse.car_ins_sheet_ichiban_cars_a:type_name -> organization.CarInsSheetIchibanCarsA
+	248, // 108: organization.UpdateCarInsSheetIchibanCarsAResponse.car_ins_sheet_ichiban_cars_a:type_name -> organization.CarInsSheetIchibanCarsA
+	248, // 109: organization.ListCarInsSheetIchibanCarsAsResponse.car_ins_sheet_ichiban_cars_as:type_name -> organization.CarInsSheetIchibanCarsA
+	248, // 110: organization.ListCarInsSheetIchibanCarsAsByOrganizationResponse.car_ins_sheet_ichiban_cars_as:type_name -> organization.CarInsSheetIchibanCarsA
+	261, // 111: organization.CreateKudgfryResponse.kudgfry:type_name -> organization.Kudgfry
+	261, // 112: organization.GetKudgfryResponse.kudgfry:type_name -> organization.Kudgfry
+	261, // 113: organization.UpdateKudgfryResponse.kudgfry:type_name -> organization.Kudgfry
+	261, // 114: organization.ListKudgfrysResponse.kudgfrys:type_name -> organization.Kudgfry
+	261, // 115: organization.ListKudgfrysByOrganizationResponse.kudgfrys:type_name -> organization.Kudgfry
+	274, // 116: organization.CreateKudguriResponse.kudguri:type_name -> organization.Kudguri
+	274, // 117: organization.GetKudguriResponse.kudguri:type_name -> organization.Kudguri
+	274, // 118: organization.UpdateKudguriResponse.kudguri:type_name -> organization.Kudguri
+	274, // 119: organization.ListKudgurisResponse.kudguris:type_name -> organization.Kudguri
+	274, // 120: organization.ListKudgurisByOrganizationResponse.kudguris:type_name -> organization.Kudguri
+	287, // 121: organization.CreateKudgcstResponse.kudgcst:type_name -> organization.Kudgcst
+	287, // 122: organization.GetKudgcstResponse.kudgcst:type_name -> organization.Kudgcst
+	287, // 123: organization.UpdateKudgcstResponse.kudgcst:type_name -> organization.Kudgcst
+	287, // 124: organization.ListKudgcstsResponse.kudgcsts:type_name -> organization.Kudgcst
+	287, // 125: organization.ListKudgcstsByOrganizationResponse.kudgcsts:type_name -> organization.Kudgcst
+	300, // 126: organization.CreateKudgfulResponse.kudgful:type_name -> organization.Kudgful
+	300, // 127: organization.GetKudgfulResponse.kudgful:type_name -> organization.Kudgful
+	300, // 128: organization.UpdateKudgfulResponse.kudgful:type_name -> organization.Kudgful
+	300, // 129: organization.ListKudgfulsResponse.kudgfuls:type_name -> organization.Kudgful
+	300, // 130: organization.ListKudgfulsByOrganizationResponse.kudgfuls:type_name -> organization.Kudgful
+	313, // 131: organization.CreateKudgsirResponse.kudgsir:type_name -> organization.Kudgsir
+	313, // 132: organization.GetKudgsirResponse.kudgsir:type_name -> organization.Kudgsir
+	313, // 133: organization.UpdateKudgsirResponse.kudgsir:type_name -> organization.Kudgsir
+	313, // 134: organization.ListKudgsirsResponse.kudgsirs:type_name -> organization.Kudgsir
+	313, // 135: organization.ListKudgsirsByOrganizationResponse.kudgsirs:type_name -> organization.Kudgsir
+	326, // 136: organization.CreateKudgivtResponse.kudgivt:type_name -> organization.Kudgivt
+	326, // 137: organization.GetKudgivtResponse.kudgivt:type_name -> organization.Kudgivt
+	419, // 138: organization.UpdateKudgivtRequest.update_mask:type_name -> google.protobuf.FieldMask
+	326, // 139: organization.UpdateKudgivtResponse.kudgivt:type_name -> organization.Kudgivt
+	326, // 140: organization.ListKudgivtsResponse.kudgivts:type_name -> organization.Kudgivt
+	326, // 141: organization.ListKudgivtsByOrganizationResponse.kudgivts:type_name -> organization.Kudgivt
+	339, // 142: organization.CreateDtakologsResponse.dtakologs:type_name -> organization.Dtakologs
+	339, // 143: organization.GetDtakologsResponse.dtakologs:type_name -> organization.Dtakologs
+	339, // 144: organization.UpdateDtakologsResponse.dtakologs:type_name -> organization.Dtakologs
+	339, // 145: organization.ListDtakologsResponse.dtakologs:type_name -> organization.Dtakologs
+	339, // 146: organization.ListDtakologsByOrganizationResponse.dtakologs:type_name -> organization.Dtakologs
+	11,  // 147: organization.AuthResponse.user:type_name -> organization.AppUser
+	11,  // 148: organization.ValidateTokenResponse.user:type_name -> organization.AppUser
+	418, // 149: organization.Session.last_refreshed_at:type_name -> google.protobuf.Timestamp
+	418, // 150: organization.Session.expires_at:type_name -> google.protobuf.Timestamp
+	364, // 151: organization.ListSessionsResponse.sessions:type_name -> organization.Session
+	418, // 152: organization.LinkedAccount.created_at:type_name -> google.protobuf.Timestamp
+	369, // 153: organization.LinkAccountResponse.account:type_name -> organization.LinkedAccount
+	369, // 154: organization.ListLinkedAccountsResponse.accounts:type_name -> organization.LinkedAccount
+	418, // 155: organization.Invitation.expires_at:type_name -> google.protobuf.Timestamp
+	418, // 156: organization.Invitation.accepted_at:type_name -> google.protobuf.Timestamp
+	418, // 157: organization.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	418, // 158: organization.Invitation.updated_at:type_name -> google.protobuf.Timestamp
+	376, // 159: organization.CreateInvitationResponse.invitation:type_name -> organization.Invitation
+	376, // 160: organization.GetInvitationResponse.invitation:type_name -> organization.Invitation
+	376, // 161: organization.GetInvitationByTokenResponse.invitation:type_name -> organization.Invitation
+	0,   // 162: organization.GetInvitationByTokenResponse.organization:type_name -> organization.Organization
+	24,  // 163: organization.AcceptInvitationResponse.user_organization:type_name -> organization.UserOrganization
+	376, // 164: organization.ListInvitationsResponse.invitations:type_name -> organization.Invitation
+	376, // 165: organization.ResendInvitationResponse.invitation:type_name -> organization.Invitation
+	418, // 166: organization.ETCMeisai.date_fr:type_name -> google.protobuf.Timestamp
+	418, // 167: organization.ETCMeisai.date_to:type_name -> google.protobuf.Timestamp
+	418, // 168: organization.ETCMeisai.created_at:type_name -> google.protobuf.Timestamp
+	418, // 169: organization.ETCMeisai.updated_at:type_name -> google.protobuf.Timestamp
+	418, // 170: organization.CreateETCMeisaiRequest.date_fr:type_name -> google.protobuf.Timestamp
+	418, // 171: organization.CreateETCMeisaiRequest.date_to:type_name -> google.protobuf.Timestamp
+	391, // 172: organization.CreateETCMeisaiResponse.etc_meisai:type_name -> organization.ETCMeisai
+	391, // 173: organization.GetETCMeisaiResponse.etc_meisai:type_name -> organization.ETCMeisai
+	391, // 174: organization.GetETCMeisaiByHashResponse.etc_meisai:type_name -> organization.ETCMeisai
+	418, // 175: organization.UpdateETCMeisaiRequest.date_fr:type_name -> google.protobuf.Timestamp
+	418, // 176: organization.UpdateETCMeisaiRequest.date_to:type_name -> google.protobuf.Timestamp
+	391, // 177: organization.UpdateETCMeisaiResponse.etc_meisai:type_name -> organization.ETCMeisai
+	391, // 178: organization.ListETCMeisaiResponse.etc_meisai_list:type_name -> organization.ETCMeisai
+	392, // 179: organization.BulkCreateETCMeisaiRequest.records:type_name -> organization.CreateETCMeisaiRequest
+	418, // 180: organization.ApiKey.expires_at:type_name -> google.protobuf.Timestamp
+	418, // 181: organization.ApiKey.last_used_at:type_name -> google.protobuf.Timestamp
+	418, // 182: organization.ApiKey.revoked_at:type_name -> google.protobuf.Timestamp
+	418, // 183: organization.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	418, // 184: organization.CreateApiKeyRequest.expires_at:type_name -> google.protobuf.Timestamp
+	406, // 185: organization.CreateApiKeyResponse.api_key:type_name -> organization.ApiKey
+	406, // 186: organization.GetApiKeyResponse.api_key:type_name -> organization.ApiKey
+	406, // 187: organization.ListApiKeysResponse.api_keys:type_name -> organization.ApiKey
+	418, // 188: organization.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	418, // 189: organization.ListAuditEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	418, // 190: organization.ListAuditEventsRequest.end_time:type_name -> google.protobuf.Timestamp
+	415, // 191: organization.ListAuditEventsResponse.events:type_name -> organization.AuditEvent
+	1,   // 192: organization.OrganizationService.CreateOrganization:input_type -> organization.CreateOrganizationRequest
+	3,   // 193: organization.OrganizationService.GetOrganization:input_type -> organization.GetOrganizationRequest
+	5,   // 194: organization.OrganizationService.UpdateOrganization:input_type -> organization.UpdateOrganizationRequest
+	7,   // 195: organization.OrganizationService.DeleteOrganization:input_type -> organization.DeleteOrganizationRequest
+	9,   // 196: organization.OrganizationService.ListOrganizations:input_type -> organization.ListOrganizationsRequest
+	12,  // 197: organization.AppUserService.CreateAppUser:input_type -> organization.CreateAppUserRequest
+	14,  // 198: organization.AppUserService.GetAppUser:input_type -> organization.GetAppUserRequest
+	16,  // 199: organization.AppUserService.GetAppUserByEmail:input_type -> organization.GetAppUserByEmailRequest
+	18,  // 200: organization.AppUserService.UpdateAppUser:input_type -> organization.UpdateAppUserRequest
+	20,  // 201: organization.AppUserService.DeleteAppUser:input_type -> organization.DeleteAppUserRequest
+	22,  // 202: organization.AppUserService.ListAppUsers:input_type -> organization.ListAppUsersRequest
+	25,  // 203: organization.UserOrganizationService.CreateUserOrganization:input_type -> organization.CreateUserOrganizationRequest
+	27,  // 204: organization.UserOrganizationService.GetUserOrganization:input_type -> organization.GetUserOrganizationRequest
+	29,  // 205: organization.UserOrganizationService.UpdateUserOrganization:input_type -> organization.UpdateUserOrganizationRequest
+	31,  // 206: organization.UserOrganizationService.DeleteUserOrganization:input_type -> organization.DeleteUserOrganizationRequest
+	33,  // 207: organization.UserOrganizationService.ListUserOrganizations:input_type -> organization.ListUserOrganizationsRequest
+	35,  // 208: organization.UserOrganizationService.ListUserOrganizationsByUser:input_type -> organization.ListUserOrganizationsByUserRequest
+	37,  // 209: organization.UserOrganizationService.ListUserOrganizationsByOrg:input_type -> organization.ListUserOrganizationsByOrgRequest
+	40,  // 210: organization.FileService.CreateFile:input_type -> organization.CreateFileRequest
+	42,  // 211: organization.FileService.GetFile:input_type -> organization.GetFileRequest
+	44,  // 212: organization.FileService.UpdateFile:input_type -> organization.UpdateFileRequest
+	46,  // 213: organization.FileService.DeleteFile:input_type -> organization.DeleteFileRequest
+	48,  // 214: organization.FileService.ListFiles:input_type -> organization.ListFilesRequest
+	50,  // 215: organization.FileService.ListFilesByOrganization:input_type -> organization.ListFilesByOrganizationRequest
+	53,  // 216: organization.FlickrPhotoService.CreateFlickrPhoto:input_type -> organization.CreateFlickrPhotoRequest
+	55,  // 217: organization.FlickrPhotoService.GetFlickrPhoto:input_type -> organization.GetFlickrPhotoRequest
+	57,  // 218: organization.FlickrPhotoService.UpdateFlickrPhoto:input_type -> organization.UpdateFlickrPhotoRequest
+	59,  // 219: organization.FlickrPhotoService.DeleteFlickrPhoto:input_type -> organization.DeleteFlickrPhotoRequest
+	61,  // 220: organization.FlickrPhotoService.ListFlickrPhotos:input_type -> organization.ListFlickrPhotosRequest
+	63,  // 221: organization.FlickrPhotoService.ListFlickrPhotosByOrganization:input_type -> organization.ListFlickrPhotosByOrganizationRequest
+	66,  // 222: organization.CamFileService.CreateCamFile:input_type -> organization.CreateCamFileRequest
+	68,  // 223: organization.CamFileService.GetCamFile:input_type -> organization.GetCamFileRequest
+	70,  // 224: organization.CamFileService.UpdateCamFile:input_type -> organization.UpdateCamFileRequest
+	72,  // 225: organization.CamFileService.DeleteCamFile:input_type -> organization.DeleteCamFileRequest
+	74,  // 226: organization.CamFileService.ListCamFiles:input_type -> organization.ListCamFilesRequest
+	76,  // 227: organization.CamFileService.ListCamFilesByOrganization:input_type -> organization.ListCamFilesByOrganizationRequest
+	79,  // 228: organization.CamFileExeService.CreateCamFileExe:input_type -> organization.CreateCamFileExeRequest
+	81,  // 229: organization.CamFileExeService.GetCamFileExe:input_type -> organization.GetCamFileExeRequest
+	83,  // 230: organization.CamFileExeService.UpdateCamFileExe:input_type -> organization.UpdateCamFileExeRequest
+	85,  // 231: organization.CamFileExeService.DeleteCamFileExe:input_type -> organization.DeleteCamFileExeRequest
+	87,  // 232: organization.CamFileExeService.ListCamFileExes:input_type -> organization.ListCamFileExesRequest
+	89,  // 233: organization.CamFileExeService.ListCamFileExesByOrganization:input_type -> organization.ListCamFileExesByOrganizationRequest
+	92,  // 234: organization.CamFileExeStageService.CreateCamFileExeStage:input_type -> organization.CreateCamFileExeStageRequest
+	94,  // 235: organization.CamFileExeStageService.GetCamFileExeStage:input_type -> organization.GetCamFileExeStageRequest
+	96,  // 236: organization.CamFileExeStageService.UpdateCamFileExeStage:input_type -> organization.UpdateCamFileExeStageRequest
+	98,  // 237: organization.CamFileExeStageService.DeleteCamFileExeStage:input_type -> organization.DeleteCamFileExeStageRequest
+	100, // 238: organization.CamFileExeStageService.ListCamFileExeStages:input_type -> organization.ListCamFileExeStagesRequest
+	102, // 239: organization.CamFileExeStageService.ListCamFileExeStagesByOrganization:input_type -> organization.ListCamFileExeStagesByOrganizationRequest
+	105, // 240: organization.IchibanCarService.CreateIchibanCar:input_type -> organization.CreateIchibanCarRequest
+	107, // 241: organization.IchibanCarService.GetIchibanCar:input_type -> organization.GetIchibanCarRequest
+	109, // 242: organization.IchibanCarService.UpdateIchibanCar:input_type -> organization.UpdateIchibanCarRequest
+	111, // 243: organization.IchibanCarService.DeleteIchibanCar:input_type -> organization.DeleteIchibanCarRequest
+	113, // 244: organization.IchibanCarService.ListIchibanCars:input_type -> organization.ListIchibanCarsRequest
+	115, // 245: organization.IchibanCarService.ListIchibanCarsByOrganization:input_type -> organization.ListIchibanCarsByOrganizationRequest
+	118, // 246: organization.DtakoCarsIchibanCarsService.CreateDtakoCarsIchibanCars:input_type -> organization.CreateDtakoCarsIchibanCarsRequest
+	120, // 247: organization.DtakoCarsIchibanCarsService.GetDtakoCarsIchibanCars:input_type -> organization.GetDtakoCarsIchibanCarsRequest
+	122, // 248: organization.DtakoCarsIchibanCarsService.UpdateDtakoCarsIchibanCars:input_type -> organization.UpdateDtakoCarsIchibanCarsRequest
+	124, // 249: organization.DtakoCarsIchibanCarsService.DeleteDtakoCarsIchibanCars:input_type -> organization.DeleteDtakoCarsIchibanCarsRequest
+	126, // 250: organization.DtakoCarsIchibanCarsService.ListDtakoCarsIchibanCars:input_type -> organization.ListDtakoCarsIchibanCarsRequest
+	128, // 251: organization.DtakoCarsIchibanCarsService.ListDtakoCarsIchibanCarsByOrganization:input_type -> organization.ListDtakoCarsIchibanCarsByOrganizationRequest
+	131, // 252: organization.UriageService.CreateUriage:input_type -> organization.CreateUriageRequest
+	133, // 253: organization.UriageService.GetUriage:input_type -> organization.GetUriageRequest
+	135, // 254: organization.UriageService.UpdateUriage:input_type -> organization.UpdateUriageRequest
+	137, // 255: organization.UriageService.DeleteUriage:input_type -> organization.DeleteUriageRequest
+	139, // 256: organization.UriageService.ListUriages:input_type -> organization.ListUriagesRequest
+	141, // 257: organization.UriageService.ListUriagesByOrganization:input_type -> organization.ListUriagesByOrganizationRequest
+	144, // 258: organization.UriageJishaService.CreateUriageJisha:input_type -> organization.CreateUriageJishaRequest
+	146, // 259: organization.UriageJishaService.GetUriageJisha:input_type -> organization.GetUriageJishaRequest
+	148, // 260: organization.UriageJishaService.UpdateUriageJisha:input_type -> organization.UpdateUriageJishaRequest
+	150, // 261: organization.UriageJishaService.DeleteUriageJisha:input_type -> organization.DeleteUriageJishaRequest
+	152, // 262: organization.UriageJishaService.ListUriageJishas:input_type -> organization.ListUriageJishasRequest
+	154, // 263: organization.UriageJishaService.ListUriageJishasByOrganization:input_type -> organization.ListUriageJishasByOrganizationRequest
+	157, // 264: organization.CarInspectionService.CreateCarInspection:input_type -> organization.CreateCarInspectionRequest
+	160, // 265: organization.CarInspectionService.GetCarInspection:input_type -> organization.GetCarInspectionRequest
+	162, // 266: organization.CarInspectionService.UpdateCarInspection:input_type -> organization.UpdateCarInspectionRequest
+	164, // 267: organization.CarInspectionService.DeleteCarInspection:input_type -> organization.DeleteCarInspectionRequest
+	166, // 268: organization.CarInspectionService.ListCarInspections:input_type -> organization.ListCarInspectionsRequest
+	168, // 269: organization.CarInspectionService.ListCarInspectionsByOrganization:input_type -> organization.ListCarInspectionsByOrganizationRequest
+	171, // 270: organization.CarInspectionFilesService.CreateCarInspectionFile:input_type -> organization.CreateCarInspectionFileRequest
+	173, // 271: organization.CarInspectionFilesService.GetCarInspectionFile:input_type -> organization.GetCarInspectionFileRequest
+	175, // 272: organization.CarInspectionFilesService.UpdateCarInspectionFile:input_type -> organization.UpdateCarInspectionFileRequest
+	177, // 273: organization.CarInspectionFilesService.DeleteCarInspectionFile:input_type -> organization.DeleteCarInspectionFileRequest
+	179, // 274: organization.CarInspectionFilesService.ListCarInspectionFiles:input_type -> organization.ListCarInspectionFilesRequest
+	181, // 275: organization.CarInspectionFilesService.ListCarInspectionFilesByOrganization:input_type -> organization.ListCarInspectionFilesByOrganizationRequest
+	184, // 276: organization.CarInspectionFilesAService.CreateCarInspectionFilesA:input_type -> organization.CreateCarInspectionFilesARequest
+	186, // 277: organization.CarInspectionFilesAService.GetCarInspectionFilesA:input_type -> organization.GetCarInspectionFilesARequest
+	188, // 278: organization.CarInspectionFilesAService.UpdateCarInspectionFilesA:input_type -> organization.UpdateCarInspectionFilesARequest
+	190, // 279: organization.CarInspectionFilesAService.DeleteCarInspectionFilesA:input_type -> organization.DeleteCarInspectionFilesARequest
+	192, // 280: organization.CarInspectionFilesAService.ListCarInspectionFilesAs:input_type -> organization.ListCarInspectionFilesAsRequest
+	194, // 281: organization.CarInspectionFilesAService.ListCarInspectionFilesAsByOrganization:input_type -> organization.ListCarInspectionFilesAsByOrganizationRequest
+	197, // 282: organization.CarInspectionFilesBService.CreateCarInspectionFilesB:input_type -> organization.CreateCarInspectionFilesBRequest
+	199, // 283: organization.CarInspectionFilesBService.GetCarInspectionFilesB:input_type -> organization.GetCarInspectionFilesBRequest
+	201, // 284: organization.CarInspectionFilesBService.UpdateCarInspectionFilesB:input_type -> organization.UpdateCarInspectionFilesBRequest
+	203, // 285: organization.CarInspectionFilesBService.DeleteCarInspectionFilesB:input_type -> organization.DeleteCarInspectionFilesBRequest
+	205, // 286: organization.CarInspectionFilesBService.ListCarInspectionFilesBs:input_type -> organization.ListCarInspectionFilesBsRequest
+	207, // 287: organization.CarInspectionFilesBService.ListCarInspectionFilesBsByOrganization:input_type -> organization.ListCarInspectionFilesBsByOrganizationRequest
+	210, // 288: organization.CarInspectionDeregistrationService.CreateCarInspectionDeregistration:input_type -> organization.CreateCarInspectionDeregistrationRequest
+	212, // 289: organization.CarInspectionDeregistrationService.GetCarInspectionDeregistration:input_type -> organization.GetCarInspectionDeregistrationRequest
+	214, // 290: organization.CarInspectionDeregistrationService.UpdateCarInspectionDeregistration:input_type -> organization.UpdateCarInspectionDeregistrationRequest
+	216, // 291: organization.CarInspectionDeregistrationService.DeleteCarInspectionDeregistration:input_type -> organization.DeleteCarInspectionDeregistrationRequest
+	218, // 292: organization.CarInspectionDeregistrationService.ListCarInspectionDeregistrations:input_type -> organization.ListCarInspectionDeregistrationsRequest
+	220, // 293: organization.CarInspectionDeregistrationService.ListCarInspectionDeregistrationsByOrganization:input_type -> organization.ListCarInspectionDeregistrationsByOrganizationRequest
+	223, // 294: organization.CarInspectionDeregistrationFilesService.CreateCarInspectionDeregistrationFiles:input_type -> organization.CreateCarInspectionDeregistrationFilesRequest
+	225, // 295: organization.CarInspectionDeregistrationFilesService.GetCarInspectionDeregistrationFiles:input_type -> organization.GetCarInspectionDeregistrationFilesRequest
+	227, // 296: organization.CarInspectionDeregistrationFilesService.UpdateCarInspectionDeregistrationFiles:input_type -> organization.UpdateCarInspectionDeregistrationFilesRequest
+	229, // 297: organization.CarInspectionDeregistrationFilesService.DeleteCarInspectionDeregistrationFiles:input_type -> organization.DeleteCarInspectionDeregistrationFilesRequest
+	231, // 298: organization.CarInspectionDeregistrationFilesService.ListCarInspectionDeregistrationFiless:input_type -> organization.ListCarInspectionDeregistrationFilessRequest
+	233, // 299: organization.CarInspectionDeregistrationFilesService.ListCarInspectionDeregistrationFilessByOrganization:input_type -> organization.ListCarInspectionDeregistrationFilessByOrganizationRequest
+	236, // 300: organization.CarInsSheetIchibanCarsService.CreateCarInsSheetIchibanCars:input_type -> organization.CreateCarInsSheetIchibanCarsRequest
+	238, // 301: organization.CarInsSheetIchibanCarsService.GetCarInsSheetIchibanCars:input_type -> organization.GetCarInsSheetIchibanCarsRequest
+	240, // 302: organization.CarInsSheetIchibanCarsService.UpdateCarInsSheetIchibanCars:input_type -> organization.UpdateCarInsSheetIchibanCarsRequest
+	242, // 303: organization.CarInsSheetIchibanCarsService.DeleteCarInsSheetIchibanCars:input_type -> organization.DeleteCarInsSheetIchibanCarsRequest
+	244, // 304: organization.CarInsSheetIchibanCarsService.ListCarInsSheetIchibanCarss:input_type -> organization.ListCarInsSheetIchibanCarssRequest
+	246, // 305: organization.CarInsSheetIchibanCarsService.ListCarInsSheetIchibanCarssByOrganization:input_type -> organization.ListCarInsSheetIchibanCarssByOrganizationRequest
+	249, // 306: organization.CarInsSheetIchibanCarsAService.CreateCarInsSheetIchibanCarsA:input_type -> organization.CreateCarInsSheetIchibanCarsARequest
+	251, // 307: organization.CarInsSheetIchibanCarsAService.GetCarInsSheetIchibanCarsA:input_type -> organization.GetCarInsSheetIchibanCarsARequest
+	253, // 308: organization.CarInsSheetIchibanCarsAService.UpdateCarInsSheetIchibanCarsA:input_type -> organization.UpdateCarInsSheetIchibanCarsARequest
+	255, // 309: organization.CarInsSheetIchibanCarsAService.DeleteCarInsSheetIchibanCarsA:input_type -> organization.DeleteCarInsSheetIchibanCarsARequest
+	257, // 310: organization.CarInsSheetIchibanCarsAService.ListCarInsSheetIchibanCarsAs:input_type -> organization.ListCarInsSheetIchibanCarsAsRequest
+	259, // 311: organization.CarInsSheetIchibanCarsAService.ListCarInsSheetIchibanCarsAsByOrganization:input_type -> organization.ListCarInsSheetIchibanCarsAsByOrganizationRequest
+	262, // 312: organization.KudgfryService.CreateKudgfry:input_type -> organization.CreateKudgfryRequest
+	264, // 313: organization.KudgfryService.GetKudgfry:input_type -> organization.GetKudgfryRequest
+	266, // 314: organization.KudgfryService.UpdateKudgfry:input_type -> organization.UpdateKudgfryRequest
+	268, // 315: organization.KudgfryService.DeleteKudgfry:input_type -> organization.DeleteKudgfryRequest
+	270, // 316: organization.KudgfryService.ListKudgfrys:input_type -> organization.ListKudgfrysRequest
+	272, // 317: organization.KudgfryService.ListKudgfrysByOrganization:input_type -> organization.ListKudgfrysByOrganizationRequest
+	275, // 318: organization.KudguriService.CreateKudguri:input_type -> organization.CreateKudguriRequest
+	277, // 319: organization.KudguriService.GetKudguri:input_type -> organization.GetKudguriRequest
+	279, // 320: organization.KudguriService.UpdateKudguri:input_type -> organization.UpdateKudguriRequest
+	281, // 321: organization.KudguriService.DeleteKudguri:input_type -> organization.DeleteKudguriRequest
+	283, // 322: organization.KudguriService.ListKudguris:input_type -> organization.ListKudgurisRequest
+	285, // 323: organization.KudguriService.ListKudgurisByOrganization:input_type -> organization.ListKudgurisByOrganizationRequest
+	288, // 324: organization.KudgcstService.CreateKudgcst:input_type -> organization.CreateKudgcstRequest
+	290, // 325: organization.KudgcstService.GetKudgcst:input_type -> organization.GetKudgcstRequest
+	292, // 326: organization.KudgcstService.UpdateKudgcst:input_type -> organization.UpdateKudgcstRequest
+	294, // 327: organization.KudgcstService.DeleteKudgcst:input_type -> organization.DeleteKudgcstRequest
+	296, // 328: organization.KudgcstService.ListKudgcsts:input_type -> organization.ListKudgcstsRequest
+	298, // 329: organization.KudgcstService.ListKudgcstsByOrganization:input_type -> organization.ListKudgcstsByOrganizationRequest
+	301, // 330: organization.KudgfulService.CreateKudgful:input_type -> organization.CreateKudgfulRequest
+	303, // 331: organization.KudgfulService.GetKudgful:input_type -> organization.GetKudgfulRequest
+	305, // 332: organization.KudgfulService.UpdateKudgful:input_type -> organization.UpdateKudgfulRequest
+	307, // 333: organization.KudgfulService.DeleteKudgful:input_type -> organization.DeleteKudgfulRequest
+	309, // 334: organization.KudgfulService.ListKudgfuls:input_type -> organization.ListKudgfulsRequest
+	311, // 335: organization.KudgfulService.ListKudgfulsByOrganization:input_type -> organization.ListKudgfulsByOrganizationRequest
+	314, // 336: organization.KudgsirService.CreateKudgsir:input_type -> organization.CreateKudgsirRequest
+	316, // 337: organization.KudgsirService.GetKudgsir:input_type -> organization.GetKudgsirRequest
+	318, // 338: organization.KudgsirService.UpdateKudgsir:input_type -> organization.UpdateKudgsirRequest
+	320, // 339: organization.KudgsirService.DeleteKudgsir:input_type -> organization.DeleteKudgsirRequest
+	322, // 340: organization.KudgsirService.ListKudgsirs:input_type -> organization.ListKudgsirsRequest
+	324, // 341: organization.KudgsirService.ListKudgsirsByOrganization:input_type -> organization.ListKudgsirsByOrganizationRequest
+	327, // 342: organization.KudgivtService.CreateKudgivt:input_type -> organization.CreateKudgivtRequest
+	329, // 343: organization.KudgivtService.GetKudgivt:input_type -> organization.GetKudgivtRequest
+	331, // 344: organization.KudgivtService.UpdateKudgivt:input_type -> organization.UpdateKudgivtRequest
+	333, // 345: organization.KudgivtService.DeleteKudgivt:input_type -> organization.DeleteKudgivtRequest
+	335, // 346: organization.KudgivtService.ListKudgivts:input_type -> organization.ListKudgivtsRequest
+	337, // 347: organization.KudgivtService.ListKudgivtsByOrganization:input_type -> organization.ListKudgivtsByOrganizationRequest
+	340, // 348: organization.DtakologsService.CreateDtakologs:input_type -> organization.CreateDtakologsRequest
+	342, // 349: organization.DtakologsService.GetDtakologs:input_type -> organization.GetDtakologsRequest
+	344, // 350: organization.DtakologsService.UpdateDtakologs:input_type -> organization.UpdateDtakologsRequest
+	346, // 351: organization.DtakologsService.DeleteDtakologs:input_type -> organization.DeleteDtakologsRequest
+	348, // 352: organization.DtakologsService.ListDtakologs:input_type -> organization.ListDtakologsRequest
+	350, // 353: organization.DtakologsService.ListDtakologsByOrganization:input_type -> organization.ListDtakologsByOrganizationRequest
+	353, // 354: organization.AuthService.AuthWithGoogle:input_type -> organization.AuthWithGoogleRequest
+	354, // 355: organization.AuthService.AuthWithLine:input_type -> organization.AuthWithLineRequest
+	355, // 356: organization.AuthService.RefreshToken:input_type -> organization.RefreshTokenRequest
+	356, // 357: organization.AuthService.GetAuthURL:input_type -> organization.GetAuthURLRequest
+	358, // 358: organization.AuthService.ValidateToken:input_type -> organization.ValidateTokenRequest
+	360, // 359: organization.AuthService.Logout:input_type -> organization.LogoutRequest
+	362, // 360: organization.AuthService.RevokeAllSessions:input_type -> organization.RevokeAllSessionsRequest
+	365, // 361: organization.AuthService.ListSessions:input_type -> organization.ListSessionsRequest
+	367, // 362: organization.AuthService.SwitchOrganization:input_type -> organization.SwitchOrganizationRequest
+	370, // 363: organization.AuthService.LinkAccount:input_type -> organization.LinkAccountRequest
+	372, // 364: organization.AuthService.UnlinkAccount:input_type -> organization.UnlinkAccountRequest
+	374, // 365: organization.AuthService.ListLinkedAccounts:input_type -> organization.ListLinkedAccountsRequest
+	377, // 366: organization.InvitationService.CreateInvitation:input_type -> organization.CreateInvitationRequest
+	379, // 367: organization.InvitationService.GetInvitation:input_type -> organization.GetInvitationRequest
+	381, // 368: organization.InvitationService.GetInvitationByToken:input_type -> organization.GetInvitationByTokenRequest
+	383, // 369: organization.InvitationService.AcceptInvitation:input_type -> organization.AcceptInvitationRequest
+	385, // 370: organization.InvitationService.CancelInvitation:input_type -> organization.CancelInvitationRequest
+	387, // 371: organization.InvitationService.ListInvitations:input_type -> organization.ListInvitationsRequest
+	389, // 372: organization.InvitationService.ResendInvitation:input_type -> organization.ResendInvitationRequest
+	392, // 373: organization.ETCMeisaiService.CreateETCMeisai:input_type -> organization.CreateETCMeisaiRequest
+	394, // 374: organization.ETCMeisaiService.GetETCMeisai:input_type -> organization.GetETCMeisaiRequest
+	396, // 375: organization.ETCMeisaiService.GetETCMeisaiByHash:input_type -> organization.GetETCMeisaiByHashRequest
+	398, // 376: organization.ETCMeisaiService.UpdateETCMeisai:input_type -> organization.UpdateETCMeisaiRequest
+	400, // 377: organization.ETCMeisaiService.DeleteETCMeisai:input_type -> organization.DeleteETCMeisaiRequest
+	402, // 378: organization.ETCMeisaiService.ListETCMeisai:input_type -> organization.ListETCMeisaiRequest
+	404, // 379: organization.ETCMeisaiService.BulkCreateETCMeisai:input_type -> organization.BulkCreateETCMeisaiRequest
+	407, // 380: organization.ApiKeyService.CreateApiKey:input_type -> organization.CreateApiKeyRequest
+	409, // 381: organization.ApiKeyService.GetApiKey:input_type -> organization.GetApiKeyRequest
+	411, // 382: organization.ApiKeyService.ListApiKeys:input_type -> organization.ListApiKeysRequest
+	413, // 383: organization.ApiKeyService.RevokeApiKey:input_type -> organization.RevokeApiKeyRequest
+	416, // 384: organization.AuditLogService.ListAuditEvents:input_type -> organization.ListAuditEventsRequest
+	2,   // 385: organization.OrganizationService.CreateOrganization:output_type -> organization.CreateOrganizationResponse
+	4,   // 386: organization.OrganizationService.GetOrganization:output_type -> organization.GetOrganizationResponse
+	6,   // 387: organization.OrganizationService.UpdateOrganization:output_type -> organization.UpdateOrganizationResponse
+	8,   // 388: organization.OrganizationService.DeleteOrganization:output_type -> organization.DeleteOrganizationResponse
+	10,  // 389: organization.OrganizationService.ListOrganizations:output_type -> organization.ListOrganizationsResponse
+	13,  // 390: organization.AppUserService.CreateAppUser:output_type -> organization.CreateAppUserResponse
+	15,  // 391: organization.AppUserService.GetAppUser:output_type -> organization.GetAppUserResponse
+	17,  // 392: organization.AppUserService.GetAppUserByEmail:output_type -> organization.GetAppUserByEmailResponse
+	19,  // 393: organization.AppUserService.UpdateAppUser:output_type -> organization.UpdateAppUserResponse
+	21,  // 394: organization.AppUserService.DeleteAppUser:output_type -> organization.DeleteAppUserResponse
+	23,  // 395: organization.AppUserService.ListAppUsers:output_type -> organization.ListAppUsersResponse
+	26,  // 396: organization.UserOrganizationService.CreateUserOrganization:output_type -> organization.CreateUserOrganizationResponse
+	28,  // 397: organization.UserOrganizationService.GetUserOrganization:output_type -> organization.GetUserOrganizationResponse
+	30,  // 398: organization.UserOrganizationService.UpdateUserOrganization:output_type -> organization.UpdateUserOrganizationResponse
+	32,  // 399: organization.UserOrganizationService.DeleteUserOrganization:output_type -> organization.DeleteUserOrganizationResponse
+	34,  // 400: organization.UserOrganizationService.ListUserOrganizations:output_type -> organization.ListUserOrganizationsResponse
+	36,  // 401: organization.UserOrganizationService.ListUserOrganizationsByUser:output_type -> organization.ListUserOrganizationsByUserResponse
+	38,  // 402: organization.UserOrganizationService.ListUserOrganizationsByOrg:output_type -> organization.ListUserOrganizationsByOrgResponse
+	41,  // 403: organization.FileService.CreateFile:output_type -> organization.CreateFileResponse
+	43,  // 404: organization.FileService.GetFile:output_type -> organization.GetFileResponse
+	45,  // 405: organization.FileService.UpdateFile:output_type -> organization.UpdateFileResponse
+	47,  // 406: organization.FileService.DeleteFile:output_type -> organization.DeleteFileResponse
+	49,  // 407: organization.FileService.ListFiles:output_type -> organization.ListFilesResponse
+	51,  // 408: organization.FileService.ListFilesByOrganization:output_type -> organization.ListFilesByOrganizationResponse
+	54,  // 409: organization.FlickrPhotoService.CreateFlickrPhoto:output_type -> organization.CreateFlickrPhotoResponse
+	56,  // 410: organization.FlickrPhotoService.GetFlickrPhoto:output_type -> organization.GetFlickrPhotoResponse
+	58,  // 411: organization.FlickrPhotoService.UpdateFlickrPhoto:output_type -> organization.UpdateFlickrPhotoResponse
+	60,  // 412: organization.FlickrPhotoService.DeleteFlickrPhoto:output_type -> organization.DeleteFlickrPhotoResponse
+	62,  // 413: organization.FlickrPhotoService.ListFlickrPhotos:output_type -> organization.ListFlickrPhotosResponse
+	64,  // 414: organization.FlickrPhotoService.ListFlickrPhotosByOrganization:output_type -> organization.ListFlickrPhotosByOrganizationResponse
+	67,  // 415: organization.CamFileService.CreateCamFile:output_type -> organization.CreateCamFileResponse
+	69,  // 416: organization.CamFileService.GetCamFile:output_type -> organization.GetCamFileResponse
+	71,  // 417: organization.CamFileService.UpdateCamFile:output_type -> organization.UpdateCamFileResponse
+	73,  // 418: organization.CamFileService.DeleteCamFile:output_type -> organization.DeleteCamFileResponse
+	75,  // 419: organization.CamFileService.ListCamFiles:output_type -> organization.ListCamFilesResponse
+	77,  // 420: organization.CamFileService.ListCamFilesByOrganization:output_type -> organization.ListCamFilesByOrganizationResponse
+	80,  // 421: organization.CamFileExeService.CreateCamFileExe:output_type -> organization.CreateCamFileExeResponse
+	82,  // 422: organization.CamFileExeService.GetCamFileExe:output_type -> organization.GetCamFileExeResponse
+	84,  // 423: organization.CamFileExeService.UpdateCamFileExe:output_type -> organization.UpdateCamFileExeResponse
+	86,  // 424: organization.CamFileExeService.DeleteCamFileExe:output_type -> organization.DeleteCamFileExeResponse
+	88,  // 425: organization.CamFileExeService.ListCamFileExes:output_type -> organization.ListCamFileExesResponse
+	90,  // 426: organization.CamFileExeService.ListCamFileExesByOrganization:output_type -> organization.ListCamFileExesByOrganizationResponse
+	93,  // 427: organization.CamFileExeStageService.CreateCamFileExeStage:output_type -> organization.CreateCamFileExeStageResponse
+	95,  // 428: organization.CamFileExeStageService.GetCamFileExeStage:output_type -> organization.GetCamFileExeStageResponse
+	97,  // 429: organization.CamFileExeStageService.UpdateCamFileExeStage:output_type -> organization.UpdateCamFileExeStageResponse
+	99,  // 430: organization.CamFileExeStageService.DeleteCamFileExeStage:output_type -> organization.DeleteCamFileExeStageResponse
+	101, // 431: organization.CamFileExeStageService.ListCamFileExeStages:output_type -> organization.ListCamFileExeStagesResponse
+	103, // 432: organization.CamFileExeStageService.ListCamFileExeStagesByOrganization:output_type -> organization.ListCamFileExeStagesByOrganizationResponse
+	106, // 433: organization.IchibanCarService.CreateIchibanCar:output_type -> organization.CreateIchibanCarResponse
+	108, // 434: organization.IchibanCarService.GetIchibanCar:output_type -> organization.GetIchibanCarResponse
+	110, // 435: organization.IchibanCarService.UpdateIchibanCar:output_type -> organization.UpdateIchibanCarResponse
+	112, // 436: organization.IchibanCarService.DeleteIchibanCar:output_type -> organization.DeleteIchibanCarResponse
+	114, // 437: organization.IchibanCarService.ListIchibanCars:output_type -> organization.ListIchibanCarsResponse
+	116, // 438: organization.IchibanCarService.ListIchibanCarsByOrganization:output_type -> organization.ListIchibanCarsByOrganizationResponse
+	119, // 439: organization.DtakoCarsIchibanCarsService.CreateDtakoCarsIchibanCars:output_type -> organization.CreateDtakoCarsIchibanCarsResponse
+	121, // 440: organization.DtakoCarsIchibanCarsService.GetDtakoCarsIchibanCars:output_type -> organization.GetDtakoCarsIchibanCarsResponse
+	123, // 441: organization.DtakoCarsIchibanCarsService.UpdateDtakoCarsIchibanCars:output_type -> organization.UpdateDtakoCarsIchibanCarsResponse
+	125, // 442: organization.DtakoCarsIchibanCarsService.DeleteDtakoCarsIchibanCars:output_type -> organization.DeleteDtakoCarsIchibanCarsResponse
+	127, // 443: organization.DtakoCarsIchibanCarsService.ListDtakoCarsIchibanCars:output_type -> organization.ListDtakoCarsIchibanCarsResponse
+	129, // 444: organization.DtakoCarsIchibanCarsService.ListDtakoCarsIchibanCarsByOrganization:output_type -> organization.ListDtakoCarsIchibanCarsByOrganizationResponse
+	132, // 445: organization.UriageService.CreateUriage:output_type -> organization.CreateUriageResponse
+	134, // 446: organization.UriageService.GetUriage:output_type -> organization.GetUriageResponse
+	136, // 447: organization.UriageService.UpdateUriage:output_type -> organization.UpdateUriageResponse
+	138, // 448: organization.UriageService.DeleteUriage:output_type -> organization.DeleteUriageResponse
+	140, // 449: organization.UriageService.ListUriages:output_type -> organization.ListUriagesResponse
+	142, // 450: organization.UriageService.ListUriagesByOrganization:output_type -> organization.ListUriagesByOrganizationResponse
+	145, // 451: organization.UriageJishaService.CreateUriageJisha:output_type -> organization.CreateUriageJishaResponse
+	147, // 452: organization.UriageJishaService.GetUriageJisha:output_type -> organization.GetUriageJishaResponse
+	149, // 453: organization.UriageJishaService.UpdateUriageJisha:output_type -> organization.UpdateUriageJishaResponse
+	151, // 454: organization.UriageJishaService.DeleteUriageJisha:output_type -> organization.DeleteUriageJishaResponse
+	153, // 455: organization.UriageJishaService.ListUriageJishas:output_type -> organization.ListUriageJishasResponse
+	155, // 456: organization.UriageJishaService.ListUriageJishasByOrganization:output_type -> organization.ListUriageJishasByOrganizationResponse
+	159, // 457: organization.CarInspectionService.CreateCarInspection:output_type -> organization.CreateCarInspectionResponse
+	161, // 458: organization.CarInspectionService.GetCarInspection:output_type -> organization.GetCarInspectionResponse
+	163, // 459: organization.CarInspectionService.UpdateCarInspection:output_type -> organization.UpdateCarInspectionResponse
+	165, // 460: organization.CarInspectionService.DeleteCarInspection:output_type -> organization.DeleteCarInspectionResponse
+	167, // 461: organization.CarInspectionService.ListCarInspections:output_type -> organization.ListCarInspectionsResponse
+	169, // 462: organization.CarInspectionService.ListCarInspectionsByOrganization:output_type -> organization.ListCarInspectionsByOrganizationResponse
+	172, // 463: organization.CarInspectionFilesService.CreateCarInspectionFile:output_type -> organization.CreateCarInspectionFileResponse
+	174, // 464: organization.CarInspectionFilesService.GetCarInspectionFile:output_type -> organization.GetCarInspectionFileResponse
+	176, // 465: organization.CarInspectionFilesService.UpdateCarInspectionFile:output_type -> organization.UpdateCarInspectionFileResponse
+	178, // 466: organization.CarInspectionFilesService.DeleteCarInspectionFile:output_type -> organization.DeleteCarInspectionFileResponse
+	180, // 467: organization.CarInspectionFilesService.ListCarInspectionFiles:output_type -> organization.ListCarInspectionFilesResponse
+	182, // 468: organization.CarInspectionFilesService.ListCarInspectionFilesByOrganization:output_type -> organization.ListCarInspectionFilesByOrganizationResponse
+	185, // 469: organization.CarInspectionFilesAService.CreateCarInspectionFilesA:output_type -> organization.CreateCarInspectionFilesAResponse
+	187, // 470: organization.CarInspectionFilesAService.GetCarInspectionFilesA:output_type -> organization.GetCarInspectionFilesAResponse
+	189, // 471: organization.CarInspectionFilesAService.UpdateCarInspectionFilesA:output_type -> organization.UpdateCarInspectionFilesAResponse
+	191, // 472: organization.CarInspectionFilesAService.DeleteCarInspectionFilesA:output_type -> organization.DeleteCarInspectionFilesAResponse
+	193, // 473: organization.CarInspectionFilesAService.ListCarInspectionFilesAs:output_type -> organization.ListCarInspectionFilesAsResponse
+	195, // 474: organization.CarInspectionFilesAService.ListCarInspectionFilesAsByOrganization:output_type -> organization.ListCarInspectionFilesAsByOrganizationResponse
+	198, // 475: organization.CarInspectionFilesBService.CreateCarInspectionFilesB:output_type -> organization.CreateCarInspectionFilesBResponse
+	200, // 476: organization.CarInspectionFilesBService.GetCarInspectionFilesB:output_type -> organization.GetCarInspectionFilesBResponse
+	202, // 477: organization.CarInspectionFilesBService.UpdateCarInspectionFilesB:output_type -> organization.UpdateCarInspectionFilesBResponse
+	204, // 478: organization.CarInspectionFilesBService.DeleteCarInspectionFilesB:output_type -> organization.DeleteCarInspectionFilesBResponse
+	206, // 479: organization.CarInspectionFilesBService.ListCarInspectionFilesBs:output_type -> organization.ListCarInspectionFilesBsResponse
+	208, // 480: organization.CarInspectionFilesBService.ListCarInspectionFilesBsByOrganization:output_type -> organization.ListCarInspectionFilesBsByOrganizationResponse
+	211, // 481: organization.CarInspectionDeregistrationService.CreateCarInspectionDeregistration:output_type -> organization.CreateCarInspectionDeregistrationResponse
+	213, // 482: organization.CarInspectionDeregistrationService.GetCarInspectionDeregistration:output_type -> organization.GetCarInspectionDeregistrationResponse
+	215, // 483: organization.CarInspectionDeregistrationService.UpdateCarInspectionDeregistration:output_type -> organization.UpdateCarInspectionDeregistrationResponse
+	217, // 484: organization.CarInspectionDeregistrationService.DeleteCarInspectionDeregistration:output_type -> organization.DeleteCarInspectionDeregistrationResponse
+	219, // 485: organization.CarInspectionDeregistrationService.ListCarInspectionDeregistrations:output_type -> organization.ListCarInspectionDeregistrationsResponse
+	221, // 486: organization.CarInspectionDeregistrationService.ListCarInspectionDeregistrationsByOrganization:output_type -> organization.ListCarInspectionDeregistrationsByOrganizationResponse
+	224, // 487: organization.CarInspectionDeregistrationFilesService.CreateCarInspectionDeregistrationFiles:output_type -> organization.CreateCarInspectionDeregistrationFilesResponse
+	226, // 488: organization.CarInspectionDeregistrationFilesService.GetCarInspectionDeregistrationFiles:output_type -> organization.GetCarInspectionDeregistrationFilesResponse
+	228, // 489: organization.CarInspectionDeregistrationFilesService.UpdateCarInspectionDeregistrationFiles:output_type -> organization.UpdateCarInspectionDeregistrationFilesResponse
+	230, // 490: organization.CarInspectionDeregistrationFilesService.DeleteCarInspectionDeregistrationFiles:output_type -> organization.DeleteCarInspectionDeregistrationFilesResponse
+	232, // 491: organization.CarInspectionDeregistrationFilesService.ListCarInspectionDeregistrationFiless:output_type -> organization.ListCarInspectionDeregistrationFilessResponse
+	234, // 492: organization.CarInspectionDeregistrationFilesService.ListCarInspectionDeregistrationFilessByOrganization:output_type -> organization.ListCarInspectionDeregistrationFilessByOrganizationResponse
+	237, // 493: organization.CarInsSheetIchibanCarsService.CreateCarInsSheetIchibanCars:output_type -> organization.CreateCarInsSheetIchibanCarsResponse
+	239, // 494: organization.CarInsSheetIchibanCarsService.GetCarInsSheetIchibanCars:output_type -> organization.GetCarInsSheetIchibanCarsResponse
+	241, // 495: organization.CarInsSheetIchibanCarsService.UpdateCarInsSheetIchibanCars:output_type -> organization.UpdateCarInsSheetIchibanCarsResponse
+	243, // 496: organization.CarInsSheetIchibanCarsService.DeleteCarInsSheetIchibanCars:output_type -> organization.DeleteCarInsSheetIchibanCarsResponse
+	245, // 497: organization.CarInsSheetIchibanCarsService.ListCarInsSheetIchibanCarss:output_type -> organization.ListCarInsSheetIchibanCarssResponse
+	247, // 498: organization.CarInsSheetIchibanCarsService.ListCarInsSheetIchibanCarssByOrganization:output_type -> organization.ListCarInsSheetIchibanCarssByOrganizationResponse
+	250, // 499: organization.CarInsSheetIchibanCarsAService.CreateCarInsSheetIchibanCarsA:output_type -> organization.CreateCarInsSheetIchibanCarsAResponse
+	252, // 500: organization.CarInsSheetIchibanCarsAService.GetCarInsSheetIchibanCarsA:output_type -> organization.GetCarInsSheetIchibanCarsAResponse
+	254, // 501: organization.CarInsSheetIchibanCarsAService.UpdateCarInsSheetIchibanCarsA:output_type -> organization.UpdateCarInsSheetIchibanCarsAResponse
+	256, // 502: organization.CarInsSheetIchibanCarsAService.DeleteCarInsSheetIchibanCarsA:output_type -> organization.DeleteCarInsSheetIchibanCarsAResponse
+	258, // 503: organization.CarInsSheetIchibanCarsAService.ListCarInsSheetIchibanCarsAs:output_type -> organization.ListCarInsSheetIchibanCarsAsResponse
+	260, // 504: organization.CarInsSheetIchibanCarsAService.ListCarInsSheetIchibanCarsAsByOrganization:output_type -> organization.ListCarInsSheetIchibanCarsAsByOrganizationResponse
+	263, // 505: organization.KudgfryService.CreateKudgfry:output_type -> organization.CreateKudgfryResponse
+	265, // 506: organization.KudgfryService.GetKudgfry:output_type -> organization.GetKudgfryResponse
+	267, // 507: organization.KudgfryService.UpdateKudgfry:output_type -> organization.UpdateKudgfryResponse
+	269, // 508: organization.KudgfryService.DeleteKudgfry:output_type -> organization.DeleteKudgfryResponse
+	271, // 509: organization.KudgfryService.ListKudgfrys:output_type -> organization.ListKudgfrysResponse
+	273, // 510: organization.KudgfryService.ListKudgfrysByOrganization:output_type -> organization.ListKudgfrysByOrganizationResponse
+	276, // 511: organization.KudguriService.CreateKudguri:output_type -> organization.CreateKudguriResponse
+	278, // 512: organization.KudguriService.GetKudguri:output_type -> organization.GetKudguriResponse
+	280, // 513: organization.KudguriService.UpdateKudguri:output_type -> organization.UpdateKudguriResponse
+	282, // 514: organization.KudguriService.DeleteKudguri:output_type -> organization.DeleteKudguriResponse
+	284, // 515: organization.KudguriService.ListKudguris:output_type -> organization.ListKudgurisResponse
+	286, // 516: organization.KudguriService.ListKudgurisByOrganization:output_type -> organization.ListKudgurisByOrganizationResponse
+	289, // 517: organization.KudgcstService.CreateKudgcst:output_type -> organization.CreateKudgcstResponse
+	291, // 518: organization.KudgcstService.GetKudgcst:output_type -> organization.GetKudgcstResponse
+	293, // 519: organization.KudgcstService.UpdateKudgcst:output_type -> organization.UpdateKudgcstResponse
+	295, // 520: organization.KudgcstService.DeleteKudgcst:output_type -> organization.DeleteKudgcstResponse
+	297, // 521: organization.KudgcstService.ListKudgcsts:output_type -> organization.ListKudgcstsResponse
+	299, // 522: organization.KudgcstService.ListKudgcstsByOrganization:output_type -> organization.ListKudgcstsByOrganizationResponse
+	302, // 523: organization.KudgfulService.CreateKudgful:output_type -> organization.CreateKudgfulResponse
+	304, // 524: organization.KudgfulService.GetKudgful:output_type -> organization.GetKudgfulResponse
+	306, // 525: organization.KudgfulService.UpdateKudgful:output_type -> organization.UpdateKudgfulResponse
+	308, // 526: organization.KudgfulService.DeleteKudgful:output_type -> organization.DeleteKudgfulResponse
+	310, // 527: organization.KudgfulService.ListKudgfuls:output_type -> organization.ListKudgfulsResponse
+	312, // 528: organization.KudgfulService.ListKudgfulsByOrganization:output_type -> organization.ListKudgfulsByOrganizationResponse
+	315, // 529: organization.KudgsirService.CreateKudgsir:output_type -> organization.CreateKudgsirResponse
+	317, // 530: organization.KudgsirService.GetKudgsir:output_type -> organization.GetKudgsirResponse
+	319, // 531: organization.KudgsirService.UpdateKudgsir:output_type -> organization.UpdateKudgsirResponse
+	321, // 532: organization.KudgsirService.DeleteKudgsir:output_type -> organization.DeleteKudgsirResponse
+	323, // 533: organization.KudgsirService.ListKudgsirs:output_type -> organization.ListKudgsirsResponse
+	325, // 534: organization.KudgsirService.ListKudgsirsByOrganization:output_type -> organization.ListKudgsirsByOrganizationResponse
+	328, // 535: organization.KudgivtService.CreateKudgivt:output_type -> organization.CreateKudgivtResponse
+	330, // 536: organization.KudgivtService.GetKudgivt:output_type -> organization.GetKudgivtResponse
+	332, // 537: organization.KudgivtService.UpdateKudgivt:output_type -> organization.UpdateKudgivtResponse
+	334, // 538: organization.KudgivtService.DeleteKudgivt:output_type -> organization.DeleteKudgivtResponse
+	336, // 539: organization.KudgivtService.ListKudgivts:output_type -> organization.ListKudgivtsResponse
+	338, // 540: organization.KudgivtService.ListKudgivtsByOrganization:output_type -> organization.ListKudgivtsByOrganizationResponse
+	341, // 541: organization.DtakologsService.CreateDtakologs:output_type -> organization.CreateDtakologsResponse
+	343, // 542: organization.DtakologsService.GetDtakologs:output_type -> organization.GetDtakologsResponse
+	345, // 543: organization.DtakologsService.UpdateDtakologs:output_type -> organization.UpdateDtakologsResponse
+	347, // 544: organization.DtakologsService.DeleteDtakologs:output_type -> organization.DeleteDtakologsResponse
+	349, // 545: organization.DtakologsService.ListDtakologs:output_type -> organization.ListDtakologsResponse
+	351, // 546: organization.DtakologsService.ListDtakologsByOrganization:output_type -> organization.ListDtakologsByOrganizationResponse
+	352, // 547: organization.AuthService.AuthWithGoogle:output_type -> organization.AuthResponse
+	352, // 548: organization.AuthService.AuthWithLine:output_type -> organization.AuthResponse
+	352, // 549: organization.AuthService.RefreshToken:output_type -> organization.AuthResponse
+	357, // 550: organization.AuthService.GetAuthURL:output_type -> organization.GetAuthURLResponse
+	359, // 551: organization.AuthService.ValidateToken:output_type -> organization.ValidateTokenResponse
+	361, // 552: organization.AuthService.Logout:output_type -> organization.LogoutResponse
+	363, // 553: organization.AuthService.RevokeAllSessions:output_type -> organization.RevokeAllSessionsResponse
+	366, // 554: organization.AuthService.ListSessions:output_type -> organization.ListSessionsResponse
+	368, // 555: organization.AuthService.SwitchOrganization:output_type -> organization.SwitchOrganizationResponse
+	371, // 556: organization.AuthService.LinkAccount:output_type -> organization.LinkAccountResponse
+	373, // 557: organization.AuthService.UnlinkAccount:output_type -> organization.UnlinkAccountResponse
+	375, // 558: organization.AuthService.ListLinkedAccounts:output_type -> organization.ListLinkedAccountsResponse
+	378, // 559: organization.InvitationService.CreateInvitation:output_type -> organization.CreateInvitationResponse
+	380, // 560: organization.InvitationService.GetInvitation:output_type -> organization.GetInvitationResponse
+	382, // 561: organization.InvitationService.GetInvitationByToken:output_type -> organization.GetInvitationByTokenResponse
+	384, // 562: organization.InvitationService.AcceptInvitation:output_type -> organization.AcceptInvitationResponse
+	386, // 563: organization.InvitationService.CancelInvitation:output_type -> organization.CancelInvitationResponse
+	388, // 564: organization.InvitationService.ListInvitations:output_type -> organization.ListInvitationsResponse
+	390, // 565: organization.InvitationService.ResendInvitation:output_type -> organization.ResendInvitationResponse
+	393, // 566: organization.ETCMeisaiService.CreateETCMeisai:output_type -> organization.CreateETCMeisaiResponse
+	395, // 567: organization.ETCMeisaiService.GetETCMeisai:output_type -> organization.GetETCMeisaiResponse
+	397, // 568: organization.ETCMeisaiService.GetETCMeisaiByHash:output_type -> organization.GetETCMeisaiByHashResponse
+	399, // 569: organization.ETCMeisaiService.UpdateETCMeisai:output_type -> organization.UpdateETCMeisaiResponse
+	401, // 570: organization.ETCMeisaiService.DeleteETCMeisai:output_type -> organization.DeleteETCMeisaiResponse
+	403, // 571: organization.ETCMeisaiService.ListETCMeisai:output_type -> organization.ListETCMeisaiResponse
+	405, // 572: organization.ETCMeisaiService.BulkCreateETCMeisai:output_type -> organization.BulkCreateETCMeisaiResponse
+	408, // 573: organization.ApiKeyService.CreateApiKey:output_type -> organization.CreateApiKeyResponse
+	410, // 574: organization.ApiKeyService.GetApiKey:output_type -> organization.GetApiKeyResponse
+	412, // 575: organization.ApiKeyService.ListApiKeys:output_type -> organization.ListApiKeysResponse
+	414, // 576: organization.ApiKeyService.RevokeApiKey:output_type -> organization.RevokeApiKeyResponse
+	417, // 577: organization.AuditLogService.ListAuditEvents:output_type -> organization.ListAuditEventsResponse
+	385, // [385:578] is the sub-list for method output_type
+	192, // [192:385] is the sub-list for method input_type
+	192, // [192:192] is the sub-list for extension type_name
+	192, // [192:192] is the sub-list for extension extendee
+	0,   // [0:192] is the sub-list for field type_name
 }
 
 func init() { file_service_proto_init() }
@@ -36730,50 +39841,61 @@ func file_service_proto_init() {
 	file_service_proto_msgTypes[143].OneofWrappers = []any{}
 	file_service_proto_msgTypes[144].OneofWrappers = []any{}
 	file_service_proto_msgTypes[148].OneofWrappers = []any{}
-	file_service_proto_msgTypes[169].OneofWrappers = []any{}
-	file_service_proto_msgTypes[182].OneofWrappers = []any{}
-	file_service_proto_msgTypes[195].OneofWrappers = []any{}
-	file_service_proto_msgTypes[234].OneofWrappers = []any{}
+	file_service_proto_msgTypes[170].OneofWrappers = []any{}
+	file_service_proto_msgTypes[183].OneofWrappers = []any{}
+	file_service_proto_msgTypes[196].OneofWrappers = []any{}
 	file_service_proto_msgTypes[235].OneofWrappers = []any{}
-	file_service_proto_msgTypes[239].OneofWrappers = []any{}
-	file_service_proto_msgTypes[247].OneofWrappers = []any{}
+	file_service_proto_msgTypes[236].OneofWrappers = []any{}
+	file_service_proto_msgTypes[240].OneofWrappers = []any{}
 	file_service_proto_msgTypes[248].OneofWrappers = []any{}
-	file_service_proto_msgTypes[252].OneofWrappers = []any{}
-	file_service_proto_msgTypes[260].OneofWrappers = []any{}
+	file_service_proto_msgTypes[249].OneofWrappers = []any{}
+	file_service_proto_msgTypes[253].OneofWrappers = []any{}
 	file_service_proto_msgTypes[261].OneofWrappers = []any{}
-	file_service_proto_msgTypes[265].OneofWrappers = []any{}
-	file_service_proto_msgTypes[273].OneofWrappers = []any{}
+	file_service_proto_msgTypes[262].OneofWrappers = []any{}
+	file_service_proto_msgTypes[266].OneofWrappers = []any{}
 	file_service_proto_msgTypes[274].OneofWrappers = []any{}
-	file_service_proto_msgTypes[278].OneofWrappers = []any{}
-	file_service_proto_msgTypes[286].OneofWrappers = []any{}
+	file_service_proto_msgTypes[275].OneofWrappers = []any{}
+	file_service_proto_msgTypes[279].OneofWrappers = []any{}
 	file_service_proto_msgTypes[287].OneofWrappers = []any{}
-	file_service_proto_msgTypes[291].OneofWrappers = []any{}
-	file_service_proto_msgTypes[299].OneofWrappers = []any{}
+	file_service_proto_msgTypes[288].OneofWrappers = []any{}
+	file_service_proto_msgTypes[292].OneofWrappers = []any{}
 	file_service_proto_msgTypes[300].OneofWrappers = []any{}
-	file_service_proto_msgTypes[304].OneofWrappers = []any{}
-	file_service_proto_msgTypes[312].OneofWrappers = []any{}
+	file_service_proto_msgTypes[301].OneofWrappers = []any{}
+	file_service_proto_msgTypes[305].OneofWrappers = []any{}
 	file_service_proto_msgTypes[313].OneofWrappers = []any{}
-	file_service_proto_msgTypes[317].OneofWrappers = []any{}
-	file_service_proto_msgTypes[325].OneofWrappers = []any{}
+	file_service_proto_msgTypes[314].OneofWrappers = []any{}
+	file_service_proto_msgTypes[318].OneofWrappers = []any{}
 	file_service_proto_msgTypes[326].OneofWrappers = []any{}
-	file_service_proto_msgTypes[330].OneofWrappers = []any{}
-	file_service_proto_msgTypes[338].OneofWrappers = []any{}
+	file_service_proto_msgTypes[327].OneofWrappers = []any{}
+	file_service_proto_msgTypes[331].OneofWrappers = []any{}
 	file_service_proto_msgTypes[339].OneofWrappers = []any{}
-	file_service_proto_msgTypes[343].OneofWrappers = []any{}
-	file_service_proto_msgTypes[359].OneofWrappers = []any{}
-	file_service_proto_msgTypes[374].OneofWrappers = []any{}
-	file_service_proto_msgTypes[375].OneofWrappers = []any{}
-	file_service_proto_msgTypes[381].OneofWrappers = []any{}
-	file_service_proto_msgTypes[385].OneofWrappers = []any{}
+	file_service_proto_msgTypes[340].OneofWrappers = []any{}
+	file_service_proto_msgTypes[344].OneofWrappers = []any{}
+	file_service_proto_msgTypes[352].OneofWrappers = []any{}
+	file_service_proto_msgTypes[353].OneofWrappers = []any{}
+	file_service_proto_msgTypes[354].OneofWrappers = []any{}
+	file_service_proto_msgTypes[356].OneofWrappers = []any{}
+	file_service_proto_msgTypes[364].OneofWrappers = []any{}
+	file_service_proto_msgTypes[369].OneofWrappers = []any{}
+	file_service_proto_msgTypes[370].OneofWrappers = []any{}
+	file_service_proto_msgTypes[376].OneofWrappers = []any{}
+	file_service_proto_msgTypes[391].OneofWrappers = []any{}
+	file_service_proto_msgTypes[392].OneofWrappers = []any{}
+	file_service_proto_msgTypes[398].OneofWrappers = []any{}
+	file_service_proto_msgTypes[402].OneofWrappers = []any{}
+	file_service_proto_msgTypes[406].OneofWrappers = []any{}
+	file_service_proto_msgTypes[407].OneofWrappers = []any{}
+	file_service_proto_msgTypes[415].OneofWrappers = []any{}
+	file_service_proto_msgTypes[416].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_proto_rawDesc), len(file_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   389,
+			NumMessages:   418,
 			NumExtensions: 0,
-			NumServices:   30,
+			NumServices:   32,
 		},
 		GoTypes:           file_service_proto_goTypes,
 		DependencyIndexes: file_service_proto_depIdxs,
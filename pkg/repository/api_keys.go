@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+)
+
+var (
+	ErrAPIKeyNotFound = notFound("api key not found")
+)
+
+// APIKey represents the database model.
+// Only the SHA-256 of the key is stored; the key itself is shown once on creation.
+type APIKey struct {
+	ID             string     `db:"id,pk"`
+	OrganizationID string     `db:"organization_id"`
+	Name           string     `db:"name"`
+	Prefix         string     `db:"prefix"`   // leading characters of the key, safe to display
+	KeyHash        string     `db:"key_hash"` // SHA-256 of the full key
+	Scopes         []string   `db:"scopes"`   // services the key may call ("organization.DtakologsService")
+	Role           string     `db:"role"`     // 'member', 'viewer'
+	CreatedBy      string     `db:"created_by"`
+	ExpiresAt      *time.Time `db:"expires_at"` // nullable, never expires if nil
+	LastUsedAt     *time.Time `db:"last_used_at"`
+	RevokedAt      *time.Time `db:"revoked_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+}
+
+var apiKeyTable = NewTable[APIKey]("api_keys", ErrAPIKeyNotFound)
+
+// APIKeyRepository handles database operations for api_keys
+type APIKeyRepository struct {
+	db DB
+}
+
+// NewAPIKeyRepository creates a new repository
+func NewAPIKeyRepository(pool *pgxpool.Pool) *APIKeyRepository {
+	return &APIKeyRepository{db: pool}
+}
+
+// NewAPIKeyRepositoryWithDB creates a repository with custom DB interface (for testing)
+func NewAPIKeyRepositoryWithDB(db DB) *APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+// Create inserts a new api key
+func (r *APIKeyRepository) Create(ctx context.Context, organizationID, name, prefix, keyHash string, scopes []string, role, createdBy string, expiresAt *time.Time) (*APIKey, error) {
+	ctx = db.WithQueryLabel(ctx, "APIKeyRepository.Create")
+	return apiKeyTable.insert(ctx, r.db, &APIKey{
+		ID:             uuid.New().String(),
+		OrganizationID: organizationID,
+		Name:           name,
+		Prefix:         prefix,
+		KeyHash:        keyHash,
+		Scopes:         scopes,
+		Role:           role,
+		CreatedBy:      createdBy,
+		ExpiresAt:      expiresAt,
+		CreatedAt:      time.Now(),
+	})
+}
+
+// GetByID retrieves an api key by ID
+func (r *APIKeyRepository) GetByID(ctx context.Context, id string) (*APIKey, error) {
+	ctx = db.WithQueryLabel(ctx, "APIKeyRepository.GetByID")
+	return apiKeyTable.get(ctx, r.db, id)
+}
+
+// GetByKeyHash retrieves an api key by the hash of the key
+func (r *APIKeyRepository) GetByKeyHash(ctx context.Context, keyHash string) (*APIKey, error) {
+	ctx = db.WithQueryLabel(ctx, "APIKeyRepository.GetByKeyHash")
+	return apiKeyTable.find(ctx, r.db, "key_hash = $1", keyHash)
+}
+
+// ListByOrganization retrieves the api keys of an organization, newest first
+func (r *APIKeyRepository) ListByOrganization(ctx context.Context, organizationID string, includeRevoked bool) ([]*APIKey, error) {
+	ctx = db.WithQueryLabel(ctx, "APIKeyRepository.ListByOrganization")
+	return apiKeyTable.findAll(ctx, r.db, "organization_id = $1 AND ($2 OR revoked_at IS NULL)", "created_at DESC", organizationID, includeRevoked)
+}
+
+// Revoke marks an api key as revoked. Revoking an already revoked key is a no-op.
+func (r *APIKeyRepository) Revoke(ctx context.Context, id string) error {
+	ctx = db.WithQueryLabel(ctx, "APIKeyRepository.Revoke")
+	query := `
+		UPDATE api_keys
+		SET revoked_at = COALESCE(revoked_at, $2)
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query, id, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// TouchLastUsed records that an api key was used. Only rows last used before
+// usedAt minus interval are written, so busy keys do not update on every request.
+func (r *APIKeyRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time, interval time.Duration) error {
+	ctx = db.WithQueryLabel(ctx, "APIKeyRepository.TouchLastUsed")
+	query := `
+		UPDATE api_keys
+		SET last_used_at = $2
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < $3)
+	`
+
+	_, err := r.db.Exec(ctx, query, id, usedAt, usedAt.Add(-interval))
+	return err
+}
@@ -0,0 +1,93 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestIntegration_APIKeys_Lifecycle(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+
+	repo := NewAPIKeyRepository(pool)
+	orgRepo := NewOrganizationRepository(pool)
+	userRepo := NewAppUserRepository(pool)
+	ctx := context.Background()
+
+	// Setup: Create test organization and user
+	org, err := orgRepo.Create(ctx, "Test APIKey Org")
+	if err != nil {
+		t.Fatalf("Setup: failed to create organization: %v", err)
+	}
+	defer orgRepo.Delete(ctx, org.ID, "")
+
+	uniqueEmail := fmt.Sprintf("test-apikey-user-%d@example.com", time.Now().UnixNano())
+	testUser, err := userRepo.Create(ctx, &uniqueEmail, "Test APIKey User", nil, false)
+	if err != nil {
+		t.Fatalf("Setup: failed to create test user: %v", err)
+	}
+	defer userRepo.Delete(ctx, testUser.ID, "")
+	defer pool.Exec(ctx, "DELETE FROM api_keys WHERE organization_id = $1", org.ID)
+
+	// 1. Create
+	keyHash := "hash-" + uuid.New().String()
+	created, err := repo.Create(ctx, org.ID, "uploader", "ppk_abcdefgh", keyHash, []string{"organization.DtakologsService"}, "member", testUser.ID, nil)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if len(created.Scopes) != 1 || created.Scopes[0] != "organization.DtakologsService" {
+		t.Errorf("Create: Scopes = %v", created.Scopes)
+	}
+	fmt.Printf("✓ Create: ID=%s, Prefix=%s\n", created.ID, created.Prefix)
+
+	// 2. GetByKeyHash
+	fetched, err := repo.GetByKeyHash(ctx, keyHash)
+	if err != nil {
+		t.Fatalf("GetByKeyHash failed: %v", err)
+	}
+	if fetched.ID != created.ID {
+		t.Errorf("GetByKeyHash: ID = %s, want %s", fetched.ID, created.ID)
+	}
+
+	// 3. TouchLastUsed only writes once per interval
+	usedAt := time.Now().Truncate(time.Microsecond)
+	if err := repo.TouchLastUsed(ctx, created.ID, usedAt, time.Minute); err != nil {
+		t.Fatalf("TouchLastUsed failed: %v", err)
+	}
+	if err := repo.TouchLastUsed(ctx, created.ID, usedAt.Add(time.Second), time.Minute); err != nil {
+		t.Fatalf("TouchLastUsed failed: %v", err)
+	}
+	fetched, _ = repo.GetByID(ctx, created.ID)
+	if fetched.LastUsedAt == nil || !fetched.LastUsedAt.Equal(usedAt) {
+		t.Errorf("LastUsedAt = %v, want %v", fetched.LastUsedAt, usedAt)
+	}
+
+	// 4. Revoke hides the key from the default listing
+	if err := repo.Revoke(ctx, created.ID); err != nil {
+		t.Fatalf("Revoke failed: %v", err)
+	}
+	active, err := repo.ListByOrganization(ctx, org.ID, false)
+	if err != nil {
+		t.Fatalf("ListByOrganization failed: %v", err)
+	}
+	if len(active) != 0 {
+		t.Errorf("ListByOrganization: got %d active keys, want 0", len(active))
+	}
+	all, _ := repo.ListByOrganization(ctx, org.ID, true)
+	if len(all) != 1 || all[0].RevokedAt == nil {
+		t.Errorf("ListByOrganization(includeRevoked): got %+v, want the revoked key", all)
+	}
+	fmt.Println("✓ Revoke")
+
+	// 5. Unknown IDs
+	if err := repo.Revoke(ctx, uuid.New().String()); !errors.Is(err, ErrAPIKeyNotFound) {
+		t.Errorf("Revoke(unknown): err = %v, want ErrAPIKeyNotFound", err)
+	}
+}
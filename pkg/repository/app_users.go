@@ -2,30 +2,34 @@ package repository
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrAppUserNotFound = errors.New("app user not found")
+	ErrAppUserNotFound = notFound("app user not found")
 )
 
 // AppUser represents the database model
 type AppUser struct {
-	ID           string
-	Email        *string // nullable (LINE may not provide email)
-	DisplayName  string
-	AvatarURL    *string // nullable
-	IsSuperadmin bool
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	DeletedAt    *time.Time
+	ID           string     `db:"id,pk"`
+	Email        *string    `db:"email,readonly"` // nullable (LINE may not provide email)
+	DisplayName  string     `db:"display_name"`
+	AvatarURL    *string    `db:"avatar_url"` // nullable
+	IsSuperadmin bool       `db:"is_superadmin"`
+	CreatedAt    time.Time  `db:"created_at,readonly"`
+	UpdatedAt    time.Time  `db:"updated_at,modified"`
+	DeletedAt    *time.Time `db:"deleted_at,deleted,readonly"`
+	Etag         string     `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var appUserTable = NewTable[AppUser]("app_users", ErrAppUserNotFound)
+
 // AppUserRepository handles database operations for app_users
 type AppUserRepository struct {
 	db DB
@@ -43,145 +47,52 @@ func NewAppUserRepositoryWithDB(db DB) *AppUserRepository {
 
 // Create inserts a new app user
 func (r *AppUserRepository) Create(ctx context.Context, email *string, displayName string, avatarURL *string, isSuperadmin bool) (*AppUser, error) {
-	id := uuid.New().String()
+	ctx = db.WithQueryLabel(ctx, "AppUserRepository.Create")
 	now := time.Now()
-
-	query := `
-		INSERT INTO app_users (id, email, display_name, avatar_url, is_superadmin, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, email, display_name, avatar_url, is_superadmin, created_at, updated_at, deleted_at
-	`
-
-	var user AppUser
-	err := r.db.QueryRow(ctx, query, id, email, displayName, avatarURL, isSuperadmin, now, now).Scan(
-		&user.ID, &user.Email, &user.DisplayName, &user.AvatarURL, &user.IsSuperadmin, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &user, nil
+	return appUserTable.insert(ctx, r.db, &AppUser{
+		ID:           uuid.New().String(),
+		Email:        email,
+		DisplayName:  displayName,
+		AvatarURL:    avatarURL,
+		IsSuperadmin: isSuperadmin,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
 }
 
 // GetByID retrieves an app user by ID
 func (r *AppUserRepository) GetByID(ctx context.Context, id string) (*AppUser, error) {
-	query := `
-		SELECT id, email, display_name, avatar_url, is_superadmin, created_at, updated_at, deleted_at
-		FROM app_users
-		WHERE id = $1 AND deleted_at IS NULL
-	`
-
-	var user AppUser
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&user.ID, &user.Email, &user.DisplayName, &user.AvatarURL, &user.IsSuperadmin, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrAppUserNotFound
-		}
-		return nil, err
-	}
-
-	return &user, nil
+	ctx = db.WithQueryLabel(ctx, "AppUserRepository.GetByID")
+	return appUserTable.get(ctx, r.db, id)
 }
 
 // GetByEmail retrieves an app user by email
 func (r *AppUserRepository) GetByEmail(ctx context.Context, email string) (*AppUser, error) {
-	query := `
-		SELECT id, email, display_name, avatar_url, is_superadmin, created_at, updated_at, deleted_at
-		FROM app_users
-		WHERE email = $1 AND deleted_at IS NULL
-	`
-
-	var user AppUser
-	err := r.db.QueryRow(ctx, query, email).Scan(
-		&user.ID, &user.Email, &user.DisplayName, &user.AvatarURL, &user.IsSuperadmin, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrAppUserNotFound
-		}
-		return nil, err
-	}
-
-	return &user, nil
+	ctx = db.WithQueryLabel(ctx, "AppUserRepository.GetByEmail")
+	return appUserTable.find(ctx, r.db, "email = $1", email)
 }
 
-// Update modifies an existing app user
-func (r *AppUserRepository) Update(ctx context.Context, id, displayName string, avatarURL *string, isSuperadmin bool) (*AppUser, error) {
-	query := `
-		UPDATE app_users
-		SET display_name = $2, avatar_url = $3, is_superadmin = $4, updated_at = $5
-		WHERE id = $1 AND deleted_at IS NULL
-		RETURNING id, email, display_name, avatar_url, is_superadmin, created_at, updated_at, deleted_at
-	`
-
-	var user AppUser
-	err := r.db.QueryRow(ctx, query, id, displayName, avatarURL, isSuperadmin, time.Now()).Scan(
-		&user.ID, &user.Email, &user.DisplayName, &user.AvatarURL, &user.IsSuperadmin, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrAppUserNotFound
-		}
-		return nil, err
-	}
-
-	return &user, nil
+// Update modifies an existing app user. A non-empty etag must be the current one.
+func (r *AppUserRepository) Update(ctx context.Context, id, displayName string, avatarURL *string, isSuperadmin bool, etag string) (*AppUser, error) {
+	ctx = db.WithQueryLabel(ctx, "AppUserRepository.Update")
+	user := &AppUser{ID: id, DisplayName: displayName, AvatarURL: avatarURL, IsSuperadmin: isSuperadmin, UpdatedAt: time.Now()}
+	return appUserTable.update(ctx, r.db, user, nil, etag)
 }
 
-// Delete soft-deletes an app user
-func (r *AppUserRepository) Delete(ctx context.Context, id string) error {
-	query := `
-		UPDATE app_users
-		SET deleted_at = $2, updated_at = $2
-		WHERE id = $1 AND deleted_at IS NULL
-	`
-
-	result, err := r.db.Exec(ctx, query, id, time.Now())
-	if err != nil {
-		return err
-	}
-
-	if result.RowsAffected() == 0 {
-		return ErrAppUserNotFound
-	}
+// Delete soft-deletes an app user. A non-empty etag must be the current one.
+func (r *AppUserRepository) Delete(ctx context.Context, id, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "AppUserRepository.Delete")
+	return appUserTable.softDelete(ctx, r.db, time.Now(), etag, id)
+}
 
-	return nil
+// appUserOrder lists users newest first
+var appUserOrder = pagination.Order{
+	pagination.Desc("created_at", "timestamptz"),
+	pagination.Desc("id", "uuid"),
 }
 
 // List retrieves app users with pagination
-func (r *AppUserRepository) List(ctx context.Context, limit int, offset int) ([]*AppUser, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT id, email, display_name, avatar_url, is_superadmin, created_at, updated_at, deleted_at
-		FROM app_users
-		WHERE deleted_at IS NULL
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var users []*AppUser
-	for rows.Next() {
-		var user AppUser
-		err := rows.Scan(&user.ID, &user.Email, &user.DisplayName, &user.AvatarURL, &user.IsSuperadmin, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt)
-		if err != nil {
-			return nil, err
-		}
-		users = append(users, &user)
-	}
-
-	return users, rows.Err()
+func (r *AppUserRepository) List(ctx context.Context, page pagination.Page) ([]*AppUser, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "AppUserRepository.List")
+	return appUserTable.list(ctx, r.db, ListOptions{Page: page}, nil, appUserOrder, "")
 }
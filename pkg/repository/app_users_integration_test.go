@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_AppUsers_CRUD(t *testing.T) {
@@ -75,7 +77,7 @@ func TestIntegration_AppUsers_CRUD(t *testing.T) {
 		// 4. Update
 		t.Run("Update", func(t *testing.T) {
 			newAvatarURL := "https://example.com/new-avatar.png"
-			updated, err := repo.Update(ctx, user.ID, "Updated AppUser", &newAvatarURL, true)
+			updated, err := repo.Update(ctx, user.ID, "Updated AppUser", &newAvatarURL, true, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -96,7 +98,7 @@ func TestIntegration_AppUsers_CRUD(t *testing.T) {
 
 		// 5. List
 		t.Run("List", func(t *testing.T) {
-			users, err := repo.List(ctx, 10, 0)
+			users, _, err := repo.List(ctx, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("List failed: %v", err)
 			}
@@ -108,7 +110,7 @@ func TestIntegration_AppUsers_CRUD(t *testing.T) {
 
 		// 6. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, user.ID)
+			err := repo.Delete(ctx, user.ID, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
@@ -134,6 +136,6 @@ func TestIntegration_AppUsers_CRUD(t *testing.T) {
 		fmt.Printf("✓ CreateWithNilEmail: ID=%s, Email=nil, DisplayName=%s\n", user.ID, user.DisplayName)
 
 		// Cleanup
-		_ = repo.Delete(ctx, user.ID)
+		_ = repo.Delete(ctx, user.ID, "")
 	})
 }
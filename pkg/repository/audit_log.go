@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
+)
+
+var (
+	ErrAuditEventNotFound = notFound("audit event not found")
+)
+
+// AuditEvent represents the database model.
+// One row is written for every mutating RPC, whether it succeeded or not.
+type AuditEvent struct {
+	ID             string    `db:"id,pk"`
+	OrganizationID *string   `db:"organization_id"` // nullable for calls outside an organization
+	UserID         *string   `db:"user_id"`         // for API key calls, the user who created the key
+	APIKeyID       *string   `db:"api_key_id"`
+	Method         string    `db:"method"`      // full gRPC method, e.g. "/organization.CarInspectionService/UpdateCarInspection"
+	EntityType     string    `db:"entity_type"` // e.g. "CarInspection"
+	EntityID       *string   `db:"entity_id"`   // primary key of the affected row; "k1=v1&k2=v2" for composite keys
+	StatusCode     string    `db:"status_code"` // gRPC status code name, e.g. "OK", "PermissionDenied"
+	Request        []byte    `db:"request"`     // JSON of the request fields (for updates, the ones written), secrets redacted
+	CreatedAt      time.Time `db:"created_at"`
+}
+
+var auditLogTable = NewTable[AuditEvent]("audit_log", ErrAuditEventNotFound)
+
+// AuditEventListParams filters ListAuditEvents. Zero values do not filter.
+type AuditEventListParams struct {
+	OrganizationID string
+	UserID         string
+	Method         string
+	EntityType     string
+	EntityID       string
+	StartTime      *time.Time // inclusive
+	EndTime        *time.Time // exclusive
+	Page           pagination.Page
+}
+
+// AuditLogRepository handles database operations for audit_log
+type AuditLogRepository struct {
+	db DB
+}
+
+// NewAuditLogRepository creates a new repository
+func NewAuditLogRepository(pool *pgxpool.Pool) *AuditLogRepository {
+	return &AuditLogRepository{db: pool}
+}
+
+// NewAuditLogRepositoryWithDB creates a repository with custom DB interface (for testing)
+func NewAuditLogRepositoryWithDB(db DB) *AuditLogRepository {
+	return &AuditLogRepository{db: db}
+}
+
+// Create inserts an audit event
+func (r *AuditLogRepository) Create(ctx context.Context, e *AuditEvent) error {
+	ctx = db.WithQueryLabel(ctx, "AuditLogRepository.Create")
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now()
+	}
+
+	_, err := auditLogTable.insert(ctx, r.db, e)
+	return err
+}
+
+// auditLogOrder lists audit events newest first
+var auditLogOrder = pagination.Order{
+	pagination.Desc("created_at", "timestamptz"),
+	pagination.Desc("id", "uuid"),
+}
+
+// List retrieves a page of the audit events matching params, newest first
+func (r *AuditLogRepository) List(ctx context.Context, params AuditEventListParams) ([]*AuditEvent, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "AuditLogRepository.List")
+	var conds []string
+	var args []any
+	addFilter := func(clause string, value any) {
+		args = append(args, value)
+		conds = append(conds, fmt.Sprintf(clause, len(args)))
+	}
+	if params.OrganizationID != "" {
+		addFilter("organization_id = $%d", params.OrganizationID)
+	}
+	if params.UserID != "" {
+		addFilter("user_id = $%d", params.UserID)
+	}
+	if params.Method != "" {
+		addFilter("method = $%d", params.Method)
+	}
+	if params.EntityType != "" {
+		addFilter("entity_type = $%d", params.EntityType)
+	}
+	if params.EntityID != "" {
+		addFilter("entity_id = $%d", params.EntityID)
+	}
+	if params.StartTime != nil {
+		addFilter("created_at >= $%d", *params.StartTime)
+	}
+	if params.EndTime != nil {
+		addFilter("created_at < $%d", *params.EndTime)
+	}
+
+	return auditLogTable.list(ctx, r.db, ListOptions{Page: params.Page}, nil, auditLogOrder, strings.Join(conds, " AND "), args...)
+}
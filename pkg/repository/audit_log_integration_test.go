@@ -0,0 +1,153 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
+)
+
+func TestIntegration_AuditLog_CreateAndList(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+
+	repo := NewAuditLogRepository(pool)
+	orgRepo := NewOrganizationRepository(pool)
+	ctx := context.Background()
+
+	// Setup: Create test organization
+	org, err := orgRepo.Create(ctx, "Test AuditLog Org")
+	if err != nil {
+		t.Fatalf("Setup: failed to create organization: %v", err)
+	}
+	defer orgRepo.Delete(ctx, org.ID, "")
+	defer pool.Exec(ctx, "DELETE FROM audit_log WHERE organization_id = $1", org.ID)
+
+	start := time.Now().Add(-time.Second)
+	entityID := "inv-1"
+	for i, code := range []string{"OK", "PermissionDenied"} {
+		e := &AuditEvent{
+			OrganizationID: &org.ID,
+			Method:         "/organization.InvitationService/CreateInvitation",
+			EntityType:     "Invitation",
+			EntityID:       &entityID,
+			StatusCode:     code,
+			Request:        []byte(`{"email":"new@example.com"}`),
+			CreatedAt:      start.Add(time.Duration(i+1) * time.Millisecond),
+		}
+		if err := repo.Create(ctx, e); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+	fmt.Printf("✓ Create: 2 events\n")
+
+	// List: newest first, filtered by organization and entity
+	events, _, err := repo.List(ctx, AuditEventListParams{OrganizationID: org.ID, EntityType: "Invitation", EntityID: entityID, StartTime: &start})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("List: got %d events, want 2", len(events))
+	}
+	if events[0].StatusCode != "PermissionDenied" {
+		t.Errorf("List: first StatusCode = %s, want newest (PermissionDenied)", events[0].StatusCode)
+	}
+	if events[0].UserID != nil || events[0].APIKeyID != nil {
+		t.Errorf("List: UserID/APIKeyID = %v/%v, want nil", events[0].UserID, events[0].APIKeyID)
+	}
+	fmt.Printf("✓ List: %d events\n", len(events))
+
+	// List page by page
+	events, next, err := repo.List(ctx, AuditEventListParams{OrganizationID: org.ID, Page: pagination.Page{Size: 1}})
+	if err != nil {
+		t.Fatalf("List first page failed: %v", err)
+	}
+	if len(events) != 1 || events[0].StatusCode != "PermissionDenied" || next == nil {
+		t.Fatalf("List first page: got %v, next %v", events, next)
+	}
+	events, next, err = repo.List(ctx, AuditEventListParams{OrganizationID: org.ID, Page: pagination.Page{Size: 1, After: next}})
+	if err != nil {
+		t.Fatalf("List second page failed: %v", err)
+	}
+	if len(events) != 1 || events[0].StatusCode != "OK" || next != nil {
+		t.Errorf("List second page: got %v, next %v", events, next)
+	}
+	fmt.Printf("✓ List page by page\n")
+}
+
+// TestIntegration_AuditLog_PagesStableUnderInserts lists audit events page by page while
+// new events are written ahead of and behind the current position. Every event that
+// existed when the listing started must appear exactly once.
+func TestIntegration_AuditLog_PagesStableUnderInserts(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+
+	repo := NewAuditLogRepository(pool)
+	orgRepo := NewOrganizationRepository(pool)
+	ctx := context.Background()
+
+	org, err := orgRepo.Create(ctx, "Test AuditLog Paging Org")
+	if err != nil {
+		t.Fatalf("Setup: failed to create organization: %v", err)
+	}
+	defer orgRepo.Delete(ctx, org.ID, "")
+	defer pool.Exec(ctx, "DELETE FROM audit_log WHERE organization_id = $1", org.ID)
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	create := func(createdAt time.Time) string {
+		e := &AuditEvent{
+			OrganizationID: &org.ID,
+			Method:         "/organization.KudgfryService/DeleteKudgfry",
+			EntityType:     "Kudgfry",
+			StatusCode:     "OK",
+			CreatedAt:      createdAt,
+		}
+		if err := repo.Create(ctx, e); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		return e.ID
+	}
+
+	want := map[string]bool{}
+	for i := 1; i <= 7; i++ {
+		want[create(base.Add(time.Duration(i)*time.Minute))] = true
+	}
+	// events written in the same instant are ordered by id
+	want[create(base.Add(3*time.Minute))] = true
+
+	seen := map[string]int{}
+	var next pagination.Key
+	for page := 0; ; page++ {
+		events, key, err := repo.List(ctx, AuditEventListParams{OrganizationID: org.ID, Page: pagination.Page{Size: 2, After: next}})
+		if err != nil {
+			t.Fatalf("List page %d failed: %v", page, err)
+		}
+		for _, e := range events {
+			seen[e.ID]++
+		}
+		if key == nil {
+			break
+		}
+		next = key
+
+		// a newer event sorts before the listed pages (the shift offset paging repeats
+		// rows on), an older one after them
+		create(base.Add(time.Hour + time.Duration(page)*time.Second))
+		create(base.Add(-time.Hour - time.Duration(page)*time.Second))
+	}
+
+	for id := range want {
+		if seen[id] != 1 {
+			t.Errorf("event %s listed %d times, want 1", id, seen[id])
+		}
+	}
+	for id, n := range seen {
+		if n > 1 {
+			t.Errorf("event %s listed %d times", id, n)
+		}
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"context"
 	"fmt"
 	"testing"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_CamFileExe_CRUD(t *testing.T) {
@@ -21,7 +23,7 @@ func TestIntegration_CamFileExe_CRUD(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create test organization: %v", err)
 	}
-	defer orgRepo.Delete(ctx, org.ID)
+	defer orgRepo.Delete(ctx, org.ID, "")
 
 	// 1. Create
 	t.Run("Create", func(t *testing.T) {
@@ -64,7 +66,7 @@ func TestIntegration_CamFileExe_CRUD(t *testing.T) {
 
 		// 3. Update
 		t.Run("Update", func(t *testing.T) {
-			updated, err := repo.Update(ctx, "test-file.nc", "CAM-001", org.ID, 2)
+			updated, err := repo.Update(ctx, "test-file.nc", "CAM-001", org.ID, 2, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -89,7 +91,7 @@ func TestIntegration_CamFileExe_CRUD(t *testing.T) {
 
 		// 5. ListByOrganization
 		t.Run("ListByOrganization", func(t *testing.T) {
-			camFileExes, err := repo.ListByOrganization(ctx, org.ID, 10, 0)
+			camFileExes, _, err := repo.ListByOrganization(ctx, org.ID, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -101,7 +103,7 @@ func TestIntegration_CamFileExe_CRUD(t *testing.T) {
 
 		// 6. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, "test-file.nc", "CAM-001", org.ID)
+			err := repo.Delete(ctx, "test-file.nc", "CAM-001", org.ID, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
@@ -125,7 +127,7 @@ func TestIntegration_CamFileExe_CRUD(t *testing.T) {
 
 		// 8. Update on non-existent entry
 		t.Run("Update_NotFound", func(t *testing.T) {
-			_, err := repo.Update(ctx, "non-existent.nc", "CAM-999", org.ID, 5)
+			_, err := repo.Update(ctx, "non-existent.nc", "CAM-999", org.ID, 5, "")
 			if err != ErrCamFileExeNotFound {
 				t.Errorf("Update_NotFound: should return ErrCamFileExeNotFound, got %v", err)
 			}
@@ -134,7 +136,7 @@ func TestIntegration_CamFileExe_CRUD(t *testing.T) {
 
 		// 9. Delete on non-existent entry
 		t.Run("Delete_NotFound", func(t *testing.T) {
-			err := repo.Delete(ctx, "non-existent.nc", "CAM-999", org.ID)
+			err := repo.Delete(ctx, "non-existent.nc", "CAM-999", org.ID, "")
 			if err != ErrCamFileExeNotFound {
 				t.Errorf("Delete_NotFound: should return ErrCamFileExeNotFound, got %v", err)
 			}
@@ -142,8 +144,8 @@ func TestIntegration_CamFileExe_CRUD(t *testing.T) {
 		})
 
 		// Cleanup remaining test entries
-		repo.Delete(ctx, "test-file2.nc", "CAM-002", org.ID)
-		repo.Delete(ctx, "test-file3.nc", "CAM-003", org.ID)
+		repo.Delete(ctx, "test-file2.nc", "CAM-002", org.ID, "")
+		repo.Delete(ctx, "test-file3.nc", "CAM-003", org.ID, "")
 	})
 }
 
@@ -160,7 +162,7 @@ func TestIntegration_CamFileExe_ListPagination(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create test organization: %v", err)
 	}
-	defer orgRepo.Delete(ctx, org.ID)
+	defer orgRepo.Delete(ctx, org.ID, "")
 
 	// Create multiple entries for pagination test
 	for i := 1; i <= 15; i++ {
@@ -171,34 +173,42 @@ func TestIntegration_CamFileExe_ListPagination(t *testing.T) {
 	}
 	defer func() {
 		for i := 1; i <= 15; i++ {
-			repo.Delete(ctx, fmt.Sprintf("file-%02d.nc", i), fmt.Sprintf("CAM-%03d", i), org.ID)
+			repo.Delete(ctx, fmt.Sprintf("file-%02d.nc", i), fmt.Sprintf("CAM-%03d", i), org.ID, "")
 		}
 	}()
 
+	var next pagination.Key
 	t.Run("Pagination_FirstPage", func(t *testing.T) {
-		camFileExes, err := repo.ListByOrganization(ctx, org.ID, 10, 0)
+		camFileExes, key, err := repo.ListByOrganization(ctx, org.ID, pagination.Page{Size: 10})
 		if err != nil {
 			t.Fatalf("ListByOrganization failed: %v", err)
 		}
 		if len(camFileExes) != 10 {
 			t.Errorf("Pagination_FirstPage: expected 10 entries, got %d", len(camFileExes))
 		}
+		if key == nil {
+			t.Fatal("Pagination_FirstPage: expected a key of the next page")
+		}
+		next = key
 		fmt.Printf("✓ Pagination_FirstPage: returned %d entries\n", len(camFileExes))
 	})
 
 	t.Run("Pagination_SecondPage", func(t *testing.T) {
-		camFileExes, err := repo.ListByOrganization(ctx, org.ID, 10, 10)
+		camFileExes, key, err := repo.ListByOrganization(ctx, org.ID, pagination.Page{Size: 10, After: next})
 		if err != nil {
 			t.Fatalf("ListByOrganization failed: %v", err)
 		}
 		if len(camFileExes) != 5 {
 			t.Errorf("Pagination_SecondPage: expected 5 entries, got %d", len(camFileExes))
 		}
+		if key != nil {
+			t.Errorf("Pagination_SecondPage: expected the last page, got next key %v", key)
+		}
 		fmt.Printf("✓ Pagination_SecondPage: returned %d entries\n", len(camFileExes))
 	})
 
 	t.Run("Pagination_DefaultLimit", func(t *testing.T) {
-		camFileExes, err := repo.ListByOrganization(ctx, org.ID, 0, 0)
+		camFileExes, _, err := repo.ListByOrganization(ctx, org.ID, pagination.Page{})
 		if err != nil {
 			t.Fatalf("ListByOrganization failed: %v", err)
 		}
@@ -209,7 +219,7 @@ func TestIntegration_CamFileExe_ListPagination(t *testing.T) {
 	})
 
 	t.Run("Pagination_MaxLimit", func(t *testing.T) {
-		camFileExes, err := repo.ListByOrganization(ctx, org.ID, 200, 0)
+		camFileExes, _, err := repo.ListByOrganization(ctx, org.ID, pagination.Page{Size: 200})
 		if err != nil {
 			t.Fatalf("ListByOrganization failed: %v", err)
 		}
@@ -6,6 +6,8 @@ import (
 	"context"
 	"fmt"
 	"testing"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestCamFileExeStageIntegration_CRUD(t *testing.T) {
@@ -21,7 +23,7 @@ func TestCamFileExeStageIntegration_CRUD(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create test organization: %v", err)
 	}
-	defer orgRepo.Delete(ctx, testOrg.ID)
+	defer orgRepo.Delete(ctx, testOrg.ID, "")
 
 	// 1. Create
 	t.Run("Create", func(t *testing.T) {
@@ -57,7 +59,7 @@ func TestCamFileExeStageIntegration_CRUD(t *testing.T) {
 
 		// 3. Update
 		t.Run("Update", func(t *testing.T) {
-			updated, err := repo.Update(ctx, 1, testOrg.ID, "Updated Stage Name")
+			updated, err := repo.Update(ctx, 1, testOrg.ID, "Updated Stage Name", "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -82,7 +84,7 @@ func TestCamFileExeStageIntegration_CRUD(t *testing.T) {
 
 		// 5. ListByOrganization
 		t.Run("ListByOrganization", func(t *testing.T) {
-			stages, err := repo.ListByOrganization(ctx, testOrg.ID)
+			stages, _, err := repo.ListByOrganization(ctx, testOrg.ID, pagination.Page{})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -100,7 +102,7 @@ func TestCamFileExeStageIntegration_CRUD(t *testing.T) {
 
 		// 6. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, 1, testOrg.ID)
+			err := repo.Delete(ctx, 1, testOrg.ID, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
@@ -114,8 +116,8 @@ func TestCamFileExeStageIntegration_CRUD(t *testing.T) {
 		})
 
 		// Clean up remaining stages
-		repo.Delete(ctx, 2, testOrg.ID)
-		repo.Delete(ctx, 3, testOrg.ID)
+		repo.Delete(ctx, 2, testOrg.ID, "")
+		repo.Delete(ctx, 3, testOrg.ID, "")
 	})
 }
 
@@ -135,7 +137,7 @@ func TestCamFileExeStageIntegration_NotFound(t *testing.T) {
 	})
 
 	t.Run("Update_NotFound", func(t *testing.T) {
-		_, err := repo.Update(ctx, 999, "00000000-0000-0000-0000-000000000000", "Test")
+		_, err := repo.Update(ctx, 999, "00000000-0000-0000-0000-000000000000", "Test", "")
 		if err != ErrCamFileExeStageNotFound {
 			t.Errorf("Update: expected ErrCamFileExeStageNotFound, got %v", err)
 		}
@@ -143,7 +145,7 @@ func TestCamFileExeStageIntegration_NotFound(t *testing.T) {
 	})
 
 	t.Run("Delete_NotFound", func(t *testing.T) {
-		err := repo.Delete(ctx, 999, "00000000-0000-0000-0000-000000000000")
+		err := repo.Delete(ctx, 999, "00000000-0000-0000-0000-000000000000", "")
 		if err != ErrCamFileExeStageNotFound {
 			t.Errorf("Delete: expected ErrCamFileExeStageNotFound, got %v", err)
 		}
@@ -2,27 +2,31 @@ package repository
 
 import (
 	"context"
-	"errors"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrCamFileNotFound = errors.New("cam file not found")
+	ErrCamFileNotFound = notFound("cam file not found")
 )
 
 // CamFile represents the database model
 type CamFile struct {
-	Name           string
-	OrganizationID string
-	Date           string
-	Hour           string
-	Type           string
-	Cam            string
-	FlickrID       *string
+	Name           string  `db:"name,pk"`
+	OrganizationID string  `db:"organization_id,pk"`
+	Date           string  `db:"date"`
+	Hour           string  `db:"hour"`
+	Type           string  `db:"type"`
+	Cam            string  `db:"cam"`
+	FlickrID       *string `db:"flickr_id"`
+	Etag           string  `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var camFileTable = NewTable[CamFile]("cam_files", ErrCamFileNotFound)
+
 // CamFileRepository handles database operations for cam_files
 type CamFileRepository struct {
 	db DB
@@ -40,119 +44,63 @@ func NewCamFileRepositoryWithDB(db DB) *CamFileRepository {
 
 // Create inserts a new cam file
 func (r *CamFileRepository) Create(ctx context.Context, name, organizationID, date, hour, fileType, cam string, flickrID *string) (*CamFile, error) {
-	query := `
-		INSERT INTO cam_files (name, organization_id, date, hour, type, cam, flickr_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING name, organization_id, date, hour, type, cam, flickr_id
-	`
-
-	var file CamFile
-	err := r.db.QueryRow(ctx, query, name, organizationID, date, hour, fileType, cam, flickrID).Scan(
-		&file.Name, &file.OrganizationID, &file.Date, &file.Hour, &file.Type, &file.Cam, &file.FlickrID,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &file, nil
+	ctx = db.WithQueryLabel(ctx, "CamFileRepository.Create")
+	return camFileTable.insert(ctx, r.db, &CamFile{
+		Name:           name,
+		OrganizationID: organizationID,
+		Date:           date,
+		Hour:           hour,
+		Type:           fileType,
+		Cam:            cam,
+		FlickrID:       flickrID,
+	})
 }
 
 // GetByNameAndOrg retrieves a cam file by composite primary key (name, organization_id)
 func (r *CamFileRepository) GetByNameAndOrg(ctx context.Context, name, organizationID string) (*CamFile, error) {
-	query := `
-		SELECT name, organization_id, date, hour, type, cam, flickr_id
-		FROM cam_files
-		WHERE name = $1 AND organization_id = $2
-	`
-
-	var file CamFile
-	err := r.db.QueryRow(ctx, query, name, organizationID).Scan(
-		&file.Name, &file.OrganizationID, &file.Date, &file.Hour, &file.Type, &file.Cam, &file.FlickrID,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrCamFileNotFound
-		}
-		return nil, err
-	}
-
-	return &file, nil
+	ctx = db.WithQueryLabel(ctx, "CamFileRepository.GetByNameAndOrg")
+	return camFileTable.get(ctx, r.db, name, organizationID)
 }
 
-// Update modifies an existing cam file
-func (r *CamFileRepository) Update(ctx context.Context, name, organizationID, date, hour, fileType, cam string, flickrID *string) (*CamFile, error) {
-	query := `
-		UPDATE cam_files
-		SET date = $3, hour = $4, type = $5, cam = $6, flickr_id = $7
-		WHERE name = $1 AND organization_id = $2
-		RETURNING name, organization_id, date, hour, type, cam, flickr_id
-	`
-
-	var file CamFile
-	err := r.db.QueryRow(ctx, query, name, organizationID, date, hour, fileType, cam, flickrID).Scan(
-		&file.Name, &file.OrganizationID, &file.Date, &file.Hour, &file.Type, &file.Cam, &file.FlickrID,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrCamFileNotFound
-		}
-		return nil, err
-	}
-
-	return &file, nil
+// Update modifies an existing cam file. A non-empty etag must be the current one.
+func (r *CamFileRepository) Update(ctx context.Context, name, organizationID, date, hour, fileType, cam string, flickrID *string, etag string) (*CamFile, error) {
+	ctx = db.WithQueryLabel(ctx, "CamFileRepository.Update")
+	return camFileTable.update(ctx, r.db, &CamFile{
+		Name:           name,
+		OrganizationID: organizationID,
+		Date:           date,
+		Hour:           hour,
+		Type:           fileType,
+		Cam:            cam,
+		FlickrID:       flickrID,
+	}, nil, etag)
 }
 
-// Delete removes a cam file (hard delete)
-func (r *CamFileRepository) Delete(ctx context.Context, name, organizationID string) error {
-	query := `
-		DELETE FROM cam_files
-		WHERE name = $1 AND organization_id = $2
-	`
-
-	result, err := r.db.Exec(ctx, query, name, organizationID)
-	if err != nil {
-		return err
-	}
+// Delete removes a cam file (hard delete). A non-empty etag must be the current one.
+func (r *CamFileRepository) Delete(ctx context.Context, name, organizationID, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "CamFileRepository.Delete")
+	return camFileTable.delete(ctx, r.db, etag, name, organizationID)
+}
 
-	if result.RowsAffected() == 0 {
-		return ErrCamFileNotFound
-	}
+// camFileFields are the fields cam_files listings can be filtered and ordered by
+var camFileFields = ListFields{
+	"name":      {Column: "name", Type: "text"},
+	"date":      {Column: "date", Type: "text"},
+	"hour":      {Column: "hour", Type: "text"},
+	"type":      {Column: "type", Type: "text"},
+	"cam":       {Column: "cam", Type: "text"},
+	"flickr_id": {Column: "flickr_id", Type: "text", Nullable: true},
+}
 
-	return nil
+// camFileOrder lists the files of an organization newest first; name is unique in it
+var camFileOrder = pagination.Order{
+	pagination.Desc("date", "text"),
+	pagination.Desc("hour", "text"),
+	pagination.Asc("name", "text"),
 }
 
 // ListByOrganization retrieves cam files for a specific organization with pagination
-func (r *CamFileRepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*CamFile, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT name, organization_id, date, hour, type, cam, flickr_id
-		FROM cam_files
-		WHERE organization_id = $1
-		ORDER BY date DESC, hour DESC, name ASC
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var files []*CamFile
-	for rows.Next() {
-		var file CamFile
-		err := rows.Scan(&file.Name, &file.OrganizationID, &file.Date, &file.Hour, &file.Type, &file.Cam, &file.FlickrID)
-		if err != nil {
-			return nil, err
-		}
-		files = append(files, &file)
-	}
-
-	return files, rows.Err()
+func (r *CamFileRepository) ListByOrganization(ctx context.Context, organizationID string, opts ListOptions) ([]*CamFile, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CamFileRepository.ListByOrganization")
+	return camFileTable.list(ctx, r.db, opts, camFileFields, camFileOrder, "organization_id = $1", organizationID)
 }
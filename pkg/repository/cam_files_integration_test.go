@@ -6,6 +6,8 @@ import (
 	"context"
 	"fmt"
 	"testing"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_CamFiles_CRUD(t *testing.T) {
@@ -21,7 +23,7 @@ func TestIntegration_CamFiles_CRUD(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create test organization: %v", err)
 	}
-	defer orgRepo.Delete(ctx, testOrg.ID) // Clean up organization at the end
+	defer orgRepo.Delete(ctx, testOrg.ID, "") // Clean up organization at the end
 
 	// Test data
 	testName := "test_file_001.jpg"
@@ -87,7 +89,7 @@ func TestIntegration_CamFiles_CRUD(t *testing.T) {
 			updatedCam := "cam02"
 			updatedFlickrID := "flickr789012"
 
-			updated, err := repo.Update(ctx, testName, testOrg.ID, updatedDate, updatedHour, updatedType, updatedCam, &updatedFlickrID)
+			updated, err := repo.Update(ctx, testName, testOrg.ID, updatedDate, updatedHour, updatedType, updatedCam, &updatedFlickrID, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -125,7 +127,7 @@ func TestIntegration_CamFiles_CRUD(t *testing.T) {
 				t.Fatalf("Failed to create second file: %v", err)
 			}
 
-			files, err := repo.ListByOrganization(ctx, testOrg.ID, 10, 0)
+			files, _, err := repo.ListByOrganization(ctx, testOrg.ID, ListOptions{Page: pagination.Page{Size: 10}})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -135,12 +137,12 @@ func TestIntegration_CamFiles_CRUD(t *testing.T) {
 			fmt.Printf("✓ ListByOrganization: returned %d files for organization %s\n", len(files), testOrg.ID)
 
 			// Clean up second file
-			repo.Delete(ctx, file2Name, testOrg.ID)
+			repo.Delete(ctx, file2Name, testOrg.ID, "")
 		})
 
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, testName, testOrg.ID)
+			err := repo.Delete(ctx, testName, testOrg.ID, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
@@ -168,7 +170,7 @@ func TestIntegration_CamFiles_NullFlickrID(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create test organization: %v", err)
 	}
-	defer orgRepo.Delete(ctx, testOrg.ID)
+	defer orgRepo.Delete(ctx, testOrg.ID, "")
 
 	// Test with null FlickrID
 	testName := "test_file_null_flickr.jpg"
@@ -176,7 +178,7 @@ func TestIntegration_CamFiles_NullFlickrID(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Create with null FlickrID failed: %v", err)
 	}
-	defer repo.Delete(ctx, testName, testOrg.ID)
+	defer repo.Delete(ctx, testName, testOrg.ID, "")
 
 	if file.FlickrID != nil {
 		t.Errorf("FlickrID should be nil, got %v", file.FlickrID)
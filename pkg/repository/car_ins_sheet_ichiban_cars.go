@@ -2,27 +2,31 @@ package repository
 
 import (
 	"context"
-	"errors"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrCarInsSheetIchibanCarsNotFound = errors.New("car_ins_sheet_ichiban_cars not found")
+	ErrCarInsSheetIchibanCarsNotFound = notFound("car_ins_sheet_ichiban_cars not found")
 )
 
 // CarInsSheetIchibanCars represents the database model
 type CarInsSheetIchibanCars struct {
-	OrganizationID         string
-	IDCars                 *string
-	ElectCertMgNo          string
-	ElectCertPublishdateE  string
-	ElectCertPublishdateY  string
-	ElectCertPublishdateM  string
-	ElectCertPublishdateD  string
+	OrganizationID        string  `db:"organization_id,pk"`
+	IDCars                *string `db:"id_cars"`
+	ElectCertMgNo         string  `db:"ElectCertMgNo,pk"`
+	ElectCertPublishdateE string  `db:"ElectCertPublishdateE,pk"`
+	ElectCertPublishdateY string  `db:"ElectCertPublishdateY,pk"`
+	ElectCertPublishdateM string  `db:"ElectCertPublishdateM,pk"`
+	ElectCertPublishdateD string  `db:"ElectCertPublishdateD,pk"`
+	Etag                  string  `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var carInsSheetIchibanCarsTable = NewTable[CarInsSheetIchibanCars]("car_ins_sheet_ichiban_cars", ErrCarInsSheetIchibanCarsNotFound)
+
 // CarInsSheetIchibanCarsRepository handles database operations for car_ins_sheet_ichiban_cars
 type CarInsSheetIchibanCarsRepository struct {
 	db DB
@@ -40,154 +44,66 @@ func NewCarInsSheetIchibanCarsRepositoryWithDB(db DB) *CarInsSheetIchibanCarsRep
 
 // Create inserts a new car_ins_sheet_ichiban_cars record
 func (r *CarInsSheetIchibanCarsRepository) Create(ctx context.Context, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD string, idCars *string) (*CarInsSheetIchibanCars, error) {
-	query := `
-		INSERT INTO car_ins_sheet_ichiban_cars (organization_id, id_cars, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD")
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING organization_id, id_cars, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD"
-	`
-
-	var record CarInsSheetIchibanCars
-	err := r.db.QueryRow(ctx, query, organizationID, idCars, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD).Scan(
-		&record.OrganizationID, &record.IDCars, &record.ElectCertMgNo, &record.ElectCertPublishdateE, &record.ElectCertPublishdateY, &record.ElectCertPublishdateM, &record.ElectCertPublishdateD,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &record, nil
+	ctx = db.WithQueryLabel(ctx, "CarInsSheetIchibanCarsRepository.Create")
+	return carInsSheetIchibanCarsTable.insert(ctx, r.db, &CarInsSheetIchibanCars{
+		OrganizationID:        organizationID,
+		IDCars:                idCars,
+		ElectCertMgNo:         electCertMgNo,
+		ElectCertPublishdateE: electCertPublishdateE,
+		ElectCertPublishdateY: electCertPublishdateY,
+		ElectCertPublishdateM: electCertPublishdateM,
+		ElectCertPublishdateD: electCertPublishdateD,
+	})
 }
 
 // GetByPrimaryKey retrieves a car_ins_sheet_ichiban_cars record by composite primary key
 func (r *CarInsSheetIchibanCarsRepository) GetByPrimaryKey(ctx context.Context, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD string) (*CarInsSheetIchibanCars, error) {
-	query := `
-		SELECT organization_id, id_cars, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD"
-		FROM car_ins_sheet_ichiban_cars
-		WHERE organization_id = $1 AND "ElectCertMgNo" = $2 AND "ElectCertPublishdateE" = $3 AND "ElectCertPublishdateY" = $4 AND "ElectCertPublishdateM" = $5 AND "ElectCertPublishdateD" = $6
-	`
-
-	var record CarInsSheetIchibanCars
-	err := r.db.QueryRow(ctx, query, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD).Scan(
-		&record.OrganizationID, &record.IDCars, &record.ElectCertMgNo, &record.ElectCertPublishdateE, &record.ElectCertPublishdateY, &record.ElectCertPublishdateM, &record.ElectCertPublishdateD,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrCarInsSheetIchibanCarsNotFound
-		}
-		return nil, err
-	}
-
-	return &record, nil
+	ctx = db.WithQueryLabel(ctx, "CarInsSheetIchibanCarsRepository.GetByPrimaryKey")
+	return carInsSheetIchibanCarsTable.get(ctx, r.db, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD)
 }
 
-// Update modifies an existing car_ins_sheet_ichiban_cars record
-func (r *CarInsSheetIchibanCarsRepository) Update(ctx context.Context, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD string, idCars *string) (*CarInsSheetIchibanCars, error) {
-	query := `
-		UPDATE car_ins_sheet_ichiban_cars
-		SET id_cars = $7
-		WHERE organization_id = $1 AND "ElectCertMgNo" = $2 AND "ElectCertPublishdateE" = $3 AND "ElectCertPublishdateY" = $4 AND "ElectCertPublishdateM" = $5 AND "ElectCertPublishdateD" = $6
-		RETURNING organization_id, id_cars, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD"
-	`
-
-	var record CarInsSheetIchibanCars
-	err := r.db.QueryRow(ctx, query, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, idCars).Scan(
-		&record.OrganizationID, &record.IDCars, &record.ElectCertMgNo, &record.ElectCertPublishdateE, &record.ElectCertPublishdateY, &record.ElectCertPublishdateM, &record.ElectCertPublishdateD,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrCarInsSheetIchibanCarsNotFound
-		}
-		return nil, err
-	}
-
-	return &record, nil
+// Update modifies an existing car_ins_sheet_ichiban_cars record.
+// A non-empty etag must be the current one.
+func (r *CarInsSheetIchibanCarsRepository) Update(ctx context.Context, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD string, idCars *string, etag string) (*CarInsSheetIchibanCars, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInsSheetIchibanCarsRepository.Update")
+	return carInsSheetIchibanCarsTable.update(ctx, r.db, &CarInsSheetIchibanCars{
+		OrganizationID:        organizationID,
+		IDCars:                idCars,
+		ElectCertMgNo:         electCertMgNo,
+		ElectCertPublishdateE: electCertPublishdateE,
+		ElectCertPublishdateY: electCertPublishdateY,
+		ElectCertPublishdateM: electCertPublishdateM,
+		ElectCertPublishdateD: electCertPublishdateD,
+	}, nil, etag)
 }
 
-// Delete hard-deletes a car_ins_sheet_ichiban_cars record (no soft delete for this table)
-func (r *CarInsSheetIchibanCarsRepository) Delete(ctx context.Context, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD string) error {
-	query := `
-		DELETE FROM car_ins_sheet_ichiban_cars
-		WHERE organization_id = $1 AND "ElectCertMgNo" = $2 AND "ElectCertPublishdateE" = $3 AND "ElectCertPublishdateY" = $4 AND "ElectCertPublishdateM" = $5 AND "ElectCertPublishdateD" = $6
-	`
-
-	result, err := r.db.Exec(ctx, query, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD)
-	if err != nil {
-		return err
-	}
-
-	if result.RowsAffected() == 0 {
-		return ErrCarInsSheetIchibanCarsNotFound
-	}
+// Delete hard-deletes a car_ins_sheet_ichiban_cars record (no soft delete for this table).
+// A non-empty etag must be the current one.
+func (r *CarInsSheetIchibanCarsRepository) Delete(ctx context.Context, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "CarInsSheetIchibanCarsRepository.Delete")
+	return carInsSheetIchibanCarsTable.delete(ctx, r.db, etag, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD)
+}
 
-	return nil
+// carInsSheetIchibanCarsOrder lists the records of an organization by primary key
+var carInsSheetIchibanCarsOrder = pagination.Order{
+	pagination.Asc(`"ElectCertMgNo"`, "text"),
+	pagination.Asc(`"ElectCertPublishdateE"`, "text"),
+	pagination.Asc(`"ElectCertPublishdateY"`, "text"),
+	pagination.Asc(`"ElectCertPublishdateM"`, "text"),
+	pagination.Asc(`"ElectCertPublishdateD"`, "text"),
 }
 
+// carInsSheetIchibanCarsListOrder lists the records of all visible organizations
+var carInsSheetIchibanCarsListOrder = append(pagination.Order{pagination.Asc("organization_id", "text")}, carInsSheetIchibanCarsOrder...)
+
 // ListByOrganization retrieves car_ins_sheet_ichiban_cars records by organization with pagination
-func (r *CarInsSheetIchibanCarsRepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*CarInsSheetIchibanCars, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT organization_id, id_cars, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD"
-		FROM car_ins_sheet_ichiban_cars
-		WHERE organization_id = $1
-		ORDER BY "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD"
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var records []*CarInsSheetIchibanCars
-	for rows.Next() {
-		var record CarInsSheetIchibanCars
-		err := rows.Scan(&record.OrganizationID, &record.IDCars, &record.ElectCertMgNo, &record.ElectCertPublishdateE, &record.ElectCertPublishdateY, &record.ElectCertPublishdateM, &record.ElectCertPublishdateD)
-		if err != nil {
-			return nil, err
-		}
-		records = append(records, &record)
-	}
-
-	return records, rows.Err()
+func (r *CarInsSheetIchibanCarsRepository) ListByOrganization(ctx context.Context, organizationID string, page pagination.Page) ([]*CarInsSheetIchibanCars, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInsSheetIchibanCarsRepository.ListByOrganization")
+	return carInsSheetIchibanCarsTable.list(ctx, r.db, ListOptions{Page: page}, nil, carInsSheetIchibanCarsOrder, "organization_id = $1", organizationID)
 }
 
 // List retrieves all car_ins_sheet_ichiban_cars records with pagination
-func (r *CarInsSheetIchibanCarsRepository) List(ctx context.Context, limit int, offset int) ([]*CarInsSheetIchibanCars, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT organization_id, id_cars, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD"
-		FROM car_ins_sheet_ichiban_cars
-		ORDER BY organization_id, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD"
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var records []*CarInsSheetIchibanCars
-	for rows.Next() {
-		var record CarInsSheetIchibanCars
-		err := rows.Scan(&record.OrganizationID, &record.IDCars, &record.ElectCertMgNo, &record.ElectCertPublishdateE, &record.ElectCertPublishdateY, &record.ElectCertPublishdateM, &record.ElectCertPublishdateD)
-		if err != nil {
-			return nil, err
-		}
-		records = append(records, &record)
-	}
-
-	return records, rows.Err()
+func (r *CarInsSheetIchibanCarsRepository) List(ctx context.Context, page pagination.Page) ([]*CarInsSheetIchibanCars, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInsSheetIchibanCarsRepository.List")
+	return carInsSheetIchibanCarsTable.list(ctx, r.db, ListOptions{Page: page}, nil, carInsSheetIchibanCarsListOrder, "")
 }
@@ -2,27 +2,31 @@ package repository
 
 import (
 	"context"
-	"errors"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrCarInsSheetIchibanCarsANotFound = errors.New("car ins sheet ichiban cars a entry not found")
+	ErrCarInsSheetIchibanCarsANotFound = notFound("car_ins_sheet_ichiban_cars_a not found")
 )
 
 // CarInsSheetIchibanCarsA represents the database model
 type CarInsSheetIchibanCarsA struct {
-	OrganizationID string
-	IDCars         *string
-	ElectCertMgNo  string
-	GrantdateE     string
-	GrantdateY     string
-	GrantdateM     string
-	GrantdateD     string
+	OrganizationID string  `db:"organization_id,pk"`
+	IDCars         *string `db:"id_cars"`
+	ElectCertMgNo  string  `db:"ElectCertMgNo,pk"`
+	GrantdateE     string  `db:"GrantdateE,pk"`
+	GrantdateY     string  `db:"GrantdateY,pk"`
+	GrantdateM     string  `db:"GrantdateM,pk"`
+	GrantdateD     string  `db:"GrantdateD,pk"`
+	Etag           string  `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var carInsSheetIchibanCarsATable = NewTable[CarInsSheetIchibanCarsA]("car_ins_sheet_ichiban_cars_a", ErrCarInsSheetIchibanCarsANotFound)
+
 // CarInsSheetIchibanCarsARepository handles database operations for car_ins_sheet_ichiban_cars_a
 type CarInsSheetIchibanCarsARepository struct {
 	db DB
@@ -40,154 +44,64 @@ func NewCarInsSheetIchibanCarsARepositoryWithDB(db DB) *CarInsSheetIchibanCarsAR
 
 // Create inserts a new car_ins_sheet_ichiban_cars_a entry
 func (r *CarInsSheetIchibanCarsARepository) Create(ctx context.Context, organizationID, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD string, idCars *string) (*CarInsSheetIchibanCarsA, error) {
-	query := `
-		INSERT INTO car_ins_sheet_ichiban_cars_a (organization_id, id_cars, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD")
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING organization_id, id_cars, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD"
-	`
-
-	var entry CarInsSheetIchibanCarsA
-	err := r.db.QueryRow(ctx, query, organizationID, idCars, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD).Scan(
-		&entry.OrganizationID, &entry.IDCars, &entry.ElectCertMgNo, &entry.GrantdateE, &entry.GrantdateY, &entry.GrantdateM, &entry.GrantdateD,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &entry, nil
+	ctx = db.WithQueryLabel(ctx, "CarInsSheetIchibanCarsARepository.Create")
+	return carInsSheetIchibanCarsATable.insert(ctx, r.db, &CarInsSheetIchibanCarsA{
+		OrganizationID: organizationID,
+		IDCars:         idCars,
+		ElectCertMgNo:  electCertMgNo,
+		GrantdateE:     grantdateE,
+		GrantdateY:     grantdateY,
+		GrantdateM:     grantdateM,
+		GrantdateD:     grantdateD,
+	})
 }
 
 // GetByPrimaryKey retrieves an entry by composite primary key (organization_id, ElectCertMgNo, GrantdateE, GrantdateY, GrantdateM, GrantdateD)
 func (r *CarInsSheetIchibanCarsARepository) GetByPrimaryKey(ctx context.Context, organizationID, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD string) (*CarInsSheetIchibanCarsA, error) {
-	query := `
-		SELECT organization_id, id_cars, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD"
-		FROM car_ins_sheet_ichiban_cars_a
-		WHERE organization_id = $1 AND "ElectCertMgNo" = $2 AND "GrantdateE" = $3 AND "GrantdateY" = $4 AND "GrantdateM" = $5 AND "GrantdateD" = $6
-	`
-
-	var entry CarInsSheetIchibanCarsA
-	err := r.db.QueryRow(ctx, query, organizationID, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD).Scan(
-		&entry.OrganizationID, &entry.IDCars, &entry.ElectCertMgNo, &entry.GrantdateE, &entry.GrantdateY, &entry.GrantdateM, &entry.GrantdateD,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrCarInsSheetIchibanCarsANotFound
-		}
-		return nil, err
-	}
-
-	return &entry, nil
+	ctx = db.WithQueryLabel(ctx, "CarInsSheetIchibanCarsARepository.GetByPrimaryKey")
+	return carInsSheetIchibanCarsATable.get(ctx, r.db, organizationID, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD)
 }
 
-// Update modifies an existing entry
-func (r *CarInsSheetIchibanCarsARepository) Update(ctx context.Context, organizationID, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD string, idCars *string) (*CarInsSheetIchibanCarsA, error) {
-	query := `
-		UPDATE car_ins_sheet_ichiban_cars_a
-		SET id_cars = $7
-		WHERE organization_id = $1 AND "ElectCertMgNo" = $2 AND "GrantdateE" = $3 AND "GrantdateY" = $4 AND "GrantdateM" = $5 AND "GrantdateD" = $6
-		RETURNING organization_id, id_cars, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD"
-	`
-
-	var entry CarInsSheetIchibanCarsA
-	err := r.db.QueryRow(ctx, query, organizationID, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD, idCars).Scan(
-		&entry.OrganizationID, &entry.IDCars, &entry.ElectCertMgNo, &entry.GrantdateE, &entry.GrantdateY, &entry.GrantdateM, &entry.GrantdateD,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrCarInsSheetIchibanCarsANotFound
-		}
-		return nil, err
-	}
-
-	return &entry, nil
+// Update modifies an existing entry. A non-empty etag must be the current one.
+func (r *CarInsSheetIchibanCarsARepository) Update(ctx context.Context, organizationID, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD string, idCars *string, etag string) (*CarInsSheetIchibanCarsA, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInsSheetIchibanCarsARepository.Update")
+	return carInsSheetIchibanCarsATable.update(ctx, r.db, &CarInsSheetIchibanCarsA{
+		OrganizationID: organizationID,
+		IDCars:         idCars,
+		ElectCertMgNo:  electCertMgNo,
+		GrantdateE:     grantdateE,
+		GrantdateY:     grantdateY,
+		GrantdateM:     grantdateM,
+		GrantdateD:     grantdateD,
+	}, nil, etag)
 }
 
-// Delete removes an entry by composite primary key
-func (r *CarInsSheetIchibanCarsARepository) Delete(ctx context.Context, organizationID, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD string) error {
-	query := `
-		DELETE FROM car_ins_sheet_ichiban_cars_a
-		WHERE organization_id = $1 AND "ElectCertMgNo" = $2 AND "GrantdateE" = $3 AND "GrantdateY" = $4 AND "GrantdateM" = $5 AND "GrantdateD" = $6
-	`
-
-	result, err := r.db.Exec(ctx, query, organizationID, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD)
-	if err != nil {
-		return err
-	}
-
-	if result.RowsAffected() == 0 {
-		return ErrCarInsSheetIchibanCarsANotFound
-	}
+// Delete removes an entry by composite primary key. A non-empty etag must be the current one.
+func (r *CarInsSheetIchibanCarsARepository) Delete(ctx context.Context, organizationID, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "CarInsSheetIchibanCarsARepository.Delete")
+	return carInsSheetIchibanCarsATable.delete(ctx, r.db, etag, organizationID, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD)
+}
 
-	return nil
+// carInsSheetIchibanCarsAOrder lists the records of an organization by primary key
+var carInsSheetIchibanCarsAOrder = pagination.Order{
+	pagination.Asc(`"ElectCertMgNo"`, "text"),
+	pagination.Asc(`"GrantdateE"`, "text"),
+	pagination.Asc(`"GrantdateY"`, "text"),
+	pagination.Asc(`"GrantdateM"`, "text"),
+	pagination.Asc(`"GrantdateD"`, "text"),
 }
 
+// carInsSheetIchibanCarsAListOrder lists the records of all visible organizations
+var carInsSheetIchibanCarsAListOrder = append(pagination.Order{pagination.Asc("organization_id", "text")}, carInsSheetIchibanCarsAOrder...)
+
 // ListByOrganization retrieves entries for a specific organization with pagination
-func (r *CarInsSheetIchibanCarsARepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*CarInsSheetIchibanCarsA, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT organization_id, id_cars, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD"
-		FROM car_ins_sheet_ichiban_cars_a
-		WHERE organization_id = $1
-		ORDER BY "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD"
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var entries []*CarInsSheetIchibanCarsA
-	for rows.Next() {
-		var entry CarInsSheetIchibanCarsA
-		err := rows.Scan(&entry.OrganizationID, &entry.IDCars, &entry.ElectCertMgNo, &entry.GrantdateE, &entry.GrantdateY, &entry.GrantdateM, &entry.GrantdateD)
-		if err != nil {
-			return nil, err
-		}
-		entries = append(entries, &entry)
-	}
-
-	return entries, rows.Err()
+func (r *CarInsSheetIchibanCarsARepository) ListByOrganization(ctx context.Context, organizationID string, page pagination.Page) ([]*CarInsSheetIchibanCarsA, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInsSheetIchibanCarsARepository.ListByOrganization")
+	return carInsSheetIchibanCarsATable.list(ctx, r.db, ListOptions{Page: page}, nil, carInsSheetIchibanCarsAOrder, "organization_id = $1", organizationID)
 }
 
 // List retrieves all entries with pagination
-func (r *CarInsSheetIchibanCarsARepository) List(ctx context.Context, limit int, offset int) ([]*CarInsSheetIchibanCarsA, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT organization_id, id_cars, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD"
-		FROM car_ins_sheet_ichiban_cars_a
-		ORDER BY organization_id, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD"
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var entries []*CarInsSheetIchibanCarsA
-	for rows.Next() {
-		var entry CarInsSheetIchibanCarsA
-		err := rows.Scan(&entry.OrganizationID, &entry.IDCars, &entry.ElectCertMgNo, &entry.GrantdateE, &entry.GrantdateY, &entry.GrantdateM, &entry.GrantdateD)
-		if err != nil {
-			return nil, err
-		}
-		entries = append(entries, &entry)
-	}
-
-	return entries, rows.Err()
+func (r *CarInsSheetIchibanCarsARepository) List(ctx context.Context, page pagination.Page) ([]*CarInsSheetIchibanCarsA, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInsSheetIchibanCarsARepository.List")
+	return carInsSheetIchibanCarsATable.list(ctx, r.db, ListOptions{Page: page}, nil, carInsSheetIchibanCarsAListOrder, "")
 }
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_CarInsSheetIchibanCars_CRUD(t *testing.T) {
@@ -52,7 +54,7 @@ func TestIntegration_CarInsSheetIchibanCars_CRUD(t *testing.T) {
 		// 3. Update
 		t.Run("Update", func(t *testing.T) {
 			newIdCars := fmt.Sprintf("updated-car-%s", uuid.New().String()[:8])
-			updated, err := repo.Update(ctx, orgID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, &newIdCars)
+			updated, err := repo.Update(ctx, orgID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, &newIdCars, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -64,7 +66,7 @@ func TestIntegration_CarInsSheetIchibanCars_CRUD(t *testing.T) {
 
 		// 4. List
 		t.Run("ListByOrganization", func(t *testing.T) {
-			records, err := repo.ListByOrganization(ctx, orgID, 10, 0)
+			records, _, err := repo.ListByOrganization(ctx, orgID, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -76,7 +78,7 @@ func TestIntegration_CarInsSheetIchibanCars_CRUD(t *testing.T) {
 
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, orgID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD)
+			err := repo.Delete(ctx, orgID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
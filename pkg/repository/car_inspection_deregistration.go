@@ -2,29 +2,33 @@ package repository
 
 import (
 	"context"
-	"errors"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrCarInspectionDeregistrationNotFound = errors.New("car inspection deregistration not found")
+	ErrCarInspectionDeregistrationNotFound = notFound("car inspection deregistration not found")
 )
 
 // CarInspectionDeregistration represents the database model
 type CarInspectionDeregistration struct {
-	OrganizationID                             string
-	CarID                                      string
-	TwodimensionCodeInfoCarNo                  string
-	CarNo                                      string
-	ValidPeriodExpirDateE                      string
-	ValidPeriodExpirDateY                      string
-	ValidPeriodExpirDateM                      string
-	ValidPeriodExpirDateD                      string
-	TwodimensionCodeInfoValidPeriodExpirDate   string
+	OrganizationID                           string `db:"organization_id,pk"`
+	CarID                                    string `db:"CarId,pk"`
+	TwodimensionCodeInfoCarNo                string `db:"TwodimensionCodeInfoCarNo"`
+	CarNo                                    string `db:"CarNo"`
+	ValidPeriodExpirDateE                    string `db:"ValidPeriodExpirdateE"`
+	ValidPeriodExpirDateY                    string `db:"ValidPeriodExpirdateY"`
+	ValidPeriodExpirDateM                    string `db:"ValidPeriodExpirdateM"`
+	ValidPeriodExpirDateD                    string `db:"ValidPeriodExpirdateD"`
+	TwodimensionCodeInfoValidPeriodExpirDate string `db:"TwodimensionCodeInfoValidPeriodExpirdate,pk"`
+	Etag                                     string `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var carInspectionDeregistrationTable = NewTable[CarInspectionDeregistration]("car_inspection_deregistration", ErrCarInspectionDeregistrationNotFound)
+
 // CarInspectionDeregistrationRepository handles database operations for car_inspection_deregistration
 type CarInspectionDeregistrationRepository struct {
 	db DB
@@ -42,154 +46,67 @@ func NewCarInspectionDeregistrationRepositoryWithDB(db DB) *CarInspectionDeregis
 
 // Create inserts a new car inspection deregistration record
 func (r *CarInspectionDeregistrationRepository) Create(ctx context.Context, organizationID, carID, twodimensionCodeInfoCarNo, carNo, validPeriodExpirDateE, validPeriodExpirDateY, validPeriodExpirDateM, validPeriodExpirDateD, twodimensionCodeInfoValidPeriodExpirDate string) (*CarInspectionDeregistration, error) {
-	query := `
-		INSERT INTO car_inspection_deregistration (organization_id, "CarId", "TwodimensionCodeInfoCarNo", "CarNo", "ValidPeriodExpirdateE", "ValidPeriodExpirdateY", "ValidPeriodExpirdateM", "ValidPeriodExpirdateD", "TwodimensionCodeInfoValidPeriodExpirdate")
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-		RETURNING organization_id, "CarId", "TwodimensionCodeInfoCarNo", "CarNo", "ValidPeriodExpirdateE", "ValidPeriodExpirdateY", "ValidPeriodExpirdateM", "ValidPeriodExpirdateD", "TwodimensionCodeInfoValidPeriodExpirdate"
-	`
-
-	var record CarInspectionDeregistration
-	err := r.db.QueryRow(ctx, query, organizationID, carID, twodimensionCodeInfoCarNo, carNo, validPeriodExpirDateE, validPeriodExpirDateY, validPeriodExpirDateM, validPeriodExpirDateD, twodimensionCodeInfoValidPeriodExpirDate).Scan(
-		&record.OrganizationID, &record.CarID, &record.TwodimensionCodeInfoCarNo, &record.CarNo, &record.ValidPeriodExpirDateE, &record.ValidPeriodExpirDateY, &record.ValidPeriodExpirDateM, &record.ValidPeriodExpirDateD, &record.TwodimensionCodeInfoValidPeriodExpirDate,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &record, nil
+	ctx = db.WithQueryLabel(ctx, "CarInspectionDeregistrationRepository.Create")
+	return carInspectionDeregistrationTable.insert(ctx, r.db, &CarInspectionDeregistration{
+		OrganizationID:                           organizationID,
+		CarID:                                    carID,
+		TwodimensionCodeInfoCarNo:                twodimensionCodeInfoCarNo,
+		CarNo:                                    carNo,
+		ValidPeriodExpirDateE:                    validPeriodExpirDateE,
+		ValidPeriodExpirDateY:                    validPeriodExpirDateY,
+		ValidPeriodExpirDateM:                    validPeriodExpirDateM,
+		ValidPeriodExpirDateD:                    validPeriodExpirDateD,
+		TwodimensionCodeInfoValidPeriodExpirDate: twodimensionCodeInfoValidPeriodExpirDate,
+	})
 }
 
 // GetByPrimaryKey retrieves a car inspection deregistration record by composite primary key (organization_id, CarId, TwodimensionCodeInfoValidPeriodExpirdate)
 func (r *CarInspectionDeregistrationRepository) GetByPrimaryKey(ctx context.Context, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate string) (*CarInspectionDeregistration, error) {
-	query := `
-		SELECT organization_id, "CarId", "TwodimensionCodeInfoCarNo", "CarNo", "ValidPeriodExpirdateE", "ValidPeriodExpirdateY", "ValidPeriodExpirdateM", "ValidPeriodExpirdateD", "TwodimensionCodeInfoValidPeriodExpirdate"
-		FROM car_inspection_deregistration
-		WHERE organization_id = $1 AND "CarId" = $2 AND "TwodimensionCodeInfoValidPeriodExpirdate" = $3
-	`
-
-	var record CarInspectionDeregistration
-	err := r.db.QueryRow(ctx, query, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate).Scan(
-		&record.OrganizationID, &record.CarID, &record.TwodimensionCodeInfoCarNo, &record.CarNo, &record.ValidPeriodExpirDateE, &record.ValidPeriodExpirDateY, &record.ValidPeriodExpirDateM, &record.ValidPeriodExpirDateD, &record.TwodimensionCodeInfoValidPeriodExpirDate,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrCarInspectionDeregistrationNotFound
-		}
-		return nil, err
-	}
-
-	return &record, nil
+	ctx = db.WithQueryLabel(ctx, "CarInspectionDeregistrationRepository.GetByPrimaryKey")
+	return carInspectionDeregistrationTable.get(ctx, r.db, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate)
 }
 
-// Update modifies an existing car inspection deregistration record
-func (r *CarInspectionDeregistrationRepository) Update(ctx context.Context, organizationID, carID, twodimensionCodeInfoCarNo, carNo, validPeriodExpirDateE, validPeriodExpirDateY, validPeriodExpirDateM, validPeriodExpirDateD, twodimensionCodeInfoValidPeriodExpirDate string) (*CarInspectionDeregistration, error) {
-	query := `
-		UPDATE car_inspection_deregistration
-		SET "TwodimensionCodeInfoCarNo" = $3, "CarNo" = $4, "ValidPeriodExpirdateE" = $5, "ValidPeriodExpirdateY" = $6, "ValidPeriodExpirdateM" = $7, "ValidPeriodExpirdateD" = $8
-		WHERE organization_id = $1 AND "CarId" = $2 AND "TwodimensionCodeInfoValidPeriodExpirdate" = $9
-		RETURNING organization_id, "CarId", "TwodimensionCodeInfoCarNo", "CarNo", "ValidPeriodExpirdateE", "ValidPeriodExpirdateY", "ValidPeriodExpirdateM", "ValidPeriodExpirdateD", "TwodimensionCodeInfoValidPeriodExpirdate"
-	`
-
-	var record CarInspectionDeregistration
-	err := r.db.QueryRow(ctx, query, organizationID, carID, twodimensionCodeInfoCarNo, carNo, validPeriodExpirDateE, validPeriodExpirDateY, validPeriodExpirDateM, validPeriodExpirDateD, twodimensionCodeInfoValidPeriodExpirDate).Scan(
-		&record.OrganizationID, &record.CarID, &record.TwodimensionCodeInfoCarNo, &record.CarNo, &record.ValidPeriodExpirDateE, &record.ValidPeriodExpirDateY, &record.ValidPeriodExpirDateM, &record.ValidPeriodExpirDateD, &record.TwodimensionCodeInfoValidPeriodExpirDate,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrCarInspectionDeregistrationNotFound
-		}
-		return nil, err
-	}
-
-	return &record, nil
+// Update modifies an existing car inspection deregistration record.
+// A non-empty etag must be the current one.
+func (r *CarInspectionDeregistrationRepository) Update(ctx context.Context, organizationID, carID, twodimensionCodeInfoCarNo, carNo, validPeriodExpirDateE, validPeriodExpirDateY, validPeriodExpirDateM, validPeriodExpirDateD, twodimensionCodeInfoValidPeriodExpirDate, etag string) (*CarInspectionDeregistration, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionDeregistrationRepository.Update")
+	return carInspectionDeregistrationTable.update(ctx, r.db, &CarInspectionDeregistration{
+		OrganizationID:                           organizationID,
+		CarID:                                    carID,
+		TwodimensionCodeInfoCarNo:                twodimensionCodeInfoCarNo,
+		CarNo:                                    carNo,
+		ValidPeriodExpirDateE:                    validPeriodExpirDateE,
+		ValidPeriodExpirDateY:                    validPeriodExpirDateY,
+		ValidPeriodExpirDateM:                    validPeriodExpirDateM,
+		ValidPeriodExpirDateD:                    validPeriodExpirDateD,
+		TwodimensionCodeInfoValidPeriodExpirDate: twodimensionCodeInfoValidPeriodExpirDate,
+	}, nil, etag)
 }
 
-// Delete hard-deletes a car inspection deregistration record (no soft delete for this table)
-func (r *CarInspectionDeregistrationRepository) Delete(ctx context.Context, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate string) error {
-	query := `
-		DELETE FROM car_inspection_deregistration
-		WHERE organization_id = $1 AND "CarId" = $2 AND "TwodimensionCodeInfoValidPeriodExpirdate" = $3
-	`
-
-	result, err := r.db.Exec(ctx, query, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate)
-	if err != nil {
-		return err
-	}
-
-	if result.RowsAffected() == 0 {
-		return ErrCarInspectionDeregistrationNotFound
-	}
+// Delete hard-deletes a car inspection deregistration record (no soft delete for this table).
+// A non-empty etag must be the current one.
+func (r *CarInspectionDeregistrationRepository) Delete(ctx context.Context, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionDeregistrationRepository.Delete")
+	return carInspectionDeregistrationTable.delete(ctx, r.db, etag, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate)
+}
 
-	return nil
+// carInspectionDeregistrationOrder lists the records of an organization by primary key
+var carInspectionDeregistrationOrder = pagination.Order{
+	pagination.Asc(`"CarId"`, "text"),
+	pagination.Asc(`"TwodimensionCodeInfoValidPeriodExpirdate"`, "text"),
 }
 
+// carInspectionDeregistrationListOrder lists the records of all visible organizations
+var carInspectionDeregistrationListOrder = append(pagination.Order{pagination.Asc("organization_id", "text")}, carInspectionDeregistrationOrder...)
+
 // ListByOrganization retrieves car inspection deregistration records by organization with pagination
-func (r *CarInspectionDeregistrationRepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*CarInspectionDeregistration, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT organization_id, "CarId", "TwodimensionCodeInfoCarNo", "CarNo", "ValidPeriodExpirdateE", "ValidPeriodExpirdateY", "ValidPeriodExpirdateM", "ValidPeriodExpirdateD", "TwodimensionCodeInfoValidPeriodExpirdate"
-		FROM car_inspection_deregistration
-		WHERE organization_id = $1
-		ORDER BY "CarId", "TwodimensionCodeInfoValidPeriodExpirdate"
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var records []*CarInspectionDeregistration
-	for rows.Next() {
-		var record CarInspectionDeregistration
-		err := rows.Scan(&record.OrganizationID, &record.CarID, &record.TwodimensionCodeInfoCarNo, &record.CarNo, &record.ValidPeriodExpirDateE, &record.ValidPeriodExpirDateY, &record.ValidPeriodExpirDateM, &record.ValidPeriodExpirDateD, &record.TwodimensionCodeInfoValidPeriodExpirDate)
-		if err != nil {
-			return nil, err
-		}
-		records = append(records, &record)
-	}
-
-	return records, rows.Err()
+func (r *CarInspectionDeregistrationRepository) ListByOrganization(ctx context.Context, organizationID string, page pagination.Page) ([]*CarInspectionDeregistration, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionDeregistrationRepository.ListByOrganization")
+	return carInspectionDeregistrationTable.list(ctx, r.db, ListOptions{Page: page}, nil, carInspectionDeregistrationOrder, "organization_id = $1", organizationID)
 }
 
 // List retrieves all car inspection deregistration records with pagination
-func (r *CarInspectionDeregistrationRepository) List(ctx context.Context, limit int, offset int) ([]*CarInspectionDeregistration, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT organization_id, "CarId", "TwodimensionCodeInfoCarNo", "CarNo", "ValidPeriodExpirdateE", "ValidPeriodExpirdateY", "ValidPeriodExpirdateM", "ValidPeriodExpirdateD", "TwodimensionCodeInfoValidPeriodExpirdate"
-		FROM car_inspection_deregistration
-		ORDER BY organization_id, "CarId", "TwodimensionCodeInfoValidPeriodExpirdate"
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var records []*CarInspectionDeregistration
-	for rows.Next() {
-		var record CarInspectionDeregistration
-		err := rows.Scan(&record.OrganizationID, &record.CarID, &record.TwodimensionCodeInfoCarNo, &record.CarNo, &record.ValidPeriodExpirDateE, &record.ValidPeriodExpirDateY, &record.ValidPeriodExpirDateM, &record.ValidPeriodExpirDateD, &record.TwodimensionCodeInfoValidPeriodExpirDate)
-		if err != nil {
-			return nil, err
-		}
-		records = append(records, &record)
-	}
-
-	return records, rows.Err()
+func (r *CarInspectionDeregistrationRepository) List(ctx context.Context, page pagination.Page) ([]*CarInspectionDeregistration, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionDeregistrationRepository.List")
+	return carInspectionDeregistrationTable.list(ctx, r.db, ListOptions{Page: page}, nil, carInspectionDeregistrationListOrder, "")
 }
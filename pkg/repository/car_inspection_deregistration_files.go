@@ -2,24 +2,27 @@ package repository
 
 import (
 	"context"
-	"errors"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrCarInspectionDeregistrationFilesNotFound = errors.New("car inspection deregistration files not found")
+	ErrCarInspectionDeregistrationFilesNotFound = notFound("car inspection deregistration file not found")
 )
 
 // CarInspectionDeregistrationFiles represents the database model
 type CarInspectionDeregistrationFiles struct {
-	OrganizationID                              string
-	CarID                                       string
-	TwodimensionCodeInfoValidPeriodExpirDate    string
-	FileUUID                                    string
+	OrganizationID                           string `db:"organization_id,pk"`
+	CarID                                    string `db:"CarId,pk"`
+	TwodimensionCodeInfoValidPeriodExpirDate string `db:"TwodimensionCodeInfoValidPeriodExpirdate,pk"`
+	FileUUID                                 string `db:"fileUuid,pk"`
 }
 
+var carInspectionDeregistrationFilesTable = NewTable[CarInspectionDeregistrationFiles]("car_inspection_deregistration_files", ErrCarInspectionDeregistrationFilesNotFound)
+
 // CarInspectionDeregistrationFilesRepository handles database operations for car_inspection_deregistration_files
 type CarInspectionDeregistrationFilesRepository struct {
 	db DB
@@ -37,167 +40,58 @@ func NewCarInspectionDeregistrationFilesRepositoryWithDB(db DB) *CarInspectionDe
 
 // Create inserts a new car inspection deregistration file
 func (r *CarInspectionDeregistrationFilesRepository) Create(ctx context.Context, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate, fileUUID string) (*CarInspectionDeregistrationFiles, error) {
-	query := `
-		INSERT INTO car_inspection_deregistration_files (organization_id, "CarId", "TwodimensionCodeInfoValidPeriodExpirdate", "fileUuid")
-		VALUES ($1, $2, $3, $4)
-		RETURNING organization_id, "CarId", "TwodimensionCodeInfoValidPeriodExpirdate", "fileUuid"
-	`
-
-	var cidf CarInspectionDeregistrationFiles
-	err := r.db.QueryRow(ctx, query, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate, fileUUID).Scan(
-		&cidf.OrganizationID, &cidf.CarID, &cidf.TwodimensionCodeInfoValidPeriodExpirDate, &cidf.FileUUID,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &cidf, nil
+	ctx = db.WithQueryLabel(ctx, "CarInspectionDeregistrationFilesRepository.Create")
+	return carInspectionDeregistrationFilesTable.insert(ctx, r.db, &CarInspectionDeregistrationFiles{
+		OrganizationID:                           organizationID,
+		CarID:                                    carID,
+		TwodimensionCodeInfoValidPeriodExpirDate: twodimensionCodeInfoValidPeriodExpirDate,
+		FileUUID:                                 fileUUID,
+	})
 }
 
 // GetByPrimaryKey retrieves a car inspection deregistration file by composite primary key
 func (r *CarInspectionDeregistrationFilesRepository) GetByPrimaryKey(ctx context.Context, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate, fileUUID string) (*CarInspectionDeregistrationFiles, error) {
-	query := `
-		SELECT organization_id, "CarId", "TwodimensionCodeInfoValidPeriodExpirdate", "fileUuid"
-		FROM car_inspection_deregistration_files
-		WHERE organization_id = $1 AND "CarId" = $2 AND "TwodimensionCodeInfoValidPeriodExpirdate" = $3 AND "fileUuid" = $4
-	`
-
-	var cidf CarInspectionDeregistrationFiles
-	err := r.db.QueryRow(ctx, query, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate, fileUUID).Scan(
-		&cidf.OrganizationID, &cidf.CarID, &cidf.TwodimensionCodeInfoValidPeriodExpirDate, &cidf.FileUUID,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrCarInspectionDeregistrationFilesNotFound
-		}
-		return nil, err
-	}
-
-	return &cidf, nil
+	ctx = db.WithQueryLabel(ctx, "CarInspectionDeregistrationFilesRepository.GetByPrimaryKey")
+	return carInspectionDeregistrationFilesTable.get(ctx, r.db, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate, fileUUID)
 }
 
 // Delete removes a car inspection deregistration file
 func (r *CarInspectionDeregistrationFilesRepository) Delete(ctx context.Context, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate, fileUUID string) error {
-	query := `
-		DELETE FROM car_inspection_deregistration_files
-		WHERE organization_id = $1 AND "CarId" = $2 AND "TwodimensionCodeInfoValidPeriodExpirdate" = $3 AND "fileUuid" = $4
-	`
+	ctx = db.WithQueryLabel(ctx, "CarInspectionDeregistrationFilesRepository.Delete")
+	return carInspectionDeregistrationFilesTable.delete(ctx, r.db, "", organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate, fileUUID)
+}
 
-	result, err := r.db.Exec(ctx, query, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate, fileUUID)
-	if err != nil {
-		return err
-	}
+// carInspectionDeregistrationFilesOrder lists the files of an organization by primary key
+var carInspectionDeregistrationFilesOrder = pagination.Order{
+	pagination.Asc(`"CarId"`, "text"),
+	pagination.Asc(`"TwodimensionCodeInfoValidPeriodExpirdate"`, "text"),
+	pagination.Asc(`"fileUuid"`, "text"),
+}
 
-	if result.RowsAffected() == 0 {
-		return ErrCarInspectionDeregistrationFilesNotFound
-	}
+// carInspectionDeregistrationFilesListOrder lists the files of all visible organizations
+var carInspectionDeregistrationFilesListOrder = append(pagination.Order{pagination.Asc("organization_id", "text")}, carInspectionDeregistrationFilesOrder...)
 
-	return nil
+// carInspectionDeregistrationFilesByDeregistrationOrder lists the files of one deregistration
+var carInspectionDeregistrationFilesByDeregistrationOrder = pagination.Order{
+	pagination.Asc(`"fileUuid"`, "text"),
 }
 
 // ListByOrganization retrieves all car inspection deregistration files for an organization with pagination
-func (r *CarInspectionDeregistrationFilesRepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*CarInspectionDeregistrationFiles, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT organization_id, "CarId", "TwodimensionCodeInfoValidPeriodExpirdate", "fileUuid"
-		FROM car_inspection_deregistration_files
-		WHERE organization_id = $1
-		ORDER BY "CarId", "TwodimensionCodeInfoValidPeriodExpirdate", "fileUuid"
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var files []*CarInspectionDeregistrationFiles
-	for rows.Next() {
-		var cidf CarInspectionDeregistrationFiles
-		err := rows.Scan(&cidf.OrganizationID, &cidf.CarID, &cidf.TwodimensionCodeInfoValidPeriodExpirDate, &cidf.FileUUID)
-		if err != nil {
-			return nil, err
-		}
-		files = append(files, &cidf)
-	}
-
-	return files, rows.Err()
+func (r *CarInspectionDeregistrationFilesRepository) ListByOrganization(ctx context.Context, organizationID string, page pagination.Page) ([]*CarInspectionDeregistrationFiles, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionDeregistrationFilesRepository.ListByOrganization")
+	return carInspectionDeregistrationFilesTable.list(ctx, r.db, ListOptions{Page: page}, nil, carInspectionDeregistrationFilesOrder, "organization_id = $1", organizationID)
 }
 
 // ListByCarInspectionDeregistration retrieves all files for a specific car inspection deregistration with pagination
-func (r *CarInspectionDeregistrationFilesRepository) ListByCarInspectionDeregistration(ctx context.Context, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate string, limit int, offset int) ([]*CarInspectionDeregistrationFiles, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT organization_id, "CarId", "TwodimensionCodeInfoValidPeriodExpirdate", "fileUuid"
-		FROM car_inspection_deregistration_files
-		WHERE organization_id = $1 AND "CarId" = $2 AND "TwodimensionCodeInfoValidPeriodExpirdate" = $3
-		ORDER BY "fileUuid"
-		LIMIT $4 OFFSET $5
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var files []*CarInspectionDeregistrationFiles
-	for rows.Next() {
-		var cidf CarInspectionDeregistrationFiles
-		err := rows.Scan(&cidf.OrganizationID, &cidf.CarID, &cidf.TwodimensionCodeInfoValidPeriodExpirDate, &cidf.FileUUID)
-		if err != nil {
-			return nil, err
-		}
-		files = append(files, &cidf)
-	}
-
-	return files, rows.Err()
+func (r *CarInspectionDeregistrationFilesRepository) ListByCarInspectionDeregistration(ctx context.Context, organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate string, page pagination.Page) ([]*CarInspectionDeregistrationFiles, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionDeregistrationFilesRepository.ListByCarInspectionDeregistration")
+	return carInspectionDeregistrationFilesTable.list(ctx, r.db, ListOptions{Page: page}, nil, carInspectionDeregistrationFilesByDeregistrationOrder,
+		`organization_id = $1 AND "CarId" = $2 AND "TwodimensionCodeInfoValidPeriodExpirdate" = $3`,
+		organizationID, carID, twodimensionCodeInfoValidPeriodExpirDate)
 }
 
 // List retrieves all car inspection deregistration files with pagination
-func (r *CarInspectionDeregistrationFilesRepository) List(ctx context.Context, limit int, offset int) ([]*CarInspectionDeregistrationFiles, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT organization_id, "CarId", "TwodimensionCodeInfoValidPeriodExpirdate", "fileUuid"
-		FROM car_inspection_deregistration_files
-		ORDER BY organization_id, "CarId", "TwodimensionCodeInfoValidPeriodExpirdate", "fileUuid"
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var files []*CarInspectionDeregistrationFiles
-	for rows.Next() {
-		var cidf CarInspectionDeregistrationFiles
-		err := rows.Scan(&cidf.OrganizationID, &cidf.CarID, &cidf.TwodimensionCodeInfoValidPeriodExpirDate, &cidf.FileUUID)
-		if err != nil {
-			return nil, err
-		}
-		files = append(files, &cidf)
-	}
-
-	return files, rows.Err()
+func (r *CarInspectionDeregistrationFilesRepository) List(ctx context.Context, page pagination.Page) ([]*CarInspectionDeregistrationFiles, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionDeregistrationFilesRepository.List")
+	return carInspectionDeregistrationFilesTable.list(ctx, r.db, ListOptions{Page: page}, nil, carInspectionDeregistrationFilesListOrder, "")
 }
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_CarInspectionDeregistrationFiles_CRUD(t *testing.T) {
@@ -57,7 +59,7 @@ func TestIntegration_CarInspectionDeregistrationFiles_CRUD(t *testing.T) {
 
 		// 3. ListByOrganization
 		t.Run("ListByOrganization", func(t *testing.T) {
-			records, err := repo.ListByOrganization(ctx, orgID, 10, 0)
+			records, _, err := repo.ListByOrganization(ctx, orgID, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -69,7 +71,7 @@ func TestIntegration_CarInspectionDeregistrationFiles_CRUD(t *testing.T) {
 
 		// 4. ListByCarInspectionDeregistration
 		t.Run("ListByCarInspectionDeregistration", func(t *testing.T) {
-			records, err := repo.ListByCarInspectionDeregistration(ctx, orgID, carID, expirDate, 10, 0)
+			records, _, err := repo.ListByCarInspectionDeregistration(ctx, orgID, carID, expirDate, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("ListByCarInspectionDeregistration failed: %v", err)
 			}
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_CarInspectionDeregistration_CRUD(t *testing.T) {
@@ -60,7 +62,7 @@ func TestIntegration_CarInspectionDeregistration_CRUD(t *testing.T) {
 		// 3. Update
 		t.Run("Update", func(t *testing.T) {
 			updatedCarNo := "XYZ-5678"
-			updated, err := repo.Update(ctx, orgID, carID, twodimensionCodeInfoCarNo, updatedCarNo, validPeriodExpirDateE, validPeriodExpirDateY, validPeriodExpirDateM, validPeriodExpirDateD, twodimensionCodeInfoValidPeriodExpirDate)
+			updated, err := repo.Update(ctx, orgID, carID, twodimensionCodeInfoCarNo, updatedCarNo, validPeriodExpirDateE, validPeriodExpirDateY, validPeriodExpirDateM, validPeriodExpirDateD, twodimensionCodeInfoValidPeriodExpirDate, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -72,7 +74,7 @@ func TestIntegration_CarInspectionDeregistration_CRUD(t *testing.T) {
 
 		// 4. List
 		t.Run("ListByOrganization", func(t *testing.T) {
-			records, err := repo.ListByOrganization(ctx, orgID, 10, 0)
+			records, _, err := repo.ListByOrganization(ctx, orgID, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -84,7 +86,7 @@ func TestIntegration_CarInspectionDeregistration_CRUD(t *testing.T) {
 
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, orgID, carID, twodimensionCodeInfoValidPeriodExpirDate)
+			err := repo.Delete(ctx, orgID, carID, twodimensionCodeInfoValidPeriodExpirDate, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
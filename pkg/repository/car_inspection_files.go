@@ -2,32 +2,36 @@ package repository
 
 import (
 	"context"
-	"errors"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrCarInspectionFileNotFound = errors.New("car inspection file not found")
+	ErrCarInspectionFileNotFound = notFound("car inspection file not found")
 )
 
 // CarInspectionFile represents the database model
 type CarInspectionFile struct {
-	UUID                   string
-	OrganizationID         string
-	Type                   string
-	ElectCertMgNo          string
-	ElectCertPublishdateE  string
-	ElectCertPublishdateY  string
-	ElectCertPublishdateM  string
-	ElectCertPublishdateD  string
-	Created                string
-	Modified               string
-	Deleted                *string
+	UUID                  string  `db:"uuid,pk"`
+	OrganizationID        string  `db:"organization_id,readonly"`
+	Type                  string  `db:"type"`
+	ElectCertMgNo         string  `db:"ElectCertMgNo"`
+	ElectCertPublishdateE string  `db:"ElectCertPublishdateE"`
+	ElectCertPublishdateY string  `db:"ElectCertPublishdateY"`
+	ElectCertPublishdateM string  `db:"ElectCertPublishdateM"`
+	ElectCertPublishdateD string  `db:"ElectCertPublishdateD"`
+	Created               string  `db:"created,readonly"`
+	Modified              string  `db:"modified"`
+	Deleted               *string `db:"deleted,deleted,readonly"`
+	Etag                  string  `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var carInspectionFileTable = NewTable[CarInspectionFile]("car_inspection_files", ErrCarInspectionFileNotFound)
+
 // CarInspectionFilesRepository handles database operations for car inspection files
 type CarInspectionFilesRepository struct {
 	db DB
@@ -45,158 +49,85 @@ func NewCarInspectionFilesRepositoryWithDB(db DB) *CarInspectionFilesRepository
 
 // Create inserts a new car inspection file
 func (r *CarInspectionFilesRepository) Create(ctx context.Context, organizationID, fileType, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, created, modified string) (*CarInspectionFile, error) {
-	id := uuid.New().String()
-
-	query := `
-		INSERT INTO car_inspection_files (uuid, organization_id, type, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD", created, modified, deleted)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULL)
-		RETURNING uuid, organization_id, type, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD", created, modified, deleted
-	`
-
-	var f CarInspectionFile
-	err := r.db.QueryRow(ctx, query, id, organizationID, fileType, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, created, modified).Scan(
-		&f.UUID, &f.OrganizationID, &f.Type, &f.ElectCertMgNo, &f.ElectCertPublishdateE, &f.ElectCertPublishdateY, &f.ElectCertPublishdateM, &f.ElectCertPublishdateD, &f.Created, &f.Modified, &f.Deleted,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &f, nil
+	ctx = db.WithQueryLabel(ctx, "CarInspectionFilesRepository.Create")
+	return carInspectionFileTable.insert(ctx, r.db, &CarInspectionFile{
+		UUID:                  uuid.New().String(),
+		OrganizationID:        organizationID,
+		Type:                  fileType,
+		ElectCertMgNo:         electCertMgNo,
+		ElectCertPublishdateE: electCertPublishdateE,
+		ElectCertPublishdateY: electCertPublishdateY,
+		ElectCertPublishdateM: electCertPublishdateM,
+		ElectCertPublishdateD: electCertPublishdateD,
+		Created:               created,
+		Modified:              modified,
+	})
 }
 
 // GetByUUID retrieves a car inspection file by UUID
 func (r *CarInspectionFilesRepository) GetByUUID(ctx context.Context, uuid string) (*CarInspectionFile, error) {
-	query := `
-		SELECT uuid, organization_id, type, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD", created, modified, deleted
-		FROM car_inspection_files
-		WHERE uuid = $1 AND deleted IS NULL
-	`
-
-	var f CarInspectionFile
-	err := r.db.QueryRow(ctx, query, uuid).Scan(
-		&f.UUID, &f.OrganizationID, &f.Type, &f.ElectCertMgNo, &f.ElectCertPublishdateE, &f.ElectCertPublishdateY, &f.ElectCertPublishdateM, &f.ElectCertPublishdateD, &f.Created, &f.Modified, &f.Deleted,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrCarInspectionFileNotFound
-		}
-		return nil, err
-	}
-
-	return &f, nil
+	ctx = db.WithQueryLabel(ctx, "CarInspectionFilesRepository.GetByUUID")
+	return carInspectionFileTable.get(ctx, r.db, uuid)
 }
 
-// Update modifies an existing car inspection file
-func (r *CarInspectionFilesRepository) Update(ctx context.Context, uuid, fileType, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, modified string) (*CarInspectionFile, error) {
-	query := `
-		UPDATE car_inspection_files
-		SET type = $2, "ElectCertMgNo" = $3, "ElectCertPublishdateE" = $4, "ElectCertPublishdateY" = $5, "ElectCertPublishdateM" = $6, "ElectCertPublishdateD" = $7, modified = $8
-		WHERE uuid = $1 AND deleted IS NULL
-		RETURNING uuid, organization_id, type, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD", created, modified, deleted
-	`
-
-	var f CarInspectionFile
-	err := r.db.QueryRow(ctx, query, uuid, fileType, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, modified).Scan(
-		&f.UUID, &f.OrganizationID, &f.Type, &f.ElectCertMgNo, &f.ElectCertPublishdateE, &f.ElectCertPublishdateY, &f.ElectCertPublishdateM, &f.ElectCertPublishdateD, &f.Created, &f.Modified, &f.Deleted,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrCarInspectionFileNotFound
-		}
-		return nil, err
-	}
+// Update modifies an existing car inspection file. A non-empty etag must be the current one.
+func (r *CarInspectionFilesRepository) Update(ctx context.Context, uuid, fileType, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, modified, etag string) (*CarInspectionFile, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionFilesRepository.Update")
+	return carInspectionFileTable.update(ctx, r.db, &CarInspectionFile{
+		UUID:                  uuid,
+		Type:                  fileType,
+		ElectCertMgNo:         electCertMgNo,
+		ElectCertPublishdateE: electCertPublishdateE,
+		ElectCertPublishdateY: electCertPublishdateY,
+		ElectCertPublishdateM: electCertPublishdateM,
+		ElectCertPublishdateD: electCertPublishdateD,
+		Modified:              modified,
+	}, nil, etag)
+}
 
-	return &f, nil
+// Delete soft-deletes a car inspection file. A non-empty etag must be the current one.
+func (r *CarInspectionFilesRepository) Delete(ctx context.Context, uuid, deletedTimestamp, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionFilesRepository.Delete")
+	return carInspectionFileTable.softDelete(ctx, r.db, deletedTimestamp, etag, uuid)
 }
 
-// Delete soft-deletes a car inspection file
-func (r *CarInspectionFilesRepository) Delete(ctx context.Context, uuid, deletedTimestamp string) error {
+// DeleteByCarInspection soft-deletes all files of a car inspection and returns how many were deleted
+func (r *CarInspectionFilesRepository) DeleteByCarInspection(ctx context.Context, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, deletedTimestamp string) (int64, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionFilesRepository.DeleteByCarInspection")
 	query := `
 		UPDATE car_inspection_files
-		SET deleted = $2
-		WHERE uuid = $1 AND deleted IS NULL
+		SET deleted = $7
+		WHERE organization_id = $1
+			AND "ElectCertMgNo" = $2
+			AND "ElectCertPublishdateE" = $3
+			AND "ElectCertPublishdateY" = $4
+			AND "ElectCertPublishdateM" = $5
+			AND "ElectCertPublishdateD" = $6
+			AND deleted IS NULL
 	`
 
-	result, err := r.db.Exec(ctx, query, uuid, deletedTimestamp)
+	result, err := r.db.Exec(ctx, query, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, deletedTimestamp)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	if result.RowsAffected() == 0 {
-		return ErrCarInspectionFileNotFound
-	}
+	return result.RowsAffected(), nil
+}
 
-	return nil
+// carInspectionFileOrder lists files newest first
+var carInspectionFileOrder = pagination.Order{
+	pagination.Desc("created", "text"),
+	pagination.Desc("uuid", "text"),
 }
 
 // ListByOrganization retrieves car inspection files by organization with pagination
-func (r *CarInspectionFilesRepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*CarInspectionFile, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT uuid, organization_id, type, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD", created, modified, deleted
-		FROM car_inspection_files
-		WHERE organization_id = $1 AND deleted IS NULL
-		ORDER BY created DESC
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var files []*CarInspectionFile
-	for rows.Next() {
-		var f CarInspectionFile
-		err := rows.Scan(&f.UUID, &f.OrganizationID, &f.Type, &f.ElectCertMgNo, &f.ElectCertPublishdateE, &f.ElectCertPublishdateY, &f.ElectCertPublishdateM, &f.ElectCertPublishdateD, &f.Created, &f.Modified, &f.Deleted)
-		if err != nil {
-			return nil, err
-		}
-		files = append(files, &f)
-	}
-
-	return files, rows.Err()
+func (r *CarInspectionFilesRepository) ListByOrganization(ctx context.Context, organizationID string, page pagination.Page) ([]*CarInspectionFile, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionFilesRepository.ListByOrganization")
+	return carInspectionFileTable.list(ctx, r.db, ListOptions{Page: page}, nil, carInspectionFileOrder, "organization_id = $1", organizationID)
 }
 
 // List retrieves all car inspection files with pagination
-func (r *CarInspectionFilesRepository) List(ctx context.Context, limit int, offset int) ([]*CarInspectionFile, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT uuid, organization_id, type, "ElectCertMgNo", "ElectCertPublishdateE", "ElectCertPublishdateY", "ElectCertPublishdateM", "ElectCertPublishdateD", created, modified, deleted
-		FROM car_inspection_files
-		WHERE deleted IS NULL
-		ORDER BY created DESC
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var files []*CarInspectionFile
-	for rows.Next() {
-		var f CarInspectionFile
-		err := rows.Scan(&f.UUID, &f.OrganizationID, &f.Type, &f.ElectCertMgNo, &f.ElectCertPublishdateE, &f.ElectCertPublishdateY, &f.ElectCertPublishdateM, &f.ElectCertPublishdateD, &f.Created, &f.Modified, &f.Deleted)
-		if err != nil {
-			return nil, err
-		}
-		files = append(files, &f)
-	}
-
-	return files, rows.Err()
+func (r *CarInspectionFilesRepository) List(ctx context.Context, page pagination.Page) ([]*CarInspectionFile, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionFilesRepository.List")
+	return carInspectionFileTable.list(ctx, r.db, ListOptions{Page: page}, nil, carInspectionFileOrder, "")
 }
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_CarInspectionFilesA_CRUD(t *testing.T) {
@@ -64,7 +66,7 @@ func TestIntegration_CarInspectionFilesA_CRUD(t *testing.T) {
 		t.Run("Update", func(t *testing.T) {
 			testData.Type = "updated-type-a"
 			testData.Modified = time.Now().Format(time.RFC3339)
-			updated, err := repo.Update(ctx, testData)
+			updated, err := repo.Update(ctx, testData, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -76,7 +78,7 @@ func TestIntegration_CarInspectionFilesA_CRUD(t *testing.T) {
 
 		// 4. List
 		t.Run("ListByOrganization", func(t *testing.T) {
-			records, err := repo.ListByOrganization(ctx, orgID, 10, 0)
+			records, _, err := repo.ListByOrganization(ctx, orgID, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -89,7 +91,7 @@ func TestIntegration_CarInspectionFilesA_CRUD(t *testing.T) {
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
 			deletedTime := time.Now().Format(time.RFC3339)
-			err := repo.Delete(ctx, testData.UUID, deletedTime)
+			err := repo.Delete(ctx, testData.UUID, deletedTime, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
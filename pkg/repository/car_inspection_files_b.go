@@ -2,33 +2,37 @@ package repository
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrCarInspectionFilesBNotFound = errors.New("car inspection files b not found")
+	ErrCarInspectionFilesBNotFound = notFound("car inspection files B not found")
 )
 
 // CarInspectionFilesB represents the database model
 type CarInspectionFilesB struct {
-	UUID           string
-	OrganizationID string
-	Type           string
-	ElectCertMgNo  string
-	GrantdateE     string
-	GrantdateY     string
-	GrantdateM     string
-	GrantdateD     string
-	Created        string
-	Modified       string
-	Deleted        *string
+	UUID           string  `db:"uuid,pk"`
+	OrganizationID string  `db:"organization_id"`
+	Type           string  `db:"type"`
+	ElectCertMgNo  string  `db:"ElectCertMgNo"`
+	GrantdateE     string  `db:"GrantdateE"`
+	GrantdateY     string  `db:"GrantdateY"`
+	GrantdateM     string  `db:"GrantdateM"`
+	GrantdateD     string  `db:"GrantdateD"`
+	Created        string  `db:"created,readonly"`
+	Modified       string  `db:"modified,modified"`
+	Deleted        *string `db:"deleted,deleted,readonly"`
+	Etag           string  `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var carInspectionFilesBTable = NewTable[CarInspectionFilesB]("car_inspection_files_b", ErrCarInspectionFilesBNotFound)
+
 // CarInspectionFilesBRepository handles database operations for car_inspection_files_b
 type CarInspectionFilesBRepository struct {
 	db DB
@@ -46,177 +50,66 @@ func NewCarInspectionFilesBRepositoryWithDB(db DB) *CarInspectionFilesBRepositor
 
 // Create inserts a new car inspection file b record
 func (r *CarInspectionFilesBRepository) Create(ctx context.Context, organizationID, typeVal, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD string) (*CarInspectionFilesB, error) {
-	id := uuid.New().String()
+	ctx = db.WithQueryLabel(ctx, "CarInspectionFilesBRepository.Create")
 	now := time.Now().Format(time.RFC3339)
 
-	query := `
-		INSERT INTO car_inspection_files_b (uuid, organization_id, type, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD", created, modified)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-		RETURNING uuid, organization_id, type, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD", created, modified, deleted
-	`
-
-	var record CarInspectionFilesB
-	err := r.db.QueryRow(ctx, query, id, organizationID, typeVal, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD, now, now).Scan(
-		&record.UUID, &record.OrganizationID, &record.Type, &record.ElectCertMgNo,
-		&record.GrantdateE, &record.GrantdateY, &record.GrantdateM, &record.GrantdateD,
-		&record.Created, &record.Modified, &record.Deleted,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &record, nil
+	return carInspectionFilesBTable.insert(ctx, r.db, &CarInspectionFilesB{
+		UUID:           uuid.New().String(),
+		OrganizationID: organizationID,
+		Type:           typeVal,
+		ElectCertMgNo:  electCertMgNo,
+		GrantdateE:     grantdateE,
+		GrantdateY:     grantdateY,
+		GrantdateM:     grantdateM,
+		GrantdateD:     grantdateD,
+		Created:        now,
+		Modified:       now,
+	})
 }
 
 // GetByUUID retrieves a car inspection file b record by UUID
 func (r *CarInspectionFilesBRepository) GetByUUID(ctx context.Context, uuid string) (*CarInspectionFilesB, error) {
-	query := `
-		SELECT uuid, organization_id, type, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD", created, modified, deleted
-		FROM car_inspection_files_b
-		WHERE uuid = $1 AND deleted IS NULL
-	`
-
-	var record CarInspectionFilesB
-	err := r.db.QueryRow(ctx, query, uuid).Scan(
-		&record.UUID, &record.OrganizationID, &record.Type, &record.ElectCertMgNo,
-		&record.GrantdateE, &record.GrantdateY, &record.GrantdateM, &record.GrantdateD,
-		&record.Created, &record.Modified, &record.Deleted,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrCarInspectionFilesBNotFound
-		}
-		return nil, err
-	}
-
-	return &record, nil
+	ctx = db.WithQueryLabel(ctx, "CarInspectionFilesBRepository.GetByUUID")
+	return carInspectionFilesBTable.get(ctx, r.db, uuid)
 }
 
-// Update modifies an existing car inspection file b record
-func (r *CarInspectionFilesBRepository) Update(ctx context.Context, uuid, organizationID, typeVal, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD string) (*CarInspectionFilesB, error) {
-	now := time.Now().Format(time.RFC3339)
-
-	query := `
-		UPDATE car_inspection_files_b
-		SET organization_id = $2, type = $3, "ElectCertMgNo" = $4, "GrantdateE" = $5, "GrantdateY" = $6, "GrantdateM" = $7, "GrantdateD" = $8, modified = $9
-		WHERE uuid = $1 AND deleted IS NULL
-		RETURNING uuid, organization_id, type, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD", created, modified, deleted
-	`
-
-	var record CarInspectionFilesB
-	err := r.db.QueryRow(ctx, query, uuid, organizationID, typeVal, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD, now).Scan(
-		&record.UUID, &record.OrganizationID, &record.Type, &record.ElectCertMgNo,
-		&record.GrantdateE, &record.GrantdateY, &record.GrantdateM, &record.GrantdateD,
-		&record.Created, &record.Modified, &record.Deleted,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrCarInspectionFilesBNotFound
-		}
-		return nil, err
-	}
-
-	return &record, nil
+// Update modifies an existing car inspection file b record.
+// A non-empty etag must be the current one.
+func (r *CarInspectionFilesBRepository) Update(ctx context.Context, uuid, organizationID, typeVal, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD, etag string) (*CarInspectionFilesB, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionFilesBRepository.Update")
+	return carInspectionFilesBTable.update(ctx, r.db, &CarInspectionFilesB{
+		UUID:           uuid,
+		OrganizationID: organizationID,
+		Type:           typeVal,
+		ElectCertMgNo:  electCertMgNo,
+		GrantdateE:     grantdateE,
+		GrantdateY:     grantdateY,
+		GrantdateM:     grantdateM,
+		GrantdateD:     grantdateD,
+		Modified:       time.Now().Format(time.RFC3339),
+	}, nil, etag)
 }
 
-// Delete soft-deletes a car inspection file b record
-func (r *CarInspectionFilesBRepository) Delete(ctx context.Context, uuid string) error {
-	now := time.Now().Format(time.RFC3339)
-
-	query := `
-		UPDATE car_inspection_files_b
-		SET deleted = $2, modified = $2
-		WHERE uuid = $1 AND deleted IS NULL
-	`
-
-	result, err := r.db.Exec(ctx, query, uuid, now)
-	if err != nil {
-		return err
-	}
-
-	if result.RowsAffected() == 0 {
-		return ErrCarInspectionFilesBNotFound
-	}
+// Delete soft-deletes a car inspection file b record. A non-empty etag must be the current one.
+func (r *CarInspectionFilesBRepository) Delete(ctx context.Context, uuid, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionFilesBRepository.Delete")
+	return carInspectionFilesBTable.softDelete(ctx, r.db, time.Now().Format(time.RFC3339), etag, uuid)
+}
 
-	return nil
+// carInspectionFilesBOrder lists files newest first
+var carInspectionFilesBOrder = pagination.Order{
+	pagination.Desc("created", "text"),
+	pagination.Desc("uuid", "text"),
 }
 
 // ListByOrganization retrieves car inspection files b records by organization with pagination
-func (r *CarInspectionFilesBRepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*CarInspectionFilesB, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT uuid, organization_id, type, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD", created, modified, deleted
-		FROM car_inspection_files_b
-		WHERE organization_id = $1 AND deleted IS NULL
-		ORDER BY created DESC
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var records []*CarInspectionFilesB
-	for rows.Next() {
-		var record CarInspectionFilesB
-		err := rows.Scan(
-			&record.UUID, &record.OrganizationID, &record.Type, &record.ElectCertMgNo,
-			&record.GrantdateE, &record.GrantdateY, &record.GrantdateM, &record.GrantdateD,
-			&record.Created, &record.Modified, &record.Deleted,
-		)
-		if err != nil {
-			return nil, err
-		}
-		records = append(records, &record)
-	}
-
-	return records, rows.Err()
+func (r *CarInspectionFilesBRepository) ListByOrganization(ctx context.Context, organizationID string, page pagination.Page) ([]*CarInspectionFilesB, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionFilesBRepository.ListByOrganization")
+	return carInspectionFilesBTable.list(ctx, r.db, ListOptions{Page: page}, nil, carInspectionFilesBOrder, "organization_id = $1", organizationID)
 }
 
 // List retrieves car inspection files b records with pagination
-func (r *CarInspectionFilesBRepository) List(ctx context.Context, limit int, offset int) ([]*CarInspectionFilesB, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT uuid, organization_id, type, "ElectCertMgNo", "GrantdateE", "GrantdateY", "GrantdateM", "GrantdateD", created, modified, deleted
-		FROM car_inspection_files_b
-		WHERE deleted IS NULL
-		ORDER BY created DESC
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var records []*CarInspectionFilesB
-	for rows.Next() {
-		var record CarInspectionFilesB
-		err := rows.Scan(
-			&record.UUID, &record.OrganizationID, &record.Type, &record.ElectCertMgNo,
-			&record.GrantdateE, &record.GrantdateY, &record.GrantdateM, &record.GrantdateD,
-			&record.Created, &record.Modified, &record.Deleted,
-		)
-		if err != nil {
-			return nil, err
-		}
-		records = append(records, &record)
-	}
-
-	return records, rows.Err()
+func (r *CarInspectionFilesBRepository) List(ctx context.Context, page pagination.Page) ([]*CarInspectionFilesB, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "CarInspectionFilesBRepository.List")
+	return carInspectionFilesBTable.list(ctx, r.db, ListOptions{Page: page}, nil, carInspectionFilesBOrder, "")
 }
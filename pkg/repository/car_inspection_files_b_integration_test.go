@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_CarInspectionFilesB_CRUD(t *testing.T) {
@@ -58,7 +60,7 @@ func TestIntegration_CarInspectionFilesB_CRUD(t *testing.T) {
 		// 3. Update
 		t.Run("Update", func(t *testing.T) {
 			newType := "updated-type-b"
-			updated, err := repo.Update(ctx, createdUUID, orgID, newType, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD)
+			updated, err := repo.Update(ctx, createdUUID, orgID, newType, electCertMgNo, grantdateE, grantdateY, grantdateM, grantdateD, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -70,7 +72,7 @@ func TestIntegration_CarInspectionFilesB_CRUD(t *testing.T) {
 
 		// 4. List
 		t.Run("ListByOrganization", func(t *testing.T) {
-			records, err := repo.ListByOrganization(ctx, orgID, 10, 0)
+			records, _, err := repo.ListByOrganization(ctx, orgID, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -82,7 +84,7 @@ func TestIntegration_CarInspectionFilesB_CRUD(t *testing.T) {
 
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, createdUUID)
+			err := repo.Delete(ctx, createdUUID, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
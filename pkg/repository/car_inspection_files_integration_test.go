@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_CarInspectionFiles_CRUD(t *testing.T) {
@@ -61,7 +63,7 @@ func TestIntegration_CarInspectionFiles_CRUD(t *testing.T) {
 		t.Run("Update", func(t *testing.T) {
 			updatedType := "updated-inspection"
 			modifiedTime := time.Now().Format(time.RFC3339)
-			updated, err := repo.Update(ctx, createdUUID, updatedType, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, modifiedTime)
+			updated, err := repo.Update(ctx, createdUUID, updatedType, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, modifiedTime, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -73,7 +75,7 @@ func TestIntegration_CarInspectionFiles_CRUD(t *testing.T) {
 
 		// 4. List
 		t.Run("ListByOrganization", func(t *testing.T) {
-			records, err := repo.ListByOrganization(ctx, orgID, 10, 0)
+			records, _, err := repo.ListByOrganization(ctx, orgID, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -86,7 +88,7 @@ func TestIntegration_CarInspectionFiles_CRUD(t *testing.T) {
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
 			deletedTime := time.Now().Format(time.RFC3339)
-			err := repo.Delete(ctx, createdUUID, deletedTime)
+			err := repo.Delete(ctx, createdUUID, deletedTime, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
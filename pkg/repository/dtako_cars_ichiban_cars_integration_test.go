@@ -6,6 +6,8 @@ import (
 	"context"
 	"fmt"
 	"testing"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_DtakoCarsIchibanCars_CRUD(t *testing.T) {
@@ -21,7 +23,7 @@ func TestIntegration_DtakoCarsIchibanCars_CRUD(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create test organization: %v", err)
 	}
-	defer orgRepo.Delete(ctx, org.ID)
+	defer orgRepo.Delete(ctx, org.ID, "")
 
 	// 1. Create
 	t.Run("Create", func(t *testing.T) {
@@ -62,7 +64,7 @@ func TestIntegration_DtakoCarsIchibanCars_CRUD(t *testing.T) {
 		// 3. Update
 		t.Run("Update", func(t *testing.T) {
 			updatedIdValue := "ichiban-456"
-			updated, err := repo.Update(ctx, entry.IdDtako, entry.OrganizationID, &updatedIdValue)
+			updated, err := repo.Update(ctx, entry.IdDtako, entry.OrganizationID, &updatedIdValue, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -80,7 +82,7 @@ func TestIntegration_DtakoCarsIchibanCars_CRUD(t *testing.T) {
 
 		// 4. ListByOrganization
 		t.Run("ListByOrganization", func(t *testing.T) {
-			entries, err := repo.ListByOrganization(ctx, org.ID, 10, 0)
+			entries, _, err := repo.ListByOrganization(ctx, org.ID, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -92,7 +94,7 @@ func TestIntegration_DtakoCarsIchibanCars_CRUD(t *testing.T) {
 
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, entry.IdDtako, entry.OrganizationID)
+			err := repo.Delete(ctx, entry.IdDtako, entry.OrganizationID, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
@@ -120,14 +122,14 @@ func TestIntegration_DtakoCarsIchibanCars_CreateWithNullId(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create test organization: %v", err)
 	}
-	defer orgRepo.Delete(ctx, org.ID)
+	defer orgRepo.Delete(ctx, org.ID, "")
 
 	// Create entry with null id
 	entry, err := repo.Create(ctx, "dtako-002", org.ID, nil)
 	if err != nil {
 		t.Fatalf("Create with null id failed: %v", err)
 	}
-	defer repo.Delete(ctx, entry.IdDtako, entry.OrganizationID)
+	defer repo.Delete(ctx, entry.IdDtako, entry.OrganizationID, "")
 
 	if entry.Id != nil {
 		t.Errorf("Create with null: Id should be nil, got %v", entry.Id)
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_Dtakologs_CRUD(t *testing.T) {
@@ -63,7 +65,7 @@ func TestIntegration_Dtakologs_CRUD(t *testing.T) {
 		// 3. Update
 		t.Run("Update", func(t *testing.T) {
 			testData.VehicleName = "Updated Vehicle"
-			err := repo.Update(ctx, testData)
+			err := repo.Update(ctx, testData, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -80,7 +82,7 @@ func TestIntegration_Dtakologs_CRUD(t *testing.T) {
 
 		// 4. List
 		t.Run("ListByOrganization", func(t *testing.T) {
-			records, err := repo.ListByOrganization(ctx, orgID, 10, 0)
+			records, _, err := repo.ListByOrganization(ctx, orgID, ListOptions{Page: pagination.Page{Size: 10}})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -92,7 +94,7 @@ func TestIntegration_Dtakologs_CRUD(t *testing.T) {
 
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, orgID, dataDateTime, vehicleCd)
+			err := repo.Delete(ctx, orgID, dataDateTime, vehicleCd, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
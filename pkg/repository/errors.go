@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Error categories. A missing row or a violated constraint matches exactly one of them
+// with errors.Is, whichever repository it came from.
+var (
+	ErrNotFound            = errors.New("not found")
+	ErrAlreadyExists       = errors.New("already exists")
+	ErrForeignKeyViolation = errors.New("foreign key violation")
+	ErrCheckViolation      = errors.New("check violation")
+	ErrConflict            = errors.New("conflict")
+	ErrInvalidArgument     = errors.New("invalid argument")
+	ErrEtagMismatch        = errors.New("etag mismatch")
+)
+
+// Error is a repository error of one of the categories above. Message is safe to show to
+// clients; the driver error, whose text may contain SQL and row values, is only reachable
+// through Unwrap.
+type Error struct {
+	Kind       error  // one of the categories above
+	Message    string // client-facing description
+	Constraint string // violated constraint, if any
+	Field      string // offending column(s), if known
+	err        error
+}
+
+// notFound returns a sentinel error of category ErrNotFound
+func notFound(message string) error {
+	return &Error{Kind: ErrNotFound, Message: message}
+}
+
+func (e *Error) Error() string {
+	if e.err != nil {
+		return e.Message + ": " + e.err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() []error {
+	if e.err != nil {
+		return []error{e.Kind, e.err}
+	}
+	return []error{e.Kind}
+}
+
+// keyColumnsPattern extracts the column list from a constraint violation detail such as
+// `Key (organization_id, hash)=(...) already exists.`; expression keys do not match
+var keyColumnsPattern = regexp.MustCompile(`^Key \(([a-z_][a-z0-9_]*(?:, [a-z_][a-z0-9_]*)*)\)=`)
+
+// Classify gives Postgres errors a category by SQLSTATE:
+//   - 23505 unique_violation: ErrAlreadyExists
+//   - 23503 foreign_key_violation: ErrForeignKeyViolation
+//   - 23514 check_violation, 23502 not_null_violation: ErrCheckViolation
+//   - 23P01 exclusion_violation, 40001 serialization_failure, 40P01 deadlock_detected,
+//     55P03 lock_not_available: ErrConflict
+//
+// The result wraps err. Errors that already have a category, and all other errors, are
+// returned unchanged.
+func Classify(err error) error {
+	var repoErr *Error
+	if err == nil || errors.As(err, &repoErr) {
+		return err
+	}
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	e := &Error{Constraint: pgErr.ConstraintName, Field: pgErr.ColumnName, err: err}
+	switch pgErr.Code {
+	case "23505":
+		e.Kind, e.Message = ErrAlreadyExists, "record already exists"
+	case "23503":
+		e.Kind, e.Message = ErrForeignKeyViolation, "referenced record does not exist"
+		if strings.Contains(pgErr.Detail, "is still referenced") {
+			e.Message = "record is still referenced by other records"
+		}
+	case "23514":
+		e.Kind, e.Message = ErrCheckViolation, "value violates a check constraint"
+	case "23502":
+		e.Kind, e.Message = ErrCheckViolation, "required value is missing"
+	case "23P01":
+		e.Kind, e.Message = ErrConflict, "record conflicts with an existing record"
+	case "40001", "40P01", "55P03":
+		e.Kind, e.Message = ErrConflict, "concurrent update conflict, retry the request"
+	default:
+		return err
+	}
+
+	if e.Field == "" {
+		if m := keyColumnsPattern.FindStringSubmatch(pgErr.Detail); m != nil {
+			e.Field = m[1]
+		}
+	}
+	return e
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name           string
+		pgErr          *pgconn.PgError
+		wantKind       error
+		wantField      string
+		wantConstraint string
+	}{
+		{
+			name: "unique violation",
+			pgErr: &pgconn.PgError{Code: "23505", ConstraintName: "api_keys_org_hash_key",
+				Detail: "Key (organization_id, hash)=(o, h) already exists."},
+			wantKind:       ErrAlreadyExists,
+			wantField:      "organization_id, hash",
+			wantConstraint: "api_keys_org_hash_key",
+		},
+		{
+			name: "foreign key violation",
+			pgErr: &pgconn.PgError{Code: "23503", ConstraintName: "files_organization_id_fkey",
+				Detail: `Key (organization_id)=(x) is not present in table "organizations".`},
+			wantKind:       ErrForeignKeyViolation,
+			wantField:      "organization_id",
+			wantConstraint: "files_organization_id_fkey",
+		},
+		{
+			name:      "not null violation",
+			pgErr:     &pgconn.PgError{Code: "23502", ColumnName: "email"},
+			wantKind:  ErrCheckViolation,
+			wantField: "email",
+		},
+		{
+			name:     "serialization failure",
+			pgErr:    &pgconn.PgError{Code: "40001"},
+			wantKind: ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Classify(fmt.Errorf("insert: %w", tt.pgErr))
+			if !errors.Is(err, tt.wantKind) {
+				t.Fatalf("Classify() = %v, want kind %v", err, tt.wantKind)
+			}
+			var pgErr *pgconn.PgError
+			if !errors.As(err, &pgErr) {
+				t.Error("Classify() result does not wrap the driver error")
+			}
+			var repoErr *Error
+			if !errors.As(err, &repoErr) {
+				t.Fatalf("Classify() = %T, want *Error", err)
+			}
+			if repoErr.Field != tt.wantField {
+				t.Errorf("Field = %q, want %q", repoErr.Field, tt.wantField)
+			}
+			if repoErr.Constraint != tt.wantConstraint {
+				t.Errorf("Constraint = %q, want %q", repoErr.Constraint, tt.wantConstraint)
+			}
+		})
+	}
+}
+
+func TestClassify_Unchanged(t *testing.T) {
+	plain := errors.New("boom")
+	if got := Classify(plain); got != plain {
+		t.Errorf("Classify(plain) = %v, want unchanged", got)
+	}
+	syntax := &pgconn.PgError{Code: "42601"}
+	if got := Classify(syntax); got != error(syntax) {
+		t.Errorf("Classify(syntax error) = %v, want unchanged", got)
+	}
+	if got := Classify(ErrKudgivtNotFound); got != ErrKudgivtNotFound {
+		t.Errorf("Classify(ErrKudgivtNotFound) = %v, want unchanged", got)
+	}
+	if !errors.Is(ErrKudgivtNotFound, ErrNotFound) {
+		t.Error("ErrKudgivtNotFound is not ErrNotFound")
+	}
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+// Etags are the row version of a record: Postgres's xmin system column as text, selected
+// as xmin::text. Every write of the row changes it. Reads return it and Update/Delete take
+// the etag the client read, so a write based on a stale copy fails instead of overwriting
+// someone else's change. An empty etag skips the check.
+
+// errEtagMismatch is returned when a write's etag is not the current one of the row
+var errEtagMismatch = &Error{
+	Kind:    ErrEtagMismatch,
+	Message: "record was modified since it was read, get it again and retry",
+	Field:   "etag",
+}
+
+// etagCondition is the WHERE condition that the row still has the etag in parameter arg
+func etagCondition(arg int) string {
+	return fmt.Sprintf("($%d::text = '' OR xmin::text = $%d::text)", arg, arg)
+}
+
+// missingOrStale explains a conditional write that matched no row: errEtagMismatch if the
+// row that where selects in table still exists, notFound otherwise
+func missingOrStale(ctx context.Context, db DB, etag string, notFound error, table, where string, args ...any) error {
+	if etag == "" {
+		return notFound
+	}
+	var exists bool
+	if err := db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM `+table+` WHERE `+where+`)`, args...).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return errEtagMismatch
+	}
+	return notFound
+}
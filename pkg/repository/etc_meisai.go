@@ -2,39 +2,45 @@ package repository
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"strings"
 	"time"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrETCMeisaiNotFound = errors.New("etc_meisai not found")
+	ErrETCMeisaiNotFound = notFound("etc_meisai not found")
 )
 
 // ETCMeisai represents the database model for ETC明細
 type ETCMeisai struct {
-	ID             int64
-	OrganizationID string
-	DateFr         *time.Time // nullable
-	DateTo         time.Time
-	DateToDate     string // YYYY-MM-DD format
-	IcFr           string
-	IcTo           string
-	PriceBf        *int32 // nullable
-	Discount       *int32 // nullable
-	Price          int32
-	Shashu         int32
-	CarIdNum       *int32 // nullable
-	EtcNum         string
-	Detail         *string // nullable
-	DtakoRowId     *string // nullable
-	Hash           string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID             int64      `db:"id,pk,default"`
+	OrganizationID string     `db:"organization_id,readonly"`
+	DateFr         *time.Time `db:"date_fr"` // nullable
+	DateTo         time.Time  `db:"date_to"`
+	DateToDate     string     `db:"date_to_date"` // YYYY-MM-DD format
+	IcFr           string     `db:"ic_fr"`
+	IcTo           string     `db:"ic_to"`
+	PriceBf        *int32     `db:"price_bf"` // nullable
+	Discount       *int32     `db:"discount"` // nullable
+	Price          int32      `db:"price"`
+	Shashu         int32      `db:"shashu"`
+	CarIdNum       *int32     `db:"car_id_num"` // nullable
+	EtcNum         string     `db:"etc_num"`
+	Detail         *string    `db:"detail"`       // nullable
+	DtakoRowId     *string    `db:"dtako_row_id"` // nullable
+	Hash           string     `db:"hash"`
+	CreatedAt      time.Time  `db:"created_at,readonly"`
+	UpdatedAt      time.Time  `db:"updated_at,modified"`
+	Etag           string     `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var etcMeisaiTable = NewTable[ETCMeisai]("etc_meisai", ErrETCMeisaiNotFound)
+
 // ETCMeisaiRepository handles database operations for etc_meisai
 type ETCMeisaiRepository struct {
 	db DB
@@ -52,94 +58,31 @@ func NewETCMeisaiRepositoryWithDB(db DB) *ETCMeisaiRepository {
 
 // Create inserts a new ETC meisai record
 func (r *ETCMeisaiRepository) Create(ctx context.Context, organizationID string, meisai *ETCMeisai) (*ETCMeisai, error) {
+	ctx = db.WithQueryLabel(ctx, "ETCMeisaiRepository.Create")
 	now := time.Now()
+	row := *meisai
+	row.OrganizationID = organizationID
+	row.CreatedAt = now
+	row.UpdatedAt = now
 
-	query := `
-		INSERT INTO etc_meisai (
-			organization_id, date_fr, date_to, date_to_date, ic_fr, ic_to,
-			price_bf, discount, price, shashu, car_id_num, etc_num,
-			detail, dtako_row_id, hash, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
-		RETURNING id, organization_id, date_fr, date_to, date_to_date, ic_fr, ic_to,
-			price_bf, discount, price, shashu, car_id_num, etc_num,
-			detail, dtako_row_id, hash, created_at, updated_at
-	`
-
-	var result ETCMeisai
-	err := r.db.QueryRow(ctx, query,
-		organizationID, meisai.DateFr, meisai.DateTo, meisai.DateToDate,
-		meisai.IcFr, meisai.IcTo, meisai.PriceBf, meisai.Discount,
-		meisai.Price, meisai.Shashu, meisai.CarIdNum, meisai.EtcNum,
-		meisai.Detail, meisai.DtakoRowId, meisai.Hash, now, now,
-	).Scan(
-		&result.ID, &result.OrganizationID, &result.DateFr, &result.DateTo, &result.DateToDate,
-		&result.IcFr, &result.IcTo, &result.PriceBf, &result.Discount,
-		&result.Price, &result.Shashu, &result.CarIdNum, &result.EtcNum,
-		&result.Detail, &result.DtakoRowId, &result.Hash, &result.CreatedAt, &result.UpdatedAt,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &result, nil
+	return etcMeisaiTable.insert(ctx, r.db, &row)
 }
 
 // GetByID retrieves an ETC meisai by ID
 func (r *ETCMeisaiRepository) GetByID(ctx context.Context, id int64) (*ETCMeisai, error) {
-	query := `
-		SELECT id, organization_id, date_fr, date_to, date_to_date, ic_fr, ic_to,
-			price_bf, discount, price, shashu, car_id_num, etc_num,
-			detail, dtako_row_id, hash, created_at, updated_at
-		FROM etc_meisai
-		WHERE id = $1
-	`
-
-	var result ETCMeisai
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&result.ID, &result.OrganizationID, &result.DateFr, &result.DateTo, &result.DateToDate,
-		&result.IcFr, &result.IcTo, &result.PriceBf, &result.Discount,
-		&result.Price, &result.Shashu, &result.CarIdNum, &result.EtcNum,
-		&result.Detail, &result.DtakoRowId, &result.Hash, &result.CreatedAt, &result.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrETCMeisaiNotFound
-		}
-		return nil, err
-	}
-
-	return &result, nil
+	ctx = db.WithQueryLabel(ctx, "ETCMeisaiRepository.GetByID")
+	return etcMeisaiTable.get(ctx, r.db, id)
 }
 
 // GetByHash retrieves an ETC meisai by hash (for duplicate check)
 func (r *ETCMeisaiRepository) GetByHash(ctx context.Context, hash string) (*ETCMeisai, error) {
-	query := `
-		SELECT id, organization_id, date_fr, date_to, date_to_date, ic_fr, ic_to,
-			price_bf, discount, price, shashu, car_id_num, etc_num,
-			detail, dtako_row_id, hash, created_at, updated_at
-		FROM etc_meisai
-		WHERE hash = $1
-	`
-
-	var result ETCMeisai
-	err := r.db.QueryRow(ctx, query, hash).Scan(
-		&result.ID, &result.OrganizationID, &result.DateFr, &result.DateTo, &result.DateToDate,
-		&result.IcFr, &result.IcTo, &result.PriceBf, &result.Discount,
-		&result.Price, &result.Shashu, &result.CarIdNum, &result.EtcNum,
-		&result.Detail, &result.DtakoRowId, &result.Hash, &result.CreatedAt, &result.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrETCMeisaiNotFound
-		}
-		return nil, err
-	}
-
-	return &result, nil
+	ctx = db.WithQueryLabel(ctx, "ETCMeisaiRepository.GetByHash")
+	return etcMeisaiTable.find(ctx, r.db, "hash = $1", hash)
 }
 
 // ExistsByHash checks if a record with the given hash exists
 func (r *ETCMeisaiRepository) ExistsByHash(ctx context.Context, hash string) (bool, error) {
+	ctx = db.WithQueryLabel(ctx, "ETCMeisaiRepository.ExistsByHash")
 	query := `SELECT EXISTS(SELECT 1 FROM etc_meisai WHERE hash = $1)`
 
 	var exists bool
@@ -151,168 +94,74 @@ func (r *ETCMeisaiRepository) ExistsByHash(ctx context.Context, hash string) (bo
 	return exists, nil
 }
 
-// Update updates an ETC meisai record
-func (r *ETCMeisaiRepository) Update(ctx context.Context, meisai *ETCMeisai) (*ETCMeisai, error) {
-	now := time.Now()
-
-	query := `
-		UPDATE etc_meisai SET
-			date_fr = $2, date_to = $3, date_to_date = $4, ic_fr = $5, ic_to = $6,
-			price_bf = $7, discount = $8, price = $9, shashu = $10, car_id_num = $11,
-			etc_num = $12, detail = $13, dtako_row_id = $14, hash = $15, updated_at = $16
-		WHERE id = $1
-		RETURNING id, organization_id, date_fr, date_to, date_to_date, ic_fr, ic_to,
-			price_bf, discount, price, shashu, car_id_num, etc_num,
-			detail, dtako_row_id, hash, created_at, updated_at
-	`
+// Update updates an ETC meisai record. A non-empty etag must be the current one.
+func (r *ETCMeisaiRepository) Update(ctx context.Context, meisai *ETCMeisai, etag string) (*ETCMeisai, error) {
+	ctx = db.WithQueryLabel(ctx, "ETCMeisaiRepository.Update")
+	row := *meisai
+	row.UpdatedAt = time.Now()
 
-	var result ETCMeisai
-	err := r.db.QueryRow(ctx, query,
-		meisai.ID, meisai.DateFr, meisai.DateTo, meisai.DateToDate,
-		meisai.IcFr, meisai.IcTo, meisai.PriceBf, meisai.Discount,
-		meisai.Price, meisai.Shashu, meisai.CarIdNum, meisai.EtcNum,
-		meisai.Detail, meisai.DtakoRowId, meisai.Hash, now,
-	).Scan(
-		&result.ID, &result.OrganizationID, &result.DateFr, &result.DateTo, &result.DateToDate,
-		&result.IcFr, &result.IcTo, &result.PriceBf, &result.Discount,
-		&result.Price, &result.Shashu, &result.CarIdNum, &result.EtcNum,
-		&result.Detail, &result.DtakoRowId, &result.Hash, &result.CreatedAt, &result.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrETCMeisaiNotFound
-		}
-		return nil, err
-	}
-
-	return &result, nil
+	return etcMeisaiTable.update(ctx, r.db, &row, nil, etag)
 }
 
-// Delete deletes an ETC meisai record
-func (r *ETCMeisaiRepository) Delete(ctx context.Context, id int64) error {
-	query := `DELETE FROM etc_meisai WHERE id = $1`
-
-	result, err := r.db.Exec(ctx, query, id)
-	if err != nil {
-		return err
-	}
-
-	if result.RowsAffected() == 0 {
-		return ErrETCMeisaiNotFound
-	}
-
-	return nil
+// Delete deletes an ETC meisai record. A non-empty etag must be the current one.
+func (r *ETCMeisaiRepository) Delete(ctx context.Context, id int64, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "ETCMeisaiRepository.Delete")
+	return etcMeisaiTable.delete(ctx, r.db, etag, id)
 }
 
-// ListParams contains parameters for listing ETC meisai
+// ETCMeisaiListParams contains parameters for listing ETC meisai
 type ETCMeisaiListParams struct {
-	PageSize  int
-	PageToken string // base64 encoded offset
-	DateFrom  *string
-	DateTo    *string
-	EtcNum    *string
+	Page     pagination.Page
+	DateFrom *string
+	DateTo   *string
+	EtcNum   *string
 }
 
-// List retrieves ETC meisai with optional filters
-func (r *ETCMeisaiRepository) List(ctx context.Context, params ETCMeisaiListParams) ([]*ETCMeisai, int, string, error) {
-	if params.PageSize <= 0 {
-		params.PageSize = 20
-	}
-	if params.PageSize > 100 {
-		params.PageSize = 100
-	}
-
-	offset := 0
-	if params.PageToken != "" {
-		// Decode page token as offset
-		// For simplicity, use numeric offset directly
-		// In production, consider using cursor-based pagination
-	}
-
-	// Build dynamic query
-	query := `
-		SELECT id, organization_id, date_fr, date_to, date_to_date, ic_fr, ic_to,
-			price_bf, discount, price, shashu, car_id_num, etc_num,
-			detail, dtako_row_id, hash, created_at, updated_at
-		FROM etc_meisai
-		WHERE 1=1
-	`
-	countQuery := `SELECT COUNT(*) FROM etc_meisai WHERE 1=1`
+// etcMeisaiOrder lists ETC meisai newest first, served by etc_meisai_organization_id_date_to_idx
+var etcMeisaiOrder = pagination.Order{
+	pagination.Desc("date_to", "timestamptz"),
+	pagination.Desc("id", "bigint"),
+}
 
-	args := []interface{}{}
-	argIndex := 1
+// List retrieves ETC meisai with optional filters. The total count covers all pages.
+func (r *ETCMeisaiRepository) List(ctx context.Context, params ETCMeisaiListParams) ([]*ETCMeisai, int, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "ETCMeisaiRepository.List")
+	var conds []string
+	args := []any{}
 
+	addFilter := func(clause string, value any) {
+		args = append(args, value)
+		conds = append(conds, fmt.Sprintf(clause, len(args)))
+	}
 	if params.DateFrom != nil && *params.DateFrom != "" {
-		query += ` AND date_to_date >= $` + string(rune('0'+argIndex))
-		countQuery += ` AND date_to_date >= $` + string(rune('0'+argIndex))
-		args = append(args, *params.DateFrom)
-		argIndex++
+		addFilter("date_to_date >= $%d", *params.DateFrom)
 	}
-
 	if params.DateTo != nil && *params.DateTo != "" {
-		query += ` AND date_to_date <= $` + string(rune('0'+argIndex))
-		countQuery += ` AND date_to_date <= $` + string(rune('0'+argIndex))
-		args = append(args, *params.DateTo)
-		argIndex++
+		addFilter("date_to_date <= $%d", *params.DateTo)
 	}
-
 	if params.EtcNum != nil && *params.EtcNum != "" {
-		query += ` AND etc_num = $` + string(rune('0'+argIndex))
-		countQuery += ` AND etc_num = $` + string(rune('0'+argIndex))
-		args = append(args, *params.EtcNum)
-		argIndex++
+		addFilter("etc_num = $%d", *params.EtcNum)
 	}
+	cond := strings.Join(conds, " AND ")
 
 	// Get total count
 	var totalCount int
-	err := r.db.QueryRow(ctx, countQuery, args...).Scan(&totalCount)
+	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM etc_meisai WHERE `+etcMeisaiTable.where(cond), args...).Scan(&totalCount)
 	if err != nil {
-		return nil, 0, "", err
+		return nil, 0, nil, err
 	}
 
-	// Add ordering and pagination
-	query += ` ORDER BY date_to DESC, id DESC`
-	query += ` LIMIT $` + string(rune('0'+argIndex)) + ` OFFSET $` + string(rune('0'+argIndex+1))
-	args = append(args, params.PageSize+1, offset) // +1 to check if more exists
-
-	rows, err := r.db.Query(ctx, query, args...)
+	page := pagination.Page{Size: params.Page.Limit(20, 100), After: params.Page.After}
+	results, next, err := etcMeisaiTable.list(ctx, r.db, ListOptions{Page: page}, nil, etcMeisaiOrder, cond, args...)
 	if err != nil {
-		return nil, 0, "", err
-	}
-	defer rows.Close()
-
-	var results []*ETCMeisai
-	for rows.Next() {
-		var m ETCMeisai
-		err := rows.Scan(
-			&m.ID, &m.OrganizationID, &m.DateFr, &m.DateTo, &m.DateToDate,
-			&m.IcFr, &m.IcTo, &m.PriceBf, &m.Discount,
-			&m.Price, &m.Shashu, &m.CarIdNum, &m.EtcNum,
-			&m.Detail, &m.DtakoRowId, &m.Hash, &m.CreatedAt, &m.UpdatedAt,
-		)
-		if err != nil {
-			return nil, 0, "", err
-		}
-		results = append(results, &m)
+		return nil, 0, nil, err
 	}
-
-	if err := rows.Err(); err != nil {
-		return nil, 0, "", err
-	}
-
-	// Determine next page token
-	nextPageToken := ""
-	if len(results) > params.PageSize {
-		results = results[:params.PageSize]
-		// Use simple offset-based pagination
-		nextPageToken = "more" // In production, encode actual cursor
-	}
-
-	return results, totalCount, nextPageToken, nil
+	return results, totalCount, next, nil
 }
 
 // BulkCreate creates multiple ETC meisai records, optionally skipping duplicates
 func (r *ETCMeisaiRepository) BulkCreate(ctx context.Context, organizationID string, records []*ETCMeisai, skipDuplicates bool) (int, int, []string, error) {
+	ctx = db.WithQueryLabel(ctx, "ETCMeisaiRepository.BulkCreate")
 	createdCount := 0
 	skippedCount := 0
 	var errors []string
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"testing"
 	"time"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_Files_CRUD(t *testing.T) {
@@ -22,7 +24,7 @@ func TestIntegration_Files_CRUD(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Setup: failed to create test organization: %v", err)
 	}
-	defer orgRepo.Delete(ctx, testOrg.ID)
+	defer orgRepo.Delete(ctx, testOrg.ID, "")
 
 	// 1. Create
 	t.Run("Create", func(t *testing.T) {
@@ -58,7 +60,7 @@ func TestIntegration_Files_CRUD(t *testing.T) {
 		// 3. Update
 		t.Run("Update", func(t *testing.T) {
 			newBlob := "updated blob data"
-			updated, err := repo.Update(ctx, f.UUID, "updated-file.txt", "pdf", &newBlob)
+			updated, err := repo.Update(ctx, f.UUID, "updated-file.txt", "pdf", &newBlob, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -73,7 +75,7 @@ func TestIntegration_Files_CRUD(t *testing.T) {
 
 		// 4. ListByOrganization
 		t.Run("ListByOrganization", func(t *testing.T) {
-			files, err := repo.ListByOrganization(ctx, testOrg.ID, 10, 0)
+			files, _, err := repo.ListByOrganization(ctx, testOrg.ID, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -85,7 +87,7 @@ func TestIntegration_Files_CRUD(t *testing.T) {
 
 		// 5. List
 		t.Run("List", func(t *testing.T) {
-			files, err := repo.List(ctx, 10, 0)
+			files, _, err := repo.List(ctx, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("List failed: %v", err)
 			}
@@ -98,7 +100,7 @@ func TestIntegration_Files_CRUD(t *testing.T) {
 		// 6. Delete
 		t.Run("Delete", func(t *testing.T) {
 			deletedAt := time.Now().Format(time.RFC3339)
-			err := repo.Delete(ctx, f.UUID, deletedAt)
+			err := repo.Delete(ctx, f.UUID, deletedAt, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
@@ -112,3 +114,66 @@ func TestIntegration_Files_CRUD(t *testing.T) {
 		})
 	})
 }
+
+// TestIntegration_Files_PagesStableUnderInserts lists files page by page while new files
+// are inserted ahead of and behind the current position. Every file that existed when
+// the listing started must appear exactly once.
+func TestIntegration_Files_PagesStableUnderInserts(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+
+	repo := NewFileRepository(pool)
+	orgRepo := NewOrganizationRepository(pool)
+	ctx := context.Background()
+
+	testOrg, err := orgRepo.Create(ctx, "Test Files Paging Org")
+	if err != nil {
+		t.Fatalf("Setup: failed to create test organization: %v", err)
+	}
+	defer orgRepo.Delete(ctx, testOrg.ID, "")
+
+	create := func(created string) string {
+		f, err := repo.Create(ctx, testOrg.ID, "page-"+created+".txt", created, "text", nil)
+		if err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		return f.UUID
+	}
+
+	want := map[string]bool{}
+	for i := 1; i <= 7; i++ {
+		want[create(fmt.Sprintf("2026-01-01T00:00:%02dZ", i*10))] = true
+	}
+
+	seen := map[string]int{}
+	var next pagination.Key
+	for page := 0; ; page++ {
+		files, key, err := repo.ListByOrganization(ctx, testOrg.ID, pagination.Page{Size: 2, After: next})
+		if err != nil {
+			t.Fatalf("ListByOrganization page %d failed: %v", page, err)
+		}
+		for _, f := range files {
+			seen[f.UUID]++
+		}
+		if key == nil {
+			break
+		}
+		next = key
+
+		// a newer file sorts before the listed pages (the shift offset paging repeats
+		// rows on), an older one after them
+		create(fmt.Sprintf("2026-01-02T00:00:%02dZ", page))
+		create(fmt.Sprintf("2025-12-31T00:00:%02dZ", page))
+	}
+
+	for uuid := range want {
+		if seen[uuid] != 1 {
+			t.Errorf("file %s listed %d times, want 1", uuid, seen[uuid])
+		}
+	}
+	for uuid, n := range seen {
+		if n > 1 {
+			t.Errorf("file %s listed %d times", uuid, n)
+		}
+	}
+}
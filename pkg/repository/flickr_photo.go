@@ -2,24 +2,28 @@ package repository
 
 import (
 	"context"
-	"errors"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrFlickrPhotoNotFound = errors.New("flickr photo not found")
+	ErrFlickrPhotoNotFound = notFound("flickr photo not found")
 )
 
 // FlickrPhoto represents the database model
 type FlickrPhoto struct {
-	ID             string
-	OrganizationID string
-	Secret         string
-	Server         string
+	ID             string `db:"id,pk"`
+	OrganizationID string `db:"organization_id,readonly"`
+	Secret         string `db:"secret"`
+	Server         string `db:"server"`
+	Etag           string `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var flickrPhotoTable = NewTable[FlickrPhoto]("flickr_photo", ErrFlickrPhotoNotFound)
+
 // FlickrPhotoRepository handles database operations for flickr_photo
 type FlickrPhotoRepository struct {
 	db DB
@@ -37,154 +41,41 @@ func NewFlickrPhotoRepositoryWithDB(db DB) *FlickrPhotoRepository {
 
 // Create inserts a new flickr photo
 func (r *FlickrPhotoRepository) Create(ctx context.Context, id, organizationID, secret, server string) (*FlickrPhoto, error) {
-	query := `
-		INSERT INTO flickr_photo (id, organization_id, secret, server)
-		VALUES ($1, $2, $3, $4)
-		RETURNING id, organization_id, secret, server
-	`
-
-	var photo FlickrPhoto
-	err := r.db.QueryRow(ctx, query, id, organizationID, secret, server).Scan(
-		&photo.ID, &photo.OrganizationID, &photo.Secret, &photo.Server,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &photo, nil
+	ctx = db.WithQueryLabel(ctx, "FlickrPhotoRepository.Create")
+	return flickrPhotoTable.insert(ctx, r.db, &FlickrPhoto{ID: id, OrganizationID: organizationID, Secret: secret, Server: server})
 }
 
 // GetByID retrieves a flickr photo by ID
 func (r *FlickrPhotoRepository) GetByID(ctx context.Context, id string) (*FlickrPhoto, error) {
-	query := `
-		SELECT id, organization_id, secret, server
-		FROM flickr_photo
-		WHERE id = $1
-	`
-
-	var photo FlickrPhoto
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&photo.ID, &photo.OrganizationID, &photo.Secret, &photo.Server,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrFlickrPhotoNotFound
-		}
-		return nil, err
-	}
-
-	return &photo, nil
+	ctx = db.WithQueryLabel(ctx, "FlickrPhotoRepository.GetByID")
+	return flickrPhotoTable.get(ctx, r.db, id)
 }
 
-// Update modifies an existing flickr photo
-func (r *FlickrPhotoRepository) Update(ctx context.Context, id, secret, server string) (*FlickrPhoto, error) {
-	query := `
-		UPDATE flickr_photo
-		SET secret = $2, server = $3
-		WHERE id = $1
-		RETURNING id, organization_id, secret, server
-	`
-
-	var photo FlickrPhoto
-	err := r.db.QueryRow(ctx, query, id, secret, server).Scan(
-		&photo.ID, &photo.OrganizationID, &photo.Secret, &photo.Server,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrFlickrPhotoNotFound
-		}
-		return nil, err
-	}
-
-	return &photo, nil
+// Update modifies an existing flickr photo. A non-empty etag must be the current one.
+func (r *FlickrPhotoRepository) Update(ctx context.Context, id, secret, server, etag string) (*FlickrPhoto, error) {
+	ctx = db.WithQueryLabel(ctx, "FlickrPhotoRepository.Update")
+	return flickrPhotoTable.update(ctx, r.db, &FlickrPhoto{ID: id, Secret: secret, Server: server}, nil, etag)
 }
 
-// Delete hard-deletes a flickr photo
-func (r *FlickrPhotoRepository) Delete(ctx context.Context, id string) error {
-	query := `
-		DELETE FROM flickr_photo
-		WHERE id = $1
-	`
-
-	result, err := r.db.Exec(ctx, query, id)
-	if err != nil {
-		return err
-	}
-
-	if result.RowsAffected() == 0 {
-		return ErrFlickrPhotoNotFound
-	}
+// Delete hard-deletes a flickr photo. A non-empty etag must be the current one.
+func (r *FlickrPhotoRepository) Delete(ctx context.Context, id, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "FlickrPhotoRepository.Delete")
+	return flickrPhotoTable.delete(ctx, r.db, etag, id)
+}
 
-	return nil
+// flickrPhotoOrder lists photos by id
+var flickrPhotoOrder = pagination.Order{
+	pagination.Asc("id", "text"),
 }
 
 // List retrieves flickr photos with pagination
-func (r *FlickrPhotoRepository) List(ctx context.Context, limit int, offset int) ([]*FlickrPhoto, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT id, organization_id, secret, server
-		FROM flickr_photo
-		ORDER BY id ASC
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var photos []*FlickrPhoto
-	for rows.Next() {
-		var photo FlickrPhoto
-		err := rows.Scan(&photo.ID, &photo.OrganizationID, &photo.Secret, &photo.Server)
-		if err != nil {
-			return nil, err
-		}
-		photos = append(photos, &photo)
-	}
-
-	return photos, rows.Err()
+func (r *FlickrPhotoRepository) List(ctx context.Context, page pagination.Page) ([]*FlickrPhoto, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "FlickrPhotoRepository.List")
+	return flickrPhotoTable.list(ctx, r.db, ListOptions{Page: page}, nil, flickrPhotoOrder, "")
 }
 
 // ListByOrganization retrieves flickr photos for a specific organization with pagination
-func (r *FlickrPhotoRepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*FlickrPhoto, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT id, organization_id, secret, server
-		FROM flickr_photo
-		WHERE organization_id = $1
-		ORDER BY id ASC
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var photos []*FlickrPhoto
-	for rows.Next() {
-		var photo FlickrPhoto
-		err := rows.Scan(&photo.ID, &photo.OrganizationID, &photo.Secret, &photo.Server)
-		if err != nil {
-			return nil, err
-		}
-		photos = append(photos, &photo)
-	}
-
-	return photos, rows.Err()
+func (r *FlickrPhotoRepository) ListByOrganization(ctx context.Context, organizationID string, page pagination.Page) ([]*FlickrPhoto, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "FlickrPhotoRepository.ListByOrganization")
+	return flickrPhotoTable.list(ctx, r.db, ListOptions{Page: page}, nil, flickrPhotoOrder, "organization_id = $1", organizationID)
 }
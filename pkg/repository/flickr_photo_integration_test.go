@@ -6,6 +6,8 @@ import (
 	"context"
 	"fmt"
 	"testing"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_FlickrPhoto_CRUD(t *testing.T) {
@@ -21,7 +23,7 @@ func TestIntegration_FlickrPhoto_CRUD(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create test organization: %v", err)
 	}
-	defer orgRepo.Delete(ctx, org.ID) // Clean up organization at the end
+	defer orgRepo.Delete(ctx, org.ID, "") // Clean up organization at the end
 
 	// 1. Create
 	t.Run("Create", func(t *testing.T) {
@@ -66,7 +68,7 @@ func TestIntegration_FlickrPhoto_CRUD(t *testing.T) {
 
 		// 3. Update
 		t.Run("Update", func(t *testing.T) {
-			updated, err := photoRepo.Update(ctx, photo.ID, "newsecret999", "newserver888")
+			updated, err := photoRepo.Update(ctx, photo.ID, "newsecret999", "newserver888", "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -87,17 +89,17 @@ func TestIntegration_FlickrPhoto_CRUD(t *testing.T) {
 		if err != nil {
 			t.Fatalf("Create second photo failed: %v", err)
 		}
-		defer photoRepo.Delete(ctx, photo2.ID)
+		defer photoRepo.Delete(ctx, photo2.ID, "")
 
 		photo3, err := photoRepo.Create(ctx, "33333333333", org.ID, "secret333", "server333")
 		if err != nil {
 			t.Fatalf("Create third photo failed: %v", err)
 		}
-		defer photoRepo.Delete(ctx, photo3.ID)
+		defer photoRepo.Delete(ctx, photo3.ID, "")
 
 		// 5. List
 		t.Run("List", func(t *testing.T) {
-			photos, err := photoRepo.List(ctx, 10, 0)
+			photos, _, err := photoRepo.List(ctx, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("List failed: %v", err)
 			}
@@ -109,7 +111,7 @@ func TestIntegration_FlickrPhoto_CRUD(t *testing.T) {
 
 		// 6. ListByOrganization
 		t.Run("ListByOrganization", func(t *testing.T) {
-			photos, err := photoRepo.ListByOrganization(ctx, org.ID, 10, 0)
+			photos, _, err := photoRepo.ListByOrganization(ctx, org.ID, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -127,7 +129,7 @@ func TestIntegration_FlickrPhoto_CRUD(t *testing.T) {
 
 		// 7. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := photoRepo.Delete(ctx, photo.ID)
+			err := photoRepo.Delete(ctx, photo.ID, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
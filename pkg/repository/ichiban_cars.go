@@ -2,32 +2,36 @@ package repository
 
 import (
 	"context"
-	"errors"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrIchibanCarNotFound = errors.New("ichiban car not found")
+	ErrIchibanCarNotFound = notFound("ichiban car not found")
 )
 
 // IchibanCar represents the database model
 type IchibanCar struct {
-	ID             string
-	OrganizationID string
-	ID4            string
-	Name           *string
-	NameR          *string
-	Shashu         string
-	Sekisai        *float64
-	RegDate        *string
-	ParchDate      *string
-	ScrapDate      *string
-	BumonCodeID    *string
-	DriverID       *string
+	ID             string   `db:"id,pk"`
+	OrganizationID string   `db:"organization_id,pk"`
+	ID4            string   `db:"id4"`
+	Name           *string  `db:"name"`
+	NameR          *string  `db:"name_R"`
+	Shashu         string   `db:"shashu"`
+	Sekisai        *float64 `db:"sekisai"`
+	RegDate        *string  `db:"reg_date"`
+	ParchDate      *string  `db:"parch_date"`
+	ScrapDate      *string  `db:"scrap_date"`
+	BumonCodeID    *string  `db:"bumon_code_id"`
+	DriverID       *string  `db:"driver_id"`
+	Etag           string   `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var ichibanCarTable = NewTable[IchibanCar]("ichiban_cars", ErrIchibanCarNotFound)
+
 // IchibanCarRepository handles database operations for ichiban_cars
 type IchibanCarRepository struct {
 	db DB
@@ -45,154 +49,71 @@ func NewIchibanCarRepositoryWithDB(db DB) *IchibanCarRepository {
 
 // Create inserts a new ichiban car
 func (r *IchibanCarRepository) Create(ctx context.Context, id, organizationID, id4, shashu string, name, nameR *string, sekisai *float64, regDate, parchDate, scrapDate, bumonCodeID, driverID *string) (*IchibanCar, error) {
-	query := `
-		INSERT INTO ichiban_cars (id, organization_id, id4, name, "name_R", shashu, sekisai, reg_date, parch_date, scrap_date, bumon_code_id, driver_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
-		RETURNING id, organization_id, id4, name, "name_R", shashu, sekisai, reg_date, parch_date, scrap_date, bumon_code_id, driver_id
-	`
-
-	var car IchibanCar
-	err := r.db.QueryRow(ctx, query, id, organizationID, id4, name, nameR, shashu, sekisai, regDate, parchDate, scrapDate, bumonCodeID, driverID).Scan(
-		&car.ID, &car.OrganizationID, &car.ID4, &car.Name, &car.NameR, &car.Shashu, &car.Sekisai, &car.RegDate, &car.ParchDate, &car.ScrapDate, &car.BumonCodeID, &car.DriverID,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &car, nil
+	ctx = db.WithQueryLabel(ctx, "IchibanCarRepository.Create")
+	return ichibanCarTable.insert(ctx, r.db, &IchibanCar{
+		ID:             id,
+		OrganizationID: organizationID,
+		ID4:            id4,
+		Name:           name,
+		NameR:          nameR,
+		Shashu:         shashu,
+		Sekisai:        sekisai,
+		RegDate:        regDate,
+		ParchDate:      parchDate,
+		ScrapDate:      scrapDate,
+		BumonCodeID:    bumonCodeID,
+		DriverID:       driverID,
+	})
 }
 
 // GetByIDAndOrg retrieves an ichiban car by composite primary key (id, organization_id)
 func (r *IchibanCarRepository) GetByIDAndOrg(ctx context.Context, id, organizationID string) (*IchibanCar, error) {
-	query := `
-		SELECT id, organization_id, id4, name, "name_R", shashu, sekisai, reg_date, parch_date, scrap_date, bumon_code_id, driver_id
-		FROM ichiban_cars
-		WHERE id = $1 AND organization_id = $2
-	`
-
-	var car IchibanCar
-	err := r.db.QueryRow(ctx, query, id, organizationID).Scan(
-		&car.ID, &car.OrganizationID, &car.ID4, &car.Name, &car.NameR, &car.Shashu, &car.Sekisai, &car.RegDate, &car.ParchDate, &car.ScrapDate, &car.BumonCodeID, &car.DriverID,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrIchibanCarNotFound
-		}
-		return nil, err
-	}
-
-	return &car, nil
+	ctx = db.WithQueryLabel(ctx, "IchibanCarRepository.GetByIDAndOrg")
+	return ichibanCarTable.get(ctx, r.db, id, organizationID)
 }
 
-// Update modifies an existing ichiban car
-func (r *IchibanCarRepository) Update(ctx context.Context, id, organizationID, id4, shashu string, name, nameR *string, sekisai *float64, regDate, parchDate, scrapDate, bumonCodeID, driverID *string) (*IchibanCar, error) {
-	query := `
-		UPDATE ichiban_cars
-		SET id4 = $3, name = $4, "name_R" = $5, shashu = $6, sekisai = $7, reg_date = $8, parch_date = $9, scrap_date = $10, bumon_code_id = $11, driver_id = $12
-		WHERE id = $1 AND organization_id = $2
-		RETURNING id, organization_id, id4, name, "name_R", shashu, sekisai, reg_date, parch_date, scrap_date, bumon_code_id, driver_id
-	`
-
-	var car IchibanCar
-	err := r.db.QueryRow(ctx, query, id, organizationID, id4, name, nameR, shashu, sekisai, regDate, parchDate, scrapDate, bumonCodeID, driverID).Scan(
-		&car.ID, &car.OrganizationID, &car.ID4, &car.Name, &car.NameR, &car.Shashu, &car.Sekisai, &car.RegDate, &car.ParchDate, &car.ScrapDate, &car.BumonCodeID, &car.DriverID,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrIchibanCarNotFound
-		}
-		return nil, err
-	}
-
-	return &car, nil
+// Update modifies an existing ichiban car. A non-empty etag must be the current one.
+func (r *IchibanCarRepository) Update(ctx context.Context, id, organizationID, id4, shashu string, name, nameR *string, sekisai *float64, regDate, parchDate, scrapDate, bumonCodeID, driverID *string, etag string) (*IchibanCar, error) {
+	ctx = db.WithQueryLabel(ctx, "IchibanCarRepository.Update")
+	return ichibanCarTable.update(ctx, r.db, &IchibanCar{
+		ID:             id,
+		OrganizationID: organizationID,
+		ID4:            id4,
+		Name:           name,
+		NameR:          nameR,
+		Shashu:         shashu,
+		Sekisai:        sekisai,
+		RegDate:        regDate,
+		ParchDate:      parchDate,
+		ScrapDate:      scrapDate,
+		BumonCodeID:    bumonCodeID,
+		DriverID:       driverID,
+	}, nil, etag)
 }
 
-// Delete hard-deletes an ichiban car (no soft delete for this table)
-func (r *IchibanCarRepository) Delete(ctx context.Context, id, organizationID string) error {
-	query := `
-		DELETE FROM ichiban_cars
-		WHERE id = $1 AND organization_id = $2
-	`
-
-	result, err := r.db.Exec(ctx, query, id, organizationID)
-	if err != nil {
-		return err
-	}
-
-	if result.RowsAffected() == 0 {
-		return ErrIchibanCarNotFound
-	}
+// Delete hard-deletes an ichiban car (no soft delete for this table).
+// A non-empty etag must be the current one.
+func (r *IchibanCarRepository) Delete(ctx context.Context, id, organizationID, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "IchibanCarRepository.Delete")
+	return ichibanCarTable.delete(ctx, r.db, etag, id, organizationID)
+}
 
-	return nil
+// ichibanCarOrder lists the cars of an organization by id
+var ichibanCarOrder = pagination.Order{
+	pagination.Asc("id", "text"),
 }
 
+// ichibanCarListOrder lists the cars of all visible organizations
+var ichibanCarListOrder = append(pagination.Order{pagination.Asc("organization_id", "text")}, ichibanCarOrder...)
+
 // ListByOrganization retrieves ichiban cars by organization with pagination
-func (r *IchibanCarRepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*IchibanCar, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT id, organization_id, id4, name, "name_R", shashu, sekisai, reg_date, parch_date, scrap_date, bumon_code_id, driver_id
-		FROM ichiban_cars
-		WHERE organization_id = $1
-		ORDER BY id
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var cars []*IchibanCar
-	for rows.Next() {
-		var car IchibanCar
-		err := rows.Scan(&car.ID, &car.OrganizationID, &car.ID4, &car.Name, &car.NameR, &car.Shashu, &car.Sekisai, &car.RegDate, &car.ParchDate, &car.ScrapDate, &car.BumonCodeID, &car.DriverID)
-		if err != nil {
-			return nil, err
-		}
-		cars = append(cars, &car)
-	}
-
-	return cars, rows.Err()
+func (r *IchibanCarRepository) ListByOrganization(ctx context.Context, organizationID string, page pagination.Page) ([]*IchibanCar, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "IchibanCarRepository.ListByOrganization")
+	return ichibanCarTable.list(ctx, r.db, ListOptions{Page: page}, nil, ichibanCarOrder, "organization_id = $1", organizationID)
 }
 
 // List retrieves all ichiban cars with pagination
-func (r *IchibanCarRepository) List(ctx context.Context, limit int, offset int) ([]*IchibanCar, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT id, organization_id, id4, name, "name_R", shashu, sekisai, reg_date, parch_date, scrap_date, bumon_code_id, driver_id
-		FROM ichiban_cars
-		ORDER BY organization_id, id
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var cars []*IchibanCar
-	for rows.Next() {
-		var car IchibanCar
-		err := rows.Scan(&car.ID, &car.OrganizationID, &car.ID4, &car.Name, &car.NameR, &car.Shashu, &car.Sekisai, &car.RegDate, &car.ParchDate, &car.ScrapDate, &car.BumonCodeID, &car.DriverID)
-		if err != nil {
-			return nil, err
-		}
-		cars = append(cars, &car)
-	}
-
-	return cars, rows.Err()
+func (r *IchibanCarRepository) List(ctx context.Context, page pagination.Page) ([]*IchibanCar, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "IchibanCarRepository.List")
+	return ichibanCarTable.list(ctx, r.db, ListOptions{Page: page}, nil, ichibanCarListOrder, "")
 }
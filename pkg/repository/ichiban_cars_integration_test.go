@@ -6,6 +6,8 @@ import (
 	"context"
 	"fmt"
 	"testing"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_IchibanCars_CRUD(t *testing.T) {
@@ -21,7 +23,7 @@ func TestIntegration_IchibanCars_CRUD(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Setup: failed to create test organization: %v", err)
 	}
-	defer orgRepo.Delete(ctx, testOrg.ID)
+	defer orgRepo.Delete(ctx, testOrg.ID, "")
 
 	// 1. Create
 	t.Run("Create", func(t *testing.T) {
@@ -85,7 +87,7 @@ func TestIntegration_IchibanCars_CRUD(t *testing.T) {
 			newName := "Updated Car"
 			newSekisai := 4.0
 
-			updated, err := repo.Update(ctx, id, testOrg.ID, newID4, newShashu, &newName, &nameR, &newSekisai, &regDate, &parchDate, nil, &bumonCodeID, &driverID)
+			updated, err := repo.Update(ctx, id, testOrg.ID, newID4, newShashu, &newName, &nameR, &newSekisai, &regDate, &parchDate, nil, &bumonCodeID, &driverID, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -106,7 +108,7 @@ func TestIntegration_IchibanCars_CRUD(t *testing.T) {
 
 		// 4. ListByOrganization
 		t.Run("ListByOrganization", func(t *testing.T) {
-			cars, err := repo.ListByOrganization(ctx, testOrg.ID, 10, 0)
+			cars, _, err := repo.ListByOrganization(ctx, testOrg.ID, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -118,7 +120,7 @@ func TestIntegration_IchibanCars_CRUD(t *testing.T) {
 
 		// 5. List
 		t.Run("List", func(t *testing.T) {
-			cars, err := repo.List(ctx, 10, 0)
+			cars, _, err := repo.List(ctx, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("List failed: %v", err)
 			}
@@ -130,7 +132,7 @@ func TestIntegration_IchibanCars_CRUD(t *testing.T) {
 
 		// 6. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, id, testOrg.ID)
+			err := repo.Delete(ctx, id, testOrg.ID, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
@@ -158,13 +160,13 @@ func TestIntegration_IchibanCars_MultipleOrganizations(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Setup: failed to create org1: %v", err)
 	}
-	defer orgRepo.Delete(ctx, org1.ID)
+	defer orgRepo.Delete(ctx, org1.ID, "")
 
 	org2, err := orgRepo.Create(ctx, "Org 2")
 	if err != nil {
 		t.Fatalf("Setup: failed to create org2: %v", err)
 	}
-	defer orgRepo.Delete(ctx, org2.ID)
+	defer orgRepo.Delete(ctx, org2.ID, "")
 
 	t.Run("CompositeKeyIsolation", func(t *testing.T) {
 		// Same car ID can exist in different organizations
@@ -178,14 +180,14 @@ func TestIntegration_IchibanCars_MultipleOrganizations(t *testing.T) {
 		if err != nil {
 			t.Fatalf("Create car in org1 failed: %v", err)
 		}
-		defer repo.Delete(ctx, carID, org1.ID)
+		defer repo.Delete(ctx, carID, org1.ID, "")
 
 		// Create car with same ID in org2
 		car2, err := repo.Create(ctx, carID, org2.ID, id4, shashu, nil, nil, &sekisai, nil, nil, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("Create car in org2 failed: %v", err)
 		}
-		defer repo.Delete(ctx, carID, org2.ID)
+		defer repo.Delete(ctx, carID, org2.ID, "")
 
 		// Verify both exist independently
 		fetchedCar1, err := repo.GetByIDAndOrg(ctx, carID, org1.ID)
@@ -207,7 +209,7 @@ func TestIntegration_IchibanCars_MultipleOrganizations(t *testing.T) {
 		fmt.Printf("✓ CompositeKeyIsolation: Same car ID exists in both org1=%s and org2=%s\n", car1.OrganizationID, car2.OrganizationID)
 
 		// Verify ListByOrganization returns only the org's cars
-		org1Cars, err := repo.ListByOrganization(ctx, org1.ID, 100, 0)
+		org1Cars, _, err := repo.ListByOrganization(ctx, org1.ID, pagination.Page{Size: 100})
 		if err != nil {
 			t.Fatalf("ListByOrganization for org1 failed: %v", err)
 		}
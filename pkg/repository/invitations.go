@@ -8,32 +8,36 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrInvitationNotFound = errors.New("invitation not found")
+	ErrInvitationNotFound = notFound("invitation not found")
 	ErrInvitationExpired  = errors.New("invitation expired")
 	ErrInvitationUsed     = errors.New("invitation already used")
 )
 
 // Invitation represents the database model
 type Invitation struct {
-	ID             string
-	OrganizationID string
-	Email          string
-	Role           string
-	Token          string
-	InvitedBy      string
-	Status         string // 'pending', 'accepted', 'expired', 'cancelled'
-	ExpiresAt      time.Time
-	AcceptedAt     *time.Time
-	AcceptedBy     *string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID             string     `db:"id,pk"`
+	OrganizationID string     `db:"organization_id"`
+	Email          string     `db:"email"`
+	Role           string     `db:"role"`
+	Token          string     `db:"token"`
+	InvitedBy      string     `db:"invited_by"`
+	Status         string     `db:"status"` // 'pending', 'accepted', 'expired', 'cancelled'
+	ExpiresAt      time.Time  `db:"expires_at"`
+	AcceptedAt     *time.Time `db:"accepted_at"`
+	AcceptedBy     *string    `db:"accepted_by"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
 }
 
+var invitationTable = NewTable[Invitation]("invitations", ErrInvitationNotFound)
+
 // InvitationRepository handles database operations for invitations
 type InvitationRepository struct {
 	db DB
@@ -60,7 +64,7 @@ func generateToken() (string, error) {
 
 // Create inserts a new invitation
 func (r *InvitationRepository) Create(ctx context.Context, organizationID, email, role, invitedBy string) (*Invitation, error) {
-	id := uuid.New().String()
+	ctx = db.WithQueryLabel(ctx, "InvitationRepository.Create")
 	now := time.Now()
 	expiresAt := now.Add(7 * 24 * time.Hour) // 7 days expiry
 
@@ -73,75 +77,35 @@ func (r *InvitationRepository) Create(ctx context.Context, organizationID, email
 		role = "member"
 	}
 
-	query := `
-		INSERT INTO invitations (id, organization_id, email, role, token, invited_by, status, expires_at, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, 'pending', $7, $8, $9)
-		RETURNING id, organization_id, email, role, token, invited_by, status, expires_at, accepted_at, accepted_by, created_at, updated_at
-	`
-
-	var inv Invitation
-	err = r.db.QueryRow(ctx, query, id, organizationID, email, role, token, invitedBy, expiresAt, now, now).Scan(
-		&inv.ID, &inv.OrganizationID, &inv.Email, &inv.Role, &inv.Token,
-		&inv.InvitedBy, &inv.Status, &inv.ExpiresAt, &inv.AcceptedAt, &inv.AcceptedBy,
-		&inv.CreatedAt, &inv.UpdatedAt,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &inv, nil
+	return invitationTable.insert(ctx, r.db, &Invitation{
+		ID:             uuid.New().String(),
+		OrganizationID: organizationID,
+		Email:          email,
+		Role:           role,
+		Token:          token,
+		InvitedBy:      invitedBy,
+		Status:         "pending",
+		ExpiresAt:      expiresAt,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
 }
 
 // GetByID retrieves an invitation by ID
 func (r *InvitationRepository) GetByID(ctx context.Context, id string) (*Invitation, error) {
-	query := `
-		SELECT id, organization_id, email, role, token, invited_by, status, expires_at, accepted_at, accepted_by, created_at, updated_at
-		FROM invitations
-		WHERE id = $1
-	`
-
-	var inv Invitation
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&inv.ID, &inv.OrganizationID, &inv.Email, &inv.Role, &inv.Token,
-		&inv.InvitedBy, &inv.Status, &inv.ExpiresAt, &inv.AcceptedAt, &inv.AcceptedBy,
-		&inv.CreatedAt, &inv.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrInvitationNotFound
-		}
-		return nil, err
-	}
-
-	return &inv, nil
+	ctx = db.WithQueryLabel(ctx, "InvitationRepository.GetByID")
+	return invitationTable.get(ctx, r.db, id)
 }
 
 // GetByToken retrieves an invitation by token
 func (r *InvitationRepository) GetByToken(ctx context.Context, token string) (*Invitation, error) {
-	query := `
-		SELECT id, organization_id, email, role, token, invited_by, status, expires_at, accepted_at, accepted_by, created_at, updated_at
-		FROM invitations
-		WHERE token = $1
-	`
-
-	var inv Invitation
-	err := r.db.QueryRow(ctx, query, token).Scan(
-		&inv.ID, &inv.OrganizationID, &inv.Email, &inv.Role, &inv.Token,
-		&inv.InvitedBy, &inv.Status, &inv.ExpiresAt, &inv.AcceptedAt, &inv.AcceptedBy,
-		&inv.CreatedAt, &inv.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrInvitationNotFound
-		}
-		return nil, err
-	}
-
-	return &inv, nil
+	ctx = db.WithQueryLabel(ctx, "InvitationRepository.GetByToken")
+	return invitationTable.find(ctx, r.db, "token = $1", token)
 }
 
 // Accept marks an invitation as accepted
 func (r *InvitationRepository) Accept(ctx context.Context, id, acceptedBy string) (*Invitation, error) {
+	ctx = db.WithQueryLabel(ctx, "InvitationRepository.Accept")
 	now := time.Now()
 
 	// First check status and expiry
@@ -163,24 +127,20 @@ func (r *InvitationRepository) Accept(ctx context.Context, id, acceptedBy string
 	query := `
 		UPDATE invitations
 		SET status = 'accepted', accepted_at = $2, accepted_by = $3, updated_at = $4
-		WHERE id = $1
-		RETURNING id, organization_id, email, role, token, invited_by, status, expires_at, accepted_at, accepted_by, created_at, updated_at
-	`
-
-	err = r.db.QueryRow(ctx, query, id, now, acceptedBy, now).Scan(
-		&inv.ID, &inv.OrganizationID, &inv.Email, &inv.Role, &inv.Token,
-		&inv.InvitedBy, &inv.Status, &inv.ExpiresAt, &inv.AcceptedAt, &inv.AcceptedBy,
-		&inv.CreatedAt, &inv.UpdatedAt,
-	)
-	if err != nil {
-		return nil, err
-	}
+		WHERE id = $1 AND status = 'pending'
+		RETURNING ` + invitationTable.selectList()
 
-	return inv, nil
+	inv, err = invitationTable.queryRow(ctx, r.db, query, id, now, acceptedBy, now)
+	// Accepted concurrently since the status check above
+	if errors.Is(err, ErrInvitationNotFound) {
+		return nil, ErrInvitationUsed
+	}
+	return inv, err
 }
 
 // Cancel marks an invitation as cancelled
 func (r *InvitationRepository) Cancel(ctx context.Context, id string) error {
+	ctx = db.WithQueryLabel(ctx, "InvitationRepository.Cancel")
 	query := `UPDATE invitations SET status = 'cancelled', updated_at = $2 WHERE id = $1 AND status = 'pending'`
 
 	result, err := r.db.Exec(ctx, query, id, time.Now())
@@ -195,63 +155,27 @@ func (r *InvitationRepository) Cancel(ctx context.Context, id string) error {
 	return nil
 }
 
-// List retrieves invitations for an organization with optional status filter
-func (r *InvitationRepository) List(ctx context.Context, organizationID, status string, limit, offset int) ([]*Invitation, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	var query string
-	var args []interface{}
+// invitationOrder lists invitations newest first
+var invitationOrder = pagination.Order{
+	pagination.Desc("created_at", "timestamptz"),
+	pagination.Desc("id", "uuid"),
+}
 
+// List retrieves invitations for an organization with optional status filter and pagination
+func (r *InvitationRepository) List(ctx context.Context, organizationID, status string, page pagination.Page) ([]*Invitation, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "InvitationRepository.List")
+	cond := "organization_id = $1"
+	args := []any{organizationID}
 	if status != "" {
-		query = `
-			SELECT id, organization_id, email, role, token, invited_by, status, expires_at, accepted_at, accepted_by, created_at, updated_at
-			FROM invitations
-			WHERE organization_id = $1 AND status = $2
-			ORDER BY created_at DESC
-			LIMIT $3 OFFSET $4
-		`
-		args = []interface{}{organizationID, status, limit, offset}
-	} else {
-		query = `
-			SELECT id, organization_id, email, role, token, invited_by, status, expires_at, accepted_at, accepted_by, created_at, updated_at
-			FROM invitations
-			WHERE organization_id = $1
-			ORDER BY created_at DESC
-			LIMIT $2 OFFSET $3
-		`
-		args = []interface{}{organizationID, limit, offset}
-	}
-
-	rows, err := r.db.Query(ctx, query, args...)
-	if err != nil {
-		return nil, err
+		cond += " AND status = $2"
+		args = append(args, status)
 	}
-	defer rows.Close()
-
-	var invitations []*Invitation
-	for rows.Next() {
-		var inv Invitation
-		err := rows.Scan(
-			&inv.ID, &inv.OrganizationID, &inv.Email, &inv.Role, &inv.Token,
-			&inv.InvitedBy, &inv.Status, &inv.ExpiresAt, &inv.AcceptedAt, &inv.AcceptedBy,
-			&inv.CreatedAt, &inv.UpdatedAt,
-		)
-		if err != nil {
-			return nil, err
-		}
-		invitations = append(invitations, &inv)
-	}
-
-	return invitations, rows.Err()
+	return invitationTable.list(ctx, r.db, ListOptions{Page: page}, nil, invitationOrder, cond, args...)
 }
 
 // Resend regenerates the token and extends the expiry
 func (r *InvitationRepository) Resend(ctx context.Context, id string) (*Invitation, error) {
+	ctx = db.WithQueryLabel(ctx, "InvitationRepository.Resend")
 	now := time.Now()
 	expiresAt := now.Add(7 * 24 * time.Hour)
 
@@ -264,45 +188,13 @@ func (r *InvitationRepository) Resend(ctx context.Context, id string) (*Invitati
 		UPDATE invitations
 		SET token = $2, expires_at = $3, status = 'pending', updated_at = $4
 		WHERE id = $1 AND status IN ('pending', 'expired')
-		RETURNING id, organization_id, email, role, token, invited_by, status, expires_at, accepted_at, accepted_by, created_at, updated_at
-	`
-
-	var inv Invitation
-	err = r.db.QueryRow(ctx, query, id, token, expiresAt, now).Scan(
-		&inv.ID, &inv.OrganizationID, &inv.Email, &inv.Role, &inv.Token,
-		&inv.InvitedBy, &inv.Status, &inv.ExpiresAt, &inv.AcceptedAt, &inv.AcceptedBy,
-		&inv.CreatedAt, &inv.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrInvitationNotFound
-		}
-		return nil, err
-	}
+		RETURNING ` + invitationTable.selectList()
 
-	return &inv, nil
+	return invitationTable.queryRow(ctx, r.db, query, id, token, expiresAt, now)
 }
 
 // GetPendingByEmailAndOrg checks if there's already a pending invitation for email+org
 func (r *InvitationRepository) GetPendingByEmailAndOrg(ctx context.Context, email, organizationID string) (*Invitation, error) {
-	query := `
-		SELECT id, organization_id, email, role, token, invited_by, status, expires_at, accepted_at, accepted_by, created_at, updated_at
-		FROM invitations
-		WHERE email = $1 AND organization_id = $2 AND status = 'pending'
-	`
-
-	var inv Invitation
-	err := r.db.QueryRow(ctx, query, email, organizationID).Scan(
-		&inv.ID, &inv.OrganizationID, &inv.Email, &inv.Role, &inv.Token,
-		&inv.InvitedBy, &inv.Status, &inv.ExpiresAt, &inv.AcceptedAt, &inv.AcceptedBy,
-		&inv.CreatedAt, &inv.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrInvitationNotFound
-		}
-		return nil, err
-	}
-
-	return &inv, nil
+	ctx = db.WithQueryLabel(ctx, "InvitationRepository.GetPendingByEmailAndOrg")
+	return invitationTable.find(ctx, r.db, "email = $1 AND organization_id = $2 AND status = 'pending'", email, organizationID)
 }
@@ -2,71 +2,75 @@ package repository
 
 import (
 	"context"
-	"errors"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrKudgcstNotFound = errors.New("kudgcst not found")
+	ErrKudgcstNotFound = notFound("kudgcst not found")
 )
 
 // Kudgcst represents the database model for kudgcst table (フェリー運賃データ)
 type Kudgcst struct {
-	UUID           string
-	OrganizationID string
-	Hash           string
-	Created        string
-	Deleted        *string
-	KudguriUuid    *string
+	UUID           string  `db:"UUID,pk"`
+	OrganizationID string  `db:"OrganizationID"`
+	Hash           string  `db:"Hash"`
+	Created        string  `db:"Created,readonly"`
+	Deleted        *string `db:"Deleted,deleted,readonly"`
+	KudguriUuid    *string `db:"KudguriUuid"`
 
 	// 運行基本情報
-	UnkouNo   *string
-	UnkouDate *string
-	ReadDate  *string
+	UnkouNo   *string `db:"UnkouNo"`
+	UnkouDate *string `db:"UnkouDate"`
+	ReadDate  *string `db:"ReadDate"`
 
 	// 営業所・車両情報
-	OfficeCd    *string
-	OfficeName  *string
-	VehicleCd   *string
-	VehicleName *string
+	OfficeCd    *string `db:"OfficeCd"`
+	OfficeName  *string `db:"OfficeName"`
+	VehicleCd   *string `db:"VehicleCd"`
+	VehicleName *string `db:"VehicleName"`
 
 	// ドライバー情報
-	DriverCd1        *string
-	DriverName1      *string
-	TargetDriverType string
+	DriverCd1        *string `db:"DriverCd1"`
+	DriverName1      *string `db:"DriverName1"`
+	TargetDriverType string  `db:"TargetDriverType"`
 
 	// フェリー運行情報
-	StartDatetime *string
-	EndDatetime   *string
+	StartDatetime *string `db:"StartDatetime"`
+	EndDatetime   *string `db:"EndDatetime"`
 
 	// フェリー会社情報
-	FerryCompanyCd   *string
-	FerryCompanyName *string
+	FerryCompanyCd   *string `db:"FerryCompanyCd"`
+	FerryCompanyName *string `db:"FerryCompanyName"`
 
 	// 乗船・降船情報
-	BoardingPlaceCd   *string
-	BoardingPlaceName *string
-	TripNumber        *string
-	DropoffPlaceCd    *string
-	DropoffPlaceName  *string
+	BoardingPlaceCd   *string `db:"BoardingPlaceCd"`
+	BoardingPlaceName *string `db:"BoardingPlaceName"`
+	TripNumber        *string `db:"TripNumber"`
+	DropoffPlaceCd    *string `db:"DropoffPlaceCd"`
+	DropoffPlaceName  *string `db:"DropoffPlaceName"`
 
 	// 運賃情報
-	SettlementType     *string
-	SettlementTypeName *string
-	StandardFare       *string
-	ContractFare       *string
+	SettlementType     *string `db:"SettlementType"`
+	SettlementTypeName *string `db:"SettlementTypeName"`
+	StandardFare       *string `db:"StandardFare"`
+	ContractFare       *string `db:"ContractFare"`
 
 	// 車両区分
-	FerryVehicleType     *string
-	FerryVehicleTypeName *string
+	FerryVehicleType     *string `db:"FerryVehicleType"`
+	FerryVehicleTypeName *string `db:"FerryVehicleTypeName"`
 
 	// 距離
-	AssumedDistance *string
+	AssumedDistance *string `db:"AssumedDistance"`
+	Etag            string  `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var kudgcstTable = NewTable[Kudgcst]("kudgcst", ErrKudgcstNotFound)
+
 // KudgcstRepository handles database operations for kudgcst
 type KudgcstRepository struct {
 	db DB
@@ -84,327 +88,61 @@ func NewKudgcstRepositoryWithDB(db DB) *KudgcstRepository {
 
 // Create inserts a new kudgcst record
 func (r *KudgcstRepository) Create(ctx context.Context, k *Kudgcst) (*Kudgcst, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgcstRepository.Create")
 	id := uuid.New().String()
 	k.UUID = id
 
-	query := `
-		INSERT INTO kudgcst (
-			"UUID", "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"UnkouNo", "UnkouDate", "ReadDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "TargetDriverType",
-			"StartDatetime", "EndDatetime",
-			"FerryCompanyCd", "FerryCompanyName",
-			"BoardingPlaceCd", "BoardingPlaceName", "TripNumber",
-			"DropoffPlaceCd", "DropoffPlaceName",
-			"SettlementType", "SettlementTypeName", "StandardFare", "ContractFare",
-			"FerryVehicleType", "FerryVehicleTypeName",
-			"AssumedDistance"
-		)
-		VALUES (
-			$1, $2, $3, $4, $5, $6,
-			$7, $8, $9,
-			$10, $11, $12, $13,
-			$14, $15, $16,
-			$17, $18,
-			$19, $20,
-			$21, $22, $23,
-			$24, $25,
-			$26, $27, $28, $29,
-			$30, $31,
-			$32
-		)
-		RETURNING
-			"UUID", "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"UnkouNo", "UnkouDate", "ReadDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "TargetDriverType",
-			"StartDatetime", "EndDatetime",
-			"FerryCompanyCd", "FerryCompanyName",
-			"BoardingPlaceCd", "BoardingPlaceName", "TripNumber",
-			"DropoffPlaceCd", "DropoffPlaceName",
-			"SettlementType", "SettlementTypeName", "StandardFare", "ContractFare",
-			"FerryVehicleType", "FerryVehicleTypeName",
-			"AssumedDistance"
-	`
-
-	var result Kudgcst
-	err := r.db.QueryRow(ctx, query,
-		k.UUID, k.OrganizationID, k.Hash, k.Created, k.Deleted, k.KudguriUuid,
-		k.UnkouNo, k.UnkouDate, k.ReadDate,
-		k.OfficeCd, k.OfficeName, k.VehicleCd, k.VehicleName,
-		k.DriverCd1, k.DriverName1, k.TargetDriverType,
-		k.StartDatetime, k.EndDatetime,
-		k.FerryCompanyCd, k.FerryCompanyName,
-		k.BoardingPlaceCd, k.BoardingPlaceName, k.TripNumber,
-		k.DropoffPlaceCd, k.DropoffPlaceName,
-		k.SettlementType, k.SettlementTypeName, k.StandardFare, k.ContractFare,
-		k.FerryVehicleType, k.FerryVehicleTypeName,
-		k.AssumedDistance,
-	).Scan(
-		&result.UUID, &result.OrganizationID, &result.Hash, &result.Created, &result.Deleted, &result.KudguriUuid,
-		&result.UnkouNo, &result.UnkouDate, &result.ReadDate,
-		&result.OfficeCd, &result.OfficeName, &result.VehicleCd, &result.VehicleName,
-		&result.DriverCd1, &result.DriverName1, &result.TargetDriverType,
-		&result.StartDatetime, &result.EndDatetime,
-		&result.FerryCompanyCd, &result.FerryCompanyName,
-		&result.BoardingPlaceCd, &result.BoardingPlaceName, &result.TripNumber,
-		&result.DropoffPlaceCd, &result.DropoffPlaceName,
-		&result.SettlementType, &result.SettlementTypeName, &result.StandardFare, &result.ContractFare,
-		&result.FerryVehicleType, &result.FerryVehicleTypeName,
-		&result.AssumedDistance,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &result, nil
+	return kudgcstTable.insert(ctx, r.db, k)
 }
 
 // GetByUUID retrieves a kudgcst record by UUID
 func (r *KudgcstRepository) GetByUUID(ctx context.Context, uuid string) (*Kudgcst, error) {
-	query := `
-		SELECT
-			"UUID", "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"UnkouNo", "UnkouDate", "ReadDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "TargetDriverType",
-			"StartDatetime", "EndDatetime",
-			"FerryCompanyCd", "FerryCompanyName",
-			"BoardingPlaceCd", "BoardingPlaceName", "TripNumber",
-			"DropoffPlaceCd", "DropoffPlaceName",
-			"SettlementType", "SettlementTypeName", "StandardFare", "ContractFare",
-			"FerryVehicleType", "FerryVehicleTypeName",
-			"AssumedDistance"
-		FROM kudgcst
-		WHERE "UUID" = $1 AND "Deleted" IS NULL
-	`
-
-	var k Kudgcst
-	err := r.db.QueryRow(ctx, query, uuid).Scan(
-		&k.UUID, &k.OrganizationID, &k.Hash, &k.Created, &k.Deleted, &k.KudguriUuid,
-		&k.UnkouNo, &k.UnkouDate, &k.ReadDate,
-		&k.OfficeCd, &k.OfficeName, &k.VehicleCd, &k.VehicleName,
-		&k.DriverCd1, &k.DriverName1, &k.TargetDriverType,
-		&k.StartDatetime, &k.EndDatetime,
-		&k.FerryCompanyCd, &k.FerryCompanyName,
-		&k.BoardingPlaceCd, &k.BoardingPlaceName, &k.TripNumber,
-		&k.DropoffPlaceCd, &k.DropoffPlaceName,
-		&k.SettlementType, &k.SettlementTypeName, &k.StandardFare, &k.ContractFare,
-		&k.FerryVehicleType, &k.FerryVehicleTypeName,
-		&k.AssumedDistance,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrKudgcstNotFound
-		}
-		return nil, err
-	}
-
-	return &k, nil
+	ctx = db.WithQueryLabel(ctx, "KudgcstRepository.GetByUUID")
+	return kudgcstTable.get(ctx, r.db, uuid)
 }
 
-// Update modifies an existing kudgcst record
-func (r *KudgcstRepository) Update(ctx context.Context, k *Kudgcst) (*Kudgcst, error) {
-	query := `
-		UPDATE kudgcst
-		SET
-			"OrganizationID" = $2, "Hash" = $3, "KudguriUuid" = $4,
-			"UnkouNo" = $5, "UnkouDate" = $6, "ReadDate" = $7,
-			"OfficeCd" = $8, "OfficeName" = $9, "VehicleCd" = $10, "VehicleName" = $11,
-			"DriverCd1" = $12, "DriverName1" = $13, "TargetDriverType" = $14,
-			"StartDatetime" = $15, "EndDatetime" = $16,
-			"FerryCompanyCd" = $17, "FerryCompanyName" = $18,
-			"BoardingPlaceCd" = $19, "BoardingPlaceName" = $20, "TripNumber" = $21,
-			"DropoffPlaceCd" = $22, "DropoffPlaceName" = $23,
-			"SettlementType" = $24, "SettlementTypeName" = $25, "StandardFare" = $26, "ContractFare" = $27,
-			"FerryVehicleType" = $28, "FerryVehicleTypeName" = $29,
-			"AssumedDistance" = $30
-		WHERE "UUID" = $1 AND "Deleted" IS NULL
-		RETURNING
-			"UUID", "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"UnkouNo", "UnkouDate", "ReadDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "TargetDriverType",
-			"StartDatetime", "EndDatetime",
-			"FerryCompanyCd", "FerryCompanyName",
-			"BoardingPlaceCd", "BoardingPlaceName", "TripNumber",
-			"DropoffPlaceCd", "DropoffPlaceName",
-			"SettlementType", "SettlementTypeName", "StandardFare", "ContractFare",
-			"FerryVehicleType", "FerryVehicleTypeName",
-			"AssumedDistance"
-	`
-
-	var result Kudgcst
-	err := r.db.QueryRow(ctx, query,
-		k.UUID, k.OrganizationID, k.Hash, k.KudguriUuid,
-		k.UnkouNo, k.UnkouDate, k.ReadDate,
-		k.OfficeCd, k.OfficeName, k.VehicleCd, k.VehicleName,
-		k.DriverCd1, k.DriverName1, k.TargetDriverType,
-		k.StartDatetime, k.EndDatetime,
-		k.FerryCompanyCd, k.FerryCompanyName,
-		k.BoardingPlaceCd, k.BoardingPlaceName, k.TripNumber,
-		k.DropoffPlaceCd, k.DropoffPlaceName,
-		k.SettlementType, k.SettlementTypeName, k.StandardFare, k.ContractFare,
-		k.FerryVehicleType, k.FerryVehicleTypeName,
-		k.AssumedDistance,
-	).Scan(
-		&result.UUID, &result.OrganizationID, &result.Hash, &result.Created, &result.Deleted, &result.KudguriUuid,
-		&result.UnkouNo, &result.UnkouDate, &result.ReadDate,
-		&result.OfficeCd, &result.OfficeName, &result.VehicleCd, &result.VehicleName,
-		&result.DriverCd1, &result.DriverName1, &result.TargetDriverType,
-		&result.StartDatetime, &result.EndDatetime,
-		&result.FerryCompanyCd, &result.FerryCompanyName,
-		&result.BoardingPlaceCd, &result.BoardingPlaceName, &result.TripNumber,
-		&result.DropoffPlaceCd, &result.DropoffPlaceName,
-		&result.SettlementType, &result.SettlementTypeName, &result.StandardFare, &result.ContractFare,
-		&result.FerryVehicleType, &result.FerryVehicleTypeName,
-		&result.AssumedDistance,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrKudgcstNotFound
-		}
-		return nil, err
-	}
-
-	return &result, nil
+// Update modifies an existing kudgcst record. A non-empty etag must be the current one.
+func (r *KudgcstRepository) Update(ctx context.Context, k *Kudgcst, etag string) (*Kudgcst, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgcstRepository.Update")
+	return kudgcstTable.update(ctx, r.db, k, nil, etag)
 }
 
-// Delete soft-deletes a kudgcst record
-func (r *KudgcstRepository) Delete(ctx context.Context, uuid, deletedTimestamp string) error {
-	query := `
-		UPDATE kudgcst
-		SET "Deleted" = $2
-		WHERE "UUID" = $1 AND "Deleted" IS NULL
-	`
-
-	result, err := r.db.Exec(ctx, query, uuid, deletedTimestamp)
-	if err != nil {
-		return err
-	}
+// Delete soft-deletes a kudgcst record. A non-empty etag must be the current one.
+func (r *KudgcstRepository) Delete(ctx context.Context, uuid, deletedTimestamp, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "KudgcstRepository.Delete")
+	return kudgcstTable.softDelete(ctx, r.db, deletedTimestamp, etag, uuid)
+}
 
-	if result.RowsAffected() == 0 {
-		return ErrKudgcstNotFound
-	}
+// kudgcstFields are the fields kudgcst listings can be filtered and ordered by
+var kudgcstFields = ListFields{
+	"created":            {Column: `"Created"`, Type: "text"},
+	"kudguri_uuid":       {Column: `"KudguriUuid"`, Type: "text", Nullable: true},
+	"unkou_no":           {Column: `"UnkouNo"`, Type: "text", Nullable: true},
+	"unkou_date":         {Column: `"UnkouDate"`, Type: "text", Nullable: true},
+	"read_date":          {Column: `"ReadDate"`, Type: "text", Nullable: true},
+	"office_cd":          {Column: `"OfficeCd"`, Type: "text", Nullable: true},
+	"vehicle_cd":         {Column: `"VehicleCd"`, Type: "text", Nullable: true},
+	"vehicle_name":       {Column: `"VehicleName"`, Type: "text", Nullable: true},
+	"driver_cd1":         {Column: `"DriverCd1"`, Type: "text", Nullable: true},
+	"target_driver_type": {Column: `"TargetDriverType"`, Type: "text"},
+	"start_datetime":     {Column: `"StartDatetime"`, Type: "text", Nullable: true},
+	"end_datetime":       {Column: `"EndDatetime"`, Type: "text", Nullable: true},
+}
 
-	return nil
+// kudgcstOrder lists kudgcst newest first; "UUID" makes the order unique
+var kudgcstOrder = pagination.Order{
+	pagination.Desc(`"Created"`, "text"),
+	pagination.Desc(`"UUID"`, "text"),
 }
 
 // ListByOrganization retrieves kudgcst records by organization with pagination
-func (r *KudgcstRepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*Kudgcst, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT
-			"UUID", "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"UnkouNo", "UnkouDate", "ReadDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "TargetDriverType",
-			"StartDatetime", "EndDatetime",
-			"FerryCompanyCd", "FerryCompanyName",
-			"BoardingPlaceCd", "BoardingPlaceName", "TripNumber",
-			"DropoffPlaceCd", "DropoffPlaceName",
-			"SettlementType", "SettlementTypeName", "StandardFare", "ContractFare",
-			"FerryVehicleType", "FerryVehicleTypeName",
-			"AssumedDistance"
-		FROM kudgcst
-		WHERE "OrganizationID" = $1 AND "Deleted" IS NULL
-		ORDER BY "Created" DESC
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var results []*Kudgcst
-	for rows.Next() {
-		var k Kudgcst
-		err := rows.Scan(
-			&k.UUID, &k.OrganizationID, &k.Hash, &k.Created, &k.Deleted, &k.KudguriUuid,
-			&k.UnkouNo, &k.UnkouDate, &k.ReadDate,
-			&k.OfficeCd, &k.OfficeName, &k.VehicleCd, &k.VehicleName,
-			&k.DriverCd1, &k.DriverName1, &k.TargetDriverType,
-			&k.StartDatetime, &k.EndDatetime,
-			&k.FerryCompanyCd, &k.FerryCompanyName,
-			&k.BoardingPlaceCd, &k.BoardingPlaceName, &k.TripNumber,
-			&k.DropoffPlaceCd, &k.DropoffPlaceName,
-			&k.SettlementType, &k.SettlementTypeName, &k.StandardFare, &k.ContractFare,
-			&k.FerryVehicleType, &k.FerryVehicleTypeName,
-			&k.AssumedDistance,
-		)
-		if err != nil {
-			return nil, err
-		}
-		results = append(results, &k)
-	}
-
-	return results, rows.Err()
+func (r *KudgcstRepository) ListByOrganization(ctx context.Context, organizationID string, opts ListOptions) ([]*Kudgcst, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgcstRepository.ListByOrganization")
+	return kudgcstTable.list(ctx, r.db, opts, kudgcstFields, kudgcstOrder, `"OrganizationID" = $1`, organizationID)
 }
 
 // List retrieves all kudgcst records with pagination
-func (r *KudgcstRepository) List(ctx context.Context, limit int, offset int) ([]*Kudgcst, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT
-			"UUID", "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"UnkouNo", "UnkouDate", "ReadDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "TargetDriverType",
-			"StartDatetime", "EndDatetime",
-			"FerryCompanyCd", "FerryCompanyName",
-			"BoardingPlaceCd", "BoardingPlaceName", "TripNumber",
-			"DropoffPlaceCd", "DropoffPlaceName",
-			"SettlementType", "SettlementTypeName", "StandardFare", "ContractFare",
-			"FerryVehicleType", "FerryVehicleTypeName",
-			"AssumedDistance"
-		FROM kudgcst
-		WHERE "Deleted" IS NULL
-		ORDER BY "Created" DESC
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var results []*Kudgcst
-	for rows.Next() {
-		var k Kudgcst
-		err := rows.Scan(
-			&k.UUID, &k.OrganizationID, &k.Hash, &k.Created, &k.Deleted, &k.KudguriUuid,
-			&k.UnkouNo, &k.UnkouDate, &k.ReadDate,
-			&k.OfficeCd, &k.OfficeName, &k.VehicleCd, &k.VehicleName,
-			&k.DriverCd1, &k.DriverName1, &k.TargetDriverType,
-			&k.StartDatetime, &k.EndDatetime,
-			&k.FerryCompanyCd, &k.FerryCompanyName,
-			&k.BoardingPlaceCd, &k.BoardingPlaceName, &k.TripNumber,
-			&k.DropoffPlaceCd, &k.DropoffPlaceName,
-			&k.SettlementType, &k.SettlementTypeName, &k.StandardFare, &k.ContractFare,
-			&k.FerryVehicleType, &k.FerryVehicleTypeName,
-			&k.AssumedDistance,
-		)
-		if err != nil {
-			return nil, err
-		}
-		results = append(results, &k)
-	}
-
-	return results, rows.Err()
+func (r *KudgcstRepository) List(ctx context.Context, opts ListOptions) ([]*Kudgcst, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgcstRepository.List")
+	return kudgcstTable.list(ctx, r.db, opts, kudgcstFields, kudgcstOrder, "")
 }
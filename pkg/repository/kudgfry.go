@@ -2,51 +2,55 @@ package repository
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrKudgfryNotFound = errors.New("kudgfry not found")
+	ErrKudgfryNotFound = notFound("kudgfry not found")
 )
 
 // Kudgfry represents the database model
 type Kudgfry struct {
-	UUID                        string
-	OrganizationID              string
-	Hash                        string
-	Created                     string
-	Deleted                     *string
-	KudguriUuid                 *string
-	TargetDriverType            string
-	UnkouNo                     *string
-	UnkouDate                   *string
-	ReadDate                    *string
-	OfficeCd                    *string
-	OfficeName                  *string
-	VehicleCd                   *string
-	VehicleName                 *string
-	DriverCd1                   *string
-	DriverName1                 *string
-	DriverCd2                   *string
-	DriverName2                 *string
-	RelevantDatetime            *string
-	RefuelInspectCategory       *string
-	RefuelInspectCategoryName   *string
-	RefuelInspectType           *string
-	RefuelInspectTypeName       *string
-	RefuelInspectKind           *string
-	RefuelInspectKindName       *string
-	RefillAmount                *string
-	OwnOtherType                *string
-	Mileage                     *string
-	MeterValue                  *string
+	UUID                      string  `db:"uuid,pk"`
+	OrganizationID            string  `db:"OrganizationID"`
+	Hash                      string  `db:"Hash"`
+	Created                   string  `db:"Created,readonly"`
+	Deleted                   *string `db:"Deleted,deleted,readonly"`
+	KudguriUuid               *string `db:"KudguriUuid"`
+	TargetDriverType          string  `db:"TargetDriverType"`
+	UnkouNo                   *string `db:"UnkouNo"`
+	UnkouDate                 *string `db:"UnkouDate"`
+	ReadDate                  *string `db:"ReadDate"`
+	OfficeCd                  *string `db:"OfficeCd"`
+	OfficeName                *string `db:"OfficeName"`
+	VehicleCd                 *string `db:"VehicleCd"`
+	VehicleName               *string `db:"VehicleName"`
+	DriverCd1                 *string `db:"DriverCd1"`
+	DriverName1               *string `db:"DriverName1"`
+	DriverCd2                 *string `db:"DriverCd2"`
+	DriverName2               *string `db:"DriverName2"`
+	RelevantDatetime          *string `db:"RelevantDatetime"`
+	RefuelInspectCategory     *string `db:"RefuelInspectCategory"`
+	RefuelInspectCategoryName *string `db:"RefuelInspectCategoryName"`
+	RefuelInspectType         *string `db:"RefuelInspectType"`
+	RefuelInspectTypeName     *string `db:"RefuelInspectTypeName"`
+	RefuelInspectKind         *string `db:"RefuelInspectKind"`
+	RefuelInspectKindName     *string `db:"RefuelInspectKindName"`
+	RefillAmount              *string `db:"RefillAmount"`
+	OwnOtherType              *string `db:"OwnOtherType"`
+	Mileage                   *string `db:"mileage"`
+	MeterValue                *string `db:"MeterValue"`
+	Etag                      string  `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var kudgfryTable = NewTable[Kudgfry]("kudgfry", ErrKudgfryNotFound)
+
 // KudgfryRepository handles database operations for kudgfry
 type KudgfryRepository struct {
 	db DB
@@ -64,6 +68,7 @@ func NewKudgfryRepositoryWithDB(db DB) *KudgfryRepository {
 
 // Create inserts a new kudgfry record
 func (r *KudgfryRepository) Create(ctx context.Context, kudgfry *Kudgfry) (*Kudgfry, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgfryRepository.Create")
 	if kudgfry.UUID == "" {
 		kudgfry.UUID = uuid.New().String()
 	}
@@ -71,283 +76,57 @@ func (r *KudgfryRepository) Create(ctx context.Context, kudgfry *Kudgfry) (*Kudg
 		kudgfry.Created = time.Now().Format(time.RFC3339)
 	}
 
-	query := `
-		INSERT INTO kudgfry (
-			uuid, "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"TargetDriverType", "UnkouNo", "UnkouDate", "ReadDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "DriverCd2", "DriverName2",
-			"RelevantDatetime", "RefuelInspectCategory", "RefuelInspectCategoryName",
-			"RefuelInspectType", "RefuelInspectTypeName", "RefuelInspectKind",
-			"RefuelInspectKindName", "RefillAmount", "OwnOtherType",
-			mileage, "MeterValue"
-		)
-		VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10,
-			$11, $12, $13, $14, $15, $16, $17, $18,
-			$19, $20, $21, $22, $23, $24, $25, $26,
-			$27, $28, $29
-		)
-		RETURNING
-			uuid, "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"TargetDriverType", "UnkouNo", "UnkouDate", "ReadDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "DriverCd2", "DriverName2",
-			"RelevantDatetime", "RefuelInspectCategory", "RefuelInspectCategoryName",
-			"RefuelInspectType", "RefuelInspectTypeName", "RefuelInspectKind",
-			"RefuelInspectKindName", "RefillAmount", "OwnOtherType",
-			mileage, "MeterValue"
-	`
-
-	var result Kudgfry
-	err := r.db.QueryRow(ctx, query,
-		kudgfry.UUID, kudgfry.OrganizationID, kudgfry.Hash, kudgfry.Created,
-		kudgfry.Deleted, kudgfry.KudguriUuid, kudgfry.TargetDriverType,
-		kudgfry.UnkouNo, kudgfry.UnkouDate, kudgfry.ReadDate,
-		kudgfry.OfficeCd, kudgfry.OfficeName, kudgfry.VehicleCd, kudgfry.VehicleName,
-		kudgfry.DriverCd1, kudgfry.DriverName1, kudgfry.DriverCd2, kudgfry.DriverName2,
-		kudgfry.RelevantDatetime, kudgfry.RefuelInspectCategory, kudgfry.RefuelInspectCategoryName,
-		kudgfry.RefuelInspectType, kudgfry.RefuelInspectTypeName, kudgfry.RefuelInspectKind,
-		kudgfry.RefuelInspectKindName, kudgfry.RefillAmount, kudgfry.OwnOtherType,
-		kudgfry.Mileage, kudgfry.MeterValue,
-	).Scan(
-		&result.UUID, &result.OrganizationID, &result.Hash, &result.Created,
-		&result.Deleted, &result.KudguriUuid, &result.TargetDriverType,
-		&result.UnkouNo, &result.UnkouDate, &result.ReadDate,
-		&result.OfficeCd, &result.OfficeName, &result.VehicleCd, &result.VehicleName,
-		&result.DriverCd1, &result.DriverName1, &result.DriverCd2, &result.DriverName2,
-		&result.RelevantDatetime, &result.RefuelInspectCategory, &result.RefuelInspectCategoryName,
-		&result.RefuelInspectType, &result.RefuelInspectTypeName, &result.RefuelInspectKind,
-		&result.RefuelInspectKindName, &result.RefillAmount, &result.OwnOtherType,
-		&result.Mileage, &result.MeterValue,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &result, nil
+	return kudgfryTable.insert(ctx, r.db, kudgfry)
 }
 
 // GetByUUID retrieves a kudgfry record by UUID
 func (r *KudgfryRepository) GetByUUID(ctx context.Context, uuid string) (*Kudgfry, error) {
-	query := `
-		SELECT
-			uuid, "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"TargetDriverType", "UnkouNo", "UnkouDate", "ReadDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "DriverCd2", "DriverName2",
-			"RelevantDatetime", "RefuelInspectCategory", "RefuelInspectCategoryName",
-			"RefuelInspectType", "RefuelInspectTypeName", "RefuelInspectKind",
-			"RefuelInspectKindName", "RefillAmount", "OwnOtherType",
-			mileage, "MeterValue"
-		FROM kudgfry
-		WHERE uuid = $1 AND "Deleted" IS NULL
-	`
-
-	var kudgfry Kudgfry
-	err := r.db.QueryRow(ctx, query, uuid).Scan(
-		&kudgfry.UUID, &kudgfry.OrganizationID, &kudgfry.Hash, &kudgfry.Created,
-		&kudgfry.Deleted, &kudgfry.KudguriUuid, &kudgfry.TargetDriverType,
-		&kudgfry.UnkouNo, &kudgfry.UnkouDate, &kudgfry.ReadDate,
-		&kudgfry.OfficeCd, &kudgfry.OfficeName, &kudgfry.VehicleCd, &kudgfry.VehicleName,
-		&kudgfry.DriverCd1, &kudgfry.DriverName1, &kudgfry.DriverCd2, &kudgfry.DriverName2,
-		&kudgfry.RelevantDatetime, &kudgfry.RefuelInspectCategory, &kudgfry.RefuelInspectCategoryName,
-		&kudgfry.RefuelInspectType, &kudgfry.RefuelInspectTypeName, &kudgfry.RefuelInspectKind,
-		&kudgfry.RefuelInspectKindName, &kudgfry.RefillAmount, &kudgfry.OwnOtherType,
-		&kudgfry.Mileage, &kudgfry.MeterValue,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrKudgfryNotFound
-		}
-		return nil, err
-	}
-
-	return &kudgfry, nil
+	ctx = db.WithQueryLabel(ctx, "KudgfryRepository.GetByUUID")
+	return kudgfryTable.get(ctx, r.db, uuid)
 }
 
-// Update modifies an existing kudgfry record
-func (r *KudgfryRepository) Update(ctx context.Context, kudgfry *Kudgfry) (*Kudgfry, error) {
-	query := `
-		UPDATE kudgfry
-		SET
-			"OrganizationID" = $2, "Hash" = $3, "KudguriUuid" = $4,
-			"TargetDriverType" = $5, "UnkouNo" = $6, "UnkouDate" = $7, "ReadDate" = $8,
-			"OfficeCd" = $9, "OfficeName" = $10, "VehicleCd" = $11, "VehicleName" = $12,
-			"DriverCd1" = $13, "DriverName1" = $14, "DriverCd2" = $15, "DriverName2" = $16,
-			"RelevantDatetime" = $17, "RefuelInspectCategory" = $18, "RefuelInspectCategoryName" = $19,
-			"RefuelInspectType" = $20, "RefuelInspectTypeName" = $21, "RefuelInspectKind" = $22,
-			"RefuelInspectKindName" = $23, "RefillAmount" = $24, "OwnOtherType" = $25,
-			mileage = $26, "MeterValue" = $27
-		WHERE uuid = $1 AND "Deleted" IS NULL
-		RETURNING
-			uuid, "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"TargetDriverType", "UnkouNo", "UnkouDate", "ReadDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "DriverCd2", "DriverName2",
-			"RelevantDatetime", "RefuelInspectCategory", "RefuelInspectCategoryName",
-			"RefuelInspectType", "RefuelInspectTypeName", "RefuelInspectKind",
-			"RefuelInspectKindName", "RefillAmount", "OwnOtherType",
-			mileage, "MeterValue"
-	`
-
-	var result Kudgfry
-	err := r.db.QueryRow(ctx, query,
-		kudgfry.UUID, kudgfry.OrganizationID, kudgfry.Hash,
-		kudgfry.KudguriUuid, kudgfry.TargetDriverType,
-		kudgfry.UnkouNo, kudgfry.UnkouDate, kudgfry.ReadDate,
-		kudgfry.OfficeCd, kudgfry.OfficeName, kudgfry.VehicleCd, kudgfry.VehicleName,
-		kudgfry.DriverCd1, kudgfry.DriverName1, kudgfry.DriverCd2, kudgfry.DriverName2,
-		kudgfry.RelevantDatetime, kudgfry.RefuelInspectCategory, kudgfry.RefuelInspectCategoryName,
-		kudgfry.RefuelInspectType, kudgfry.RefuelInspectTypeName, kudgfry.RefuelInspectKind,
-		kudgfry.RefuelInspectKindName, kudgfry.RefillAmount, kudgfry.OwnOtherType,
-		kudgfry.Mileage, kudgfry.MeterValue,
-	).Scan(
-		&result.UUID, &result.OrganizationID, &result.Hash, &result.Created,
-		&result.Deleted, &result.KudguriUuid, &result.TargetDriverType,
-		&result.UnkouNo, &result.UnkouDate, &result.ReadDate,
-		&result.OfficeCd, &result.OfficeName, &result.VehicleCd, &result.VehicleName,
-		&result.DriverCd1, &result.DriverName1, &result.DriverCd2, &result.DriverName2,
-		&result.RelevantDatetime, &result.RefuelInspectCategory, &result.RefuelInspectCategoryName,
-		&result.RefuelInspectType, &result.RefuelInspectTypeName, &result.RefuelInspectKind,
-		&result.RefuelInspectKindName, &result.RefillAmount, &result.OwnOtherType,
-		&result.Mileage, &result.MeterValue,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrKudgfryNotFound
-		}
-		return nil, err
-	}
-
-	return &result, nil
+// Update modifies an existing kudgfry record. A non-empty etag must be the current one.
+func (r *KudgfryRepository) Update(ctx context.Context, kudgfry *Kudgfry, etag string) (*Kudgfry, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgfryRepository.Update")
+	return kudgfryTable.update(ctx, r.db, kudgfry, nil, etag)
 }
 
-// Delete soft-deletes a kudgfry record
-func (r *KudgfryRepository) Delete(ctx context.Context, uuid string) error {
+// Delete soft-deletes a kudgfry record. A non-empty etag must be the current one.
+func (r *KudgfryRepository) Delete(ctx context.Context, uuid, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "KudgfryRepository.Delete")
 	deletedAt := time.Now().Format(time.RFC3339)
-	query := `
-		UPDATE kudgfry
-		SET "Deleted" = $2
-		WHERE uuid = $1 AND "Deleted" IS NULL
-	`
-
-	result, err := r.db.Exec(ctx, query, uuid, deletedAt)
-	if err != nil {
-		return err
-	}
+	return kudgfryTable.softDelete(ctx, r.db, deletedAt, etag, uuid)
+}
 
-	if result.RowsAffected() == 0 {
-		return ErrKudgfryNotFound
-	}
+// kudgfryFields are the fields kudgfry listings can be filtered and ordered by
+var kudgfryFields = ListFields{
+	"created":            {Column: `"Created"`, Type: "text"},
+	"kudguri_uuid":       {Column: `"KudguriUuid"`, Type: "text", Nullable: true},
+	"target_driver_type": {Column: `"TargetDriverType"`, Type: "text"},
+	"unkou_no":           {Column: `"UnkouNo"`, Type: "text", Nullable: true},
+	"unkou_date":         {Column: `"UnkouDate"`, Type: "text", Nullable: true},
+	"read_date":          {Column: `"ReadDate"`, Type: "text", Nullable: true},
+	"office_cd":          {Column: `"OfficeCd"`, Type: "text", Nullable: true},
+	"vehicle_cd":         {Column: `"VehicleCd"`, Type: "text", Nullable: true},
+	"vehicle_name":       {Column: `"VehicleName"`, Type: "text", Nullable: true},
+	"driver_cd1":         {Column: `"DriverCd1"`, Type: "text", Nullable: true},
+	"relevant_datetime":  {Column: `"RelevantDatetime"`, Type: "text", Nullable: true},
+}
 
-	return nil
+// kudgfryOrder lists kudgfry newest first; uuid makes the order unique
+var kudgfryOrder = pagination.Order{
+	pagination.Desc(`"Created"`, "text"),
+	pagination.Desc(`uuid`, "text"),
 }
 
 // ListByOrganization retrieves kudgfry records for a specific organization with pagination
-func (r *KudgfryRepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*Kudgfry, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT
-			uuid, "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"TargetDriverType", "UnkouNo", "UnkouDate", "ReadDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "DriverCd2", "DriverName2",
-			"RelevantDatetime", "RefuelInspectCategory", "RefuelInspectCategoryName",
-			"RefuelInspectType", "RefuelInspectTypeName", "RefuelInspectKind",
-			"RefuelInspectKindName", "RefillAmount", "OwnOtherType",
-			mileage, "MeterValue"
-		FROM kudgfry
-		WHERE "OrganizationID" = $1 AND "Deleted" IS NULL
-		ORDER BY "Created" DESC
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var kudgfrys []*Kudgfry
-	for rows.Next() {
-		var kudgfry Kudgfry
-		err := rows.Scan(
-			&kudgfry.UUID, &kudgfry.OrganizationID, &kudgfry.Hash, &kudgfry.Created,
-			&kudgfry.Deleted, &kudgfry.KudguriUuid, &kudgfry.TargetDriverType,
-			&kudgfry.UnkouNo, &kudgfry.UnkouDate, &kudgfry.ReadDate,
-			&kudgfry.OfficeCd, &kudgfry.OfficeName, &kudgfry.VehicleCd, &kudgfry.VehicleName,
-			&kudgfry.DriverCd1, &kudgfry.DriverName1, &kudgfry.DriverCd2, &kudgfry.DriverName2,
-			&kudgfry.RelevantDatetime, &kudgfry.RefuelInspectCategory, &kudgfry.RefuelInspectCategoryName,
-			&kudgfry.RefuelInspectType, &kudgfry.RefuelInspectTypeName, &kudgfry.RefuelInspectKind,
-			&kudgfry.RefuelInspectKindName, &kudgfry.RefillAmount, &kudgfry.OwnOtherType,
-			&kudgfry.Mileage, &kudgfry.MeterValue,
-		)
-		if err != nil {
-			return nil, err
-		}
-		kudgfrys = append(kudgfrys, &kudgfry)
-	}
-
-	return kudgfrys, rows.Err()
+func (r *KudgfryRepository) ListByOrganization(ctx context.Context, organizationID string, opts ListOptions) ([]*Kudgfry, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgfryRepository.ListByOrganization")
+	return kudgfryTable.list(ctx, r.db, opts, kudgfryFields, kudgfryOrder, `"OrganizationID" = $1`, organizationID)
 }
 
 // List retrieves all kudgfry records with pagination
-func (r *KudgfryRepository) List(ctx context.Context, limit int, offset int) ([]*Kudgfry, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT
-			uuid, "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"TargetDriverType", "UnkouNo", "UnkouDate", "ReadDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "DriverCd2", "DriverName2",
-			"RelevantDatetime", "RefuelInspectCategory", "RefuelInspectCategoryName",
-			"RefuelInspectType", "RefuelInspectTypeName", "RefuelInspectKind",
-			"RefuelInspectKindName", "RefillAmount", "OwnOtherType",
-			mileage, "MeterValue"
-		FROM kudgfry
-		WHERE "Deleted" IS NULL
-		ORDER BY "Created" DESC
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var kudgfrys []*Kudgfry
-	for rows.Next() {
-		var kudgfry Kudgfry
-		err := rows.Scan(
-			&kudgfry.UUID, &kudgfry.OrganizationID, &kudgfry.Hash, &kudgfry.Created,
-			&kudgfry.Deleted, &kudgfry.KudguriUuid, &kudgfry.TargetDriverType,
-			&kudgfry.UnkouNo, &kudgfry.UnkouDate, &kudgfry.ReadDate,
-			&kudgfry.OfficeCd, &kudgfry.OfficeName, &kudgfry.VehicleCd, &kudgfry.VehicleName,
-			&kudgfry.DriverCd1, &kudgfry.DriverName1, &kudgfry.DriverCd2, &kudgfry.DriverName2,
-			&kudgfry.RelevantDatetime, &kudgfry.RefuelInspectCategory, &kudgfry.RefuelInspectCategoryName,
-			&kudgfry.RefuelInspectType, &kudgfry.RefuelInspectTypeName, &kudgfry.RefuelInspectKind,
-			&kudgfry.RefuelInspectKindName, &kudgfry.RefillAmount, &kudgfry.OwnOtherType,
-			&kudgfry.Mileage, &kudgfry.MeterValue,
-		)
-		if err != nil {
-			return nil, err
-		}
-		kudgfrys = append(kudgfrys, &kudgfry)
-	}
-
-	return kudgfrys, rows.Err()
+func (r *KudgfryRepository) List(ctx context.Context, opts ListOptions) ([]*Kudgfry, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgfryRepository.List")
+	return kudgfryTable.list(ctx, r.db, opts, kudgfryFields, kudgfryOrder, "")
 }
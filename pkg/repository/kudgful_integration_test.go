@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_Kudgful_CRUD(t *testing.T) {
@@ -59,7 +61,7 @@ func TestIntegration_Kudgful_CRUD(t *testing.T) {
 		// 3. Update
 		t.Run("Update", func(t *testing.T) {
 			testData.Hash = fmt.Sprintf("updated-hash-%s", uuid.New().String()[:8])
-			updated, err := repo.Update(ctx, testData)
+			updated, err := repo.Update(ctx, testData, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -71,7 +73,7 @@ func TestIntegration_Kudgful_CRUD(t *testing.T) {
 
 		// 4. List
 		t.Run("ListByOrganization", func(t *testing.T) {
-			records, err := repo.ListByOrganization(ctx, orgID, 10, 0)
+			records, _, err := repo.ListByOrganization(ctx, orgID, ListOptions{Page: pagination.Page{Size: 10}})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -84,7 +86,7 @@ func TestIntegration_Kudgful_CRUD(t *testing.T) {
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
 			deletedTime := time.Now().Format(time.RFC3339)
-			err := repo.Delete(ctx, testData.UUID, deletedTime)
+			err := repo.Delete(ctx, testData.UUID, deletedTime, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
@@ -2,201 +2,209 @@ package repository
 
 import (
 	"context"
-	"errors"
+	"time"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrKudgivtNotFound = errors.New("kudgivt not found")
+	ErrKudgivtNotFound = notFound("kudgivt not found")
 )
 
 // Kudgivt represents the database model for kudgivt table
 type Kudgivt struct {
-	UUID           string
-	OrganizationID string
-	Hash           string
-	Created        string
-	Deleted        *string
-	KudguriUuid    *string
+	UUID           string  `db:"UUID,pk"`
+	OrganizationID string  `db:"OrganizationID"`
+	Hash           string  `db:"Hash"`
+	Created        string  `db:"Created"`
+	Deleted        *string `db:"Deleted,deleted"`
+	KudguriUuid    *string `db:"KudguriUuid"`
 
 	// 運行情報
-	UnkouNo   *string
-	ReadDate  *string
-	UnkouDate *string
+	UnkouNo   *string `db:"UnkouNo"`
+	ReadDate  *string `db:"ReadDate"`
+	UnkouDate *string `db:"UnkouDate"`
 
 	// 事業所・車両情報
-	OfficeCd    *string
-	OfficeName  *string
-	VehicleCd   *string
-	VehicleName *string
+	OfficeCd    *string `db:"OfficeCd"`
+	OfficeName  *string `db:"OfficeName"`
+	VehicleCd   *string `db:"VehicleCd"`
+	VehicleName *string `db:"VehicleName"`
 
 	// 乗務員情報
-	DriverCd1        *string
-	DriverName1      *string
-	TargetDriverType string
-	TargetDriverCd   *string
-	TargetDriverName *string
+	DriverCd1        *string `db:"DriverCd1"`
+	DriverName1      *string `db:"DriverName1"`
+	TargetDriverType string  `db:"TargetDriverType"`
+	TargetDriverCd   *string `db:"TargetDriverCd"`
+	TargetDriverName *string `db:"TargetDriverName"`
 
 	// 勤務時間
-	ClockInDatetime  *string
-	ClockOutDatetime *string
+	ClockInDatetime  *string `db:"ClockInDatetime"`
+	ClockOutDatetime *string `db:"ClockOutDatetime"`
 
 	// 出発・帰着時刻
-	DepartureDatetime *string
-	ReturnDatetime    *string
+	DepartureDatetime *string `db:"DepartureDatetime"`
+	ReturnDatetime    *string `db:"ReturnDatetime"`
 
 	// 走行距離
-	DepartureMeter *string
-	ReturnMeter    *string
-	TotalMileage   *string
+	DepartureMeter *string `db:"DepartureMeter"`
+	ReturnMeter    *string `db:"ReturnMeter"`
+	TotalMileage   *string `db:"TotalMileage"`
 
 	// 行先情報
-	DestinationCityName  *string
-	DestinationPlaceName *string
+	DestinationCityName  *string `db:"DestinationCityName"`
+	DestinationPlaceName *string `db:"DestinationPlaceName"`
 
 	// 実車距離
-	ActualMileage *string
+	ActualMileage *string `db:"ActualMileage"`
 
 	// 運転時間
-	LocalDriveTime   *string
-	ExpressDriveTime *string
-	BypassDriveTime  *string
-	ActualDriveTime  *string
-	EmptyDriveTime   *string
+	LocalDriveTime   *string `db:"LocalDriveTime"`
+	ExpressDriveTime *string `db:"ExpressDriveTime"`
+	BypassDriveTime  *string `db:"BypassDriveTime"`
+	ActualDriveTime  *string `db:"ActualDriveTime"`
+	EmptyDriveTime   *string `db:"EmptyDriveTime"`
 
 	// 作業時間 (Work1Time ~ Work10Time)
-	Work1Time  *string
-	Work2Time  *string
-	Work3Time  *string
-	Work4Time  *string
-	Work5Time  *string
-	Work6Time  *string
-	Work7Time  *string
-	Work8Time  *string
-	Work9Time  *string
-	Work10Time *string
+	Work1Time  *string `db:"Work1Time"`
+	Work2Time  *string `db:"Work2Time"`
+	Work3Time  *string `db:"Work3Time"`
+	Work4Time  *string `db:"Work4Time"`
+	Work5Time  *string `db:"Work5Time"`
+	Work6Time  *string `db:"Work6Time"`
+	Work7Time  *string `db:"Work7Time"`
+	Work8Time  *string `db:"Work8Time"`
+	Work9Time  *string `db:"Work9Time"`
+	Work10Time *string `db:"Work10Time"`
 
 	// 状態距離 (State1Distance ~ State5Distance)
-	State1Distance *string
-	State2Distance *string
-	State3Distance *string
-	State4Distance *string
-	State5Distance *string
+	State1Distance *string `db:"State1Distance"`
+	State2Distance *string `db:"State2Distance"`
+	State3Distance *string `db:"State3Distance"`
+	State4Distance *string `db:"State4Distance"`
+	State5Distance *string `db:"State5Distance"`
 
 	// 状態時間 (State1Time ~ State5Time)
-	State1Time *string
-	State2Time *string
-	State3Time *string
-	State4Time *string
-	State5Time *string
+	State1Time *string `db:"State1Time"`
+	State2Time *string `db:"State2Time"`
+	State3Time *string `db:"State3Time"`
+	State4Time *string `db:"State4Time"`
+	State5Time *string `db:"State5Time"`
 
 	// 自社燃料等
-	OwnMainFuel     *string
-	OwnMainAdditive *string
-	OwnConsumable   *string
+	OwnMainFuel     *string `db:"OwnMainFuel"`
+	OwnMainAdditive *string `db:"OwnMainAdditive"`
+	OwnConsumable   *string `db:"OwnConsumable"`
 
 	// 他社燃料等
-	OtherMainFuel     *string
-	OtherMainAdditive *string
-	OtherConsumable   *string
+	OtherMainFuel     *string `db:"OtherMainFuel"`
+	OtherMainAdditive *string `db:"OtherMainAdditive"`
+	OtherConsumable   *string `db:"OtherConsumable"`
 
 	// 速度超過（一般道）
-	LocalSpeedOverMax   *string
-	LocalSpeedOverTime  *string
-	LocalSpeedOverCount *string
+	LocalSpeedOverMax   *string `db:"LocalSpeedOverMax"`
+	LocalSpeedOverTime  *string `db:"LocalSpeedOverTime"`
+	LocalSpeedOverCount *string `db:"LocalSpeedOverCount"`
 
 	// 速度超過（高速道）
-	ExpressSpeedOverMax   *string
-	ExpressSpeedOverTime  *string
-	ExpressSpeedOverCount *string
+	ExpressSpeedOverMax   *string `db:"ExpressSpeedOverMax"`
+	ExpressSpeedOverTime  *string `db:"ExpressSpeedOverTime"`
+	ExpressSpeedOverCount *string `db:"ExpressSpeedOverCount"`
 
 	// 速度超過（専用道）
-	DedicatedSpeedOverMax   *string
-	DedicatedSpeedOverTime  *string
-	DedicatedSpeedOverCount *string
+	DedicatedSpeedOverMax   *string `db:"DedicatedSpeedOverMax"`
+	DedicatedSpeedOverTime  *string `db:"DedicatedSpeedOverTime"`
+	DedicatedSpeedOverCount *string `db:"DedicatedSpeedOverCount"`
 
 	// アイドリング
-	IdlingTime      *string
-	IdlingTimeCount *string
+	IdlingTime      *string `db:"IdlingTime"`
+	IdlingTimeCount *string `db:"IdlingTimeCount"`
 
 	// 回転数超過
-	RotationOverMax   *string
-	RotationOverCount *string
-	RotationOverTime  *string
+	RotationOverMax   *string `db:"RotationOverMax"`
+	RotationOverCount *string `db:"RotationOverCount"`
+	RotationOverTime  *string `db:"RotationOverTime"`
 
 	// 急加速
-	RapidAccelCount1   *string
-	RapidAccelCount2   *string
-	RapidAccelCount3   *string
-	RapidAccelCount4   *string
-	RapidAccelCount5   *string
-	RapidAccelMax      *string
-	RapidAccelMaxSpeed *string
+	RapidAccelCount1   *string `db:"RapidAccelCount1"`
+	RapidAccelCount2   *string `db:"RapidAccelCount2"`
+	RapidAccelCount3   *string `db:"RapidAccelCount3"`
+	RapidAccelCount4   *string `db:"RapidAccelCount4"`
+	RapidAccelCount5   *string `db:"RapidAccelCount5"`
+	RapidAccelMax      *string `db:"RapidAccelMax"`
+	RapidAccelMaxSpeed *string `db:"RapidAccelMaxSpeed"`
 
 	// 急減速
-	RapidDecelCount1   *string
-	RapidDecelCount2   *string
-	RapidDecelCount3   *string
-	RapidDecelCount4   *string
-	RapidDecelCount5   *string
-	RapidDecelMax      *string
-	RapidDecelMaxSpeed *string
+	RapidDecelCount1   *string `db:"RapidDecelCount1"`
+	RapidDecelCount2   *string `db:"RapidDecelCount2"`
+	RapidDecelCount3   *string `db:"RapidDecelCount3"`
+	RapidDecelCount4   *string `db:"RapidDecelCount4"`
+	RapidDecelCount5   *string `db:"RapidDecelCount5"`
+	RapidDecelMax      *string `db:"RapidDecelMax"`
+	RapidDecelMaxSpeed *string `db:"RapidDecelMaxSpeed"`
 
 	// 急ハンドル
-	RapidCurveCount1   *string
-	RapidCurveCount2   *string
-	RapidCurveCount3   *string
-	RapidCurveCount4   *string
-	RapidCurveCount5   *string
-	RapidCurveMax      *string
-	RapidCurveMaxSpeed *string
+	RapidCurveCount1   *string `db:"RapidCurveCount1"`
+	RapidCurveCount2   *string `db:"RapidCurveCount2"`
+	RapidCurveCount3   *string `db:"RapidCurveCount3"`
+	RapidCurveCount4   *string `db:"RapidCurveCount4"`
+	RapidCurveCount5   *string `db:"RapidCurveCount5"`
+	RapidCurveMax      *string `db:"RapidCurveMax"`
+	RapidCurveMaxSpeed *string `db:"RapidCurveMaxSpeed"`
+
+	// The counts and scores below are calculated when the record is imported and have no
+	// field in UpdateKudgivtRequest, so updates never write them
 
 	// 連続運転
-	ContinuousDriveOverCount *string
-	ContinuousDriveMaxTime   *string
-	ContinuousDriveTotalTime *string
+	ContinuousDriveOverCount *string `db:"ContinuousDriveOverCount,readonly"`
+	ContinuousDriveMaxTime   *string `db:"ContinuousDriveMaxTime,readonly"`
+	ContinuousDriveTotalTime *string `db:"ContinuousDriveTotalTime,readonly"`
 
 	// 波状運転
-	WaveDriveCount        *string
-	WaveDriveMaxTime      *string
-	WaveDriveMaxSpeedDiff *string
+	WaveDriveCount        *string `db:"WaveDriveCount,readonly"`
+	WaveDriveMaxTime      *string `db:"WaveDriveMaxTime,readonly"`
+	WaveDriveMaxSpeedDiff *string `db:"WaveDriveMaxSpeedDiff,readonly"`
 
 	// スコア（速度）
-	LocalSpeedScore     *string
-	ExpressSpeedScore   *string
-	DedicatedSpeedScore *string
+	LocalSpeedScore     *string `db:"LocalSpeedScore,readonly"`
+	ExpressSpeedScore   *string `db:"ExpressSpeedScore,readonly"`
+	DedicatedSpeedScore *string `db:"DedicatedSpeedScore,readonly"`
 
 	// スコア（距離）
-	LocalDistanceScore     *string
-	ExpressDistanceScore   *string
-	DedicatedDistanceScore *string
+	LocalDistanceScore     *string `db:"LocalDistanceScore,readonly"`
+	ExpressDistanceScore   *string `db:"ExpressDistanceScore,readonly"`
+	DedicatedDistanceScore *string `db:"DedicatedDistanceScore,readonly"`
 
 	// スコア（急操作）
-	RapidAccelScore *string
-	RapidDecelScore *string
-	RapidCurveScore *string
+	RapidAccelScore *string `db:"RapidAccelScore,readonly"`
+	RapidDecelScore *string `db:"RapidDecelScore,readonly"`
+	RapidCurveScore *string `db:"RapidCurveScore,readonly"`
 
 	// スコア（回転数・実車）
-	ActualLowSpeedRotationScore  *string
-	ActualHighSpeedRotationScore *string
+	ActualLowSpeedRotationScore  *string `db:"ActualLowSpeedRotationScore,readonly"`
+	ActualHighSpeedRotationScore *string `db:"ActualHighSpeedRotationScore,readonly"`
 
 	// スコア（回転数・空車）
-	EmptyLowSpeedRotationScore  *string
-	EmptyHighSpeedRotationScore *string
+	EmptyLowSpeedRotationScore  *string `db:"EmptyLowSpeedRotationScore,readonly"`
+	EmptyHighSpeedRotationScore *string `db:"EmptyHighSpeedRotationScore,readonly"`
 
 	// スコア（その他）
-	IdlingScore          *string
-	ContinuousDriveScore *string
-	WaveDriveScore       *string
+	IdlingScore          *string `db:"IdlingScore,readonly"`
+	ContinuousDriveScore *string `db:"ContinuousDriveScore,readonly"`
+	WaveDriveScore       *string `db:"WaveDriveScore,readonly"`
 
 	// 総合スコア
-	SafetyScore  *string
-	EconomyScore *string
-	TotalScore   *string
+	SafetyScore  *string `db:"SafetyScore,readonly"`
+	EconomyScore *string `db:"EconomyScore,readonly"`
+	TotalScore   *string `db:"TotalScore,readonly"`
+	Etag         string  `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var kudgivtTable = NewTable[Kudgivt]("kudgivt", ErrKudgivtNotFound)
+
 // KudgivtRepository handles database operations for kudgivt
 type KudgivtRepository struct {
 	db DB
@@ -214,702 +222,62 @@ func NewKudgivtRepositoryWithDB(db DB) *KudgivtRepository {
 
 // Create inserts a new kudgivt record
 func (r *KudgivtRepository) Create(ctx context.Context, k *Kudgivt) (*Kudgivt, error) {
-	query := `
-		INSERT INTO kudgivt (
-			"UUID", "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"UnkouNo", "ReadDate", "UnkouDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "TargetDriverType", "TargetDriverCd", "TargetDriverName",
-			"ClockInDatetime", "ClockOutDatetime",
-			"DepartureDatetime", "ReturnDatetime",
-			"DepartureMeter", "ReturnMeter", "TotalMileage",
-			"DestinationCityName", "DestinationPlaceName",
-			"ActualMileage",
-			"LocalDriveTime", "ExpressDriveTime", "BypassDriveTime", "ActualDriveTime", "EmptyDriveTime",
-			"Work1Time", "Work2Time", "Work3Time", "Work4Time", "Work5Time",
-			"Work6Time", "Work7Time", "Work8Time", "Work9Time", "Work10Time",
-			"State1Distance", "State2Distance", "State3Distance", "State4Distance", "State5Distance",
-			"State1Time", "State2Time", "State3Time", "State4Time", "State5Time",
-			"OwnMainFuel", "OwnMainAdditive", "OwnConsumable",
-			"OtherMainFuel", "OtherMainAdditive", "OtherConsumable",
-			"LocalSpeedOverMax", "LocalSpeedOverTime", "LocalSpeedOverCount",
-			"ExpressSpeedOverMax", "ExpressSpeedOverTime", "ExpressSpeedOverCount",
-			"DedicatedSpeedOverMax", "DedicatedSpeedOverTime", "DedicatedSpeedOverCount",
-			"IdlingTime", "IdlingTimeCount",
-			"RotationOverMax", "RotationOverCount", "RotationOverTime",
-			"RapidAccelCount1", "RapidAccelCount2", "RapidAccelCount3", "RapidAccelCount4", "RapidAccelCount5",
-			"RapidAccelMax", "RapidAccelMaxSpeed",
-			"RapidDecelCount1", "RapidDecelCount2", "RapidDecelCount3", "RapidDecelCount4", "RapidDecelCount5",
-			"RapidDecelMax", "RapidDecelMaxSpeed",
-			"RapidCurveCount1", "RapidCurveCount2", "RapidCurveCount3", "RapidCurveCount4", "RapidCurveCount5",
-			"RapidCurveMax", "RapidCurveMaxSpeed",
-			"ContinuousDriveOverCount", "ContinuousDriveMaxTime", "ContinuousDriveTotalTime",
-			"WaveDriveCount", "WaveDriveMaxTime", "WaveDriveMaxSpeedDiff",
-			"LocalSpeedScore", "ExpressSpeedScore", "DedicatedSpeedScore",
-			"LocalDistanceScore", "ExpressDistanceScore", "DedicatedDistanceScore",
-			"RapidAccelScore", "RapidDecelScore", "RapidCurveScore",
-			"ActualLowSpeedRotationScore", "ActualHighSpeedRotationScore",
-			"EmptyLowSpeedRotationScore", "EmptyHighSpeedRotationScore",
-			"IdlingScore", "ContinuousDriveScore", "WaveDriveScore",
-			"SafetyScore", "EconomyScore", "TotalScore"
-		)
-		VALUES (
-			$1, $2, $3, $4, $5, $6,
-			$7, $8, $9,
-			$10, $11, $12, $13,
-			$14, $15, $16, $17, $18,
-			$19, $20,
-			$21, $22,
-			$23, $24, $25,
-			$26, $27,
-			$28,
-			$29, $30, $31, $32, $33,
-			$34, $35, $36, $37, $38,
-			$39, $40, $41, $42, $43,
-			$44, $45, $46, $47, $48,
-			$49, $50, $51, $52, $53,
-			$54, $55, $56,
-			$57, $58, $59,
-			$60, $61, $62,
-			$63, $64, $65,
-			$66, $67, $68,
-			$69, $70,
-			$71, $72, $73,
-			$74, $75, $76, $77, $78,
-			$79, $80,
-			$81, $82, $83, $84, $85,
-			$86, $87,
-			$88, $89, $90, $91, $92,
-			$93, $94,
-			$95, $96, $97,
-			$98, $99, $100,
-			$101, $102, $103,
-			$104, $105, $106,
-			$107, $108, $109,
-			$110, $111,
-			$112, $113,
-			$114, $115, $116,
-			$117, $118, $119
-		)
-		RETURNING
-			"UUID", "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"UnkouNo", "ReadDate", "UnkouDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "TargetDriverType", "TargetDriverCd", "TargetDriverName",
-			"ClockInDatetime", "ClockOutDatetime",
-			"DepartureDatetime", "ReturnDatetime",
-			"DepartureMeter", "ReturnMeter", "TotalMileage",
-			"DestinationCityName", "DestinationPlaceName",
-			"ActualMileage",
-			"LocalDriveTime", "ExpressDriveTime", "BypassDriveTime", "ActualDriveTime", "EmptyDriveTime",
-			"Work1Time", "Work2Time", "Work3Time", "Work4Time", "Work5Time",
-			"Work6Time", "Work7Time", "Work8Time", "Work9Time", "Work10Time",
-			"State1Distance", "State2Distance", "State3Distance", "State4Distance", "State5Distance",
-			"State1Time", "State2Time", "State3Time", "State4Time", "State5Time",
-			"OwnMainFuel", "OwnMainAdditive", "OwnConsumable",
-			"OtherMainFuel", "OtherMainAdditive", "OtherConsumable",
-			"LocalSpeedOverMax", "LocalSpeedOverTime", "LocalSpeedOverCount",
-			"ExpressSpeedOverMax", "ExpressSpeedOverTime", "ExpressSpeedOverCount",
-			"DedicatedSpeedOverMax", "DedicatedSpeedOverTime", "DedicatedSpeedOverCount",
-			"IdlingTime", "IdlingTimeCount",
-			"RotationOverMax", "RotationOverCount", "RotationOverTime",
-			"RapidAccelCount1", "RapidAccelCount2", "RapidAccelCount3", "RapidAccelCount4", "RapidAccelCount5",
-			"RapidAccelMax", "RapidAccelMaxSpeed",
-			"RapidDecelCount1", "RapidDecelCount2", "RapidDecelCount3", "RapidDecelCount4", "RapidDecelCount5",
-			"RapidDecelMax", "RapidDecelMaxSpeed",
-			"RapidCurveCount1", "RapidCurveCount2", "RapidCurveCount3", "RapidCurveCount4", "RapidCurveCount5",
-			"RapidCurveMax", "RapidCurveMaxSpeed",
-			"ContinuousDriveOverCount", "ContinuousDriveMaxTime", "ContinuousDriveTotalTime",
-			"WaveDriveCount", "WaveDriveMaxTime", "WaveDriveMaxSpeedDiff",
-			"LocalSpeedScore", "ExpressSpeedScore", "DedicatedSpeedScore",
-			"LocalDistanceScore", "ExpressDistanceScore", "DedicatedDistanceScore",
-			"RapidAccelScore", "RapidDecelScore", "RapidCurveScore",
-			"ActualLowSpeedRotationScore", "ActualHighSpeedRotationScore",
-			"EmptyLowSpeedRotationScore", "EmptyHighSpeedRotationScore",
-			"IdlingScore", "ContinuousDriveScore", "WaveDriveScore",
-			"SafetyScore", "EconomyScore", "TotalScore"
-	`
-
-	var result Kudgivt
-	err := r.db.QueryRow(ctx, query,
-		k.UUID, k.OrganizationID, k.Hash, k.Created, k.Deleted, k.KudguriUuid,
-		k.UnkouNo, k.ReadDate, k.UnkouDate,
-		k.OfficeCd, k.OfficeName, k.VehicleCd, k.VehicleName,
-		k.DriverCd1, k.DriverName1, k.TargetDriverType, k.TargetDriverCd, k.TargetDriverName,
-		k.ClockInDatetime, k.ClockOutDatetime,
-		k.DepartureDatetime, k.ReturnDatetime,
-		k.DepartureMeter, k.ReturnMeter, k.TotalMileage,
-		k.DestinationCityName, k.DestinationPlaceName,
-		k.ActualMileage,
-		k.LocalDriveTime, k.ExpressDriveTime, k.BypassDriveTime, k.ActualDriveTime, k.EmptyDriveTime,
-		k.Work1Time, k.Work2Time, k.Work3Time, k.Work4Time, k.Work5Time,
-		k.Work6Time, k.Work7Time, k.Work8Time, k.Work9Time, k.Work10Time,
-		k.State1Distance, k.State2Distance, k.State3Distance, k.State4Distance, k.State5Distance,
-		k.State1Time, k.State2Time, k.State3Time, k.State4Time, k.State5Time,
-		k.OwnMainFuel, k.OwnMainAdditive, k.OwnConsumable,
-		k.OtherMainFuel, k.OtherMainAdditive, k.OtherConsumable,
-		k.LocalSpeedOverMax, k.LocalSpeedOverTime, k.LocalSpeedOverCount,
-		k.ExpressSpeedOverMax, k.ExpressSpeedOverTime, k.ExpressSpeedOverCount,
-		k.DedicatedSpeedOverMax, k.DedicatedSpeedOverTime, k.DedicatedSpeedOverCount,
-		k.IdlingTime, k.IdlingTimeCount,
-		k.RotationOverMax, k.RotationOverCount, k.RotationOverTime,
-		k.RapidAccelCount1, k.RapidAccelCount2, k.RapidAccelCount3, k.RapidAccelCount4, k.RapidAccelCount5,
-		k.RapidAccelMax, k.RapidAccelMaxSpeed,
-		k.RapidDecelCount1, k.RapidDecelCount2, k.RapidDecelCount3, k.RapidDecelCount4, k.RapidDecelCount5,
-		k.RapidDecelMax, k.RapidDecelMaxSpeed,
-		k.RapidCurveCount1, k.RapidCurveCount2, k.RapidCurveCount3, k.RapidCurveCount4, k.RapidCurveCount5,
-		k.RapidCurveMax, k.RapidCurveMaxSpeed,
-		k.ContinuousDriveOverCount, k.ContinuousDriveMaxTime, k.ContinuousDriveTotalTime,
-		k.WaveDriveCount, k.WaveDriveMaxTime, k.WaveDriveMaxSpeedDiff,
-		k.LocalSpeedScore, k.ExpressSpeedScore, k.DedicatedSpeedScore,
-		k.LocalDistanceScore, k.ExpressDistanceScore, k.DedicatedDistanceScore,
-		k.RapidAccelScore, k.RapidDecelScore, k.RapidCurveScore,
-		k.ActualLowSpeedRotationScore, k.ActualHighSpeedRotationScore,
-		k.EmptyLowSpeedRotationScore, k.EmptyHighSpeedRotationScore,
-		k.IdlingScore, k.ContinuousDriveScore, k.WaveDriveScore,
-		k.SafetyScore, k.EconomyScore, k.TotalScore,
-	).Scan(
-		&result.UUID, &result.OrganizationID, &result.Hash, &result.Created, &result.Deleted, &result.KudguriUuid,
-		&result.UnkouNo, &result.ReadDate, &result.UnkouDate,
-		&result.OfficeCd, &result.OfficeName, &result.VehicleCd, &result.VehicleName,
-		&result.DriverCd1, &result.DriverName1, &result.TargetDriverType, &result.TargetDriverCd, &result.TargetDriverName,
-		&result.ClockInDatetime, &result.ClockOutDatetime,
-		&result.DepartureDatetime, &result.ReturnDatetime,
-		&result.DepartureMeter, &result.ReturnMeter, &result.TotalMileage,
-		&result.DestinationCityName, &result.DestinationPlaceName,
-		&result.ActualMileage,
-		&result.LocalDriveTime, &result.ExpressDriveTime, &result.BypassDriveTime, &result.ActualDriveTime, &result.EmptyDriveTime,
-		&result.Work1Time, &result.Work2Time, &result.Work3Time, &result.Work4Time, &result.Work5Time,
-		&result.Work6Time, &result.Work7Time, &result.Work8Time, &result.Work9Time, &result.Work10Time,
-		&result.State1Distance, &result.State2Distance, &result.State3Distance, &result.State4Distance, &result.State5Distance,
-		&result.State1Time, &result.State2Time, &result.State3Time, &result.State4Time, &result.State5Time,
-		&result.OwnMainFuel, &result.OwnMainAdditive, &result.OwnConsumable,
-		&result.OtherMainFuel, &result.OtherMainAdditive, &result.OtherConsumable,
-		&result.LocalSpeedOverMax, &result.LocalSpeedOverTime, &result.LocalSpeedOverCount,
-		&result.ExpressSpeedOverMax, &result.ExpressSpeedOverTime, &result.ExpressSpeedOverCount,
-		&result.DedicatedSpeedOverMax, &result.DedicatedSpeedOverTime, &result.DedicatedSpeedOverCount,
-		&result.IdlingTime, &result.IdlingTimeCount,
-		&result.RotationOverMax, &result.RotationOverCount, &result.RotationOverTime,
-		&result.RapidAccelCount1, &result.RapidAccelCount2, &result.RapidAccelCount3, &result.RapidAccelCount4, &result.RapidAccelCount5,
-		&result.RapidAccelMax, &result.RapidAccelMaxSpeed,
-		&result.RapidDecelCount1, &result.RapidDecelCount2, &result.RapidDecelCount3, &result.RapidDecelCount4, &result.RapidDecelCount5,
-		&result.RapidDecelMax, &result.RapidDecelMaxSpeed,
-		&result.RapidCurveCount1, &result.RapidCurveCount2, &result.RapidCurveCount3, &result.RapidCurveCount4, &result.RapidCurveCount5,
-		&result.RapidCurveMax, &result.RapidCurveMaxSpeed,
-		&result.ContinuousDriveOverCount, &result.ContinuousDriveMaxTime, &result.ContinuousDriveTotalTime,
-		&result.WaveDriveCount, &result.WaveDriveMaxTime, &result.WaveDriveMaxSpeedDiff,
-		&result.LocalSpeedScore, &result.ExpressSpeedScore, &result.DedicatedSpeedScore,
-		&result.LocalDistanceScore, &result.ExpressDistanceScore, &result.DedicatedDistanceScore,
-		&result.RapidAccelScore, &result.RapidDecelScore, &result.RapidCurveScore,
-		&result.ActualLowSpeedRotationScore, &result.ActualHighSpeedRotationScore,
-		&result.EmptyLowSpeedRotationScore, &result.EmptyHighSpeedRotationScore,
-		&result.IdlingScore, &result.ContinuousDriveScore, &result.WaveDriveScore,
-		&result.SafetyScore, &result.EconomyScore, &result.TotalScore,
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &result, nil
+	ctx = db.WithQueryLabel(ctx, "KudgivtRepository.Create")
+	return kudgivtTable.insert(ctx, r.db, k)
 }
 
 // GetByUUID retrieves a kudgivt record by UUID
 func (r *KudgivtRepository) GetByUUID(ctx context.Context, uuid string) (*Kudgivt, error) {
-	query := `
-		SELECT
-			"UUID", "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"UnkouNo", "ReadDate", "UnkouDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "TargetDriverType", "TargetDriverCd", "TargetDriverName",
-			"ClockInDatetime", "ClockOutDatetime",
-			"DepartureDatetime", "ReturnDatetime",
-			"DepartureMeter", "ReturnMeter", "TotalMileage",
-			"DestinationCityName", "DestinationPlaceName",
-			"ActualMileage",
-			"LocalDriveTime", "ExpressDriveTime", "BypassDriveTime", "ActualDriveTime", "EmptyDriveTime",
-			"Work1Time", "Work2Time", "Work3Time", "Work4Time", "Work5Time",
-			"Work6Time", "Work7Time", "Work8Time", "Work9Time", "Work10Time",
-			"State1Distance", "State2Distance", "State3Distance", "State4Distance", "State5Distance",
-			"State1Time", "State2Time", "State3Time", "State4Time", "State5Time",
-			"OwnMainFuel", "OwnMainAdditive", "OwnConsumable",
-			"OtherMainFuel", "OtherMainAdditive", "OtherConsumable",
-			"LocalSpeedOverMax", "LocalSpeedOverTime", "LocalSpeedOverCount",
-			"ExpressSpeedOverMax", "ExpressSpeedOverTime", "ExpressSpeedOverCount",
-			"DedicatedSpeedOverMax", "DedicatedSpeedOverTime", "DedicatedSpeedOverCount",
-			"IdlingTime", "IdlingTimeCount",
-			"RotationOverMax", "RotationOverCount", "RotationOverTime",
-			"RapidAccelCount1", "RapidAccelCount2", "RapidAccelCount3", "RapidAccelCount4", "RapidAccelCount5",
-			"RapidAccelMax", "RapidAccelMaxSpeed",
-			"RapidDecelCount1", "RapidDecelCount2", "RapidDecelCount3", "RapidDecelCount4", "RapidDecelCount5",
-			"RapidDecelMax", "RapidDecelMaxSpeed",
-			"RapidCurveCount1", "RapidCurveCount2", "RapidCurveCount3", "RapidCurveCount4", "RapidCurveCount5",
-			"RapidCurveMax", "RapidCurveMaxSpeed",
-			"ContinuousDriveOverCount", "ContinuousDriveMaxTime", "ContinuousDriveTotalTime",
-			"WaveDriveCount", "WaveDriveMaxTime", "WaveDriveMaxSpeedDiff",
-			"LocalSpeedScore", "ExpressSpeedScore", "DedicatedSpeedScore",
-			"LocalDistanceScore", "ExpressDistanceScore", "DedicatedDistanceScore",
-			"RapidAccelScore", "RapidDecelScore", "RapidCurveScore",
-			"ActualLowSpeedRotationScore", "ActualHighSpeedRotationScore",
-			"EmptyLowSpeedRotationScore", "EmptyHighSpeedRotationScore",
-			"IdlingScore", "ContinuousDriveScore", "WaveDriveScore",
-			"SafetyScore", "EconomyScore", "TotalScore"
-		FROM kudgivt
-		WHERE "UUID" = $1 AND "Deleted" IS NULL
-	`
-
-	var k Kudgivt
-	err := r.db.QueryRow(ctx, query, uuid).Scan(
-		&k.UUID, &k.OrganizationID, &k.Hash, &k.Created, &k.Deleted, &k.KudguriUuid,
-		&k.UnkouNo, &k.ReadDate, &k.UnkouDate,
-		&k.OfficeCd, &k.OfficeName, &k.VehicleCd, &k.VehicleName,
-		&k.DriverCd1, &k.DriverName1, &k.TargetDriverType, &k.TargetDriverCd, &k.TargetDriverName,
-		&k.ClockInDatetime, &k.ClockOutDatetime,
-		&k.DepartureDatetime, &k.ReturnDatetime,
-		&k.DepartureMeter, &k.ReturnMeter, &k.TotalMileage,
-		&k.DestinationCityName, &k.DestinationPlaceName,
-		&k.ActualMileage,
-		&k.LocalDriveTime, &k.ExpressDriveTime, &k.BypassDriveTime, &k.ActualDriveTime, &k.EmptyDriveTime,
-		&k.Work1Time, &k.Work2Time, &k.Work3Time, &k.Work4Time, &k.Work5Time,
-		&k.Work6Time, &k.Work7Time, &k.Work8Time, &k.Work9Time, &k.Work10Time,
-		&k.State1Distance, &k.State2Distance, &k.State3Distance, &k.State4Distance, &k.State5Distance,
-		&k.State1Time, &k.State2Time, &k.State3Time, &k.State4Time, &k.State5Time,
-		&k.OwnMainFuel, &k.OwnMainAdditive, &k.OwnConsumable,
-		&k.OtherMainFuel, &k.OtherMainAdditive, &k.OtherConsumable,
-		&k.LocalSpeedOverMax, &k.LocalSpeedOverTime, &k.LocalSpeedOverCount,
-		&k.ExpressSpeedOverMax, &k.ExpressSpeedOverTime, &k.ExpressSpeedOverCount,
-		&k.DedicatedSpeedOverMax, &k.DedicatedSpeedOverTime, &k.DedicatedSpeedOverCount,
-		&k.IdlingTime, &k.IdlingTimeCount,
-		&k.RotationOverMax, &k.RotationOverCount, &k.RotationOverTime,
-		&k.RapidAccelCount1, &k.RapidAccelCount2, &k.RapidAccelCount3, &k.RapidAccelCount4, &k.RapidAccelCount5,
-		&k.RapidAccelMax, &k.RapidAccelMaxSpeed,
-		&k.RapidDecelCount1, &k.RapidDecelCount2, &k.RapidDecelCount3, &k.RapidDecelCount4, &k.RapidDecelCount5,
-		&k.RapidDecelMax, &k.RapidDecelMaxSpeed,
-		&k.RapidCurveCount1, &k.RapidCurveCount2, &k.RapidCurveCount3, &k.RapidCurveCount4, &k.RapidCurveCount5,
-		&k.RapidCurveMax, &k.RapidCurveMaxSpeed,
-		&k.ContinuousDriveOverCount, &k.ContinuousDriveMaxTime, &k.ContinuousDriveTotalTime,
-		&k.WaveDriveCount, &k.WaveDriveMaxTime, &k.WaveDriveMaxSpeedDiff,
-		&k.LocalSpeedScore, &k.ExpressSpeedScore, &k.DedicatedSpeedScore,
-		&k.LocalDistanceScore, &k.ExpressDistanceScore, &k.DedicatedDistanceScore,
-		&k.RapidAccelScore, &k.RapidDecelScore, &k.RapidCurveScore,
-		&k.ActualLowSpeedRotationScore, &k.ActualHighSpeedRotationScore,
-		&k.EmptyLowSpeedRotationScore, &k.EmptyHighSpeedRotationScore,
-		&k.IdlingScore, &k.ContinuousDriveScore, &k.WaveDriveScore,
-		&k.SafetyScore, &k.EconomyScore, &k.TotalScore,
-	)
-
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrKudgivtNotFound
-		}
-		return nil, err
-	}
-
-	return &k, nil
+	ctx = db.WithQueryLabel(ctx, "KudgivtRepository.GetByUUID")
+	return kudgivtTable.get(ctx, r.db, uuid)
 }
 
-// Update modifies an existing kudgivt record
-func (r *KudgivtRepository) Update(ctx context.Context, k *Kudgivt) (*Kudgivt, error) {
-	query := `
-		UPDATE kudgivt
-		SET
-			"OrganizationID" = $2, "Hash" = $3, "Created" = $4, "Deleted" = $5, "KudguriUuid" = $6,
-			"UnkouNo" = $7, "ReadDate" = $8, "UnkouDate" = $9,
-			"OfficeCd" = $10, "OfficeName" = $11, "VehicleCd" = $12, "VehicleName" = $13,
-			"DriverCd1" = $14, "DriverName1" = $15, "TargetDriverType" = $16, "TargetDriverCd" = $17, "TargetDriverName" = $18,
-			"ClockInDatetime" = $19, "ClockOutDatetime" = $20,
-			"DepartureDatetime" = $21, "ReturnDatetime" = $22,
-			"DepartureMeter" = $23, "ReturnMeter" = $24, "TotalMileage" = $25,
-			"DestinationCityName" = $26, "DestinationPlaceName" = $27,
-			"ActualMileage" = $28,
-			"LocalDriveTime" = $29, "ExpressDriveTime" = $30, "BypassDriveTime" = $31, "ActualDriveTime" = $32, "EmptyDriveTime" = $33,
-			"Work1Time" = $34, "Work2Time" = $35, "Work3Time" = $36, "Work4Time" = $37, "Work5Time" = $38,
-			"Work6Time" = $39, "Work7Time" = $40, "Work8Time" = $41, "Work9Time" = $42, "Work10Time" = $43,
-			"State1Distance" = $44, "State2Distance" = $45, "State3Distance" = $46, "State4Distance" = $47, "State5Distance" = $48,
-			"State1Time" = $49, "State2Time" = $50, "State3Time" = $51, "State4Time" = $52, "State5Time" = $53,
-			"OwnMainFuel" = $54, "OwnMainAdditive" = $55, "OwnConsumable" = $56,
-			"OtherMainFuel" = $57, "OtherMainAdditive" = $58, "OtherConsumable" = $59,
-			"LocalSpeedOverMax" = $60, "LocalSpeedOverTime" = $61, "LocalSpeedOverCount" = $62,
-			"ExpressSpeedOverMax" = $63, "ExpressSpeedOverTime" = $64, "ExpressSpeedOverCount" = $65,
-			"DedicatedSpeedOverMax" = $66, "DedicatedSpeedOverTime" = $67, "DedicatedSpeedOverCount" = $68,
-			"IdlingTime" = $69, "IdlingTimeCount" = $70,
-			"RotationOverMax" = $71, "RotationOverCount" = $72, "RotationOverTime" = $73,
-			"RapidAccelCount1" = $74, "RapidAccelCount2" = $75, "RapidAccelCount3" = $76, "RapidAccelCount4" = $77, "RapidAccelCount5" = $78,
-			"RapidAccelMax" = $79, "RapidAccelMaxSpeed" = $80,
-			"RapidDecelCount1" = $81, "RapidDecelCount2" = $82, "RapidDecelCount3" = $83, "RapidDecelCount4" = $84, "RapidDecelCount5" = $85,
-			"RapidDecelMax" = $86, "RapidDecelMaxSpeed" = $87,
-			"RapidCurveCount1" = $88, "RapidCurveCount2" = $89, "RapidCurveCount3" = $90, "RapidCurveCount4" = $91, "RapidCurveCount5" = $92,
-			"RapidCurveMax" = $93, "RapidCurveMaxSpeed" = $94,
-			"ContinuousDriveOverCount" = $95, "ContinuousDriveMaxTime" = $96, "ContinuousDriveTotalTime" = $97,
-			"WaveDriveCount" = $98, "WaveDriveMaxTime" = $99, "WaveDriveMaxSpeedDiff" = $100,
-			"LocalSpeedScore" = $101, "ExpressSpeedScore" = $102, "DedicatedSpeedScore" = $103,
-			"LocalDistanceScore" = $104, "ExpressDistanceScore" = $105, "DedicatedDistanceScore" = $106,
-			"RapidAccelScore" = $107, "RapidDecelScore" = $108, "RapidCurveScore" = $109,
-			"ActualLowSpeedRotationScore" = $110, "ActualHighSpeedRotationScore" = $111,
-			"EmptyLowSpeedRotationScore" = $112, "EmptyHighSpeedRotationScore" = $113,
-			"IdlingScore" = $114, "ContinuousDriveScore" = $115, "WaveDriveScore" = $116,
-			"SafetyScore" = $117, "EconomyScore" = $118, "TotalScore" = $119
-		WHERE "UUID" = $1 AND "Deleted" IS NULL
-		RETURNING
-			"UUID", "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"UnkouNo", "ReadDate", "UnkouDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "TargetDriverType", "TargetDriverCd", "TargetDriverName",
-			"ClockInDatetime", "ClockOutDatetime",
-			"DepartureDatetime", "ReturnDatetime",
-			"DepartureMeter", "ReturnMeter", "TotalMileage",
-			"DestinationCityName", "DestinationPlaceName",
-			"ActualMileage",
-			"LocalDriveTime", "ExpressDriveTime", "BypassDriveTime", "ActualDriveTime", "EmptyDriveTime",
-			"Work1Time", "Work2Time", "Work3Time", "Work4Time", "Work5Time",
-			"Work6Time", "Work7Time", "Work8Time", "Work9Time", "Work10Time",
-			"State1Distance", "State2Distance", "State3Distance", "State4Distance", "State5Distance",
-			"State1Time", "State2Time", "State3Time", "State4Time", "State5Time",
-			"OwnMainFuel", "OwnMainAdditive", "OwnConsumable",
-			"OtherMainFuel", "OtherMainAdditive", "OtherConsumable",
-			"LocalSpeedOverMax", "LocalSpeedOverTime", "LocalSpeedOverCount",
-			"ExpressSpeedOverMax", "ExpressSpeedOverTime", "ExpressSpeedOverCount",
-			"DedicatedSpeedOverMax", "DedicatedSpeedOverTime", "DedicatedSpeedOverCount",
-			"IdlingTime", "IdlingTimeCount",
-			"RotationOverMax", "RotationOverCount", "RotationOverTime",
-			"RapidAccelCount1", "RapidAccelCount2", "RapidAccelCount3", "RapidAccelCount4", "RapidAccelCount5",
-			"RapidAccelMax", "RapidAccelMaxSpeed",
-			"RapidDecelCount1", "RapidDecelCount2", "RapidDecelCount3", "RapidDecelCount4", "RapidDecelCount5",
-			"RapidDecelMax", "RapidDecelMaxSpeed",
-			"RapidCurveCount1", "RapidCurveCount2", "RapidCurveCount3", "RapidCurveCount4", "RapidCurveCount5",
-			"RapidCurveMax", "RapidCurveMaxSpeed",
-			"ContinuousDriveOverCount", "ContinuousDriveMaxTime", "ContinuousDriveTotalTime",
-			"WaveDriveCount", "WaveDriveMaxTime", "WaveDriveMaxSpeedDiff",
-			"LocalSpeedScore", "ExpressSpeedScore", "DedicatedSpeedScore",
-			"LocalDistanceScore", "ExpressDistanceScore", "DedicatedDistanceScore",
-			"RapidAccelScore", "RapidDecelScore", "RapidCurveScore",
-			"ActualLowSpeedRotationScore", "ActualHighSpeedRotationScore",
-			"EmptyLowSpeedRotationScore", "EmptyHighSpeedRotationScore",
-			"IdlingScore", "ContinuousDriveScore", "WaveDriveScore",
-			"SafetyScore", "EconomyScore", "TotalScore"
-	`
-
-	var result Kudgivt
-	err := r.db.QueryRow(ctx, query,
-		k.UUID, k.OrganizationID, k.Hash, k.Created, k.Deleted, k.KudguriUuid,
-		k.UnkouNo, k.ReadDate, k.UnkouDate,
-		k.OfficeCd, k.OfficeName, k.VehicleCd, k.VehicleName,
-		k.DriverCd1, k.DriverName1, k.TargetDriverType, k.TargetDriverCd, k.TargetDriverName,
-		k.ClockInDatetime, k.ClockOutDatetime,
-		k.DepartureDatetime, k.ReturnDatetime,
-		k.DepartureMeter, k.ReturnMeter, k.TotalMileage,
-		k.DestinationCityName, k.DestinationPlaceName,
-		k.ActualMileage,
-		k.LocalDriveTime, k.ExpressDriveTime, k.BypassDriveTime, k.ActualDriveTime, k.EmptyDriveTime,
-		k.Work1Time, k.Work2Time, k.Work3Time, k.Work4Time, k.Work5Time,
-		k.Work6Time, k.Work7Time, k.Work8Time, k.Work9Time, k.Work10Time,
-		k.State1Distance, k.State2Distance, k.State3Distance, k.State4Distance, k.State5Distance,
-		k.State1Time, k.State2Time, k.State3Time, k.State4Time, k.State5Time,
-		k.OwnMainFuel, k.OwnMainAdditive, k.OwnConsumable,
-		k.OtherMainFuel, k.OtherMainAdditive, k.OtherConsumable,
-		k.LocalSpeedOverMax, k.LocalSpeedOverTime, k.LocalSpeedOverCount,
-		k.ExpressSpeedOverMax, k.ExpressSpeedOverTime, k.ExpressSpeedOverCount,
-		k.DedicatedSpeedOverMax, k.DedicatedSpeedOverTime, k.DedicatedSpeedOverCount,
-		k.IdlingTime, k.IdlingTimeCount,
-		k.RotationOverMax, k.RotationOverCount, k.RotationOverTime,
-		k.RapidAccelCount1, k.RapidAccelCount2, k.RapidAccelCount3, k.RapidAccelCount4, k.RapidAccelCount5,
-		k.RapidAccelMax, k.RapidAccelMaxSpeed,
-		k.RapidDecelCount1, k.RapidDecelCount2, k.RapidDecelCount3, k.RapidDecelCount4, k.RapidDecelCount5,
-		k.RapidDecelMax, k.RapidDecelMaxSpeed,
-		k.RapidCurveCount1, k.RapidCurveCount2, k.RapidCurveCount3, k.RapidCurveCount4, k.RapidCurveCount5,
-		k.RapidCurveMax, k.RapidCurveMaxSpeed,
-		k.ContinuousDriveOverCount, k.ContinuousDriveMaxTime, k.ContinuousDriveTotalTime,
-		k.WaveDriveCount, k.WaveDriveMaxTime, k.WaveDriveMaxSpeedDiff,
-		k.LocalSpeedScore, k.ExpressSpeedScore, k.DedicatedSpeedScore,
-		k.LocalDistanceScore, k.ExpressDistanceScore, k.DedicatedDistanceScore,
-		k.RapidAccelScore, k.RapidDecelScore, k.RapidCurveScore,
-		k.ActualLowSpeedRotationScore, k.ActualHighSpeedRotationScore,
-		k.EmptyLowSpeedRotationScore, k.EmptyHighSpeedRotationScore,
-		k.IdlingScore, k.ContinuousDriveScore, k.WaveDriveScore,
-		k.SafetyScore, k.EconomyScore, k.TotalScore,
-	).Scan(
-		&result.UUID, &result.OrganizationID, &result.Hash, &result.Created, &result.Deleted, &result.KudguriUuid,
-		&result.UnkouNo, &result.ReadDate, &result.UnkouDate,
-		&result.OfficeCd, &result.OfficeName, &result.VehicleCd, &result.VehicleName,
-		&result.DriverCd1, &result.DriverName1, &result.TargetDriverType, &result.TargetDriverCd, &result.TargetDriverName,
-		&result.ClockInDatetime, &result.ClockOutDatetime,
-		&result.DepartureDatetime, &result.ReturnDatetime,
-		&result.DepartureMeter, &result.ReturnMeter, &result.TotalMileage,
-		&result.DestinationCityName, &result.DestinationPlaceName,
-		&result.ActualMileage,
-		&result.LocalDriveTime, &result.ExpressDriveTime, &result.BypassDriveTime, &result.ActualDriveTime, &result.EmptyDriveTime,
-		&result.Work1Time, &result.Work2Time, &result.Work3Time, &result.Work4Time, &result.Work5Time,
-		&result.Work6Time, &result.Work7Time, &result.Work8Time, &result.Work9Time, &result.Work10Time,
-		&result.State1Distance, &result.State2Distance, &result.State3Distance, &result.State4Distance, &result.State5Distance,
-		&result.State1Time, &result.State2Time, &result.State3Time, &result.State4Time, &result.State5Time,
-		&result.OwnMainFuel, &result.OwnMainAdditive, &result.OwnConsumable,
-		&result.OtherMainFuel, &result.OtherMainAdditive, &result.OtherConsumable,
-		&result.LocalSpeedOverMax, &result.LocalSpeedOverTime, &result.LocalSpeedOverCount,
-		&result.ExpressSpeedOverMax, &result.ExpressSpeedOverTime, &result.ExpressSpeedOverCount,
-		&result.DedicatedSpeedOverMax, &result.DedicatedSpeedOverTime, &result.DedicatedSpeedOverCount,
-		&result.IdlingTime, &result.IdlingTimeCount,
-		&result.RotationOverMax, &result.RotationOverCount, &result.RotationOverTime,
-		&result.RapidAccelCount1, &result.RapidAccelCount2, &result.RapidAccelCount3, &result.RapidAccelCount4, &result.RapidAccelCount5,
-		&result.RapidAccelMax, &result.RapidAccelMaxSpeed,
-		&result.RapidDecelCount1, &result.RapidDecelCount2, &result.RapidDecelCount3, &result.RapidDecelCount4, &result.RapidDecelCount5,
-		&result.RapidDecelMax, &result.RapidDecelMaxSpeed,
-		&result.RapidCurveCount1, &result.RapidCurveCount2, &result.RapidCurveCount3, &result.RapidCurveCount4, &result.RapidCurveCount5,
-		&result.RapidCurveMax, &result.RapidCurveMaxSpeed,
-		&result.ContinuousDriveOverCount, &result.ContinuousDriveMaxTime, &result.ContinuousDriveTotalTime,
-		&result.WaveDriveCount, &result.WaveDriveMaxTime, &result.WaveDriveMaxSpeedDiff,
-		&result.LocalSpeedScore, &result.ExpressSpeedScore, &result.DedicatedSpeedScore,
-		&result.LocalDistanceScore, &result.ExpressDistanceScore, &result.DedicatedDistanceScore,
-		&result.RapidAccelScore, &result.RapidDecelScore, &result.RapidCurveScore,
-		&result.ActualLowSpeedRotationScore, &result.ActualHighSpeedRotationScore,
-		&result.EmptyLowSpeedRotationScore, &result.EmptyHighSpeedRotationScore,
-		&result.IdlingScore, &result.ContinuousDriveScore, &result.WaveDriveScore,
-		&result.SafetyScore, &result.EconomyScore, &result.TotalScore,
-	)
-
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrKudgivtNotFound
-		}
-		return nil, err
-	}
-
-	return &result, nil
+// Update writes the fields of mask (all of them if mask is empty) to an existing kudgivt
+// record. A non-empty etag must be the current one.
+func (r *KudgivtRepository) Update(ctx context.Context, k *Kudgivt, mask []string, etag string) (*Kudgivt, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgivtRepository.Update")
+	return kudgivtTable.update(ctx, r.db, k, mask, etag)
 }
 
-// Delete soft-deletes a kudgivt record
-func (r *KudgivtRepository) Delete(ctx context.Context, uuid string) error {
-	query := `
-		UPDATE kudgivt
-		SET "Deleted" = NOW()::text
-		WHERE "UUID" = $1 AND "Deleted" IS NULL
-	`
-
-	result, err := r.db.Exec(ctx, query, uuid)
-	if err != nil {
-		return err
-	}
+// Delete soft-deletes a kudgivt record. A non-empty etag must be the current one.
+func (r *KudgivtRepository) Delete(ctx context.Context, uuid, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "KudgivtRepository.Delete")
+	return kudgivtTable.softDelete(ctx, r.db, time.Now().Format(time.RFC3339), etag, uuid)
+}
 
-	if result.RowsAffected() == 0 {
-		return ErrKudgivtNotFound
-	}
+// kudgivtFields are the fields kudgivt listings can be filtered and ordered by
+var kudgivtFields = ListFields{
+	"created":            {Column: `"Created"`, Type: "text"},
+	"kudguri_uuid":       {Column: `"KudguriUuid"`, Type: "text", Nullable: true},
+	"unkou_no":           {Column: `"UnkouNo"`, Type: "text", Nullable: true},
+	"read_date":          {Column: `"ReadDate"`, Type: "text", Nullable: true},
+	"unkou_date":         {Column: `"UnkouDate"`, Type: "text", Nullable: true},
+	"office_cd":          {Column: `"OfficeCd"`, Type: "text", Nullable: true},
+	"vehicle_cd":         {Column: `"VehicleCd"`, Type: "text", Nullable: true},
+	"vehicle_name":       {Column: `"VehicleName"`, Type: "text", Nullable: true},
+	"driver_cd1":         {Column: `"DriverCd1"`, Type: "text", Nullable: true},
+	"target_driver_type": {Column: `"TargetDriverType"`, Type: "text"},
+	"target_driver_cd":   {Column: `"TargetDriverCd"`, Type: "text", Nullable: true},
+	"clock_in_datetime":  {Column: `"ClockInDatetime"`, Type: "text", Nullable: true},
+	"clock_out_datetime": {Column: `"ClockOutDatetime"`, Type: "text", Nullable: true},
+	"departure_datetime": {Column: `"DepartureDatetime"`, Type: "text", Nullable: true},
+	"return_datetime":    {Column: `"ReturnDatetime"`, Type: "text", Nullable: true},
+}
 
-	return nil
+// kudgivtOrder lists kudgivt newest first; "UUID" makes the order unique
+var kudgivtOrder = pagination.Order{
+	pagination.Desc(`"Created"`, "text"),
+	pagination.Desc(`"UUID"`, "text"),
 }
 
 // ListByOrganization retrieves kudgivt records for a specific organization with pagination
-func (r *KudgivtRepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*Kudgivt, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT
-			"UUID", "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"UnkouNo", "ReadDate", "UnkouDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "TargetDriverType", "TargetDriverCd", "TargetDriverName",
-			"ClockInDatetime", "ClockOutDatetime",
-			"DepartureDatetime", "ReturnDatetime",
-			"DepartureMeter", "ReturnMeter", "TotalMileage",
-			"DestinationCityName", "DestinationPlaceName",
-			"ActualMileage",
-			"LocalDriveTime", "ExpressDriveTime", "BypassDriveTime", "ActualDriveTime", "EmptyDriveTime",
-			"Work1Time", "Work2Time", "Work3Time", "Work4Time", "Work5Time",
-			"Work6Time", "Work7Time", "Work8Time", "Work9Time", "Work10Time",
-			"State1Distance", "State2Distance", "State3Distance", "State4Distance", "State5Distance",
-			"State1Time", "State2Time", "State3Time", "State4Time", "State5Time",
-			"OwnMainFuel", "OwnMainAdditive", "OwnConsumable",
-			"OtherMainFuel", "OtherMainAdditive", "OtherConsumable",
-			"LocalSpeedOverMax", "LocalSpeedOverTime", "LocalSpeedOverCount",
-			"ExpressSpeedOverMax", "ExpressSpeedOverTime", "ExpressSpeedOverCount",
-			"DedicatedSpeedOverMax", "DedicatedSpeedOverTime", "DedicatedSpeedOverCount",
-			"IdlingTime", "IdlingTimeCount",
-			"RotationOverMax", "RotationOverCount", "RotationOverTime",
-			"RapidAccelCount1", "RapidAccelCount2", "RapidAccelCount3", "RapidAccelCount4", "RapidAccelCount5",
-			"RapidAccelMax", "RapidAccelMaxSpeed",
-			"RapidDecelCount1", "RapidDecelCount2", "RapidDecelCount3", "RapidDecelCount4", "RapidDecelCount5",
-			"RapidDecelMax", "RapidDecelMaxSpeed",
-			"RapidCurveCount1", "RapidCurveCount2", "RapidCurveCount3", "RapidCurveCount4", "RapidCurveCount5",
-			"RapidCurveMax", "RapidCurveMaxSpeed",
-			"ContinuousDriveOverCount", "ContinuousDriveMaxTime", "ContinuousDriveTotalTime",
-			"WaveDriveCount", "WaveDriveMaxTime", "WaveDriveMaxSpeedDiff",
-			"LocalSpeedScore", "ExpressSpeedScore", "DedicatedSpeedScore",
-			"LocalDistanceScore", "ExpressDistanceScore", "DedicatedDistanceScore",
-			"RapidAccelScore", "RapidDecelScore", "RapidCurveScore",
-			"ActualLowSpeedRotationScore", "ActualHighSpeedRotationScore",
-			"EmptyLowSpeedRotationScore", "EmptyHighSpeedRotationScore",
-			"IdlingScore", "ContinuousDriveScore", "WaveDriveScore",
-			"SafetyScore", "EconomyScore", "TotalScore"
-		FROM kudgivt
-		WHERE "OrganizationID" = $1 AND "Deleted" IS NULL
-		ORDER BY "Created" DESC
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var results []*Kudgivt
-	for rows.Next() {
-		var k Kudgivt
-		err := rows.Scan(
-			&k.UUID, &k.OrganizationID, &k.Hash, &k.Created, &k.Deleted, &k.KudguriUuid,
-			&k.UnkouNo, &k.ReadDate, &k.UnkouDate,
-			&k.OfficeCd, &k.OfficeName, &k.VehicleCd, &k.VehicleName,
-			&k.DriverCd1, &k.DriverName1, &k.TargetDriverType, &k.TargetDriverCd, &k.TargetDriverName,
-			&k.ClockInDatetime, &k.ClockOutDatetime,
-			&k.DepartureDatetime, &k.ReturnDatetime,
-			&k.DepartureMeter, &k.ReturnMeter, &k.TotalMileage,
-			&k.DestinationCityName, &k.DestinationPlaceName,
-			&k.ActualMileage,
-			&k.LocalDriveTime, &k.ExpressDriveTime, &k.BypassDriveTime, &k.ActualDriveTime, &k.EmptyDriveTime,
-			&k.Work1Time, &k.Work2Time, &k.Work3Time, &k.Work4Time, &k.Work5Time,
-			&k.Work6Time, &k.Work7Time, &k.Work8Time, &k.Work9Time, &k.Work10Time,
-			&k.State1Distance, &k.State2Distance, &k.State3Distance, &k.State4Distance, &k.State5Distance,
-			&k.State1Time, &k.State2Time, &k.State3Time, &k.State4Time, &k.State5Time,
-			&k.OwnMainFuel, &k.OwnMainAdditive, &k.OwnConsumable,
-			&k.OtherMainFuel, &k.OtherMainAdditive, &k.OtherConsumable,
-			&k.LocalSpeedOverMax, &k.LocalSpeedOverTime, &k.LocalSpeedOverCount,
-			&k.ExpressSpeedOverMax, &k.ExpressSpeedOverTime, &k.ExpressSpeedOverCount,
-			&k.DedicatedSpeedOverMax, &k.DedicatedSpeedOverTime, &k.DedicatedSpeedOverCount,
-			&k.IdlingTime, &k.IdlingTimeCount,
-			&k.RotationOverMax, &k.RotationOverCount, &k.RotationOverTime,
-			&k.RapidAccelCount1, &k.RapidAccelCount2, &k.RapidAccelCount3, &k.RapidAccelCount4, &k.RapidAccelCount5,
-			&k.RapidAccelMax, &k.RapidAccelMaxSpeed,
-			&k.RapidDecelCount1, &k.RapidDecelCount2, &k.RapidDecelCount3, &k.RapidDecelCount4, &k.RapidDecelCount5,
-			&k.RapidDecelMax, &k.RapidDecelMaxSpeed,
-			&k.RapidCurveCount1, &k.RapidCurveCount2, &k.RapidCurveCount3, &k.RapidCurveCount4, &k.RapidCurveCount5,
-			&k.RapidCurveMax, &k.RapidCurveMaxSpeed,
-			&k.ContinuousDriveOverCount, &k.ContinuousDriveMaxTime, &k.ContinuousDriveTotalTime,
-			&k.WaveDriveCount, &k.WaveDriveMaxTime, &k.WaveDriveMaxSpeedDiff,
-			&k.LocalSpeedScore, &k.ExpressSpeedScore, &k.DedicatedSpeedScore,
-			&k.LocalDistanceScore, &k.ExpressDistanceScore, &k.DedicatedDistanceScore,
-			&k.RapidAccelScore, &k.RapidDecelScore, &k.RapidCurveScore,
-			&k.ActualLowSpeedRotationScore, &k.ActualHighSpeedRotationScore,
-			&k.EmptyLowSpeedRotationScore, &k.EmptyHighSpeedRotationScore,
-			&k.IdlingScore, &k.ContinuousDriveScore, &k.WaveDriveScore,
-			&k.SafetyScore, &k.EconomyScore, &k.TotalScore,
-		)
-		if err != nil {
-			return nil, err
-		}
-		results = append(results, &k)
-	}
-
-	return results, rows.Err()
+func (r *KudgivtRepository) ListByOrganization(ctx context.Context, organizationID string, opts ListOptions) ([]*Kudgivt, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgivtRepository.ListByOrganization")
+	return kudgivtTable.list(ctx, r.db, opts, kudgivtFields, kudgivtOrder, `"OrganizationID" = $1`, organizationID)
 }
 
 // List retrieves all kudgivt records with pagination
-func (r *KudgivtRepository) List(ctx context.Context, limit int, offset int) ([]*Kudgivt, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT
-			"UUID", "OrganizationID", "Hash", "Created", "Deleted", "KudguriUuid",
-			"UnkouNo", "ReadDate", "UnkouDate",
-			"OfficeCd", "OfficeName", "VehicleCd", "VehicleName",
-			"DriverCd1", "DriverName1", "TargetDriverType", "TargetDriverCd", "TargetDriverName",
-			"ClockInDatetime", "ClockOutDatetime",
-			"DepartureDatetime", "ReturnDatetime",
-			"DepartureMeter", "ReturnMeter", "TotalMileage",
-			"DestinationCityName", "DestinationPlaceName",
-			"ActualMileage",
-			"LocalDriveTime", "ExpressDriveTime", "BypassDriveTime", "ActualDriveTime", "EmptyDriveTime",
-			"Work1Time", "Work2Time", "Work3Time", "Work4Time", "Work5Time",
-			"Work6Time", "Work7Time", "Work8Time", "Work9Time", "Work10Time",
-			"State1Distance", "State2Distance", "State3Distance", "State4Distance", "State5Distance",
-			"State1Time", "State2Time", "State3Time", "State4Time", "State5Time",
-			"OwnMainFuel", "OwnMainAdditive", "OwnConsumable",
-			"OtherMainFuel", "OtherMainAdditive", "OtherConsumable",
-			"LocalSpeedOverMax", "LocalSpeedOverTime", "LocalSpeedOverCount",
-			"ExpressSpeedOverMax", "ExpressSpeedOverTime", "ExpressSpeedOverCount",
-			"DedicatedSpeedOverMax", "DedicatedSpeedOverTime", "DedicatedSpeedOverCount",
-			"IdlingTime", "IdlingTimeCount",
-			"RotationOverMax", "RotationOverCount", "RotationOverTime",
-			"RapidAccelCount1", "RapidAccelCount2", "RapidAccelCount3", "RapidAccelCount4", "RapidAccelCount5",
-			"RapidAccelMax", "RapidAccelMaxSpeed",
-			"RapidDecelCount1", "RapidDecelCount2", "RapidDecelCount3", "RapidDecelCount4", "RapidDecelCount5",
-			"RapidDecelMax", "RapidDecelMaxSpeed",
-			"RapidCurveCount1", "RapidCurveCount2", "RapidCurveCount3", "RapidCurveCount4", "RapidCurveCount5",
-			"RapidCurveMax", "RapidCurveMaxSpeed",
-			"ContinuousDriveOverCount", "ContinuousDriveMaxTime", "ContinuousDriveTotalTime",
-			"WaveDriveCount", "WaveDriveMaxTime", "WaveDriveMaxSpeedDiff",
-			"LocalSpeedScore", "ExpressSpeedScore", "DedicatedSpeedScore",
-			"LocalDistanceScore", "ExpressDistanceScore", "DedicatedDistanceScore",
-			"RapidAccelScore", "RapidDecelScore", "RapidCurveScore",
-			"ActualLowSpeedRotationScore", "ActualHighSpeedRotationScore",
-			"EmptyLowSpeedRotationScore", "EmptyHighSpeedRotationScore",
-			"IdlingScore", "ContinuousDriveScore", "WaveDriveScore",
-			"SafetyScore", "EconomyScore", "TotalScore"
-		FROM kudgivt
-		WHERE "Deleted" IS NULL
-		ORDER BY "Created" DESC
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var results []*Kudgivt
-	for rows.Next() {
-		var k Kudgivt
-		err := rows.Scan(
-			&k.UUID, &k.OrganizationID, &k.Hash, &k.Created, &k.Deleted, &k.KudguriUuid,
-			&k.UnkouNo, &k.ReadDate, &k.UnkouDate,
-			&k.OfficeCd, &k.OfficeName, &k.VehicleCd, &k.VehicleName,
-			&k.DriverCd1, &k.DriverName1, &k.TargetDriverType, &k.TargetDriverCd, &k.TargetDriverName,
-			&k.ClockInDatetime, &k.ClockOutDatetime,
-			&k.DepartureDatetime, &k.ReturnDatetime,
-			&k.DepartureMeter, &k.ReturnMeter, &k.TotalMileage,
-			&k.DestinationCityName, &k.DestinationPlaceName,
-			&k.ActualMileage,
-			&k.LocalDriveTime, &k.ExpressDriveTime, &k.BypassDriveTime, &k.ActualDriveTime, &k.EmptyDriveTime,
-			&k.Work1Time, &k.Work2Time, &k.Work3Time, &k.Work4Time, &k.Work5Time,
-			&k.Work6Time, &k.Work7Time, &k.Work8Time, &k.Work9Time, &k.Work10Time,
-			&k.State1Distance, &k.State2Distance, &k.State3Distance, &k.State4Distance, &k.State5Distance,
-			&k.State1Time, &k.State2Time, &k.State3Time, &k.State4Time, &k.State5Time,
-			&k.OwnMainFuel, &k.OwnMainAdditive, &k.OwnConsumable,
-			&k.OtherMainFuel, &k.OtherMainAdditive, &k.OtherConsumable,
-			&k.LocalSpeedOverMax, &k.LocalSpeedOverTime, &k.LocalSpeedOverCount,
-			&k.ExpressSpeedOverMax, &k.ExpressSpeedOverTime, &k.ExpressSpeedOverCount,
-			&k.DedicatedSpeedOverMax, &k.DedicatedSpeedOverTime, &k.DedicatedSpeedOverCount,
-			&k.IdlingTime, &k.IdlingTimeCount,
-			&k.RotationOverMax, &k.RotationOverCount, &k.RotationOverTime,
-			&k.RapidAccelCount1, &k.RapidAccelCount2, &k.RapidAccelCount3, &k.RapidAccelCount4, &k.RapidAccelCount5,
-			&k.RapidAccelMax, &k.RapidAccelMaxSpeed,
-			&k.RapidDecelCount1, &k.RapidDecelCount2, &k.RapidDecelCount3, &k.RapidDecelCount4, &k.RapidDecelCount5,
-			&k.RapidDecelMax, &k.RapidDecelMaxSpeed,
-			&k.RapidCurveCount1, &k.RapidCurveCount2, &k.RapidCurveCount3, &k.RapidCurveCount4, &k.RapidCurveCount5,
-			&k.RapidCurveMax, &k.RapidCurveMaxSpeed,
-			&k.ContinuousDriveOverCount, &k.ContinuousDriveMaxTime, &k.ContinuousDriveTotalTime,
-			&k.WaveDriveCount, &k.WaveDriveMaxTime, &k.WaveDriveMaxSpeedDiff,
-			&k.LocalSpeedScore, &k.ExpressSpeedScore, &k.DedicatedSpeedScore,
-			&k.LocalDistanceScore, &k.ExpressDistanceScore, &k.DedicatedDistanceScore,
-			&k.RapidAccelScore, &k.RapidDecelScore, &k.RapidCurveScore,
-			&k.ActualLowSpeedRotationScore, &k.ActualHighSpeedRotationScore,
-			&k.EmptyLowSpeedRotationScore, &k.EmptyHighSpeedRotationScore,
-			&k.IdlingScore, &k.ContinuousDriveScore, &k.WaveDriveScore,
-			&k.SafetyScore, &k.EconomyScore, &k.TotalScore,
-		)
-		if err != nil {
-			return nil, err
-		}
-		results = append(results, &k)
-	}
-
-	return results, rows.Err()
+func (r *KudgivtRepository) List(ctx context.Context, opts ListOptions) ([]*Kudgivt, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgivtRepository.List")
+	return kudgivtTable.list(ctx, r.db, opts, kudgivtFields, kudgivtOrder, "")
 }
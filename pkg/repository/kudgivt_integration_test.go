@@ -4,11 +4,14 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_Kudgivt_CRUD(t *testing.T) {
@@ -60,7 +63,7 @@ func TestIntegration_Kudgivt_CRUD(t *testing.T) {
 		// 3. Update
 		t.Run("Update", func(t *testing.T) {
 			testData.Hash = fmt.Sprintf("updated-hash-%s", uuid.New().String()[:8])
-			updated, err := repo.Update(ctx, testData)
+			updated, err := repo.Update(ctx, testData, nil, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -70,9 +73,32 @@ func TestIntegration_Kudgivt_CRUD(t *testing.T) {
 			fmt.Printf("✓ Update: Hash=%s\n", updated.Hash)
 		})
 
+		// 3b. Update with a field mask writes only the masked columns
+		t.Run("UpdateMasked", func(t *testing.T) {
+			vehicleCd := "masked-vehicle"
+			partial := *testData
+			partial.Hash = "not-written"
+			partial.VehicleCd = &vehicleCd
+			updated, err := repo.Update(ctx, &partial, []string{"vehicle_cd"}, "")
+			if err != nil {
+				t.Fatalf("Update failed: %v", err)
+			}
+			if updated.VehicleCd == nil || *updated.VehicleCd != vehicleCd {
+				t.Errorf("Update: VehicleCd = %v, want %s", updated.VehicleCd, vehicleCd)
+			}
+			if updated.Hash != testData.Hash {
+				t.Errorf("Update: Hash = %s, want unchanged %s", updated.Hash, testData.Hash)
+			}
+
+			if _, err := repo.Update(ctx, &partial, []string{"uuid"}, ""); !errors.Is(err, ErrInvalidArgument) {
+				t.Errorf("Update with mask uuid: err = %v, want ErrInvalidArgument", err)
+			}
+			fmt.Printf("✓ UpdateMasked: VehicleCd=%s\n", *updated.VehicleCd)
+		})
+
 		// 4. List
 		t.Run("ListByOrganization", func(t *testing.T) {
-			records, err := repo.ListByOrganization(ctx, orgID, 10, 0)
+			records, _, err := repo.ListByOrganization(ctx, orgID, ListOptions{Page: pagination.Page{Size: 10}})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -84,7 +110,7 @@ func TestIntegration_Kudgivt_CRUD(t *testing.T) {
 
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, testData.UUID)
+			err := repo.Delete(ctx, testData.UUID, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
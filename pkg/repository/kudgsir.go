@@ -2,81 +2,85 @@ package repository
 
 import (
 	"context"
-	"errors"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrKudgsirNotFound = errors.New("kudgsir not found")
+	ErrKudgsirNotFound = notFound("kudgsir not found")
 )
 
 // Kudgsir represents the database model for kudgsir table
 type Kudgsir struct {
-	UUID           string
-	OrganizationID string
-	Hash           string
-	Created        string
-	Deleted        *string
-	KudguriUuid    *string
+	UUID           string  `db:"uuid,pk"`
+	OrganizationID string  `db:"organization_id"`
+	Hash           string  `db:"hash"`
+	Created        string  `db:"created,readonly"`
+	Deleted        *string `db:"deleted,deleted,readonly"`
+	KudguriUuid    *string `db:"kudguri_uuid"`
 
 	// 運行基本情報
-	UnkouNo  *string
-	ReadDate *string
+	UnkouNo  *string `db:"unkou_no"`
+	ReadDate *string `db:"read_date"`
 
 	// 営業所・車両情報
-	OfficeCd    *string
-	OfficeName  *string
-	VehicleCd   *string
-	VehicleName *string
+	OfficeCd    *string `db:"office_cd"`
+	OfficeName  *string `db:"office_name"`
+	VehicleCd   *string `db:"vehicle_cd"`
+	VehicleName *string `db:"vehicle_name"`
 
 	// ドライバー情報
-	DriverCd1        *string
-	DriverName1      *string
-	TargetDriverType string
-	TargetDriverCd   *string
-	TargetDriverName *string
+	DriverCd1        *string `db:"driver_cd_1"`
+	DriverName1      *string `db:"driver_name_1"`
+	TargetDriverType string  `db:"target_driver_type"`
+	TargetDriverCd   *string `db:"target_driver_cd"`
+	TargetDriverName *string `db:"target_driver_name"`
 
 	// 区間情報
-	StartDatetime *string
-	EndDatetime   *string
-	EventCd       *string
-	EventName     *string
+	StartDatetime *string `db:"start_datetime"`
+	EndDatetime   *string `db:"end_datetime"`
+	EventCd       *string `db:"event_cd"`
+	EventName     *string `db:"event_name"`
 
 	// 距離・時間情報
-	StartMileage    *string
-	EndMileage      *string
-	SectionTime     *string
-	SectionDistance *string
+	StartMileage    *string `db:"start_mileage"`
+	EndMileage      *string `db:"end_mileage"`
+	SectionTime     *string `db:"section_time"`
+	SectionDistance *string `db:"section_distance"`
 
 	// 市区町村情報
-	StartCityCd   *string
-	StartCityName *string
-	EndCityCd     *string
-	EndCityName   *string
+	StartCityCd   *string `db:"start_city_cd"`
+	StartCityName *string `db:"start_city_name"`
+	EndCityCd     *string `db:"end_city_cd"`
+	EndCityName   *string `db:"end_city_name"`
 
 	// 場所情報
-	StartPlaceCd   *string
-	StartPlaceName *string
-	EndPlaceCd     *string
-	EndPlaceName   *string
+	StartPlaceCd   *string `db:"start_place_cd"`
+	StartPlaceName *string `db:"start_place_name"`
+	EndPlaceCd     *string `db:"end_place_cd"`
+	EndPlaceName   *string `db:"end_place_name"`
 
 	// GPS情報 (開始地点)
-	StartGpsValid *string
-	StartGpsLat   *string
-	StartGpsLng   *string
+	StartGpsValid *string `db:"start_gps_valid"`
+	StartGpsLat   *string `db:"start_gps_lat"`
+	StartGpsLng   *string `db:"start_gps_lng"`
 
 	// GPS情報 (終了地点)
-	EndGpsValid *string
-	EndGpsLat   *string
-	EndGpsLng   *string
+	EndGpsValid *string `db:"end_gps_valid"`
+	EndGpsLat   *string `db:"end_gps_lat"`
+	EndGpsLng   *string `db:"end_gps_lng"`
 
 	// 速度超過
-	OverLimitMax *string
+	OverLimitMax *string `db:"over_limit_max"`
+	Etag         string  `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var kudgsirTable = NewTable[Kudgsir]("kudgsir", ErrKudgsirNotFound)
+
 // KudgsirRepository handles database operations for kudgsir
 type KudgsirRepository struct {
 	db DB
@@ -94,327 +98,62 @@ func NewKudgsirRepositoryWithDB(db DB) *KudgsirRepository {
 
 // Create inserts a new kudgsir record
 func (r *KudgsirRepository) Create(ctx context.Context, k *Kudgsir) (*Kudgsir, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgsirRepository.Create")
 	id := uuid.New().String()
 	k.UUID = id
 
-	query := `
-		INSERT INTO kudgsir (
-			uuid, organization_id, hash, created, deleted, kudguri_uuid,
-			unkou_no, read_date,
-			office_cd, office_name, vehicle_cd, vehicle_name,
-			driver_cd_1, driver_name_1, target_driver_type, target_driver_cd, target_driver_name,
-			start_datetime, end_datetime, event_cd, event_name,
-			start_mileage, end_mileage, section_time, section_distance,
-			start_city_cd, start_city_name, end_city_cd, end_city_name,
-			start_place_cd, start_place_name, end_place_cd, end_place_name,
-			start_gps_valid, start_gps_lat, start_gps_lng,
-			end_gps_valid, end_gps_lat, end_gps_lng,
-			over_limit_max
-		)
-		VALUES (
-			$1, $2, $3, $4, $5, $6,
-			$7, $8,
-			$9, $10, $11, $12,
-			$13, $14, $15, $16, $17,
-			$18, $19, $20, $21,
-			$22, $23, $24, $25,
-			$26, $27, $28, $29,
-			$30, $31, $32, $33,
-			$34, $35, $36,
-			$37, $38, $39,
-			$40
-		)
-		RETURNING
-			uuid, organization_id, hash, created, deleted, kudguri_uuid,
-			unkou_no, read_date,
-			office_cd, office_name, vehicle_cd, vehicle_name,
-			driver_cd_1, driver_name_1, target_driver_type, target_driver_cd, target_driver_name,
-			start_datetime, end_datetime, event_cd, event_name,
-			start_mileage, end_mileage, section_time, section_distance,
-			start_city_cd, start_city_name, end_city_cd, end_city_name,
-			start_place_cd, start_place_name, end_place_cd, end_place_name,
-			start_gps_valid, start_gps_lat, start_gps_lng,
-			end_gps_valid, end_gps_lat, end_gps_lng,
-			over_limit_max
-	`
-
-	var result Kudgsir
-	err := r.db.QueryRow(ctx, query,
-		k.UUID, k.OrganizationID, k.Hash, k.Created, k.Deleted, k.KudguriUuid,
-		k.UnkouNo, k.ReadDate,
-		k.OfficeCd, k.OfficeName, k.VehicleCd, k.VehicleName,
-		k.DriverCd1, k.DriverName1, k.TargetDriverType, k.TargetDriverCd, k.TargetDriverName,
-		k.StartDatetime, k.EndDatetime, k.EventCd, k.EventName,
-		k.StartMileage, k.EndMileage, k.SectionTime, k.SectionDistance,
-		k.StartCityCd, k.StartCityName, k.EndCityCd, k.EndCityName,
-		k.StartPlaceCd, k.StartPlaceName, k.EndPlaceCd, k.EndPlaceName,
-		k.StartGpsValid, k.StartGpsLat, k.StartGpsLng,
-		k.EndGpsValid, k.EndGpsLat, k.EndGpsLng,
-		k.OverLimitMax,
-	).Scan(
-		&result.UUID, &result.OrganizationID, &result.Hash, &result.Created, &result.Deleted, &result.KudguriUuid,
-		&result.UnkouNo, &result.ReadDate,
-		&result.OfficeCd, &result.OfficeName, &result.VehicleCd, &result.VehicleName,
-		&result.DriverCd1, &result.DriverName1, &result.TargetDriverType, &result.TargetDriverCd, &result.TargetDriverName,
-		&result.StartDatetime, &result.EndDatetime, &result.EventCd, &result.EventName,
-		&result.StartMileage, &result.EndMileage, &result.SectionTime, &result.SectionDistance,
-		&result.StartCityCd, &result.StartCityName, &result.EndCityCd, &result.EndCityName,
-		&result.StartPlaceCd, &result.StartPlaceName, &result.EndPlaceCd, &result.EndPlaceName,
-		&result.StartGpsValid, &result.StartGpsLat, &result.StartGpsLng,
-		&result.EndGpsValid, &result.EndGpsLat, &result.EndGpsLng,
-		&result.OverLimitMax,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &result, nil
+	return kudgsirTable.insert(ctx, r.db, k)
 }
 
 // GetByUUID retrieves a kudgsir record by UUID
 func (r *KudgsirRepository) GetByUUID(ctx context.Context, uuid string) (*Kudgsir, error) {
-	query := `
-		SELECT
-			uuid, organization_id, hash, created, deleted, kudguri_uuid,
-			unkou_no, read_date,
-			office_cd, office_name, vehicle_cd, vehicle_name,
-			driver_cd_1, driver_name_1, target_driver_type, target_driver_cd, target_driver_name,
-			start_datetime, end_datetime, event_cd, event_name,
-			start_mileage, end_mileage, section_time, section_distance,
-			start_city_cd, start_city_name, end_city_cd, end_city_name,
-			start_place_cd, start_place_name, end_place_cd, end_place_name,
-			start_gps_valid, start_gps_lat, start_gps_lng,
-			end_gps_valid, end_gps_lat, end_gps_lng,
-			over_limit_max
-		FROM kudgsir
-		WHERE uuid = $1 AND deleted IS NULL
-	`
-
-	var k Kudgsir
-	err := r.db.QueryRow(ctx, query, uuid).Scan(
-		&k.UUID, &k.OrganizationID, &k.Hash, &k.Created, &k.Deleted, &k.KudguriUuid,
-		&k.UnkouNo, &k.ReadDate,
-		&k.OfficeCd, &k.OfficeName, &k.VehicleCd, &k.VehicleName,
-		&k.DriverCd1, &k.DriverName1, &k.TargetDriverType, &k.TargetDriverCd, &k.TargetDriverName,
-		&k.StartDatetime, &k.EndDatetime, &k.EventCd, &k.EventName,
-		&k.StartMileage, &k.EndMileage, &k.SectionTime, &k.SectionDistance,
-		&k.StartCityCd, &k.StartCityName, &k.EndCityCd, &k.EndCityName,
-		&k.StartPlaceCd, &k.StartPlaceName, &k.EndPlaceCd, &k.EndPlaceName,
-		&k.StartGpsValid, &k.StartGpsLat, &k.StartGpsLng,
-		&k.EndGpsValid, &k.EndGpsLat, &k.EndGpsLng,
-		&k.OverLimitMax,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrKudgsirNotFound
-		}
-		return nil, err
-	}
-
-	return &k, nil
+	ctx = db.WithQueryLabel(ctx, "KudgsirRepository.GetByUUID")
+	return kudgsirTable.get(ctx, r.db, uuid)
 }
 
-// Update modifies an existing kudgsir record
-func (r *KudgsirRepository) Update(ctx context.Context, k *Kudgsir) (*Kudgsir, error) {
-	query := `
-		UPDATE kudgsir
-		SET
-			organization_id = $2, hash = $3, kudguri_uuid = $4,
-			unkou_no = $5, read_date = $6,
-			office_cd = $7, office_name = $8, vehicle_cd = $9, vehicle_name = $10,
-			driver_cd_1 = $11, driver_name_1 = $12, target_driver_type = $13, target_driver_cd = $14, target_driver_name = $15,
-			start_datetime = $16, end_datetime = $17, event_cd = $18, event_name = $19,
-			start_mileage = $20, end_mileage = $21, section_time = $22, section_distance = $23,
-			start_city_cd = $24, start_city_name = $25, end_city_cd = $26, end_city_name = $27,
-			start_place_cd = $28, start_place_name = $29, end_place_cd = $30, end_place_name = $31,
-			start_gps_valid = $32, start_gps_lat = $33, start_gps_lng = $34,
-			end_gps_valid = $35, end_gps_lat = $36, end_gps_lng = $37,
-			over_limit_max = $38
-		WHERE uuid = $1 AND deleted IS NULL
-		RETURNING
-			uuid, organization_id, hash, created, deleted, kudguri_uuid,
-			unkou_no, read_date,
-			office_cd, office_name, vehicle_cd, vehicle_name,
-			driver_cd_1, driver_name_1, target_driver_type, target_driver_cd, target_driver_name,
-			start_datetime, end_datetime, event_cd, event_name,
-			start_mileage, end_mileage, section_time, section_distance,
-			start_city_cd, start_city_name, end_city_cd, end_city_name,
-			start_place_cd, start_place_name, end_place_cd, end_place_name,
-			start_gps_valid, start_gps_lat, start_gps_lng,
-			end_gps_valid, end_gps_lat, end_gps_lng,
-			over_limit_max
-	`
-
-	var result Kudgsir
-	err := r.db.QueryRow(ctx, query,
-		k.UUID, k.OrganizationID, k.Hash, k.KudguriUuid,
-		k.UnkouNo, k.ReadDate,
-		k.OfficeCd, k.OfficeName, k.VehicleCd, k.VehicleName,
-		k.DriverCd1, k.DriverName1, k.TargetDriverType, k.TargetDriverCd, k.TargetDriverName,
-		k.StartDatetime, k.EndDatetime, k.EventCd, k.EventName,
-		k.StartMileage, k.EndMileage, k.SectionTime, k.SectionDistance,
-		k.StartCityCd, k.StartCityName, k.EndCityCd, k.EndCityName,
-		k.StartPlaceCd, k.StartPlaceName, k.EndPlaceCd, k.EndPlaceName,
-		k.StartGpsValid, k.StartGpsLat, k.StartGpsLng,
-		k.EndGpsValid, k.EndGpsLat, k.EndGpsLng,
-		k.OverLimitMax,
-	).Scan(
-		&result.UUID, &result.OrganizationID, &result.Hash, &result.Created, &result.Deleted, &result.KudguriUuid,
-		&result.UnkouNo, &result.ReadDate,
-		&result.OfficeCd, &result.OfficeName, &result.VehicleCd, &result.VehicleName,
-		&result.DriverCd1, &result.DriverName1, &result.TargetDriverType, &result.TargetDriverCd, &result.TargetDriverName,
-		&result.StartDatetime, &result.EndDatetime, &result.EventCd, &result.EventName,
-		&result.StartMileage, &result.EndMileage, &result.SectionTime, &result.SectionDistance,
-		&result.StartCityCd, &result.StartCityName, &result.EndCityCd, &result.EndCityName,
-		&result.StartPlaceCd, &result.StartPlaceName, &result.EndPlaceCd, &result.EndPlaceName,
-		&result.StartGpsValid, &result.StartGpsLat, &result.StartGpsLng,
-		&result.EndGpsValid, &result.EndGpsLat, &result.EndGpsLng,
-		&result.OverLimitMax,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrKudgsirNotFound
-		}
-		return nil, err
-	}
-
-	return &result, nil
+// Update modifies an existing kudgsir record. A non-empty etag must be the current one.
+func (r *KudgsirRepository) Update(ctx context.Context, k *Kudgsir, etag string) (*Kudgsir, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgsirRepository.Update")
+	return kudgsirTable.update(ctx, r.db, k, nil, etag)
 }
 
-// Delete soft-deletes a kudgsir record
-func (r *KudgsirRepository) Delete(ctx context.Context, uuid, deletedTimestamp string) error {
-	query := `
-		UPDATE kudgsir
-		SET deleted = $2
-		WHERE uuid = $1 AND deleted IS NULL
-	`
-
-	result, err := r.db.Exec(ctx, query, uuid, deletedTimestamp)
-	if err != nil {
-		return err
-	}
+// Delete soft-deletes a kudgsir record. A non-empty etag must be the current one.
+func (r *KudgsirRepository) Delete(ctx context.Context, uuid, deletedTimestamp, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "KudgsirRepository.Delete")
+	return kudgsirTable.softDelete(ctx, r.db, deletedTimestamp, etag, uuid)
+}
 
-	if result.RowsAffected() == 0 {
-		return ErrKudgsirNotFound
-	}
+// kudgsirFields are the fields kudgsir listings can be filtered and ordered by
+var kudgsirFields = ListFields{
+	"created":            {Column: "created", Type: "text"},
+	"unkou_no":           {Column: "unkou_no", Type: "text", Nullable: true},
+	"kudguri_uuid":       {Column: "kudguri_uuid", Type: "text", Nullable: true},
+	"read_date":          {Column: "read_date", Type: "text", Nullable: true},
+	"office_cd":          {Column: "office_cd", Type: "text", Nullable: true},
+	"vehicle_cd":         {Column: "vehicle_cd", Type: "text", Nullable: true},
+	"vehicle_name":       {Column: "vehicle_name", Type: "text", Nullable: true},
+	"driver_cd1":         {Column: "driver_cd_1", Type: "text", Nullable: true},
+	"target_driver_type": {Column: "target_driver_type", Type: "text"},
+	"target_driver_cd":   {Column: "target_driver_cd", Type: "text", Nullable: true},
+	"start_datetime":     {Column: "start_datetime", Type: "text", Nullable: true},
+	"end_datetime":       {Column: "end_datetime", Type: "text", Nullable: true},
+	"event_cd":           {Column: "event_cd", Type: "text", Nullable: true},
+}
 
-	return nil
+// kudgsirOrder lists kudgsir newest first; uuid makes the order unique
+var kudgsirOrder = pagination.Order{
+	pagination.Desc(`created`, "text"),
+	pagination.Desc(`uuid`, "text"),
 }
 
 // ListByOrganization retrieves kudgsir records by organization with pagination
-func (r *KudgsirRepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*Kudgsir, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT
-			uuid, organization_id, hash, created, deleted, kudguri_uuid,
-			unkou_no, read_date,
-			office_cd, office_name, vehicle_cd, vehicle_name,
-			driver_cd_1, driver_name_1, target_driver_type, target_driver_cd, target_driver_name,
-			start_datetime, end_datetime, event_cd, event_name,
-			start_mileage, end_mileage, section_time, section_distance,
-			start_city_cd, start_city_name, end_city_cd, end_city_name,
-			start_place_cd, start_place_name, end_place_cd, end_place_name,
-			start_gps_valid, start_gps_lat, start_gps_lng,
-			end_gps_valid, end_gps_lat, end_gps_lng,
-			over_limit_max
-		FROM kudgsir
-		WHERE organization_id = $1 AND deleted IS NULL
-		ORDER BY created DESC
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var results []*Kudgsir
-	for rows.Next() {
-		var k Kudgsir
-		err := rows.Scan(
-			&k.UUID, &k.OrganizationID, &k.Hash, &k.Created, &k.Deleted, &k.KudguriUuid,
-			&k.UnkouNo, &k.ReadDate,
-			&k.OfficeCd, &k.OfficeName, &k.VehicleCd, &k.VehicleName,
-			&k.DriverCd1, &k.DriverName1, &k.TargetDriverType, &k.TargetDriverCd, &k.TargetDriverName,
-			&k.StartDatetime, &k.EndDatetime, &k.EventCd, &k.EventName,
-			&k.StartMileage, &k.EndMileage, &k.SectionTime, &k.SectionDistance,
-			&k.StartCityCd, &k.StartCityName, &k.EndCityCd, &k.EndCityName,
-			&k.StartPlaceCd, &k.StartPlaceName, &k.EndPlaceCd, &k.EndPlaceName,
-			&k.StartGpsValid, &k.StartGpsLat, &k.StartGpsLng,
-			&k.EndGpsValid, &k.EndGpsLat, &k.EndGpsLng,
-			&k.OverLimitMax,
-		)
-		if err != nil {
-			return nil, err
-		}
-		results = append(results, &k)
-	}
-
-	return results, rows.Err()
+func (r *KudgsirRepository) ListByOrganization(ctx context.Context, organizationID string, opts ListOptions) ([]*Kudgsir, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgsirRepository.ListByOrganization")
+	return kudgsirTable.list(ctx, r.db, opts, kudgsirFields, kudgsirOrder, "organization_id = $1", organizationID)
 }
 
 // List retrieves all kudgsir records with pagination
-func (r *KudgsirRepository) List(ctx context.Context, limit int, offset int) ([]*Kudgsir, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT
-			uuid, organization_id, hash, created, deleted, kudguri_uuid,
-			unkou_no, read_date,
-			office_cd, office_name, vehicle_cd, vehicle_name,
-			driver_cd_1, driver_name_1, target_driver_type, target_driver_cd, target_driver_name,
-			start_datetime, end_datetime, event_cd, event_name,
-			start_mileage, end_mileage, section_time, section_distance,
-			start_city_cd, start_city_name, end_city_cd, end_city_name,
-			start_place_cd, start_place_name, end_place_cd, end_place_name,
-			start_gps_valid, start_gps_lat, start_gps_lng,
-			end_gps_valid, end_gps_lat, end_gps_lng,
-			over_limit_max
-		FROM kudgsir
-		WHERE deleted IS NULL
-		ORDER BY created DESC
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var results []*Kudgsir
-	for rows.Next() {
-		var k Kudgsir
-		err := rows.Scan(
-			&k.UUID, &k.OrganizationID, &k.Hash, &k.Created, &k.Deleted, &k.KudguriUuid,
-			&k.UnkouNo, &k.ReadDate,
-			&k.OfficeCd, &k.OfficeName, &k.VehicleCd, &k.VehicleName,
-			&k.DriverCd1, &k.DriverName1, &k.TargetDriverType, &k.TargetDriverCd, &k.TargetDriverName,
-			&k.StartDatetime, &k.EndDatetime, &k.EventCd, &k.EventName,
-			&k.StartMileage, &k.EndMileage, &k.SectionTime, &k.SectionDistance,
-			&k.StartCityCd, &k.StartCityName, &k.EndCityCd, &k.EndCityName,
-			&k.StartPlaceCd, &k.StartPlaceName, &k.EndPlaceCd, &k.EndPlaceName,
-			&k.StartGpsValid, &k.StartGpsLat, &k.StartGpsLng,
-			&k.EndGpsValid, &k.EndGpsLat, &k.EndGpsLng,
-			&k.OverLimitMax,
-		)
-		if err != nil {
-			return nil, err
-		}
-		results = append(results, &k)
-	}
-
-	return results, rows.Err()
+func (r *KudgsirRepository) List(ctx context.Context, opts ListOptions) ([]*Kudgsir, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "KudgsirRepository.List")
+	return kudgsirTable.list(ctx, r.db, opts, kudgsirFields, kudgsirOrder, "")
 }
@@ -4,11 +4,14 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_Kudguri_CRUD(t *testing.T) {
@@ -58,7 +61,7 @@ func TestIntegration_Kudguri_CRUD(t *testing.T) {
 		// 3. Update
 		t.Run("Update", func(t *testing.T) {
 			testData.Hash = fmt.Sprintf("updated-hash-%s", uuid.New().String()[:8])
-			updated, err := repo.Update(ctx, testData)
+			updated, err := repo.Update(ctx, testData, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -70,7 +73,7 @@ func TestIntegration_Kudguri_CRUD(t *testing.T) {
 
 		// 4. List
 		t.Run("ListByOrganization", func(t *testing.T) {
-			records, err := repo.ListByOrganization(ctx, orgID, 10, 0)
+			records, _, err := repo.ListByOrganization(ctx, orgID, ListOptions{Page: pagination.Page{Size: 10}})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -83,7 +86,7 @@ func TestIntegration_Kudguri_CRUD(t *testing.T) {
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
 			deletedTime := time.Now().Format(time.RFC3339)
-			err := repo.Delete(ctx, testData.UUID, deletedTime)
+			err := repo.Delete(ctx, testData.UUID, deletedTime, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
@@ -97,3 +100,82 @@ func TestIntegration_Kudguri_CRUD(t *testing.T) {
 		})
 	})
 }
+
+func TestIntegration_Kudguri_FilterAndOrder(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+
+	repo := NewKudguriRepository(pool)
+	ctx := context.Background()
+
+	orgID := fmt.Sprintf("test-org-%s", uuid.New().String()[:8])
+	now := time.Now().Format(time.RFC3339)
+	str := func(s string) *string { return &s }
+
+	rows := []struct {
+		vehicle string
+		start   *string
+	}{
+		{"V1", str("2026-01-01T08:00:00")},
+		{"V1", str("2026-01-02T08:00:00")},
+		{"V1", str("2026-01-03T08:00:00")},
+		{"V1", nil},
+		{"V2", str("2026-01-02T09:00:00")},
+	}
+	for _, r := range rows {
+		_, err := repo.Create(ctx, &Kudguri{
+			OrganizationID: orgID,
+			Hash:           fmt.Sprintf("hash-%s", uuid.New().String()[:8]),
+			Created:        now,
+			VehicleCd:      str(r.vehicle),
+			StartDatetime:  r.start,
+		})
+		if err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	// walk the listing one row per page so every page continues from a key
+	list := func(opts ListOptions) []string {
+		var starts []string
+		for {
+			opts.Page.Size = 1
+			records, next, err := repo.ListByOrganization(ctx, orgID, opts)
+			if err != nil {
+				t.Fatalf("ListByOrganization(%q, %q) failed: %v", opts.Filter, opts.OrderBy, err)
+			}
+			for _, r := range records {
+				if r.StartDatetime == nil {
+					starts = append(starts, "null")
+				} else {
+					starts = append(starts, *r.StartDatetime)
+				}
+			}
+			if next == nil {
+				return starts
+			}
+			opts.Page.After = next
+		}
+	}
+
+	got := list(ListOptions{Filter: `vehicle_cd = "V1" AND start_datetime >= "2026-01-02"`, OrderBy: "start_datetime desc"})
+	want := []string{"2026-01-03T08:00:00", "2026-01-02T08:00:00"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("filtered = %v, want %v", got, want)
+	}
+
+	got = list(ListOptions{Filter: `vehicle_cd = "V1"`, OrderBy: "start_datetime"})
+	want = []string{"2026-01-01T08:00:00", "2026-01-02T08:00:00", "2026-01-03T08:00:00", "null"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("ordered = %v, want %v (nulls last)", got, want)
+	}
+
+	got = list(ListOptions{Filter: `start_datetime = null OR vehicle_cd = "V2"`})
+	if len(got) != 2 {
+		t.Errorf("null filter = %v, want 2 rows", got)
+	}
+
+	if _, _, err := repo.ListByOrganization(ctx, orgID, ListOptions{Filter: `"Deleted" = null`}); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("filter on a column name: err = %v, want ErrInvalidArgument", err)
+	}
+}
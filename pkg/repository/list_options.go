@@ -0,0 +1,417 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
+)
+
+// Limits on filter expressions, so a request cannot make the planner work on a huge WHERE
+const (
+	maxFilterLength      = 2000
+	maxFilterComparisons = 20
+)
+
+// ListField is a column a listing can be filtered and ordered by
+type ListField struct {
+	Column   string // SQL column, quoted as needed
+	Type     string // SQL type of the column: "text", "integer", "bigint" or "real"
+	Nullable bool
+}
+
+// ListFields is the allow-list of a table's filter and order_by fields, keyed by their
+// API (proto) field name. Fields that are not listed cannot be referenced.
+type ListFields map[string]ListField
+
+// ListOptions selects a page of a listing, optionally filtered and ordered by the client
+type ListOptions struct {
+	// Filter is an AIP-160 style expression: comparisons `field op value` with the
+	// operators = != < <= > >=, combined with AND, OR, NOT and parentheses. As in AIP-160,
+	// OR binds tighter than AND. Values are quoted strings, numbers, true/false or null
+	// (`field = null`), e.g. `vehicle_cd = "123" AND start_datetime >= "2026-01-01"`.
+	Filter string
+	// OrderBy is a comma-separated list of fields, each optionally followed by "asc" or
+	// "desc", e.g. "start_datetime desc, vehicle_cd". Null values sort last.
+	OrderBy string
+	Page    pagination.Page
+}
+
+// invalidArgument returns an error of category ErrInvalidArgument about a request field
+func invalidArgument(field, format string, args ...any) error {
+	return &Error{Kind: ErrInvalidArgument, Message: "invalid " + field + ": " + fmt.Sprintf(format, args...), Field: field}
+}
+
+// listQuery is the WHERE condition and sort order of a listing built from ListOptions
+type listQuery struct {
+	where string // filter and page conditions, "TRUE" if there are none
+	args  []any
+	order pagination.Order
+}
+
+// query builds the listing of opts. Its parameters are numbered from arg. The order_by
+// columns come first and defaultOrder, which ends in the primary key, breaks ties.
+func (f ListFields) query(opts ListOptions, defaultOrder pagination.Order, arg int) (*listQuery, error) {
+	q := &listQuery{where: "TRUE"}
+
+	if strings.TrimSpace(opts.Filter) != "" {
+		p, err := newFilterParser(f, opts.Filter, arg)
+		if err != nil {
+			return nil, err
+		}
+		where, err := p.parse()
+		if err != nil {
+			return nil, err
+		}
+		q.where, q.args = where, p.args
+	}
+
+	order, err := f.order(opts.OrderBy, defaultOrder)
+	if err != nil {
+		return nil, err
+	}
+	q.order = order
+
+	after, afterArgs, err := order.After(opts.Page.After, arg+len(q.args))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case after == "TRUE":
+	case q.where == "TRUE":
+		q.where = after
+	default:
+		q.where = q.where + " AND " + after
+	}
+	q.args = append(q.args, afterArgs...)
+	return q, nil
+}
+
+// key returns the select-list expression of a row's page key, its sort values as text
+func (q *listQuery) key() string {
+	cols := make([]string, len(q.order))
+	for i, c := range q.order {
+		cols[i] = "(" + c.Name + ")::text"
+	}
+	return "ARRAY[" + strings.Join(cols, ", ") + "]"
+}
+
+// order parses an order_by list and completes it with defaultOrder
+func (f ListFields) order(orderBy string, defaultOrder pagination.Order) (pagination.Order, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return defaultOrder, nil
+	}
+
+	var order pagination.Order
+	seen := map[string]bool{}
+	for _, item := range strings.Split(orderBy, ",") {
+		words := strings.Fields(item)
+		if len(words) == 0 || len(words) > 2 {
+			return nil, invalidArgument("order_by", "expected \"field [asc|desc]\", got %q", strings.TrimSpace(item))
+		}
+		field, ok := f[words[0]]
+		if !ok {
+			return nil, invalidArgument("order_by", "unknown field %q", words[0])
+		}
+		if seen[words[0]] {
+			return nil, invalidArgument("order_by", "field %q listed twice", words[0])
+		}
+		seen[words[0]] = true
+
+		desc := false
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				desc = true
+			default:
+				return nil, invalidArgument("order_by", "expected asc or desc after %q, got %q", words[0], words[1])
+			}
+		}
+
+		col := field.Column
+		if field.Nullable {
+			// keyset conditions cannot compare nulls: sort by whether the value is
+			// null first (nulls last), then by the value with nulls replaced
+			order = append(order, pagination.Asc("("+col+" IS NULL)", "boolean"))
+			col = "COALESCE(" + col + ", " + zeroValue(field.Type) + ")"
+		}
+		if desc {
+			order = append(order, pagination.Desc(col, field.Type))
+		} else {
+			order = append(order, pagination.Asc(col, field.Type))
+		}
+	}
+
+	for _, c := range defaultOrder {
+		if !containsColumn(order, c.Name) {
+			order = append(order, c)
+		}
+	}
+	return order, nil
+}
+
+func containsColumn(order pagination.Order, name string) bool {
+	for _, c := range order {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// zeroValue is the SQL literal nulls of a column type are sorted as
+func zeroValue(typ string) string {
+	if typ == "text" {
+		return "''"
+	}
+	return "0"
+}
+
+// filterToken is a lexical token of a filter expression
+type filterToken struct {
+	kind  byte   // '(' ')' 'o' (operator), 's' (string), 'w' (word), 0 (end)
+	text  string // operator, unquoted string or word
+	start int
+}
+
+// filterParser translates a filter expression to a parameterized SQL condition
+type filterParser struct {
+	fields      ListFields
+	tokens      []filterToken
+	pos         int
+	arg         int
+	args        []any
+	comparisons int
+}
+
+func newFilterParser(fields ListFields, filter string, arg int) (*filterParser, error) {
+	if len(filter) > maxFilterLength {
+		return nil, invalidArgument("filter", "longer than %d characters", maxFilterLength)
+	}
+	tokens, err := lexFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	return &filterParser{fields: fields, tokens: tokens, arg: arg}, nil
+}
+
+func lexFilter(s string) ([]filterToken, error) {
+	var tokens []filterToken
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, filterToken{kind: c, text: string(c), start: i})
+			i++
+		case c == '=':
+			tokens = append(tokens, filterToken{kind: 'o', text: "=", start: i})
+			i++
+		case c == '!' || c == '<' || c == '>':
+			op := string(c)
+			if i+1 < len(s) && s[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, invalidArgument("filter", "unexpected %q at position %d", "!", i)
+			}
+			tokens = append(tokens, filterToken{kind: 'o', text: op, start: i})
+			i += len(op)
+		case c == '"':
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' && j+1 < len(s) {
+					j++
+				}
+				sb.WriteByte(s[j])
+			}
+			if j == len(s) {
+				return nil, invalidArgument("filter", "unterminated string at position %d", i)
+			}
+			tokens = append(tokens, filterToken{kind: 's', text: sb.String(), start: i})
+			i = j + 1
+		case isWordByte(c):
+			j := i
+			for j < len(s) && isWordByte(s[j]) {
+				j++
+			}
+			tokens = append(tokens, filterToken{kind: 'w', text: s[i:j], start: i})
+			i = j
+		default:
+			return nil, invalidArgument("filter", "unexpected %q at position %d", string(c), i)
+		}
+	}
+	return append(tokens, filterToken{start: len(s)}), nil
+}
+
+// isWordByte reports whether c can be part of a field name or an unquoted value
+// such as 123, -1.5 or 2026-01-01
+func isWordByte(c byte) bool {
+	return c == '_' || c == '.' || c == '-' || c == '+' || c == ':' ||
+		'0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() filterToken {
+	t := p.tokens[p.pos]
+	if t.kind != 0 {
+		p.pos++
+	}
+	return t
+}
+
+func (p *filterParser) keyword(word string) bool {
+	t := p.peek()
+	if t.kind == 'w' && t.text == word {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) unexpected(t filterToken) error {
+	if t.kind == 0 {
+		return invalidArgument("filter", "unexpected end of expression")
+	}
+	return invalidArgument("filter", "unexpected %q at position %d", t.text, t.start)
+}
+
+// parse parses the whole expression
+func (p *filterParser) parse() (string, error) {
+	sql, err := p.conjunction()
+	if err != nil {
+		return "", err
+	}
+	if t := p.peek(); t.kind != 0 {
+		return "", p.unexpected(t)
+	}
+	return sql, nil
+}
+
+// conjunction := disjunction { AND disjunction }
+func (p *filterParser) conjunction() (string, error) {
+	return p.sequence("AND", p.disjunction)
+}
+
+// disjunction := term { OR term }
+func (p *filterParser) disjunction() (string, error) {
+	return p.sequence("OR", p.term)
+}
+
+func (p *filterParser) sequence(op string, operand func() (string, error)) (string, error) {
+	first, err := operand()
+	if err != nil {
+		return "", err
+	}
+	parts := []string{first}
+	for p.keyword(op) {
+		next, err := operand()
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, next)
+	}
+	if len(parts) == 1 {
+		return first, nil
+	}
+	return "(" + strings.Join(parts, " "+op+" ") + ")", nil
+}
+
+// term := [NOT] ( "(" conjunction ")" | comparison )
+func (p *filterParser) term() (string, error) {
+	if p.keyword("NOT") {
+		sql, err := p.term()
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + sql, nil
+	}
+
+	if p.peek().kind == '(' {
+		p.next()
+		sql, err := p.conjunction()
+		if err != nil {
+			return "", err
+		}
+		if t := p.next(); t.kind != ')' {
+			return "", p.unexpected(t)
+		}
+		return "(" + sql + ")", nil
+	}
+	return p.comparison()
+}
+
+// comparison := field operator value
+func (p *filterParser) comparison() (string, error) {
+	name := p.next()
+	if name.kind != 'w' {
+		return "", p.unexpected(name)
+	}
+	field, ok := p.fields[name.text]
+	if !ok {
+		return "", invalidArgument("filter", "unknown field %q", name.text)
+	}
+
+	op := p.next()
+	if op.kind != 'o' {
+		return "", p.unexpected(op)
+	}
+	value := p.next()
+	if value.kind != 's' && value.kind != 'w' {
+		return "", p.unexpected(value)
+	}
+
+	p.comparisons++
+	if p.comparisons > maxFilterComparisons {
+		return "", invalidArgument("filter", "more than %d comparisons", maxFilterComparisons)
+	}
+
+	if value.kind == 'w' && value.text == "null" {
+		switch op.text {
+		case "=":
+			return "(" + field.Column + " IS NULL)", nil
+		case "!=":
+			return "(" + field.Column + " IS NOT NULL)", nil
+		default:
+			return "", invalidArgument("filter", "null can only be compared with = or !=")
+		}
+	}
+
+	if err := checkFilterValue(field.Type, value.text); err != nil {
+		return "", invalidArgument("filter", "%s: %v", name.text, err)
+	}
+	p.args = append(p.args, value.text)
+	param := fmt.Sprintf("$%d::%s", p.arg+len(p.args)-1, field.Type)
+
+	if op.text == "!=" {
+		// rows where the field is null differ from every value
+		return "(" + field.Column + " IS DISTINCT FROM " + param + ")", nil
+	}
+	return "(" + field.Column + " " + op.text + " " + param + ")", nil
+}
+
+// checkFilterValue rejects values Postgres could not cast to the column type
+func checkFilterValue(typ, value string) error {
+	var err error
+	switch typ {
+	case "integer":
+		_, err = strconv.ParseInt(value, 10, 32)
+	case "bigint":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case "real":
+		_, err = strconv.ParseFloat(value, 32)
+	case "boolean":
+		_, err = strconv.ParseBool(value)
+	}
+	if err != nil {
+		return fmt.Errorf("%q is not a valid %s", value, typ)
+	}
+	return nil
+}
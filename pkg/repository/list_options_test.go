@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
+)
+
+var testListFields = ListFields{
+	"created":        {Column: `"Created"`, Type: "text"},
+	"vehicle_cd":     {Column: `"VehicleCd"`, Type: "text", Nullable: true},
+	"start_datetime": {Column: `"StartDatetime"`, Type: "text", Nullable: true},
+	"speed":          {Column: `"Speed"`, Type: "real"},
+	"driver_cd":      {Column: `"DriverCD"`, Type: "integer"},
+}
+
+var testDefaultOrder = pagination.Order{
+	pagination.Desc(`"Created"`, "text"),
+	pagination.Desc("uuid", "text"),
+}
+
+func TestListFields_Filter(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    string
+		wantWhere string
+		wantArgs  []any
+	}{
+		{
+			name:      "empty",
+			filter:    "  ",
+			wantWhere: "TRUE",
+		},
+		{
+			name:      "comparison",
+			filter:    `vehicle_cd = "123"`,
+			wantWhere: `("VehicleCd" = $3::text)`,
+			wantArgs:  []any{"123"},
+		},
+		{
+			name:      "and",
+			filter:    `vehicle_cd = "123" AND start_datetime >= "2026-01-01"`,
+			wantWhere: `(("VehicleCd" = $3::text) AND ("StartDatetime" >= $4::text))`,
+			wantArgs:  []any{"123", "2026-01-01"},
+		},
+		{
+			name:      "or binds tighter than and",
+			filter:    `driver_cd = 1 AND speed > 10 OR speed < 0.5`,
+			wantWhere: `(("DriverCD" = $3::integer) AND (("Speed" > $4::real) OR ("Speed" < $5::real)))`,
+			wantArgs:  []any{"1", "10", "0.5"},
+		},
+		{
+			name:      "parentheses and not",
+			filter:    `NOT (driver_cd = 1 AND driver_cd = 2)`,
+			wantWhere: `NOT ((("DriverCD" = $3::integer) AND ("DriverCD" = $4::integer)))`,
+			wantArgs:  []any{"1", "2"},
+		},
+		{
+			name:      "not equal includes nulls",
+			filter:    `vehicle_cd != "123"`,
+			wantWhere: `("VehicleCd" IS DISTINCT FROM $3::text)`,
+			wantArgs:  []any{"123"},
+		},
+		{
+			name:      "null",
+			filter:    `vehicle_cd = null AND start_datetime != null`,
+			wantWhere: `(("VehicleCd" IS NULL) AND ("StartDatetime" IS NOT NULL))`,
+		},
+		{
+			name:      "escaped quote",
+			filter:    `vehicle_cd = "a\"b"`,
+			wantWhere: `("VehicleCd" = $3::text)`,
+			wantArgs:  []any{`a"b`},
+		},
+		{
+			name:      "injection stays a parameter",
+			filter:    `vehicle_cd = "1'; DROP TABLE kudguri; --"`,
+			wantWhere: `("VehicleCd" = $3::text)`,
+			wantArgs:  []any{"1'; DROP TABLE kudguri; --"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := testListFields.query(ListOptions{Filter: tt.filter}, testDefaultOrder, 3)
+			if err != nil {
+				t.Fatalf("query: %v", err)
+			}
+			if q.where != tt.wantWhere {
+				t.Errorf("where = %s, want %s", q.where, tt.wantWhere)
+			}
+			if !reflect.DeepEqual(q.args, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", q.args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestListFields_InvalidFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		wantMsg string
+	}{
+		{"unknown field", `deleted = "x"`, `unknown field "deleted"`},
+		{"column name", `"VehicleCd" = "x"`, `unexpected "VehicleCd"`},
+		{"missing value", `vehicle_cd =`, "unexpected end"},
+		{"missing operator", `vehicle_cd "x"`, `unexpected "x"`},
+		{"unclosed parenthesis", `(vehicle_cd = "x"`, "unexpected end"},
+		{"trailing token", `vehicle_cd = "x" vehicle_cd`, `unexpected "vehicle_cd"`},
+		{"unterminated string", `vehicle_cd = "x`, "unterminated string"},
+		{"sql", `vehicle_cd = 'x'`, `unexpected "'"`},
+		{"not a number", `driver_cd = "abc"`, `"abc" is not a valid integer`},
+		{"null ordering", `vehicle_cd < null`, "null can only be compared"},
+		{"too many comparisons", strings.Repeat(`driver_cd = 1 AND `, maxFilterComparisons) + `driver_cd = 1`, "more than"},
+		{"too long", `vehicle_cd = "` + strings.Repeat("x", maxFilterLength) + `"`, "longer than"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testListFields.query(ListOptions{Filter: tt.filter}, testDefaultOrder, 3)
+			var repoErr *Error
+			if !errors.As(err, &repoErr) || !errors.Is(err, ErrInvalidArgument) {
+				t.Fatalf("err = %v, want ErrInvalidArgument", err)
+			}
+			if repoErr.Field != "filter" {
+				t.Errorf("Field = %q, want filter", repoErr.Field)
+			}
+			if !strings.Contains(repoErr.Message, tt.wantMsg) {
+				t.Errorf("Message = %q, want it to contain %q", repoErr.Message, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestListFields_OrderBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		orderBy string
+		want    string
+	}{
+		{"default", "", `"Created" DESC, uuid DESC`},
+		{"field", "driver_cd", `"DriverCD", "Created" DESC, uuid DESC`},
+		{"default column", "created asc", `"Created", uuid DESC`},
+		{"nullable", "start_datetime desc, speed", `("StartDatetime" IS NULL), COALESCE("StartDatetime", '') DESC, "Speed", "Created" DESC, uuid DESC`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := testListFields.query(ListOptions{OrderBy: tt.orderBy}, testDefaultOrder, 1)
+			if err != nil {
+				t.Fatalf("query: %v", err)
+			}
+			if got := q.order.OrderBy(); got != tt.want {
+				t.Errorf("OrderBy = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	for _, orderBy := range []string{"deleted", "driver_cd up", "driver_cd, driver_cd", "driver_cd desc nulls", ","} {
+		if _, err := testListFields.query(ListOptions{OrderBy: orderBy}, testDefaultOrder, 1); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("order_by %q: err = %v, want ErrInvalidArgument", orderBy, err)
+		}
+	}
+}
+
+func TestListFields_AfterKey(t *testing.T) {
+	opts := ListOptions{
+		Filter:  `vehicle_cd = "123"`,
+		OrderBy: "start_datetime",
+		Page:    pagination.Page{After: pagination.Key{"false", "2026-01-01", "2026-01-02", "uuid-1"}},
+	}
+	q, err := testListFields.query(opts, testDefaultOrder, 3)
+	if err != nil {
+		t.Fatalf("query: %v", err)
+	}
+
+	if !strings.HasPrefix(q.where, `("VehicleCd" = $3::text) AND ((("StartDatetime" IS NULL) > $4::boolean) OR `) {
+		t.Errorf("where = %s", q.where)
+	}
+	if want := []any{"123", "false", "2026-01-01", "2026-01-02", "uuid-1"}; !reflect.DeepEqual(q.args, want) {
+		t.Errorf("args = %v, want %v", q.args, want)
+	}
+	if want := `ARRAY[(("StartDatetime" IS NULL))::text, (COALESCE("StartDatetime", ''))::text, ("Created")::text, (uuid)::text]`; q.key() != want {
+		t.Errorf("key = %s, want %s", q.key(), want)
+	}
+
+	// a token issued for another order_by has a key of another length
+	opts.OrderBy = ""
+	if _, err := testListFields.query(opts, testDefaultOrder, 3); !errors.Is(err, pagination.ErrInvalidToken) {
+		t.Errorf("err = %v, want ErrInvalidToken", err)
+	}
+}
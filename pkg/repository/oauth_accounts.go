@@ -6,28 +6,33 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
 )
 
 var (
-	ErrOAuthAccountNotFound = errors.New("oauth account not found")
+	ErrOAuthAccountNotFound = notFound("oauth account not found")
+	// ErrLastOAuthAccount is returned when deleting the only sign-in method of a user
+	ErrLastOAuthAccount = errors.New("cannot remove the last oauth account of a user")
 )
 
 // OAuthAccount represents the database model
 type OAuthAccount struct {
-	ID             string
-	AppUserID      string
-	Provider       string // 'google', 'line'
-	ProviderUserID string
-	Email          *string
-	AccessToken    *string
-	RefreshToken   *string
-	TokenExpiresAt *time.Time
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID             string     `db:"id,pk"`
+	AppUserID      string     `db:"app_user_id"`
+	Provider       string     `db:"provider"` // 'google', 'line'
+	ProviderUserID string     `db:"provider_user_id"`
+	Email          *string    `db:"email"`
+	AccessToken    *string    `db:"access_token"`
+	RefreshToken   *string    `db:"refresh_token"`
+	TokenExpiresAt *time.Time `db:"token_expires_at"`
+	CreatedAt      time.Time  `db:"created_at,readonly"`
+	UpdatedAt      time.Time  `db:"updated_at,modified"`
 }
 
+var oauthAccountTable = NewTable[OAuthAccount]("oauth_accounts", ErrOAuthAccountNotFound)
+
 // OAuthAccountRepository handles database operations for oauth_accounts
 type OAuthAccountRepository struct {
 	db DB
@@ -45,131 +50,81 @@ func NewOAuthAccountRepositoryWithDB(db DB) *OAuthAccountRepository {
 
 // Create inserts a new oauth account
 func (r *OAuthAccountRepository) Create(ctx context.Context, appUserID, provider, providerUserID string, email, accessToken, refreshToken *string, tokenExpiresAt *time.Time) (*OAuthAccount, error) {
-	id := uuid.New().String()
+	ctx = db.WithQueryLabel(ctx, "OAuthAccountRepository.Create")
 	now := time.Now()
-
-	query := `
-		INSERT INTO oauth_accounts (id, app_user_id, provider, provider_user_id, email, access_token, refresh_token, token_expires_at, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-		RETURNING id, app_user_id, provider, provider_user_id, email, access_token, refresh_token, token_expires_at, created_at, updated_at
-	`
-
-	var account OAuthAccount
-	err := r.db.QueryRow(ctx, query, id, appUserID, provider, providerUserID, email, accessToken, refreshToken, tokenExpiresAt, now, now).Scan(
-		&account.ID, &account.AppUserID, &account.Provider, &account.ProviderUserID, &account.Email, &account.AccessToken, &account.RefreshToken, &account.TokenExpiresAt, &account.CreatedAt, &account.UpdatedAt,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &account, nil
+	return oauthAccountTable.insert(ctx, r.db, &OAuthAccount{
+		ID:             uuid.New().String(),
+		AppUserID:      appUserID,
+		Provider:       provider,
+		ProviderUserID: providerUserID,
+		Email:          email,
+		AccessToken:    accessToken,
+		RefreshToken:   refreshToken,
+		TokenExpiresAt: tokenExpiresAt,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
 }
 
 // GetByID retrieves an oauth account by ID
 func (r *OAuthAccountRepository) GetByID(ctx context.Context, id string) (*OAuthAccount, error) {
-	query := `
-		SELECT id, app_user_id, provider, provider_user_id, email, access_token, refresh_token, token_expires_at, created_at, updated_at
-		FROM oauth_accounts
-		WHERE id = $1
-	`
-
-	var account OAuthAccount
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&account.ID, &account.AppUserID, &account.Provider, &account.ProviderUserID, &account.Email, &account.AccessToken, &account.RefreshToken, &account.TokenExpiresAt, &account.CreatedAt, &account.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrOAuthAccountNotFound
-		}
-		return nil, err
-	}
-
-	return &account, nil
+	ctx = db.WithQueryLabel(ctx, "OAuthAccountRepository.GetByID")
+	return oauthAccountTable.get(ctx, r.db, id)
 }
 
 // GetByProviderAndProviderUserID retrieves an oauth account by provider and provider_user_id
 func (r *OAuthAccountRepository) GetByProviderAndProviderUserID(ctx context.Context, provider, providerUserID string) (*OAuthAccount, error) {
-	query := `
-		SELECT id, app_user_id, provider, provider_user_id, email, access_token, refresh_token, token_expires_at, created_at, updated_at
-		FROM oauth_accounts
-		WHERE provider = $1 AND provider_user_id = $2
-	`
-
-	var account OAuthAccount
-	err := r.db.QueryRow(ctx, query, provider, providerUserID).Scan(
-		&account.ID, &account.AppUserID, &account.Provider, &account.ProviderUserID, &account.Email, &account.AccessToken, &account.RefreshToken, &account.TokenExpiresAt, &account.CreatedAt, &account.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrOAuthAccountNotFound
-		}
-		return nil, err
-	}
-
-	return &account, nil
+	ctx = db.WithQueryLabel(ctx, "OAuthAccountRepository.GetByProviderAndProviderUserID")
+	return oauthAccountTable.find(ctx, r.db, "provider = $1 AND provider_user_id = $2", provider, providerUserID)
 }
 
 // ListByAppUserID retrieves all oauth accounts for an app user
 func (r *OAuthAccountRepository) ListByAppUserID(ctx context.Context, appUserID string) ([]*OAuthAccount, error) {
-	query := `
-		SELECT id, app_user_id, provider, provider_user_id, email, access_token, refresh_token, token_expires_at, created_at, updated_at
-		FROM oauth_accounts
-		WHERE app_user_id = $1
-		ORDER BY created_at DESC
-	`
-
-	rows, err := r.db.Query(ctx, query, appUserID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var accounts []*OAuthAccount
-	for rows.Next() {
-		var account OAuthAccount
-		err := rows.Scan(&account.ID, &account.AppUserID, &account.Provider, &account.ProviderUserID, &account.Email, &account.AccessToken, &account.RefreshToken, &account.TokenExpiresAt, &account.CreatedAt, &account.UpdatedAt)
-		if err != nil {
-			return nil, err
-		}
-		accounts = append(accounts, &account)
-	}
-
-	return accounts, rows.Err()
+	ctx = db.WithQueryLabel(ctx, "OAuthAccountRepository.ListByAppUserID")
+	return oauthAccountTable.findAll(ctx, r.db, "app_user_id = $1", "created_at DESC", appUserID)
 }
 
 // UpdateTokens updates the access and refresh tokens
 func (r *OAuthAccountRepository) UpdateTokens(ctx context.Context, id string, accessToken, refreshToken *string, tokenExpiresAt *time.Time) (*OAuthAccount, error) {
-	query := `
-		UPDATE oauth_accounts
-		SET access_token = $2, refresh_token = $3, token_expires_at = $4, updated_at = $5
-		WHERE id = $1
-		RETURNING id, app_user_id, provider, provider_user_id, email, access_token, refresh_token, token_expires_at, created_at, updated_at
-	`
-
-	var account OAuthAccount
-	err := r.db.QueryRow(ctx, query, id, accessToken, refreshToken, tokenExpiresAt, time.Now()).Scan(
-		&account.ID, &account.AppUserID, &account.Provider, &account.ProviderUserID, &account.Email, &account.AccessToken, &account.RefreshToken, &account.TokenExpiresAt, &account.CreatedAt, &account.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrOAuthAccountNotFound
-		}
-		return nil, err
-	}
-
-	return &account, nil
+	ctx = db.WithQueryLabel(ctx, "OAuthAccountRepository.UpdateTokens")
+	account := &OAuthAccount{ID: id, AccessToken: accessToken, RefreshToken: refreshToken, TokenExpiresAt: tokenExpiresAt, UpdatedAt: time.Now()}
+	return oauthAccountTable.update(ctx, r.db, account, []string{"access_token", "refresh_token", "token_expires_at"}, "")
 }
 
 // Delete removes an oauth account
 func (r *OAuthAccountRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM oauth_accounts WHERE id = $1`
+	ctx = db.WithQueryLabel(ctx, "OAuthAccountRepository.Delete")
+	return oauthAccountTable.delete(ctx, r.db, "", id)
+}
 
-	result, err := r.db.Exec(ctx, query, id)
+// DeleteByProviderUnlessLast removes a user's account for a provider unless it is the
+// user's only account. The user's accounts are locked first so two concurrent unlinks
+// cannot both succeed and leave the user without a way to sign in.
+func (r *OAuthAccountRepository) DeleteByProviderUnlessLast(ctx context.Context, appUserID, provider string) error {
+	ctx = db.WithQueryLabel(ctx, "OAuthAccountRepository.DeleteByProviderUnlessLast")
+	query := `
+		WITH locked AS (
+			SELECT id FROM oauth_accounts WHERE app_user_id = $1 FOR UPDATE
+		)
+		DELETE FROM oauth_accounts
+		WHERE app_user_id = $1 AND provider = $2 AND (SELECT count(*) FROM locked) > 1
+	`
+
+	result, err := r.db.Exec(ctx, query, appUserID, provider)
 	if err != nil {
 		return err
 	}
 
 	if result.RowsAffected() == 0 {
+		accounts, err := r.ListByAppUserID(ctx, appUserID)
+		if err != nil {
+			return err
+		}
+		for _, account := range accounts {
+			if account.Provider == provider {
+				return ErrLastOAuthAccount
+			}
+		}
 		return ErrOAuthAccountNotFound
 	}
 
@@ -178,6 +133,7 @@ func (r *OAuthAccountRepository) Delete(ctx context.Context, id string) error {
 
 // DeleteByAppUserID removes all oauth accounts for an app user
 func (r *OAuthAccountRepository) DeleteByAppUserID(ctx context.Context, appUserID string) error {
+	ctx = db.WithQueryLabel(ctx, "OAuthAccountRepository.DeleteByAppUserID")
 	query := `DELETE FROM oauth_accounts WHERE app_user_id = $1`
 
 	_, err := r.db.Exec(ctx, query, appUserID)
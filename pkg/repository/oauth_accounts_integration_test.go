@@ -0,0 +1,61 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestIntegration_OAuthAccounts_DeleteByProviderUnlessLast(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+
+	repo := NewOAuthAccountRepository(pool)
+	userRepo := NewAppUserRepository(pool)
+	ctx := context.Background()
+
+	// Setup: Create test user with a Google and a LINE identity
+	uniqueEmail := fmt.Sprintf("test-oauth-user-%d@example.com", time.Now().UnixNano())
+	testUser, err := userRepo.Create(ctx, &uniqueEmail, "Test OAuth User", nil, false)
+	if err != nil {
+		t.Fatalf("Setup: failed to create test user: %v", err)
+	}
+	defer userRepo.Delete(ctx, testUser.ID, "")
+	defer pool.Exec(ctx, "DELETE FROM oauth_accounts WHERE app_user_id = $1", testUser.ID)
+
+	for _, provider := range []string{"google", "line"} {
+		if _, err := repo.Create(ctx, testUser.ID, provider, "test-"+uuid.New().String(), &uniqueEmail, nil, nil, nil); err != nil {
+			t.Fatalf("Setup: failed to create %s account: %v", provider, err)
+		}
+	}
+
+	// 1. Unlink one of two identities
+	if err := repo.DeleteByProviderUnlessLast(ctx, testUser.ID, "google"); err != nil {
+		t.Fatalf("DeleteByProviderUnlessLast(google) failed: %v", err)
+	}
+	fmt.Println("✓ DeleteByProviderUnlessLast: removed google")
+
+	// 2. Unlinking a provider that is not linked
+	if err := repo.DeleteByProviderUnlessLast(ctx, testUser.ID, "google"); !errors.Is(err, ErrOAuthAccountNotFound) {
+		t.Errorf("DeleteByProviderUnlessLast(google) again: err = %v, want ErrOAuthAccountNotFound", err)
+	}
+
+	// 3. The last identity stays
+	if err := repo.DeleteByProviderUnlessLast(ctx, testUser.ID, "line"); !errors.Is(err, ErrLastOAuthAccount) {
+		t.Errorf("DeleteByProviderUnlessLast(line): err = %v, want ErrLastOAuthAccount", err)
+	}
+	accounts, err := repo.ListByAppUserID(ctx, testUser.ID)
+	if err != nil {
+		t.Fatalf("ListByAppUserID failed: %v", err)
+	}
+	if len(accounts) != 1 || accounts[0].Provider != "line" {
+		t.Errorf("remaining accounts = %+v, want only line", accounts)
+	}
+	fmt.Println("✓ DeleteByProviderUnlessLast: kept the last identity")
+}
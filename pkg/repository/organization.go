@@ -6,26 +6,29 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrNotFound = errors.New("organization not found")
+	ErrOrganizationNotFound = notFound("organization not found")
 )
 
 // Organization represents the database model
 type Organization struct {
-	ID        string
-	Name      string
-	Slug      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time
+	ID        string     `db:"id,pk"`
+	Name      string     `db:"name"`
+	Slug      string     `db:"slug"`
+	CreatedAt time.Time  `db:"created_at,readonly"`
+	UpdatedAt time.Time  `db:"updated_at,modified"`
+	DeletedAt *time.Time `db:"deleted_at,deleted,readonly"`
+	Etag      string     `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var organizationTable = NewTable[Organization]("organizations", ErrOrganizationNotFound)
+
 // OrganizationRepository handles database operations for organizations
 type OrganizationRepository struct {
 	db      DB
@@ -48,126 +51,45 @@ func NewOrganizationRepositoryWithDB(d DB) *OrganizationRepository {
 
 // Create inserts a new organization with auto-generated UUID slug
 func (r *OrganizationRepository) Create(ctx context.Context, name string) (*Organization, error) {
-	id := uuid.New().String()
-	slug := uuid.New().String() // Auto-generate slug as UUID
+	ctx = db.WithQueryLabel(ctx, "OrganizationRepository.Create")
 	now := time.Now()
-
-	query := `
-		INSERT INTO organizations (id, name, slug, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, name, slug, created_at, updated_at, deleted_at
-	`
-
-	var org Organization
-	err := r.db.QueryRow(ctx, query, id, name, slug, now, now).Scan(
-		&org.ID, &org.Name, &org.Slug, &org.CreatedAt, &org.UpdatedAt, &org.DeletedAt,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &org, nil
+	return organizationTable.insert(ctx, r.db, &Organization{
+		ID:        uuid.New().String(),
+		Name:      name,
+		Slug:      uuid.New().String(), // Auto-generate slug as UUID
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
 }
 
 // GetByID retrieves an organization by ID
 func (r *OrganizationRepository) GetByID(ctx context.Context, id string) (*Organization, error) {
-	query := `
-		SELECT id, name, slug, created_at, updated_at, deleted_at
-		FROM organizations
-		WHERE id = $1 AND deleted_at IS NULL
-	`
-
-	var org Organization
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&org.ID, &org.Name, &org.Slug, &org.CreatedAt, &org.UpdatedAt, &org.DeletedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrNotFound
-		}
-		return nil, err
-	}
-
-	return &org, nil
+	ctx = db.WithQueryLabel(ctx, "OrganizationRepository.GetByID")
+	return organizationTable.get(ctx, r.db, id)
 }
 
-// Update modifies an existing organization
-func (r *OrganizationRepository) Update(ctx context.Context, id, name, slug string) (*Organization, error) {
-	query := `
-		UPDATE organizations
-		SET name = $2, slug = $3, updated_at = $4
-		WHERE id = $1 AND deleted_at IS NULL
-		RETURNING id, name, slug, created_at, updated_at, deleted_at
-	`
-
-	var org Organization
-	err := r.db.QueryRow(ctx, query, id, name, slug, time.Now()).Scan(
-		&org.ID, &org.Name, &org.Slug, &org.CreatedAt, &org.UpdatedAt, &org.DeletedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrNotFound
-		}
-		return nil, err
-	}
-
-	return &org, nil
+// Update modifies an existing organization. A non-empty etag must be the current one.
+func (r *OrganizationRepository) Update(ctx context.Context, id, name, slug, etag string) (*Organization, error) {
+	ctx = db.WithQueryLabel(ctx, "OrganizationRepository.Update")
+	return organizationTable.update(ctx, r.db, &Organization{ID: id, Name: name, Slug: slug, UpdatedAt: time.Now()}, nil, etag)
 }
 
-// Delete soft-deletes an organization
-func (r *OrganizationRepository) Delete(ctx context.Context, id string) error {
-	query := `
-		UPDATE organizations
-		SET deleted_at = $2, updated_at = $2
-		WHERE id = $1 AND deleted_at IS NULL
-	`
-
-	result, err := r.db.Exec(ctx, query, id, time.Now())
-	if err != nil {
-		return err
-	}
-
-	if result.RowsAffected() == 0 {
-		return ErrNotFound
-	}
+// Delete soft-deletes an organization. A non-empty etag must be the current one.
+func (r *OrganizationRepository) Delete(ctx context.Context, id, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "OrganizationRepository.Delete")
+	return organizationTable.softDelete(ctx, r.db, time.Now(), etag, id)
+}
 
-	return nil
+// organizationOrder lists organizations newest first
+var organizationOrder = pagination.Order{
+	pagination.Desc("created_at", "timestamptz"),
+	pagination.Desc("id", "uuid"),
 }
 
 // List retrieves organizations with pagination
-func (r *OrganizationRepository) List(ctx context.Context, limit int, offset int) ([]*Organization, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT id, name, slug, created_at, updated_at, deleted_at
-		FROM organizations
-		WHERE deleted_at IS NULL
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var orgs []*Organization
-	for rows.Next() {
-		var org Organization
-		err := rows.Scan(&org.ID, &org.Name, &org.Slug, &org.CreatedAt, &org.UpdatedAt, &org.DeletedAt)
-		if err != nil {
-			return nil, err
-		}
-		orgs = append(orgs, &org)
-	}
-
-	return orgs, rows.Err()
+func (r *OrganizationRepository) List(ctx context.Context, page pagination.Page) ([]*Organization, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "OrganizationRepository.List")
+	return organizationTable.list(ctx, r.db, ListOptions{Page: page}, nil, organizationOrder, "")
 }
 
 // CreateWithOwnerResult contains both the organization and user_organization created
@@ -180,6 +102,7 @@ type CreateWithOwnerResult struct {
 // The user is assigned the "owner" role and this organization is set as their default.
 // slug is auto-generated as UUID to avoid duplicate key errors.
 func (r *OrganizationRepository) CreateWithOwner(ctx context.Context, name, userID string) (*CreateWithOwnerResult, error) {
+	ctx = db.WithQueryLabel(ctx, "OrganizationRepository.CreateWithOwner")
 	if r.rlsPool == nil {
 		return nil, errors.New("transaction support requires RLSPool")
 	}
@@ -191,34 +114,29 @@ func (r *OrganizationRepository) CreateWithOwner(ctx context.Context, name, user
 	defer tx.Rollback(ctx)
 
 	now := time.Now()
-	orgID := uuid.New().String()
-	slug := uuid.New().String() // Auto-generate slug as UUID
-	userOrgID := uuid.New().String()
 
 	// Create organization
-	orgQuery := `
-		INSERT INTO organizations (id, name, slug, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, name, slug, created_at, updated_at, deleted_at
-	`
-	var org Organization
-	err = tx.QueryRow(ctx, orgQuery, orgID, name, slug, now, now).Scan(
-		&org.ID, &org.Name, &org.Slug, &org.CreatedAt, &org.UpdatedAt, &org.DeletedAt,
-	)
+	org, err := organizationTable.insert(ctx, tx, &Organization{
+		ID:        uuid.New().String(),
+		Name:      name,
+		Slug:      uuid.New().String(), // Auto-generate slug as UUID
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	// Create user_organization link with owner role
-	userOrgQuery := `
-		INSERT INTO user_organizations (id, user_id, organization_id, role, is_default, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, user_id, organization_id, role, is_default, created_at, updated_at
-	`
-	var userOrg UserOrganization
-	err = tx.QueryRow(ctx, userOrgQuery, userOrgID, userID, orgID, "owner", true, now, now).Scan(
-		&userOrg.ID, &userOrg.UserID, &userOrg.OrganizationID, &userOrg.Role, &userOrg.IsDefault, &userOrg.CreatedAt, &userOrg.UpdatedAt,
-	)
+	userOrg, err := userOrganizationTable.insert(ctx, tx, &UserOrganization{
+		ID:             uuid.New().String(),
+		UserID:         userID,
+		OrganizationID: org.ID,
+		Role:           "owner",
+		IsDefault:      true,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -228,7 +146,7 @@ func (r *OrganizationRepository) CreateWithOwner(ctx context.Context, name, user
 	}
 
 	return &CreateWithOwnerResult{
-		Organization:     &org,
-		UserOrganization: &userOrg,
+		Organization:     org,
+		UserOrganization: userOrg,
 	}, nil
 }
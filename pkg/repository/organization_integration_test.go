@@ -4,10 +4,13 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func setupTestDB(t *testing.T) *pgxpool.Pool {
@@ -55,19 +58,31 @@ func TestIntegration_CRUD(t *testing.T) {
 
 		// 3. Update
 		t.Run("Update", func(t *testing.T) {
-			updated, err := repo.Update(ctx, org.ID, "Updated Organization", org.Slug)
+			updated, err := repo.Update(ctx, org.ID, "Updated Organization", org.Slug, org.Etag)
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
 			if updated.Name != "Updated Organization" {
 				t.Errorf("Update: Name = %s, want Updated Organization", updated.Name)
 			}
+			if updated.Etag == org.Etag {
+				t.Errorf("Update: Etag = %s, want a new one", updated.Etag)
+			}
 			fmt.Printf("✓ Update: ID=%s, Name=%s, Slug=%s\n", updated.ID, updated.Name, updated.Slug)
+
+			// org.Etag is now stale
+			if _, err := repo.Update(ctx, org.ID, "Lost Update", org.Slug, org.Etag); !errors.Is(err, ErrEtagMismatch) {
+				t.Errorf("Update with stale etag: err = %v, want ErrEtagMismatch", err)
+			}
+			if err := repo.Delete(ctx, org.ID, org.Etag); !errors.Is(err, ErrEtagMismatch) {
+				t.Errorf("Delete with stale etag: err = %v, want ErrEtagMismatch", err)
+			}
+			fmt.Printf("✓ Update: stale etag rejected\n")
 		})
 
 		// 4. List
 		t.Run("List", func(t *testing.T) {
-			orgs, err := repo.List(ctx, 10, 0)
+			orgs, _, err := repo.List(ctx, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("List failed: %v", err)
 			}
@@ -79,15 +94,15 @@ func TestIntegration_CRUD(t *testing.T) {
 
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, org.ID)
+			err := repo.Delete(ctx, org.ID, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
 
 			// Verify soft delete
 			_, err = repo.GetByID(ctx, org.ID)
-			if err != ErrNotFound {
-				t.Errorf("Delete: GetByID should return ErrNotFound, got %v", err)
+			if err != ErrOrganizationNotFound {
+				t.Errorf("Delete: GetByID should return ErrOrganizationNotFound, got %v", err)
 			}
 			fmt.Printf("✓ Delete: organization soft deleted\n")
 		})
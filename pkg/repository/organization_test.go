@@ -3,11 +3,15 @@ package repository
 import (
 	"context"
 	"errors"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 // MockRow implements pgx.Row for testing
@@ -21,6 +25,7 @@ func (r *MockRow) Scan(dest ...any) error {
 
 // MockRows implements pgx.Rows for testing
 type MockRows struct {
+	columns []string
 	data    [][]any
 	current int
 	closed  bool
@@ -31,7 +36,11 @@ func (r *MockRows) Close()                        { r.closed = true }
 func (r *MockRows) Err() error                    { return r.err }
 func (r *MockRows) CommandTag() pgconn.CommandTag { return pgconn.CommandTag{} }
 func (r *MockRows) FieldDescriptions() []pgconn.FieldDescription {
-	return nil
+	fields := make([]pgconn.FieldDescription, len(r.columns))
+	for i, name := range r.columns {
+		fields[i] = pgconn.FieldDescription{Name: name}
+	}
+	return fields
 }
 func (r *MockRows) Next() bool {
 	if r.current >= len(r.data) {
@@ -58,6 +67,8 @@ func (r *MockRows) Scan(dest ...any) error {
 				t := row[i].(time.Time)
 				*v = &t
 			}
+		case *[]string:
+			*v = row[i].([]string)
 		}
 	}
 	return nil
@@ -66,6 +77,14 @@ func (r *MockRows) Values() ([]any, error) { return nil, nil }
 func (r *MockRows) RawValues() [][]byte    { return nil }
 func (r *MockRows) Conn() *pgx.Conn        { return nil }
 
+// organizationColumns are the columns organization statements return
+var organizationColumns = []string{"id", "name", "slug", "created_at", "updated_at", "deleted_at", "etag"}
+
+// organizationRows returns the organizations data as rows of organizationColumns
+func organizationRows(data ...[]any) *MockRows {
+	return &MockRows{columns: organizationColumns, data: data}
+}
+
 // MockDB implements DB interface for testing
 type MockDB struct {
 	queryRowFunc func(ctx context.Context, sql string, args ...any) pgx.Row
@@ -89,44 +108,34 @@ func TestCreate(t *testing.T) {
 	now := time.Now()
 
 	tests := []struct {
-		name    string
-		input   string // name only, slug is auto-generated
-		mockRow *MockRow
-		wantErr bool
+		name     string
+		input    string // name only, slug is auto-generated
+		mockRows *MockRows
+		queryErr error
+		wantErr  bool
 	}{
 		{
-			name:  "success",
-			input: "Test Org",
-			mockRow: &MockRow{
-				scanFunc: func(dest ...any) error {
-					*dest[0].(*string) = "uuid-123"
-					*dest[1].(*string) = "Test Org"
-					*dest[2].(*string) = "auto-generated-slug"
-					*dest[3].(*time.Time) = now
-					*dest[4].(*time.Time) = now
-					*dest[5].(**time.Time) = nil
-					return nil
-				},
-			},
-			wantErr: false,
+			name:     "success",
+			input:    "Test Org",
+			mockRows: organizationRows([]any{"uuid-123", "Test Org", "auto-generated-slug", now, now, nil, "100"}),
+			wantErr:  false,
 		},
 		{
-			name:  "db error",
-			input: "Test",
-			mockRow: &MockRow{
-				scanFunc: func(dest ...any) error {
-					return errors.New("db error")
-				},
-			},
-			wantErr: true,
+			name:     "db error",
+			input:    "Test",
+			queryErr: errors.New("db error"),
+			wantErr:  true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockDB := &MockDB{
-				queryRowFunc: func(ctx context.Context, sql string, args ...any) pgx.Row {
-					return tt.mockRow
+				queryFunc: func(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+					if tt.queryErr != nil {
+						return nil, tt.queryErr
+					}
+					return tt.mockRows, nil
 				},
 			}
 
@@ -153,44 +162,31 @@ func TestGetByID(t *testing.T) {
 	now := time.Now()
 
 	tests := []struct {
-		name    string
-		id      string
-		mockRow *MockRow
-		wantErr error
+		name     string
+		id       string
+		mockRows *MockRows
+		exists   bool // whether the row is there when the update matches nothing
+		wantErr  error
 	}{
 		{
-			name: "found",
-			id:   "uuid-123",
-			mockRow: &MockRow{
-				scanFunc: func(dest ...any) error {
-					*dest[0].(*string) = "uuid-123"
-					*dest[1].(*string) = "Test Org"
-					*dest[2].(*string) = "test-org"
-					*dest[3].(*time.Time) = now
-					*dest[4].(*time.Time) = now
-					*dest[5].(**time.Time) = nil
-					return nil
-				},
-			},
-			wantErr: nil,
+			name:     "found",
+			id:       "uuid-123",
+			mockRows: organizationRows([]any{"uuid-123", "Test Org", "test-org", now, now, nil, "100"}),
+			wantErr:  nil,
 		},
 		{
-			name: "not found",
-			id:   "nonexistent",
-			mockRow: &MockRow{
-				scanFunc: func(dest ...any) error {
-					return pgx.ErrNoRows
-				},
-			},
-			wantErr: ErrNotFound,
+			name:     "not found",
+			id:       "nonexistent",
+			mockRows: organizationRows(),
+			wantErr:  ErrOrganizationNotFound,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockDB := &MockDB{
-				queryRowFunc: func(ctx context.Context, sql string, args ...any) pgx.Row {
-					return tt.mockRow
+				queryFunc: func(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+					return tt.mockRows, nil
 				},
 			}
 
@@ -213,36 +209,36 @@ func TestUpdate(t *testing.T) {
 	now := time.Now()
 
 	tests := []struct {
-		name    string
-		input   struct{ id, name, slug string }
-		mockRow *MockRow
-		wantErr error
+		name     string
+		input    struct{ id, name, slug, etag string }
+		mockRows *MockRows
+		exists   bool // whether the row is there when the update matches nothing
+		wantErr  error
 	}{
 		{
-			name:  "success",
-			input: struct{ id, name, slug string }{"uuid-123", "Updated Org", "updated-org"},
-			mockRow: &MockRow{
-				scanFunc: func(dest ...any) error {
-					*dest[0].(*string) = "uuid-123"
-					*dest[1].(*string) = "Updated Org"
-					*dest[2].(*string) = "updated-org"
-					*dest[3].(*time.Time) = now
-					*dest[4].(*time.Time) = now
-					*dest[5].(**time.Time) = nil
-					return nil
-				},
-			},
-			wantErr: nil,
+			name:     "success",
+			input:    struct{ id, name, slug, etag string }{"uuid-123", "Updated Org", "updated-org", ""},
+			mockRows: organizationRows([]any{"uuid-123", "Updated Org", "updated-org", now, now, nil, "100"}),
+			wantErr:  nil,
 		},
 		{
-			name:  "not found",
-			input: struct{ id, name, slug string }{"nonexistent", "Name", "slug"},
-			mockRow: &MockRow{
-				scanFunc: func(dest ...any) error {
-					return pgx.ErrNoRows
-				},
-			},
-			wantErr: ErrNotFound,
+			name:     "not found",
+			input:    struct{ id, name, slug, etag string }{"nonexistent", "Name", "slug", ""},
+			mockRows: organizationRows(),
+			wantErr:  ErrOrganizationNotFound,
+		},
+		{
+			name:     "stale etag",
+			input:    struct{ id, name, slug, etag string }{"uuid-123", "Name", "slug", "100"},
+			mockRows: organizationRows(),
+			exists:   true,
+			wantErr:  ErrEtagMismatch,
+		},
+		{
+			name:     "not found with etag",
+			input:    struct{ id, name, slug, etag string }{"nonexistent", "Name", "slug", "100"},
+			mockRows: organizationRows(),
+			wantErr:  ErrOrganizationNotFound,
 		},
 	}
 
@@ -250,12 +246,19 @@ func TestUpdate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockDB := &MockDB{
 				queryRowFunc: func(ctx context.Context, sql string, args ...any) pgx.Row {
-					return tt.mockRow
+					// only the EXISTS check of a write that matched no row
+					return &MockRow{scanFunc: func(dest ...any) error {
+						*dest[0].(*bool) = tt.exists
+						return nil
+					}}
+				},
+				queryFunc: func(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+					return tt.mockRows, nil
 				},
 			}
 
 			repo := NewOrganizationRepositoryWithDB(mockDB)
-			org, err := repo.Update(context.Background(), tt.input.id, tt.input.name, tt.input.slug)
+			org, err := repo.Update(context.Background(), tt.input.id, tt.input.name, tt.input.slug, tt.input.etag)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
@@ -271,32 +274,32 @@ func TestUpdate(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	tests := []struct {
-		name        string
-		id          string
+		name         string
+		id           string
 		rowsAffected int64
-		execErr     error
-		wantErr     error
+		execErr      error
+		wantErr      error
 	}{
 		{
-			name:        "success",
-			id:          "uuid-123",
+			name:         "success",
+			id:           "uuid-123",
 			rowsAffected: 1,
-			execErr:     nil,
-			wantErr:     nil,
+			execErr:      nil,
+			wantErr:      nil,
 		},
 		{
-			name:        "not found",
-			id:          "nonexistent",
+			name:         "not found",
+			id:           "nonexistent",
 			rowsAffected: 0,
-			execErr:     nil,
-			wantErr:     ErrNotFound,
+			execErr:      nil,
+			wantErr:      ErrOrganizationNotFound,
 		},
 		{
-			name:        "db error",
-			id:          "uuid-123",
+			name:         "db error",
+			id:           "uuid-123",
 			rowsAffected: 0,
-			execErr:     errors.New("db error"),
-			wantErr:     errors.New("db error"),
+			execErr:      errors.New("db error"),
+			wantErr:      errors.New("db error"),
 		},
 	}
 
@@ -312,7 +315,7 @@ func TestDelete(t *testing.T) {
 			}
 
 			repo := NewOrganizationRepositoryWithDB(mockDB)
-			err := repo.Delete(context.Background(), tt.id)
+			err := repo.Delete(context.Background(), tt.id, "")
 
 			if tt.wantErr == nil && err != nil {
 				t.Errorf("Delete() unexpected error = %v", err)
@@ -329,29 +332,42 @@ func TestList(t *testing.T) {
 
 	tests := []struct {
 		name     string
-		limit    int
-		offset   int
+		size     int
 		mockRows *MockRows
 		wantLen  int
+		wantNext pagination.Key
 		wantErr  bool
 	}{
 		{
-			name:   "success with results",
-			limit:  10,
-			offset: 0,
+			name: "success with results",
+			size: 10,
 			mockRows: &MockRows{
+				columns: append(organizationColumns, "key"),
 				data: [][]any{
-					{"uuid-1", "Org 1", "org-1", now, now, nil},
-					{"uuid-2", "Org 2", "org-2", now, now, nil},
+					{"uuid-1", "Org 1", "org-1", now, now, nil, "100", []string{"2024-01-02 03:04:05.123456+00", "uuid-1"}},
+					{"uuid-2", "Org 2", "org-2", now, now, nil, "100", []string{"2024-01-01 03:04:05+00", "uuid-2"}},
 				},
 			},
 			wantLen: 2,
 			wantErr: false,
 		},
 		{
-			name:   "empty results",
-			limit:  10,
-			offset: 0,
+			name: "more rows than the page",
+			size: 1,
+			mockRows: &MockRows{
+				columns: append(organizationColumns, "key"),
+				data: [][]any{
+					{"uuid-1", "Org 1", "org-1", now, now, nil, "100", []string{"2024-01-02 03:04:05.123456+00", "uuid-1"}},
+					{"uuid-2", "Org 2", "org-2", now, now, nil, "100", []string{"2024-01-01 03:04:05+00", "uuid-2"}},
+				},
+			},
+			wantLen:  1,
+			wantNext: pagination.Key{"2024-01-02 03:04:05.123456+00", "uuid-1"},
+			wantErr:  false,
+		},
+		{
+			name: "empty results",
+			size: 10,
 			mockRows: &MockRows{
 				data: [][]any{},
 			},
@@ -359,9 +375,8 @@ func TestList(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name:   "limit capped at 100",
-			limit:  200,
-			offset: 0,
+			name: "limit capped at 100",
+			size: 200,
 			mockRows: &MockRows{
 				data: [][]any{},
 			},
@@ -379,7 +394,7 @@ func TestList(t *testing.T) {
 			}
 
 			repo := NewOrganizationRepositoryWithDB(mockDB)
-			orgs, err := repo.List(context.Background(), tt.limit, tt.offset)
+			orgs, next, err := repo.List(context.Background(), pagination.Page{Size: tt.size})
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("List() error = %v, wantErr %v", err, tt.wantErr)
@@ -389,6 +404,37 @@ func TestList(t *testing.T) {
 			if len(orgs) != tt.wantLen {
 				t.Errorf("List() returned %d orgs, want %d", len(orgs), tt.wantLen)
 			}
+			if !reflect.DeepEqual(next, tt.wantNext) {
+				t.Errorf("List() next = %v, want %v", next, tt.wantNext)
+			}
 		})
 	}
 }
+
+func TestList_AfterKey(t *testing.T) {
+	var gotSQL string
+	var gotArgs []any
+	mockDB := &MockDB{
+		queryFunc: func(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+			gotSQL, gotArgs = sql, args
+			return &MockRows{}, nil
+		},
+	}
+
+	repo := NewOrganizationRepositoryWithDB(mockDB)
+	after := pagination.Key{"2024-01-02T03:04:05.123456Z", "uuid-1"}
+	if _, _, err := repo.List(context.Background(), pagination.Page{Size: 5, After: after}); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	if !strings.Contains(gotSQL, "(created_at, id) < ($2::timestamptz, $3::uuid)") {
+		t.Errorf("List() query does not start after the key:\n%s", gotSQL)
+	}
+	if want := []any{6, "2024-01-02T03:04:05.123456Z", "uuid-1"}; !reflect.DeepEqual(gotArgs, want) {
+		t.Errorf("List() args = %v, want %v", gotArgs, want)
+	}
+
+	if _, _, err := repo.List(context.Background(), pagination.Page{After: pagination.Key{"only-one"}}); !errors.Is(err, pagination.ErrInvalidToken) {
+		t.Errorf("List() with a key of another order: error = %v, want ErrInvalidToken", err)
+	}
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+)
+
+var (
+	ErrRefreshTokenNotFound = notFound("refresh token not found")
+	// ErrRefreshTokenAlreadyRotated is returned when a token was already rotated or revoked
+	ErrRefreshTokenAlreadyRotated = errors.New("refresh token already rotated")
+)
+
+// RefreshToken represents the database model.
+// Each login starts a family; every rotation revokes the current token and
+// inserts its replacement into the same family.
+type RefreshToken struct {
+	ID         string     `db:"id,pk"`
+	UserID     string     `db:"user_id"`
+	FamilyID   string     `db:"family_id"`
+	TokenHash  string     `db:"token_hash"` // SHA-256 of the token's jti, never the token itself
+	UserAgent  *string    `db:"user_agent"` // nullable
+	IPAddress  *string    `db:"ip_address"` // nullable
+	IssuedAt   time.Time  `db:"issued_at"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+	ReplacedBy *string    `db:"replaced_by"` // ID of the token issued when this one was rotated
+	// OrganizationID is the organization selected in the session; carried over on rotation
+	OrganizationID *string `db:"organization_id"`
+}
+
+var refreshTokenTable = NewTable[RefreshToken]("refresh_tokens", ErrRefreshTokenNotFound)
+
+// RefreshTokenRepository handles database operations for refresh_tokens
+type RefreshTokenRepository struct {
+	db DB
+}
+
+// NewRefreshTokenRepository creates a new repository
+func NewRefreshTokenRepository(pool *pgxpool.Pool) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: pool}
+}
+
+// NewRefreshTokenRepositoryWithDB creates a repository with custom DB interface (for testing)
+func NewRefreshTokenRepositoryWithDB(db DB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+// Create inserts a new refresh token
+func (r *RefreshTokenRepository) Create(ctx context.Context, id, userID, familyID, tokenHash string, userAgent, ipAddress, organizationID *string, issuedAt, expiresAt time.Time) (*RefreshToken, error) {
+	ctx = db.WithQueryLabel(ctx, "RefreshTokenRepository.Create")
+	return refreshTokenTable.insert(ctx, r.db, &RefreshToken{
+		ID:             id,
+		UserID:         userID,
+		FamilyID:       familyID,
+		TokenHash:      tokenHash,
+		UserAgent:      userAgent,
+		IPAddress:      ipAddress,
+		IssuedAt:       issuedAt,
+		ExpiresAt:      expiresAt,
+		OrganizationID: organizationID,
+	})
+}
+
+// GetByTokenHash retrieves a refresh token by the hash of its jti
+func (r *RefreshTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*RefreshToken, error) {
+	ctx = db.WithQueryLabel(ctx, "RefreshTokenRepository.GetByTokenHash")
+	return refreshTokenTable.find(ctx, r.db, "token_hash = $1", tokenHash)
+}
+
+// MarkReplaced revokes a token as part of rotation and records its replacement.
+// Returns ErrRefreshTokenAlreadyRotated if the token was already revoked, which
+// means the same token was presented twice.
+func (r *RefreshTokenRepository) MarkReplaced(ctx context.Context, id, replacedBy string) error {
+	ctx = db.WithQueryLabel(ctx, "RefreshTokenRepository.MarkReplaced")
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = $3, replaced_by = $2
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query, id, replacedBy, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrRefreshTokenAlreadyRotated
+	}
+
+	return nil
+}
+
+// SetFamilyOrganization records the organization selected in a session on its active token,
+// so that rotation keeps the selection. The user ID guards against switching another user's session.
+func (r *RefreshTokenRepository) SetFamilyOrganization(ctx context.Context, familyID, userID, organizationID string) error {
+	ctx = db.WithQueryLabel(ctx, "RefreshTokenRepository.SetFamilyOrganization")
+	query := `
+		UPDATE refresh_tokens
+		SET organization_id = $3
+		WHERE family_id = $1 AND user_id = $2 AND revoked_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query, familyID, userID, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrRefreshTokenNotFound
+	}
+
+	return nil
+}
+
+// RevokeFamily revokes every active token in a family and returns the number revoked
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) (int64, error) {
+	ctx = db.WithQueryLabel(ctx, "RefreshTokenRepository.RevokeFamily")
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = $2
+		WHERE family_id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query, familyID, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// RevokeAllForUser revokes every active token of a user and returns the number revoked
+func (r *RefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID string) (int64, error) {
+	ctx = db.WithQueryLabel(ctx, "RefreshTokenRepository.RevokeAllForUser")
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = $2
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query, userID, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// ListActiveByUser retrieves the unrevoked, unexpired tokens of a user.
+// Rotation keeps exactly one active token per family, so each row is one session.
+func (r *RefreshTokenRepository) ListActiveByUser(ctx context.Context, userID string) ([]*RefreshToken, error) {
+	ctx = db.WithQueryLabel(ctx, "RefreshTokenRepository.ListActiveByUser")
+	return refreshTokenTable.findAll(ctx, r.db, "user_id = $1 AND revoked_at IS NULL AND expires_at > $2", "issued_at DESC", userID, time.Now())
+}
@@ -0,0 +1,114 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestIntegration_RefreshTokens_Rotation(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+
+	repo := NewRefreshTokenRepository(pool)
+	userRepo := NewAppUserRepository(pool)
+	ctx := context.Background()
+
+	// Setup: Create test user
+	uniqueEmail := fmt.Sprintf("test-rt-user-%d@example.com", time.Now().UnixNano())
+	testUser, err := userRepo.Create(ctx, &uniqueEmail, "Test RT User", nil, false)
+	if err != nil {
+		t.Fatalf("Setup: failed to create test user: %v", err)
+	}
+	defer userRepo.Delete(ctx, testUser.ID, "")
+	defer pool.Exec(ctx, "DELETE FROM refresh_tokens WHERE user_id = $1", testUser.ID)
+
+	familyID := uuid.New().String()
+	userAgent := "integration-test"
+	now := time.Now()
+
+	// 1. Create
+	first, err := repo.Create(ctx, uuid.New().String(), testUser.ID, familyID, "hash-"+uuid.New().String(), &userAgent, nil, nil, now, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if first.RevokedAt != nil {
+		t.Error("Create: RevokedAt should be nil")
+	}
+	fmt.Printf("✓ Create: ID=%s, FamilyID=%s\n", first.ID, first.FamilyID)
+
+	// 2. GetByTokenHash
+	fetched, err := repo.GetByTokenHash(ctx, first.TokenHash)
+	if err != nil {
+		t.Fatalf("GetByTokenHash failed: %v", err)
+	}
+	if fetched.ID != first.ID {
+		t.Errorf("GetByTokenHash: ID = %s, want %s", fetched.ID, first.ID)
+	}
+
+	// 3. Rotate
+	secondID := uuid.New().String()
+	if err := repo.MarkReplaced(ctx, first.ID, secondID); err != nil {
+		t.Fatalf("MarkReplaced failed: %v", err)
+	}
+	second, err := repo.Create(ctx, secondID, testUser.ID, familyID, "hash-"+uuid.New().String(), &userAgent, nil, nil, now, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Create (rotated) failed: %v", err)
+	}
+
+	rotated, err := repo.GetByTokenHash(ctx, first.TokenHash)
+	if err != nil {
+		t.Fatalf("GetByTokenHash (rotated) failed: %v", err)
+	}
+	if rotated.RevokedAt == nil || rotated.ReplacedBy == nil || *rotated.ReplacedBy != second.ID {
+		t.Errorf("MarkReplaced: RevokedAt = %v, ReplacedBy = %v, want revoked and replaced by %s", rotated.RevokedAt, rotated.ReplacedBy, second.ID)
+	}
+	fmt.Printf("✓ MarkReplaced: %s -> %s\n", first.ID, second.ID)
+
+	// 4. Rotating the same token twice is detected
+	if err := repo.MarkReplaced(ctx, first.ID, uuid.New().String()); !errors.Is(err, ErrRefreshTokenAlreadyRotated) {
+		t.Errorf("MarkReplaced twice: err = %v, want ErrRefreshTokenAlreadyRotated", err)
+	}
+
+	// 5. ListActiveByUser returns only the current token of the family
+	active, err := repo.ListActiveByUser(ctx, testUser.ID)
+	if err != nil {
+		t.Fatalf("ListActiveByUser failed: %v", err)
+	}
+	if len(active) != 1 || active[0].ID != second.ID {
+		t.Errorf("ListActiveByUser: got %d tokens, want only %s", len(active), second.ID)
+	}
+
+	// 6. RevokeFamily
+	count, err := repo.RevokeFamily(ctx, familyID)
+	if err != nil {
+		t.Fatalf("RevokeFamily failed: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("RevokeFamily: revoked %d, want 1", count)
+	}
+	fmt.Printf("✓ RevokeFamily: revoked=%d\n", count)
+
+	// 7. RevokeAllForUser after a new login
+	if _, err := repo.Create(ctx, uuid.New().String(), testUser.ID, uuid.New().String(), "hash-"+uuid.New().String(), nil, nil, nil, now, now.Add(time.Hour)); err != nil {
+		t.Fatalf("Create (new family) failed: %v", err)
+	}
+	count, err = repo.RevokeAllForUser(ctx, testUser.ID)
+	if err != nil {
+		t.Fatalf("RevokeAllForUser failed: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("RevokeAllForUser: revoked %d, want 1", count)
+	}
+
+	// 8. Unknown hash
+	if _, err := repo.GetByTokenHash(ctx, "does-not-exist"); !errors.Is(err, ErrRefreshTokenNotFound) {
+		t.Errorf("GetByTokenHash unknown: err = %v, want ErrRefreshTokenNotFound", err)
+	}
+}
@@ -0,0 +1,187 @@
+package repository
+
+// Repositories creates repositories that share one DB.
+// Bound to a db.Tx, every repository it returns takes part in the same transaction:
+//
+//	err := rlsPool.RunInTx(ctx, func(tx db.Tx) error {
+//		repos := repository.NewRepositories(tx)
+//		...
+//	})
+type Repositories struct {
+	db DB
+}
+
+// NewRepositories creates a repository factory bound to db
+func NewRepositories(db DB) *Repositories {
+	return &Repositories{db: db}
+}
+
+// DB returns the DB the repositories are bound to
+func (r *Repositories) DB() DB {
+	return r.db
+}
+
+// APIKey returns the APIKey repository bound to the factory's DB
+func (r *Repositories) APIKey() *APIKeyRepository {
+	return NewAPIKeyRepositoryWithDB(r.db)
+}
+
+// AppUser returns the AppUser repository bound to the factory's DB
+func (r *Repositories) AppUser() *AppUserRepository {
+	return NewAppUserRepositoryWithDB(r.db)
+}
+
+// AuditLog returns the AuditLog repository bound to the factory's DB
+func (r *Repositories) AuditLog() *AuditLogRepository {
+	return NewAuditLogRepositoryWithDB(r.db)
+}
+
+// CamFile returns the CamFile repository bound to the factory's DB
+func (r *Repositories) CamFile() *CamFileRepository {
+	return NewCamFileRepositoryWithDB(r.db)
+}
+
+// CamFileExe returns the CamFileExe repository bound to the factory's DB
+func (r *Repositories) CamFileExe() *CamFileExeRepository {
+	return NewCamFileExeRepositoryWithDB(r.db)
+}
+
+// CamFileExeStage returns the CamFileExeStage repository bound to the factory's DB
+func (r *Repositories) CamFileExeStage() *CamFileExeStageRepository {
+	return NewCamFileExeStageRepositoryWithDB(r.db)
+}
+
+// CarInsSheetIchibanCars returns the CarInsSheetIchibanCars repository bound to the factory's DB
+func (r *Repositories) CarInsSheetIchibanCars() *CarInsSheetIchibanCarsRepository {
+	return NewCarInsSheetIchibanCarsRepositoryWithDB(r.db)
+}
+
+// CarInsSheetIchibanCarsA returns the CarInsSheetIchibanCarsA repository bound to the factory's DB
+func (r *Repositories) CarInsSheetIchibanCarsA() *CarInsSheetIchibanCarsARepository {
+	return NewCarInsSheetIchibanCarsARepositoryWithDB(r.db)
+}
+
+// CarInspection returns the CarInspection repository bound to the factory's DB
+func (r *Repositories) CarInspection() *CarInspectionRepository {
+	return NewCarInspectionRepositoryWithDB(r.db)
+}
+
+// CarInspectionDeregistration returns the CarInspectionDeregistration repository bound to the factory's DB
+func (r *Repositories) CarInspectionDeregistration() *CarInspectionDeregistrationRepository {
+	return NewCarInspectionDeregistrationRepositoryWithDB(r.db)
+}
+
+// CarInspectionDeregistrationFiles returns the CarInspectionDeregistrationFiles repository bound to the factory's DB
+func (r *Repositories) CarInspectionDeregistrationFiles() *CarInspectionDeregistrationFilesRepository {
+	return NewCarInspectionDeregistrationFilesRepositoryWithDB(r.db)
+}
+
+// CarInspectionFiles returns the CarInspectionFiles repository bound to the factory's DB
+func (r *Repositories) CarInspectionFiles() *CarInspectionFilesRepository {
+	return NewCarInspectionFilesRepositoryWithDB(r.db)
+}
+
+// CarInspectionFilesA returns the CarInspectionFilesA repository bound to the factory's DB
+func (r *Repositories) CarInspectionFilesA() *CarInspectionFilesARepository {
+	return NewCarInspectionFilesARepositoryWithDB(r.db)
+}
+
+// CarInspectionFilesB returns the CarInspectionFilesB repository bound to the factory's DB
+func (r *Repositories) CarInspectionFilesB() *CarInspectionFilesBRepository {
+	return NewCarInspectionFilesBRepositoryWithDB(r.db)
+}
+
+// DtakoCarsIchibanCars returns the DtakoCarsIchibanCars repository bound to the factory's DB
+func (r *Repositories) DtakoCarsIchibanCars() *DtakoCarsIchibanCarsRepository {
+	return NewDtakoCarsIchibanCarsRepositoryWithDB(r.db)
+}
+
+// Dtakologs returns the Dtakologs repository bound to the factory's DB
+func (r *Repositories) Dtakologs() *DtakologsRepository {
+	return NewDtakologsRepositoryWithDB(r.db)
+}
+
+// ETCMeisai returns the ETCMeisai repository bound to the factory's DB
+func (r *Repositories) ETCMeisai() *ETCMeisaiRepository {
+	return NewETCMeisaiRepositoryWithDB(r.db)
+}
+
+// File returns the File repository bound to the factory's DB
+func (r *Repositories) File() *FileRepository {
+	return NewFileRepositoryWithDB(r.db)
+}
+
+// FlickrPhoto returns the FlickrPhoto repository bound to the factory's DB
+func (r *Repositories) FlickrPhoto() *FlickrPhotoRepository {
+	return NewFlickrPhotoRepositoryWithDB(r.db)
+}
+
+// IchibanCar returns the IchibanCar repository bound to the factory's DB
+func (r *Repositories) IchibanCar() *IchibanCarRepository {
+	return NewIchibanCarRepositoryWithDB(r.db)
+}
+
+// Invitation returns the Invitation repository bound to the factory's DB
+func (r *Repositories) Invitation() *InvitationRepository {
+	return NewInvitationRepositoryWithDB(r.db)
+}
+
+// Kudgcst returns the Kudgcst repository bound to the factory's DB
+func (r *Repositories) Kudgcst() *KudgcstRepository {
+	return NewKudgcstRepositoryWithDB(r.db)
+}
+
+// Kudgfry returns the Kudgfry repository bound to the factory's DB
+func (r *Repositories) Kudgfry() *KudgfryRepository {
+	return NewKudgfryRepositoryWithDB(r.db)
+}
+
+// Kudgful returns the Kudgful repository bound to the factory's DB
+func (r *Repositories) Kudgful() *KudgfulRepository {
+	return NewKudgfulRepositoryWithDB(r.db)
+}
+
+// Kudgivt returns the Kudgivt repository bound to the factory's DB
+func (r *Repositories) Kudgivt() *KudgivtRepository {
+	return NewKudgivtRepositoryWithDB(r.db)
+}
+
+// Kudgsir returns the Kudgsir repository bound to the factory's DB
+func (r *Repositories) Kudgsir() *KudgsirRepository {
+	return NewKudgsirRepositoryWithDB(r.db)
+}
+
+// Kudguri returns the Kudguri repository bound to the factory's DB
+func (r *Repositories) Kudguri() *KudguriRepository {
+	return NewKudguriRepositoryWithDB(r.db)
+}
+
+// OAuthAccount returns the OAuthAccount repository bound to the factory's DB
+func (r *Repositories) OAuthAccount() *OAuthAccountRepository {
+	return NewOAuthAccountRepositoryWithDB(r.db)
+}
+
+// Organization returns the Organization repository bound to the factory's DB
+func (r *Repositories) Organization() *OrganizationRepository {
+	return NewOrganizationRepositoryWithDB(r.db)
+}
+
+// RefreshToken returns the RefreshToken repository bound to the factory's DB
+func (r *Repositories) RefreshToken() *RefreshTokenRepository {
+	return NewRefreshTokenRepositoryWithDB(r.db)
+}
+
+// Uriage returns the Uriage repository bound to the factory's DB
+func (r *Repositories) Uriage() *UriageRepository {
+	return NewUriageRepositoryWithDB(r.db)
+}
+
+// UriageJisha returns the UriageJisha repository bound to the factory's DB
+func (r *Repositories) UriageJisha() *UriageJishaRepository {
+	return NewUriageJishaRepositoryWithDB(r.db)
+}
+
+// UserOrganization returns the UserOrganization repository bound to the factory's DB
+func (r *Repositories) UserOrganization() *UserOrganizationRepository {
+	return NewUserOrganizationRepositoryWithDB(r.db)
+}
@@ -0,0 +1,416 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
+)
+
+// Table generates the SQL of a table's CRUD statements from the struct T of its rows and
+// scans the results with pgx.RowToAddrOfStructByName, so no repository keeps column lists
+// or scan orders in step by hand.
+//
+// Every exported field of T is a column, named by its db tag as RowToStructByName matches
+// it. Options after the name describe how the statements treat the column:
+//   - pk: part of the primary key, in field order; never updated
+//   - default: left out of inserts, so the column default applies (serial ids)
+//   - readonly: written by inserts only (creation timestamps, owners)
+//   - modified: written by every update, whatever its mask (modification timestamps)
+//   - deleted: soft-delete marker. Rows where it is set (not NULL, or not empty for a
+//     string field) are hidden from reads and writes, and soft deletes set it.
+//   - rowversion: the etag of the row (see etag.go), selected as xmin::text; never written
+//
+// For example
+//
+//	type File struct {
+//		UUID    string  `db:"uuid,pk"`
+//		Created string  `db:"created,readonly"`
+//		Deleted *string `db:"deleted,deleted"`
+//		Blob    *string `db:"blob"`
+//	}
+type Table[T any] struct {
+	name     string
+	notFound error
+	columns  []tableColumn
+	pk       []tableColumn
+	deleted  *tableColumn
+	version  *tableColumn
+}
+
+// tableColumn is a column of a Table
+type tableColumn struct {
+	name       string // as in the db tag
+	field      string // API (proto) field name, see fieldName
+	index      int    // of the struct field
+	pk         bool
+	dflt       bool
+	readonly   bool
+	modified   bool
+	deleted    bool
+	rowversion bool
+}
+
+// NewTable returns the Table of the struct T, whose rows are missing with notFound. It
+// panics if the tags of T are invalid, so tables are declared as package variables.
+func NewTable[T any](name string, notFound error) *Table[T] {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("repository: table %s: %s is not a struct", name, typ))
+	}
+
+	t := &Table[T]{name: name, notFound: notFound}
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		tag, ok := sf.Tag.Lookup("db")
+		if !sf.IsExported() || tag == "-" {
+			continue
+		}
+		if !ok || tag == "" {
+			panic(fmt.Sprintf("repository: table %s: field %s has no db tag", name, sf.Name))
+		}
+
+		opts := strings.Split(tag, ",")
+		c := tableColumn{name: opts[0], field: fieldName(opts[0]), index: i}
+		for _, opt := range opts[1:] {
+			switch opt {
+			case "pk":
+				c.pk = true
+			case "default":
+				c.dflt = true
+			case "readonly":
+				c.readonly = true
+			case "modified":
+				c.modified = true
+			case "deleted":
+				c.deleted = true
+			case "rowversion":
+				c.rowversion = true
+			default:
+				panic(fmt.Sprintf("repository: table %s: field %s: unknown option %q", name, sf.Name, opt))
+			}
+		}
+		t.columns = append(t.columns, c)
+		switch {
+		case c.pk:
+			t.pk = append(t.pk, c)
+		case c.deleted:
+			t.deleted = &t.columns[len(t.columns)-1]
+		case c.rowversion:
+			t.version = &t.columns[len(t.columns)-1]
+		}
+	}
+	if len(t.pk) == 0 {
+		panic(fmt.Sprintf("repository: table %s has no pk column", name))
+	}
+	return t
+}
+
+// fieldName is the API name of a column, its name in snake_case: ElectCertMgNo is
+// elect_cert_mg_no and OrganizationID organization_id. Update masks use it.
+func fieldName(column string) string {
+	runes := []rune(column)
+	var sb strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+				sb.WriteByte('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
+
+// ident quotes a column name; the names come from struct tags and contain no quotes
+func ident(name string) string {
+	return `"` + name + `"`
+}
+
+// selectList returns the columns of T for a SELECT or RETURNING list
+func (t *Table[T]) selectList() string {
+	cols := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		if c.rowversion {
+			cols[i] = "xmin::text AS " + ident(c.name)
+		} else {
+			cols[i] = ident(c.name)
+		}
+	}
+	return strings.Join(cols, ", ")
+}
+
+// where joins conditions with AND, adding that the row is not soft-deleted
+func (t *Table[T]) where(conds ...string) string {
+	var parts []string
+	for _, cond := range conds {
+		if cond != "" {
+			parts = append(parts, cond)
+		}
+	}
+	if t.deleted != nil {
+		if reflect.TypeOf((*T)(nil)).Elem().Field(t.deleted.index).Type.Kind() == reflect.Pointer {
+			parts = append(parts, ident(t.deleted.name)+" IS NULL")
+		} else {
+			parts = append(parts, ident(t.deleted.name)+" = ''")
+		}
+	}
+	if len(parts) == 0 {
+		return "TRUE"
+	}
+	return strings.Join(parts, " AND ")
+}
+
+// keyCondition matches the primary key with the parameters $1, $2, ...
+func (t *Table[T]) keyCondition() string {
+	conds := make([]string, len(t.pk))
+	for i, c := range t.pk {
+		conds[i] = fmt.Sprintf("%s = $%d", ident(c.name), i+1)
+	}
+	return strings.Join(conds, " AND ")
+}
+
+// key returns the primary key values of row
+func (t *Table[T]) key(row *T) []any {
+	v := reflect.ValueOf(row).Elem()
+	key := make([]any, len(t.pk))
+	for i, c := range t.pk {
+		key[i] = v.Field(c.index).Interface()
+	}
+	return key
+}
+
+// queryRow runs a statement returning rows of T and returns the first one, or notFound
+func (t *Table[T]) queryRow(ctx context.Context, db DB, sql string, args ...any) (*T, error) {
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[T])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, t.notFound
+		}
+		return nil, err
+	}
+	return row, nil
+}
+
+// query runs a statement returning rows of T
+func (t *Table[T]) query(ctx context.Context, db DB, sql string, args ...any) ([]*T, error) {
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.AppendRows([]*T(nil), rows, pgx.RowToAddrOfStructByName[T])
+}
+
+// insert inserts row and returns it as stored
+func (t *Table[T]) insert(ctx context.Context, db DB, row *T) (*T, error) {
+	v := reflect.ValueOf(row).Elem()
+	var cols, params []string
+	var args []any
+	for _, c := range t.columns {
+		if c.dflt || c.rowversion {
+			continue
+		}
+		args = append(args, v.Field(c.index).Interface())
+		cols = append(cols, ident(c.name))
+		params = append(params, fmt.Sprintf("$%d", len(args)))
+	}
+
+	sql := "INSERT INTO " + t.name + " (" + strings.Join(cols, ", ") + ")" +
+		" VALUES (" + strings.Join(params, ", ") + ")" +
+		" RETURNING " + t.selectList()
+	return t.queryRow(ctx, db, sql, args...)
+}
+
+// get returns the row with the primary key key
+func (t *Table[T]) get(ctx context.Context, db DB, key ...any) (*T, error) {
+	return t.find(ctx, db, t.keyCondition(), key...)
+}
+
+// find returns the first row matching cond, a condition on the parameters args
+func (t *Table[T]) find(ctx context.Context, db DB, cond string, args ...any) (*T, error) {
+	return t.queryRow(ctx, db, "SELECT "+t.selectList()+" FROM "+t.name+" WHERE "+t.where(cond), args...)
+}
+
+// findAll returns the rows matching cond, a condition on the parameters args, sorted by
+// orderBy
+func (t *Table[T]) findAll(ctx context.Context, db DB, cond, orderBy string, args ...any) ([]*T, error) {
+	return t.query(ctx, db, "SELECT "+t.selectList()+" FROM "+t.name+" WHERE "+t.where(cond)+" ORDER BY "+orderBy, args...)
+}
+
+// update writes the columns of mask (every updatable one if mask is empty or the single
+// path "*", as in AIP-134) and the modified columns from row to the row with row's primary
+// key, and returns the row as updated. Mask paths are field names; paths that are not
+// updatable fields are rejected. A non-empty etag must be the current one of the row.
+func (t *Table[T]) update(ctx context.Context, db DB, row *T, mask []string, etag string) (*T, error) {
+	cols, err := t.updateColumns(mask)
+	if err != nil {
+		return nil, err
+	}
+
+	v := reflect.ValueOf(row).Elem()
+	key := t.key(row)
+	args := append([]any(nil), key...)
+	sets := make([]string, len(cols))
+	for i, c := range cols {
+		args = append(args, v.Field(c.index).Interface())
+		sets[i] = fmt.Sprintf("%s = $%d", ident(c.name), len(args))
+	}
+	sql := "UPDATE " + t.name + " SET " + strings.Join(sets, ", ") +
+		" WHERE " + t.where(t.keyCondition(), t.etagCondition(&args, etag)) +
+		" RETURNING " + t.selectList()
+
+	updated, err := t.queryRow(ctx, db, sql, args...)
+	if errors.Is(err, t.notFound) {
+		return nil, t.missingOrStale(ctx, db, etag, key)
+	}
+	return updated, err
+}
+
+// updateColumns returns the columns an update with mask writes, in table order
+func (t *Table[T]) updateColumns(mask []string) ([]tableColumn, error) {
+	all := len(mask) == 0 || len(mask) == 1 && mask[0] == "*"
+	selected := make(map[string]bool, len(mask))
+	if !all {
+		for _, path := range mask {
+			if !t.updatable(path) {
+				return nil, invalidArgument("update_mask", "%q is not an updatable field", path)
+			}
+			selected[path] = true
+		}
+	}
+
+	var cols []tableColumn
+	for _, c := range t.columns {
+		if c.modified || all && t.updatable(c.field) || selected[c.field] {
+			cols = append(cols, c)
+		}
+	}
+	return cols, nil
+}
+
+// updatable reports whether field names a column updates can write by mask
+func (t *Table[T]) updatable(field string) bool {
+	for _, c := range t.columns {
+		if c.field == field {
+			return !c.pk && !c.readonly && !c.modified && !c.rowversion
+		}
+	}
+	return false
+}
+
+// delete deletes the row with the primary key key. A non-empty etag must be its current one.
+func (t *Table[T]) delete(ctx context.Context, db DB, etag string, key ...any) error {
+	args := append([]any(nil), key...)
+	sql := "DELETE FROM " + t.name + " WHERE " + t.where(t.keyCondition(), t.etagCondition(&args, etag))
+	return t.exec(ctx, db, sql, etag, key, args)
+}
+
+// softDelete sets the deleted column, and the modified ones, of the row with the primary
+// key key to at. A non-empty etag must be its current one.
+func (t *Table[T]) softDelete(ctx context.Context, db DB, at any, etag string, key ...any) error {
+	args := append(append([]any(nil), key...), at)
+	sets := []string{fmt.Sprintf("%s = $%d", ident(t.deleted.name), len(args))}
+	for _, c := range t.columns {
+		if c.modified {
+			sets = append(sets, fmt.Sprintf("%s = $%d", ident(c.name), len(args)))
+		}
+	}
+	sql := "UPDATE " + t.name + " SET " + strings.Join(sets, ", ") +
+		" WHERE " + t.where(t.keyCondition(), t.etagCondition(&args, etag))
+	return t.exec(ctx, db, sql, etag, key, args)
+}
+
+// exec runs a statement writing the row with the primary key key
+func (t *Table[T]) exec(ctx context.Context, db DB, sql, etag string, key, args []any) error {
+	result, err := db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return t.missingOrStale(ctx, db, etag, key)
+	}
+	return nil
+}
+
+// etagCondition appends etag to args and returns the condition that the row still has it,
+// or "" if T has no rowversion column
+func (t *Table[T]) etagCondition(args *[]any, etag string) string {
+	if t.version == nil {
+		return ""
+	}
+	*args = append(*args, etag)
+	return etagCondition(len(*args))
+}
+
+// missingOrStale tells why a write of the row with the primary key key matched no row
+func (t *Table[T]) missingOrStale(ctx context.Context, db DB, etag string, key []any) error {
+	if t.version == nil {
+		return t.notFound
+	}
+	return missingOrStale(ctx, db, etag, t.notFound, t.name, t.where(t.keyCondition()), key...)
+}
+
+// list returns a page of the rows matching cond, a condition on the parameters args, and
+// the filter of opts, sorted by its order_by and defaultOrder. fields are the fields opts
+// can filter and order by, nil for none. The key of the page's last row is returned if
+// there are more rows.
+func (t *Table[T]) list(ctx context.Context, db DB, opts ListOptions, fields ListFields, defaultOrder pagination.Order, cond string, args ...any) ([]*T, pagination.Key, error) {
+	limit := opts.Page.Limit(10, 100)
+	q, err := fields.query(opts, defaultOrder, len(args)+2)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sql := "SELECT " + t.selectList() + ", " + q.key() +
+		" FROM " + t.name +
+		" WHERE " + t.where(cond, q.where) +
+		" ORDER BY " + q.order.OrderBy() +
+		fmt.Sprintf(" LIMIT $%d", len(args)+1)
+
+	rows, err := db.Query(ctx, sql, append(append(args, limit+1), q.args...)...)
+	if err != nil {
+		return nil, nil, err
+	}
+	var keys []pagination.Key
+	results, err := pgx.AppendRows([]*T(nil), rows, func(row pgx.CollectableRow) (*T, error) {
+		var key pagination.Key
+		result, err := pgx.RowToAddrOfStructByName[T](keyedRow{row, &key})
+		keys = append(keys, key)
+		return result, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results, next := pagination.TrimKeys(results, keys, limit)
+	return results, next, nil
+}
+
+// keyedRow is a listing row whose last column, the page key, is scanned into key and
+// hidden from RowToStructByName
+type keyedRow struct {
+	pgx.CollectableRow
+	key *pagination.Key
+}
+
+func (r keyedRow) FieldDescriptions() []pgconn.FieldDescription {
+	fields := r.CollectableRow.FieldDescriptions()
+	return fields[:len(fields)-1]
+}
+
+func (r keyedRow) Scan(dest ...any) error {
+	return r.CollectableRow.Scan(append(dest, (*[]string)(r.key))...)
+}
@@ -0,0 +1,295 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
+)
+
+var errTestRowNotFound = notFound("test row not found")
+
+type testRow struct {
+	UUID     string  `db:"uuid,pk"`
+	Name     string  `db:"Name"`
+	Speed    *string `db:"Speed"`
+	Count    int     `db:"count"`
+	Created  string  `db:"created,readonly"`
+	Modified string  `db:"modified,modified"`
+	Deleted  *string `db:"deleted,deleted,readonly"`
+	Etag     string  `db:"etag,rowversion"`
+}
+
+var testTable = NewTable[testRow]("test_rows", errTestRowNotFound)
+
+const testSelectList = `"uuid", "Name", "Speed", "count", "created", "modified", "deleted", xmin::text AS "etag"`
+
+func TestFieldName(t *testing.T) {
+	tests := map[string]string{
+		"uuid":                 "uuid",
+		"organization_id":      "organization_id",
+		"OrganizationID":       "organization_id",
+		"ElectCertMgNo":        "elect_cert_mg_no",
+		"CarId":                "car_id",
+		"DriverCd1":            "driver_cd1",
+		"GPSLat":               "gps_lat",
+		"unkouNo":              "unkou_no",
+		"TwodimensionCodeInfo": "twodimension_code_info",
+	}
+	for column, want := range tests {
+		if got := fieldName(column); got != want {
+			t.Errorf("fieldName(%q) = %q, want %q", column, got, want)
+		}
+	}
+}
+
+func TestNewTable_InvalidTags(t *testing.T) {
+	type noPK struct {
+		Name string `db:"name"`
+	}
+	type badOption struct {
+		ID string `db:"id,pk,unique"`
+	}
+	type untagged struct {
+		ID   string `db:"id,pk"`
+		Name string
+	}
+
+	for name, newTable := range map[string]func(){
+		"no pk":          func() { NewTable[noPK]("t", errTestRowNotFound) },
+		"unknown option": func() { NewTable[badOption]("t", errTestRowNotFound) },
+		"untagged field": func() { NewTable[untagged]("t", errTestRowNotFound) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("NewTable did not panic")
+				}
+			}()
+			newTable()
+		})
+	}
+}
+
+func TestTable_SQL(t *testing.T) {
+	if got := testTable.selectList(); got != testSelectList {
+		t.Errorf("selectList() = %s, want %s", got, testSelectList)
+	}
+	if got, want := testTable.keyCondition(), `"uuid" = $1`; got != want {
+		t.Errorf("keyCondition() = %s, want %s", got, want)
+	}
+	if got, want := testTable.where("", `"Name" = $2`), `"Name" = $2 AND "deleted" IS NULL`; got != want {
+		t.Errorf("where() = %s, want %s", got, want)
+	}
+
+	type plain struct {
+		A string `db:"a,pk"`
+		B string `db:"b,pk"`
+	}
+	table := NewTable[plain]("plain", errTestRowNotFound)
+	if got, want := table.keyCondition(), `"a" = $1 AND "b" = $2`; got != want {
+		t.Errorf("keyCondition() = %s, want %s", got, want)
+	}
+	if got := table.where(""); got != "TRUE" {
+		t.Errorf("where() = %s, want TRUE", got)
+	}
+}
+
+func TestTable_UpdateColumns(t *testing.T) {
+	tests := []struct {
+		name string
+		mask []string
+		want []string
+	}{
+		{"empty mask", nil, []string{"Name", "Speed", "count", "modified"}},
+		{"wildcard", []string{"*"}, []string{"Name", "Speed", "count", "modified"}},
+		{"one field", []string{"speed"}, []string{"Speed", "modified"}},
+		{"table order", []string{"count", "name"}, []string{"Name", "count", "modified"}},
+		{"repeated path", []string{"count", "count"}, []string{"count", "modified"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cols, err := testTable.updateColumns(tt.mask)
+			if err != nil {
+				t.Fatalf("updateColumns: %v", err)
+			}
+			var got []string
+			for _, c := range cols {
+				got = append(got, c.name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("columns = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_InvalidMask(t *testing.T) {
+	masks := [][]string{{"uuid"}, {"name", "Name"}, {"*", "name"}, {""}, {"created"}, {"modified"}, {"etag"}}
+	for _, mask := range masks {
+		_, err := testTable.update(context.Background(), &MockDB{}, &testRow{}, mask, "")
+		var repoErr *Error
+		if !errors.As(err, &repoErr) || !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("mask %q: err = %v, want ErrInvalidArgument", mask, err)
+			continue
+		}
+		if repoErr.Field != "update_mask" {
+			t.Errorf("mask %q: Field = %q, want update_mask", mask, repoErr.Field)
+		}
+	}
+}
+
+func TestTable_Insert(t *testing.T) {
+	var gotSQL string
+	var gotArgs []any
+	mockDB := &MockDB{
+		queryFunc: func(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+			gotSQL, gotArgs = sql, args
+			return &MockRows{columns: []string{"uuid", "Name", "Speed", "count", "created", "modified", "deleted", "etag"}}, nil
+		},
+	}
+
+	row := &testRow{UUID: "u1", Name: "a", Count: 3, Created: "c", Modified: "m"}
+	if _, err := testTable.insert(context.Background(), mockDB, row); !errors.Is(err, errTestRowNotFound) {
+		t.Fatalf("insert() with no row returned: error = %v, want errTestRowNotFound", err)
+	}
+
+	wantSQL := `INSERT INTO test_rows ("uuid", "Name", "Speed", "count", "created", "modified", "deleted")` +
+		` VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING ` + testSelectList
+	if gotSQL != wantSQL {
+		t.Errorf("insert() SQL =\n%s\nwant\n%s", gotSQL, wantSQL)
+	}
+	if want := []any{"u1", "a", (*string)(nil), 3, "c", "m", (*string)(nil)}; !reflect.DeepEqual(gotArgs, want) {
+		t.Errorf("insert() args = %v, want %v", gotArgs, want)
+	}
+}
+
+func TestTable_Update(t *testing.T) {
+	var gotSQL string
+	var gotArgs []any
+	mockDB := &MockDB{
+		queryFunc: func(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+			gotSQL, gotArgs = sql, args
+			return &MockRows{
+				columns: []string{"uuid", "Name", "Speed", "count", "created", "modified", "deleted", "etag"},
+				data:    [][]any{{"u1", "b", nil, 0, "c", "m2", nil, "101"}},
+			}, nil
+		},
+	}
+
+	row := &testRow{UUID: "u1", Name: "b", Modified: "m2"}
+	got, err := testTable.update(context.Background(), mockDB, row, []string{"name"}, "100")
+	if err != nil {
+		t.Fatalf("update() error = %v", err)
+	}
+	if got.Name != "b" || got.Etag != "101" {
+		t.Errorf("update() = %+v", got)
+	}
+
+	wantSQL := `UPDATE test_rows SET "Name" = $2, "modified" = $3` +
+		` WHERE "uuid" = $1 AND ` + etagCondition(4) + ` AND "deleted" IS NULL RETURNING ` + testSelectList
+	if gotSQL != wantSQL {
+		t.Errorf("update() SQL =\n%s\nwant\n%s", gotSQL, wantSQL)
+	}
+	if want := []any{"u1", "b", "m2", "100"}; !reflect.DeepEqual(gotArgs, want) {
+		t.Errorf("update() args = %v, want %v", gotArgs, want)
+	}
+}
+
+func TestTable_SoftDelete(t *testing.T) {
+	var gotSQL string
+	var gotArgs []any
+	mockDB := &MockDB{
+		execFunc: func(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+			gotSQL, gotArgs = sql, args
+			return pgconn.NewCommandTag("UPDATE 0"), nil
+		},
+	}
+
+	if err := testTable.softDelete(context.Background(), mockDB, "now", "", "u1"); !errors.Is(err, errTestRowNotFound) {
+		t.Errorf("softDelete() of a missing row: error = %v, want errTestRowNotFound", err)
+	}
+
+	wantSQL := `UPDATE test_rows SET "deleted" = $2, "modified" = $2` +
+		` WHERE "uuid" = $1 AND ` + etagCondition(3) + ` AND "deleted" IS NULL`
+	if gotSQL != wantSQL {
+		t.Errorf("softDelete() SQL =\n%s\nwant\n%s", gotSQL, wantSQL)
+	}
+	if want := []any{"u1", "now", ""}; !reflect.DeepEqual(gotArgs, want) {
+		t.Errorf("softDelete() args = %v, want %v", gotArgs, want)
+	}
+}
+
+func TestTable_List(t *testing.T) {
+	var gotSQL string
+	var gotArgs []any
+	mockDB := &MockDB{
+		queryFunc: func(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+			gotSQL, gotArgs = sql, args
+			return &MockRows{
+				columns: []string{"uuid", "Name", "Speed", "count", "created", "modified", "deleted", "etag", "key"},
+				data: [][]any{
+					{"u1", "a", nil, 0, "c1", "m", nil, "100", []string{"c1", "u1"}},
+					{"u2", "b", nil, 0, "c2", "m", nil, "100", []string{"c2", "u2"}},
+				},
+			}, nil
+		},
+	}
+
+	fields := ListFields{"name": {Column: `"Name"`, Type: "text"}}
+	order := pagination.Order{pagination.Asc("created", "text"), pagination.Asc("uuid", "text")}
+	opts := ListOptions{Filter: `name = "a"`, Page: pagination.Page{Size: 1, After: pagination.Key{"c0", "u0"}}}
+	rows, next, err := testTable.list(context.Background(), mockDB, opts, fields, order, `"count" = $1`, 3)
+	if err != nil {
+		t.Fatalf("list() error = %v", err)
+	}
+
+	if len(rows) != 1 || rows[0].UUID != "u1" || rows[0].Name != "a" {
+		t.Errorf("list() rows = %+v, want u1", rows)
+	}
+	if want := (pagination.Key{"c1", "u1"}); !reflect.DeepEqual(next, want) {
+		t.Errorf("list() next = %v, want %v", next, want)
+	}
+
+	wantSQL := `SELECT ` + testSelectList + `, ARRAY[(created)::text, (uuid)::text]` +
+		` FROM test_rows WHERE "count" = $1 AND ("Name" = $3::text) AND (created, uuid) > ($4::text, $5::text) AND "deleted" IS NULL` +
+		` ORDER BY created, uuid LIMIT $2`
+	if gotSQL != wantSQL {
+		t.Errorf("list() SQL =\n%s\nwant\n%s", gotSQL, wantSQL)
+	}
+	if want := []any{3, 2, "a", "c0", "u0"}; !reflect.DeepEqual(gotArgs, want) {
+		t.Errorf("list() args = %v, want %v", gotArgs, want)
+	}
+}
+
+func TestKudgivtRepository_UpdateKeepsScores(t *testing.T) {
+	for _, mask := range [][]string{nil, {"*"}} {
+		var gotSQL string
+		mockDB := &MockDB{
+			queryFunc: func(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+				gotSQL = sql
+				return &MockRows{}, nil
+			},
+		}
+
+		repo := NewKudgivtRepositoryWithDB(mockDB)
+		if _, err := repo.Update(context.Background(), &Kudgivt{UUID: "k1"}, mask, ""); !errors.Is(err, ErrKudgivtNotFound) {
+			t.Fatalf("mask %q: Update() error = %v, want ErrKudgivtNotFound", mask, err)
+		}
+
+		set := gotSQL[:strings.Index(gotSQL, " WHERE ")]
+		if !strings.Contains(set, `"VehicleCd" = `) {
+			t.Errorf("mask %q: SET does not write VehicleCD:\n%s", mask, set)
+		}
+		// safety_score has no field in UpdateKudgivtRequest, so a full update must not clear it
+		if strings.Contains(set, `"SafetyScore"`) {
+			t.Errorf("mask %q: SET writes SafetyScore:\n%s", mask, set)
+		}
+	}
+}
@@ -2,27 +2,31 @@ package repository
 
 import (
 	"context"
-	"errors"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrUriageNotFound = errors.New("uriage not found")
+	ErrUriageNotFound = notFound("uriage not found")
 )
 
 // Uriage represents the database model
 type Uriage struct {
-	Name           string
-	Bumon          string
-	OrganizationID string
-	Kingaku        *int32
-	Type           *int32
-	Cam            *int32
-	Date           string
+	Name           string `db:"name,pk"`
+	Bumon          string `db:"bumon,pk"`
+	OrganizationID string `db:"organization_id,pk"`
+	Kingaku        *int32 `db:"kingaku"`
+	Type           *int32 `db:"type"`
+	Cam            *int32 `db:"cam"`
+	Date           string `db:"date,pk"`
+	Etag           string `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var uriageTable = NewTable[Uriage]("uriage", ErrUriageNotFound)
+
 // UriageRepository handles database operations for uriage
 type UriageRepository struct {
 	db DB
@@ -40,154 +44,77 @@ func NewUriageRepositoryWithDB(db DB) *UriageRepository {
 
 // Create inserts a new uriage
 func (r *UriageRepository) Create(ctx context.Context, name, bumon, organizationID string, kingaku, uriageType, cam *int32, date string) (*Uriage, error) {
-	query := `
-		INSERT INTO uriage (name, bumon, organization_id, kingaku, type, cam, date)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING name, bumon, organization_id, kingaku, type, cam, date
-	`
-
-	var uriage Uriage
-	err := r.db.QueryRow(ctx, query, name, bumon, organizationID, kingaku, uriageType, cam, date).Scan(
-		&uriage.Name, &uriage.Bumon, &uriage.OrganizationID, &uriage.Kingaku, &uriage.Type, &uriage.Cam, &uriage.Date,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &uriage, nil
+	ctx = db.WithQueryLabel(ctx, "UriageRepository.Create")
+	return uriageTable.insert(ctx, r.db, &Uriage{
+		Name:           name,
+		Bumon:          bumon,
+		OrganizationID: organizationID,
+		Kingaku:        kingaku,
+		Type:           uriageType,
+		Cam:            cam,
+		Date:           date,
+	})
 }
 
 // GetByPrimaryKey retrieves a uriage by composite primary key (name, bumon, date, organization_id)
 func (r *UriageRepository) GetByPrimaryKey(ctx context.Context, name, bumon, date, organizationID string) (*Uriage, error) {
-	query := `
-		SELECT name, bumon, organization_id, kingaku, type, cam, date
-		FROM uriage
-		WHERE name = $1 AND bumon = $2 AND date = $3 AND organization_id = $4
-	`
-
-	var uriage Uriage
-	err := r.db.QueryRow(ctx, query, name, bumon, date, organizationID).Scan(
-		&uriage.Name, &uriage.Bumon, &uriage.OrganizationID, &uriage.Kingaku, &uriage.Type, &uriage.Cam, &uriage.Date,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrUriageNotFound
-		}
-		return nil, err
-	}
-
-	return &uriage, nil
+	ctx = db.WithQueryLabel(ctx, "UriageRepository.GetByPrimaryKey")
+	return uriageTable.get(ctx, r.db, name, bumon, organizationID, date)
 }
 
-// Update modifies an existing uriage
-func (r *UriageRepository) Update(ctx context.Context, name, bumon, date, organizationID string, kingaku, uriageType, cam *int32) (*Uriage, error) {
-	query := `
-		UPDATE uriage
-		SET kingaku = $5, type = $6, cam = $7
-		WHERE name = $1 AND bumon = $2 AND date = $3 AND organization_id = $4
-		RETURNING name, bumon, organization_id, kingaku, type, cam, date
-	`
-
-	var uriage Uriage
-	err := r.db.QueryRow(ctx, query, name, bumon, date, organizationID, kingaku, uriageType, cam).Scan(
-		&uriage.Name, &uriage.Bumon, &uriage.OrganizationID, &uriage.Kingaku, &uriage.Type, &uriage.Cam, &uriage.Date,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrUriageNotFound
-		}
-		return nil, err
-	}
-
-	return &uriage, nil
+// Update modifies an existing uriage. A non-empty etag must be the current one.
+func (r *UriageRepository) Update(ctx context.Context, name, bumon, date, organizationID string, kingaku, uriageType, cam *int32, etag string) (*Uriage, error) {
+	ctx = db.WithQueryLabel(ctx, "UriageRepository.Update")
+	return uriageTable.update(ctx, r.db, &Uriage{
+		Name:           name,
+		Bumon:          bumon,
+		OrganizationID: organizationID,
+		Kingaku:        kingaku,
+		Type:           uriageType,
+		Cam:            cam,
+		Date:           date,
+	}, nil, etag)
 }
 
-// Delete removes a uriage (hard delete)
-func (r *UriageRepository) Delete(ctx context.Context, name, bumon, date, organizationID string) error {
-	query := `
-		DELETE FROM uriage
-		WHERE name = $1 AND bumon = $2 AND date = $3 AND organization_id = $4
-	`
+// Delete removes a uriage (hard delete). A non-empty etag must be the current one.
+func (r *UriageRepository) Delete(ctx context.Context, name, bumon, date, organizationID, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "UriageRepository.Delete")
+	return uriageTable.delete(ctx, r.db, etag, name, bumon, organizationID, date)
+}
 
-	result, err := r.db.Exec(ctx, query, name, bumon, date, organizationID)
-	if err != nil {
-		return err
-	}
+// uriageFields are the fields uriage listings can be filtered and ordered by
+var uriageFields = ListFields{
+	"date":    {Column: "date", Type: "text"},
+	"name":    {Column: "name", Type: "text"},
+	"bumon":   {Column: "bumon", Type: "text"},
+	"kingaku": {Column: "kingaku", Type: "integer", Nullable: true},
+	"type":    {Column: "type", Type: "integer", Nullable: true},
+	"cam":     {Column: "cam", Type: "integer", Nullable: true},
+}
 
-	if result.RowsAffected() == 0 {
-		return ErrUriageNotFound
-	}
+// uriageOrder lists the sales of an organization newest first, by primary key
+var uriageOrder = pagination.Order{
+	pagination.Desc("date", "text"),
+	pagination.Asc("name", "text"),
+	pagination.Asc("bumon", "text"),
+}
 
-	return nil
+// uriageListOrder lists the sales of all visible organizations
+var uriageListOrder = pagination.Order{
+	pagination.Desc("date", "text"),
+	pagination.Asc("name", "text"),
+	pagination.Asc("bumon", "text"),
+	pagination.Asc("organization_id", "text"),
 }
 
 // ListByOrganization retrieves uriage entries for a specific organization with pagination
-func (r *UriageRepository) ListByOrganization(ctx context.Context, organizationID string, limit int, offset int) ([]*Uriage, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT name, bumon, organization_id, kingaku, type, cam, date
-		FROM uriage
-		WHERE organization_id = $1
-		ORDER BY date DESC, name ASC, bumon ASC
-		LIMIT $2 OFFSET $3
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var uriages []*Uriage
-	for rows.Next() {
-		var uriage Uriage
-		err := rows.Scan(&uriage.Name, &uriage.Bumon, &uriage.OrganizationID, &uriage.Kingaku, &uriage.Type, &uriage.Cam, &uriage.Date)
-		if err != nil {
-			return nil, err
-		}
-		uriages = append(uriages, &uriage)
-	}
-
-	return uriages, rows.Err()
+func (r *UriageRepository) ListByOrganization(ctx context.Context, organizationID string, opts ListOptions) ([]*Uriage, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "UriageRepository.ListByOrganization")
+	return uriageTable.list(ctx, r.db, opts, uriageFields, uriageOrder, "organization_id = $1", organizationID)
 }
 
 // List retrieves all uriage entries with pagination
-func (r *UriageRepository) List(ctx context.Context, limit int, offset int) ([]*Uriage, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT name, bumon, organization_id, kingaku, type, cam, date
-		FROM uriage
-		ORDER BY date DESC, name ASC, bumon ASC
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var uriages []*Uriage
-	for rows.Next() {
-		var uriage Uriage
-		err := rows.Scan(&uriage.Name, &uriage.Bumon, &uriage.OrganizationID, &uriage.Kingaku, &uriage.Type, &uriage.Cam, &uriage.Date)
-		if err != nil {
-			return nil, err
-		}
-		uriages = append(uriages, &uriage)
-	}
-
-	return uriages, rows.Err()
+func (r *UriageRepository) List(ctx context.Context, opts ListOptions) ([]*Uriage, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "UriageRepository.List")
+	return uriageTable.list(ctx, r.db, opts, uriageFields, uriageListOrder, "")
 }
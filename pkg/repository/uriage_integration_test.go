@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_Uriage_CRUD(t *testing.T) {
@@ -58,7 +60,7 @@ func TestIntegration_Uriage_CRUD(t *testing.T) {
 			newKingaku := int32(100000)
 			newType := int32(2)
 			newCam := int32(200)
-			updated, err := repo.Update(ctx, name, bumon, date, orgID, &newKingaku, &newType, &newCam)
+			updated, err := repo.Update(ctx, name, bumon, date, orgID, &newKingaku, &newType, &newCam, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -70,7 +72,7 @@ func TestIntegration_Uriage_CRUD(t *testing.T) {
 
 		// 4. List
 		t.Run("ListByOrganization", func(t *testing.T) {
-			records, err := repo.ListByOrganization(ctx, orgID, 10, 0)
+			records, _, err := repo.ListByOrganization(ctx, orgID, ListOptions{Page: pagination.Page{Size: 10}})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -82,7 +84,7 @@ func TestIntegration_Uriage_CRUD(t *testing.T) {
 
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, name, bumon, date, orgID)
+			err := repo.Delete(ctx, name, bumon, date, orgID, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_UriageJisha_CRUD(t *testing.T) {
@@ -55,7 +57,7 @@ func TestIntegration_UriageJisha_CRUD(t *testing.T) {
 		t.Run("Update", func(t *testing.T) {
 			newKingaku := int32(20000)
 			newType := int32(2)
-			updated, err := repo.Update(ctx, bumon, date, orgID, &newKingaku, &newType)
+			updated, err := repo.Update(ctx, bumon, date, orgID, &newKingaku, &newType, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -67,7 +69,7 @@ func TestIntegration_UriageJisha_CRUD(t *testing.T) {
 
 		// 4. List
 		t.Run("ListByOrganization", func(t *testing.T) {
-			records, err := repo.ListByOrganization(ctx, orgID, 10, 0)
+			records, _, err := repo.ListByOrganization(ctx, orgID, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("ListByOrganization failed: %v", err)
 			}
@@ -79,7 +81,7 @@ func TestIntegration_UriageJisha_CRUD(t *testing.T) {
 
 		// 5. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := repo.Delete(ctx, bumon, date, orgID)
+			err := repo.Delete(ctx, bumon, date, orgID, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
@@ -2,29 +2,33 @@ package repository
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 var (
-	ErrUserOrganizationNotFound = errors.New("user organization not found")
+	ErrUserOrganizationNotFound = notFound("user organization not found")
 )
 
 // UserOrganization represents the database model
 type UserOrganization struct {
-	ID             string
-	UserID         string
-	OrganizationID string
-	Role           string
-	IsDefault      bool
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID             string    `db:"id,pk"`
+	UserID         string    `db:"user_id,readonly"`
+	OrganizationID string    `db:"organization_id,readonly"`
+	Role           string    `db:"role"`
+	IsDefault      bool      `db:"is_default"`
+	CreatedAt      time.Time `db:"created_at,readonly"`
+	UpdatedAt      time.Time `db:"updated_at,modified"`
+	Etag           string    `db:"etag,rowversion"` // row version, see etag.go
 }
 
+var userOrganizationTable = NewTable[UserOrganization]("user_organizations", ErrUserOrganizationNotFound)
+
 // UserOrganizationRepository handles database operations for user_organizations
 type UserOrganizationRepository struct {
 	db DB
@@ -42,196 +46,80 @@ func NewUserOrganizationRepositoryWithDB(db DB) *UserOrganizationRepository {
 
 // Create inserts a new user organization
 func (r *UserOrganizationRepository) Create(ctx context.Context, userID, organizationID, role string, isDefault bool) (*UserOrganization, error) {
-	id := uuid.New().String()
+	ctx = db.WithQueryLabel(ctx, "UserOrganizationRepository.Create")
 	now := time.Now()
-
-	query := `
-		INSERT INTO user_organizations (id, user_id, organization_id, role, is_default, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, user_id, organization_id, role, is_default, created_at, updated_at
-	`
-
-	var uo UserOrganization
-	err := r.db.QueryRow(ctx, query, id, userID, organizationID, role, isDefault, now, now).Scan(
-		&uo.ID, &uo.UserID, &uo.OrganizationID, &uo.Role, &uo.IsDefault, &uo.CreatedAt, &uo.UpdatedAt,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &uo, nil
+	return userOrganizationTable.insert(ctx, r.db, &UserOrganization{
+		ID:             uuid.New().String(),
+		UserID:         userID,
+		OrganizationID: organizationID,
+		Role:           role,
+		IsDefault:      isDefault,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
 }
 
 // GetByID retrieves a user organization by ID
 func (r *UserOrganizationRepository) GetByID(ctx context.Context, id string) (*UserOrganization, error) {
-	query := `
-		SELECT id, user_id, organization_id, role, is_default, created_at, updated_at
-		FROM user_organizations
-		WHERE id = $1
-	`
-
-	var uo UserOrganization
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&uo.ID, &uo.UserID, &uo.OrganizationID, &uo.Role, &uo.IsDefault, &uo.CreatedAt, &uo.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrUserOrganizationNotFound
-		}
-		return nil, err
-	}
-
-	return &uo, nil
+	ctx = db.WithQueryLabel(ctx, "UserOrganizationRepository.GetByID")
+	return userOrganizationTable.get(ctx, r.db, id)
 }
 
 // GetByUserAndOrganization retrieves a user organization by user_id and organization_id
 func (r *UserOrganizationRepository) GetByUserAndOrganization(ctx context.Context, userID, organizationID string) (*UserOrganization, error) {
+	ctx = db.WithQueryLabel(ctx, "UserOrganizationRepository.GetByUserAndOrganization")
+	return userOrganizationTable.find(ctx, r.db, "user_id = $1 AND organization_id = $2", userID, organizationID)
+}
+
+// GetDefaultByUserID retrieves the organization a user starts a session in:
+// the membership flagged is_default, otherwise the oldest one.
+// Memberships of deleted organizations are ignored.
+func (r *UserOrganizationRepository) GetDefaultByUserID(ctx context.Context, userID string) (*UserOrganization, error) {
+	ctx = db.WithQueryLabel(ctx, "UserOrganizationRepository.GetDefaultByUserID")
 	query := `
-		SELECT id, user_id, organization_id, role, is_default, created_at, updated_at
-		FROM user_organizations
-		WHERE user_id = $1 AND organization_id = $2
+		SELECT uo.id, uo.user_id, uo.organization_id, uo.role, uo.is_default, uo.created_at, uo.updated_at, uo.xmin::text AS etag
+		FROM user_organizations uo
+		JOIN organizations o ON o.id = uo.organization_id
+		WHERE uo.user_id = $1 AND o.deleted_at IS NULL
+		ORDER BY uo.is_default DESC, uo.created_at ASC
+		LIMIT 1
 	`
 
-	var uo UserOrganization
-	err := r.db.QueryRow(ctx, query, userID, organizationID).Scan(
-		&uo.ID, &uo.UserID, &uo.OrganizationID, &uo.Role, &uo.IsDefault, &uo.CreatedAt, &uo.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrUserOrganizationNotFound
-		}
-		return nil, err
-	}
-
-	return &uo, nil
+	return userOrganizationTable.queryRow(ctx, r.db, query, userID)
 }
 
 // ListByUserID retrieves all organizations for a user
 func (r *UserOrganizationRepository) ListByUserID(ctx context.Context, userID string) ([]*UserOrganization, error) {
-	query := `
-		SELECT id, user_id, organization_id, role, is_default, created_at, updated_at
-		FROM user_organizations
-		WHERE user_id = $1
-		ORDER BY created_at DESC
-	`
-
-	rows, err := r.db.Query(ctx, query, userID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var uos []*UserOrganization
-	for rows.Next() {
-		var uo UserOrganization
-		err := rows.Scan(&uo.ID, &uo.UserID, &uo.OrganizationID, &uo.Role, &uo.IsDefault, &uo.CreatedAt, &uo.UpdatedAt)
-		if err != nil {
-			return nil, err
-		}
-		uos = append(uos, &uo)
-	}
-
-	return uos, rows.Err()
+	ctx = db.WithQueryLabel(ctx, "UserOrganizationRepository.ListByUserID")
+	return userOrganizationTable.findAll(ctx, r.db, "user_id = $1", "created_at DESC", userID)
 }
 
 // ListByOrganizationID retrieves all users for an organization
 func (r *UserOrganizationRepository) ListByOrganizationID(ctx context.Context, organizationID string) ([]*UserOrganization, error) {
-	query := `
-		SELECT id, user_id, organization_id, role, is_default, created_at, updated_at
-		FROM user_organizations
-		WHERE organization_id = $1
-		ORDER BY created_at DESC
-	`
-
-	rows, err := r.db.Query(ctx, query, organizationID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var uos []*UserOrganization
-	for rows.Next() {
-		var uo UserOrganization
-		err := rows.Scan(&uo.ID, &uo.UserID, &uo.OrganizationID, &uo.Role, &uo.IsDefault, &uo.CreatedAt, &uo.UpdatedAt)
-		if err != nil {
-			return nil, err
-		}
-		uos = append(uos, &uo)
-	}
-
-	return uos, rows.Err()
+	ctx = db.WithQueryLabel(ctx, "UserOrganizationRepository.ListByOrganizationID")
+	return userOrganizationTable.findAll(ctx, r.db, "organization_id = $1", "created_at DESC", organizationID)
 }
 
-// Update modifies an existing user organization
-func (r *UserOrganizationRepository) Update(ctx context.Context, id, role string, isDefault bool) (*UserOrganization, error) {
-	query := `
-		UPDATE user_organizations
-		SET role = $2, is_default = $3, updated_at = $4
-		WHERE id = $1
-		RETURNING id, user_id, organization_id, role, is_default, created_at, updated_at
-	`
-
-	var uo UserOrganization
-	err := r.db.QueryRow(ctx, query, id, role, isDefault, time.Now()).Scan(
-		&uo.ID, &uo.UserID, &uo.OrganizationID, &uo.Role, &uo.IsDefault, &uo.CreatedAt, &uo.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrUserOrganizationNotFound
-		}
-		return nil, err
-	}
-
-	return &uo, nil
+// Update modifies an existing user organization. A non-empty etag must be the current one.
+func (r *UserOrganizationRepository) Update(ctx context.Context, id, role string, isDefault bool, etag string) (*UserOrganization, error) {
+	ctx = db.WithQueryLabel(ctx, "UserOrganizationRepository.Update")
+	return userOrganizationTable.update(ctx, r.db, &UserOrganization{ID: id, Role: role, IsDefault: isDefault, UpdatedAt: time.Now()}, nil, etag)
 }
 
-// Delete removes a user organization (hard delete)
-func (r *UserOrganizationRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM user_organizations WHERE id = $1`
-
-	result, err := r.db.Exec(ctx, query, id)
-	if err != nil {
-		return err
-	}
-
-	if result.RowsAffected() == 0 {
-		return ErrUserOrganizationNotFound
-	}
+// Delete removes a user organization (hard delete). A non-empty etag must be the current one.
+func (r *UserOrganizationRepository) Delete(ctx context.Context, id, etag string) error {
+	ctx = db.WithQueryLabel(ctx, "UserOrganizationRepository.Delete")
+	return userOrganizationTable.delete(ctx, r.db, etag, id)
+}
 
-	return nil
+// userOrganizationOrder lists memberships newest first
+var userOrganizationOrder = pagination.Order{
+	pagination.Desc("created_at", "timestamptz"),
+	pagination.Desc("id", "uuid"),
 }
 
 // List retrieves user organizations with pagination
-func (r *UserOrganizationRepository) List(ctx context.Context, limit int, offset int) ([]*UserOrganization, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
-
-	query := `
-		SELECT id, user_id, organization_id, role, is_default, created_at, updated_at
-		FROM user_organizations
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
-	`
-
-	rows, err := r.db.Query(ctx, query, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var uos []*UserOrganization
-	for rows.Next() {
-		var uo UserOrganization
-		err := rows.Scan(&uo.ID, &uo.UserID, &uo.OrganizationID, &uo.Role, &uo.IsDefault, &uo.CreatedAt, &uo.UpdatedAt)
-		if err != nil {
-			return nil, err
-		}
-		uos = append(uos, &uo)
-	}
-
-	return uos, rows.Err()
+func (r *UserOrganizationRepository) List(ctx context.Context, page pagination.Page) ([]*UserOrganization, pagination.Key, error) {
+	ctx = db.WithQueryLabel(ctx, "UserOrganizationRepository.List")
+	return userOrganizationTable.list(ctx, r.db, ListOptions{Page: page}, nil, userOrganizationOrder, "")
 }
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"testing"
 	"time"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/pagination"
 )
 
 func TestIntegration_UserOrganizations_CRUD(t *testing.T) {
@@ -24,14 +26,14 @@ func TestIntegration_UserOrganizations_CRUD(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Setup: failed to create test user: %v", err)
 	}
-	defer userRepo.Delete(ctx, testUser.ID)
+	defer userRepo.Delete(ctx, testUser.ID, "")
 
 	// slug is auto-generated
 	testOrg, err := orgRepo.Create(ctx, "Test UO Organization")
 	if err != nil {
 		t.Fatalf("Setup: failed to create test organization: %v", err)
 	}
-	defer orgRepo.Delete(ctx, testOrg.ID)
+	defer orgRepo.Delete(ctx, testOrg.ID, "")
 
 	// 1. Create
 	t.Run("Create", func(t *testing.T) {
@@ -109,7 +111,7 @@ func TestIntegration_UserOrganizations_CRUD(t *testing.T) {
 
 		// 6. Update
 		t.Run("Update", func(t *testing.T) {
-			updated, err := uoRepo.Update(ctx, uo.ID, "member", false)
+			updated, err := uoRepo.Update(ctx, uo.ID, "member", false, "")
 			if err != nil {
 				t.Fatalf("Update failed: %v", err)
 			}
@@ -124,7 +126,7 @@ func TestIntegration_UserOrganizations_CRUD(t *testing.T) {
 
 		// 7. List
 		t.Run("List", func(t *testing.T) {
-			uos, err := uoRepo.List(ctx, 10, 0)
+			uos, _, err := uoRepo.List(ctx, pagination.Page{Size: 10})
 			if err != nil {
 				t.Fatalf("List failed: %v", err)
 			}
@@ -136,7 +138,7 @@ func TestIntegration_UserOrganizations_CRUD(t *testing.T) {
 
 		// 8. Delete
 		t.Run("Delete", func(t *testing.T) {
-			err := uoRepo.Delete(ctx, uo.ID)
+			err := uoRepo.Delete(ctx, uo.ID, "")
 			if err != nil {
 				t.Fatalf("Delete failed: %v", err)
 			}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+// CarInspectionService handles car inspection operations that span repositories
+type CarInspectionService struct {
+	uow *UnitOfWork
+}
+
+// NewCarInspectionService creates a new CarInspectionService
+func NewCarInspectionService(uow *UnitOfWork) *CarInspectionService {
+	return &CarInspectionService{uow: uow}
+}
+
+// CreateWithFiles creates a car inspection and its car_inspection_files rows in one transaction.
+// The organization and certificate keys of the files are taken from the inspection.
+func (s *CarInspectionService) CreateWithFiles(ctx context.Context, inspection *repository.CarInspection, files []*repository.CarInspectionFile) (*repository.CarInspection, []*repository.CarInspectionFile, error) {
+	var created *repository.CarInspection
+	var createdFiles []*repository.CarInspectionFile
+
+	err := s.uow.Do(ctx, func(repos *repository.Repositories) error {
+		var err error
+		created, err = repos.CarInspection().Create(ctx, inspection)
+		if err != nil {
+			return err
+		}
+
+		createdFiles = make([]*repository.CarInspectionFile, 0, len(files))
+		for _, f := range files {
+			file, err := repos.CarInspectionFiles().Create(ctx,
+				created.OrganizationID,
+				f.Type,
+				created.ElectCertMgNo,
+				created.ElectCertPublishdateE,
+				created.ElectCertPublishdateY,
+				created.ElectCertPublishdateM,
+				created.ElectCertPublishdateD,
+				f.Created,
+				f.Modified,
+			)
+			if err != nil {
+				return err
+			}
+			createdFiles = append(createdFiles, file)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return created, createdFiles, nil
+}
+
+// Delete deletes a car inspection and soft-deletes its files in one transaction.
+// A non-empty etag must be the current one of the car inspection.
+// It returns the number of files deleted.
+func (s *CarInspectionService) Delete(ctx context.Context, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, etag string) (int64, error) {
+	var deletedFiles int64
+	deletedTimestamp := time.Now().Format(time.RFC3339)
+
+	err := s.uow.Do(ctx, func(repos *repository.Repositories) error {
+		err := repos.CarInspection().Delete(ctx, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, etag)
+		if err != nil {
+			return err
+		}
+
+		deletedFiles, err = repos.CarInspectionFiles().DeleteByCarInspection(ctx, organizationID, electCertMgNo, electCertPublishdateE, electCertPublishdateY, electCertPublishdateM, electCertPublishdateD, deletedTimestamp)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deletedFiles, nil
+}
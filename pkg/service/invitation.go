@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+var (
+	ErrAlreadyMember = errors.New("already a member of this organization")
+)
+
+// InvitationService handles invitation operations that span repositories
+type InvitationService struct {
+	uow *UnitOfWork
+}
+
+// NewInvitationService creates a new InvitationService
+func NewInvitationService(uow *UnitOfWork) *InvitationService {
+	return &InvitationService{uow: uow}
+}
+
+// Accept accepts the invitation with token and makes userID a member of its organization.
+// Marking the invitation accepted and creating the membership happen in one transaction.
+func (s *InvitationService) Accept(ctx context.Context, token, userID string) (*repository.UserOrganization, error) {
+	var userOrg *repository.UserOrganization
+	var expired bool
+
+	err := s.uow.Do(ctx, func(repos *repository.Repositories) error {
+		inv, err := repos.Invitation().GetByToken(ctx, token)
+		if err != nil {
+			return err
+		}
+
+		_, err = repos.UserOrganization().GetByUserAndOrganization(ctx, userID, inv.OrganizationID)
+		if err == nil {
+			return ErrAlreadyMember
+		}
+		if !errors.Is(err, repository.ErrUserOrganizationNotFound) {
+			return err
+		}
+
+		acceptedInv, err := repos.Invitation().Accept(ctx, inv.ID, userID)
+		if errors.Is(err, repository.ErrInvitationExpired) {
+			// Commit so the invitation stays marked as expired
+			expired = true
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		userOrg, err = repos.UserOrganization().Create(ctx, userID, acceptedInv.OrganizationID, acceptedInv.Role, false)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if expired {
+		return nil, repository.ErrInvitationExpired
+	}
+
+	return userOrg, nil
+}
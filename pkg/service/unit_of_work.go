@@ -0,0 +1,34 @@
+// Package service implements operations that span several repositories.
+// Each operation runs in one transaction, so it either takes effect completely or not at all.
+package service
+
+import (
+	"context"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+// TxRunner runs a function in a database transaction. *db.RLSPool implements it.
+type TxRunner interface {
+	RunInTx(ctx context.Context, fn func(tx db.Tx) error) error
+}
+
+// UnitOfWork runs functions with repositories that share one transaction
+type UnitOfWork struct {
+	runner TxRunner
+}
+
+// NewUnitOfWork creates a new UnitOfWork
+func NewUnitOfWork(runner TxRunner) *UnitOfWork {
+	return &UnitOfWork{runner: runner}
+}
+
+// Do calls fn with repositories bound to a new transaction. The transaction is
+// committed if fn returns nil and rolled back otherwise. The RLS context of ctx
+// applies to every statement.
+func (u *UnitOfWork) Do(ctx context.Context, fn func(repos *repository.Repositories) error) error {
+	return u.runner.RunInTx(ctx, func(tx db.Tx) error {
+		return fn(repository.NewRepositories(tx))
+	})
+}
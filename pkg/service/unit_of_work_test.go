@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/repository"
+)
+
+// fakeTx implements db.Tx and records how the transaction ended
+type fakeTx struct {
+	committed  bool
+	rolledBack bool
+}
+
+func (t *fakeTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row { return nil }
+func (t *fakeTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return nil, nil
+}
+func (t *fakeTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, nil
+}
+func (t *fakeTx) Commit(ctx context.Context) error   { t.committed = true; return nil }
+func (t *fakeTx) Rollback(ctx context.Context) error { t.rolledBack = true; return nil }
+
+// fakeTxRunner implements TxRunner the way RLSPool.RunInTx does
+type fakeTxRunner struct {
+	tx *fakeTx
+}
+
+func (r *fakeTxRunner) RunInTx(ctx context.Context, fn func(tx db.Tx) error) error {
+	r.tx = &fakeTx{}
+	if err := fn(r.tx); err != nil {
+		r.tx.Rollback(ctx)
+		return err
+	}
+	return r.tx.Commit(ctx)
+}
+
+func TestUnitOfWork_Do(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("repositories are bound to the transaction", func(t *testing.T) {
+		runner := &fakeTxRunner{}
+		var bound repository.DB
+		err := NewUnitOfWork(runner).Do(ctx, func(repos *repository.Repositories) error {
+			bound = repos.DB()
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Do: %v", err)
+		}
+		if bound != runner.tx {
+			t.Errorf("repositories bound to %T, want the transaction", bound)
+		}
+		if !runner.tx.committed {
+			t.Error("transaction was not committed")
+		}
+	})
+
+	t.Run("error rolls back", func(t *testing.T) {
+		runner := &fakeTxRunner{}
+		errFailed := errors.New("failed")
+		err := NewUnitOfWork(runner).Do(ctx, func(repos *repository.Repositories) error {
+			return errFailed
+		})
+		if !errors.Is(err, errFailed) {
+			t.Errorf("Do error = %v, want %v", err, errFailed)
+		}
+		if runner.tx.committed || !runner.tx.rolledBack {
+			t.Errorf("committed=%v rolledBack=%v, want rollback only", runner.tx.committed, runner.tx.rolledBack)
+		}
+	})
+}
@@ -0,0 +1,85 @@
+// Package telemetry exports Prometheus metrics and OpenTelemetry traces of the
+// connection pools, SQL queries and RPCs.
+package telemetry
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/codes"
+)
+
+// latencyBuckets covers fast index lookups up to the longest RPC timeouts, in seconds
+var latencyBuckets = []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60}
+
+// Metrics holds the Prometheus collectors of the server. It is safe for concurrent use.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	queryDuration   *prometheus.HistogramVec
+	acquireDuration *prometheus.HistogramVec
+	acquiring       *prometheus.GaugeVec
+	rpcDuration     *prometheus.HistogramVec
+	rpcHandled      *prometheus.CounterVec
+}
+
+// NewMetrics creates the collectors on a new registry, together with the Go runtime
+// and process collectors
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "db_query_duration_seconds",
+			Help:    "Latency of SQL statements and batches by pool and repository method.",
+			Buckets: latencyBuckets,
+		}, []string{"pool", "method", "status"}),
+		acquireDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "db_pool_acquire_duration_seconds",
+			Help:    "Time taken to acquire a connection from the pool.",
+			Buckets: latencyBuckets,
+		}, []string{"pool"}),
+		acquiring: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "db_pool_acquiring_conns",
+			Help: "Callers currently waiting to acquire a connection from the pool.",
+		}, []string{"pool"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Latency of RPCs handled by the server.",
+			Buckets: latencyBuckets,
+		}, []string{"method"}),
+		rpcHandled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "RPCs completed on the server by status code.",
+		}, []string{"method", "code"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.queryDuration,
+		m.acquireDuration,
+		m.acquiring,
+		m.rpcDuration,
+		m.rpcHandled,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// Register adds further collectors (e.g. a PoolCollector) to the registry
+func (m *Metrics) Register(c prometheus.Collector) error {
+	return m.registry.Register(c)
+}
+
+// ObserveRPC records a completed RPC
+func (m *Metrics) ObserveRPC(fullMethod string, code codes.Code, duration time.Duration) {
+	m.rpcDuration.WithLabelValues(fullMethod).Observe(duration.Seconds())
+	m.rpcHandled.WithLabelValues(fullMethod, code.String()).Inc()
+}
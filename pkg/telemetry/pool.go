@@ -0,0 +1,104 @@
+package telemetry
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+)
+
+var (
+	poolAcquiredDesc = prometheus.NewDesc("db_pool_acquired_conns",
+		"Connections currently checked out of the pool.", []string{"pool"}, nil)
+	poolIdleDesc = prometheus.NewDesc("db_pool_idle_conns",
+		"Idle connections in the pool.", []string{"pool"}, nil)
+	poolConstructingDesc = prometheus.NewDesc("db_pool_constructing_conns",
+		"Connections being opened.", []string{"pool"}, nil)
+	poolTotalDesc = prometheus.NewDesc("db_pool_total_conns",
+		"Open connections (acquired, idle and constructing).", []string{"pool"}, nil)
+	poolMaxDesc = prometheus.NewDesc("db_pool_max_conns",
+		"Maximum size of the pool.", []string{"pool"}, nil)
+	poolAcquiresDesc = prometheus.NewDesc("db_pool_acquires_total",
+		"Successful connection acquires.", []string{"pool"}, nil)
+	poolEmptyAcquiresDesc = prometheus.NewDesc("db_pool_empty_acquires_total",
+		"Acquires that had to wait for a connection because none was idle.", []string{"pool"}, nil)
+	poolCanceledAcquiresDesc = prometheus.NewDesc("db_pool_canceled_acquires_total",
+		"Acquires canceled by their context while waiting.", []string{"pool"}, nil)
+	poolAcquireSecondsDesc = prometheus.NewDesc("db_pool_acquire_seconds_total",
+		"Total time spent acquiring connections.", []string{"pool"}, nil)
+
+	retriesDesc = prometheus.NewDesc("db_retries_total",
+		"Retries of transient database errors by reason (SQLSTATE or connection).", []string{"reason"}, nil)
+	retriesExhaustedDesc = prometheus.NewDesc("db_retries_exhausted_total",
+		"Operations that still failed with a transient error after the last attempt.", nil, nil)
+)
+
+// PoolCollector exports the statistics of a pgxpool.Pool when scraped
+type PoolCollector struct {
+	name string
+	pool *pgxpool.Pool
+}
+
+// NewPoolCollector creates a collector for pool, labeled pool=name ("primary", "replica")
+func NewPoolCollector(name string, pool *pgxpool.Pool) *PoolCollector {
+	return &PoolCollector{name: name, pool: pool}
+}
+
+// Describe implements prometheus.Collector
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- poolAcquiredDesc
+	ch <- poolIdleDesc
+	ch <- poolConstructingDesc
+	ch <- poolTotalDesc
+	ch <- poolMaxDesc
+	ch <- poolAcquiresDesc
+	ch <- poolEmptyAcquiresDesc
+	ch <- poolCanceledAcquiresDesc
+	ch <- poolAcquireSecondsDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.pool.Stat()
+	gauge := func(desc *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, v, c.name)
+	}
+	counter := func(desc *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, v, c.name)
+	}
+
+	gauge(poolAcquiredDesc, float64(s.AcquiredConns()))
+	gauge(poolIdleDesc, float64(s.IdleConns()))
+	gauge(poolConstructingDesc, float64(s.ConstructingConns()))
+	gauge(poolTotalDesc, float64(s.TotalConns()))
+	gauge(poolMaxDesc, float64(s.MaxConns()))
+	counter(poolAcquiresDesc, float64(s.AcquireCount()))
+	counter(poolEmptyAcquiresDesc, float64(s.EmptyAcquireCount()))
+	counter(poolCanceledAcquiresDesc, float64(s.CanceledAcquireCount()))
+	counter(poolAcquireSecondsDesc, s.AcquireDuration().Seconds())
+}
+
+// RetryCollector exports the counts of a db.RetryMetrics when scraped
+type RetryCollector struct {
+	metrics *db.RetryMetrics
+}
+
+// NewRetryCollector creates a collector for the retries counted by metrics
+func NewRetryCollector(metrics *db.RetryMetrics) *RetryCollector {
+	return &RetryCollector{metrics: metrics}
+}
+
+// Describe implements prometheus.Collector
+func (c *RetryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- retriesDesc
+	ch <- retriesExhaustedDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *RetryCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.metrics.Snapshot()
+	for reason, n := range stats.Retries {
+		ch <- prometheus.MustNewConstMetric(retriesDesc, prometheus.CounterValue, float64(n), reason)
+	}
+	ch <- prometheus.MustNewConstMetric(retriesExhaustedDesc, prometheus.CounterValue, float64(stats.Exhausted))
+}
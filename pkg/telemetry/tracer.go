@@ -0,0 +1,143 @@
+package telemetry
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/db"
+)
+
+// instrumentationName names the tracer of this package
+const instrumentationName = "github.com/yhonda-ohishi-pub-dev/postgres-prod/pkg/telemetry"
+
+// otherMethod labels queries that were not issued by a repository (e.g. migrations)
+const otherMethod = "other"
+
+// queryMethod returns the repository method label of a statement, set with db.WithQueryLabel
+func queryMethod(ctx context.Context) string {
+	if method := db.QueryLabel(ctx); method != "" {
+		return method
+	}
+	return otherMethod
+}
+
+// queryTraceKey is the context key of the query in flight
+type queryTraceKey struct{}
+
+// queryTrace is a query or batch in flight
+type queryTrace struct {
+	start  time.Time
+	method string
+	span   trace.Span
+	err    error
+}
+
+// acquireTraceKey is the context key of the start time of an acquire
+type acquireTraceKey struct{}
+
+// QueryTracer records query latency by repository method and pool statistics that
+// pgxpool.Stat lacks (callers waiting for a connection, acquire latency), and creates
+// a span for every query as a child of the RPC span in its context.
+// Set it as the Tracer of the pool's ConnConfig (see db.PoolOptions).
+type QueryTracer struct {
+	pool    string
+	metrics *Metrics
+	tracer  trace.Tracer
+}
+
+// NewQueryTracer creates a tracer for the pool labeled pool=name
+func (m *Metrics) NewQueryTracer(name string) *QueryTracer {
+	return &QueryTracer{
+		pool:    name,
+		metrics: m,
+		tracer:  otel.Tracer(instrumentationName),
+	}
+}
+
+// start begins the trace of a statement issued from the repository method labeled in ctx
+func (t *QueryTracer) start(ctx context.Context, sql string) context.Context {
+	method := queryMethod(ctx)
+	ctx, span := t.tracer.Start(ctx, "db "+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.operation", method),
+			attribute.String("db.statement", sql),
+			attribute.String("db.pool", t.pool),
+		),
+	)
+	return context.WithValue(ctx, queryTraceKey{}, &queryTrace{start: time.Now(), method: method, span: span})
+}
+
+// end records the statement started with start
+func (t *QueryTracer) end(ctx context.Context, err error) {
+	qt, ok := ctx.Value(queryTraceKey{}).(*queryTrace)
+	if !ok {
+		return
+	}
+	if err == nil {
+		err = qt.err
+	}
+
+	status := "ok"
+	if err != nil {
+		status = "error"
+		qt.span.RecordError(err)
+		qt.span.SetStatus(otelcodes.Error, "query failed")
+	}
+	t.metrics.queryDuration.WithLabelValues(t.pool, qt.method, status).Observe(time.Since(qt.start).Seconds())
+	qt.span.End()
+}
+
+// TraceQueryStart implements pgx.QueryTracer
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return t.start(ctx, data.SQL)
+}
+
+// TraceQueryEnd implements pgx.QueryTracer
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	t.end(ctx, data.Err)
+}
+
+// TraceBatchStart implements pgx.BatchTracer. The RLS pool queues the statement last,
+// after the set_config calls, so the last statement names the batch.
+func (t *QueryTracer) TraceBatchStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchStartData) context.Context {
+	var sql string
+	if n := len(data.Batch.QueuedQueries); n > 0 {
+		sql = data.Batch.QueuedQueries[n-1].SQL
+	}
+	return t.start(ctx, sql)
+}
+
+// TraceBatchQuery implements pgx.BatchTracer
+func (t *QueryTracer) TraceBatchQuery(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchQueryData) {
+	if qt, ok := ctx.Value(queryTraceKey{}).(*queryTrace); ok && data.Err != nil && qt.err == nil {
+		qt.err = data.Err
+	}
+}
+
+// TraceBatchEnd implements pgx.BatchTracer
+func (t *QueryTracer) TraceBatchEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchEndData) {
+	t.end(ctx, data.Err)
+}
+
+// TraceAcquireStart implements pgxpool.AcquireTracer
+func (t *QueryTracer) TraceAcquireStart(ctx context.Context, pool *pgxpool.Pool, data pgxpool.TraceAcquireStartData) context.Context {
+	t.metrics.acquiring.WithLabelValues(t.pool).Inc()
+	return context.WithValue(ctx, acquireTraceKey{}, time.Now())
+}
+
+// TraceAcquireEnd implements pgxpool.AcquireTracer
+func (t *QueryTracer) TraceAcquireEnd(ctx context.Context, pool *pgxpool.Pool, data pgxpool.TraceAcquireEndData) {
+	t.metrics.acquiring.WithLabelValues(t.pool).Dec()
+	if start, ok := ctx.Value(acquireTraceKey{}).(time.Time); ok {
+		t.metrics.acquireDuration.WithLabelValues(t.pool).Observe(time.Since(start).Seconds())
+	}
+}